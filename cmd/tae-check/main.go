@@ -0,0 +1,47 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
+)
+
+// tae-check checks a TAE directory which is not in use and reports the
+// corrupted, missing and orphaned files
+func main() {
+	quarantine := flag.Bool("quarantine", false, "move the corrupted segment files to the quarantine directory")
+	flag.Usage = func() {
+		fmt.Printf("usage: %s [-quarantine] directory\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(-1)
+	}
+	report, err := db.Check(flag.Arg(0), &db.CheckOptions{Quarantine: *quarantine})
+	if err != nil {
+		fmt.Printf("check failed. error:%v \n", err)
+		os.Exit(-1)
+	}
+	fmt.Println(report.String())
+	if !report.OK() {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
+)
+
+// tae-restore rebuilds a TAE directory from a full backup followed by its
+// incremental backups
+func main() {
+	if len(os.Args) < 3 {
+		fmt.Printf("usage: %s targetDirectory fullBackup [incrementalBackup...]\n", os.Args[0])
+		os.Exit(-1)
+	}
+	if err := db.Restore(os.Args[1], os.Args[2:]...); err != nil {
+		fmt.Printf("restore failed. error:%v \n", err)
+		os.Exit(-1)
+	}
+}
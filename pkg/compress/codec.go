@@ -0,0 +1,507 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compress
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/pierrec/lz4"
+)
+
+var (
+	ErrNotApplicable = errors.New("compress: codec not applicable to the column type")
+	ErrCorrupted     = errors.New("compress: corrupted encoded column")
+)
+
+const (
+	// autoSampleChunks and autoSampleRows bound the values Pick encodes
+	// with every candidate codec
+	autoSampleChunks = 4
+	autoSampleRows   = 256
+)
+
+// column is a vector in the layout written by Vector.Show: the encoded
+// type, the length of the null bitmap and the bitmap, followed by the
+// values. The type-aware codecs only rewrite the values and keep the
+// header as is.
+type column struct {
+	typ    types.Type
+	header []byte
+	values []byte
+}
+
+func parseColumn(src []byte) (col column, err error) {
+	if len(src) < encoding.TypeSize+4 {
+		err = ErrCorrupted
+		return
+	}
+	col.typ = encoding.DecodeType(src[:encoding.TypeSize])
+	nsp := int(binary.LittleEndian.Uint32(src[encoding.TypeSize:]))
+	end := encoding.TypeSize + 4 + nsp
+	if len(src) < end {
+		err = ErrCorrupted
+		return
+	}
+	col.header = src[:end]
+	col.values = src[end:]
+	return
+}
+
+func isString(typ types.Type) bool {
+	switch typ.Oid {
+	case types.T_char, types.T_varchar, types.T_json:
+		return true
+	}
+	return false
+}
+
+func isInteger(typ types.Type) bool {
+	switch typ.Oid {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_date, types.T_datetime, types.T_timestamp, types.T_sel:
+		return true
+	}
+	return false
+}
+
+func valueWidth(typ types.Type) int {
+	switch typ.Oid {
+	case types.T_int8, types.T_uint8:
+		return 1
+	case types.T_int16, types.T_uint16:
+		return 2
+	case types.T_int32, types.T_uint32, types.T_date, types.T_float32:
+		return 4
+	case types.T_int64, types.T_uint64, types.T_datetime, types.T_timestamp,
+		types.T_float64, types.T_sel, types.T_decimal64:
+		return 8
+	case types.T_decimal128:
+		return 16
+	}
+	return 0
+}
+
+// split returns the values of the column, sharing memory with it
+func (col column) split() ([][]byte, error) {
+	if isString(col.typ) {
+		if len(col.values) < 4 {
+			return nil, ErrCorrupted
+		}
+		cnt := int(int32(binary.LittleEndian.Uint32(col.values)))
+		data := col.values[4:]
+		if len(data) < cnt*4 {
+			return nil, ErrCorrupted
+		}
+		lengths, data := data[:cnt*4], data[cnt*4:]
+		vals := make([][]byte, cnt)
+		for i := range vals {
+			n := int(binary.LittleEndian.Uint32(lengths[i*4:]))
+			if len(data) < n {
+				return nil, ErrCorrupted
+			}
+			vals[i], data = data[:n], data[n:]
+		}
+		return vals, nil
+	}
+	width := valueWidth(col.typ)
+	if width == 0 {
+		return nil, ErrNotApplicable
+	}
+	if len(col.values)%width != 0 {
+		return nil, ErrCorrupted
+	}
+	vals := make([][]byte, len(col.values)/width)
+	for i := range vals {
+		vals[i] = col.values[i*width : (i+1)*width]
+	}
+	return vals, nil
+}
+
+// join writes the values in the layout of Vector.Show
+func join(typ types.Type, vals [][]byte, w *bytes.Buffer) {
+	if !isString(typ) {
+		for _, v := range vals {
+			w.Write(v)
+		}
+		return
+	}
+	var word [4]byte
+	binary.LittleEndian.PutUint32(word[:], uint32(len(vals)))
+	w.Write(word[:])
+	if len(vals) == 0 {
+		return
+	}
+	for _, v := range vals {
+		binary.LittleEndian.PutUint32(word[:], uint32(len(v)))
+		w.Write(word[:])
+	}
+	for _, v := range vals {
+		w.Write(v)
+	}
+}
+
+type valueReader struct {
+	buf []byte
+	err error
+}
+
+func (r *valueReader) uvarint() uint64 {
+	if r.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(r.buf)
+	if n <= 0 {
+		r.err = ErrCorrupted
+		return 0
+	}
+	r.buf = r.buf[n:]
+	return v
+}
+
+func (r *valueReader) varint() int64 {
+	if r.err != nil {
+		return 0
+	}
+	v, n := binary.Varint(r.buf)
+	if n <= 0 {
+		r.err = ErrCorrupted
+		return 0
+	}
+	r.buf = r.buf[n:]
+	return v
+}
+
+func (r *valueReader) bytes(n int) []byte {
+	if r.err != nil {
+		return nil
+	}
+	if len(r.buf) < n {
+		r.err = ErrCorrupted
+		return nil
+	}
+	v := r.buf[:n]
+	r.buf = r.buf[n:]
+	return v
+}
+
+func (r *valueReader) uint32() uint32 {
+	v := r.bytes(4)
+	if r.err != nil {
+		return 0
+	}
+	return binary.LittleEndian.Uint32(v)
+}
+
+func (r *valueReader) value(width int) []byte {
+	if width > 0 {
+		return r.bytes(width)
+	}
+	return r.bytes(int(r.uvarint()))
+}
+
+func putUvarint(w *bytes.Buffer, v uint64) {
+	var buf [binary.MaxVarintLen64]byte
+	w.Write(buf[:binary.PutUvarint(buf[:], v)])
+}
+
+func putVarint(w *bytes.Buffer, v int64) {
+	var buf [binary.MaxVarintLen64]byte
+	w.Write(buf[:binary.PutVarint(buf[:], v)])
+}
+
+func putValue(w *bytes.Buffer, v []byte, width int) {
+	if width == 0 {
+		putUvarint(w, uint64(len(v)))
+	}
+	w.Write(v)
+}
+
+// encodeDict replaces every value with its index in a dictionary of the
+// distinct values, which suits low-cardinality strings
+func encodeDict(col column, vals [][]byte, w *bytes.Buffer) error {
+	if !isString(col.typ) {
+		return ErrNotApplicable
+	}
+	dict := make(map[string]uint64)
+	var entries [][]byte
+	idxes := make([]uint64, len(vals))
+	for i, v := range vals {
+		idx, ok := dict[string(v)]
+		if !ok {
+			idx = uint64(len(entries))
+			dict[string(v)] = idx
+			entries = append(entries, v)
+		}
+		idxes[i] = idx
+	}
+	putUvarint(w, uint64(len(entries)))
+	for _, v := range entries {
+		putValue(w, v, 0)
+	}
+	putUvarint(w, uint64(len(idxes)))
+	for _, idx := range idxes {
+		putUvarint(w, idx)
+	}
+	return nil
+}
+
+func decodeDict(r *valueReader) [][]byte {
+	entries := make([][]byte, r.uvarint())
+	for i := range entries {
+		entries[i] = r.value(0)
+	}
+	vals := make([][]byte, r.uvarint())
+	for i := range vals {
+		idx := r.uvarint()
+		if idx >= uint64(len(entries)) {
+			r.err = ErrCorrupted
+		}
+		if r.err != nil {
+			return nil
+		}
+		vals[i] = entries[idx]
+	}
+	return vals
+}
+
+// encodeRLE writes runs of equal values as a count and the value, which
+// suits sorted or repetitive columns
+func encodeRLE(col column, vals [][]byte, w *bytes.Buffer) error {
+	width := valueWidth(col.typ)
+	var runs bytes.Buffer
+	cnt := uint64(0)
+	for i := 0; i < len(vals); {
+		j := i + 1
+		for j < len(vals) && bytes.Equal(vals[i], vals[j]) {
+			j++
+		}
+		putUvarint(&runs, uint64(j-i))
+		putValue(&runs, vals[i], width)
+		cnt++
+		i = j
+	}
+	putUvarint(w, cnt)
+	w.Write(runs.Bytes())
+	return nil
+}
+
+func decodeRLE(col column, r *valueReader) [][]byte {
+	width := valueWidth(col.typ)
+	var vals [][]byte
+	for runs := r.uvarint(); runs > 0 && r.err == nil; runs-- {
+		n := r.uvarint()
+		v := r.value(width)
+		for ; n > 0 && r.err == nil; n-- {
+			vals = append(vals, v)
+		}
+	}
+	return vals
+}
+
+// encodeDelta writes the first value as the frame of reference and every
+// following value as the zigzag varint of the difference to its
+// predecessor, which suits integers and timestamps that grow slowly
+func encodeDelta(col column, vals [][]byte, w *bytes.Buffer) error {
+	if !isInteger(col.typ) {
+		return ErrNotApplicable
+	}
+	width := valueWidth(col.typ)
+	putUvarint(w, uint64(len(vals)))
+	prev := int64(0)
+	for _, v := range vals {
+		cur := readInt(v, width)
+		putVarint(w, cur-prev)
+		prev = cur
+	}
+	return nil
+}
+
+func decodeDelta(col column, r *valueReader) [][]byte {
+	width := valueWidth(col.typ)
+	cnt := r.uvarint()
+	if r.err != nil {
+		return nil
+	}
+	buf := make([]byte, int(cnt)*width)
+	vals := make([][]byte, cnt)
+	prev := int64(0)
+	for i := range vals {
+		prev += r.varint()
+		vals[i] = buf[i*width : (i+1)*width]
+		writeInt(vals[i], prev)
+	}
+	return vals
+}
+
+// readInt sign-extends the value so that the differences of narrow signed
+// values stay small; unsigned values wrap around consistently as only the
+// low bytes are written back
+func readInt(v []byte, width int) int64 {
+	switch width {
+	case 1:
+		return int64(int8(v[0]))
+	case 2:
+		return int64(int16(binary.LittleEndian.Uint16(v)))
+	case 4:
+		return int64(int32(binary.LittleEndian.Uint32(v)))
+	default:
+		return int64(binary.LittleEndian.Uint64(v))
+	}
+}
+
+func writeInt(v []byte, x int64) {
+	switch len(v) {
+	case 1:
+		v[0] = byte(x)
+	case 2:
+		binary.LittleEndian.PutUint16(v, uint16(x))
+	case 4:
+		binary.LittleEndian.PutUint32(v, uint32(x))
+	default:
+		binary.LittleEndian.PutUint64(v, uint64(x))
+	}
+}
+
+// encodeColumn encodes the values of a serialized vector with one of the
+// type-aware codecs and compresses the result with LZ4. The output is the
+// size of the encoded column followed by the compressed bytes.
+func encodeColumn(src, dst []byte, typ int) ([]byte, error) {
+	col, err := parseColumn(src)
+	if err != nil {
+		return nil, err
+	}
+	vals, err := col.split()
+	if err != nil {
+		return nil, err
+	}
+	var w bytes.Buffer
+	var word [4]byte
+	binary.LittleEndian.PutUint32(word[:], uint32(len(col.header)))
+	w.Write(word[:])
+	w.Write(col.header)
+	switch typ {
+	case Dict:
+		err = encodeDict(col, vals, &w)
+	case RLE:
+		err = encodeRLE(col, vals, &w)
+	case Delta:
+		err = encodeDelta(col, vals, &w)
+	default:
+		err = fmt.Errorf("%w: %s", ErrNotApplicable, T(typ))
+	}
+	if err != nil {
+		return nil, err
+	}
+	encoded := w.Bytes()
+	size := 4 + lz4.CompressBlockBound(len(encoded))
+	if cap(dst) < size {
+		dst = make([]byte, size)
+	}
+	dst = dst[:size]
+	binary.LittleEndian.PutUint32(dst, uint32(len(encoded)))
+	n, err := lz4.CompressBlock(encoded, dst[4:], nil)
+	if err != nil {
+		return nil, err
+	}
+	return dst[:4+n], nil
+}
+
+// decodeColumn reverses encodeColumn and writes the serialized vector to dst
+func decodeColumn(src, dst []byte, typ int) ([]byte, error) {
+	if len(src) < 4 {
+		return nil, ErrCorrupted
+	}
+	encoded := make([]byte, binary.LittleEndian.Uint32(src))
+	n, err := lz4.UncompressBlock(src[4:], encoded)
+	if err != nil {
+		return nil, err
+	}
+	r := &valueReader{buf: encoded[:n]}
+	header := r.bytes(int(r.uint32()))
+	if r.err != nil {
+		return nil, r.err
+	}
+	col, err := parseColumn(header)
+	if err != nil {
+		return nil, err
+	}
+	var vals [][]byte
+	switch typ {
+	case Dict:
+		vals = decodeDict(r)
+	case RLE:
+		vals = decodeRLE(col, r)
+	case Delta:
+		vals = decodeDelta(col, r)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrNotApplicable, T(typ))
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+	w := bytes.NewBuffer(dst[:0])
+	w.Write(header)
+	join(col.typ, vals, w)
+	return w.Bytes(), nil
+}
+
+// Pick chooses the codec for a serialized vector by encoding a sample of
+// its values with every applicable codec and keeping the smallest output.
+// LZ4 is picked when the data is not a vector.
+func Pick(src []byte) int {
+	col, err := parseColumn(src)
+	if err != nil {
+		return Lz4
+	}
+	vals, err := col.split()
+	if err != nil {
+		return Lz4
+	}
+	var sample bytes.Buffer
+	sample.Write(col.header)
+	join(col.typ, sampleValues(vals), &sample)
+
+	best, bestSize := Lz4, -1
+	for _, typ := range []int{Lz4, Zstd, Dict, RLE, Delta} {
+		buf, err := Compress(sample.Bytes(), nil, typ)
+		if err != nil {
+			continue
+		}
+		if bestSize < 0 || len(buf) < bestSize {
+			best, bestSize = typ, len(buf)
+		}
+	}
+	return best
+}
+
+// sampleValues takes a few contiguous chunks spread over the values, so that
+// runs and deltas are preserved within each chunk
+func sampleValues(vals [][]byte) [][]byte {
+	if len(vals) <= autoSampleChunks*autoSampleRows {
+		return vals
+	}
+	sample := make([][]byte, 0, autoSampleChunks*autoSampleRows)
+	step := len(vals) / autoSampleChunks
+	for i := 0; i < autoSampleChunks; i++ {
+		sample = append(sample, vals[i*step:i*step+autoSampleRows]...)
+	}
+	return sample
+}
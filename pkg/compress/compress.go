@@ -15,14 +15,42 @@
 package compress
 
 import (
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
 	"github.com/pierrec/lz4"
 )
 
 var Algorithms map[string]int = map[string]int{
-	"lz4":  Lz4,
-	"none": None,
+	"lz4":   Lz4,
+	"none":  None,
+	"zstd":  Zstd,
+	"dict":  Dict,
+	"rle":   RLE,
+	"delta": Delta,
+	"auto":  Auto,
+}
+
+var (
+	zstdOnce    sync.Once
+	zstdEncoder *zstd.Encoder
+	zstdDecoder *zstd.Decoder
+)
+
+func initZstd() {
+	var err error
+	if zstdEncoder, err = zstd.NewWriter(nil); err != nil {
+		panic(err)
+	}
+	if zstdDecoder, err = zstd.NewReader(nil); err != nil {
+		panic(err)
+	}
 }
 
+// Compress compresses src with the algorithm typ. dst is used as the output
+// buffer when it is large enough. Dict, RLE and Delta expect src to be a
+// vector serialized by Vector.Show and return ErrNotApplicable for the
+// types they do not support.
 func Compress(src, dst []byte, typ int) ([]byte, error) {
 	switch typ {
 	case Lz4:
@@ -31,6 +59,11 @@ func Compress(src, dst []byte, typ int) ([]byte, error) {
 			return nil, err
 		}
 		return dst[:n], nil
+	case Zstd:
+		zstdOnce.Do(initZstd)
+		return zstdEncoder.EncodeAll(src, dst[:0]), nil
+	case Dict, RLE, Delta:
+		return encodeColumn(src, dst, typ)
 	}
 	return nil, nil
 }
@@ -43,6 +76,11 @@ func Decompress(src, dst []byte, typ int) ([]byte, error) {
 			return nil, err
 		}
 		return dst[:n], nil
+	case Zstd:
+		zstdOnce.Do(initZstd)
+		return zstdDecoder.DecodeAll(src, dst[:0])
+	case Dict, RLE, Delta:
+		return decodeColumn(src, dst, typ)
 	}
 	return nil, nil
 }
@@ -15,11 +15,16 @@
 package compress
 
 import (
+	"errors"
 	"fmt"
 	"log"
 	"testing"
 
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/stretchr/testify/require"
 
 	"github.com/pierrec/lz4"
 )
@@ -41,3 +46,37 @@ func TestLz4(t *testing.T) {
 	}
 	fmt.Printf("dat: %v\n", data)
 }
+
+func TestCodecs(t *testing.T) {
+	ints := vector.New(types.Type{Oid: types.T_int64, Size: 8})
+	strs := vector.New(types.Type{Oid: types.T_varchar, Size: 24})
+	for i := 0; i < 3000; i++ {
+		ints.Col = append(ints.Col.([]int64), int64(1000+i/3))
+		strs.Col.(*types.Bytes).Offsets = append(strs.Col.(*types.Bytes).Offsets, uint32(len(strs.Col.(*types.Bytes).Data)))
+		v := []byte(fmt.Sprintf("region-%d", i%7))
+		strs.Col.(*types.Bytes).Lengths = append(strs.Col.(*types.Bytes).Lengths, uint32(len(v)))
+		strs.Col.(*types.Bytes).Data = append(strs.Col.(*types.Bytes).Data, v...)
+	}
+	nulls.Add(ints.Nsp, 7)
+	for _, vec := range []*vector.Vector{ints, strs} {
+		raw, err := vec.Show()
+		require.NoError(t, err)
+		for _, typ := range []int{Lz4, Zstd, Dict, RLE, Delta} {
+			buf, err := Compress(raw, make([]byte, lz4.CompressBlockBound(len(raw))), typ)
+			if errors.Is(err, ErrNotApplicable) {
+				continue
+			}
+			require.NoError(t, err)
+			data, err := Decompress(buf, make([]byte, len(raw)), typ)
+			require.NoError(t, err)
+			require.Equal(t, raw, data, T(typ).String())
+			t.Logf("%s %s: %d => %d", vec.Typ, T(typ), len(raw), len(buf))
+		}
+	}
+	raw, _ := ints.Show()
+	_, err := Compress(raw, nil, Dict)
+	require.ErrorIs(t, err, ErrNotApplicable)
+	require.NotEqual(t, Lz4, Pick(raw))
+	raw, _ = strs.Show()
+	require.NotEqual(t, Lz4, Pick(raw))
+}
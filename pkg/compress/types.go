@@ -19,6 +19,15 @@ import "fmt"
 const (
 	None = iota
 	Lz4
+	Zstd
+	// Dict, RLE and Delta encode the values of a serialized vector and
+	// compress the encoded column with LZ4
+	Dict
+	RLE
+	Delta
+	// Auto picks one of the codecs above by sampling the data when it is
+	// written. It is never stored with the data.
+	Auto
 )
 
 type T uint8
@@ -29,6 +38,16 @@ func (t T) String() string {
 		return "None"
 	case Lz4:
 		return "LZ4"
+	case Zstd:
+		return "ZSTD"
+	case Dict:
+		return "DICT"
+	case RLE:
+		return "RLE"
+	case Delta:
+		return "DELTA"
+	case Auto:
+		return "AUTO"
 	}
 	return fmt.Sprintf("unexpected compress type: %d", t)
 }
@@ -35,6 +35,12 @@ func DecodeFixedCol[T any](v *Vector, sz int) []T {
 
 func New(typ types.Type) *Vector {
 	switch typ.Oid {
+	case types.T_bool:
+		return &Vector{
+			Typ: typ,
+			Col: []bool{},
+			Nsp: &nulls.Nulls{},
+		}
 	case types.T_int8:
 		return &Vector{
 			Typ: typ,
@@ -315,6 +321,8 @@ func setLengthFixed[T any](v *Vector, n int) {
 
 func SetLength(v *Vector, n int) {
 	switch v.Typ.Oid {
+	case types.T_bool:
+		setLengthFixed[bool](v, n)
 	case types.T_int8:
 		setLengthFixed[int8](v, n)
 	case types.T_int16:
@@ -751,6 +759,13 @@ func Append(v *Vector, arg interface{}) error {
 
 func Shrink(v *Vector, sels []int64) {
 	switch v.Typ.Oid {
+	case types.T_bool:
+		vs := v.Col.([]bool)
+		for i, sel := range sels {
+			vs[i] = vs[sel]
+		}
+		v.Col = vs[:len(sels)]
+		v.Nsp = nulls.Filter(v.Nsp, sels)
 	case types.T_int8:
 		vs := v.Col.([]int8)
 		for i, sel := range sels {
@@ -1105,6 +1120,8 @@ func UnionOne(v, w *Vector, sel int64, m *mheap.Mheap) error {
 		return errors.New("UnionOne operation cannot be performed for origin vector")
 	}
 	switch v.Typ.Oid {
+	case types.T_bool:
+		v.Col = append(v.Col.([]bool), w.Col.([]bool)[sel])
 	case types.T_int8:
 		if len(v.Data) == 0 {
 			data, err := mheap.Alloc(m, 8)
@@ -1559,6 +1576,8 @@ func UnionNull(v, w *Vector, m *mheap.Mheap) error {
 		return errors.New("UnionNull operation cannot be performed for origin vector")
 	}
 	switch v.Typ.Oid {
+	case types.T_bool:
+		v.Col = append(v.Col.([]bool), false)
 	case types.T_int8:
 		if len(v.Data) == 0 {
 			data, err := mheap.Alloc(m, 8)
@@ -2281,6 +2300,15 @@ func UnionBatch(v, w *Vector, offset int64, cnt int, flags []uint8, m *mheap.Mhe
 	oldLen := Length(v)
 
 	switch v.Typ.Oid {
+	case types.T_bool:
+		vs := v.Col.([]bool)
+		col := w.Col.([]bool)
+		for i := 0; i < len(flags); i++ {
+			if flags[i] > 0 {
+				vs = append(vs, col[int(offset)+i])
+			}
+		}
+		v.Col = vs
 	case types.T_int8:
 		col := w.Col.([]int8)
 		if len(v.Data) == 0 {
@@ -16,13 +16,16 @@ package frontend
 
 import (
 	"fmt"
+	"go/constant"
 	"os"
+	"regexp"
 	"runtime/pprof"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/errno"
+	pbplan "github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/compile2"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
@@ -43,6 +46,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/tracker"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -584,6 +588,28 @@ func (mce *MysqlCmdExecutor) handleSelectDatabase(sel *tree.Select) error {
 	return nil
 }
 
+//handle SELECT LAST_INSERT_ID()
+func (mce *MysqlCmdExecutor) handleSelectLastInsertID() error {
+	var err error = nil
+	ses := mce.GetSession()
+	proto := ses.protocol
+
+	col := new(MysqlColumn)
+	col.SetName("LAST_INSERT_ID()")
+	col.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+	col.SetSigned(false)
+	ses.Mrs.AddColumn(col)
+	ses.Mrs.AddRow([]interface{}{ses.lastInsertID})
+
+	mer := NewMysqlExecutionResult(0, 0, 0, 0, ses.Mrs)
+	resp := NewResponse(ResultResponse, 0, int(COM_QUERY), mer)
+
+	if err = proto.SendResponse(resp); err != nil {
+		return fmt.Errorf("routine send response failed. error:%v ", err)
+	}
+	return nil
+}
+
 /*
 handle "SELECT @@max_allowed_packet"
 */
@@ -637,6 +663,23 @@ func (mce *MysqlCmdExecutor) handleVersionComment() error {
 	return err
 }
 
+// getTxIsolation returns the value of @@tx_isolation of the session
+func getTxIsolation(ses *Session) string {
+	level := tree.ISOLATION_LEVEL_REPEATABLE_READ
+	if th := ses.GetTxnHandler(); th != nil {
+		level = th.isolation
+	}
+	switch level {
+	case tree.ISOLATION_LEVEL_READ_UNCOMMITTED:
+		return "READ-UNCOMMITTED"
+	case tree.ISOLATION_LEVEL_READ_COMMITTED:
+		return "READ-COMMITTED"
+	case tree.ISOLATION_LEVEL_SERIALIZABLE:
+		return "SERIALIZABLE"
+	}
+	return "REPEATABLE-READ"
+}
+
 /*
 handle "SELECT @@session.tx_isolation"
 */
@@ -651,7 +694,7 @@ func (mce *MysqlCmdExecutor) handleTxIsolation() error {
 	ses.Mrs.AddColumn(col)
 
 	var data = make([]interface{}, 1)
-	data[0] = "REPEATABLE-READ"
+	data[0] = getTxIsolation(ses)
 	ses.Mrs.AddRow(data)
 
 	mer := NewMysqlExecutionResult(0, 0, 0, 0, ses.Mrs)
@@ -678,7 +721,7 @@ func (mce *MysqlCmdExecutor) handleSelectVariables(v string) error {
 		ses.Mrs.AddColumn(col)
 
 		var data = make([]interface{}, 1)
-		data[0] = "REPEATABLE-READ"
+		data[0] = getTxIsolation(ses)
 		ses.Mrs.AddRow(data)
 	} else {
 		return fmt.Errorf("unsupported system variable %s", v)
@@ -884,9 +927,19 @@ func (mce *MysqlCmdExecutor) handleCmdFieldList(tableName string) error {
 /*
 handle setvar
 */
-func (mce *MysqlCmdExecutor) handleSetVar(_ *tree.SetVar) error {
+func (mce *MysqlCmdExecutor) handleSetVar(sv *tree.SetVar) error {
 	var err error = nil
-	proto := mce.GetSession().protocol
+	ses := mce.GetSession()
+	proto := ses.protocol
+	for i := 0; sv != nil && i < len(sv.Assignments); i++ {
+		if assign := sv.Assignments[i]; strings.ToLower(assign.Name) == "innodb_lock_wait_timeout" {
+			timeout, err := getLockWaitTimeout(assign.Value)
+			if err != nil {
+				return err
+			}
+			ses.GetTxnHandler().SetLockWaitTimeout(timeout)
+		}
+	}
 
 	resp := NewOkResponse(0, 0, 0, 0, int(COM_QUERY), "")
 	if err = proto.SendResponse(resp); err != nil {
@@ -895,6 +948,60 @@ func (mce *MysqlCmdExecutor) handleSetVar(_ *tree.SetVar) error {
 	return nil
 }
 
+// getLockWaitTimeout returns the value of innodb_lock_wait_timeout, in seconds
+func getLockWaitTimeout(expr tree.Expr) (time.Duration, error) {
+	val, ok := expr.(*tree.NumVal)
+	if ok && val.Value.Kind() == constant.Int {
+		if v, exact := constant.Int64Val(val.Value); exact && v >= 1 && v <= 1073741824 {
+			return time.Duration(v) * time.Second, nil
+		}
+	}
+	return 0, errors.New(errno.InvalidOptionValue, fmt.Sprintf("invalid value of innodb_lock_wait_timeout: %s", tree.String(expr, dialect.MYSQL)))
+}
+
+/*
+handle SET [GLOBAL|SESSION] TRANSACTION ISOLATION LEVEL
+*/
+func (mce *MysqlCmdExecutor) handleSetTransaction(st *tree.SetTransaction) error {
+	ses := mce.GetSession()
+	txnHandler := ses.GetTxnHandler()
+	//the characteristics of the running txn can not be changed
+	if st.Scope == tree.SET_TRANSACTION_SCOPE_NEXT && txnHandler.IsInTaeTxn() {
+		return NewMysqlError(ER_CANT_CHANGE_TX_CHARACTERISTICS)
+	}
+	txnHandler.SetIsolationLevel(st.Scope, st.Level)
+
+	resp := NewOkResponse(0, 0, 0, 0, int(COM_QUERY), "")
+	if err := ses.protocol.SendResponse(resp); err != nil {
+		return fmt.Errorf("routine send response failed. error:%v ", err)
+	}
+	return nil
+}
+
+// handleSavepoint handles SAVEPOINT, ROLLBACK TO SAVEPOINT and RELEASE SAVEPOINT
+func (mce *MysqlCmdExecutor) handleSavepoint(stmt tree.Statement) error {
+	ses := mce.GetSession()
+	txnHandler := ses.GetTxnHandler()
+	var err error
+	switch st := stmt.(type) {
+	case *tree.Savepoint:
+		err = txnHandler.Savepoint(st.Name)
+	case *tree.RollbackToSavepoint:
+		err = txnHandler.RollbackToSavepoint(st.Name)
+	case *tree.ReleaseSavepoint:
+		err = txnHandler.ReleaseSavepoint(st.Name)
+	}
+	if err != nil {
+		return err
+	}
+
+	resp := NewOkResponse(0, 0, 0, 0, int(COM_QUERY), "")
+	if err = ses.protocol.SendResponse(resp); err != nil {
+		return fmt.Errorf("routine send response failed. error:%v ", err)
+	}
+	return nil
+}
+
 /*
 handle show variables
 */
@@ -923,6 +1030,123 @@ func (mce *MysqlCmdExecutor) handleShowVariables(_ *tree.ShowVariables) error {
 	return err
 }
 
+/*
+handle "SHOW [FULL] PROCESSLIST"
+*/
+func (mce *MysqlCmdExecutor) handleShowProcessList(st *tree.ShowProcessList) error {
+	ses := mce.GetSession()
+	proto := ses.protocol
+
+	names := []string{"Id", "User", "Host", "db", "Command", "Info", "Memory", "Max_memory"}
+	colTypes := []uint8{
+		defines.MYSQL_TYPE_LONGLONG,
+		defines.MYSQL_TYPE_VARCHAR,
+		defines.MYSQL_TYPE_VARCHAR,
+		defines.MYSQL_TYPE_VARCHAR,
+		defines.MYSQL_TYPE_VARCHAR,
+		defines.MYSQL_TYPE_VARCHAR,
+		defines.MYSQL_TYPE_LONGLONG,
+		defines.MYSQL_TYPE_LONGLONG,
+	}
+	for i, name := range names {
+		col := new(MysqlColumn)
+		col.SetColumnType(colTypes[i])
+		col.SetName(name)
+		ses.Mrs.AddColumn(col)
+	}
+	if rm := mce.GetRoutineManager(); rm != nil {
+		for _, row := range rm.processList(st.Full) {
+			ses.Mrs.AddRow(row)
+		}
+	}
+
+	mer := NewMysqlExecutionResult(0, 0, 0, 0, ses.Mrs)
+	resp := NewResponse(ResultResponse, 0, int(COM_QUERY), mer)
+
+	if err := proto.SendResponse(resp); err != nil {
+		return fmt.Errorf("routine send response failed. error:%v ", err)
+	}
+	return nil
+}
+
+// handleShowStatus shows the status variables of the server
+func (mce *MysqlCmdExecutor) handleShowStatus(st *tree.ShowStatus) error {
+	ses := mce.GetSession()
+	proto := ses.protocol
+
+	var pattern *regexp.Regexp
+	if st.Like != nil {
+		var err error
+		if pattern, err = likePatternToRegexp(st.Like.Right); err != nil {
+			return err
+		}
+	}
+
+	for _, name := range []string{"Variable_name", "Value"} {
+		col := new(MysqlColumn)
+		col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+		col.SetName(name)
+		ses.Mrs.AddColumn(col)
+	}
+	vars := [][]interface{}{
+		{"Plan_cache_hits", strconv.FormatInt(ses.planCache.Hits(), 10)},
+		{"Plan_cache_misses", strconv.FormatInt(ses.planCache.Misses(), 10)},
+		{"Plan_cache_plans", strconv.Itoa(ses.planCache.Len())},
+	}
+	for _, row := range vars {
+		if pattern == nil || pattern.MatchString(row[0].(string)) {
+			ses.Mrs.AddRow(row)
+		}
+	}
+
+	mer := NewMysqlExecutionResult(0, 0, 0, 0, ses.Mrs)
+	resp := NewResponse(ResultResponse, 0, int(COM_QUERY), mer)
+
+	if err := proto.SendResponse(resp); err != nil {
+		return fmt.Errorf("routine send response failed. error:%v ", err)
+	}
+	return nil
+}
+
+// likePatternToRegexp converts the pattern of a case insensitive LIKE into a regular expression
+func likePatternToRegexp(expr tree.Expr) (*regexp.Regexp, error) {
+	val, ok := expr.(*tree.NumVal)
+	if !ok || val.Value.Kind() != constant.String {
+		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("invalid like pattern '%v'", tree.String(expr, dialect.MYSQL)))
+	}
+	var buf strings.Builder
+	buf.WriteString("(?is)^")
+	for _, c := range constant.StringVal(val.Value) {
+		switch c {
+		case '%':
+			buf.WriteString(".*")
+		case '_':
+			buf.WriteString(".")
+		default:
+			buf.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	buf.WriteString("$")
+	return regexp.Compile(buf.String())
+}
+
+// handleBackup copies the data of the storage engine to a directory
+func (mce *MysqlCmdExecutor) handleBackup(st *tree.Backup) error {
+	ses := mce.GetSession()
+	e, ok := ses.Pu.StorageEngine.(engine.BackupEngine)
+	if !ok {
+		return errors.New(errno.FeatureNotSupported, "backup is not supported by the storage engine")
+	}
+	if err := e.Backup(st.Dir, st.Base); err != nil {
+		return err
+	}
+	resp := NewOkResponse(0, 0, 0, 0, int(COM_QUERY), "")
+	if err := ses.protocol.SendResponse(resp); err != nil {
+		return fmt.Errorf("routine send response failed. error:%v ", err)
+	}
+	return nil
+}
+
 func (mce *MysqlCmdExecutor) handleAnalyzeStmt(stmt *tree.AnalyzeStmt) error {
 	// rewrite analyzeStmt to `select approx_count_distinct(col), .. from tbl`
 	// IMO, this approach is simple and future-proof
@@ -944,7 +1168,7 @@ func (mce *MysqlCmdExecutor) handleAnalyzeStmt(stmt *tree.AnalyzeStmt) error {
 	return mce.doComQuery(sql)
 }
 
-func (mce *MysqlCmdExecutor) handleExplainStmt(stmt *tree.ExplainStmt) error {
+func (mce *MysqlCmdExecutor) handleExplainStmt(stmt *tree.ExplainStmt, proc *process.Process) error {
 	es := explain.NewExplainDefaultOptions()
 
 	for _, v := range stmt.Options {
@@ -979,20 +1203,28 @@ func (mce *MysqlCmdExecutor) handleExplainStmt(stmt *tree.ExplainStmt) error {
 		}
 	}
 
-	//get query optimizer and execute Optimize
-	mockOptimizer := plan2.NewMockOptimizer()
-	qry, err := mockOptimizer.Optimize(stmt.Statement)
-
-	if err != nil {
-		logutil.Errorf("build query plan and optimize failed, error: %v", err)
-		return errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("build query plan and optimize failed:'%v'", err))
-	}
-
 	// build explain data buffer
 	buffer := explain.NewExplainDataBuffer()
-	// generator query explain
-	explainQuery := explain.NewExplainQueryImpl(qry)
-	err = explainQuery.ExplainPlan(buffer, es)
+	var err error
+	if es.Anzlyze {
+		// execute the statement and explain the plan with its runtime statistics
+		var explainQuery *explain.ExplainQueryImpl
+		if explainQuery, err = mce.runExplainAnalyze(stmt.Statement, proc); err != nil {
+			return err
+		}
+		err = explainQuery.ExplainAnalyze(buffer, es)
+	} else {
+		//get query optimizer and execute Optimize
+		mockOptimizer := plan2.NewMockOptimizer()
+		qry, err := mockOptimizer.Optimize(stmt.Statement)
+		if err != nil {
+			logutil.Errorf("build query plan and optimize failed, error: %v", err)
+			return errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("build query plan and optimize failed:'%v'", err))
+		}
+		// generator query explain
+		explainQuery := explain.NewExplainQueryImpl(qry)
+		err = explainQuery.ExplainPlan(buffer, es)
+	}
 	if err != nil {
 		logutil.Errorf("explain Query statement error: %v", err)
 		return errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("explain Query statement error:%v", err))
@@ -1075,6 +1307,35 @@ func GetExplainColumns(attrs []*plan.Attribute) ([]interface{}, error) {
 	return mysqlCols, err
 }
 
+// runExplainAnalyze compiles and runs stmt, the result of the statement is
+// discarded and only the runtime statistics collected in proc are kept. Only
+// SELECT is run, since the changes of a DML statement would be kept.
+func (mce *MysqlCmdExecutor) runExplainAnalyze(stmt tree.Statement, proc *process.Process) (*explain.ExplainQueryImpl, error) {
+	cw := InitTxnComputationWrapper(mce.GetSession(), stmt, proc)
+	ret, err := cw.Compile(mce.GetSession(), func(interface{}, *batch.Batch) error {
+		return nil
+	})
+	if err != nil {
+		logutil.Errorf("build query plan failed, error: %v", err)
+		return nil, err
+	}
+	qry := cw.plan.GetQuery()
+	if qry == nil {
+		return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("explain analyze is not supported for '%s'", tree.String(stmt, dialect.MYSQL)))
+	}
+	if qry.StmtType != pbplan.Query_SELECT {
+		return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("explain analyze is only supported for select statements, '%s' would change the data", tree.String(stmt, dialect.MYSQL)))
+	}
+	if err = ret.(ComputationRunner).Run(0); err != nil {
+		logutil.Errorf("run query failed, error: %v", err)
+		return nil, err
+	}
+	explainQuery := explain.NewExplainQueryImpl(qry)
+	explainQuery.AnalyzeInfos = proc.AnalInfos
+	explainQuery.ScopeInfos = proc.ScopeInfos.Infos()
+	return explainQuery, nil
+}
+
 func buildMoExplainQuery(attrs []*plan.Attribute, buffer *explain.ExplainDataBuffer, session *Session, fill func(interface{}, *batch.Batch) error) error {
 	bat := batch.New(true, []string{attrs[0].Name})
 	rs := buffer.Lines
@@ -1152,6 +1413,8 @@ type TxnComputationWrapper struct {
 	plan *plan2.Plan
 	proc *process.Process
 	ses  *Session
+	// comp knows the affected rows after it runs
+	comp interface{ GetAffectedRows() uint64 }
 }
 
 func InitTxnComputationWrapper(ses *Session, stmt tree.Statement, proc *process.Process) *TxnComputationWrapper {
@@ -1187,12 +1450,15 @@ func (cwft *TxnComputationWrapper) GetColumns() ([]interface{}, error) {
 }
 
 func (cwft *TxnComputationWrapper) GetAffectedRows() uint64 {
-	return 0
+	if cwft.comp == nil {
+		return 0
+	}
+	return cwft.comp.GetAffectedRows()
 }
 
 func (cwft *TxnComputationWrapper) Compile(u interface{}, fill func(interface{}, *batch.Batch) error) (interface{}, error) {
 	var err error
-	cwft.plan, err = plan2.BuildPlan(cwft.ses.GetTxnCompilerContext(), cwft.stmt)
+	cwft.plan, err = cwft.buildPlan()
 	if err != nil {
 		return nil, err
 	}
@@ -1222,9 +1488,40 @@ func (cwft *TxnComputationWrapper) Compile(u interface{}, fill func(interface{},
 			return nil, err2
 		}
 	}
+	cwft.comp = comp
 	return comp, err
 }
 
+// buildPlan builds the plan of the statement. The plans of select statements
+// are looked up in the plan cache first, with the literals of the predicates
+// replaced by parameters.
+func (cwft *TxnComputationWrapper) buildPlan() (*plan2.Plan, error) {
+	ctx := cwft.ses.GetTxnCompilerContext()
+	cache := cwft.ses.planCache
+	if cache == nil {
+		return plan2.BuildPlan(ctx, cwft.stmt)
+	}
+	ps := plan2.Parameterize(cwft.stmt)
+	if ps == nil {
+		return plan2.BuildPlan(ctx, cwft.stmt)
+	}
+	defer ps.Restore()
+
+	key := ctx.DefaultDatabase() + "/" + ps.Key
+	if pn := cache.Get(ctx, key); pn != nil {
+		return plan2.BindParams(pn, ps.Params)
+	}
+	rec := plan2.NewResolveRecorder(ctx)
+	pn, err := plan2.BuildPlan(rec, cwft.stmt)
+	if err != nil {
+		// some literals are needed while building the plan, do not cache it
+		ps.Restore()
+		return plan2.BuildPlan(ctx, cwft.stmt)
+	}
+	cache.Put(key, pn, rec)
+	return plan2.BindParams(pn, ps.Params)
+}
+
 func (cwft *TxnComputationWrapper) Run(ts uint64) error {
 	return nil
 }
@@ -1283,6 +1580,15 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 	proc.Lim.Size = ses.Pu.SV.GetProcessLimitationSize()
 	proc.Lim.BatchRows = ses.Pu.SV.GetProcessLimitationBatchRows()
 	proc.Lim.PartitionRows = ses.Pu.SV.GetProcessLimitationPartitionRows()
+	proc.Lim.SpillDir = ses.Pu.SV.GetProcessLimitationSpillDir()
+
+	//wait for the memory budget of the server and of the session
+	admissionTimeout := time.Duration(ses.Pu.SV.GetQueryAdmissionTimeout()) * time.Millisecond
+	if err := ses.tracker.Admit(admissionTimeout); err != nil {
+		return errors.New(errno.InsufficientResources, fmt.Sprintf("query is rejected: %v", err))
+	}
+	proc.Tr = tracker.New(sql, proc.Lim.Size, ses.tracker)
+	defer proc.Tr.Detach()
 
 	cws, err := GetComputationWrapper(proto.GetDatabaseName(),
 		sql,
@@ -1298,6 +1604,17 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 		ses.Mrs = nil
 	}()
 
+	//a failed statement in the txn began explicitly is rolled back by
+	//itself, the txn goes on
+	stmtBegun := false
+	defer func() {
+		if retErr != nil && stmtBegun {
+			if err := txnHandler.RollbackStatement(); err != nil {
+				logutil.Errorf("rollback the statement failed. error:%v", err)
+			}
+		}
+	}()
+
 	for _, cw := range cws {
 		ses.Mrs = &MysqlResultSet{}
 		stmt := cw.GetAst()
@@ -1306,6 +1623,7 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 		statementCount++
 
 		//check transaction states
+		stmtBegun = false
 		switch stmt.(type) {
 		case *tree.BeginTransaction:
 			err = txnHandler.StartByBegin()
@@ -1322,7 +1640,11 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 			if err != nil {
 				return err
 			}
+		case *tree.Savepoint, *tree.RollbackToSavepoint, *tree.ReleaseSavepoint:
 		default:
+			if stmtBegun, err = txnHandler.BeginStatement(); err != nil {
+				return err
+			}
 		}
 
 		switch st := stmt.(type) {
@@ -1342,6 +1664,14 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 									return err
 								}
 
+								//next statement
+								continue
+							} else if strings.ToUpper(un.Parts[0]) == "LAST_INSERT_ID" && len(fe.Exprs) == 0 {
+								err = mce.handleSelectLastInsertID()
+								if err != nil {
+									return err
+								}
+
 								//next statement
 								continue
 							}
@@ -1382,9 +1712,10 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 			//if none database has been selected, database operations must be failed.
 			switch t := stmt.(type) {
 			case *tree.ShowDatabases, *tree.CreateDatabase, *tree.ShowCreateDatabase, *tree.ShowWarnings, *tree.ShowErrors,
-				*tree.ShowStatus, *tree.DropDatabase, *tree.Load,
-				*tree.Use, *tree.SetVar,
-				*tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction:
+				*tree.ShowStatus, *tree.ShowProcessList, *tree.DropDatabase, *tree.Load,
+				*tree.Use, *tree.SetVar, *tree.SetTransaction,
+				*tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction,
+				*tree.Savepoint, *tree.RollbackToSavepoint, *tree.ReleaseSavepoint:
 			case *tree.ShowColumns:
 				if t.Table.ToTableName().SchemaName == "" {
 					return NewMysqlError(ER_NO_DB_ERROR)
@@ -1428,12 +1759,38 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 			if err != nil {
 				return err
 			}
+		case *tree.SetTransaction:
+			selfHandle = true
+			err = mce.handleSetTransaction(st)
+			if err != nil {
+				return err
+			}
+		case *tree.Savepoint, *tree.RollbackToSavepoint, *tree.ReleaseSavepoint:
+			selfHandle = true
+			if err = mce.handleSavepoint(st); err != nil {
+				return err
+			}
 		case *tree.ShowVariables:
 			selfHandle = true
 			err = mce.handleShowVariables(st)
 			if err != nil {
 				return err
 			}
+		case *tree.ShowProcessList:
+			selfHandle = true
+			if err = mce.handleShowProcessList(st); err != nil {
+				return err
+			}
+		case *tree.ShowStatus:
+			selfHandle = true
+			if err = mce.handleShowStatus(st); err != nil {
+				return err
+			}
+		case *tree.Backup:
+			selfHandle = true
+			if err = mce.handleBackup(st); err != nil {
+				return err
+			}
 		case *tree.AnalyzeStmt:
 			selfHandle = true
 			if err = mce.handleAnalyzeStmt(st); err != nil {
@@ -1441,12 +1798,16 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 			}
 		case *tree.ExplainStmt:
 			selfHandle = true
-			if err = mce.handleExplainStmt(st); err != nil {
+			if err = mce.handleExplainStmt(st, proc); err != nil {
 				return err
 			}
 		case *tree.ExplainAnalyze:
 			selfHandle = true
-			return errors.New(errno.FeatureNotSupported, "not support explain analyze statement now")
+			explainStmt := tree.NewExplainStmt(st.Statement, "text")
+			explainStmt.Options = append([]tree.OptionElem{tree.MakeOptionElem("analyze", "NULL")}, st.Options...)
+			if err = mce.handleExplainStmt(explainStmt, proc); err != nil {
+				return err
+			}
 		}
 
 		if selfHandle {
@@ -1527,7 +1888,7 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 				}
 			}
 			if er := runner.Run(epoch); er != nil {
-				return er
+				return convertConstraintError(convertLockError(er))
 			}
 			if ses.ep.Outfile {
 				if err = ses.ep.Writer.Flush(); err != nil {
@@ -1552,7 +1913,7 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 			}
 		//just status, no result set
 		case *tree.CreateTable, *tree.DropTable, *tree.CreateDatabase, *tree.DropDatabase,
-			*tree.CreateIndex, *tree.DropIndex,
+			*tree.CreateIndex, *tree.DropIndex, *tree.AlterTable,
 			*tree.Insert, *tree.Update,
 			*tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction,
 			*tree.SetVar,
@@ -1568,7 +1929,7 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 				Step 1: Start
 			*/
 			if er := runner.Run(epoch); er != nil {
-				return er
+				return convertConstraintError(convertLockError(er))
 			}
 
 			if ses.Pu.SV.GetRecordTimeElapsedOfSqlRequest() {
@@ -1583,12 +1944,20 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 				pdHook.IncDDLCountAtEpoch(epoch, 1)
 			}
 
+			//the first auto increment value generated by the insert
+			var insertID uint64
+			if _, ok := stmt.(*tree.Insert); ok {
+				if insertID = txnHandler.GetLastInsertID(); insertID != 0 {
+					ses.lastInsertID = insertID
+				}
+			}
+
 			/*
 				Step 2: Echo client
 			*/
 			resp := NewOkResponse(
 				cw.GetAffectedRows(),
-				0,
+				insertID,
 				0,
 				0,
 				int(COM_QUERY),
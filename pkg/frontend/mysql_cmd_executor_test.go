@@ -3,6 +3,7 @@ package frontend
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/fagongzi/goetty/buf"
 	"github.com/golang/mock/gomock"
@@ -15,8 +16,11 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/compile"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan2"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnbase"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 	"github.com/prashantv/gostub"
@@ -612,6 +616,14 @@ func Test_mce_selfhandle(t *testing.T) {
 		err = mce.handleSelectDatabase(nil)
 		convey.So(err, convey.ShouldBeNil)
 
+		ses.Mrs = &MysqlResultSet{}
+		ses.lastInsertID = 42
+		err = mce.handleSelectLastInsertID()
+		convey.So(err, convey.ShouldBeNil)
+		id, err := ses.Mrs.GetUint64(0, 0)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(id, convey.ShouldEqual, 42)
+
 		ses.Mrs = &MysqlResultSet{}
 		err = mce.handleMaxAllowedPacket()
 		convey.So(err, convey.ShouldBeNil)
@@ -967,6 +979,39 @@ func Test_handleShowVariables(t *testing.T) {
 	})
 }
 
+func Test_handleShowStatus(t *testing.T) {
+	convey.Convey("handleShowStatus succ", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		eng := mock_frontend.NewMockEngine(ctrl)
+		eng.EXPECT().Database(gomock.Any(), nil).Return(nil, nil).AnyTimes()
+
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+		ioses.EXPECT().OutBuf().Return(buf.NewByteBuf(1024)).AnyTimes()
+		ioses.EXPECT().WriteAndFlush(gomock.Any()).Return(nil).AnyTimes()
+
+		pu, err := getParameterUnit("test/system_vars_config.toml", eng)
+		if err != nil {
+			t.Error(err)
+		}
+
+		proto := NewMysqlClientProtocol(0, ioses, 1024, pu.SV)
+		ses := &Session{Mrs: &MysqlResultSet{}, protocol: proto, planCache: plan2.NewPlanCache(1)}
+		ses.planCache.Get(nil, "a")
+		mce := &MysqlCmdExecutor{}
+		mce.PrepareSessionBeforeExecRequest(ses)
+
+		st, err := parsers.ParseOne(dialect.MYSQL, "show status like 'plan_cache_m%'")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(mce.handleShowStatus(st.(*tree.ShowStatus)), convey.ShouldBeNil)
+		convey.So(ses.Mrs.GetRowCount(), convey.ShouldEqual, 1)
+		row, err := ses.Mrs.GetRow(0)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(row, convey.ShouldResemble, []interface{}{"Plan_cache_misses", "1"})
+	})
+}
+
 func Test_GetColumns(t *testing.T) {
 	convey.Convey("GetColumns succ", t, func() {
 		cw := &ComputationWrapperImpl{exec: &compile.Exec{}}
@@ -987,3 +1032,46 @@ func Test_GetComputationWrapper(t *testing.T) {
 		convey.So(err, convey.ShouldBeNil)
 	})
 }
+
+func Test_getLockWaitTimeout(t *testing.T) {
+	convey.Convey("innodb_lock_wait_timeout", t, func() {
+		st, err := parsers.ParseOne(dialect.MYSQL, "set innodb_lock_wait_timeout = 5")
+		convey.So(err, convey.ShouldBeNil)
+		timeout, err := getLockWaitTimeout(st.(*tree.SetVar).Assignments[0].Value)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(timeout, convey.ShouldEqual, 5*time.Second)
+
+		for _, sql := range []string{"set innodb_lock_wait_timeout = 0", "set innodb_lock_wait_timeout = 'a'"} {
+			st, err = parsers.ParseOne(dialect.MYSQL, sql)
+			convey.So(err, convey.ShouldBeNil)
+			_, err = getLockWaitTimeout(st.(*tree.SetVar).Assignments[0].Value)
+			convey.So(err, convey.ShouldNotBeNil)
+		}
+
+		err = convertLockError(txnbase.ErrLockWaitTimeout)
+		convey.So(err.(*MysqlError).ErrorCode, convey.ShouldEqual, ER_LOCK_WAIT_TIMEOUT)
+		err = convertLockError(txnbase.ErrLockDeadlock)
+		convey.So(err.(*MysqlError).ErrorCode, convey.ShouldEqual, ER_LOCK_DEADLOCK)
+	})
+}
+
+func Test_convertConstraintError(t *testing.T) {
+	convey.Convey("convert constraint errors", t, func() {
+		kases := []struct {
+			err  error
+			code uint16
+		}{
+			{txnbase.ErrNotNullViolation, ER_BAD_NULL_ERROR},
+			{txnbase.ErrCheckViolation, ER_CHECK_CONSTRAINT_VIOLATED},
+			{txnbase.ErrDuplicated, ER_DUP_UNKNOWN_IN_INDEX},
+			{txnbase.ErrNoReferencedRow, ER_NO_REFERENCED_ROW_2},
+			{txnbase.ErrRowIsReferenced, ER_ROW_IS_REFERENCED_2},
+		}
+		for _, k := range kases {
+			err := convertConstraintError(txnbase.NewConstraintError(k.err, "c1"))
+			convey.So(err.(*MysqlError).ErrorCode, convey.ShouldEqual, k.code)
+		}
+		err := convertConstraintError(txnbase.ErrDuplicated)
+		convey.So(err, convey.ShouldEqual, txnbase.ErrDuplicated)
+	})
+}
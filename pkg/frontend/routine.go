@@ -19,6 +19,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/mempool"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/tracker"
 	"sync"
 	"time"
 )
@@ -37,6 +38,9 @@ type Routine struct {
 	guestMmu *guest.Mmu
 	mempool  *mempool.Mempool
 
+	//memory tracker of the connection
+	tracker *tracker.Tracker
+
 	//channel of request
 	requestChan chan *Request
 
@@ -99,6 +103,8 @@ func (routine *Routine) Loop() {
 
 		if ses == nil {
 			ses = NewSession(routine.protocol, mgr.getEpochgc(), routine.guestMmu, routine.mempool, mgr.getParameterUnit())
+			ses.tracker = routine.tracker
+			ses.planCache = mgr.getPlanCache()
 		}
 
 		routine.executor.PrepareSessionBeforeExecRequest(ses)
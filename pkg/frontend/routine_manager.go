@@ -16,11 +16,15 @@ package frontend
 
 import (
 	"errors"
+	"fmt"
+	"sort"
 	"sync"
 
 	"github.com/fagongzi/goetty"
 	"github.com/matrixorigin/matrixone/pkg/config"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan2"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/tracker"
 )
 
 type RoutineManager struct {
@@ -31,6 +35,12 @@ type RoutineManager struct {
 	pdHook *PDCallbackImpl
 
 	pu *config.ParameterUnit
+
+	//memory tracker of the server
+	tracker *tracker.Tracker
+
+	//plans shared by all the sessions, nil if plans are not cached
+	planCache *plan2.PlanCache
 }
 
 func (rm *RoutineManager) getEpochgc() *PDCallbackImpl {
@@ -41,6 +51,14 @@ func (rm *RoutineManager) getParameterUnit() *config.ParameterUnit {
 	return rm.pu
 }
 
+func (rm *RoutineManager) getTracker() *tracker.Tracker {
+	return rm.tracker
+}
+
+func (rm *RoutineManager) getPlanCache() *plan2.PlanCache {
+	return rm.planCache
+}
+
 func (rm *RoutineManager) Created(rs goetty.IOSession) {
 	pro := NewMysqlClientProtocol(nextConnectionID(), rs, int(rm.pu.SV.GetMaxBytesInOutbufToFlush()), rm.pu.SV)
 	exe := NewMysqlCmdExecutor()
@@ -48,6 +66,7 @@ func (rm *RoutineManager) Created(rs goetty.IOSession) {
 
 	routine := NewRoutine(pro, exe, rm.pu)
 	routine.SetRoutineMgr(rm)
+	routine.tracker = tracker.New(fmt.Sprintf("connection %d", pro.ConnectionID()), rm.pu.SV.GetGuestMmuLimitation(), rm.tracker)
 
 	hsV10pkt := pro.makeHandshakeV10Payload()
 	err := pro.writePackets(hsV10pkt)
@@ -75,6 +94,7 @@ func (rm *RoutineManager) Closed(rs goetty.IOSession) {
 	}
 	logutil.Infof("will close iosession")
 	rt.Quit()
+	rt.tracker.Detach()
 }
 
 /*
@@ -98,6 +118,50 @@ func (rm *RoutineManager) killStatement(id uint64) error {
 	return nil
 }
 
+/*
+SHOW PROCESSLIST
+the memory of a connection is the memory of its running query,
+the info is truncated to 100 characters if full is false
+*/
+func (rm *RoutineManager) processList(full bool) [][]interface{} {
+	rm.rwlock.RLock()
+	defer rm.rwlock.RUnlock()
+	rows := make([][]interface{}, 0, len(rm.clients))
+	for _, rt := range rm.clients {
+		var info interface{}
+		var peak int64
+		command := "Sleep"
+		if qrys := rt.tracker.Children(); len(qrys) > 0 {
+			command = "Query"
+			sql := qrys[0].Label()
+			if !full && len(sql) > 100 {
+				sql = sql[:100]
+			}
+			info = sql
+			peak = qrys[0].Peak()
+		}
+		host, port := rt.protocol.Peer()
+		var db interface{}
+		if name := rt.protocol.GetDatabaseName(); name != "" {
+			db = name
+		}
+		rows = append(rows, []interface{}{
+			uint64(rt.getConnID()),
+			rt.protocol.GetUserName(),
+			host + ":" + port,
+			db,
+			command,
+			info,
+			rt.tracker.Size(),
+			peak,
+		})
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		return rows[i][0].(uint64) < rows[j][0].(uint64)
+	})
+	return rows
+}
+
 func (rm *RoutineManager) Handler(rs goetty.IOSession, msg interface{}, received uint64) error {
 	if rm.pu.SV.GetRejectWhenHeartbeatFromPDLeaderIsTimeout() {
 		if !rm.pdHook.CanAcceptSomething() {
@@ -170,8 +234,12 @@ func NewRoutineManager(pu *config.ParameterUnit, pdHook *PDCallbackImpl) *Routin
 	rm := &RoutineManager{
 		clients: make(map[goetty.IOSession]*Routine),
 
-		pdHook: pdHook,
-		pu:     pu,
+		pdHook:  pdHook,
+		pu:      pu,
+		tracker: tracker.New("server", pu.SV.GetHostMmuLimitation(), nil),
+	}
+	if capacity := pu.SV.GetPlanCacheCapacity(); capacity > 0 {
+		rm.planCache = plan2.NewPlanCache(int(capacity))
 	}
 	return rm
 }
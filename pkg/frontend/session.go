@@ -17,15 +17,25 @@ package frontend
 import (
 	goErrors "errors"
 	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
 	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan2"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/moengine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnbase"
 	"github.com/matrixorigin/matrixone/pkg/vm/mempool"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/tracker"
 )
 
 var (
@@ -126,18 +136,201 @@ func (tti *TaeTxnDumpImpl) GetError() error {
 	return nil
 }
 
+// gIsolationLevel is the isolation level of the new sessions,
+// it is set by SET GLOBAL TRANSACTION ISOLATION LEVEL
+var gIsolationLevel = int32(tree.ISOLATION_LEVEL_REPEATABLE_READ)
+
 type TxnHandler struct {
 	storage  engine.Engine
 	taeTxn   moengine.Txn
 	txnState *TxnState
+	//the isolation level of the txns in the session
+	isolation tree.IsolationLevel
+	//the isolation level of the next txn only, set by SET TRANSACTION
+	nextIsolation *tree.IsolationLevel
+	//the time the txns wait for a row lock, 0 is the default of the engine
+	lockWaitTimeout time.Duration
 }
 
 func InitTxnHandler(storage engine.Engine) *TxnHandler {
 	return &TxnHandler{
-		taeTxn:   InitTaeTxnImpl(),
-		txnState: InitTxnState(),
-		storage:  storage,
+		taeTxn:    InitTaeTxnImpl(),
+		txnState:  InitTxnState(),
+		storage:   storage,
+		isolation: tree.IsolationLevel(atomic.LoadInt32(&gIsolationLevel)),
+	}
+}
+
+// SetIsolationLevel sets the isolation level of the txns started later
+func (th *TxnHandler) SetIsolationLevel(scope tree.SetTransactionScope, level tree.IsolationLevel) {
+	switch scope {
+	case tree.SET_TRANSACTION_SCOPE_NEXT:
+		th.nextIsolation = &level
+	case tree.SET_TRANSACTION_SCOPE_SESSION:
+		th.isolation = level
+	case tree.SET_TRANSACTION_SCOPE_GLOBAL:
+		atomic.StoreInt32(&gIsolationLevel, int32(level))
+	}
+}
+
+// GetIsolationLevel returns the isolation level of the next txn
+func (th *TxnHandler) GetIsolationLevel() tree.IsolationLevel {
+	if th.nextIsolation != nil {
+		return *th.nextIsolation
+	}
+	return th.isolation
+}
+
+// SetLockWaitTimeout sets the time the txns wait for a row lock, it takes
+// effect on the running txn too
+func (th *TxnHandler) SetLockWaitTimeout(timeout time.Duration) {
+	th.lockWaitTimeout = timeout
+	if th.IsInTaeTxn() {
+		setTxnLockWaitTimeout(th.taeTxn, timeout)
+	}
+}
+
+func setTxnLockWaitTimeout(txn moengine.Txn, timeout time.Duration) {
+	if t, ok := txn.(interface{ SetLockWaitTimeout(time.Duration) }); ok && timeout > 0 {
+		t.SetLockWaitTimeout(timeout)
+	}
+}
+
+// startTaeTxn starts a tae txn at the isolation level of the next txn.
+// The levels below SERIALIZABLE are all served by the snapshot isolation.
+func (th *TxnHandler) startTaeTxn(taeEng moengine.TxnEngine) (txn moengine.Txn, err error) {
+	level := th.GetIsolationLevel()
+	th.nextIsolation = nil
+	if level == tree.ISOLATION_LEVEL_SERIALIZABLE {
+		txn, err = taeEng.StartTxnWithIsolation(nil, moengine.Serializable)
+	} else {
+		txn, err = taeEng.StartTxn(nil)
+	}
+	if err == nil {
+		setTxnLockWaitTimeout(txn, th.lockWaitTimeout)
+	}
+	return
+}
+
+// convertLockError converts the row lock errors of tae to the mysql errors
+func convertLockError(err error) error {
+	switch {
+	case goErrors.Is(err, txnbase.ErrLockWaitTimeout):
+		return NewMysqlError(ER_LOCK_WAIT_TIMEOUT)
+	case goErrors.Is(err, txnbase.ErrLockDeadlock):
+		return NewMysqlError(ER_LOCK_DEADLOCK)
+	case goErrors.Is(err, txnbase.ErrLockNotAvailable):
+		return NewMysqlError(ER_LOCK_NOWAIT)
+	}
+	return err
+}
+
+// convertConstraintError converts the constraint violations of tae to the
+// mysql errors
+func convertConstraintError(err error) error {
+	var cerr *txnbase.ConstraintError
+	if !goErrors.As(err, &cerr) {
+		return err
+	}
+	switch cerr.Err {
+	case txnbase.ErrNotNullViolation:
+		return NewMysqlError(ER_BAD_NULL_ERROR, cerr.Name)
+	case txnbase.ErrCheckViolation:
+		return NewMysqlError(ER_CHECK_CONSTRAINT_VIOLATED, cerr.Name)
+	case txnbase.ErrDuplicated:
+		return NewMysqlError(ER_DUP_UNKNOWN_IN_INDEX, cerr.Name)
+	case txnbase.ErrNoReferencedRow:
+		return NewMysqlError(ER_NO_REFERENCED_ROW_2, cerr.Name)
+	case txnbase.ErrRowIsReferenced, txnbase.ErrTableIsReferenced:
+		return NewMysqlError(ER_ROW_IS_REFERENCED_2, cerr.Name)
+	}
+	return err
+}
+
+// txnSavepointer is implemented by the tae txns which roll back a part of
+// their writes
+type txnSavepointer interface {
+	Savepoint(name string) error
+	RollbackToSavepoint(name string) error
+	ReleaseSavepoint(name string) error
+	BeginStatement() error
+	RollbackStatement() error
+}
+
+// getSavepointer returns the savepointer of the txn began explicitly, nil
+// if there is none
+func (th *TxnHandler) getSavepointer() txnSavepointer {
+	if !th.isTxnState(TxnBegan) {
+		return nil
+	}
+	sp, _ := th.taeTxn.(txnSavepointer)
+	return sp
+}
+
+// BeginStatement marks the start of a statement in the txn began
+// explicitly. It returns false if the statement can not be rolled back by
+// itself.
+func (th *TxnHandler) BeginStatement() (bool, error) {
+	sp := th.getSavepointer()
+	if sp == nil {
+		return false, nil
+	}
+	return true, sp.BeginStatement()
+}
+
+// RollbackStatement undoes the writes of the failed statement, the txn
+// goes on
+func (th *TxnHandler) RollbackStatement() error {
+	sp := th.getSavepointer()
+	if sp == nil {
+		return nil
 	}
+	return sp.RollbackStatement()
+}
+
+// Savepoint sets the savepoint name in the txn began explicitly. As in
+// mysql, it does nothing out of such a txn.
+func (th *TxnHandler) Savepoint(name string) error {
+	if !th.isTxnState(TxnBegan) {
+		return nil
+	}
+	sp := th.getSavepointer()
+	if sp == nil {
+		return NewMysqlError(ER_NOT_SUPPORTED_YET, "SAVEPOINT")
+	}
+	return sp.Savepoint(strings.ToLower(name))
+}
+
+func (th *TxnHandler) RollbackToSavepoint(name string) error {
+	sp := th.getSavepointer()
+	if sp == nil {
+		return NewMysqlError(ER_SP_DOES_NOT_EXIST, "SAVEPOINT", name)
+	}
+	return convertSavepointError(sp.RollbackToSavepoint(strings.ToLower(name)), name)
+}
+
+func (th *TxnHandler) ReleaseSavepoint(name string) error {
+	sp := th.getSavepointer()
+	if sp == nil {
+		return NewMysqlError(ER_SP_DOES_NOT_EXIST, "SAVEPOINT", name)
+	}
+	return convertSavepointError(sp.ReleaseSavepoint(strings.ToLower(name)), name)
+}
+
+// GetLastInsertID returns the first auto increment value generated by the
+// current statement of the tae txn, 0 if none
+func (th *TxnHandler) GetLastInsertID() uint64 {
+	if txn, ok := th.taeTxn.(interface{ GetLastInsertID() uint64 }); ok {
+		return txn.GetLastInsertID()
+	}
+	return 0
+}
+
+func convertSavepointError(err error, name string) error {
+	if goErrors.Is(err, txnbase.ErrSavepointNotFound) {
+		return NewMysqlError(ER_SP_DOES_NOT_EXIST, "SAVEPOINT", name)
+	}
+	return err
 }
 
 type Session struct {
@@ -156,6 +349,12 @@ type Session struct {
 	GuestMmu *guest.Mmu
 	Mempool  *mempool.Mempool
 
+	//memory tracker of the connection, the parent of the query trackers
+	tracker *tracker.Tracker
+
+	//plan cache of the server
+	planCache *plan2.PlanCache
+
 	Pu *config.ParameterUnit
 
 	ep *tree.ExportParam
@@ -165,6 +364,10 @@ type Session struct {
 	txnCompileCtx *TxnCompilerContext
 	storage       engine.Engine
 	sql           string
+
+	//the first auto increment value generated by the latest insert which
+	//generated any, returned by LAST_INSERT_ID()
+	lastInsertID uint64
 }
 
 func NewSession(proto Protocol, pdHook *PDCallbackImpl, gm *guest.Mmu, mp *mempool.Mempool, PU *config.ParameterUnit) *Session {
@@ -278,7 +481,7 @@ func (th *TxnHandler) StartByBegin() error {
 		switch th.txnState.getState() {
 		case TxnInit, TxnEnd, TxnErr:
 			//begin a transaction
-			th.taeTxn, err = taeEng.StartTxn(nil)
+			th.taeTxn, err = th.startTaeTxn(taeEng)
 		case TxnBegan:
 			err = errorTaeTxnBeginInBegan
 		case TxnAutocommit:
@@ -303,7 +506,7 @@ func (th *TxnHandler) StartByAutocommit() error {
 		switch th.txnState.getState() {
 		case TxnInit, TxnEnd, TxnErr:
 			//begin a transaction
-			th.taeTxn, err = taeEng.StartTxn(nil)
+			th.taeTxn, err = th.startTaeTxn(taeEng)
 		case TxnAutocommit:
 			err = errorTaeTxnAutocommitInAutocommit
 		case TxnBegan:
@@ -333,7 +536,7 @@ func (th *TxnHandler) StartByAutocommitIfNeeded() (bool, error) {
 		switch th.txnState.getState() {
 		case TxnInit, TxnEnd, TxnErr:
 			//begin a transaction
-			th.taeTxn, err = taeEng.StartTxn(nil)
+			th.taeTxn, err = th.startTaeTxn(taeEng)
 		case TxnAutocommit:
 			err = errorTaeTxnAutocommitInAutocommit
 		case TxnBegan:
@@ -589,6 +792,13 @@ func (tcc *TxnCompilerContext) Resolve(dbName string, tableName string) (*plan2.
 		Name: tableName,
 		Cols: defs,
 	}
+	for _, def := range engineDefs {
+		if partitionDef, ok := def.(*engine.PartitionByDef); ok {
+			if partition := convertPartitionDef(partitionDef, defs); partition != nil {
+				tableDef.Defs = append(tableDef.Defs, partition)
+			}
+		}
+	}
 
 	if newTxn {
 		err2 := tcc.txnHandler.CommitAfterAutocommitOnly()
@@ -600,6 +810,108 @@ func (tcc *TxnCompilerContext) Resolve(dbName string, tableName string) (*plan2.
 	return obj, tableDef
 }
 
+// convertPartitionDef converts the partitioning of a table to the plan's
+// for the partition pruning, nil if the partition column or one of the
+// partition values is not known.
+func convertPartitionDef(def *engine.PartitionByDef, cols []*plan2.ColDef) *plan.TableDef_DefType {
+	if len(def.Fields) != 1 {
+		return nil
+	}
+	var typ *plan2.Type
+	for _, col := range cols {
+		if col.Name == def.Fields[0] {
+			typ = col.Typ
+		}
+	}
+	if typ == nil {
+		return nil
+	}
+	partition := &plan.PartitionByDef{
+		Fields: def.Fields,
+	}
+	switch {
+	case def.Hash > 0:
+		partition.Type = plan.PartitionByDef_HASH
+		partition.Num = int32(def.Hash)
+	case len(def.Range) > 0:
+		partition.Type = plan.PartitionByDef_RANGE
+		for _, rng := range def.Range {
+			part := &plan.PartitionByDef_Partition{Name: rng.Name}
+			for _, e := range rng.To {
+				value := convertPartitionValue(e, typ)
+				if value == nil {
+					return nil
+				}
+				part.Values = append(part.Values, value)
+			}
+			partition.Partitions = append(partition.Partitions, part)
+		}
+	default:
+		partition.Type = plan.PartitionByDef_LIST
+		for _, list := range def.List {
+			part := &plan.PartitionByDef_Partition{Name: list.Name}
+			for _, e := range list.Extends {
+				value := convertPartitionValue(e, typ)
+				if value == nil {
+					return nil
+				}
+				part.Values = append(part.Values, value)
+			}
+			partition.Partitions = append(partition.Partitions, part)
+		}
+	}
+	return &plan.TableDef_DefType{
+		Def: &plan.TableDef_DefType_Partition{
+			Partition: partition,
+		},
+	}
+}
+
+// convertPartitionValue returns the constant of a partition value, the
+// dates and the datetimes are strings. It returns nil if the value is not
+// known.
+func convertPartitionValue(e extend.Extend, typ *plan2.Type) *plan2.Expr {
+	val, ok := e.(*extend.ValueExtend)
+	if !ok || val.V == nil || vector.Length(val.V) != 1 {
+		return nil
+	}
+	c := &plan2.Const{}
+	if nulls.Contains(val.V.Nsp, 0) {
+		c.Isnull = true
+	} else {
+		switch col := val.V.Col.(type) {
+		case []int8:
+			c.Value = &plan.Const_Ival{Ival: int64(col[0])}
+		case []int16:
+			c.Value = &plan.Const_Ival{Ival: int64(col[0])}
+		case []int32:
+			c.Value = &plan.Const_Ival{Ival: int64(col[0])}
+		case []int64:
+			c.Value = &plan.Const_Ival{Ival: col[0]}
+		case []uint8:
+			c.Value = &plan.Const_Ival{Ival: int64(col[0])}
+		case []uint16:
+			c.Value = &plan.Const_Ival{Ival: int64(col[0])}
+		case []uint32:
+			c.Value = &plan.Const_Ival{Ival: int64(col[0])}
+		case []uint64:
+			c.Value = &plan.Const_Ival{Ival: int64(col[0])}
+		case []types.Date:
+			c.Value = &plan.Const_Sval{Sval: col[0].String()}
+		case []types.Datetime:
+			c.Value = &plan.Const_Sval{Sval: col[0].String()}
+		case *types.Bytes:
+			c.Value = &plan.Const_Sval{Sval: string(col.Get(0))}
+		default:
+			return nil
+		}
+	}
+	return &plan2.Expr{
+		Expr: &plan.Expr_C{C: c},
+		Typ:  typ,
+	}
+}
+
 func (tcc *TxnCompilerContext) Cost(obj *plan2.ObjectRef, e *plan2.Expr) *plan2.Cost {
 	return &plan2.Cost{}
 }
@@ -3,6 +3,9 @@ package frontend
 import (
 	"github.com/golang/mock/gomock"
 	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/moengine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnbase"
 	"github.com/smartystreets/goconvey/convey"
 	"testing"
 )
@@ -70,4 +73,103 @@ func TestTxnHandler(t *testing.T) {
 		err = txn.StartByAutocommit()
 		convey.So(err, convey.ShouldNotBeNil)
 	})
+
+	convey.Convey("tae isolation level", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		tae := mock_frontend.NewMockTxnEngine(ctrl)
+		txn := InitTxnHandler(tae)
+		txnImpl := mock_frontend.NewMockTxn(ctrl)
+		txnImpl.EXPECT().Commit().Return(nil).AnyTimes()
+		convey.So(txn.GetIsolationLevel(), convey.ShouldEqual, tree.ISOLATION_LEVEL_REPEATABLE_READ)
+
+		//SET TRANSACTION only affects the next txn
+		txn.SetIsolationLevel(tree.SET_TRANSACTION_SCOPE_NEXT, tree.ISOLATION_LEVEL_SERIALIZABLE)
+		tae.EXPECT().StartTxnWithIsolation(gomock.Any(), moengine.Serializable).Return(txnImpl, nil)
+		convey.So(txn.StartByBegin(), convey.ShouldBeNil)
+		convey.So(txn.CommitAfterBegin(), convey.ShouldBeNil)
+		convey.So(txn.GetIsolationLevel(), convey.ShouldEqual, tree.ISOLATION_LEVEL_REPEATABLE_READ)
+
+		tae.EXPECT().StartTxn(gomock.Any()).Return(txnImpl, nil)
+		convey.So(txn.StartByBegin(), convey.ShouldBeNil)
+		convey.So(txn.CommitAfterBegin(), convey.ShouldBeNil)
+
+		//SET SESSION TRANSACTION affects all the later txns
+		txn.SetIsolationLevel(tree.SET_TRANSACTION_SCOPE_SESSION, tree.ISOLATION_LEVEL_SERIALIZABLE)
+		tae.EXPECT().StartTxnWithIsolation(gomock.Any(), moengine.Serializable).Return(txnImpl, nil).Times(2)
+		for i := 0; i < 2; i++ {
+			convey.So(txn.StartByBegin(), convey.ShouldBeNil)
+			convey.So(txn.CommitAfterBegin(), convey.ShouldBeNil)
+		}
+	})
+	convey.Convey("tae savepoint", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		tae := mock_frontend.NewMockTxnEngine(ctrl)
+		txn := InitTxnHandler(tae)
+		txnImpl := &savepointTxn{MockTxn: mock_frontend.NewMockTxn(ctrl)}
+		txnImpl.EXPECT().Commit().Return(nil).AnyTimes()
+
+		//out of the txn began explicitly
+		convey.So(txn.Savepoint("sp1"), convey.ShouldBeNil)
+		err := txn.RollbackToSavepoint("sp1")
+		convey.So(err.(*MysqlError).ErrorCode, convey.ShouldEqual, ER_SP_DOES_NOT_EXIST)
+		begun, err := txn.BeginStatement()
+		convey.So(begun, convey.ShouldBeFalse)
+		convey.So(err, convey.ShouldBeNil)
+
+		tae.EXPECT().StartTxn(gomock.Any()).Return(txnImpl, nil)
+		convey.So(txn.StartByBegin(), convey.ShouldBeNil)
+		begun, err = txn.BeginStatement()
+		convey.So(begun, convey.ShouldBeTrue)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(txn.RollbackStatement(), convey.ShouldBeNil)
+		convey.So(txnImpl.stmtRollbacks, convey.ShouldEqual, 1)
+
+		convey.So(txn.Savepoint("SP1"), convey.ShouldBeNil)
+		convey.So(txn.RollbackToSavepoint("sp1"), convey.ShouldBeNil)
+		convey.So(txn.ReleaseSavepoint("sp1"), convey.ShouldBeNil)
+		err = txn.ReleaseSavepoint("sp1")
+		convey.So(err.(*MysqlError).ErrorCode, convey.ShouldEqual, ER_SP_DOES_NOT_EXIST)
+		convey.So(txn.CommitAfterBegin(), convey.ShouldBeNil)
+	})
+}
+
+// savepointTxn is a mock txn keeping the names of its savepoints
+type savepointTxn struct {
+	*mock_frontend.MockTxn
+	savepoints    map[string]bool
+	stmtRollbacks int
+}
+
+func (txn *savepointTxn) Savepoint(name string) error {
+	if txn.savepoints == nil {
+		txn.savepoints = make(map[string]bool)
+	}
+	txn.savepoints[name] = true
+	return nil
+}
+
+func (txn *savepointTxn) RollbackToSavepoint(name string) error {
+	if !txn.savepoints[name] {
+		return txnbase.ErrSavepointNotFound
+	}
+	return nil
+}
+
+func (txn *savepointTxn) ReleaseSavepoint(name string) error {
+	if !txn.savepoints[name] {
+		return txnbase.ErrSavepointNotFound
+	}
+	delete(txn.savepoints, name)
+	return nil
+}
+
+func (txn *savepointTxn) BeginStatement() error { return nil }
+
+func (txn *savepointTxn) RollbackStatement() error {
+	txn.stmtRollbacks++
+	return nil
 }
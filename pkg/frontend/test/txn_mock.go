@@ -241,3 +241,18 @@ func (mr *MockTxnEngineMockRecorder) StartTxn(info interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTxn", reflect.TypeOf((*MockTxnEngine)(nil).StartTxn), info)
 }
+
+// StartTxnWithIsolation mocks base method.
+func (m *MockTxnEngine) StartTxnWithIsolation(info []byte, level moengine.IsolationLevel) (moengine.Txn, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTxnWithIsolation", info, level)
+	ret0, _ := ret[0].(moengine.Txn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartTxnWithIsolation indicates an expected call of StartTxnWithIsolation.
+func (mr *MockTxnEngineMockRecorder) StartTxnWithIsolation(info, level interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTxnWithIsolation", reflect.TypeOf((*MockTxnEngine)(nil).StartTxnWithIsolation), info, level)
+}
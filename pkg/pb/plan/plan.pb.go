@@ -23,8 +23,13 @@ const (
 type CompressType int32
 
 const (
-	CompressType_None CompressType = 0
-	CompressType_Lz4  CompressType = 1
+	CompressType_None  CompressType = 0
+	CompressType_Lz4   CompressType = 1
+	CompressType_Zstd  CompressType = 2
+	CompressType_Dict  CompressType = 3
+	CompressType_Rle   CompressType = 4
+	CompressType_Delta CompressType = 5
+	CompressType_Auto  CompressType = 6
 )
 
 // Enum value maps for CompressType.
@@ -32,10 +37,20 @@ var (
 	CompressType_name = map[int32]string{
 		0: "None",
 		1: "Lz4",
+		2: "Zstd",
+		3: "Dict",
+		4: "Rle",
+		5: "Delta",
+		6: "Auto",
 	}
 	CompressType_value = map[string]int32{
-		"None": 0,
-		"Lz4":  1,
+		"None":  0,
+		"Lz4":   1,
+		"Zstd":  2,
+		"Dict":  3,
+		"Rle":   4,
+		"Delta": 5,
+		"Auto":  6,
 	}
 )
 
@@ -424,6 +439,104 @@ func (IndexDef_IndexType) EnumDescriptor() ([]byte, []int) {
 	return file_plan_proto_rawDescGZIP(), []int{13, 0}
 }
 
+type ForeignKeyDef_RefAction int32
+
+const (
+	ForeignKeyDef_RESTRICT ForeignKeyDef_RefAction = 0
+	ForeignKeyDef_CASCADE  ForeignKeyDef_RefAction = 1
+	ForeignKeyDef_SET_NULL ForeignKeyDef_RefAction = 2
+)
+
+// Enum value maps for ForeignKeyDef_RefAction.
+var (
+	ForeignKeyDef_RefAction_name = map[int32]string{
+		0: "RESTRICT",
+		1: "CASCADE",
+		2: "SET_NULL",
+	}
+	ForeignKeyDef_RefAction_value = map[string]int32{
+		"RESTRICT": 0,
+		"CASCADE":  1,
+		"SET_NULL": 2,
+	}
+)
+
+func (x ForeignKeyDef_RefAction) Enum() *ForeignKeyDef_RefAction {
+	p := new(ForeignKeyDef_RefAction)
+	*p = x
+	return p
+}
+
+func (x ForeignKeyDef_RefAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ForeignKeyDef_RefAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_plan_proto_enumTypes[5].Descriptor()
+}
+
+func (ForeignKeyDef_RefAction) Type() protoreflect.EnumType {
+	return &file_plan_proto_enumTypes[5]
+}
+
+func (x ForeignKeyDef_RefAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ForeignKeyDef_RefAction.Descriptor instead.
+func (ForeignKeyDef_RefAction) EnumDescriptor() ([]byte, []int) {
+	return file_plan_proto_rawDescGZIP(), []int{19, 0}
+}
+
+type PartitionByDef_PartitionType int32
+
+const (
+	PartitionByDef_RANGE PartitionByDef_PartitionType = 0
+	PartitionByDef_LIST  PartitionByDef_PartitionType = 1
+	PartitionByDef_HASH  PartitionByDef_PartitionType = 2
+)
+
+// Enum value maps for PartitionByDef_PartitionType.
+var (
+	PartitionByDef_PartitionType_name = map[int32]string{
+		0: "RANGE",
+		1: "LIST",
+		2: "HASH",
+	}
+	PartitionByDef_PartitionType_value = map[string]int32{
+		"RANGE": 0,
+		"LIST":  1,
+		"HASH":  2,
+	}
+)
+
+func (x PartitionByDef_PartitionType) Enum() *PartitionByDef_PartitionType {
+	p := new(PartitionByDef_PartitionType)
+	*p = x
+	return p
+}
+
+func (x PartitionByDef_PartitionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PartitionByDef_PartitionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_plan_proto_enumTypes[6].Descriptor()
+}
+
+func (PartitionByDef_PartitionType) Type() protoreflect.EnumType {
+	return &file_plan_proto_enumTypes[6]
+}
+
+func (x PartitionByDef_PartitionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PartitionByDef_PartitionType.Descriptor instead.
+func (PartitionByDef_PartitionType) EnumDescriptor() ([]byte, []int) {
+	return file_plan_proto_rawDescGZIP(), []int{20, 0}
+}
+
 type OrderBySpec_OrderByFlag int32
 
 const (
@@ -466,11 +579,11 @@ func (x OrderBySpec_OrderByFlag) String() string {
 }
 
 func (OrderBySpec_OrderByFlag) Descriptor() protoreflect.EnumDescriptor {
-	return file_plan_proto_enumTypes[5].Descriptor()
+	return file_plan_proto_enumTypes[7].Descriptor()
 }
 
 func (OrderBySpec_OrderByFlag) Type() protoreflect.EnumType {
-	return &file_plan_proto_enumTypes[5]
+	return &file_plan_proto_enumTypes[7]
 }
 
 func (x OrderBySpec_OrderByFlag) Number() protoreflect.EnumNumber {
@@ -479,7 +592,7 @@ func (x OrderBySpec_OrderByFlag) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use OrderBySpec_OrderByFlag.Descriptor instead.
 func (OrderBySpec_OrderByFlag) EnumDescriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{21, 0}
+	return file_plan_proto_rawDescGZIP(), []int{25, 0}
 }
 
 type Node_NodeType int32
@@ -595,11 +708,11 @@ func (x Node_NodeType) String() string {
 }
 
 func (Node_NodeType) Descriptor() protoreflect.EnumDescriptor {
-	return file_plan_proto_enumTypes[6].Descriptor()
+	return file_plan_proto_enumTypes[8].Descriptor()
 }
 
 func (Node_NodeType) Type() protoreflect.EnumType {
-	return &file_plan_proto_enumTypes[6]
+	return &file_plan_proto_enumTypes[8]
 }
 
 func (x Node_NodeType) Number() protoreflect.EnumNumber {
@@ -608,7 +721,7 @@ func (x Node_NodeType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Node_NodeType.Descriptor instead.
 func (Node_NodeType) EnumDescriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{24, 0}
+	return file_plan_proto_rawDescGZIP(), []int{28, 0}
 }
 
 type Node_JoinFlag int32
@@ -656,11 +769,11 @@ func (x Node_JoinFlag) String() string {
 }
 
 func (Node_JoinFlag) Descriptor() protoreflect.EnumDescriptor {
-	return file_plan_proto_enumTypes[7].Descriptor()
+	return file_plan_proto_enumTypes[9].Descriptor()
 }
 
 func (Node_JoinFlag) Type() protoreflect.EnumType {
-	return &file_plan_proto_enumTypes[7]
+	return &file_plan_proto_enumTypes[9]
 }
 
 func (x Node_JoinFlag) Number() protoreflect.EnumNumber {
@@ -669,7 +782,7 @@ func (x Node_JoinFlag) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Node_JoinFlag.Descriptor instead.
 func (Node_JoinFlag) EnumDescriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{24, 1}
+	return file_plan_proto_rawDescGZIP(), []int{28, 1}
 }
 
 type Node_AggMode int32
@@ -705,11 +818,11 @@ func (x Node_AggMode) String() string {
 }
 
 func (Node_AggMode) Descriptor() protoreflect.EnumDescriptor {
-	return file_plan_proto_enumTypes[8].Descriptor()
+	return file_plan_proto_enumTypes[10].Descriptor()
 }
 
 func (Node_AggMode) Type() protoreflect.EnumType {
-	return &file_plan_proto_enumTypes[8]
+	return &file_plan_proto_enumTypes[10]
 }
 
 func (x Node_AggMode) Number() protoreflect.EnumNumber {
@@ -718,7 +831,7 @@ func (x Node_AggMode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Node_AggMode.Descriptor instead.
 func (Node_AggMode) EnumDescriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{24, 2}
+	return file_plan_proto_rawDescGZIP(), []int{28, 2}
 }
 
 type Query_StatementType int32
@@ -763,11 +876,11 @@ func (x Query_StatementType) String() string {
 }
 
 func (Query_StatementType) Descriptor() protoreflect.EnumDescriptor {
-	return file_plan_proto_enumTypes[9].Descriptor()
+	return file_plan_proto_enumTypes[11].Descriptor()
 }
 
 func (Query_StatementType) Type() protoreflect.EnumType {
-	return &file_plan_proto_enumTypes[9]
+	return &file_plan_proto_enumTypes[11]
 }
 
 func (x Query_StatementType) Number() protoreflect.EnumNumber {
@@ -776,7 +889,7 @@ func (x Query_StatementType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Query_StatementType.Descriptor instead.
 func (Query_StatementType) EnumDescriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{25, 0}
+	return file_plan_proto_rawDescGZIP(), []int{29, 0}
 }
 
 type TransationControl_TclType int32
@@ -812,11 +925,11 @@ func (x TransationControl_TclType) String() string {
 }
 
 func (TransationControl_TclType) Descriptor() protoreflect.EnumDescriptor {
-	return file_plan_proto_enumTypes[10].Descriptor()
+	return file_plan_proto_enumTypes[12].Descriptor()
 }
 
 func (TransationControl_TclType) Type() protoreflect.EnumType {
-	return &file_plan_proto_enumTypes[10]
+	return &file_plan_proto_enumTypes[12]
 }
 
 func (x TransationControl_TclType) Number() protoreflect.EnumNumber {
@@ -825,7 +938,7 @@ func (x TransationControl_TclType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use TransationControl_TclType.Descriptor instead.
 func (TransationControl_TclType) EnumDescriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{26, 0}
+	return file_plan_proto_rawDescGZIP(), []int{30, 0}
 }
 
 type TransationBegin_TransationMode int32
@@ -861,11 +974,11 @@ func (x TransationBegin_TransationMode) String() string {
 }
 
 func (TransationBegin_TransationMode) Descriptor() protoreflect.EnumDescriptor {
-	return file_plan_proto_enumTypes[11].Descriptor()
+	return file_plan_proto_enumTypes[13].Descriptor()
 }
 
 func (TransationBegin_TransationMode) Type() protoreflect.EnumType {
-	return &file_plan_proto_enumTypes[11]
+	return &file_plan_proto_enumTypes[13]
 }
 
 func (x TransationBegin_TransationMode) Number() protoreflect.EnumNumber {
@@ -874,7 +987,7 @@ func (x TransationBegin_TransationMode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use TransationBegin_TransationMode.Descriptor instead.
 func (TransationBegin_TransationMode) EnumDescriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{27, 0}
+	return file_plan_proto_rawDescGZIP(), []int{31, 0}
 }
 
 type DataDefinition_DdlType int32
@@ -964,11 +1077,11 @@ func (x DataDefinition_DdlType) String() string {
 }
 
 func (DataDefinition_DdlType) Descriptor() protoreflect.EnumDescriptor {
-	return file_plan_proto_enumTypes[12].Descriptor()
+	return file_plan_proto_enumTypes[14].Descriptor()
 }
 
 func (DataDefinition_DdlType) Type() protoreflect.EnumType {
-	return &file_plan_proto_enumTypes[12]
+	return &file_plan_proto_enumTypes[14]
 }
 
 func (x DataDefinition_DdlType) Number() protoreflect.EnumNumber {
@@ -977,7 +1090,71 @@ func (x DataDefinition_DdlType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DataDefinition_DdlType.Descriptor instead.
 func (DataDefinition_DdlType) EnumDescriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{31, 0}
+	return file_plan_proto_rawDescGZIP(), []int{35, 0}
+}
+
+type AlterTableAction_ActionType int32
+
+const (
+	AlterTableAction_ADD_COLUMN         AlterTableAction_ActionType = 0
+	AlterTableAction_DROP_COLUMN        AlterTableAction_ActionType = 1
+	AlterTableAction_RENAME_COLUMN      AlterTableAction_ActionType = 2
+	AlterTableAction_MODIFY_COLUMN      AlterTableAction_ActionType = 3
+	AlterTableAction_COMPACT            AlterTableAction_ActionType = 4
+	AlterTableAction_ADD_PARTITION      AlterTableAction_ActionType = 5
+	AlterTableAction_DROP_PARTITION     AlterTableAction_ActionType = 6
+	AlterTableAction_TRUNCATE_PARTITION AlterTableAction_ActionType = 7
+)
+
+// Enum value maps for AlterTableAction_ActionType.
+var (
+	AlterTableAction_ActionType_name = map[int32]string{
+		0: "ADD_COLUMN",
+		1: "DROP_COLUMN",
+		2: "RENAME_COLUMN",
+		3: "MODIFY_COLUMN",
+		4: "COMPACT",
+		5: "ADD_PARTITION",
+		6: "DROP_PARTITION",
+		7: "TRUNCATE_PARTITION",
+	}
+	AlterTableAction_ActionType_value = map[string]int32{
+		"ADD_COLUMN":         0,
+		"DROP_COLUMN":        1,
+		"RENAME_COLUMN":      2,
+		"MODIFY_COLUMN":      3,
+		"COMPACT":            4,
+		"ADD_PARTITION":      5,
+		"DROP_PARTITION":     6,
+		"TRUNCATE_PARTITION": 7,
+	}
+)
+
+func (x AlterTableAction_ActionType) Enum() *AlterTableAction_ActionType {
+	p := new(AlterTableAction_ActionType)
+	*p = x
+	return p
+}
+
+func (x AlterTableAction_ActionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AlterTableAction_ActionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_plan_proto_enumTypes[15].Descriptor()
+}
+
+func (AlterTableAction_ActionType) Type() protoreflect.EnumType {
+	return &file_plan_proto_enumTypes[15]
+}
+
+func (x AlterTableAction_ActionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AlterTableAction_ActionType.Descriptor instead.
+func (AlterTableAction_ActionType) EnumDescriptor() ([]byte, []int) {
+	return file_plan_proto_rawDescGZIP(), []int{41, 0}
 }
 
 type Type struct {
@@ -1906,13 +2083,15 @@ type ColDef struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name    string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Alias   string       `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
-	Alg     CompressType `protobuf:"varint,3,opt,name=alg,proto3,enum=CompressType" json:"alg,omitempty"`
-	Typ     *Type        `protobuf:"bytes,4,opt,name=typ,proto3" json:"typ,omitempty"`
-	Default *DefaultExpr `protobuf:"bytes,5,opt,name=default,proto3" json:"default,omitempty"`
-	Primary bool         `protobuf:"varint,6,opt,name=primary,proto3" json:"primary,omitempty"`
-	Pkidx   int32        `protobuf:"varint,7,opt,name=pkidx,proto3" json:"pkidx,omitempty"`
+	Name          string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Alias         string       `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
+	Alg           CompressType `protobuf:"varint,3,opt,name=alg,proto3,enum=CompressType" json:"alg,omitempty"`
+	Typ           *Type        `protobuf:"bytes,4,opt,name=typ,proto3" json:"typ,omitempty"`
+	Default       *DefaultExpr `protobuf:"bytes,5,opt,name=default,proto3" json:"default,omitempty"`
+	Primary       bool         `protobuf:"varint,6,opt,name=primary,proto3" json:"primary,omitempty"`
+	Pkidx         int32        `protobuf:"varint,7,opt,name=pkidx,proto3" json:"pkidx,omitempty"`
+	AutoIncrement bool         `protobuf:"varint,8,opt,name=auto_increment,json=autoIncrement,proto3" json:"auto_increment,omitempty"`
+	NotNull       bool         `protobuf:"varint,9,opt,name=not_null,json=notNull,proto3" json:"not_null,omitempty"`
 }
 
 func (x *ColDef) Reset() {
@@ -1996,6 +2175,20 @@ func (x *ColDef) GetPkidx() int32 {
 	return 0
 }
 
+func (x *ColDef) GetAutoIncrement() bool {
+	if x != nil {
+		return x.AutoIncrement
+	}
+	return false
+}
+
+func (x *ColDef) GetNotNull() bool {
+	if x != nil {
+		return x.NotNull
+	}
+	return false
+}
+
 type IndexDef struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2208,19 +2401,17 @@ func (x *PropertiesDef) GetProperties() []*Property {
 	return nil
 }
 
-type TableDef struct {
+type UniqueIndexDef struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name  string              `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Alias string              `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
-	Cols  []*ColDef           `protobuf:"bytes,3,rep,name=cols,proto3" json:"cols,omitempty"`
-	Defs  []*TableDef_DefType `protobuf:"bytes,4,rep,name=defs,proto3" json:"defs,omitempty"`
+	Name     string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ColNames []string `protobuf:"bytes,2,rep,name=col_names,json=colNames,proto3" json:"col_names,omitempty"`
 }
 
-func (x *TableDef) Reset() {
-	*x = TableDef{}
+func (x *UniqueIndexDef) Reset() {
+	*x = UniqueIndexDef{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_plan_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2228,13 +2419,13 @@ func (x *TableDef) Reset() {
 	}
 }
 
-func (x *TableDef) String() string {
+func (x *UniqueIndexDef) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TableDef) ProtoMessage() {}
+func (*UniqueIndexDef) ProtoMessage() {}
 
-func (x *TableDef) ProtoReflect() protoreflect.Message {
+func (x *UniqueIndexDef) ProtoReflect() protoreflect.Message {
 	mi := &file_plan_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2246,53 +2437,36 @@ func (x *TableDef) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TableDef.ProtoReflect.Descriptor instead.
-func (*TableDef) Descriptor() ([]byte, []int) {
+// Deprecated: Use UniqueIndexDef.ProtoReflect.Descriptor instead.
+func (*UniqueIndexDef) Descriptor() ([]byte, []int) {
 	return file_plan_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *TableDef) GetName() string {
+func (x *UniqueIndexDef) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *TableDef) GetAlias() string {
-	if x != nil {
-		return x.Alias
-	}
-	return ""
-}
-
-func (x *TableDef) GetCols() []*ColDef {
-	if x != nil {
-		return x.Cols
-	}
-	return nil
-}
-
-func (x *TableDef) GetDefs() []*TableDef_DefType {
+func (x *UniqueIndexDef) GetColNames() []string {
 	if x != nil {
-		return x.Defs
+		return x.ColNames
 	}
 	return nil
 }
 
-type Cost struct {
+type CheckDef struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Card    float64 `protobuf:"fixed64,1,opt,name=card,proto3" json:"card,omitempty"`
-	Rowsize float64 `protobuf:"fixed64,2,opt,name=rowsize,proto3" json:"rowsize,omitempty"`
-	Ndv     float64 `protobuf:"fixed64,3,opt,name=ndv,proto3" json:"ndv,omitempty"`
-	Start   float64 `protobuf:"fixed64,4,opt,name=start,proto3" json:"start,omitempty"`
-	Total   float64 `protobuf:"fixed64,5,opt,name=total,proto3" json:"total,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Expr string `protobuf:"bytes,2,opt,name=expr,proto3" json:"expr,omitempty"`
 }
 
-func (x *Cost) Reset() {
-	*x = Cost{}
+func (x *CheckDef) Reset() {
+	*x = CheckDef{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_plan_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2300,13 +2474,13 @@ func (x *Cost) Reset() {
 	}
 }
 
-func (x *Cost) String() string {
+func (x *CheckDef) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Cost) ProtoMessage() {}
+func (*CheckDef) ProtoMessage() {}
 
-func (x *Cost) ProtoReflect() protoreflect.Message {
+func (x *CheckDef) ProtoReflect() protoreflect.Message {
 	mi := &file_plan_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2318,63 +2492,41 @@ func (x *Cost) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Cost.ProtoReflect.Descriptor instead.
-func (*Cost) Descriptor() ([]byte, []int) {
+// Deprecated: Use CheckDef.ProtoReflect.Descriptor instead.
+func (*CheckDef) Descriptor() ([]byte, []int) {
 	return file_plan_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *Cost) GetCard() float64 {
-	if x != nil {
-		return x.Card
-	}
-	return 0
-}
-
-func (x *Cost) GetRowsize() float64 {
-	if x != nil {
-		return x.Rowsize
-	}
-	return 0
-}
-
-func (x *Cost) GetNdv() float64 {
-	if x != nil {
-		return x.Ndv
-	}
-	return 0
-}
-
-func (x *Cost) GetStart() float64 {
+func (x *CheckDef) GetName() string {
 	if x != nil {
-		return x.Start
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *Cost) GetTotal() float64 {
+func (x *CheckDef) GetExpr() string {
 	if x != nil {
-		return x.Total
+		return x.Expr
 	}
-	return 0
+	return ""
 }
 
-type ColData struct {
+type ForeignKeyDef struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RowCount  int32     `protobuf:"varint,1,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
-	NullCount int32     `protobuf:"varint,2,opt,name=null_count,json=nullCount,proto3" json:"null_count,omitempty"`
-	Nulls     []bool    `protobuf:"varint,3,rep,packed,name=nulls,proto3" json:"nulls,omitempty"`
-	I32       []int32   `protobuf:"varint,4,rep,packed,name=i32,proto3" json:"i32,omitempty"`
-	I64       []int64   `protobuf:"varint,5,rep,packed,name=i64,proto3" json:"i64,omitempty"`
-	F32       []float32 `protobuf:"fixed32,6,rep,packed,name=f32,proto3" json:"f32,omitempty"`
-	F64       []float64 `protobuf:"fixed64,7,rep,packed,name=f64,proto3" json:"f64,omitempty"`
-	S         []string  `protobuf:"bytes,8,rep,name=s,proto3" json:"s,omitempty"`
+	Name        string                  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ColNames    []string                `protobuf:"bytes,2,rep,name=col_names,json=colNames,proto3" json:"col_names,omitempty"`
+	RefDatabase string                  `protobuf:"bytes,3,opt,name=ref_database,json=refDatabase,proto3" json:"ref_database,omitempty"`
+	RefTable    string                  `protobuf:"bytes,4,opt,name=ref_table,json=refTable,proto3" json:"ref_table,omitempty"`
+	RefColNames []string                `protobuf:"bytes,5,rep,name=ref_col_names,json=refColNames,proto3" json:"ref_col_names,omitempty"`
+	OnDelete    ForeignKeyDef_RefAction `protobuf:"varint,6,opt,name=on_delete,json=onDelete,proto3,enum=ForeignKeyDef_RefAction" json:"on_delete,omitempty"`
+	OnUpdate    ForeignKeyDef_RefAction `protobuf:"varint,7,opt,name=on_update,json=onUpdate,proto3,enum=ForeignKeyDef_RefAction" json:"on_update,omitempty"`
 }
 
-func (x *ColData) Reset() {
-	*x = ColData{}
+func (x *ForeignKeyDef) Reset() {
+	*x = ForeignKeyDef{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_plan_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2382,13 +2534,13 @@ func (x *ColData) Reset() {
 	}
 }
 
-func (x *ColData) String() string {
+func (x *ForeignKeyDef) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ColData) ProtoMessage() {}
+func (*ForeignKeyDef) ProtoMessage() {}
 
-func (x *ColData) ProtoReflect() protoreflect.Message {
+func (x *ForeignKeyDef) ProtoReflect() protoreflect.Message {
 	mi := &file_plan_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2400,78 +2552,74 @@ func (x *ColData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ColData.ProtoReflect.Descriptor instead.
-func (*ColData) Descriptor() ([]byte, []int) {
+// Deprecated: Use ForeignKeyDef.ProtoReflect.Descriptor instead.
+func (*ForeignKeyDef) Descriptor() ([]byte, []int) {
 	return file_plan_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *ColData) GetRowCount() int32 {
-	if x != nil {
-		return x.RowCount
-	}
-	return 0
-}
-
-func (x *ColData) GetNullCount() int32 {
+func (x *ForeignKeyDef) GetName() string {
 	if x != nil {
-		return x.NullCount
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *ColData) GetNulls() []bool {
+func (x *ForeignKeyDef) GetColNames() []string {
 	if x != nil {
-		return x.Nulls
+		return x.ColNames
 	}
 	return nil
 }
 
-func (x *ColData) GetI32() []int32 {
+func (x *ForeignKeyDef) GetRefDatabase() string {
 	if x != nil {
-		return x.I32
+		return x.RefDatabase
 	}
-	return nil
+	return ""
 }
 
-func (x *ColData) GetI64() []int64 {
+func (x *ForeignKeyDef) GetRefTable() string {
 	if x != nil {
-		return x.I64
+		return x.RefTable
 	}
-	return nil
+	return ""
 }
 
-func (x *ColData) GetF32() []float32 {
+func (x *ForeignKeyDef) GetRefColNames() []string {
 	if x != nil {
-		return x.F32
+		return x.RefColNames
 	}
 	return nil
 }
 
-func (x *ColData) GetF64() []float64 {
+func (x *ForeignKeyDef) GetOnDelete() ForeignKeyDef_RefAction {
 	if x != nil {
-		return x.F64
+		return x.OnDelete
 	}
-	return nil
+	return ForeignKeyDef_RESTRICT
 }
 
-func (x *ColData) GetS() []string {
+func (x *ForeignKeyDef) GetOnUpdate() ForeignKeyDef_RefAction {
 	if x != nil {
-		return x.S
+		return x.OnUpdate
 	}
-	return nil
+	return ForeignKeyDef_RESTRICT
 }
 
-type RowsetData struct {
+type PartitionByDef struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Schema *TableDef  `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
-	Cols   []*ColData `protobuf:"bytes,2,rep,name=cols,proto3" json:"cols,omitempty"`
+	Type       PartitionByDef_PartitionType `protobuf:"varint,1,opt,name=type,proto3,enum=PartitionByDef_PartitionType" json:"type,omitempty"`
+	Fields     []string                     `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	Partitions []*PartitionByDef_Partition  `protobuf:"bytes,3,rep,name=partitions,proto3" json:"partitions,omitempty"`
+	// the number of the partitions of a hash partitioned table
+	Num int32 `protobuf:"varint,4,opt,name=num,proto3" json:"num,omitempty"`
 }
 
-func (x *RowsetData) Reset() {
-	*x = RowsetData{}
+func (x *PartitionByDef) Reset() {
+	*x = PartitionByDef{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_plan_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2479,13 +2627,13 @@ func (x *RowsetData) Reset() {
 	}
 }
 
-func (x *RowsetData) String() string {
+func (x *PartitionByDef) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RowsetData) ProtoMessage() {}
+func (*PartitionByDef) ProtoMessage() {}
 
-func (x *RowsetData) ProtoReflect() protoreflect.Message {
+func (x *PartitionByDef) ProtoReflect() protoreflect.Message {
 	mi := &file_plan_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2497,16 +2645,338 @@ func (x *RowsetData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RowsetData.ProtoReflect.Descriptor instead.
-func (*RowsetData) Descriptor() ([]byte, []int) {
+// Deprecated: Use PartitionByDef.ProtoReflect.Descriptor instead.
+func (*PartitionByDef) Descriptor() ([]byte, []int) {
 	return file_plan_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *RowsetData) GetSchema() *TableDef {
+func (x *PartitionByDef) GetType() PartitionByDef_PartitionType {
 	if x != nil {
-		return x.Schema
+		return x.Type
 	}
-	return nil
+	return PartitionByDef_RANGE
+}
+
+func (x *PartitionByDef) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *PartitionByDef) GetPartitions() []*PartitionByDef_Partition {
+	if x != nil {
+		return x.Partitions
+	}
+	return nil
+}
+
+func (x *PartitionByDef) GetNum() int32 {
+	if x != nil {
+		return x.Num
+	}
+	return 0
+}
+
+type TableDef struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string              `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Alias string              `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
+	Cols  []*ColDef           `protobuf:"bytes,3,rep,name=cols,proto3" json:"cols,omitempty"`
+	Defs  []*TableDef_DefType `protobuf:"bytes,4,rep,name=defs,proto3" json:"defs,omitempty"`
+}
+
+func (x *TableDef) Reset() {
+	*x = TableDef{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plan_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TableDef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TableDef) ProtoMessage() {}
+
+func (x *TableDef) ProtoReflect() protoreflect.Message {
+	mi := &file_plan_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TableDef.ProtoReflect.Descriptor instead.
+func (*TableDef) Descriptor() ([]byte, []int) {
+	return file_plan_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *TableDef) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TableDef) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *TableDef) GetCols() []*ColDef {
+	if x != nil {
+		return x.Cols
+	}
+	return nil
+}
+
+func (x *TableDef) GetDefs() []*TableDef_DefType {
+	if x != nil {
+		return x.Defs
+	}
+	return nil
+}
+
+type Cost struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Card    float64 `protobuf:"fixed64,1,opt,name=card,proto3" json:"card,omitempty"`
+	Rowsize float64 `protobuf:"fixed64,2,opt,name=rowsize,proto3" json:"rowsize,omitempty"`
+	Ndv     float64 `protobuf:"fixed64,3,opt,name=ndv,proto3" json:"ndv,omitempty"`
+	Start   float64 `protobuf:"fixed64,4,opt,name=start,proto3" json:"start,omitempty"`
+	Total   float64 `protobuf:"fixed64,5,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *Cost) Reset() {
+	*x = Cost{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plan_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Cost) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cost) ProtoMessage() {}
+
+func (x *Cost) ProtoReflect() protoreflect.Message {
+	mi := &file_plan_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cost.ProtoReflect.Descriptor instead.
+func (*Cost) Descriptor() ([]byte, []int) {
+	return file_plan_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Cost) GetCard() float64 {
+	if x != nil {
+		return x.Card
+	}
+	return 0
+}
+
+func (x *Cost) GetRowsize() float64 {
+	if x != nil {
+		return x.Rowsize
+	}
+	return 0
+}
+
+func (x *Cost) GetNdv() float64 {
+	if x != nil {
+		return x.Ndv
+	}
+	return 0
+}
+
+func (x *Cost) GetStart() float64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *Cost) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type ColData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RowCount  int32     `protobuf:"varint,1,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	NullCount int32     `protobuf:"varint,2,opt,name=null_count,json=nullCount,proto3" json:"null_count,omitempty"`
+	Nulls     []bool    `protobuf:"varint,3,rep,packed,name=nulls,proto3" json:"nulls,omitempty"`
+	I32       []int32   `protobuf:"varint,4,rep,packed,name=i32,proto3" json:"i32,omitempty"`
+	I64       []int64   `protobuf:"varint,5,rep,packed,name=i64,proto3" json:"i64,omitempty"`
+	F32       []float32 `protobuf:"fixed32,6,rep,packed,name=f32,proto3" json:"f32,omitempty"`
+	F64       []float64 `protobuf:"fixed64,7,rep,packed,name=f64,proto3" json:"f64,omitempty"`
+	S         []string  `protobuf:"bytes,8,rep,name=s,proto3" json:"s,omitempty"`
+}
+
+func (x *ColData) Reset() {
+	*x = ColData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plan_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColData) ProtoMessage() {}
+
+func (x *ColData) ProtoReflect() protoreflect.Message {
+	mi := &file_plan_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColData.ProtoReflect.Descriptor instead.
+func (*ColData) Descriptor() ([]byte, []int) {
+	return file_plan_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ColData) GetRowCount() int32 {
+	if x != nil {
+		return x.RowCount
+	}
+	return 0
+}
+
+func (x *ColData) GetNullCount() int32 {
+	if x != nil {
+		return x.NullCount
+	}
+	return 0
+}
+
+func (x *ColData) GetNulls() []bool {
+	if x != nil {
+		return x.Nulls
+	}
+	return nil
+}
+
+func (x *ColData) GetI32() []int32 {
+	if x != nil {
+		return x.I32
+	}
+	return nil
+}
+
+func (x *ColData) GetI64() []int64 {
+	if x != nil {
+		return x.I64
+	}
+	return nil
+}
+
+func (x *ColData) GetF32() []float32 {
+	if x != nil {
+		return x.F32
+	}
+	return nil
+}
+
+func (x *ColData) GetF64() []float64 {
+	if x != nil {
+		return x.F64
+	}
+	return nil
+}
+
+func (x *ColData) GetS() []string {
+	if x != nil {
+		return x.S
+	}
+	return nil
+}
+
+type RowsetData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Schema *TableDef  `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
+	Cols   []*ColData `protobuf:"bytes,2,rep,name=cols,proto3" json:"cols,omitempty"`
+}
+
+func (x *RowsetData) Reset() {
+	*x = RowsetData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plan_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RowsetData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RowsetData) ProtoMessage() {}
+
+func (x *RowsetData) ProtoReflect() protoreflect.Message {
+	mi := &file_plan_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RowsetData.ProtoReflect.Descriptor instead.
+func (*RowsetData) Descriptor() ([]byte, []int) {
+	return file_plan_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RowsetData) GetSchema() *TableDef {
+	if x != nil {
+		return x.Schema
+	}
+	return nil
 }
 
 func (x *RowsetData) GetCols() []*ColData {
@@ -2529,7 +2999,7 @@ type OrderBySpec struct {
 func (x *OrderBySpec) Reset() {
 	*x = OrderBySpec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[21]
+		mi := &file_plan_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2542,7 +3012,7 @@ func (x *OrderBySpec) String() string {
 func (*OrderBySpec) ProtoMessage() {}
 
 func (x *OrderBySpec) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[21]
+	mi := &file_plan_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2555,7 +3025,7 @@ func (x *OrderBySpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OrderBySpec.ProtoReflect.Descriptor instead.
 func (*OrderBySpec) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{21}
+	return file_plan_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *OrderBySpec) GetExpr() *Expr {
@@ -2593,7 +3063,7 @@ type WindowSpec struct {
 func (x *WindowSpec) Reset() {
 	*x = WindowSpec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[22]
+		mi := &file_plan_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2606,7 +3076,7 @@ func (x *WindowSpec) String() string {
 func (*WindowSpec) ProtoMessage() {}
 
 func (x *WindowSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[22]
+	mi := &file_plan_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2619,7 +3089,7 @@ func (x *WindowSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WindowSpec.ProtoReflect.Descriptor instead.
 func (*WindowSpec) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{22}
+	return file_plan_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *WindowSpec) GetPartitionBy() []*Expr {
@@ -2662,7 +3132,7 @@ type UpdateList struct {
 func (x *UpdateList) Reset() {
 	*x = UpdateList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[23]
+		mi := &file_plan_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2675,7 +3145,7 @@ func (x *UpdateList) String() string {
 func (*UpdateList) ProtoMessage() {}
 
 func (x *UpdateList) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[23]
+	mi := &file_plan_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2688,7 +3158,7 @@ func (x *UpdateList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateList.ProtoReflect.Descriptor instead.
 func (*UpdateList) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{23}
+	return file_plan_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *UpdateList) GetColumns() []*Expr {
@@ -2730,12 +3200,25 @@ type Node struct {
 	ObjRef       *ObjectRef     `protobuf:"bytes,18,opt,name=obj_ref,json=objRef,proto3" json:"obj_ref,omitempty"`
 	RowsetData   *RowsetData    `protobuf:"bytes,19,opt,name=rowset_data,json=rowsetData,proto3" json:"rowset_data,omitempty"`
 	ExtraOptions string         `protobuf:"bytes,20,opt,name=extra_options,json=extraOptions,proto3" json:"extra_options,omitempty"`
+	// the unix nanoseconds of the AS OF TIMESTAMP clause of a table scan, 0 if absent
+	AsOfTimestamp int64 `protobuf:"varint,21,opt,name=as_of_timestamp,json=asOfTimestamp,proto3" json:"as_of_timestamp,omitempty"`
+	// the row lock of a locking read of a table scan, 0 none, 1 FOR SHARE, 2 FOR UPDATE
+	LockMode int32 `protobuf:"varint,22,opt,name=lock_mode,json=lockMode,proto3" json:"lock_mode,omitempty"`
+	// what a locking read does on a locked row, 0 wait, 1 NOWAIT, 2 SKIP LOCKED
+	LockWait int32 `protobuf:"varint,23,opt,name=lock_wait,json=lockWait,proto3" json:"lock_wait,omitempty"`
+	// how an insert resolves the rows with the primary key of an existing row,
+	// 0 error, 1 REPLACE, 2 ON DUPLICATE KEY UPDATE with update_list
+	OnDuplicate int32 `protobuf:"varint,24,opt,name=on_duplicate,json=onDuplicate,proto3" json:"on_duplicate,omitempty"`
+	// the partitions a table scan reads once pruned by partition_pruned,
+	// all of them otherwise
+	Partitions      []string `protobuf:"bytes,25,rep,name=partitions,proto3" json:"partitions,omitempty"`
+	PartitionPruned bool     `protobuf:"varint,26,opt,name=partition_pruned,json=partitionPruned,proto3" json:"partition_pruned,omitempty"`
 }
 
 func (x *Node) Reset() {
 	*x = Node{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[24]
+		mi := &file_plan_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2748,7 +3231,7 @@ func (x *Node) String() string {
 func (*Node) ProtoMessage() {}
 
 func (x *Node) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[24]
+	mi := &file_plan_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2761,7 +3244,7 @@ func (x *Node) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Node.ProtoReflect.Descriptor instead.
 func (*Node) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{24}
+	return file_plan_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *Node) GetNodeType() Node_NodeType {
@@ -2904,6 +3387,48 @@ func (x *Node) GetExtraOptions() string {
 	return ""
 }
 
+func (x *Node) GetAsOfTimestamp() int64 {
+	if x != nil {
+		return x.AsOfTimestamp
+	}
+	return 0
+}
+
+func (x *Node) GetLockMode() int32 {
+	if x != nil {
+		return x.LockMode
+	}
+	return 0
+}
+
+func (x *Node) GetLockWait() int32 {
+	if x != nil {
+		return x.LockWait
+	}
+	return 0
+}
+
+func (x *Node) GetOnDuplicate() int32 {
+	if x != nil {
+		return x.OnDuplicate
+	}
+	return 0
+}
+
+func (x *Node) GetPartitions() []string {
+	if x != nil {
+		return x.Partitions
+	}
+	return nil
+}
+
+func (x *Node) GetPartitionPruned() bool {
+	if x != nil {
+		return x.PartitionPruned
+	}
+	return false
+}
+
 type Query struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2923,7 +3448,7 @@ type Query struct {
 func (x *Query) Reset() {
 	*x = Query{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[25]
+		mi := &file_plan_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2936,7 +3461,7 @@ func (x *Query) String() string {
 func (*Query) ProtoMessage() {}
 
 func (x *Query) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[25]
+	mi := &file_plan_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2949,7 +3474,7 @@ func (x *Query) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Query.ProtoReflect.Descriptor instead.
 func (*Query) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{25}
+	return file_plan_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *Query) GetStmtType() Query_StatementType {
@@ -2997,7 +3522,7 @@ type TransationControl struct {
 func (x *TransationControl) Reset() {
 	*x = TransationControl{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[26]
+		mi := &file_plan_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3010,7 +3535,7 @@ func (x *TransationControl) String() string {
 func (*TransationControl) ProtoMessage() {}
 
 func (x *TransationControl) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[26]
+	mi := &file_plan_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3023,7 +3548,7 @@ func (x *TransationControl) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransationControl.ProtoReflect.Descriptor instead.
 func (*TransationControl) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{26}
+	return file_plan_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *TransationControl) GetTclType() TransationControl_TclType {
@@ -3094,7 +3619,7 @@ type TransationBegin struct {
 func (x *TransationBegin) Reset() {
 	*x = TransationBegin{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[27]
+		mi := &file_plan_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3107,7 +3632,7 @@ func (x *TransationBegin) String() string {
 func (*TransationBegin) ProtoMessage() {}
 
 func (x *TransationBegin) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[27]
+	mi := &file_plan_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3120,7 +3645,7 @@ func (x *TransationBegin) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransationBegin.ProtoReflect.Descriptor instead.
 func (*TransationBegin) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{27}
+	return file_plan_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *TransationBegin) GetMode() TransationBegin_TransationMode {
@@ -3141,7 +3666,7 @@ type TransationCommit struct {
 func (x *TransationCommit) Reset() {
 	*x = TransationCommit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[28]
+		mi := &file_plan_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3154,7 +3679,7 @@ func (x *TransationCommit) String() string {
 func (*TransationCommit) ProtoMessage() {}
 
 func (x *TransationCommit) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[28]
+	mi := &file_plan_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3167,7 +3692,7 @@ func (x *TransationCommit) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransationCommit.ProtoReflect.Descriptor instead.
 func (*TransationCommit) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{28}
+	return file_plan_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *TransationCommit) GetCompletionType() TransationCompletionType {
@@ -3188,7 +3713,7 @@ type TransationRollback struct {
 func (x *TransationRollback) Reset() {
 	*x = TransationRollback{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[29]
+		mi := &file_plan_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3201,7 +3726,7 @@ func (x *TransationRollback) String() string {
 func (*TransationRollback) ProtoMessage() {}
 
 func (x *TransationRollback) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[29]
+	mi := &file_plan_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3214,7 +3739,7 @@ func (x *TransationRollback) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransationRollback.ProtoReflect.Descriptor instead.
 func (*TransationRollback) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{29}
+	return file_plan_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *TransationRollback) GetCompletionType() TransationCompletionType {
@@ -3239,7 +3764,7 @@ type Plan struct {
 func (x *Plan) Reset() {
 	*x = Plan{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[30]
+		mi := &file_plan_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3252,7 +3777,7 @@ func (x *Plan) String() string {
 func (*Plan) ProtoMessage() {}
 
 func (x *Plan) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[30]
+	mi := &file_plan_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3265,7 +3790,7 @@ func (x *Plan) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Plan.ProtoReflect.Descriptor instead.
 func (*Plan) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{30}
+	return file_plan_proto_rawDescGZIP(), []int{34}
 }
 
 func (m *Plan) GetPlan() isPlan_Plan {
@@ -3347,7 +3872,7 @@ type DataDefinition struct {
 func (x *DataDefinition) Reset() {
 	*x = DataDefinition{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[31]
+		mi := &file_plan_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3360,7 +3885,7 @@ func (x *DataDefinition) String() string {
 func (*DataDefinition) ProtoMessage() {}
 
 func (x *DataDefinition) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[31]
+	mi := &file_plan_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3373,7 +3898,7 @@ func (x *DataDefinition) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DataDefinition.ProtoReflect.Descriptor instead.
 func (*DataDefinition) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{31}
+	return file_plan_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *DataDefinition) GetDdlType() DataDefinition_DdlType {
@@ -3556,7 +4081,7 @@ type CreateDatabase struct {
 func (x *CreateDatabase) Reset() {
 	*x = CreateDatabase{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[32]
+		mi := &file_plan_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3569,7 +4094,7 @@ func (x *CreateDatabase) String() string {
 func (*CreateDatabase) ProtoMessage() {}
 
 func (x *CreateDatabase) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[32]
+	mi := &file_plan_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3582,7 +4107,7 @@ func (x *CreateDatabase) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateDatabase.ProtoReflect.Descriptor instead.
 func (*CreateDatabase) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{32}
+	return file_plan_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *CreateDatabase) GetIfNotExists() bool {
@@ -3611,7 +4136,7 @@ type AlterDatabase struct {
 func (x *AlterDatabase) Reset() {
 	*x = AlterDatabase{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[33]
+		mi := &file_plan_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3624,7 +4149,7 @@ func (x *AlterDatabase) String() string {
 func (*AlterDatabase) ProtoMessage() {}
 
 func (x *AlterDatabase) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[33]
+	mi := &file_plan_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3637,7 +4162,7 @@ func (x *AlterDatabase) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AlterDatabase.ProtoReflect.Descriptor instead.
 func (*AlterDatabase) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{33}
+	return file_plan_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *AlterDatabase) GetIfExists() bool {
@@ -3666,7 +4191,7 @@ type DropDatabase struct {
 func (x *DropDatabase) Reset() {
 	*x = DropDatabase{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[34]
+		mi := &file_plan_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3679,7 +4204,7 @@ func (x *DropDatabase) String() string {
 func (*DropDatabase) ProtoMessage() {}
 
 func (x *DropDatabase) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[34]
+	mi := &file_plan_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3692,7 +4217,7 @@ func (x *DropDatabase) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DropDatabase.ProtoReflect.Descriptor instead.
 func (*DropDatabase) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{34}
+	return file_plan_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *DropDatabase) GetIfExists() bool {
@@ -3723,7 +4248,7 @@ type CreateTable struct {
 func (x *CreateTable) Reset() {
 	*x = CreateTable{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[35]
+		mi := &file_plan_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3736,7 +4261,7 @@ func (x *CreateTable) String() string {
 func (*CreateTable) ProtoMessage() {}
 
 func (x *CreateTable) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[35]
+	mi := &file_plan_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3749,7 +4274,7 @@ func (x *CreateTable) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTable.ProtoReflect.Descriptor instead.
 func (*CreateTable) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{35}
+	return file_plan_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *CreateTable) GetIfNotExists() bool {
@@ -3785,14 +4310,16 @@ type AlterTable struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Table    string    `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
-	TableDef *TableDef `protobuf:"bytes,2,opt,name=table_def,json=tableDef,proto3" json:"table_def,omitempty"`
+	Table    string              `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
+	TableDef *TableDef           `protobuf:"bytes,2,opt,name=table_def,json=tableDef,proto3" json:"table_def,omitempty"`
+	Database string              `protobuf:"bytes,3,opt,name=database,proto3" json:"database,omitempty"`
+	Actions  []*AlterTableAction `protobuf:"bytes,4,rep,name=actions,proto3" json:"actions,omitempty"`
 }
 
 func (x *AlterTable) Reset() {
 	*x = AlterTable{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[36]
+		mi := &file_plan_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3805,7 +4332,80 @@ func (x *AlterTable) String() string {
 func (*AlterTable) ProtoMessage() {}
 
 func (x *AlterTable) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[36]
+	mi := &file_plan_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlterTable.ProtoReflect.Descriptor instead.
+func (*AlterTable) Descriptor() ([]byte, []int) {
+	return file_plan_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *AlterTable) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *AlterTable) GetTableDef() *TableDef {
+	if x != nil {
+		return x.TableDef
+	}
+	return nil
+}
+
+func (x *AlterTable) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *AlterTable) GetActions() []*AlterTableAction {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+type AlterTableAction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActionType     AlterTableAction_ActionType `protobuf:"varint,1,opt,name=action_type,json=actionType,proto3,enum=AlterTableAction_ActionType" json:"action_type,omitempty"`
+	Name           string                      `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	NewName        string                      `protobuf:"bytes,3,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	ColDef         *ColDef                     `protobuf:"bytes,4,opt,name=col_def,json=colDef,proto3" json:"col_def,omitempty"`
+	PartitionDef   *PartitionByDef             `protobuf:"bytes,5,opt,name=partition_def,json=partitionDef,proto3" json:"partition_def,omitempty"`
+	PartitionNames []string                    `protobuf:"bytes,6,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+}
+
+func (x *AlterTableAction) Reset() {
+	*x = AlterTableAction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plan_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AlterTableAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlterTableAction) ProtoMessage() {}
+
+func (x *AlterTableAction) ProtoReflect() protoreflect.Message {
+	mi := &file_plan_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3813,24 +4413,52 @@ func (x *AlterTable) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlterTableAction.ProtoReflect.Descriptor instead.
+func (*AlterTableAction) Descriptor() ([]byte, []int) {
+	return file_plan_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *AlterTableAction) GetActionType() AlterTableAction_ActionType {
+	if x != nil {
+		return x.ActionType
+	}
+	return AlterTableAction_ADD_COLUMN
+}
+
+func (x *AlterTableAction) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AlterTableAction) GetNewName() string {
+	if x != nil {
+		return x.NewName
+	}
+	return ""
 }
 
-// Deprecated: Use AlterTable.ProtoReflect.Descriptor instead.
-func (*AlterTable) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{36}
+func (x *AlterTableAction) GetColDef() *ColDef {
+	if x != nil {
+		return x.ColDef
+	}
+	return nil
 }
 
-func (x *AlterTable) GetTable() string {
+func (x *AlterTableAction) GetPartitionDef() *PartitionByDef {
 	if x != nil {
-		return x.Table
+		return x.PartitionDef
 	}
-	return ""
+	return nil
 }
 
-func (x *AlterTable) GetTableDef() *TableDef {
+func (x *AlterTableAction) GetPartitionNames() []string {
 	if x != nil {
-		return x.TableDef
+		return x.PartitionNames
 	}
 	return nil
 }
@@ -3848,7 +4476,7 @@ type DropTable struct {
 func (x *DropTable) Reset() {
 	*x = DropTable{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[37]
+		mi := &file_plan_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3861,7 +4489,7 @@ func (x *DropTable) String() string {
 func (*DropTable) ProtoMessage() {}
 
 func (x *DropTable) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[37]
+	mi := &file_plan_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3874,7 +4502,7 @@ func (x *DropTable) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DropTable.ProtoReflect.Descriptor instead.
 func (*DropTable) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{37}
+	return file_plan_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *DropTable) GetIfExists() bool {
@@ -3903,14 +4531,17 @@ type CreateIndex struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IfNotExists bool   `protobuf:"varint,1,opt,name=if_not_exists,json=ifNotExists,proto3" json:"if_not_exists,omitempty"`
-	Index       string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+	IfNotExists bool     `protobuf:"varint,1,opt,name=if_not_exists,json=ifNotExists,proto3" json:"if_not_exists,omitempty"`
+	Index       string   `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+	Database    string   `protobuf:"bytes,3,opt,name=database,proto3" json:"database,omitempty"`
+	Table       string   `protobuf:"bytes,4,opt,name=table,proto3" json:"table,omitempty"`
+	ColNames    []string `protobuf:"bytes,5,rep,name=col_names,json=colNames,proto3" json:"col_names,omitempty"`
 }
 
 func (x *CreateIndex) Reset() {
 	*x = CreateIndex{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[38]
+		mi := &file_plan_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3923,7 +4554,7 @@ func (x *CreateIndex) String() string {
 func (*CreateIndex) ProtoMessage() {}
 
 func (x *CreateIndex) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[38]
+	mi := &file_plan_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3936,7 +4567,7 @@ func (x *CreateIndex) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateIndex.ProtoReflect.Descriptor instead.
 func (*CreateIndex) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{38}
+	return file_plan_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *CreateIndex) GetIfNotExists() bool {
@@ -3953,6 +4584,27 @@ func (x *CreateIndex) GetIndex() string {
 	return ""
 }
 
+func (x *CreateIndex) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *CreateIndex) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *CreateIndex) GetColNames() []string {
+	if x != nil {
+		return x.ColNames
+	}
+	return nil
+}
+
 type AlterIndex struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3964,7 +4616,7 @@ type AlterIndex struct {
 func (x *AlterIndex) Reset() {
 	*x = AlterIndex{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[39]
+		mi := &file_plan_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3977,7 +4629,7 @@ func (x *AlterIndex) String() string {
 func (*AlterIndex) ProtoMessage() {}
 
 func (x *AlterIndex) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[39]
+	mi := &file_plan_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3990,7 +4642,7 @@ func (x *AlterIndex) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AlterIndex.ProtoReflect.Descriptor instead.
 func (*AlterIndex) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{39}
+	return file_plan_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *AlterIndex) GetIndex() string {
@@ -4007,12 +4659,14 @@ type DropIndex struct {
 
 	IfExists bool   `protobuf:"varint,1,opt,name=if_exists,json=ifExists,proto3" json:"if_exists,omitempty"`
 	Index    string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+	Database string `protobuf:"bytes,3,opt,name=database,proto3" json:"database,omitempty"`
+	Table    string `protobuf:"bytes,4,opt,name=table,proto3" json:"table,omitempty"`
 }
 
 func (x *DropIndex) Reset() {
 	*x = DropIndex{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[40]
+		mi := &file_plan_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4025,7 +4679,7 @@ func (x *DropIndex) String() string {
 func (*DropIndex) ProtoMessage() {}
 
 func (x *DropIndex) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[40]
+	mi := &file_plan_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4038,7 +4692,7 @@ func (x *DropIndex) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DropIndex.ProtoReflect.Descriptor instead.
 func (*DropIndex) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{40}
+	return file_plan_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *DropIndex) GetIfExists() bool {
@@ -4055,6 +4709,20 @@ func (x *DropIndex) GetIndex() string {
 	return ""
 }
 
+func (x *DropIndex) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *DropIndex) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
 type TruncateTable struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -4066,7 +4734,7 @@ type TruncateTable struct {
 func (x *TruncateTable) Reset() {
 	*x = TruncateTable{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[41]
+		mi := &file_plan_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4079,7 +4747,7 @@ func (x *TruncateTable) String() string {
 func (*TruncateTable) ProtoMessage() {}
 
 func (x *TruncateTable) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[41]
+	mi := &file_plan_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4092,7 +4760,7 @@ func (x *TruncateTable) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TruncateTable.ProtoReflect.Descriptor instead.
 func (*TruncateTable) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{41}
+	return file_plan_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *TruncateTable) GetTable() string {
@@ -4114,7 +4782,7 @@ type ShowVariables struct {
 func (x *ShowVariables) Reset() {
 	*x = ShowVariables{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[42]
+		mi := &file_plan_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4127,7 +4795,7 @@ func (x *ShowVariables) String() string {
 func (*ShowVariables) ProtoMessage() {}
 
 func (x *ShowVariables) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[42]
+	mi := &file_plan_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4140,7 +4808,7 @@ func (x *ShowVariables) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShowVariables.ProtoReflect.Descriptor instead.
 func (*ShowVariables) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{42}
+	return file_plan_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *ShowVariables) GetGlobal() bool {
@@ -4157,6 +4825,63 @@ func (x *ShowVariables) GetWhere() []*Expr {
 	return nil
 }
 
+type PartitionByDef_Partition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the values of a list partition, or the bound of a range
+	// partition, none for MAXVALUE
+	Values []*Expr `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *PartitionByDef_Partition) Reset() {
+	*x = PartitionByDef_Partition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plan_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PartitionByDef_Partition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartitionByDef_Partition) ProtoMessage() {}
+
+func (x *PartitionByDef_Partition) ProtoReflect() protoreflect.Message {
+	mi := &file_plan_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartitionByDef_Partition.ProtoReflect.Descriptor instead.
+func (*PartitionByDef_Partition) Descriptor() ([]byte, []int) {
+	return file_plan_proto_rawDescGZIP(), []int{20, 0}
+}
+
+func (x *PartitionByDef_Partition) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PartitionByDef_Partition) GetValues() []*Expr {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
 type TableDef_DefType struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -4166,13 +4891,17 @@ type TableDef_DefType struct {
 	//	*TableDef_DefType_Pk
 	//	*TableDef_DefType_Idx
 	//	*TableDef_DefType_Properties
+	//	*TableDef_DefType_Unique
+	//	*TableDef_DefType_Check
+	//	*TableDef_DefType_Fk
+	//	*TableDef_DefType_Partition
 	Def isTableDef_DefType_Def `protobuf_oneof:"def"`
 }
 
 func (x *TableDef_DefType) Reset() {
 	*x = TableDef_DefType{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[43]
+		mi := &file_plan_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4185,7 +4914,7 @@ func (x *TableDef_DefType) String() string {
 func (*TableDef_DefType) ProtoMessage() {}
 
 func (x *TableDef_DefType) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[43]
+	mi := &file_plan_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4198,7 +4927,7 @@ func (x *TableDef_DefType) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TableDef_DefType.ProtoReflect.Descriptor instead.
 func (*TableDef_DefType) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{17, 0}
+	return file_plan_proto_rawDescGZIP(), []int{21, 0}
 }
 
 func (m *TableDef_DefType) GetDef() isTableDef_DefType_Def {
@@ -4229,6 +4958,34 @@ func (x *TableDef_DefType) GetProperties() *PropertiesDef {
 	return nil
 }
 
+func (x *TableDef_DefType) GetUnique() *UniqueIndexDef {
+	if x, ok := x.GetDef().(*TableDef_DefType_Unique); ok {
+		return x.Unique
+	}
+	return nil
+}
+
+func (x *TableDef_DefType) GetCheck() *CheckDef {
+	if x, ok := x.GetDef().(*TableDef_DefType_Check); ok {
+		return x.Check
+	}
+	return nil
+}
+
+func (x *TableDef_DefType) GetFk() *ForeignKeyDef {
+	if x, ok := x.GetDef().(*TableDef_DefType_Fk); ok {
+		return x.Fk
+	}
+	return nil
+}
+
+func (x *TableDef_DefType) GetPartition() *PartitionByDef {
+	if x, ok := x.GetDef().(*TableDef_DefType_Partition); ok {
+		return x.Partition
+	}
+	return nil
+}
+
 type isTableDef_DefType_Def interface {
 	isTableDef_DefType_Def()
 }
@@ -4245,12 +5002,36 @@ type TableDef_DefType_Properties struct {
 	Properties *PropertiesDef `protobuf:"bytes,3,opt,name=properties,proto3,oneof"`
 }
 
+type TableDef_DefType_Unique struct {
+	Unique *UniqueIndexDef `protobuf:"bytes,4,opt,name=unique,proto3,oneof"`
+}
+
+type TableDef_DefType_Check struct {
+	Check *CheckDef `protobuf:"bytes,5,opt,name=check,proto3,oneof"`
+}
+
+type TableDef_DefType_Fk struct {
+	Fk *ForeignKeyDef `protobuf:"bytes,6,opt,name=fk,proto3,oneof"`
+}
+
+type TableDef_DefType_Partition struct {
+	Partition *PartitionByDef `protobuf:"bytes,7,opt,name=partition,proto3,oneof"`
+}
+
 func (*TableDef_DefType_Pk) isTableDef_DefType_Def() {}
 
 func (*TableDef_DefType_Idx) isTableDef_DefType_Def() {}
 
 func (*TableDef_DefType_Properties) isTableDef_DefType_Def() {}
 
+func (*TableDef_DefType_Unique) isTableDef_DefType_Def() {}
+
+func (*TableDef_DefType_Check) isTableDef_DefType_Def() {}
+
+func (*TableDef_DefType_Fk) isTableDef_DefType_Def() {}
+
+func (*TableDef_DefType_Partition) isTableDef_DefType_Def() {}
+
 var File_plan_proto protoreflect.FileDescriptor
 
 var file_plan_proto_rawDesc = []byte{
@@ -4379,7 +5160,7 @@ var file_plan_proto_rawDesc = []byte{
 	0x52, 0x05, 0x65, 0x78, 0x69, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x05, 0x76,
 	0x61, 0x6c, 0x75, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x4e, 0x75, 0x6c, 0x6c, 0x22, 0xc4, 0x01,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x4e, 0x75, 0x6c, 0x6c, 0x22, 0x86, 0x02,
 	0x0a, 0x06, 0x43, 0x6f, 0x6c, 0x44, 0x65, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
 	0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69,
@@ -4392,41 +5173,101 @@ var file_plan_proto_rawDesc = []byte{
 	0x61, 0x75, 0x6c, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x18,
 	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x14,
 	0x0a, 0x05, 0x70, 0x6b, 0x69, 0x64, 0x78, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70,
-	0x6b, 0x69, 0x64, 0x78, 0x22, 0x92, 0x01, 0x0a, 0x08, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x44, 0x65,
-	0x66, 0x12, 0x25, 0x0a, 0x03, 0x74, 0x79, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13,
-	0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x44, 0x65, 0x66, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x03, 0x74, 0x79, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09,
-	0x63, 0x6f, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x08, 0x63, 0x6f, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x2e, 0x0a, 0x09, 0x49, 0x6e, 0x64,
-	0x65, 0x78, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x49, 0x4e, 0x56, 0x41, 0x49, 0x4c,
-	0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x5a, 0x4f, 0x4e, 0x45, 0x4d, 0x41, 0x50, 0x10, 0x01,
-	0x12, 0x07, 0x0a, 0x03, 0x42, 0x53, 0x49, 0x10, 0x02, 0x22, 0x25, 0x0a, 0x0d, 0x50, 0x72, 0x69,
-	0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x44, 0x65, 0x66, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61,
-	0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73,
-	0x22, 0x32, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x22, 0x3a, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69,
-	0x65, 0x73, 0x44, 0x65, 0x66, 0x12, 0x29, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
-	0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x50, 0x72, 0x6f, 0x70,
-	0x65, 0x72, 0x74, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73,
-	0x22, 0xfe, 0x01, 0x0a, 0x08, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x1b, 0x0a, 0x04, 0x63, 0x6f, 0x6c, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43, 0x6f, 0x6c, 0x44, 0x65, 0x66, 0x52, 0x04,
-	0x63, 0x6f, 0x6c, 0x73, 0x12, 0x25, 0x0a, 0x04, 0x64, 0x65, 0x66, 0x73, 0x18, 0x04, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x2e, 0x44, 0x65,
-	0x66, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x64, 0x65, 0x66, 0x73, 0x1a, 0x83, 0x01, 0x0a, 0x07,
-	0x44, 0x65, 0x66, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x02, 0x70, 0x6b, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79,
-	0x44, 0x65, 0x66, 0x48, 0x00, 0x52, 0x02, 0x70, 0x6b, 0x12, 0x1d, 0x0a, 0x03, 0x69, 0x64, 0x78,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x44, 0x65,
-	0x66, 0x48, 0x00, 0x52, 0x03, 0x69, 0x64, 0x78, 0x12, 0x30, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70,
-	0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x50,
-	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x44, 0x65, 0x66, 0x48, 0x00, 0x52, 0x0a,
-	0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x42, 0x05, 0x0a, 0x03, 0x64, 0x65,
+	0x6b, 0x69, 0x64, 0x78, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x69, 0x6e, 0x63,
+	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x61, 0x75,
+	0x74, 0x6f, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6e,
+	0x6f, 0x74, 0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6e,
+	0x6f, 0x74, 0x4e, 0x75, 0x6c, 0x6c, 0x22, 0x92, 0x01, 0x0a, 0x08, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x44, 0x65, 0x66, 0x12, 0x25, 0x0a, 0x03, 0x74, 0x79, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x13, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x44, 0x65, 0x66, 0x2e, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x54, 0x79, 0x70, 0x65, 0x52, 0x03, 0x74, 0x79, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x08, 0x63, 0x6f, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x2e, 0x0a, 0x09, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x49, 0x4e, 0x56, 0x41,
+	0x49, 0x4c, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x5a, 0x4f, 0x4e, 0x45, 0x4d, 0x41, 0x50,
+	0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x53, 0x49, 0x10, 0x02, 0x22, 0x25, 0x0a, 0x0d, 0x50,
+	0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x44, 0x65, 0x66, 0x12, 0x14, 0x0a, 0x05,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x22, 0x32, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3a, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72,
+	0x74, 0x69, 0x65, 0x73, 0x44, 0x65, 0x66, 0x12, 0x29, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65,
+	0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x50, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69,
+	0x65, 0x73, 0x22, 0x41, 0x0a, 0x0e, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x44, 0x65, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6c,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x32, 0x0a, 0x08, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x44, 0x65,
+	0x66, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x78, 0x70, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x65, 0x78, 0x70, 0x72, 0x22, 0xc8, 0x02, 0x0a, 0x0d, 0x46, 0x6f,
+	0x72, 0x65, 0x69, 0x67, 0x6e, 0x4b, 0x65, 0x79, 0x44, 0x65, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x72, 0x65, 0x66, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x66, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x66, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x22, 0x0a, 0x0d,
+	0x72, 0x65, 0x66, 0x5f, 0x63, 0x6f, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x66, 0x43, 0x6f, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x12, 0x35, 0x0a, 0x09, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x4b, 0x65, 0x79,
+	0x44, 0x65, 0x66, 0x2e, 0x52, 0x65, 0x66, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6f,
+	0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x09, 0x6f, 0x6e, 0x5f, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x46, 0x6f, 0x72,
+	0x65, 0x69, 0x67, 0x6e, 0x4b, 0x65, 0x79, 0x44, 0x65, 0x66, 0x2e, 0x52, 0x65, 0x66, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0x34,
+	0x0a, 0x09, 0x52, 0x65, 0x66, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0c, 0x0a, 0x08, 0x52,
+	0x45, 0x53, 0x54, 0x52, 0x49, 0x43, 0x54, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x41, 0x53,
+	0x43, 0x41, 0x44, 0x45, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x45, 0x54, 0x5f, 0x4e, 0x55,
+	0x4c, 0x4c, 0x10, 0x02, 0x22, 0x98, 0x02, 0x0a, 0x0e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x79, 0x44, 0x65, 0x66, 0x12, 0x31, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x79, 0x44, 0x65, 0x66, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x79, 0x44, 0x65, 0x66, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x10, 0x0a,
+	0x03, 0x6e, 0x75, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6e, 0x75, 0x6d, 0x1a,
+	0x3e, 0x0a, 0x09, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1d, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22,
+	0x2e, 0x0a, 0x0d, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x09, 0x0a, 0x05, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4c,
+	0x49, 0x53, 0x54, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x41, 0x53, 0x48, 0x10, 0x02, 0x22,
+	0x9f, 0x03, 0x0a, 0x08, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x1b, 0x0a, 0x04, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43, 0x6f, 0x6c, 0x44, 0x65, 0x66, 0x52, 0x04, 0x63,
+	0x6f, 0x6c, 0x73, 0x12, 0x25, 0x0a, 0x04, 0x64, 0x65, 0x66, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x2e, 0x44, 0x65, 0x66,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x64, 0x65, 0x66, 0x73, 0x1a, 0xa4, 0x02, 0x0a, 0x07, 0x44,
+	0x65, 0x66, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x02, 0x70, 0x6b, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x44,
+	0x65, 0x66, 0x48, 0x00, 0x52, 0x02, 0x70, 0x6b, 0x12, 0x1d, 0x0a, 0x03, 0x69, 0x64, 0x78, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x44, 0x65, 0x66,
+	0x48, 0x00, 0x52, 0x03, 0x69, 0x64, 0x78, 0x12, 0x30, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65,
+	0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x50, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x44, 0x65, 0x66, 0x48, 0x00, 0x52, 0x0a, 0x70,
+	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x06, 0x75, 0x6e, 0x69,
+	0x71, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x55, 0x6e, 0x69, 0x71,
+	0x75, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x44, 0x65, 0x66, 0x48, 0x00, 0x52, 0x06, 0x75, 0x6e,
+	0x69, 0x71, 0x75, 0x65, 0x12, 0x21, 0x0a, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x44, 0x65, 0x66, 0x48, 0x00,
+	0x52, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x20, 0x0a, 0x02, 0x66, 0x6b, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x4b, 0x65, 0x79,
+	0x44, 0x65, 0x66, 0x48, 0x00, 0x52, 0x02, 0x66, 0x6b, 0x12, 0x2f, 0x0a, 0x09, 0x70, 0x61, 0x72,
+	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x44, 0x65, 0x66, 0x48, 0x00, 0x52,
+	0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x05, 0x0a, 0x03, 0x64, 0x65,
 	0x66, 0x22, 0x72, 0x0a, 0x04, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x61, 0x72,
 	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x63, 0x61, 0x72, 0x64, 0x12, 0x18, 0x0a,
 	0x07, 0x72, 0x6f, 0x77, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07,
@@ -4477,7 +5318,7 @@ var file_plan_proto_rawDesc = []byte{
 	0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75,
 	0x6d, 0x6e, 0x73, 0x12, 0x1d, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20,
 	0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x73, 0x22, 0xec, 0x09, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x2b, 0x0a, 0x09, 0x6e,
+	0x65, 0x73, 0x22, 0xbc, 0x0b, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x2b, 0x0a, 0x09, 0x6e,
 	0x6f, 0x64, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e,
 	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08,
 	0x6e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65,
@@ -4523,7 +5364,20 @@ var file_plan_proto_rawDesc = []byte{
 	0x32, 0x0b, 0x2e, 0x52, 0x6f, 0x77, 0x73, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0a, 0x72,
 	0x6f, 0x77, 0x73, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x74,
 	0x72, 0x61, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x65, 0x78, 0x74, 0x72, 0x61, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xff,
+	0x52, 0x0c, 0x65, 0x78, 0x74, 0x72, 0x61, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26,
+	0x0a, 0x0f, 0x61, 0x73, 0x5f, 0x6f, 0x66, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x15, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x61, 0x73, 0x4f, 0x66, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6d,
+	0x6f, 0x64, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x6b, 0x4d,
+	0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x77, 0x61, 0x69, 0x74,
+	0x18, 0x17, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x6b, 0x57, 0x61, 0x69, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x6e, 0x5f, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x18, 0x18, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6f, 0x6e, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x19, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x70,
+	0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x22, 0xff,
 	0x02, 0x0a, 0x08, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55,
 	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x56, 0x41, 0x4c, 0x55,
 	0x45, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x41, 0x42, 0x4c,
@@ -4707,42 +5561,84 @@ var file_plan_proto_rawDesc = []byte{
 	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65,
 	0x5f, 0x64, 0x65, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x54, 0x61, 0x62,
 	0x6c, 0x65, 0x44, 0x65, 0x66, 0x52, 0x08, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x22,
-	0x4a, 0x0a, 0x0a, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61,
-	0x62, 0x6c, 0x65, 0x12, 0x26, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x64, 0x65, 0x66,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65,
-	0x66, 0x52, 0x08, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x22, 0x5a, 0x0a, 0x09, 0x44,
+	0x93, 0x01, 0x0a, 0x0a, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x12, 0x26, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x64, 0x65,
+	0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x44,
+	0x65, 0x66, 0x52, 0x08, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x41, 0x6c, 0x74, 0x65,
+	0x72, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xa3, 0x03, 0x0a, 0x10, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x0b, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1c, 0x2e, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x6e, 0x65, 0x77, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6e, 0x65, 0x77, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x5f,
+	0x64, 0x65, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x43, 0x6f, 0x6c, 0x44,
+	0x65, 0x66, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x44, 0x65, 0x66, 0x12, 0x34, 0x0a, 0x0d, 0x70, 0x61,
+	0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x44,
+	0x65, 0x66, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x66,
+	0x12, 0x27, 0x0a, 0x0f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x9f, 0x01, 0x0a, 0x0a, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x0a, 0x41, 0x44, 0x44, 0x5f,
+	0x43, 0x4f, 0x4c, 0x55, 0x4d, 0x4e, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x44, 0x52, 0x4f, 0x50,
+	0x5f, 0x43, 0x4f, 0x4c, 0x55, 0x4d, 0x4e, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x52, 0x45, 0x4e,
+	0x41, 0x4d, 0x45, 0x5f, 0x43, 0x4f, 0x4c, 0x55, 0x4d, 0x4e, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d,
+	0x4d, 0x4f, 0x44, 0x49, 0x46, 0x59, 0x5f, 0x43, 0x4f, 0x4c, 0x55, 0x4d, 0x4e, 0x10, 0x03, 0x12,
+	0x0b, 0x0a, 0x07, 0x43, 0x4f, 0x4d, 0x50, 0x41, 0x43, 0x54, 0x10, 0x04, 0x12, 0x11, 0x0a, 0x0d,
+	0x41, 0x44, 0x44, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x05, 0x12,
+	0x12, 0x0a, 0x0e, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x49, 0x54, 0x49, 0x4f,
+	0x4e, 0x10, 0x06, 0x12, 0x16, 0x0a, 0x12, 0x54, 0x52, 0x55, 0x4e, 0x43, 0x41, 0x54, 0x45, 0x5f,
+	0x50, 0x41, 0x52, 0x54, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x07, 0x22, 0x5a, 0x0a, 0x09, 0x44,
 	0x72, 0x6f, 0x70, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x66, 0x5f, 0x65,
 	0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x66, 0x45,
 	0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
 	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
 	0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x47, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x66, 0x5f, 0x6e, 0x6f, 0x74,
-	0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69,
-	0x66, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x96, 0x01, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x66, 0x5f, 0x6e, 0x6f,
+	0x74, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x69, 0x66, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x73,
 	0x22, 0x22, 0x0a, 0x0a, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x14,
 	0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x22, 0x3e, 0x0a, 0x09, 0x44, 0x72, 0x6f, 0x70, 0x49, 0x6e, 0x64, 0x65,
+	0x6e, 0x64, 0x65, 0x78, 0x22, 0x70, 0x0a, 0x09, 0x44, 0x72, 0x6f, 0x70, 0x49, 0x6e, 0x64, 0x65,
 	0x78, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x66, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x66, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x14,
 	0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x22, 0x25, 0x0a, 0x0d, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65,
-	0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x44, 0x0a, 0x0d, 0x53,
-	0x68, 0x6f, 0x77, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06,
-	0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x67, 0x6c,
-	0x6f, 0x62, 0x61, 0x6c, 0x12, 0x1b, 0x0a, 0x05, 0x77, 0x68, 0x65, 0x72, 0x65, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x05, 0x77, 0x68, 0x65, 0x72,
-	0x65, 0x2a, 0x21, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x4c,
-	0x7a, 0x34, 0x10, 0x01, 0x2a, 0x40, 0x0a, 0x18, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x09, 0x0a, 0x05, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x4e,
-	0x4f, 0x5f, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x4c,
-	0x45, 0x41, 0x53, 0x45, 0x10, 0x02, 0x42, 0x07, 0x5a, 0x05, 0x2f, 0x70, 0x6c, 0x61, 0x6e, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x25, 0x0a, 0x0d, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61,
+	0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x44, 0x0a,
+	0x0d, 0x53, 0x68, 0x6f, 0x77, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x12, 0x1b, 0x0a, 0x05, 0x77, 0x68, 0x65, 0x72, 0x65, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x05, 0x77, 0x68,
+	0x65, 0x72, 0x65, 0x2a, 0x53, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12, 0x07, 0x0a,
+	0x03, 0x4c, 0x7a, 0x34, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x5a, 0x73, 0x74, 0x64, 0x10, 0x02,
+	0x12, 0x08, 0x0a, 0x04, 0x44, 0x69, 0x63, 0x74, 0x10, 0x03, 0x12, 0x07, 0x0a, 0x03, 0x52, 0x6c,
+	0x65, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x10, 0x05, 0x12, 0x08,
+	0x0a, 0x04, 0x41, 0x75, 0x74, 0x6f, 0x10, 0x06, 0x2a, 0x40, 0x0a, 0x18, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x10, 0x00, 0x12,
+	0x0c, 0x0a, 0x08, 0x4e, 0x4f, 0x5f, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x10, 0x01, 0x12, 0x0b, 0x0a,
+	0x07, 0x52, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x10, 0x02, 0x42, 0x07, 0x5a, 0x05, 0x2f, 0x70,
+	0x6c, 0x61, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4757,151 +5653,173 @@ func file_plan_proto_rawDescGZIP() []byte {
 	return file_plan_proto_rawDescData
 }
 
-var file_plan_proto_enumTypes = make([]protoimpl.EnumInfo, 13)
-var file_plan_proto_msgTypes = make([]protoimpl.MessageInfo, 44)
+var file_plan_proto_enumTypes = make([]protoimpl.EnumInfo, 16)
+var file_plan_proto_msgTypes = make([]protoimpl.MessageInfo, 50)
 var file_plan_proto_goTypes = []interface{}{
 	(CompressType)(0),                   // 0: CompressType
 	(TransationCompletionType)(0),       // 1: TransationCompletionType
 	(Type_TypeId)(0),                    // 2: Type.TypeId
 	(Function_FuncFlag)(0),              // 3: Function.FuncFlag
 	(IndexDef_IndexType)(0),             // 4: IndexDef.IndexType
-	(OrderBySpec_OrderByFlag)(0),        // 5: OrderBySpec.OrderByFlag
-	(Node_NodeType)(0),                  // 6: Node.NodeType
-	(Node_JoinFlag)(0),                  // 7: Node.JoinFlag
-	(Node_AggMode)(0),                   // 8: Node.AggMode
-	(Query_StatementType)(0),            // 9: Query.StatementType
-	(TransationControl_TclType)(0),      // 10: TransationControl.TclType
-	(TransationBegin_TransationMode)(0), // 11: TransationBegin.TransationMode
-	(DataDefinition_DdlType)(0),         // 12: DataDefinition.DdlType
-	(*Type)(nil),                        // 13: Type
-	(*Const)(nil),                       // 14: Const
-	(*ParamRef)(nil),                    // 15: ParamRef
-	(*VarRef)(nil),                      // 16: VarRef
-	(*ColRef)(nil),                      // 17: ColRef
-	(*CorrColRef)(nil),                  // 18: CorrColRef
-	(*ExprList)(nil),                    // 19: ExprList
-	(*SubQuery)(nil),                    // 20: SubQuery
-	(*ObjectRef)(nil),                   // 21: ObjectRef
-	(*Function)(nil),                    // 22: Function
-	(*Expr)(nil),                        // 23: Expr
-	(*DefaultExpr)(nil),                 // 24: DefaultExpr
-	(*ColDef)(nil),                      // 25: ColDef
-	(*IndexDef)(nil),                    // 26: IndexDef
-	(*PrimaryKeyDef)(nil),               // 27: PrimaryKeyDef
-	(*Property)(nil),                    // 28: Property
-	(*PropertiesDef)(nil),               // 29: PropertiesDef
-	(*TableDef)(nil),                    // 30: TableDef
-	(*Cost)(nil),                        // 31: Cost
-	(*ColData)(nil),                     // 32: ColData
-	(*RowsetData)(nil),                  // 33: RowsetData
-	(*OrderBySpec)(nil),                 // 34: OrderBySpec
-	(*WindowSpec)(nil),                  // 35: WindowSpec
-	(*UpdateList)(nil),                  // 36: UpdateList
-	(*Node)(nil),                        // 37: Node
-	(*Query)(nil),                       // 38: Query
-	(*TransationControl)(nil),           // 39: TransationControl
-	(*TransationBegin)(nil),             // 40: TransationBegin
-	(*TransationCommit)(nil),            // 41: TransationCommit
-	(*TransationRollback)(nil),          // 42: TransationRollback
-	(*Plan)(nil),                        // 43: Plan
-	(*DataDefinition)(nil),              // 44: DataDefinition
-	(*CreateDatabase)(nil),              // 45: CreateDatabase
-	(*AlterDatabase)(nil),               // 46: AlterDatabase
-	(*DropDatabase)(nil),                // 47: DropDatabase
-	(*CreateTable)(nil),                 // 48: CreateTable
-	(*AlterTable)(nil),                  // 49: AlterTable
-	(*DropTable)(nil),                   // 50: DropTable
-	(*CreateIndex)(nil),                 // 51: CreateIndex
-	(*AlterIndex)(nil),                  // 52: AlterIndex
-	(*DropIndex)(nil),                   // 53: DropIndex
-	(*TruncateTable)(nil),               // 54: TruncateTable
-	(*ShowVariables)(nil),               // 55: ShowVariables
-	(*TableDef_DefType)(nil),            // 56: TableDef.DefType
+	(ForeignKeyDef_RefAction)(0),        // 5: ForeignKeyDef.RefAction
+	(PartitionByDef_PartitionType)(0),   // 6: PartitionByDef.PartitionType
+	(OrderBySpec_OrderByFlag)(0),        // 7: OrderBySpec.OrderByFlag
+	(Node_NodeType)(0),                  // 8: Node.NodeType
+	(Node_JoinFlag)(0),                  // 9: Node.JoinFlag
+	(Node_AggMode)(0),                   // 10: Node.AggMode
+	(Query_StatementType)(0),            // 11: Query.StatementType
+	(TransationControl_TclType)(0),      // 12: TransationControl.TclType
+	(TransationBegin_TransationMode)(0), // 13: TransationBegin.TransationMode
+	(DataDefinition_DdlType)(0),         // 14: DataDefinition.DdlType
+	(AlterTableAction_ActionType)(0),    // 15: AlterTableAction.ActionType
+	(*Type)(nil),                        // 16: Type
+	(*Const)(nil),                       // 17: Const
+	(*ParamRef)(nil),                    // 18: ParamRef
+	(*VarRef)(nil),                      // 19: VarRef
+	(*ColRef)(nil),                      // 20: ColRef
+	(*CorrColRef)(nil),                  // 21: CorrColRef
+	(*ExprList)(nil),                    // 22: ExprList
+	(*SubQuery)(nil),                    // 23: SubQuery
+	(*ObjectRef)(nil),                   // 24: ObjectRef
+	(*Function)(nil),                    // 25: Function
+	(*Expr)(nil),                        // 26: Expr
+	(*DefaultExpr)(nil),                 // 27: DefaultExpr
+	(*ColDef)(nil),                      // 28: ColDef
+	(*IndexDef)(nil),                    // 29: IndexDef
+	(*PrimaryKeyDef)(nil),               // 30: PrimaryKeyDef
+	(*Property)(nil),                    // 31: Property
+	(*PropertiesDef)(nil),               // 32: PropertiesDef
+	(*UniqueIndexDef)(nil),              // 33: UniqueIndexDef
+	(*CheckDef)(nil),                    // 34: CheckDef
+	(*ForeignKeyDef)(nil),               // 35: ForeignKeyDef
+	(*PartitionByDef)(nil),              // 36: PartitionByDef
+	(*TableDef)(nil),                    // 37: TableDef
+	(*Cost)(nil),                        // 38: Cost
+	(*ColData)(nil),                     // 39: ColData
+	(*RowsetData)(nil),                  // 40: RowsetData
+	(*OrderBySpec)(nil),                 // 41: OrderBySpec
+	(*WindowSpec)(nil),                  // 42: WindowSpec
+	(*UpdateList)(nil),                  // 43: UpdateList
+	(*Node)(nil),                        // 44: Node
+	(*Query)(nil),                       // 45: Query
+	(*TransationControl)(nil),           // 46: TransationControl
+	(*TransationBegin)(nil),             // 47: TransationBegin
+	(*TransationCommit)(nil),            // 48: TransationCommit
+	(*TransationRollback)(nil),          // 49: TransationRollback
+	(*Plan)(nil),                        // 50: Plan
+	(*DataDefinition)(nil),              // 51: DataDefinition
+	(*CreateDatabase)(nil),              // 52: CreateDatabase
+	(*AlterDatabase)(nil),               // 53: AlterDatabase
+	(*DropDatabase)(nil),                // 54: DropDatabase
+	(*CreateTable)(nil),                 // 55: CreateTable
+	(*AlterTable)(nil),                  // 56: AlterTable
+	(*AlterTableAction)(nil),            // 57: AlterTableAction
+	(*DropTable)(nil),                   // 58: DropTable
+	(*CreateIndex)(nil),                 // 59: CreateIndex
+	(*AlterIndex)(nil),                  // 60: AlterIndex
+	(*DropIndex)(nil),                   // 61: DropIndex
+	(*TruncateTable)(nil),               // 62: TruncateTable
+	(*ShowVariables)(nil),               // 63: ShowVariables
+	(*PartitionByDef_Partition)(nil),    // 64: PartitionByDef.Partition
+	(*TableDef_DefType)(nil),            // 65: TableDef.DefType
 }
 var file_plan_proto_depIdxs = []int32{
 	2,  // 0: Type.id:type_name -> Type.TypeId
-	23, // 1: ExprList.list:type_name -> Expr
-	21, // 2: Function.func:type_name -> ObjectRef
-	23, // 3: Function.args:type_name -> Expr
-	13, // 4: Expr.typ:type_name -> Type
-	14, // 5: Expr.c:type_name -> Const
-	15, // 6: Expr.p:type_name -> ParamRef
-	16, // 7: Expr.v:type_name -> VarRef
-	17, // 8: Expr.col:type_name -> ColRef
-	22, // 9: Expr.f:type_name -> Function
-	19, // 10: Expr.list:type_name -> ExprList
-	20, // 11: Expr.sub:type_name -> SubQuery
-	18, // 12: Expr.corr:type_name -> CorrColRef
-	23, // 13: DefaultExpr.value:type_name -> Expr
+	26, // 1: ExprList.list:type_name -> Expr
+	24, // 2: Function.func:type_name -> ObjectRef
+	26, // 3: Function.args:type_name -> Expr
+	16, // 4: Expr.typ:type_name -> Type
+	17, // 5: Expr.c:type_name -> Const
+	18, // 6: Expr.p:type_name -> ParamRef
+	19, // 7: Expr.v:type_name -> VarRef
+	20, // 8: Expr.col:type_name -> ColRef
+	25, // 9: Expr.f:type_name -> Function
+	22, // 10: Expr.list:type_name -> ExprList
+	23, // 11: Expr.sub:type_name -> SubQuery
+	21, // 12: Expr.corr:type_name -> CorrColRef
+	26, // 13: DefaultExpr.value:type_name -> Expr
 	0,  // 14: ColDef.alg:type_name -> CompressType
-	13, // 15: ColDef.typ:type_name -> Type
-	24, // 16: ColDef.default:type_name -> DefaultExpr
+	16, // 15: ColDef.typ:type_name -> Type
+	27, // 16: ColDef.default:type_name -> DefaultExpr
 	4,  // 17: IndexDef.typ:type_name -> IndexDef.IndexType
-	28, // 18: PropertiesDef.properties:type_name -> Property
-	25, // 19: TableDef.cols:type_name -> ColDef
-	56, // 20: TableDef.defs:type_name -> TableDef.DefType
-	30, // 21: RowsetData.schema:type_name -> TableDef
-	32, // 22: RowsetData.cols:type_name -> ColData
-	23, // 23: OrderBySpec.expr:type_name -> Expr
-	5,  // 24: OrderBySpec.flag:type_name -> OrderBySpec.OrderByFlag
-	23, // 25: WindowSpec.partition_by:type_name -> Expr
-	34, // 26: WindowSpec.order_by:type_name -> OrderBySpec
-	23, // 27: UpdateList.columns:type_name -> Expr
-	23, // 28: UpdateList.values:type_name -> Expr
-	6,  // 29: Node.node_type:type_name -> Node.NodeType
-	31, // 30: Node.cost:type_name -> Cost
-	23, // 31: Node.project_list:type_name -> Expr
-	7,  // 32: Node.join_type:type_name -> Node.JoinFlag
-	23, // 33: Node.on_list:type_name -> Expr
-	23, // 34: Node.where_list:type_name -> Expr
-	23, // 35: Node.group_by:type_name -> Expr
-	23, // 36: Node.grouping_set:type_name -> Expr
-	23, // 37: Node.agg_list:type_name -> Expr
-	34, // 38: Node.order_by:type_name -> OrderBySpec
-	36, // 39: Node.update_list:type_name -> UpdateList
-	35, // 40: Node.win_spec:type_name -> WindowSpec
-	23, // 41: Node.limit:type_name -> Expr
-	23, // 42: Node.offset:type_name -> Expr
-	30, // 43: Node.table_def:type_name -> TableDef
-	21, // 44: Node.obj_ref:type_name -> ObjectRef
-	33, // 45: Node.rowset_data:type_name -> RowsetData
-	9,  // 46: Query.stmt_type:type_name -> Query.StatementType
-	37, // 47: Query.nodes:type_name -> Node
-	23, // 48: Query.params:type_name -> Expr
-	10, // 49: TransationControl.tcl_type:type_name -> TransationControl.TclType
-	40, // 50: TransationControl.begin:type_name -> TransationBegin
-	41, // 51: TransationControl.commit:type_name -> TransationCommit
-	42, // 52: TransationControl.rollback:type_name -> TransationRollback
-	11, // 53: TransationBegin.mode:type_name -> TransationBegin.TransationMode
-	1,  // 54: TransationCommit.completion_type:type_name -> TransationCompletionType
-	1,  // 55: TransationRollback.completion_type:type_name -> TransationCompletionType
-	38, // 56: Plan.query:type_name -> Query
-	39, // 57: Plan.tcl:type_name -> TransationControl
-	44, // 58: Plan.ddl:type_name -> DataDefinition
-	12, // 59: DataDefinition.ddl_type:type_name -> DataDefinition.DdlType
-	38, // 60: DataDefinition.query:type_name -> Query
-	45, // 61: DataDefinition.create_database:type_name -> CreateDatabase
-	46, // 62: DataDefinition.alter_database:type_name -> AlterDatabase
-	47, // 63: DataDefinition.drop_database:type_name -> DropDatabase
-	48, // 64: DataDefinition.create_table:type_name -> CreateTable
-	49, // 65: DataDefinition.alter_table:type_name -> AlterTable
-	50, // 66: DataDefinition.drop_table:type_name -> DropTable
-	51, // 67: DataDefinition.create_index:type_name -> CreateIndex
-	52, // 68: DataDefinition.alter_index:type_name -> AlterIndex
-	53, // 69: DataDefinition.drop_index:type_name -> DropIndex
-	54, // 70: DataDefinition.truncate_table:type_name -> TruncateTable
-	55, // 71: DataDefinition.show_variables:type_name -> ShowVariables
-	30, // 72: CreateTable.table_def:type_name -> TableDef
-	30, // 73: AlterTable.table_def:type_name -> TableDef
-	23, // 74: ShowVariables.where:type_name -> Expr
-	27, // 75: TableDef.DefType.pk:type_name -> PrimaryKeyDef
-	26, // 76: TableDef.DefType.idx:type_name -> IndexDef
-	29, // 77: TableDef.DefType.properties:type_name -> PropertiesDef
-	78, // [78:78] is the sub-list for method output_type
-	78, // [78:78] is the sub-list for method input_type
-	78, // [78:78] is the sub-list for extension type_name
-	78, // [78:78] is the sub-list for extension extendee
-	0,  // [0:78] is the sub-list for field type_name
+	31, // 18: PropertiesDef.properties:type_name -> Property
+	5,  // 19: ForeignKeyDef.on_delete:type_name -> ForeignKeyDef.RefAction
+	5,  // 20: ForeignKeyDef.on_update:type_name -> ForeignKeyDef.RefAction
+	6,  // 21: PartitionByDef.type:type_name -> PartitionByDef.PartitionType
+	64, // 22: PartitionByDef.partitions:type_name -> PartitionByDef.Partition
+	28, // 23: TableDef.cols:type_name -> ColDef
+	65, // 24: TableDef.defs:type_name -> TableDef.DefType
+	37, // 25: RowsetData.schema:type_name -> TableDef
+	39, // 26: RowsetData.cols:type_name -> ColData
+	26, // 27: OrderBySpec.expr:type_name -> Expr
+	7,  // 28: OrderBySpec.flag:type_name -> OrderBySpec.OrderByFlag
+	26, // 29: WindowSpec.partition_by:type_name -> Expr
+	41, // 30: WindowSpec.order_by:type_name -> OrderBySpec
+	26, // 31: UpdateList.columns:type_name -> Expr
+	26, // 32: UpdateList.values:type_name -> Expr
+	8,  // 33: Node.node_type:type_name -> Node.NodeType
+	38, // 34: Node.cost:type_name -> Cost
+	26, // 35: Node.project_list:type_name -> Expr
+	9,  // 36: Node.join_type:type_name -> Node.JoinFlag
+	26, // 37: Node.on_list:type_name -> Expr
+	26, // 38: Node.where_list:type_name -> Expr
+	26, // 39: Node.group_by:type_name -> Expr
+	26, // 40: Node.grouping_set:type_name -> Expr
+	26, // 41: Node.agg_list:type_name -> Expr
+	41, // 42: Node.order_by:type_name -> OrderBySpec
+	43, // 43: Node.update_list:type_name -> UpdateList
+	42, // 44: Node.win_spec:type_name -> WindowSpec
+	26, // 45: Node.limit:type_name -> Expr
+	26, // 46: Node.offset:type_name -> Expr
+	37, // 47: Node.table_def:type_name -> TableDef
+	24, // 48: Node.obj_ref:type_name -> ObjectRef
+	40, // 49: Node.rowset_data:type_name -> RowsetData
+	11, // 50: Query.stmt_type:type_name -> Query.StatementType
+	44, // 51: Query.nodes:type_name -> Node
+	26, // 52: Query.params:type_name -> Expr
+	12, // 53: TransationControl.tcl_type:type_name -> TransationControl.TclType
+	47, // 54: TransationControl.begin:type_name -> TransationBegin
+	48, // 55: TransationControl.commit:type_name -> TransationCommit
+	49, // 56: TransationControl.rollback:type_name -> TransationRollback
+	13, // 57: TransationBegin.mode:type_name -> TransationBegin.TransationMode
+	1,  // 58: TransationCommit.completion_type:type_name -> TransationCompletionType
+	1,  // 59: TransationRollback.completion_type:type_name -> TransationCompletionType
+	45, // 60: Plan.query:type_name -> Query
+	46, // 61: Plan.tcl:type_name -> TransationControl
+	51, // 62: Plan.ddl:type_name -> DataDefinition
+	14, // 63: DataDefinition.ddl_type:type_name -> DataDefinition.DdlType
+	45, // 64: DataDefinition.query:type_name -> Query
+	52, // 65: DataDefinition.create_database:type_name -> CreateDatabase
+	53, // 66: DataDefinition.alter_database:type_name -> AlterDatabase
+	54, // 67: DataDefinition.drop_database:type_name -> DropDatabase
+	55, // 68: DataDefinition.create_table:type_name -> CreateTable
+	56, // 69: DataDefinition.alter_table:type_name -> AlterTable
+	58, // 70: DataDefinition.drop_table:type_name -> DropTable
+	59, // 71: DataDefinition.create_index:type_name -> CreateIndex
+	60, // 72: DataDefinition.alter_index:type_name -> AlterIndex
+	61, // 73: DataDefinition.drop_index:type_name -> DropIndex
+	62, // 74: DataDefinition.truncate_table:type_name -> TruncateTable
+	63, // 75: DataDefinition.show_variables:type_name -> ShowVariables
+	37, // 76: CreateTable.table_def:type_name -> TableDef
+	37, // 77: AlterTable.table_def:type_name -> TableDef
+	57, // 78: AlterTable.actions:type_name -> AlterTableAction
+	15, // 79: AlterTableAction.action_type:type_name -> AlterTableAction.ActionType
+	28, // 80: AlterTableAction.col_def:type_name -> ColDef
+	36, // 81: AlterTableAction.partition_def:type_name -> PartitionByDef
+	26, // 82: ShowVariables.where:type_name -> Expr
+	26, // 83: PartitionByDef.Partition.values:type_name -> Expr
+	30, // 84: TableDef.DefType.pk:type_name -> PrimaryKeyDef
+	29, // 85: TableDef.DefType.idx:type_name -> IndexDef
+	32, // 86: TableDef.DefType.properties:type_name -> PropertiesDef
+	33, // 87: TableDef.DefType.unique:type_name -> UniqueIndexDef
+	34, // 88: TableDef.DefType.check:type_name -> CheckDef
+	35, // 89: TableDef.DefType.fk:type_name -> ForeignKeyDef
+	36, // 90: TableDef.DefType.partition:type_name -> PartitionByDef
+	91, // [91:91] is the sub-list for method output_type
+	91, // [91:91] is the sub-list for method input_type
+	91, // [91:91] is the sub-list for extension type_name
+	91, // [91:91] is the sub-list for extension extendee
+	0,  // [0:91] is the sub-list for field type_name
 }
 
 func init() { file_plan_proto_init() }
@@ -5115,7 +6033,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TableDef); i {
+			switch v := v.(*UniqueIndexDef); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5127,7 +6045,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Cost); i {
+			switch v := v.(*CheckDef); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5139,7 +6057,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ColData); i {
+			switch v := v.(*ForeignKeyDef); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5151,7 +6069,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RowsetData); i {
+			switch v := v.(*PartitionByDef); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5163,7 +6081,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OrderBySpec); i {
+			switch v := v.(*TableDef); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5175,7 +6093,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WindowSpec); i {
+			switch v := v.(*Cost); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5187,7 +6105,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateList); i {
+			switch v := v.(*ColData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5199,7 +6117,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Node); i {
+			switch v := v.(*RowsetData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5211,7 +6129,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Query); i {
+			switch v := v.(*OrderBySpec); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5223,7 +6141,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransationControl); i {
+			switch v := v.(*WindowSpec); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5235,7 +6153,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransationBegin); i {
+			switch v := v.(*UpdateList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5247,7 +6165,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransationCommit); i {
+			switch v := v.(*Node); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5259,7 +6177,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransationRollback); i {
+			switch v := v.(*Query); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5271,7 +6189,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Plan); i {
+			switch v := v.(*TransationControl); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5283,7 +6201,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DataDefinition); i {
+			switch v := v.(*TransationBegin); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5295,7 +6213,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateDatabase); i {
+			switch v := v.(*TransationCommit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5307,7 +6225,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AlterDatabase); i {
+			switch v := v.(*TransationRollback); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5319,7 +6237,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DropDatabase); i {
+			switch v := v.(*Plan); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5331,7 +6249,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateTable); i {
+			switch v := v.(*DataDefinition); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5343,7 +6261,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AlterTable); i {
+			switch v := v.(*CreateDatabase); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5355,7 +6273,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DropTable); i {
+			switch v := v.(*AlterDatabase); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5367,7 +6285,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateIndex); i {
+			switch v := v.(*DropDatabase); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5379,7 +6297,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AlterIndex); i {
+			switch v := v.(*CreateTable); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5391,7 +6309,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DropIndex); i {
+			switch v := v.(*AlterTable); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5403,7 +6321,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TruncateTable); i {
+			switch v := v.(*AlterTableAction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5415,7 +6333,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ShowVariables); i {
+			switch v := v.(*DropTable); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5427,6 +6345,78 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateIndex); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plan_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AlterIndex); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plan_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DropIndex); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plan_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TruncateTable); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plan_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShowVariables); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plan_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PartitionByDef_Partition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plan_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TableDef_DefType); i {
 			case 0:
 				return &v.state
@@ -5454,17 +6444,17 @@ func file_plan_proto_init() {
 		(*Expr_Sub)(nil),
 		(*Expr_Corr)(nil),
 	}
-	file_plan_proto_msgTypes[26].OneofWrappers = []interface{}{
+	file_plan_proto_msgTypes[30].OneofWrappers = []interface{}{
 		(*TransationControl_Begin)(nil),
 		(*TransationControl_Commit)(nil),
 		(*TransationControl_Rollback)(nil),
 	}
-	file_plan_proto_msgTypes[30].OneofWrappers = []interface{}{
+	file_plan_proto_msgTypes[34].OneofWrappers = []interface{}{
 		(*Plan_Query)(nil),
 		(*Plan_Tcl)(nil),
 		(*Plan_Ddl)(nil),
 	}
-	file_plan_proto_msgTypes[31].OneofWrappers = []interface{}{
+	file_plan_proto_msgTypes[35].OneofWrappers = []interface{}{
 		(*DataDefinition_CreateDatabase)(nil),
 		(*DataDefinition_AlterDatabase)(nil),
 		(*DataDefinition_DropDatabase)(nil),
@@ -5477,18 +6467,22 @@ func file_plan_proto_init() {
 		(*DataDefinition_TruncateTable)(nil),
 		(*DataDefinition_ShowVariables)(nil),
 	}
-	file_plan_proto_msgTypes[43].OneofWrappers = []interface{}{
+	file_plan_proto_msgTypes[49].OneofWrappers = []interface{}{
 		(*TableDef_DefType_Pk)(nil),
 		(*TableDef_DefType_Idx)(nil),
 		(*TableDef_DefType_Properties)(nil),
+		(*TableDef_DefType_Unique)(nil),
+		(*TableDef_DefType_Check)(nil),
+		(*TableDef_DefType_Fk)(nil),
+		(*TableDef_DefType_Partition)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_plan_proto_rawDesc,
-			NumEnums:      13,
-			NumMessages:   44,
+			NumEnums:      16,
+			NumMessages:   50,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -46,18 +46,20 @@ func EvalExpr(bat *batch.Batch, proc *process.Process, expr *plan.Expr) (*vector
 			nulls.Add(vec.Nsp, 0)
 		} else {
 			switch t.C.GetValue().(type) {
-			case *plan.Const_Dval:
+			case *plan.Const_Ival:
 				vec = vector.NewConst(constIType)
 				data := mempool.Alloc(proc.Mp.Mp, 8)
 				cs := encoding.DecodeInt64Slice(data)
 				cs = cs[:1]
 				cs[0] = t.C.GetIval()
-			case *plan.Const_Ival:
+				vec.Data, vec.Col = data, cs
+			case *plan.Const_Dval:
 				vec = vector.NewConst(constDType)
 				data := mempool.Alloc(proc.Mp.Mp, 8)
 				cs := encoding.DecodeFloat64Slice(data)
 				cs = cs[:1]
 				cs[0] = t.C.GetDval()
+				vec.Data, vec.Col = data, cs
 			case *plan.Const_Sval:
 				vec = vector.NewConst(constSType)
 				vec.Col = []string{t.C.GetSval()}
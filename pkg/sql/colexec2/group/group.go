@@ -133,10 +133,26 @@ func (ctr *Container) process(ap *Argument, proc *process.Process) (bool, error)
 }
 
 func (ctr *Container) processWithGroup(ap *Argument, proc *process.Process) (bool, error) {
-	var err error
-
 	bat := proc.Reg.InputBatch
 	if bat == nil {
+		return ctr.flush(ap, proc)
+	}
+	if len(bat.Zs) == 0 {
+		return false, nil
+	}
+	defer bat.Clean(proc.Mp)
+	proc.Reg.InputBatch = &batch.Batch{}
+	if err := ctr.processBatch(bat, ap, proc); err != nil {
+		ctr.clean(proc)
+		return false, err
+	}
+	return false, nil
+}
+
+// flush returns the groups in memory. If some rows were spilled, every
+// following call aggregates and returns one of the spilled partitions.
+func (ctr *Container) flush(ap *Argument, proc *process.Process) (bool, error) {
+	for {
 		if ctr.bat != nil {
 			switch ctr.typ {
 			case H8:
@@ -152,14 +168,55 @@ func (ctr *Container) processWithGroup(ap *Argument, proc *process.Process) (boo
 			}
 			proc.Reg.InputBatch = ctr.bat
 			ctr.bat = nil
+			proc.Tr.Set(0)
+			for _, f := range ctr.spill.files {
+				if f.Rows() == 0 {
+					f.Close()
+					continue
+				}
+				ctr.spill.pending = append(ctr.spill.pending, partition{
+					level: ctr.spill.level + 1,
+					file:  f,
+				})
+			}
+			ctr.spill.files = nil
+			return len(ctr.spill.pending) == 0, nil
+		}
+		if len(ctr.spill.pending) == 0 {
+			return true, nil
+		}
+		pt := ctr.spill.pending[0]
+		ctr.spill.pending = ctr.spill.pending[1:]
+		ctr.spill.level = pt.level
+		err := ctr.processPartition(pt.file, ap, proc)
+		pt.file.Close()
+		if err != nil {
+			ctr.clean(proc)
+			return false, err
 		}
-		return true, nil
 	}
-	if len(bat.Zs) == 0 {
-		return false, nil
+}
+
+func (ctr *Container) processPartition(f *colexec.SpillFile, ap *Argument, proc *process.Process) error {
+	for {
+		bat, err := f.Read(proc)
+		if err != nil {
+			return err
+		}
+		if bat == nil {
+			return nil
+		}
+		err = ctr.processBatch(bat, ap, proc)
+		bat.Clean(proc.Mp)
+		if err != nil {
+			return err
+		}
 	}
-	defer bat.Clean(proc.Mp)
-	proc.Reg.InputBatch = &batch.Batch{}
+}
+
+func (ctr *Container) processBatch(bat *batch.Batch, ap *Argument, proc *process.Process) error {
+	var err error
+
 	if len(ctr.aggVecs) == 0 {
 		ctr.aggVecs = make([]evalVector, len(ap.Aggs))
 	}
@@ -171,7 +228,7 @@ func (ctr *Container) processWithGroup(ap *Argument, proc *process.Process) (boo
 					vector.Clean(ctr.aggVecs[j].vec, proc.Mp)
 				}
 			}
-			return false, nil
+			return nil
 		}
 		ctr.aggVecs[i].vec = vec
 		ctr.aggVecs[i].needFree = true
@@ -200,7 +257,7 @@ func (ctr *Container) processWithGroup(ap *Argument, proc *process.Process) (boo
 					vector.Clean(ctr.groupVecs[j].vec, proc.Mp)
 				}
 			}
-			return false, nil
+			return nil
 		}
 		ctr.groupVecs[i].vec = vec
 		ctr.groupVecs[i].needFree = true
@@ -246,9 +303,10 @@ func (ctr *Container) processWithGroup(ap *Argument, proc *process.Process) (boo
 		ctr.bat.Rs = make([]ring.Ring, len(ap.Aggs))
 		for i, agg := range ap.Aggs {
 			if ctr.bat.Rs[i], err = aggregate.New(agg.Op, ctr.aggVecs[i].vec.Typ); err != nil {
-				return false, err
+				return err
 			}
 		}
+		ctr.rows = 0
 		ctr.keyOffs = make([]uint32, UnitLimit)
 		ctr.zKeyOffs = make([]uint32, UnitLimit)
 		ctr.inserted = make([]uint8, UnitLimit)
@@ -298,11 +356,64 @@ func (ctr *Container) processWithGroup(ap *Argument, proc *process.Process) (boo
 		err = ctr.processHStr(bat, ap, proc)
 	}
 	if err != nil {
+		return err
+	}
+	if ctr.spill.files != nil {
+		for i, sels := range ctr.spill.sels {
+			if len(sels) == 0 {
+				continue
+			}
+			if err := ctr.spill.files[i].WriteRows(bat, sels, proc); err != nil {
+				return err
+			}
+			ctr.spill.sels[i] = sels[:0]
+		}
+		return nil
+	}
+	if colexec.NeedSpill(proc, ctr.size()) && ctr.spill.level < colexec.SpillMaxLevel {
+		return ctr.startSpill(proc)
+	}
+	return nil
+}
+
+// startSpill makes the rows of groups not in memory be spilled.
+func (ctr *Container) startSpill(proc *process.Process) error {
+	ctr.spill.files = make([]*colexec.SpillFile, colexec.SpillPartitions)
+	ctr.spill.sels = make([][]int64, colexec.SpillPartitions)
+	for i := range ctr.spill.files {
+		f, err := colexec.NewSpillFile(proc)
+		if err != nil {
+			return err
+		}
+		ctr.spill.files[i] = f
+	}
+	return nil
+}
+
+// size returns the memory size of the groups and the hash table.
+func (ctr *Container) size() int64 {
+	if ctr.typ == H8 {
+		return colexec.BatchSize(ctr.bat) + int64(ctr.rows)*int64(unsafe.Sizeof(hashtable.Int64HashMapCell{}))
+	}
+	return colexec.BatchSize(ctr.bat) + int64(ctr.rows)*int64(unsafe.Sizeof(hashtable.StringHashMapCell{}))
+}
+
+func (ctr *Container) clean(proc *process.Process) {
+	if ctr.bat != nil {
 		ctr.bat.Clean(proc.Mp)
 		ctr.bat = nil
-		return false, err
 	}
-	return false, err
+	proc.Tr.Set(0)
+	for _, f := range ctr.spill.files {
+		if f != nil {
+			f.Close()
+		}
+	}
+	for _, pt := range ctr.spill.pending {
+		pt.file.Close()
+	}
+	ctr.spill.files = nil
+	ctr.spill.pending = nil
 }
 
 func (ctr *Container) processH0(bat *batch.Batch, ap *Argument, proc *process.Process) error {
@@ -344,9 +455,14 @@ func (ctr *Container) processH8(bat *batch.Batch, ap *Argument, proc *process.Pr
 			}
 		}
 		ctr.hashes[0] = 0
-		ctr.intHashMap.InsertBatch(n, ctr.hashes, unsafe.Pointer(&ctr.h8.keys[0]), ctr.values)
-		if err := ctr.batchFill(i, n, bat, ap, proc); err != nil {
-			return err
+		if ctr.spill.files != nil {
+			ctr.intHashMap.FindBatch(n, ctr.hashes, unsafe.Pointer(&ctr.h8.keys[0]), ctr.values)
+			ctr.spillFill(i, n, bat)
+		} else {
+			ctr.intHashMap.InsertBatch(n, ctr.hashes, unsafe.Pointer(&ctr.h8.keys[0]), ctr.values)
+			if err := ctr.batchFill(i, n, bat, ap, proc); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
@@ -380,9 +496,14 @@ func (ctr *Container) processH24(bat *batch.Batch, ap *Argument, proc *process.P
 				fillStringGroup(ctr, vec, ctr.h24.keys, n, 24, i)
 			}
 		}
-		ctr.strHashMap.InsertString24Batch(ctr.strHashStates, ctr.h24.keys[:n], ctr.values)
-		if err := ctr.batchFill(i, n, bat, ap, proc); err != nil {
-			return err
+		if ctr.spill.files != nil {
+			ctr.strHashMap.FindString24Batch(ctr.strHashStates, ctr.h24.keys[:n], ctr.values)
+			ctr.spillFill(i, n, bat)
+		} else {
+			ctr.strHashMap.InsertString24Batch(ctr.strHashStates, ctr.h24.keys[:n], ctr.values)
+			if err := ctr.batchFill(i, n, bat, ap, proc); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
@@ -416,9 +537,14 @@ func (ctr *Container) processH32(bat *batch.Batch, ap *Argument, proc *process.P
 				fillStringGroup(ctr, vec, ctr.h32.keys, n, 32, i)
 			}
 		}
-		ctr.strHashMap.InsertString32Batch(ctr.strHashStates, ctr.h32.keys[:n], ctr.values)
-		if err := ctr.batchFill(i, n, bat, ap, proc); err != nil {
-			return err
+		if ctr.spill.files != nil {
+			ctr.strHashMap.FindString32Batch(ctr.strHashStates, ctr.h32.keys[:n], ctr.values)
+			ctr.spillFill(i, n, bat)
+		} else {
+			ctr.strHashMap.InsertString32Batch(ctr.strHashStates, ctr.h32.keys[:n], ctr.values)
+			if err := ctr.batchFill(i, n, bat, ap, proc); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
@@ -452,9 +578,14 @@ func (ctr *Container) processH40(bat *batch.Batch, ap *Argument, proc *process.P
 				fillStringGroup(ctr, vec, ctr.h40.keys, n, 40, i)
 			}
 		}
-		ctr.strHashMap.InsertString40Batch(ctr.strHashStates, ctr.h40.keys[:n], ctr.values)
-		if err := ctr.batchFill(i, n, bat, ap, proc); err != nil {
-			return err
+		if ctr.spill.files != nil {
+			ctr.strHashMap.FindString40Batch(ctr.strHashStates, ctr.h40.keys[:n], ctr.values)
+			ctr.spillFill(i, n, bat)
+		} else {
+			ctr.strHashMap.InsertString40Batch(ctr.strHashStates, ctr.h40.keys[:n], ctr.values)
+			if err := ctr.batchFill(i, n, bat, ap, proc); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
@@ -507,9 +638,14 @@ func (ctr *Container) processHStr(bat *batch.Batch, ap *Argument, proc *process.
 				ctr.hstr.keys[k] = append(ctr.hstr.keys[k], hashtable.StrKeyPadding[l:]...)
 			}
 		}
-		ctr.strHashMap.InsertStringBatch(ctr.strHashStates, ctr.hstr.keys[:n], ctr.values)
-		if err := ctr.batchFill(i, n, bat, ap, proc); err != nil {
-			return err
+		if ctr.spill.files != nil {
+			ctr.strHashMap.FindStringBatch(ctr.strHashStates, ctr.hstr.keys[:n], ctr.values)
+			ctr.spillFill(i, n, bat)
+		} else {
+			ctr.strHashMap.InsertStringBatch(ctr.strHashStates, ctr.hstr.keys[:n], ctr.values)
+			if err := ctr.batchFill(i, n, bat, ap, proc); err != nil {
+				return err
+			}
 		}
 		for k := 0; k < n; k++ {
 			ctr.hstr.keys[k] = ctr.hstr.keys[k][:0]
@@ -549,6 +685,27 @@ func (ctr *Container) batchFill(i int, n int, bat *batch.Batch, ap *Argument, pr
 	return nil
 }
 
+// spillFill aggregates the rows of groups in memory and collects the other
+// rows into the partitions they are spilled to.
+func (ctr *Container) spillFill(i int, n int, bat *batch.Batch) {
+	for k, v := range ctr.values[:n] {
+		if v == 0 {
+			h := ctr.hashes[k]
+			if ctr.typ != H8 {
+				h = ctr.strHashStates[k][0]
+			}
+			p := colexec.SpillPartition(h, ctr.spill.level)
+			ctr.spill.sels[p] = append(ctr.spill.sels[p], int64(i+k))
+			continue
+		}
+		ai := int64(v) - 1
+		ctr.bat.Zs[ai] += bat.Zs[i+k]
+		for j, r := range ctr.bat.Rs {
+			r.Fill(ai, int64(i+k), bat.Zs[i+k], ctr.aggVecs[j].vec)
+		}
+	}
+}
+
 func fillGroup[T1, T2 any](ctr *Container, vec *vector.Vector, keys []T2, n int, sz uint32, start int) {
 	vs := vector.DecodeFixedCol[T1](vec, int(sz))
 	if !nulls.Any(vec.Nsp) {
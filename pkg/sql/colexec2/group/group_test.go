@@ -26,6 +26,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/encoding"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/aggregate"
+	"github.com/matrixorigin/matrixone/pkg/sql/testutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
@@ -132,6 +133,63 @@ func TestGroup(t *testing.T) {
 	}
 }
 
+func TestGroupSpill(t *testing.T) {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	for _, pos := range []int32{0, 1} {
+		for _, size := range []int64{1, 64 << 10} {
+			proc := process.New(mheap.New(gm))
+			proc.Lim.Size = size
+			proc.Lim.SpillDir = t.TempDir()
+			arg := &Argument{
+				Exprs: []*plan.Expr{newExpression(pos)},
+				Aggs:  []aggregate.Aggregate{{Op: aggregate.Sum, E: newExpression(0)}},
+			}
+			require.NoError(t, Prepare(proc, arg))
+			expected := make(map[int64]int64)
+			for _, start := range []int64{0, 500, 1000, 3000, 0} {
+				for i := start; i < start+1000; i++ {
+					expected[i] += i
+				}
+				bat, err := testutil.MakeBatch(proc, []types.Type{{Oid: types.T_int64, Size: 8}, {Oid: types.T_varchar, Size: 24}}, 1000, func(_ int, row int64) int64 {
+					return start + row
+				})
+				require.NoError(t, err)
+				proc.Reg.InputBatch = bat
+				_, err = Call(proc, arg)
+				require.NoError(t, err)
+			}
+			sums := make(map[int64]int64)
+			for end := false; !end; {
+				var err error
+
+				proc.Reg.InputBatch = nil
+				end, err = Call(proc, arg)
+				require.NoError(t, err)
+				bat := proc.Reg.InputBatch
+				if bat == nil {
+					break
+				}
+				vs := bat.Rs[0].Eval(bat.Zs).Col.([]int64)
+				for i := range bat.Zs {
+					key := int64(0)
+					if pos == 0 {
+						key = bat.Vecs[0].Col.([]int64)[i]
+					} else {
+						key, err = strconv.ParseInt(string(bat.Vecs[0].Col.(*types.Bytes).Get(int64(i))), 10, 64)
+						require.NoError(t, err)
+					}
+					_, ok := sums[key]
+					require.False(t, ok)
+					sums[key] = vs[i]
+				}
+				bat.Clean(proc.Mp)
+			}
+			require.Equal(t, expected, sums)
+		}
+	}
+}
+
 func BenchmarkGroup(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		hm := host.New(1 << 30)
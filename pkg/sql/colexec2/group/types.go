@@ -19,6 +19,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	colexec "github.com/matrixorigin/matrixone/pkg/sql/colexec2"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/aggregate"
 )
 
@@ -34,6 +35,12 @@ const (
 	HStr
 )
 
+// partition is a part of the input rows spilled to disk.
+type partition struct {
+	level int
+	file  *colexec.SpillFile
+}
+
 type evalVector struct {
 	needFree bool
 	vec      *vector.Vector
@@ -74,6 +81,16 @@ type Container struct {
 	hstr struct {
 		keys [][]byte
 	}
+	// spill holds the state of partitioned spilling. Once the groups
+	// in memory come close to the memory limitation, rows of new groups
+	// are written to partition files and aggregated after the groups
+	// in memory are returned.
+	spill struct {
+		level   int                  // level of the partition being aggregated
+		files   []*colexec.SpillFile // files of the partitions, nil if not spilling
+		sels    [][]int64            // rows of the current batch for each partition
+		pending []partition          // spilled partitions to be aggregated
+	}
 	bat *batch.Batch
 }
 
@@ -23,6 +23,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	colexec "github.com/matrixorigin/matrixone/pkg/sql/colexec2"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -94,14 +95,28 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 		case Build:
 			if err := ctr.build(ap, proc); err != nil {
 				ctr.state = End
+				ctr.clean(proc)
 				return true, err
 			}
 			ctr.state = Probe
 		case Probe:
-			bat := <-proc.Reg.MergeReceivers[0].Ch
-			if bat == nil {
+			bat, err := ctr.nextProbe(proc)
+			if err != nil {
 				ctr.state = End
-				ctr.bat.Clean(proc.Mp)
+				ctr.clean(proc)
+				proc.Reg.InputBatch = nil
+				return true, err
+			}
+			if bat == nil {
+				if ctr.bat != nil {
+					ctr.bat.Clean(proc.Mp)
+					ctr.bat = nil
+				}
+				if ctr.nextPass() {
+					ctr.state = Build
+				} else {
+					ctr.state = End
+				}
 				continue
 			}
 			if len(bat.Zs) == 0 {
@@ -109,6 +124,7 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 			}
 			if err := ctr.probe(bat, ap, proc); err != nil {
 				ctr.state = End
+				ctr.clean(proc)
 				proc.Reg.InputBatch = nil
 				return true, err
 			}
@@ -120,6 +136,56 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 	}
 }
 
+func (ctr *Container) nextBuild(proc *process.Process) (*batch.Batch, error) {
+	if ctr.pass != nil {
+		return ctr.pass.build.Read(proc)
+	}
+	return <-proc.Reg.MergeReceivers[1].Ch, nil
+}
+
+func (ctr *Container) nextProbe(proc *process.Process) (*batch.Batch, error) {
+	if ctr.pass != nil {
+		return ctr.pass.probe.Read(proc)
+	}
+	return <-proc.Reg.MergeReceivers[0].Ch, nil
+}
+
+// nextPass queues the partitions spilled by the finished pass and starts
+// to join the next queued partition, it returns false if there is none.
+func (ctr *Container) nextPass() bool {
+	if ctr.pass != nil {
+		ctr.pass.build.Close()
+		ctr.pass.probe.Close()
+		ctr.pass = nil
+	}
+	for i, f := range ctr.spill.build {
+		if f.Rows() == 0 || ctr.spill.probe[i].Rows() == 0 {
+			f.Close()
+			ctr.spill.probe[i].Close()
+			continue
+		}
+		ctr.spill.pending = append(ctr.spill.pending, partition{
+			level: ctr.spill.level + 1,
+			build: f,
+			probe: ctr.spill.probe[i],
+		})
+	}
+	ctr.spill.build = nil
+	ctr.spill.probe = nil
+	if len(ctr.spill.pending) == 0 {
+		return false
+	}
+	pt := ctr.spill.pending[0]
+	ctr.spill.pending = ctr.spill.pending[1:]
+	ctr.pass = &pt
+	ctr.spill.level = ctr.pass.level
+	ctr.rows = 0
+	ctr.sels = nil
+	ctr.strHashMap = &hashtable.StringHashMap{}
+	ctr.strHashMap.Init()
+	return true
+}
+
 func (ctr *Container) build(ap *Argument, proc *process.Process) error {
 	if ap.IsPreBuild {
 		bat := <-proc.Reg.MergeReceivers[1].Ch
@@ -127,17 +193,26 @@ func (ctr *Container) build(ap *Argument, proc *process.Process) error {
 		ctr.strHashMap = bat.Ht.(*hashtable.StringHashMap)
 		return nil
 	}
-	if ctr.flg {
-		var err error
-
-		for {
-			bat := <-proc.Reg.MergeReceivers[1].Ch
-			if bat == nil {
-				break
-			}
-			if len(bat.Zs) == 0 {
-				continue
+	for {
+		bat, err := ctr.nextBuild(proc)
+		if err != nil {
+			return err
+		}
+		if bat == nil {
+			break
+		}
+		if len(bat.Zs) == 0 {
+			continue
+		}
+		if ctr.spill.build != nil {
+			err = ctr.spillBatch(bat, ap.Conditions[1], ctr.spill.build, false, proc)
+			bat.Clean(proc.Mp)
+			if err != nil {
+				return err
 			}
+			continue
+		}
+		if ctr.flg {
 			if ctr.bat == nil {
 				ctr.bat = batch.NewWithSize(len(bat.Vecs))
 				for i, vec := range bat.Vecs {
@@ -150,59 +225,23 @@ func (ctr *Container) build(ap *Argument, proc *process.Process) error {
 				return err
 			}
 			bat.Clean(proc.Mp)
+		} else if err = ctr.insert(bat, ap, proc); err != nil {
+			return err
+		}
+		if colexec.NeedSpill(proc, ctr.size()) && ctr.spill.level < colexec.SpillMaxLevel {
+			if err = ctr.startSpill(proc); err != nil {
+				return err
+			}
 		}
+	}
+	if ctr.flg && ctr.bat != nil {
 		count := len(ctr.bat.Zs)
 		for i := 0; i < count; i += UnitLimit {
 			n := count - i
 			if n > UnitLimit {
 				n = UnitLimit
 			}
-			copy(ctr.zValues[:n], OneInt64s[:n])
-			for _, cond := range ap.Conditions[1] {
-				vec := ctr.bat.Vecs[cond.Pos]
-				switch typLen := vec.Typ.Oid.FixedLength(); typLen {
-				case 1:
-					fillGroupStr[uint8](ctr, vec, n, 1, i)
-				case 2:
-					fillGroupStr[uint16](ctr, vec, n, 2, i)
-				case 4:
-					fillGroupStr[uint32](ctr, vec, n, 4, i)
-				case 8:
-					fillGroupStr[uint64](ctr, vec, n, 8, i)
-				case -8:
-					if cond.Scale > 0 {
-						fillGroupStrWithDecimal64(ctr, vec, n, i, cond.Scale)
-					} else {
-						fillGroupStr[uint64](ctr, vec, n, 8, i)
-					}
-				case -16:
-					if cond.Scale > 0 {
-						fillGroupStrWithDecimal128(ctr, vec, n, i, cond.Scale)
-					} else {
-						fillGroupStr[types.Decimal128](ctr, vec, n, 16, i)
-					}
-				default:
-					vs := vec.Col.(*types.Bytes)
-					if !nulls.Any(vec.Nsp) {
-						for k := 0; k < n; k++ {
-							ctr.keys[k] = append(ctr.keys[k], vs.Get(int64(i+k))...)
-						}
-					} else {
-						for k := 0; k < n; k++ {
-							if vec.Nsp.Np.Contains(uint64(i + k)) {
-								ctr.zValues[i] = 0
-							} else {
-								ctr.keys[k] = append(ctr.keys[k], vs.Get(int64(i+k))...)
-							}
-						}
-					}
-				}
-			}
-			for k := 0; k < n; k++ {
-				if l := len(ctr.keys[k]); l < 16 {
-					ctr.keys[k] = append(ctr.keys[k], hashtable.StrKeyPadding[l:]...)
-				}
-			}
+			ctr.fillKeys(ctr.bat, ap.Conditions[1], i, n)
 			ctr.strHashMap.InsertStringBatchWithRing(ctr.zValues, ctr.strHashStates, ctr.keys[:n], ctr.values)
 			for k, v := range ctr.values[:n] {
 				if ctr.zValues[k] == 0 {
@@ -218,110 +257,64 @@ func (ctr *Container) build(ap *Argument, proc *process.Process) error {
 				ctr.keys[k] = ctr.keys[k][:0]
 			}
 		}
-		return nil
 	}
-	for {
-		bat := <-proc.Reg.MergeReceivers[1].Ch
-		if bat == nil {
-			return nil
-		}
-		if len(bat.Zs) == 0 {
-			continue
+	return nil
+}
+
+func (ctr *Container) insert(bat *batch.Batch, ap *Argument, proc *process.Process) error {
+	defer bat.Clean(proc.Mp)
+	if ctr.bat == nil {
+		ctr.bat = batch.NewWithSize(len(bat.Vecs))
+		for _, pos := range ctr.poses {
+			ctr.bat.Vecs[pos] = vector.New(bat.Vecs[pos].Typ)
 		}
-		if ctr.bat == nil {
-			ctr.bat = batch.NewWithSize(len(bat.Vecs))
-			for _, pos := range ctr.poses {
-				ctr.bat.Vecs[pos] = vector.New(bat.Vecs[pos].Typ)
-			}
+	}
+	count := len(bat.Zs)
+	for i := 0; i < count; i += UnitLimit {
+		n := count - i
+		if n > UnitLimit {
+			n = UnitLimit
 		}
-		count := len(bat.Zs)
-		for i := 0; i < count; i += UnitLimit {
-			n := count - i
-			if n > UnitLimit {
-				n = UnitLimit
-			}
-			copy(ctr.zValues[:n], OneInt64s[:n])
-			for _, cond := range ap.Conditions[1] {
-				vec := bat.Vecs[cond.Pos]
-				switch typLen := vec.Typ.Oid.FixedLength(); typLen {
-				case 1:
-					fillGroupStr[uint8](ctr, vec, n, 1, i)
-				case 2:
-					fillGroupStr[uint16](ctr, vec, n, 2, i)
-				case 4:
-					fillGroupStr[uint32](ctr, vec, n, 4, i)
-				case 8:
-					fillGroupStr[uint64](ctr, vec, n, 8, i)
-				case -8:
-					if cond.Scale > 0 {
-						fillGroupStrWithDecimal64(ctr, vec, n, i, cond.Scale)
-					} else {
-						fillGroupStr[uint64](ctr, vec, n, 8, i)
-					}
-				case -16:
-					if cond.Scale > 0 {
-						fillGroupStrWithDecimal128(ctr, vec, n, i, cond.Scale)
-					} else {
-						fillGroupStr[types.Decimal128](ctr, vec, n, 16, i)
-					}
-				default:
-					vs := vec.Col.(*types.Bytes)
-					if !nulls.Any(vec.Nsp) {
-						for k := 0; k < n; k++ {
-							ctr.keys[k] = append(ctr.keys[k], vs.Get(int64(i+k))...)
-						}
-					} else {
-						for k := 0; k < n; k++ {
-							if vec.Nsp.Np.Contains(uint64(i + k)) {
-								ctr.zValues[i] = 0
-							} else {
-								ctr.keys[k] = append(ctr.keys[k], vs.Get(int64(i+k))...)
-							}
-						}
-					}
-				}
+		ctr.fillKeys(bat, ap.Conditions[1], i, n)
+		ctr.strHashMap.InsertStringBatchWithRing(ctr.zValues, ctr.strHashStates, ctr.keys[:n], ctr.values)
+		cnt := 0
+		copy(ctr.inserted[:n], ctr.zInserted[:n])
+		for k, v := range ctr.values[:n] {
+			if ctr.zValues[k] == 0 {
+				continue
 			}
-			for k := 0; k < n; k++ {
-				if l := len(ctr.keys[k]); l < 16 {
-					ctr.keys[k] = append(ctr.keys[k], hashtable.StrKeyPadding[l:]...)
-				}
+			if v > ctr.rows {
+				cnt++
+				ctr.rows++
+				ctr.inserted[k] = 1
+				ctr.bat.Zs = append(ctr.bat.Zs, 0)
 			}
-			ctr.strHashMap.InsertStringBatchWithRing(ctr.zValues, ctr.strHashStates, ctr.keys[:n], ctr.values)
-			cnt := 0
-			copy(ctr.inserted[:n], ctr.zInserted[:n])
-			for k, v := range ctr.values[:n] {
-				if ctr.zValues[k] == 0 {
-					continue
-				}
-				if v > ctr.rows {
-					cnt++
-					ctr.rows++
-					ctr.inserted[k] = 1
-					ctr.bat.Zs = append(ctr.bat.Zs, 0)
+			ai := int64(v) - 1
+			ctr.bat.Zs[ai] += bat.Zs[i+k]
+		}
+		if cnt > 0 {
+			for _, pos := range ctr.poses {
+				if err := vector.UnionBatch(ctr.bat.Vecs[pos], bat.Vecs[pos], int64(i), cnt, ctr.inserted[:n], proc.Mp); err != nil {
+					ctr.bat.Clean(proc.Mp)
+					return err
 				}
-				ai := int64(v) - 1
-				ctr.bat.Zs[ai] += bat.Zs[i+k]
-			}
-			if cnt > 0 {
-				for _, pos := range ctr.poses {
-					if err := vector.UnionBatch(ctr.bat.Vecs[pos], bat.Vecs[pos], int64(i), cnt, ctr.inserted[:n], proc.Mp); err != nil {
-						bat.Clean(proc.Mp)
-						ctr.bat.Clean(proc.Mp)
-						return err
-					}
 
-				}
-			}
-			for k := 0; k < n; k++ {
-				ctr.keys[k] = ctr.keys[k][:0]
 			}
-			bat.Clean(proc.Mp)
+		}
+		for k := 0; k < n; k++ {
+			ctr.keys[k] = ctr.keys[k][:0]
 		}
 	}
+	return nil
 }
 
 func (ctr *Container) probe(bat *batch.Batch, ap *Argument, proc *process.Process) error {
 	defer bat.Clean(proc.Mp)
+	if ctr.spill.probe != nil {
+		if err := ctr.spillBatch(bat, ap.Conditions[0], ctr.spill.probe, true, proc); err != nil {
+			return err
+		}
+	}
 	rbat := batch.NewWithSize(len(ap.Result))
 	for i, rp := range ap.Result {
 		if rp.Rel == 0 {
@@ -336,52 +329,7 @@ func (ctr *Container) probe(bat *batch.Batch, ap *Argument, proc *process.Proces
 		if n > UnitLimit {
 			n = UnitLimit
 		}
-		copy(ctr.zValues[:n], OneInt64s[:n])
-		for _, cond := range ap.Conditions[0] {
-			vec := bat.Vecs[cond.Pos]
-			switch typLen := vec.Typ.Oid.FixedLength(); typLen {
-			case 1:
-				fillGroupStr[uint8](ctr, vec, n, 1, i)
-			case 2:
-				fillGroupStr[uint16](ctr, vec, n, 2, i)
-			case 4:
-				fillGroupStr[uint32](ctr, vec, n, 4, i)
-			case 8:
-				fillGroupStr[uint64](ctr, vec, n, 8, i)
-			case -8:
-				if cond.Scale > 0 {
-					fillGroupStrWithDecimal64(ctr, vec, n, i, cond.Scale)
-				} else {
-					fillGroupStr[uint64](ctr, vec, n, 8, i)
-				}
-			case -16:
-				if cond.Scale > 0 {
-					fillGroupStrWithDecimal128(ctr, vec, n, i, cond.Scale)
-				} else {
-					fillGroupStr[types.Decimal128](ctr, vec, n, 16, i)
-				}
-			default:
-				vs := vec.Col.(*types.Bytes)
-				if !nulls.Any(vec.Nsp) {
-					for k := 0; k < n; k++ {
-						ctr.keys[k] = append(ctr.keys[k], vs.Get(int64(i+k))...)
-					}
-				} else {
-					for k := 0; k < n; k++ {
-						if vec.Nsp.Np.Contains(uint64(i + k)) {
-							ctr.zValues[i] = 0
-						} else {
-							ctr.keys[k] = append(ctr.keys[k], vs.Get(int64(i+k))...)
-						}
-					}
-				}
-			}
-		}
-		for k := 0; k < n; k++ {
-			if l := len(ctr.keys[k]); l < 16 {
-				ctr.keys[k] = append(ctr.keys[k], hashtable.StrKeyPadding[l:]...)
-			}
-		}
+		ctr.fillKeys(bat, ap.Conditions[0], i, n)
 		ctr.strHashMap.FindStringBatch(ctr.strHashStates, ctr.keys[:n], ctr.values)
 		for k := 0; k < n; k++ {
 			ctr.keys[k] = ctr.keys[k][:0]
@@ -434,6 +382,144 @@ func (ctr *Container) probe(bat *batch.Batch, ap *Argument, proc *process.Proces
 	return nil
 }
 
+// startSpill makes the following build rows be spilled. The rows in memory
+// are kept, so every probe row is both joined with them and spilled to be
+// joined with the spilled build rows of its partition later.
+func (ctr *Container) startSpill(proc *process.Process) error {
+	ctr.spill.build = make([]*colexec.SpillFile, colexec.SpillPartitions)
+	ctr.spill.probe = make([]*colexec.SpillFile, colexec.SpillPartitions)
+	ctr.spill.sels = make([][]int64, colexec.SpillPartitions)
+	for i := 0; i < colexec.SpillPartitions; i++ {
+		var err error
+
+		if ctr.spill.build[i], err = colexec.NewSpillFile(proc); err != nil {
+			return err
+		}
+		if ctr.spill.probe[i], err = colexec.NewSpillFile(proc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// spillBatch writes the rows of the batch into the files of the partitions
+// their join keys belong to. Rows with null keys never match and are dropped,
+// so are the rows of partitions without build rows if isProbe is true.
+func (ctr *Container) spillBatch(bat *batch.Batch, conds []Condition, files []*colexec.SpillFile, isProbe bool, proc *process.Process) error {
+	count := len(bat.Zs)
+	for i := 0; i < count; i += UnitLimit {
+		n := count - i
+		if n > UnitLimit {
+			n = UnitLimit
+		}
+		ctr.fillKeys(bat, conds, i, n)
+		hashtable.AesBytesBatchGenHashStates(&ctr.keys[0], &ctr.strHashStates[0], n)
+		for k := 0; k < n; k++ {
+			ctr.keys[k] = ctr.keys[k][:0]
+			if ctr.zValues[k] == 0 {
+				continue
+			}
+			p := colexec.SpillPartition(ctr.strHashStates[k][0], ctr.spill.level)
+			if isProbe && ctr.spill.build[p].Rows() == 0 {
+				continue
+			}
+			ctr.spill.sels[p] = append(ctr.spill.sels[p], int64(i+k))
+		}
+	}
+	for i, sels := range ctr.spill.sels {
+		if len(sels) == 0 {
+			continue
+		}
+		if err := files[i].WriteRows(bat, sels, proc); err != nil {
+			return err
+		}
+		ctr.spill.sels[i] = sels[:0]
+	}
+	return nil
+}
+
+// size returns the memory size of the build rows and the hash table.
+func (ctr *Container) size() int64 {
+	return colexec.BatchSize(ctr.bat) + int64(ctr.strHashMap.Cardinality())*int64(unsafe.Sizeof(hashtable.StringHashMapCell{}))
+}
+
+func (ctr *Container) clean(proc *process.Process) {
+	if ctr.bat != nil {
+		ctr.bat.Clean(proc.Mp)
+		ctr.bat = nil
+	}
+	proc.Tr.Set(0)
+	if ctr.pass != nil {
+		ctr.pass.build.Close()
+		ctr.pass.probe.Close()
+		ctr.pass = nil
+	}
+	for i := range ctr.spill.build {
+		if ctr.spill.build[i] != nil {
+			ctr.spill.build[i].Close()
+		}
+		if ctr.spill.probe[i] != nil {
+			ctr.spill.probe[i].Close()
+		}
+	}
+	for _, pt := range ctr.spill.pending {
+		pt.build.Close()
+		pt.probe.Close()
+	}
+	ctr.spill.build = nil
+	ctr.spill.probe = nil
+	ctr.spill.pending = nil
+}
+
+func (ctr *Container) fillKeys(bat *batch.Batch, conds []Condition, i, n int) {
+	copy(ctr.zValues[:n], OneInt64s[:n])
+	for _, cond := range conds {
+		vec := bat.Vecs[cond.Pos]
+		switch typLen := vec.Typ.Oid.FixedLength(); typLen {
+		case 1:
+			fillGroupStr[uint8](ctr, vec, n, 1, i)
+		case 2:
+			fillGroupStr[uint16](ctr, vec, n, 2, i)
+		case 4:
+			fillGroupStr[uint32](ctr, vec, n, 4, i)
+		case 8:
+			fillGroupStr[uint64](ctr, vec, n, 8, i)
+		case -8:
+			if cond.Scale > 0 {
+				fillGroupStrWithDecimal64(ctr, vec, n, i, cond.Scale)
+			} else {
+				fillGroupStr[uint64](ctr, vec, n, 8, i)
+			}
+		case -16:
+			if cond.Scale > 0 {
+				fillGroupStrWithDecimal128(ctr, vec, n, i, cond.Scale)
+			} else {
+				fillGroupStr[types.Decimal128](ctr, vec, n, 16, i)
+			}
+		default:
+			vs := vec.Col.(*types.Bytes)
+			if !nulls.Any(vec.Nsp) {
+				for k := 0; k < n; k++ {
+					ctr.keys[k] = append(ctr.keys[k], vs.Get(int64(i+k))...)
+				}
+			} else {
+				for k := 0; k < n; k++ {
+					if vec.Nsp.Np.Contains(uint64(i + k)) {
+						ctr.zValues[k] = 0
+					} else {
+						ctr.keys[k] = append(ctr.keys[k], vs.Get(int64(i+k))...)
+					}
+				}
+			}
+		}
+	}
+	for k := 0; k < n; k++ {
+		if l := len(ctr.keys[k]); l < 16 {
+			ctr.keys[k] = append(ctr.keys[k], hashtable.StrKeyPadding[l:]...)
+		}
+	}
+}
+
 func fillGroupStr[T any](ctr *Container, vec *vector.Vector, n int, sz int, start int) {
 	vs := vector.DecodeFixedCol[T](vec, sz)
 	data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*sz)[:len(vs)*sz]
@@ -25,6 +25,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/sql/testutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
@@ -200,6 +201,70 @@ func TestJoin(t *testing.T) {
 	}
 }
 
+func TestJoinSpill(t *testing.T) {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	ts := []types.Type{{Oid: types.T_int64}, {Oid: types.T_int64}}
+	cs := [][]Condition{
+		{{0, 0, types.Type{Oid: types.T_int64}}},
+		{{0, 0, types.Type{Oid: types.T_int64}}},
+	}
+	builds := []int64{0, 200, 400}
+	probes := []int64{0, 100, 650}
+	for _, rp := range [][]ResultPos{{{0, 0}, {1, 0}}, {{0, 0}, {1, 1}}} {
+		expected := make(map[[2]int64]int64)
+		for _, i := range probes {
+			for _, j := range builds {
+				for k := i; k < i+300; k++ {
+					if k >= j && k < j+300 {
+						expected[[2]int64{k, k * int64(rp[1].Pos*9+1)}]++
+					}
+				}
+			}
+		}
+		for _, size := range []int64{1, 16 << 10} {
+			tc := newTestCase(mheap.New(gm), []bool{false, false}, ts, rp, cs)
+			tc.proc.Lim.Size = size
+			tc.proc.Lim.SpillDir = t.TempDir()
+			require.NoError(t, Prepare(tc.proc, tc.arg))
+			go func() {
+				for _, start := range probes {
+					bat, err := testutil.MakeBatch(tc.proc, []types.Type{{Oid: types.T_int64, Size: 8}, {Oid: types.T_int64, Size: 8}}, 300, func(col int, row int64) int64 {
+						return (start + row) * int64(col*9+1)
+					})
+					require.NoError(t, err)
+					tc.proc.Reg.MergeReceivers[0].Ch <- bat
+				}
+				tc.proc.Reg.MergeReceivers[0].Ch <- nil
+			}()
+			go func() {
+				for _, start := range builds {
+					bat, err := testutil.MakeBatch(tc.proc, []types.Type{{Oid: types.T_int64, Size: 8}, {Oid: types.T_int64, Size: 8}}, 300, func(col int, row int64) int64 {
+						return (start + row) * int64(col*9+1)
+					})
+					require.NoError(t, err)
+					tc.proc.Reg.MergeReceivers[1].Ch <- bat
+				}
+				tc.proc.Reg.MergeReceivers[1].Ch <- nil
+			}()
+			result := make(map[[2]int64]int64)
+			for {
+				ok, err := Call(tc.proc, tc.arg)
+				require.NoError(t, err)
+				if ok {
+					break
+				}
+				bat := tc.proc.Reg.InputBatch
+				for i, z := range bat.Zs {
+					result[[2]int64{bat.Vecs[0].Col.([]int64)[i], bat.Vecs[1].Col.([]int64)[i]}] += z
+				}
+				bat.Clean(tc.proc.Mp)
+			}
+			require.Equal(t, expected, result)
+		}
+	}
+}
+
 func BenchmarkJoin(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		hm := host.New(1 << 30)
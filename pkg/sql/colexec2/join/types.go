@@ -18,6 +18,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	colexec "github.com/matrixorigin/matrixone/pkg/sql/colexec2"
 )
 
 const (
@@ -32,6 +33,14 @@ const (
 
 var OneInt64s []int64
 
+// partition is a pair of spilled build and probe rows with the same
+// join keys hash bits.
+type partition struct {
+	level int
+	build *colexec.SpillFile
+	probe *colexec.SpillFile
+}
+
 type Container struct {
 	flg           bool // incicates if addition columns need to be copied
 	state         int
@@ -51,6 +60,21 @@ type Container struct {
 
 	bat *batch.Batch
 
+	// pass is the partition being joined, nil for the rows from the
+	// receivers.
+	pass *partition
+	// spill holds the state of the hybrid hash join. Once the build rows
+	// come close to the memory limitation, the rows of both sides are
+	// partitioned by the hash of the join keys into files, and then the
+	// partitions are joined one by one.
+	spill struct {
+		level   int                  // level of the partitions being spilled
+		build   []*colexec.SpillFile // build rows of each partition
+		probe   []*colexec.SpillFile // probe rows of each partition
+		sels    [][]int64            // rows of the current batch for each partition
+		pending []partition          // spilled partitions to be joined
+	}
+
 	decimal64Slice  []types.Decimal64
 	decimal128Slice []types.Decimal128
 }
@@ -23,6 +23,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	colexec "github.com/matrixorigin/matrixone/pkg/sql/colexec2"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -96,12 +97,20 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 				ctr.state = End
 				return true, err
 			}
+			// a pre-built hash table is reported by the operator building it
+			if !ap.IsPreBuild {
+				if err := proc.Tr.Set(ctr.size()); err != nil {
+					ctr.state = End
+					return true, err
+				}
+			}
 			ctr.state = Probe
 		case Probe:
 			bat := <-proc.Reg.MergeReceivers[0].Ch
 			if bat == nil {
 				ctr.state = End
 				ctr.bat.Clean(proc.Mp)
+				proc.Tr.Set(0)
 				continue
 			}
 			if len(bat.Zs) == 0 {
@@ -501,3 +510,11 @@ func fillGroupStrWithDecimal128(ctr *Container, vec *vector.Vector, n int, start
 		}
 	}
 }
+
+func (ctr *Container) size() int64 {
+	size := colexec.BatchSize(ctr.bat) + int64(ctr.strHashMap.Cardinality())*int64(unsafe.Sizeof(hashtable.StringHashMapCell{}))
+	for _, sels := range ctr.sels {
+		size += int64(cap(sels)) * 8
+	}
+	return size
+}
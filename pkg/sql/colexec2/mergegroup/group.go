@@ -23,6 +23,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	colexec "github.com/matrixorigin/matrixone/pkg/sql/colexec2"
 	"github.com/matrixorigin/matrixone/pkg/vectorize/add"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
@@ -58,6 +59,7 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 			}
 			proc.Reg.InputBatch = ctr.bat
 			ctr.bat = nil
+			proc.Tr.Set(0)
 			return true, nil
 		case End:
 			proc.Reg.InputBatch = nil
@@ -92,6 +94,9 @@ func (ctr *Container) build(proc *process.Process) error {
 		if err := ctr.process(bat, proc); err != nil {
 			return err
 		}
+		if err := proc.Tr.Set(ctr.size()); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -541,3 +546,10 @@ func fillGroupStr[T any](ctr *Container, vec *vector.Vector, n int, sz int, star
 		}
 	}
 }
+
+func (ctr *Container) size() int64 {
+	if ctr.typ == H8 {
+		return colexec.BatchSize(ctr.bat) + int64(ctr.rows)*int64(unsafe.Sizeof(hashtable.Int64HashMapCell{}))
+	}
+	return colexec.BatchSize(ctr.bat) + int64(ctr.rows)*int64(unsafe.Sizeof(hashtable.StringHashMapCell{}))
+}
@@ -52,16 +52,21 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 		case Build:
 			if err := ctr.build(ap, proc); err != nil {
 				ctr.state = End
+				ctr.clean(proc)
 				return true, err
 			}
 			ctr.state = Eval
 		case Eval:
+			if len(ctr.runs) > 0 {
+				return ctr.eval(proc)
+			}
 			for i := ctr.n; i < len(ctr.bat.Vecs); i++ {
 				vector.Clean(ctr.bat.Vecs[i], proc.Mp)
 			}
 			ctr.bat.Vecs = ctr.bat.Vecs[:ctr.n]
 			proc.Reg.InputBatch = ctr.bat
 			ctr.bat = nil
+			proc.Tr.Set(0)
 			ctr.state = End
 			return true, nil
 		default:
@@ -131,11 +136,213 @@ func (ctr *Container) build(ap *Argument, proc *process.Process) error {
 				}
 				bat.Clean(proc.Mp)
 			}
+			if colexec.NeedSpill(proc, colexec.BatchSize(ctr.bat)) {
+				if err := ctr.spillRun(proc); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if len(ctr.runs) > 0 {
+		if ctr.bat != nil {
+			if err := ctr.spillRun(proc); err != nil {
+				return err
+			}
 		}
+		return ctr.mergeRuns(proc)
 	}
 	return nil
 }
 
+// eval returns the next batch of the merged run.
+func (ctr *Container) eval(proc *process.Process) (bool, error) {
+	bat, err := ctr.runs[0].Read(proc)
+	if err != nil || bat == nil {
+		ctr.clean(proc)
+		ctr.state = End
+		proc.Reg.InputBatch = nil
+		return true, err
+	}
+	for i := ctr.n; i < len(bat.Vecs); i++ {
+		vector.Clean(bat.Vecs[i], proc.Mp)
+	}
+	bat.Vecs = bat.Vecs[:ctr.n]
+	proc.Reg.InputBatch = bat
+	return false, nil
+}
+
+// spillRun writes the sorted rows in memory into a new run.
+func (ctr *Container) spillRun(proc *process.Process) error {
+	run, err := colexec.NewSpillFile(proc)
+	if err != nil {
+		return err
+	}
+	ctr.runs = append(ctr.runs, run)
+	sels := make([]int64, len(ctr.bat.Zs))
+	for i := range sels {
+		sels[i] = int64(i)
+	}
+	err = run.WriteRows(ctr.bat, sels, proc)
+	ctr.bat.Clean(proc.Mp)
+	ctr.bat = nil
+	proc.Tr.Set(0)
+	return err
+}
+
+// mergeRuns merges the runs two by two until there is only one.
+func (ctr *Container) mergeRuns(proc *process.Process) error {
+	for len(ctr.runs) > 1 {
+		run, err := ctr.mergeRun(ctr.runs[0], ctr.runs[1], proc)
+		ctr.runs[0].Close()
+		ctr.runs[1].Close()
+		ctr.runs = ctr.runs[2:]
+		if err != nil {
+			return err
+		}
+		ctr.runs = append(ctr.runs, run)
+	}
+	return nil
+}
+
+func (ctr *Container) mergeRun(r1, r2 *colexec.SpillFile, proc *process.Process) (*colexec.SpillFile, error) {
+	var err error
+	var rbat, bat1, bat2 *batch.Batch
+
+	run, err := colexec.NewSpillFile(proc)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		for _, bat := range []*batch.Batch{rbat, bat1, bat2} {
+			if bat != nil {
+				bat.Clean(proc.Mp)
+			}
+		}
+		if err != nil {
+			run.Close()
+		}
+	}()
+	if bat1, err = ctr.readRun(r1, 0, proc); err != nil {
+		return nil, err
+	}
+	if bat2, err = ctr.readRun(r2, 1, proc); err != nil {
+		return nil, err
+	}
+	i, j := int64(0), int64(0)
+	for bat1 != nil && bat2 != nil {
+		if rbat == nil {
+			rbat = batch.NewWithSize(len(bat1.Vecs))
+			for k, vec := range bat1.Vecs {
+				rbat.Vecs[k] = vector.New(vec.Typ)
+			}
+		}
+		compareResult := 0
+		for _, pos := range ctr.poses {
+			compareResult = ctr.cmps[pos].Compare(0, 1, i, j)
+			if compareResult != 0 {
+				break
+			}
+		}
+		if compareResult <= 0 {
+			for k := range rbat.Vecs {
+				if err = vector.UnionOne(rbat.Vecs[k], bat1.Vecs[k], i, proc.Mp); err != nil {
+					return nil, err
+				}
+			}
+			rbat.Zs = append(rbat.Zs, bat1.Zs[i])
+			if i++; i == int64(len(bat1.Zs)) {
+				bat1.Clean(proc.Mp)
+				if bat1, err = ctr.readRun(r1, 0, proc); err != nil {
+					return nil, err
+				}
+				i = 0
+			}
+		} else {
+			for k := range rbat.Vecs {
+				if err = vector.UnionOne(rbat.Vecs[k], bat2.Vecs[k], j, proc.Mp); err != nil {
+					return nil, err
+				}
+			}
+			rbat.Zs = append(rbat.Zs, bat2.Zs[j])
+			if j++; j == int64(len(bat2.Zs)) {
+				bat2.Clean(proc.Mp)
+				if bat2, err = ctr.readRun(r2, 1, proc); err != nil {
+					return nil, err
+				}
+				j = 0
+			}
+		}
+		if len(rbat.Zs) >= colexec.SpillBatchRows {
+			if err = run.Write(rbat); err != nil {
+				return nil, err
+			}
+			rbat.Clean(proc.Mp)
+			rbat = nil
+		}
+	}
+	if rbat != nil {
+		if err = run.Write(rbat); err != nil {
+			return nil, err
+		}
+	}
+	if bat1 != nil {
+		err = copyRun(run, r1, bat1, i, proc)
+		bat1 = nil
+	} else if bat2 != nil {
+		err = copyRun(run, r2, bat2, j, proc)
+		bat2 = nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return run, nil
+}
+
+// readRun reads the next batch of a run and sets it as the idx-th vectors
+// of the compare structures.
+func (ctr *Container) readRun(r *colexec.SpillFile, idx int, proc *process.Process) (*batch.Batch, error) {
+	bat, err := r.Read(proc)
+	if err != nil || bat == nil {
+		return nil, err
+	}
+	for i, cmp := range ctr.cmps {
+		cmp.Set(idx, bat.GetVector(int32(i)))
+	}
+	return bat, nil
+}
+
+// copyRun copies the rest of run r, from the i-th row of bat, into run.
+func copyRun(run, r *colexec.SpillFile, bat *batch.Batch, i int64, proc *process.Process) error {
+	for bat != nil {
+		sels := make([]int64, 0, int64(len(bat.Zs))-i)
+		for ; i < int64(len(bat.Zs)); i++ {
+			sels = append(sels, i)
+		}
+		err := run.WriteRows(bat, sels, proc)
+		bat.Clean(proc.Mp)
+		if err != nil {
+			return err
+		}
+		if bat, err = r.Read(proc); err != nil {
+			return err
+		}
+		i = 0
+	}
+	return nil
+}
+
+func (ctr *Container) clean(proc *process.Process) {
+	if ctr.bat != nil {
+		ctr.bat.Clean(proc.Mp)
+		ctr.bat = nil
+	}
+	proc.Tr.Set(0)
+	for _, run := range ctr.runs {
+		run.Close()
+	}
+	ctr.runs = nil
+}
+
 func (ctr *Container) processBatch(bat2 *batch.Batch, proc *process.Process) error {
 	bat1 := ctr.bat
 	rbat := batch.NewWithSize(len(bat1.Vecs))
@@ -25,6 +25,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/encoding"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	order "github.com/matrixorigin/matrixone/pkg/sql/colexec2/order"
+	"github.com/matrixorigin/matrixone/pkg/sql/testutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
@@ -104,6 +105,60 @@ func TestOrder(t *testing.T) {
 	}
 }
 
+func TestOrderSpill(t *testing.T) {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	for _, desc := range []bool{false, true} {
+		for _, size := range []int64{1, 4 << 10} {
+			typ := order.Ascending
+			if desc {
+				typ = order.Descending
+			}
+			tc := newTestCase(mheap.New(gm), []bool{desc}, []types.Type{{Oid: types.T_int64}}, []order.Field{{E: newExpression(0), Type: typ}})
+			tc.proc.Lim.Size = size
+			tc.proc.Lim.SpillDir = t.TempDir()
+			require.NoError(t, Prepare(tc.proc, tc.arg))
+			for i := range tc.proc.Reg.MergeReceivers {
+				go func(i int) {
+					for j := 0; j < 6; j++ {
+						start := int64(i*6 + j)
+						bat, err := testutil.MakeBatch(tc.proc, []types.Type{{Oid: types.T_int64, Size: 8}}, 300, func(_ int, row int64) int64 {
+							if desc {
+								return start + (300-row-1)*12
+							}
+							return start + row*12
+						})
+						require.NoError(t, err)
+						tc.proc.Reg.MergeReceivers[i].Ch <- bat
+					}
+					tc.proc.Reg.MergeReceivers[i].Ch <- nil
+				}(i)
+			}
+			var vs []int64
+			for {
+				ok, err := Call(tc.proc, tc.arg)
+				require.NoError(t, err)
+				if bat := tc.proc.Reg.InputBatch; bat != nil {
+					require.Equal(t, 1, len(bat.Vecs))
+					vs = append(vs, bat.Vecs[0].Col.([]int64)...)
+					bat.Clean(tc.proc.Mp)
+				}
+				if ok {
+					break
+				}
+			}
+			require.Equal(t, 12*300, len(vs))
+			for i := range vs {
+				if desc {
+					require.Equal(t, int64(len(vs)-i-1), vs[i])
+				} else {
+					require.Equal(t, int64(i), vs[i])
+				}
+			}
+		}
+	}
+}
+
 func BenchmarkOrder(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		hm := host.New(1 << 30)
@@ -17,6 +17,7 @@ package mergeorder
 import (
 	"github.com/matrixorigin/matrixone/pkg/compare"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	colexec "github.com/matrixorigin/matrixone/pkg/sql/colexec2"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/order"
 )
 
@@ -33,6 +34,10 @@ type Container struct {
 	cmps  []compare.Compare // compare structures used to do sort work for attrs
 
 	bat *batch.Batch // bat store the result of merge-order
+
+	// runs are the sorted runs spilled to disk when bat comes close to the
+	// memory limitation, they are merged into one run by external merge sort.
+	runs []*colexec.SpillFile
 }
 
 type Argument struct {
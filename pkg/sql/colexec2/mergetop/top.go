@@ -128,6 +128,9 @@ func (ctr *Container) build(ap *Argument, proc *process.Process) error {
 				return err
 			}
 			bat.Clean(proc.Mp)
+			if err := proc.Tr.Set(colexec.BatchSize(ctr.bat)); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
@@ -199,6 +202,7 @@ func (ctr *Container) eval(limit int64, proc *process.Process) error {
 	ctr.bat.Vecs = ctr.bat.Vecs[:ctr.n]
 	proc.Reg.InputBatch = ctr.bat
 	ctr.bat = nil
+	proc.Tr.Set(0)
 	return nil
 }
 
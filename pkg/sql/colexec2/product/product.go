@@ -19,6 +19,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	colexec "github.com/matrixorigin/matrixone/pkg/sql/colexec2"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -48,6 +49,7 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 			if bat == nil {
 				ctr.state = End
 				ctr.bat.Clean(proc.Mp)
+				proc.Tr.Set(0)
 				continue
 			}
 			if len(bat.Zs) == 0 {
@@ -89,6 +91,10 @@ func (ctr *Container) build(ap *Argument, proc *process.Process) error {
 			return err
 		}
 		bat.Clean(proc.Mp)
+		if err = proc.Tr.Set(colexec.BatchSize(ctr.bat)); err != nil {
+			ctr.bat.Clean(proc.Mp)
+			return err
+		}
 	}
 	return nil
 }
@@ -53,13 +53,17 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 			return false, err
 		}
 		rbat.Vecs[i] = vec
-		for k := 0; k < len(bat.Vecs); k++ {
+	}
+	// the vectors passed through must not be freed with the input batch
+	for k := range bat.Vecs {
+		for _, vec := range rbat.Vecs {
 			if vec == bat.Vecs[k] {
-				bat.Vecs = append(bat.Vecs[:i], bat.Vecs[i+1:]...)
+				bat.Vecs[k] = nil
 				break
 			}
 		}
 	}
+	rbat.Zs = bat.Zs
 	bat.Clean(proc.Mp)
 	proc.Reg.InputBatch = rbat
 	return false, nil
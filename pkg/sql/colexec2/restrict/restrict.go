@@ -18,6 +18,7 @@ import (
 	"bytes"
 	"fmt"
 
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	colexec "github.com/matrixorigin/matrixone/pkg/sql/colexec2"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
@@ -44,8 +45,13 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 	}
 	bs := vec.Col.([]bool)
 	sels := make([]int64, 0, 8)
-	for i, b := range bs {
-		if b {
+	// a row the filter is NULL on is dropped
+	for i := range bat.Zs {
+		row := i
+		if vec.IsConst {
+			row = 0
+		}
+		if !vec.IsConstNull && !nulls.Contains(vec.Nsp, uint64(row)) && bs[row] {
 			sels = append(sels, int64(i))
 		}
 	}
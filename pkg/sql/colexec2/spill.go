@@ -0,0 +1,235 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package colexec2
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"os"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+const (
+	// SpillRatio is the part of the memory limitation an operator may use
+	// before it starts to write its state into spill files.
+	SpillRatio = 0.8
+	// SpillPartitions is the number of partitions an operator splits its
+	// input into when it spills.
+	SpillPartitions = 8
+	// SpillMaxLevel is the max recursion depth of partitioned spilling, a
+	// partition at this level is processed in memory whatever its size.
+	SpillMaxLevel = 8
+	// SpillBatchRows is the max rows of a batch written into a spill file.
+	SpillBatchRows = 8192
+)
+
+// SpillFile is a temporary file holding the batches an operator can not keep
+// in memory. Batches are read back in the order they were written, once the
+// first Read is issued the file can no longer be written.
+type SpillFile struct {
+	rows int64
+	f    *os.File
+	w    *bufio.Writer
+	r    *bufio.Reader
+	buf  bytes.Buffer
+}
+
+// NeedSpill reports whether an operator holding size bytes comes close to
+// the memory limitation of the query or of the guest mmu. The size is also
+// reported to the memory tracker of the operator, an operator the trackers
+// can not take needs to spill as well.
+func NeedSpill(proc *process.Process, size int64) bool {
+	if err := proc.Tr.Set(size); err != nil {
+		return true
+	}
+	if proc.Lim.Size > 0 && float64(size) >= float64(proc.Lim.Size)*SpillRatio {
+		return true
+	}
+	if proc.Mp != nil && proc.Mp.Gm != nil && proc.Mp.Gm.Limit > 0 {
+		return float64(proc.Mp.Gm.Size()+size) >= float64(proc.Mp.Gm.Limit)*SpillRatio
+	}
+	return false
+}
+
+// SpillPartition returns the partition a row of hash value h belongs to at
+// the given level, every level uses different bits of the hash value.
+func SpillPartition(h uint64, level int) int {
+	return int((h >> (level * 3)) % SpillPartitions)
+}
+
+// BatchSize returns the memory size of the vectors and rings of a batch.
+func BatchSize(bat *batch.Batch) int64 {
+	if bat == nil {
+		return 0
+	}
+	size := int64(len(bat.Zs) * 8)
+	for _, vec := range bat.Vecs {
+		if vec == nil {
+			continue
+		}
+		if col, ok := vec.Col.(*types.Bytes); ok {
+			size += int64(cap(col.Data) + len(col.Offsets)*8)
+		} else {
+			size += int64(cap(vec.Data))
+		}
+	}
+	for _, r := range bat.Rs {
+		size += int64(r.Size())
+	}
+	return size
+}
+
+// NewSpillFile creates a spill file in the spill directory of the process.
+// The file is unlinked at once, so that it is gone with the query even if
+// the query is aborted.
+func NewSpillFile(proc *process.Process) (*SpillFile, error) {
+	f, err := os.CreateTemp(proc.Lim.SpillDir, "spill-")
+	if err != nil {
+		return nil, err
+	}
+	os.Remove(f.Name())
+	return &SpillFile{
+		f: f,
+		w: bufio.NewWriter(f),
+	}, nil
+}
+
+// Rows returns the number of rows written into the file.
+func (s *SpillFile) Rows() int64 {
+	return s.rows
+}
+
+// Write appends the batch to the file, nil vectors are kept as nil.
+func (s *SpillFile) Write(bat *batch.Batch) error {
+	s.buf.Reset()
+	s.buf.Write(encoding.EncodeUint32(uint32(len(bat.Vecs))))
+	for _, vec := range bat.Vecs {
+		if vec == nil {
+			s.buf.Write(encoding.EncodeUint32(0))
+			continue
+		}
+		data, err := vec.Show()
+		if err != nil {
+			return err
+		}
+		s.buf.Write(encoding.EncodeUint32(uint32(len(data))))
+		s.buf.Write(data)
+	}
+	s.buf.Write(encoding.EncodeUint32(uint32(len(bat.Zs))))
+	if len(bat.Zs) > 0 {
+		s.buf.Write(encoding.EncodeInt64Slice(bat.Zs))
+	}
+	if _, err := s.w.Write(encoding.EncodeUint32(uint32(s.buf.Len()))); err != nil {
+		return err
+	}
+	if _, err := s.w.Write(s.buf.Bytes()); err != nil {
+		return err
+	}
+	s.rows += int64(len(bat.Zs))
+	return nil
+}
+
+// WriteRows appends the rows sels of the batch to the file.
+func (s *SpillFile) WriteRows(bat *batch.Batch, sels []int64, proc *process.Process) error {
+	for len(sels) > 0 {
+		n := len(sels)
+		if n > SpillBatchRows {
+			n = SpillBatchRows
+		}
+		rbat := batch.NewWithSize(len(bat.Vecs))
+		for i, vec := range bat.Vecs {
+			if vec == nil {
+				continue
+			}
+			rbat.Vecs[i] = vector.New(vec.Typ)
+			if err := vector.Union(rbat.Vecs[i], vec, sels[:n], proc.Mp); err != nil {
+				rbat.Clean(proc.Mp)
+				return err
+			}
+		}
+		rbat.Zs = make([]int64, n)
+		for i, sel := range sels[:n] {
+			rbat.Zs[i] = bat.Zs[sel]
+		}
+		err := s.Write(rbat)
+		rbat.Clean(proc.Mp)
+		if err != nil {
+			return err
+		}
+		sels = sels[n:]
+	}
+	return nil
+}
+
+// Read returns the next batch of the file, the batch is allocated from the
+// memory heap of the process. A nil batch is returned at the end of file.
+func (s *SpillFile) Read(proc *process.Process) (*batch.Batch, error) {
+	if s.r == nil {
+		if err := s.w.Flush(); err != nil {
+			return nil, err
+		}
+		if _, err := s.f.Seek(0, io.SeekStart); err != nil {
+			return nil, err
+		}
+		s.r = bufio.NewReader(s.f)
+	}
+	var head [4]byte
+	if _, err := io.ReadFull(s.r, head[:]); err != nil {
+		if err == io.EOF {
+			return nil, nil
+		}
+		return nil, err
+	}
+	data := make([]byte, encoding.DecodeUint32(head[:]))
+	if _, err := io.ReadFull(s.r, data); err != nil {
+		return nil, err
+	}
+	bat := batch.NewWithSize(int(encoding.DecodeUint32(data)))
+	data = data[4:]
+	for i := range bat.Vecs {
+		n := encoding.DecodeUint32(data)
+		data = data[4:]
+		if n == 0 {
+			continue
+		}
+		vec := vector.New(encoding.DecodeType(data[:encoding.TypeSize]))
+		if err := vec.Read(data[:n]); err != nil {
+			bat.Clean(proc.Mp)
+			return nil, err
+		}
+		dup, err := vector.Dup(vec, proc.Mp)
+		if err != nil {
+			bat.Clean(proc.Mp)
+			return nil, err
+		}
+		bat.Vecs[i] = dup
+		data = data[n:]
+	}
+	if n := encoding.DecodeUint32(data); n > 0 {
+		bat.Zs = append([]int64{}, encoding.DecodeInt64Slice(data[4:4+n*8])...)
+	}
+	return bat, nil
+}
+
+// Close closes the file and releases its disk space.
+func (s *SpillFile) Close() error {
+	return s.f.Close()
+}
@@ -0,0 +1,440 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package subquery
+
+import (
+	"bytes"
+	"unsafe"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	colexec "github.com/matrixorigin/matrixone/pkg/sql/colexec2"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+var markType = types.Type{Oid: types.T_bool, Size: 1}
+
+func String(arg interface{}, buf *bytes.Buffer) {
+	ap := arg.(*Argument)
+	switch ap.Typ {
+	case Semi:
+		buf.WriteString(" ⋉ ")
+	case Anti:
+		buf.WriteString(" ▷ ")
+	case Mark:
+		buf.WriteString(" mark join ")
+	case Single:
+		buf.WriteString(" single join ")
+	}
+}
+
+func Prepare(_ *process.Process, arg interface{}) error {
+	ap := arg.(*Argument)
+	ap.ctr = new(Container)
+	return nil
+}
+
+func Call(proc *process.Process, arg interface{}) (bool, error) {
+	ap := arg.(*Argument)
+	ctr := ap.ctr
+	for {
+		switch ctr.state {
+		case Build:
+			if err := ctr.build(ap, proc); err != nil {
+				ctr.state = End
+				return true, err
+			}
+			if err := proc.Tr.Set(ctr.size()); err != nil {
+				ctr.state = End
+				return true, err
+			}
+			ctr.state = Probe
+		case Probe:
+			bat := <-proc.Reg.MergeReceivers[0].Ch
+			if bat == nil {
+				ctr.state = End
+				ctr.bat.Clean(proc.Mp)
+				proc.Tr.Set(0)
+				continue
+			}
+			if len(bat.Zs) == 0 {
+				continue
+			}
+			if err := ctr.probe(bat, ap, proc); err != nil {
+				ctr.state = End
+				proc.Reg.InputBatch = nil
+				return true, err
+			}
+			return false, nil
+		default:
+			proc.Reg.InputBatch = nil
+			return true, nil
+		}
+	}
+}
+
+func (ctr *Container) build(ap *Argument, proc *process.Process) error {
+	var err error
+
+	ctr.bat = batch.NewWithSize(len(ap.Typs))
+	for i, typ := range ap.Typs {
+		ctr.bat.Vecs[i] = vector.New(typ)
+	}
+	for {
+		bat := <-proc.Reg.MergeReceivers[1].Ch
+		if bat == nil {
+			break
+		}
+		if len(bat.Zs) == 0 {
+			continue
+		}
+		if ctr.bat, err = ctr.bat.Append(proc.Mp, bat); err != nil {
+			bat.Clean(proc.Mp)
+			return err
+		}
+		bat.Clean(proc.Mp)
+		if err = proc.Tr.Set(colexec.BatchSize(ctr.bat)); err != nil {
+			return err
+		}
+	}
+	rows := len(ctr.bat.Zs)
+	keys := make([][]byte, rows)
+	for i := range keys {
+		keys[i] = []byte{}
+	}
+	if len(ap.Conditions) > 0 {
+		if keys, err = encodeKeys(keys, ctr.bat, ap.Conditions[1], proc); err != nil {
+			return err
+		}
+	}
+	ctr.groups = make(map[string][]int64)
+	for i, key := range keys {
+		if key != nil {
+			ctr.groups[string(key)] = append(ctr.groups[string(key)], int64(i))
+		}
+	}
+	if ap.Typ == Mark {
+		marks := make([][]byte, rows)
+		for i := range marks {
+			marks[i] = []byte{}
+		}
+		if ctr.marks, err = encodeKeys(marks, ctr.bat, ap.MarkCond[1:], proc); err != nil {
+			return err
+		}
+		// without other conditions the mark of a left row only depends on
+		// its equi-join keys and its side of the IN comparison.
+		if len(ap.Cond) == 0 {
+			ctr.markGroups = make(map[string]bool)
+			ctr.nullGroups = make(map[string]bool)
+			for i, key := range keys {
+				switch {
+				case key == nil:
+				case ctr.marks[i] == nil:
+					ctr.nullGroups[string(key)] = true
+				default:
+					ctr.markGroups[string(key)+string(ctr.marks[i])] = true
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (ctr *Container) probe(bat *batch.Batch, ap *Argument, proc *process.Process) error {
+	var err error
+
+	defer bat.Clean(proc.Mp)
+	count := len(bat.Zs)
+	keys := make([][]byte, count)
+	for i := range keys {
+		keys[i] = []byte{}
+	}
+	if len(ap.Conditions) > 0 {
+		if keys, err = encodeKeys(keys, bat, ap.Conditions[0], proc); err != nil {
+			return err
+		}
+	}
+	// sels holds the rows of the subquery joined with each left row
+	sels := make([][]int64, count)
+	for i, key := range keys {
+		if key != nil {
+			sels[i] = ctr.groups[string(key)]
+		}
+	}
+	if len(ap.Cond) > 0 {
+		if sels, err = ctr.filter(bat, sels, ap, proc); err != nil {
+			return err
+		}
+	}
+	rbat := batch.NewWithSize(len(ap.Result))
+	for i, rp := range ap.Result {
+		switch rp.Rel {
+		case 0:
+			rbat.Vecs[i] = vector.New(bat.Vecs[rp.Pos].Typ)
+		case 1:
+			rbat.Vecs[i] = vector.New(ctr.bat.Vecs[rp.Pos].Typ)
+		default:
+			rbat.Vecs[i] = vector.New(markType)
+		}
+	}
+	var marks [][]byte
+	if ap.Typ == Mark {
+		marks = make([][]byte, count)
+		for i := range marks {
+			marks[i] = []byte{}
+		}
+		if marks, err = encodeKeys(marks, bat, ap.MarkCond[:1], proc); err != nil {
+			rbat.Clean(proc.Mp)
+			return err
+		}
+	}
+	for i := 0; i < count; i++ {
+		var sel int64 = -1
+		var mark, null bool
+
+		switch ap.Typ {
+		case Semi:
+			if len(sels[i]) == 0 {
+				continue
+			}
+		case Anti:
+			if len(sels[i]) > 0 {
+				continue
+			}
+		case Single:
+			if len(sels[i]) > 1 || (len(sels[i]) == 1 && ctr.bat.Zs[sels[i][0]] > 1) {
+				rbat.Clean(proc.Mp)
+				return errors.New(errno.CardinalityViolation, "scalar subquery returns more than 1 row")
+			}
+			if len(sels[i]) == 1 {
+				sel = sels[i][0]
+			}
+		case Mark:
+			mark, null = ctr.mark(keys[i], marks[i], sels[i])
+		}
+		for j, rp := range ap.Result {
+			switch {
+			case rp.Rel == 0:
+				err = vector.UnionOne(rbat.Vecs[j], bat.Vecs[rp.Pos], int64(i), proc.Mp)
+			case rp.Rel == 1 && sel >= 0:
+				err = vector.UnionOne(rbat.Vecs[j], ctr.bat.Vecs[rp.Pos], sel, proc.Mp)
+			case rp.Rel == 1:
+				err = vector.UnionNull(rbat.Vecs[j], ctr.bat.Vecs[rp.Pos], proc.Mp)
+			default:
+				vec := rbat.Vecs[j]
+				vec.Col = append(vec.Col.([]bool), mark)
+				if null {
+					nulls.Add(vec.Nsp, uint64(len(rbat.Zs)))
+				}
+			}
+			if err != nil {
+				rbat.Clean(proc.Mp)
+				return err
+			}
+		}
+		rbat.Zs = append(rbat.Zs, bat.Zs[i])
+	}
+	proc.Reg.InputBatch = rbat
+	return nil
+}
+
+// filter keeps the rows of the subquery in sels which satisfy the other join
+// conditions with the corresponding left row.
+func (ctr *Container) filter(bat *batch.Batch, sels [][]int64, ap *Argument, proc *process.Process) ([][]int64, error) {
+	pbat := batch.NewWithSize(len(bat.Vecs) + len(ctr.bat.Vecs))
+	for i, vec := range bat.Vecs {
+		pbat.Vecs[i] = vector.New(vec.Typ)
+	}
+	for i, vec := range ctr.bat.Vecs {
+		pbat.Vecs[len(bat.Vecs)+i] = vector.New(vec.Typ)
+	}
+	defer pbat.Clean(proc.Mp)
+	for i := range sels {
+		for _, sel := range sels[i] {
+			for j, vec := range bat.Vecs {
+				if err := vector.UnionOne(pbat.Vecs[j], vec, int64(i), proc.Mp); err != nil {
+					return nil, err
+				}
+			}
+			for j, vec := range ctr.bat.Vecs {
+				if err := vector.UnionOne(pbat.Vecs[len(bat.Vecs)+j], vec, sel, proc.Mp); err != nil {
+					return nil, err
+				}
+			}
+			pbat.Zs = append(pbat.Zs, 1)
+		}
+	}
+	if len(pbat.Zs) == 0 {
+		return sels, nil
+	}
+	flags := make([]bool, len(pbat.Zs))
+	for i := range flags {
+		flags[i] = true
+	}
+	for _, cond := range ap.Cond {
+		vec, err := colexec.EvalExpr(pbat, proc, cond)
+		if err != nil {
+			return nil, err
+		}
+		bs := vec.Col.([]bool)
+		for i := range flags {
+			row := i
+			if vec.IsConst {
+				row = 0
+			}
+			if vec.IsConstNull || nulls.Contains(vec.Nsp, uint64(row)) || !bs[row] {
+				flags[i] = false
+			}
+		}
+	}
+	rsels := make([][]int64, len(sels))
+	k := 0
+	for i := range sels {
+		for _, sel := range sels[i] {
+			if flags[k] {
+				rsels[i] = append(rsels[i], sel)
+			}
+			k++
+		}
+	}
+	return rsels, nil
+}
+
+// mark returns the mark of a left row and whether it is NULL, sels are the
+// rows of the subquery satisfying the correlated conditions with it.
+func (ctr *Container) mark(key, mark []byte, sels []int64) (bool, bool) {
+	if len(sels) == 0 {
+		return false, false
+	}
+	if mark == nil {
+		return false, true
+	}
+	if ctr.markGroups != nil {
+		if ctr.markGroups[string(key)+string(mark)] {
+			return true, false
+		}
+		return false, ctr.nullGroups[string(key)]
+	}
+	null := false
+	for _, sel := range sels {
+		switch {
+		case ctr.marks[sel] == nil:
+			null = true
+		case bytes.Equal(ctr.marks[sel], mark):
+			return true, false
+		}
+	}
+	return false, null
+}
+
+// encodeKeys appends the values of exprs evaluated on bat to keys, the key
+// of a row is set to nil if any of its values is NULL.
+func encodeKeys(keys [][]byte, bat *batch.Batch, exprs []*plan.Expr, proc *process.Process) ([][]byte, error) {
+	for _, expr := range exprs {
+		vec, err := colexec.EvalExpr(bat, proc, expr)
+		if err != nil {
+			return nil, err
+		}
+		for i := range keys {
+			if keys[i] == nil {
+				continue
+			}
+			row := i
+			if vec.IsConst {
+				row = 0
+			}
+			if vec.IsConstNull || nulls.Contains(vec.Nsp, uint64(row)) {
+				keys[i] = nil
+				continue
+			}
+			keys[i] = encodeKey(keys[i], vec, row)
+		}
+	}
+	return keys, nil
+}
+
+func encodeKey(key []byte, vec *vector.Vector, row int) []byte {
+	switch vs := vec.Col.(type) {
+	case []bool:
+		return encodeFixed(key, vs, row)
+	case []int8:
+		return encodeFixed(key, vs, row)
+	case []int16:
+		return encodeFixed(key, vs, row)
+	case []int32:
+		return encodeFixed(key, vs, row)
+	case []int64:
+		return encodeFixed(key, vs, row)
+	case []uint8:
+		return encodeFixed(key, vs, row)
+	case []uint16:
+		return encodeFixed(key, vs, row)
+	case []uint32:
+		return encodeFixed(key, vs, row)
+	case []uint64:
+		return encodeFixed(key, vs, row)
+	case []float32:
+		return encodeFixed(key, vs, row)
+	case []float64:
+		return encodeFixed(key, vs, row)
+	case []types.Date:
+		return encodeFixed(key, vs, row)
+	case []types.Datetime:
+		return encodeFixed(key, vs, row)
+	case []types.Timestamp:
+		return encodeFixed(key, vs, row)
+	case []types.Decimal64:
+		return encodeFixed(key, vs, row)
+	case []types.Decimal128:
+		return encodeFixed(key, vs, row)
+	case []string:
+		key = append(key, encoding.EncodeUint32(uint32(len(vs[row])))...)
+		return append(key, vs[row]...)
+	case *types.Bytes:
+		v := vs.Get(int64(row))
+		key = append(key, encoding.EncodeUint32(uint32(len(v)))...)
+		return append(key, v...)
+	}
+	panic(errors.New(errno.DatatypeMismatch, "unsupported join key type "+vec.Typ.String()))
+}
+
+func encodeFixed[T any](key []byte, vs []T, row int) []byte {
+	return append(key, unsafe.Slice((*byte)(unsafe.Pointer(&vs[row])), unsafe.Sizeof(vs[row]))...)
+}
+
+// size returns the memory size of the rows of the subquery and of their
+// groups and marks.
+func (ctr *Container) size() int64 {
+	size := colexec.BatchSize(ctr.bat)
+	for key, sels := range ctr.groups {
+		size += int64(len(key) + cap(sels)*8)
+	}
+	for _, mark := range ctr.marks {
+		size += int64(len(mark))
+	}
+	for key := range ctr.markGroups {
+		size += int64(len(key) + 1)
+	}
+	for key := range ctr.nullGroups {
+		size += int64(len(key) + 1)
+	}
+	return size
+}
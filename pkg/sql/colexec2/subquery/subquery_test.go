@@ -0,0 +1,229 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package subquery
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/tracker"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+// the left rows and the rows of the subquery are pairs of an int64 key and
+// a bool, nil is NULL.
+var (
+	leftRows  = [][]interface{}{{1, true}, {2, true}, {3, false}, {nil, true}}
+	rightRows = [][]interface{}{{2, true}, {3, false}, {3, true}, {nil, true}}
+)
+
+type subqueryTestCase struct {
+	arg   *Argument
+	right [][]interface{}
+	rows  []string
+	err   bool
+}
+
+func TestString(t *testing.T) {
+	buf := new(bytes.Buffer)
+	for _, typ := range []int{Semi, Anti, Mark, Single} {
+		String(&Argument{Typ: typ}, buf)
+	}
+}
+
+func TestSubquery(t *testing.T) {
+	tcs := []subqueryTestCase{
+		{
+			arg:  newArgument(Semi, true, false),
+			rows: []string{"2,true", "3,false"},
+		},
+		{
+			arg:  newArgument(Anti, true, false),
+			rows: []string{"1,true", "null,true"},
+		},
+		{
+			// the pairs are filtered by the bool of the subquery row
+			arg:  newArgument(Semi, true, true),
+			rows: []string{"2,true", "3,false"},
+		},
+		{
+			arg:   newArgument(Semi, true, true),
+			right: [][]interface{}{{2, false}, {3, true}},
+			rows:  []string{"3,false"},
+		},
+		{
+			// a IN (subquery), NULL if the subquery has a NULL
+			arg:  newArgument(Mark, false, false),
+			rows: []string{"1,true,null", "2,true,true", "3,false,true", "null,true,null"},
+		},
+		{
+			arg:   newArgument(Mark, false, false),
+			right: [][]interface{}{{2, true}},
+			rows:  []string{"1,true,false", "2,true,true", "3,false,false", "null,true,null"},
+		},
+		{
+			arg:   newArgument(Mark, false, false),
+			right: [][]interface{}{},
+			rows:  []string{"1,true,false", "2,true,false", "3,false,false", "null,true,false"},
+		},
+		{
+			// a IN (subquery where c), the NULL row is filtered out
+			arg:   newArgument(Mark, false, true),
+			right: [][]interface{}{{2, false}, {3, true}, {nil, false}},
+			rows:  []string{"1,true,false", "2,true,false", "3,false,true", "null,true,null"},
+		},
+		{
+			arg:   newArgument(Single, true, false),
+			right: [][]interface{}{{2, true}, {3, false}},
+			rows:  []string{"1,true,null", "2,true,true", "3,false,false", "null,true,null"},
+		},
+		{
+			arg: newArgument(Single, true, false),
+			err: true,
+		},
+	}
+	for i, tc := range tcs {
+		proc := newProcess()
+		proc.Tr = tracker.New("subquery", 0, nil)
+		right := tc.right
+		if right == nil {
+			right = rightRows
+		}
+		proc.Reg.MergeReceivers[0].Ch <- newBatch(t, leftRows)
+		proc.Reg.MergeReceivers[0].Ch <- &batch.Batch{}
+		proc.Reg.MergeReceivers[0].Ch <- nil
+		proc.Reg.MergeReceivers[1].Ch <- newBatch(t, right)
+		proc.Reg.MergeReceivers[1].Ch <- nil
+		require.NoError(t, Prepare(proc, tc.arg))
+		var rows []string
+		var err error
+		for {
+			var ok bool
+			if ok, err = Call(proc, tc.arg); ok || err != nil {
+				break
+			}
+			rows = append(rows, batchRows(proc.Reg.InputBatch)...)
+			proc.Reg.InputBatch.Clean(proc.Mp)
+		}
+		if tc.err {
+			require.Error(t, err, i)
+			continue
+		}
+		require.NoError(t, err, i)
+		require.Equal(t, tc.rows, rows, i)
+		require.Equal(t, int64(0), mheap.Size(proc.Mp), i)
+		// the rows of the subquery are reported while they are kept
+		if len(right) > 0 {
+			require.Less(t, int64(0), proc.Tr.Peak(), i)
+		}
+		require.Equal(t, int64(0), proc.Tr.Size(), i)
+	}
+}
+
+// newArgument builds a join of the left rows with the subquery, correlated
+// by the keys if keyed, filtered by the bool of the subquery row if filtered.
+// The IN comparison of a MARK join compares the keys.
+func newArgument(typ int, keyed, filtered bool) *Argument {
+	arg := &Argument{
+		Typ:  typ,
+		Typs: []types.Type{{Oid: types.T_int64, Size: 8}, {Oid: types.T_bool, Size: 1}},
+		Result: []ResultPos{
+			{Rel: 0, Pos: 0},
+			{Rel: 0, Pos: 1},
+		},
+	}
+	switch typ {
+	case Mark:
+		arg.MarkCond = []*plan.Expr{newColExpr(0), newColExpr(0)}
+		arg.Result = append(arg.Result, ResultPos{Rel: MarkRel})
+	case Single:
+		arg.Result = append(arg.Result, ResultPos{Rel: 1, Pos: 1})
+	}
+	if keyed {
+		arg.Conditions = [][]*plan.Expr{{newColExpr(0)}, {newColExpr(0)}}
+	}
+	if filtered {
+		arg.Cond = []*plan.Expr{newColExpr(3)}
+	}
+	return arg
+}
+
+func newColExpr(pos int32) *plan.Expr {
+	return &plan.Expr{
+		Expr: &plan.Expr_Col{
+			Col: &plan.ColRef{ColPos: pos},
+		},
+	}
+}
+
+func newProcess() *process.Process {
+	proc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+	ctx := context.Background()
+	proc.Reg.MergeReceivers = []*process.WaitRegister{
+		{Ctx: ctx, Ch: make(chan *batch.Batch, 3)},
+		{Ctx: ctx, Ch: make(chan *batch.Batch, 3)},
+	}
+	return proc
+}
+
+func newBatch(t *testing.T, rows [][]interface{}) *batch.Batch {
+	bat := batch.NewWithSize(2)
+	bat.Vecs[0] = vector.New(types.Type{Oid: types.T_int64, Size: 8})
+	bat.Vecs[1] = vector.New(types.Type{Oid: types.T_bool, Size: 1})
+	for i, row := range rows {
+		if row[0] == nil {
+			nulls.Add(bat.Vecs[0].Nsp, uint64(i))
+			require.NoError(t, vector.Append(bat.Vecs[0], []int64{0}))
+		} else {
+			require.NoError(t, vector.Append(bat.Vecs[0], []int64{int64(row[0].(int))}))
+		}
+		bat.Vecs[1].Col = append(bat.Vecs[1].Col.([]bool), row[1].(bool))
+		bat.Zs = append(bat.Zs, 1)
+	}
+	return bat
+}
+
+func batchRows(bat *batch.Batch) []string {
+	rows := make([]string, len(bat.Zs))
+	for i := range rows {
+		for j, vec := range bat.Vecs {
+			if j > 0 {
+				rows[i] += ","
+			}
+			if nulls.Contains(vec.Nsp, uint64(i)) {
+				rows[i] += "null"
+				continue
+			}
+			switch vs := vec.Col.(type) {
+			case []int64:
+				rows[i] += fmt.Sprint(vs[i])
+			case []bool:
+				rows[i] += fmt.Sprint(vs[i])
+			}
+		}
+	}
+	return rows
+}
@@ -0,0 +1,79 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package subquery
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+const (
+	Build = iota
+	Probe
+	End
+)
+
+// the kinds of join a subquery is decorrelated into.
+const (
+	Semi = iota
+	Anti
+	Mark
+	Single
+)
+
+// MarkRel is the Rel of the ResultPos referencing the mark column of a MARK
+// join.
+const MarkRel = -1
+
+type Container struct {
+	state int
+	// bat holds the rows of the subquery, and groups the rows of bat with
+	// the same equi-join keys, all the rows are in one group if there are
+	// no equi-join conditions.
+	bat    *batch.Batch
+	groups map[string][]int64
+
+	// marks is the subquery side of the IN comparison of a MARK join for
+	// each row of bat, nil for NULL.
+	marks [][]byte
+	// markGroups holds the equi-join keys followed by the non-NULL marks,
+	// nullGroups the equi-join keys of the groups with a NULL mark. They
+	// are only built if there are no other join conditions.
+	markGroups map[string]bool
+	nullGroups map[string]bool
+}
+
+type ResultPos struct {
+	Rel int32
+	Pos int32
+}
+
+type Argument struct {
+	ctr *Container
+	Typ int
+	// Conditions are the two sides of the equi-join conditions,
+	// Conditions[0] is evaluated on the left rows and Conditions[1] on the
+	// rows of the subquery.
+	Conditions [][]*plan.Expr
+	// Cond holds the other join conditions, which are evaluated on a batch
+	// of the left columns followed by the columns of the subquery.
+	Cond []*plan.Expr
+	// MarkCond is the two sides of the IN comparison of a MARK join.
+	MarkCond []*plan.Expr
+	// Typs is the types of the columns of the subquery.
+	Typs   []types.Type
+	Result []ResultPos
+}
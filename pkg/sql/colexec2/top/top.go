@@ -112,7 +112,10 @@ func (ctr *Container) build(ap *Argument, bat *batch.Batch, proc *process.Proces
 	}
 	defer bat.Clean(proc.Mp)
 	proc.Reg.InputBatch = &batch.Batch{}
-	return ctr.processBatch(ap.Limit, bat, proc)
+	if err := ctr.processBatch(ap.Limit, bat, proc); err != nil {
+		return err
+	}
+	return proc.Tr.Set(colexec.BatchSize(ctr.bat))
 }
 
 func (ctr *Container) processBatch(limit int64, bat *batch.Batch, proc *process.Process) error {
@@ -183,6 +186,7 @@ func (ctr *Container) eval(limit int64, proc *process.Process) error {
 	ctr.bat.Vecs = ctr.bat.Vecs[:ctr.n]
 	proc.Reg.InputBatch = ctr.bat
 	ctr.bat = nil
+	proc.Tr.Set(0)
 	return nil
 }
 
@@ -27,6 +27,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/tracker"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 	"github.com/stretchr/testify/require"
 )
@@ -72,6 +73,7 @@ func TestPrepare(t *testing.T) {
 
 func TestTop(t *testing.T) {
 	for _, tc := range tcs {
+		tc.proc.Tr = tracker.New("top", 0, nil)
 		Prepare(tc.proc, tc.arg)
 		tc.proc.Reg.InputBatch = newBatch(t, tc.types, tc.proc, Rows)
 		Call(tc.proc, tc.arg)
@@ -87,6 +89,8 @@ func TestTop(t *testing.T) {
 		tc.proc.Reg.InputBatch = nil
 		Call(tc.proc, tc.arg)
 		require.Equal(t, int64(0), mheap.Size(tc.proc.Mp))
+		require.Less(t, int64(0), tc.proc.Tr.Peak())
+		require.Equal(t, int64(0), tc.proc.Tr.Size())
 	}
 }
 
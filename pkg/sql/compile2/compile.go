@@ -17,12 +17,15 @@ package compile2
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
-	"github.com/matrixorigin/matrixone/pkg/sql/colexec/connector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/connector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/merge"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/output"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
@@ -62,6 +65,7 @@ func (c *compile) Compile(pn *plan.Plan, u interface{}, fill func(interface{}, *
 	// build scope for a single sql
 	s, err := c.compileScope(pn)
 	if err != nil {
+		c.releaseSnapshots()
 		return err
 	}
 	c.scope = s
@@ -75,10 +79,11 @@ func (c *compile) Run(ts uint64) (err error) {
 			err = moerr.NewPanicError(e)
 		}
 	}()
+	defer c.releaseSnapshots()
 
 	switch c.scope.Magic {
 	case Merge:
-		return nil
+		return c.scope.MergeRun(c.e)
 	case CreateDatabase:
 		return c.scope.CreateDatabase(ts, c.proc.Snapshot, c.e)
 	case DropDatabase:
@@ -91,14 +96,31 @@ func (c *compile) Run(ts uint64) (err error) {
 		return c.scope.CreateIndex(ts, c.proc.Snapshot, c.e)
 	case DropIndex:
 		return c.scope.DropIndex(ts, c.proc.Snapshot, c.e)
+	case AlterTable:
+		return c.scope.AlterTable(ts, c.proc.Snapshot, c.e)
+	case Insert:
+		c.affectRows, err = c.scope.Insert(ts, c.proc.Snapshot, c.e, c.proc)
+		return err
 	}
 	return nil
 }
 
+// GetAffectedRows returns the number of rows written by an insert, counted
+// like MySQL for REPLACE and ON DUPLICATE KEY UPDATE.
+func (c *compile) GetAffectedRows() uint64 {
+	return c.affectRows
+}
+
 func (c *compile) compileScope(pn *plan.Plan) (*Scope, error) {
 	switch qry := pn.Plan.(type) {
 	case *plan.Plan_Query:
-		return nil, nil
+		if qry.Query.StmtType == plan.Query_INSERT {
+			return &Scope{
+				Magic: Insert,
+				Plan:  pn,
+			}, nil
+		}
+		return c.compileQuery(qry.Query)
 	case *plan.Plan_Ddl:
 		switch qry.Ddl.DdlType {
 		case plan.DataDefinition_CREATE_DATABASE:
@@ -131,6 +153,11 @@ func (c *compile) compileScope(pn *plan.Plan) (*Scope, error) {
 				Magic: DropIndex,
 				Plan:  pn,
 			}, nil
+		case plan.DataDefinition_ALTER_TABLE:
+			return &Scope{
+				Magic: AlterTable,
+				Plan:  pn,
+			}, nil
 		}
 	}
 	return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("query '%s' not support now", pn))
@@ -140,6 +167,8 @@ func (c *compile) compileQuery(qry *plan.Query) (*Scope, error) {
 	if len(qry.Steps) != 1 {
 		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("query '%s' not support now", qry))
 	}
+	c.proc.AnalInfos = process.NewAnalyzeInfos(len(qry.Nodes))
+	c.proc.ScopeInfos = new(process.ScopeAnalyzeInfos)
 	ss, err := c.compilePlanScope(qry.Nodes[qry.Steps[0]], qry.Nodes)
 	if err != nil {
 		return nil, err
@@ -153,8 +182,23 @@ func (c *compile) compileQuery(qry *plan.Query) (*Scope, error) {
 	rs.Proc.Cancel = cancel
 	rs.Proc.Id = c.proc.Id
 	rs.Proc.Lim = c.proc.Lim
+	rs.Proc.Tr = c.proc.Tr
+	rs.Proc.AnalInfos = c.proc.AnalInfos
+	rs.Proc.ScopeInfos = c.proc.ScopeInfos
 	rs.Proc.UnixTime = c.proc.UnixTime
 	rs.Proc.Snapshot = c.proc.Snapshot
+	rs.Instructions = append(rs.Instructions, vm.Instruction{
+		Op:  overload.Merge,
+		Idx: -1,
+		Arg: &merge.Argument{},
+	}, vm.Instruction{
+		Op:  overload.Output,
+		Idx: -1,
+		Arg: &output.Argument{
+			Data: c.u,
+			Func: c.fill,
+		},
+	})
 	rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 	{
 		for i := 0; i < len(ss); i++ {
@@ -166,7 +210,8 @@ func (c *compile) compileQuery(qry *plan.Query) (*Scope, error) {
 	}
 	for i := range ss {
 		ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
-			Op: vm.Connector,
+			Op:  overload.Connector,
+			Idx: -1,
 			Arg: &connector.Argument{
 				Mmu: rs.Proc.Mp.Gm,
 				Reg: rs.Proc.Reg.MergeReceivers[i],
@@ -181,6 +226,12 @@ func (c *compile) compilePlanScope(n *plan.Node, ns []*plan.Node) ([]*Scope, err
 	//	case plan.Node_VALUE_SCAN:
 	case plan.Node_TABLE_SCAN:
 		snap := engine.Snapshot(c.proc.Snapshot)
+		if n.AsOfTimestamp != 0 {
+			var err error
+			if snap, err = c.snapshotAt(n.AsOfTimestamp); err != nil {
+				return nil, err
+			}
+		}
 		db, err := c.e.Database(n.ObjRef.SchemaName, snap)
 		if err != nil {
 			return nil, err
@@ -193,6 +244,12 @@ func (c *compile) compilePlanScope(n *plan.Node, ns []*plan.Node) ([]*Scope, err
 			RelationName: n.TableDef.Name,
 			SchemaName:   n.ObjRef.SchemaName,
 			Attributes:   make([]string, len(n.TableDef.Cols)),
+			Cond:         constructScanFilter(n),
+			LockMode:     engine.RowLockMode(n.LockMode),
+			LockWait:     engine.RowLockWait(n.LockWait),
+		}
+		if n.PartitionPruned {
+			src.Partitions = append([]string{}, n.Partitions...)
 		}
 		for i, col := range n.TableDef.Cols {
 			src.Attributes[i] = col.Name
@@ -208,8 +265,11 @@ func (c *compile) compilePlanScope(n *plan.Node, ns []*plan.Node) ([]*Scope, err
 			ss[i].Proc = process.New(mheap.New(c.proc.Mp.Gm))
 			ss[i].Proc.Id = c.proc.Id
 			ss[i].Proc.Lim = c.proc.Lim
+			ss[i].Proc.Tr = c.proc.Tr
+			ss[i].Proc.AnalInfos = c.proc.AnalInfos
+			ss[i].Proc.ScopeInfos = c.proc.ScopeInfos
 			ss[i].Proc.UnixTime = c.proc.UnixTime
-			ss[i].Proc.Snapshot = c.proc.Snapshot
+			ss[i].Proc.Snapshot = snap
 		}
 		return c.compileProjection(n, c.compileRestrict(n, ss)), nil
 	case plan.Node_PROJECT:
@@ -225,7 +285,8 @@ func (c *compile) compilePlanScope(n *plan.Node, ns []*plan.Node) ([]*Scope, err
 		}
 		ss = c.compileGroup(n, ss)
 		return c.compileProjection(n, c.compileRestrict(n, ss)), nil
-		//	case plan.Node_JOIN:
+	case plan.Node_JOIN:
+		return c.compileJoin(n, ns)
 	case plan.Node_SORT:
 		ss, err := c.compilePlanScope(ns[n.Children[0]], ns)
 		if err != nil {
@@ -238,6 +299,121 @@ func (c *compile) compilePlanScope(n *plan.Node, ns []*plan.Node) ([]*Scope, err
 	}
 }
 
+// compileJoin compiles the joins decorrelated subqueries are rewritten into,
+// whose join flag is set on the left child. Other joins are not supported yet.
+func (c *compile) compileJoin(n *plan.Node, ns []*plan.Node) ([]*Scope, error) {
+	left, right := ns[n.Children[0]], ns[n.Children[1]]
+	typ, ok := subqueryJoinTypes[left.JoinType]
+	if !ok {
+		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("query '%s' not support now", n))
+	}
+	arg, err := constructSubqueryJoin(n, typ, left, right)
+	if err != nil {
+		return nil, err
+	}
+	ls, err := c.compilePlanScope(left, ns)
+	if err != nil {
+		return nil, err
+	}
+	rs, err := c.compilePlanScope(right, ns)
+	if err != nil {
+		return nil, err
+	}
+	// the join reads its left input from the first receiver and the rows
+	// of the subquery from the second one.
+	js := &Scope{
+		PreScopes: []*Scope{c.newMergeScope(ls), c.newMergeScope(rs)},
+		Magic:     Merge,
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	js.Proc = process.New(mheap.New(c.proc.Mp.Gm))
+	js.Proc.Cancel = cancel
+	js.Proc.Id = c.proc.Id
+	js.Proc.Lim = c.proc.Lim
+	js.Proc.Tr = c.proc.Tr
+	js.Proc.AnalInfos = c.proc.AnalInfos
+	js.Proc.ScopeInfos = c.proc.ScopeInfos
+	js.Proc.UnixTime = c.proc.UnixTime
+	js.Proc.Snapshot = c.proc.Snapshot
+	js.Instructions = append(js.Instructions, vm.Instruction{
+		Op:  overload.Subquery,
+		Idx: int(n.NodeId),
+		Arg: arg,
+	})
+	js.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(js.PreScopes))
+	for i, s := range js.PreScopes {
+		js.Proc.Reg.MergeReceivers[i] = &process.WaitRegister{
+			Ctx: ctx,
+			Ch:  make(chan *batch.Batch, 1),
+		}
+		s.Instructions = append(s.Instructions, vm.Instruction{
+			Op:  overload.Connector,
+			Idx: -1,
+			Arg: &connector.Argument{
+				Mmu: js.Proc.Mp.Gm,
+				Reg: js.Proc.Reg.MergeReceivers[i],
+			},
+		})
+	}
+	// the where list and the project list of n are bound to its children
+	width := int32(len(left.ProjectList))
+	jn := &plan.Node{
+		NodeId:      n.NodeId,
+		WhereList:   make([]*plan.Expr, len(n.WhereList)),
+		ProjectList: make([]*plan.Expr, len(n.ProjectList)),
+	}
+	for i, expr := range n.WhereList {
+		jn.WhereList[i] = rewriteJoinExpr(expr, width)
+	}
+	for i, expr := range n.ProjectList {
+		jn.ProjectList[i] = rewriteJoinExpr(expr, width)
+	}
+	return c.compileProjection(jn, c.compileRestrict(jn, []*Scope{js})), nil
+}
+
+// newMergeScope merges the output of ss into one scope.
+func (c *compile) newMergeScope(ss []*Scope) *Scope {
+	rs := &Scope{
+		PreScopes: ss,
+		Magic:     Merge,
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	rs.Proc = process.New(mheap.New(c.proc.Mp.Gm))
+	rs.Proc.Cancel = cancel
+	rs.Proc.Id = c.proc.Id
+	rs.Proc.Lim = c.proc.Lim
+	rs.Proc.Tr = c.proc.Tr
+	rs.Proc.AnalInfos = c.proc.AnalInfos
+	rs.Proc.ScopeInfos = c.proc.ScopeInfos
+	rs.Proc.UnixTime = c.proc.UnixTime
+	rs.Proc.Snapshot = c.proc.Snapshot
+	rs.Instructions = append(rs.Instructions, vm.Instruction{
+		Op:  overload.Merge,
+		Idx: -1,
+		Arg: &merge.Argument{},
+	})
+	rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
+	{
+		for i := 0; i < len(ss); i++ {
+			rs.Proc.Reg.MergeReceivers[i] = &process.WaitRegister{
+				Ctx: ctx,
+				Ch:  make(chan *batch.Batch, 1),
+			}
+		}
+	}
+	for i := range ss {
+		ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
+			Op:  overload.Connector,
+			Idx: -1,
+			Arg: &connector.Argument{
+				Mmu: rs.Proc.Mp.Gm,
+				Reg: rs.Proc.Reg.MergeReceivers[i],
+			},
+		})
+	}
+	return rs
+}
+
 func (c *compile) compileRestrict(n *plan.Node, ss []*Scope) []*Scope {
 	if len(n.WhereList) == 0 {
 		return ss
@@ -245,6 +421,7 @@ func (c *compile) compileRestrict(n *plan.Node, ss []*Scope) []*Scope {
 	for i := range ss {
 		ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
 			Op:  overload.Restrict,
+			Idx: int(n.NodeId),
 			Arg: constructRestrict(n),
 		})
 	}
@@ -255,6 +432,7 @@ func (c *compile) compileProjection(n *plan.Node, ss []*Scope) []*Scope {
 	for i := range ss {
 		ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
 			Op:  overload.Projection,
+			Idx: int(n.NodeId),
 			Arg: constructProjection(n),
 		})
 	}
@@ -282,6 +460,7 @@ func (c *compile) compileTop(n *plan.Node, ss []*Scope) []*Scope {
 	for i := range ss {
 		ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
 			Op:  overload.Top,
+			Idx: int(n.NodeId),
 			Arg: constructTop(n, c.proc),
 		})
 	}
@@ -294,10 +473,14 @@ func (c *compile) compileTop(n *plan.Node, ss []*Scope) []*Scope {
 	rs.Proc.Cancel = cancel
 	rs.Proc.Id = c.proc.Id
 	rs.Proc.Lim = c.proc.Lim
+	rs.Proc.Tr = c.proc.Tr
+	rs.Proc.AnalInfos = c.proc.AnalInfos
+	rs.Proc.ScopeInfos = c.proc.ScopeInfos
 	rs.Proc.UnixTime = c.proc.UnixTime
 	rs.Proc.Snapshot = c.proc.Snapshot
 	rs.Instructions = append(rs.Instructions, vm.Instruction{
 		Op:  overload.MergeTop,
+		Idx: int(n.NodeId),
 		Arg: constructMergeTop(n, c.proc),
 	})
 	rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
@@ -311,7 +494,8 @@ func (c *compile) compileTop(n *plan.Node, ss []*Scope) []*Scope {
 	}
 	for i := range ss {
 		ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
-			Op: vm.Connector,
+			Op:  overload.Connector,
+			Idx: -1,
 			Arg: &connector.Argument{
 				Mmu: rs.Proc.Mp.Gm,
 				Reg: rs.Proc.Reg.MergeReceivers[i],
@@ -325,6 +509,7 @@ func (c *compile) compileOrder(n *plan.Node, ss []*Scope) []*Scope {
 	for i := range ss {
 		ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
 			Op:  overload.Order,
+			Idx: int(n.NodeId),
 			Arg: constructOrder(n, c.proc),
 		})
 	}
@@ -337,10 +522,14 @@ func (c *compile) compileOrder(n *plan.Node, ss []*Scope) []*Scope {
 	rs.Proc.Cancel = cancel
 	rs.Proc.Id = c.proc.Id
 	rs.Proc.Lim = c.proc.Lim
+	rs.Proc.Tr = c.proc.Tr
+	rs.Proc.AnalInfos = c.proc.AnalInfos
+	rs.Proc.ScopeInfos = c.proc.ScopeInfos
 	rs.Proc.UnixTime = c.proc.UnixTime
 	rs.Proc.Snapshot = c.proc.Snapshot
 	rs.Instructions = append(rs.Instructions, vm.Instruction{
 		Op:  overload.MergeOrder,
+		Idx: int(n.NodeId),
 		Arg: constructMergeOrder(n, c.proc),
 	})
 	rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
@@ -354,7 +543,8 @@ func (c *compile) compileOrder(n *plan.Node, ss []*Scope) []*Scope {
 	}
 	for i := range ss {
 		ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
-			Op: vm.Connector,
+			Op:  overload.Connector,
+			Idx: -1,
 			Arg: &connector.Argument{
 				Mmu: rs.Proc.Mp.Gm,
 				Reg: rs.Proc.Reg.MergeReceivers[i],
@@ -368,6 +558,7 @@ func (c *compile) compileOffset(n *plan.Node, ss []*Scope) []*Scope {
 	for i := range ss {
 		ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
 			Op:  overload.Offset,
+			Idx: int(n.NodeId),
 			Arg: constructOffset(n, c.proc),
 		})
 	}
@@ -380,10 +571,14 @@ func (c *compile) compileOffset(n *plan.Node, ss []*Scope) []*Scope {
 	rs.Proc.Cancel = cancel
 	rs.Proc.Id = c.proc.Id
 	rs.Proc.Lim = c.proc.Lim
+	rs.Proc.Tr = c.proc.Tr
+	rs.Proc.AnalInfos = c.proc.AnalInfos
+	rs.Proc.ScopeInfos = c.proc.ScopeInfos
 	rs.Proc.UnixTime = c.proc.UnixTime
 	rs.Proc.Snapshot = c.proc.Snapshot
 	rs.Instructions = append(rs.Instructions, vm.Instruction{
 		Op:  overload.MergeTop,
+		Idx: int(n.NodeId),
 		Arg: constructMergeTop(n, c.proc),
 	})
 	rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
@@ -397,7 +592,8 @@ func (c *compile) compileOffset(n *plan.Node, ss []*Scope) []*Scope {
 	}
 	for i := range ss {
 		ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
-			Op: vm.Connector,
+			Op:  overload.Connector,
+			Idx: -1,
 			Arg: &connector.Argument{
 				Mmu: rs.Proc.Mp.Gm,
 				Reg: rs.Proc.Reg.MergeReceivers[i],
@@ -411,6 +607,7 @@ func (c *compile) compileLimit(n *plan.Node, ss []*Scope) []*Scope {
 	for i := range ss {
 		ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
 			Op:  overload.Limit,
+			Idx: int(n.NodeId),
 			Arg: constructLimit(n, c.proc),
 		})
 	}
@@ -423,10 +620,14 @@ func (c *compile) compileLimit(n *plan.Node, ss []*Scope) []*Scope {
 	rs.Proc.Cancel = cancel
 	rs.Proc.Id = c.proc.Id
 	rs.Proc.Lim = c.proc.Lim
+	rs.Proc.Tr = c.proc.Tr
+	rs.Proc.AnalInfos = c.proc.AnalInfos
+	rs.Proc.ScopeInfos = c.proc.ScopeInfos
 	rs.Proc.UnixTime = c.proc.UnixTime
 	rs.Proc.Snapshot = c.proc.Snapshot
 	rs.Instructions = append(rs.Instructions, vm.Instruction{
 		Op:  overload.MergeLimit,
+		Idx: int(n.NodeId),
 		Arg: constructMergeLimit(n, c.proc),
 	})
 	rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
@@ -440,7 +641,8 @@ func (c *compile) compileLimit(n *plan.Node, ss []*Scope) []*Scope {
 	}
 	for i := range ss {
 		ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
-			Op: vm.Connector,
+			Op:  overload.Connector,
+			Idx: -1,
 			Arg: &connector.Argument{
 				Mmu: rs.Proc.Mp.Gm,
 				Reg: rs.Proc.Reg.MergeReceivers[i],
@@ -454,6 +656,7 @@ func (c *compile) compileGroup(n *plan.Node, ss []*Scope) []*Scope {
 	for i := range ss {
 		ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
 			Op:  overload.Group,
+			Idx: int(n.NodeId),
 			Arg: constructGroup(n),
 		})
 	}
@@ -466,10 +669,14 @@ func (c *compile) compileGroup(n *plan.Node, ss []*Scope) []*Scope {
 	rs.Proc.Cancel = cancel
 	rs.Proc.Id = c.proc.Id
 	rs.Proc.Lim = c.proc.Lim
+	rs.Proc.Tr = c.proc.Tr
+	rs.Proc.AnalInfos = c.proc.AnalInfos
+	rs.Proc.ScopeInfos = c.proc.ScopeInfos
 	rs.Proc.UnixTime = c.proc.UnixTime
 	rs.Proc.Snapshot = c.proc.Snapshot
 	rs.Instructions = append(rs.Instructions, vm.Instruction{
 		Op:  overload.MergeGroup,
+		Idx: int(n.NodeId),
 		Arg: constructMergeGroup(n, true),
 	})
 	rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
@@ -483,7 +690,8 @@ func (c *compile) compileGroup(n *plan.Node, ss []*Scope) []*Scope {
 	}
 	for i := range ss {
 		ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
-			Op: vm.Connector,
+			Op:  overload.Connector,
+			Idx: -1,
 			Arg: &connector.Argument{
 				Mmu: rs.Proc.Mp.Gm,
 				Reg: rs.Proc.Reg.MergeReceivers[i],
@@ -492,3 +700,29 @@ func (c *compile) compileGroup(n *plan.Node, ss []*Scope) []*Scope {
 	}
 	return []*Scope{rs}
 }
+
+// snapshotAt opens a read-only snapshot of the engine as it was at the unix
+// nanoseconds ts, the snapshot is released when the sql finishes.
+func (c *compile) snapshotAt(ts int64) (engine.Snapshot, error) {
+	e, ok := c.e.(engine.SnapshotEngine)
+	if !ok {
+		return nil, errors.New(errno.FeatureNotSupported, "AS OF TIMESTAMP is not supported by the storage engine")
+	}
+	snap, err := e.SnapshotAt(time.Unix(0, ts))
+	if err != nil {
+		return nil, err
+	}
+	c.snapshots = append(c.snapshots, snap)
+	return snap, nil
+}
+
+func (c *compile) releaseSnapshots() {
+	if len(c.snapshots) == 0 {
+		return
+	}
+	e := c.e.(engine.SnapshotEngine)
+	for _, snap := range c.snapshots {
+		_ = e.ReleaseSnapshot(snap)
+	}
+	c.snapshots = nil
+}
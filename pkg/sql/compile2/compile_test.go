@@ -0,0 +1,251 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile2
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/compress"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan2"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/memEngine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/memEngine/kv"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+// testCompilerContext resolves the tables of the test database of a memory
+// engine for plan2.
+type testCompilerContext struct {
+	e engine.Engine
+}
+
+func (c *testCompilerContext) DefaultDatabase() string {
+	return "test"
+}
+
+func (c *testCompilerContext) DatabaseExists(name string) bool {
+	return name == "test"
+}
+
+func (c *testCompilerContext) Cost(_ *plan2.ObjectRef, _ *plan2.Expr) *plan2.Cost {
+	return &plan2.Cost{}
+}
+
+func (c *testCompilerContext) Resolve(_ string, name string) (*plan2.ObjectRef, *plan2.TableDef) {
+	db, err := c.e.Database("test", nil)
+	if err != nil {
+		return nil, nil
+	}
+	rel, err := db.Relation(name, nil)
+	if err != nil {
+		return nil, nil
+	}
+	def := &plan2.TableDef{Name: name}
+	for _, d := range rel.TableDefs(nil) {
+		if attr, ok := d.(*engine.AttributeDef); ok {
+			def.Cols = append(def.Cols, &plan2.ColDef{
+				Name: attr.Attr.Name,
+				Typ: &plan.Type{
+					Id:       plan.Type_TypeId(attr.Attr.Type.Oid),
+					Nullable: true,
+					Size:     attr.Attr.Type.Size,
+				},
+			})
+		}
+	}
+	return &plan2.ObjectRef{SchemaName: "test", ObjName: name}, def
+}
+
+// newTestEngine creates t1 and t2 with the int64 columns a and b, the nil
+// values are NULL.
+func newTestEngine(t *testing.T) engine.Engine {
+	e := memEngine.New(kv.New(), engine.Node{Id: "0", Addr: "127.0.0.1"})
+	db, err := e.Database("test", nil)
+	require.NoError(t, err)
+	typ := types.Type{Oid: types.T_int64, Size: 8}
+	tables := map[string][][]interface{}{
+		"t1": {{1, 10}, {2, 20}, {3, 30}},
+		"t2": {{2, 20}, {3, 31}, {nil, 40}},
+	}
+	for name, rows := range tables {
+		require.NoError(t, db.Create(0, name, []engine.TableDef{
+			&engine.AttributeDef{Attr: engine.Attribute{Name: "a", Alg: compress.None, Type: typ}},
+			&engine.AttributeDef{Attr: engine.Attribute{Name: "b", Alg: compress.None, Type: typ}},
+		}, nil))
+		rel, err := db.Relation(name, nil)
+		require.NoError(t, err)
+		bat := batch.New(true, []string{"a", "b"})
+		for i := range bat.Vecs {
+			bat.Vecs[i] = vector.New(typ)
+			for j, row := range rows {
+				if row[i] == nil {
+					nulls.Add(bat.Vecs[i].Nsp, uint64(j))
+					require.NoError(t, vector.Append(bat.Vecs[i], []int64{0}))
+				} else {
+					require.NoError(t, vector.Append(bat.Vecs[i], []int64{int64(row[i].(int))}))
+				}
+			}
+		}
+		require.NoError(t, rel.Write(0, bat, nil))
+	}
+	return e
+}
+
+// runQuery runs sql and returns its rows sorted, root picks the node of the
+// plan to run, the root of the query if nil.
+func runQuery(e engine.Engine, sql string, root func(*plan.Query) int32) ([]string, error) {
+	return runQueryIn(process.New(mheap.New(guest.New(1<<30, host.New(1<<30)))), e, sql, root)
+}
+
+// runQueryIn is runQuery with the process of the query.
+func runQueryIn(proc *process.Process, e engine.Engine, sql string, root func(*plan.Query) int32) ([]string, error) {
+	stmt, err := mysql.ParseOne(sql)
+	if err != nil {
+		return nil, err
+	}
+	pn, err := plan2.BuildPlan(&testCompilerContext{e: e}, stmt)
+	if err != nil {
+		return nil, err
+	}
+	if root != nil {
+		qry := pn.GetQuery()
+		qry.Steps[0] = root(qry)
+	}
+	var rows []string
+	c := New("test", sql, "", e, proc)
+	if err := c.Compile(pn, nil, func(_ interface{}, bat *batch.Batch) error {
+		for i := range bat.Zs {
+			row := make([]string, len(bat.Vecs))
+			for j, vec := range bat.Vecs {
+				if nulls.Contains(vec.Nsp, uint64(i)) {
+					row[j] = "null"
+					continue
+				}
+				switch vs := vec.Col.(type) {
+				case []int64:
+					row[j] = fmt.Sprint(vs[i])
+				case []bool:
+					row[j] = fmt.Sprint(vs[i])
+				}
+			}
+			rows = append(rows, strings.Join(row, ","))
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := c.Run(0); err != nil {
+		return nil, err
+	}
+	sort.Strings(rows)
+	return rows, nil
+}
+
+// joinOf returns a function picking the join whose left child has flag.
+func joinOf(flag plan.Node_JoinFlag) func(*plan.Query) int32 {
+	return func(qry *plan.Query) int32 {
+		for _, n := range qry.Nodes {
+			if n.NodeType == plan.Node_JOIN && qry.Nodes[n.Children[0]].JoinType == flag {
+				return n.NodeId
+			}
+		}
+		return -1
+	}
+}
+
+func TestSubqueryJoin(t *testing.T) {
+	InitAddress("127.0.0.1")
+	e := newTestEngine(t)
+	tests := []struct {
+		sql  string
+		root func(*plan.Query) int32
+		rows []string
+	}{
+		{sql: "select a, b from t1", rows: []string{"1,10", "2,20", "3,30"}},
+		{sql: "select a from t1 where exists (select * from t2 where t2.a = t1.a)", rows: []string{"2", "3"}},
+		{sql: "select a from t1 where not exists (select * from t2 where t2.a = t1.a)", rows: []string{"1"}},
+		{sql: "select a from t1 where exists (select * from t2)", rows: []string{"1", "2", "3"}},
+		{sql: "select a from t1 where a in (select a from t2)", rows: []string{"2", "3"}},
+		{sql: "select a from t1 where a in (select a from t2 where t2.b = t1.b)", rows: []string{"2"}},
+		// t2.a has a NULL, so NOT IN is never true
+		{sql: "select a from t1 where not (a in (select a from t2))", rows: nil},
+		{sql: "select a from t1 where not (a in (select a from t2 where t2.b = t1.b))", rows: []string{"1", "3"}},
+		{sql: "select a from t1 where b = (select b from t2 where t2.a = t1.a)", rows: []string{"2"}},
+		{sql: "select a from t1 where b <> (select b from t2 where t2.a = t1.a)", rows: []string{"3"}},
+		{sql: "select a from t1 where a in (select a from t2) or b = (select b from t2 where t2.a = t1.a)", rows: []string{"2", "3"}},
+		// the MARK and SINGLE joins under the filters output a and b of t1
+		// followed by the mark or the scalar subquery
+		{
+			sql:  "select a from t1 where not (a in (select a from t2))",
+			root: joinOf(plan.Node_MARK),
+			rows: []string{"1,10,null", "2,20,true", "3,30,true"},
+		},
+		{
+			sql:  "select a from t1 where not (a in (select a from t2 where t2.b = t1.b))",
+			root: joinOf(plan.Node_MARK),
+			rows: []string{"1,10,false", "2,20,true", "3,30,false"},
+		},
+		{
+			sql:  "select a from t1 where b = (select b from t2 where t2.a = t1.a)",
+			root: joinOf(plan.Node_SINGLE),
+			rows: []string{"1,10,null", "2,20,20", "3,30,31"},
+		},
+	}
+	for _, test := range tests {
+		rows, err := runQuery(e, test.sql, test.root)
+		require.NoError(t, err, test.sql)
+		require.Equal(t, test.rows, rows, test.sql)
+	}
+
+	_, err := runQuery(e, "select a from t1 where b = (select b from t2)", joinOf(plan.Node_SINGLE))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "more than 1 row")
+}
+
+func TestScopeAnalyzeInfos(t *testing.T) {
+	InitAddress("127.0.0.1")
+	e := newTestEngine(t)
+	proc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+	rows, err := runQueryIn(proc, e, "select a from t1 where a in (select a from t2)", nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"2", "3"}, rows)
+
+	infos := proc.ScopeInfos.Infos()
+	require.Less(t, 1, len(infos))
+	var inputRows int64
+	for i, info := range infos {
+		require.Equal(t, int32(i), info.Id)
+		require.Less(t, int64(0), info.TimeConsumed)
+		inputRows += info.InputRows
+	}
+	// both tables are scanned
+	require.Equal(t, int64(6), inputRows)
+	// the root scope runs first and outputs the result
+	require.Equal(t, int32(-1), infos[0].NodeId)
+	require.Equal(t, int64(2), infos[0].OutputRows)
+}
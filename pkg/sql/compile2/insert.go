@@ -0,0 +1,303 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile2
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"google.golang.org/protobuf/proto"
+)
+
+// Insert writes the rows of the VALUES clause of an insert to the table, and
+// returns the affected rows. The rows with the primary key of an existing row
+// replace or update it for REPLACE and ON DUPLICATE KEY UPDATE.
+func (s *Scope) Insert(ts uint64, snapshot engine.Snapshot, eg engine.Engine, proc *process.Process) (uint64, error) {
+	qry := s.Plan.GetQuery()
+	n := qry.Nodes[qry.Steps[0]]
+	src := qry.Nodes[n.Children[0]]
+	if src.NodeType != plan.Node_VALUE_SCAN {
+		return 0, errors.New(errno.FeatureNotSupported, "insert from a query is not supported now")
+	}
+	rel, err := getRelation(n.ObjRef.SchemaName, n.TableDef.Name, snapshot, eg)
+	if err != nil {
+		return 0, err
+	}
+	defer rel.Close(snapshot)
+	bat, err := rowsetToBatch(n.TableDef, src.RowsetData)
+	if err != nil {
+		return 0, err
+	}
+	onDup := engine.OnDuplicate(n.OnDuplicate)
+	if onDup == engine.OnDuplicateError {
+		return uint64(vector.Length(bat.Vecs[0])), rel.Write(ts, bat, snapshot)
+	}
+	upsertRel, ok := rel.(engine.UpsertRelation)
+	if !ok {
+		return 0, errors.New(errno.FeatureNotSupported, fmt.Sprintf("upsert into '%v' is not supported by the engine", n.TableDef.Name))
+	}
+	var f engine.OnDuplicateFunc
+	if onDup == engine.OnDuplicateUpdate {
+		f = onDuplicateUpdate(n.TableDef, n.UpdateList, proc)
+	}
+	return upsertRel.Upsert(ts, bat, f, snapshot)
+}
+
+// onDuplicateUpdate evaluates the assignments of ON DUPLICATE KEY UPDATE on a
+// batch of a row, whose columns are the columns of the existing row followed
+// by the columns of the row inserted for VALUES(col).
+func onDuplicateUpdate(tableDef *plan.TableDef, list *plan.UpdateList, proc *process.Process) engine.OnDuplicateFunc {
+	cols := tableDef.Cols
+	attrs := make([]string, 2*len(cols))
+	for i, col := range cols {
+		attrs[i] = col.Name
+		attrs[i+len(cols)] = "values(" + col.Name + ")"
+	}
+	values := make([]*plan.Expr, len(list.Values))
+	for i, expr := range list.Values {
+		values[i] = proto.Clone(expr).(*plan.Expr)
+		shiftInsertedColumns(values[i], int32(len(cols)))
+	}
+	return func(old, row []interface{}) ([]interface{}, error) {
+		bat := batch.New(true, attrs)
+		for i, v := range append(append([]interface{}{}, old...), row...) {
+			bat.Vecs[i] = vector.New(colType(cols[i%len(cols)].Typ))
+			if err := appendValue(bat.Vecs[i], v); err != nil {
+				return nil, err
+			}
+		}
+		bat.InitZsOne(1)
+		updated := append([]interface{}{}, old...)
+		for i, col := range list.Columns {
+			vec, err := colexec2.EvalExpr(bat, proc, values[i])
+			if err != nil {
+				return nil, err
+			}
+			updated[col.GetCol().ColPos] = getValue(vec, 0)
+		}
+		return updated, nil
+	}
+}
+
+// shiftInsertedColumns moves the references to the row inserted, VALUES(col)
+// with RelPos 1, after the columns of the existing row.
+func shiftInsertedColumns(expr *plan.Expr, offset int32) {
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		if e.Col.RelPos == 1 {
+			e.Col.RelPos = 0
+			e.Col.ColPos += offset
+		}
+	case *plan.Expr_F:
+		for _, arg := range e.F.Args {
+			shiftInsertedColumns(arg, offset)
+		}
+	}
+}
+
+// rowsetToBatch makes a batch of the table from the rows of a VALUES clause,
+// the columns not inserted are NULL.
+func rowsetToBatch(tableDef *plan.TableDef, rowset *plan.RowsetData) (*batch.Batch, error) {
+	rows := 0
+	if len(rowset.Cols) > 0 {
+		rows = int(rowset.Cols[0].RowCount)
+	}
+	attrs := make([]string, len(tableDef.Cols))
+	for i, col := range tableDef.Cols {
+		attrs[i] = col.Name
+	}
+	bat := batch.New(true, attrs)
+	for i, col := range tableDef.Cols {
+		bat.Vecs[i] = vector.New(colType(col.Typ))
+		var data *plan.ColData
+		for j, insertCol := range rowset.Schema.Cols {
+			if insertCol.Name == col.Name {
+				data = rowset.Cols[j]
+				break
+			}
+		}
+		for row := 0; row < rows; row++ {
+			var v interface{}
+			if data != nil {
+				var err error
+				if v, err = colDataValue(data, col.Typ, row); err != nil {
+					return nil, err
+				}
+			}
+			if err := appendValue(bat.Vecs[i], v); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return bat, nil
+}
+
+func colType(typ *plan.Type) types.Type {
+	return types.Type{
+		Oid:       types.T(typ.GetId()),
+		Width:     typ.GetWidth(),
+		Precision: typ.GetPrecision(),
+		Size:      typ.GetSize(),
+	}
+}
+
+// colDataValue returns the value of a row of the column data in the Go type
+// of the column type typ.
+func colDataValue(data *plan.ColData, typ *plan.Type, row int) (interface{}, error) {
+	switch typ.Id {
+	case plan.Type_INT8:
+		return int8(data.I32[row]), nil
+	case plan.Type_INT16:
+		return int16(data.I32[row]), nil
+	case plan.Type_INT32:
+		return data.I32[row], nil
+	case plan.Type_UINT8:
+		return uint8(data.I32[row]), nil
+	case plan.Type_UINT16:
+		return uint16(data.I32[row]), nil
+	case plan.Type_INT64:
+		return data.I64[row], nil
+	case plan.Type_UINT32:
+		return uint32(data.I64[row]), nil
+	case plan.Type_UINT64:
+		return uint64(data.I64[row]), nil
+	case plan.Type_FLOAT32:
+		return data.F32[row], nil
+	case plan.Type_FLOAT64:
+		return data.F64[row], nil
+	case plan.Type_CHAR, plan.Type_VARCHAR:
+		return []byte(data.S[row]), nil
+	}
+	return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("insert into a column of type '%v' is not supported now", typ.Id))
+}
+
+// appendValue appends v to vec, nil is NULL
+func appendValue(vec *vector.Vector, v interface{}) error {
+	if v == nil {
+		nulls.Add(vec.Nsp, uint64(vector.Length(vec)))
+		v = zeroValue(vec.Typ.Oid)
+	}
+	switch val := v.(type) {
+	case int8:
+		return vector.Append(vec, []int8{val})
+	case int16:
+		return vector.Append(vec, []int16{val})
+	case int32:
+		return vector.Append(vec, []int32{val})
+	case int64:
+		return vector.Append(vec, []int64{val})
+	case uint8:
+		return vector.Append(vec, []uint8{val})
+	case uint16:
+		return vector.Append(vec, []uint16{val})
+	case uint32:
+		return vector.Append(vec, []uint32{val})
+	case uint64:
+		return vector.Append(vec, []uint64{val})
+	case float32:
+		return vector.Append(vec, []float32{val})
+	case float64:
+		return vector.Append(vec, []float64{val})
+	case []byte:
+		return vector.Append(vec, [][]byte{val})
+	case types.Date:
+		return vector.Append(vec, []types.Date{val})
+	case types.Datetime:
+		return vector.Append(vec, []types.Datetime{val})
+	}
+	return errors.New(errno.FeatureNotSupported, fmt.Sprintf("value '%v' of type %T is not supported now", v, v))
+}
+
+func zeroValue(typ types.T) interface{} {
+	switch typ {
+	case types.T_int8:
+		return int8(0)
+	case types.T_int16:
+		return int16(0)
+	case types.T_int32:
+		return int32(0)
+	case types.T_int64:
+		return int64(0)
+	case types.T_uint8:
+		return uint8(0)
+	case types.T_uint16:
+		return uint16(0)
+	case types.T_uint32:
+		return uint32(0)
+	case types.T_uint64:
+		return uint64(0)
+	case types.T_float32:
+		return float32(0)
+	case types.T_float64:
+		return float64(0)
+	case types.T_date:
+		return types.Date(0)
+	case types.T_datetime:
+		return types.Datetime(0)
+	case types.T_char, types.T_varchar:
+		return []byte{}
+	}
+	return nil
+}
+
+// getValue returns the value of a row of vec, nil for NULL
+func getValue(vec *vector.Vector, row int) interface{} {
+	if vec.IsConstNull || nulls.Contains(vec.Nsp, uint64(row)) {
+		return nil
+	}
+	if vec.IsConst {
+		row = 0
+	}
+	switch col := vec.Col.(type) {
+	case []int8:
+		return col[row]
+	case []int16:
+		return col[row]
+	case []int32:
+		return col[row]
+	case []int64:
+		return col[row]
+	case []uint8:
+		return col[row]
+	case []uint16:
+		return col[row]
+	case []uint32:
+		return col[row]
+	case []uint64:
+		return col[row]
+	case []float32:
+		return col[row]
+	case []float64:
+		return col[row]
+	case *types.Bytes:
+		return col.Get(int64(row))
+	case []string:
+		return []byte(col[row])
+	case []types.Date:
+		return col[row]
+	case []types.Datetime:
+		return col[row]
+	}
+	return nil
+}
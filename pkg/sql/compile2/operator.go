@@ -18,10 +18,15 @@ import (
 	"fmt"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
 	colexec "github.com/matrixorigin/matrixone/pkg/sql/colexec2"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/aggregate"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/connector"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/group"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/limit"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/mergegroup"
@@ -34,10 +39,12 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/output"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/projection"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/restrict"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/subquery"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/top"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"google.golang.org/protobuf/proto"
 )
 
 var constBat *batch.Batch
@@ -48,7 +55,8 @@ func init() {
 
 func dupInstruction(in vm.Instruction) vm.Instruction {
 	rin := vm.Instruction{
-		Op: in.Op,
+		Op:  in.Op,
+		Idx: in.Idx,
 	}
 	switch arg := in.Arg.(type) {
 	case *top.Argument:
@@ -81,6 +89,11 @@ func dupInstruction(in vm.Instruction) vm.Instruction {
 			Data: arg.Data,
 			Func: arg.Func,
 		}
+	case *connector.Argument:
+		rin.Arg = &connector.Argument{
+			Mmu: arg.Mmu,
+			Reg: arg.Reg,
+		}
 	default:
 		panic(errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("Unsupport instruction %T\n", in.Arg)))
 	}
@@ -93,12 +106,321 @@ func constructRestrict(n *plan.Node) *restrict.Argument {
 	}
 }
 
+// constructScanFilter converts the conjuncts of the filter of a table scan
+// which compare a column with constants into an extend, which the storage
+// engine uses to skip the blocks none of whose rows can match. The other
+// conjuncts are left out, the whole filter is still evaluated by restrict.
+func constructScanFilter(n *plan.Node) extend.Extend {
+	var cond extend.Extend
+	for _, expr := range n.WhereList {
+		cond = andScanFilter(cond, scanFilter(n, expr))
+	}
+	return cond
+}
+
+func andScanFilter(left, right extend.Extend) extend.Extend {
+	if left == nil {
+		return right
+	}
+	if right == nil {
+		return left
+	}
+	return &extend.BinaryExtend{Op: overload.And, Left: left, Right: right}
+}
+
+var scanFilterOps = map[string]int{
+	"=":  overload.EQ,
+	"<":  overload.LT,
+	"<=": overload.LE,
+	">":  overload.GT,
+	">=": overload.GE,
+}
+
+func scanFilter(n *plan.Node, expr *plan.Expr) extend.Extend {
+	f, ok := expr.Expr.(*plan.Expr_F)
+	if !ok {
+		return nil
+	}
+	args := f.F.Args
+	switch name := f.F.Func.GetObjName(); name {
+	case "and":
+		var cond extend.Extend
+		for _, arg := range args {
+			cond = andScanFilter(cond, scanFilter(n, arg))
+		}
+		return cond
+	case "=", "<", "<=", ">", ">=":
+		if len(args) != 2 {
+			return nil
+		}
+		left, right := scanFilterOperand(n, args[0]), scanFilterOperand(n, args[1])
+		if left == nil || right == nil {
+			return nil
+		}
+		// one side must be the column, the other a constant
+		_, leftIsAttr := left.(*extend.Attribute)
+		_, rightIsAttr := right.(*extend.Attribute)
+		if leftIsAttr == rightIsAttr {
+			return nil
+		}
+		return &extend.BinaryExtend{Op: scanFilterOps[name], Left: left, Right: right}
+	case "in":
+		if len(args) != 2 {
+			return nil
+		}
+		attr, ok := scanFilterOperand(n, args[0]).(*extend.Attribute)
+		if !ok {
+			return nil
+		}
+		list, ok := args[1].Expr.(*plan.Expr_List)
+		if !ok {
+			return nil
+		}
+		in := &extend.FuncExtend{Name: "in", Args: []extend.Extend{attr}}
+		for _, e := range list.List.List {
+			val, ok := scanFilterOperand(n, e).(*extend.ValueExtend)
+			if !ok {
+				return nil
+			}
+			in.Args = append(in.Args, val)
+		}
+		return in
+	case "ifnull":
+		// is null is built as ifnull of the single operand
+		if len(args) != 1 {
+			return nil
+		}
+		if attr, ok := scanFilterOperand(n, args[0]).(*extend.Attribute); ok {
+			return &extend.FuncExtend{Name: "isnull", Args: []extend.Extend{attr}}
+		}
+	}
+	return nil
+}
+
+// scanFilterOperand returns the attribute of a column of the scanned table
+// or the value of a constant, looking through the casts which do not change
+// the order of the values.
+func scanFilterOperand(n *plan.Node, expr *plan.Expr) extend.Extend {
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		if e.Col.RelPos != 0 || e.Col.ColPos < 0 || int(e.Col.ColPos) >= len(n.TableDef.Cols) {
+			return nil
+		}
+		col := n.TableDef.Cols[e.Col.ColPos]
+		return &extend.Attribute{Name: col.Name, Type: types.T(col.Typ.Id)}
+	case *plan.Expr_C:
+		return scanFilterValue(e.C, types.T(expr.Typ.Id))
+	case *plan.Expr_F:
+		if e.F.Func.GetObjName() != "cast" || len(e.F.Args) != 1 {
+			return nil
+		}
+		arg := e.F.Args[0]
+		switch a := arg.Expr.(type) {
+		case *plan.Expr_C:
+			return scanFilterValue(a.C, types.T(expr.Typ.Id))
+		case *plan.Expr_Col:
+			if isExactWidening(types.T(arg.Typ.Id), types.T(expr.Typ.Id)) {
+				return scanFilterOperand(n, arg)
+			}
+		}
+	}
+	return nil
+}
+
+// isExactWidening returns true if every value of type from is converted to
+// a distinct value of type to in the same order.
+func isExactWidening(from, to types.T) bool {
+	if from == to {
+		return true
+	}
+	switch from {
+	case types.T_int8, types.T_int16, types.T_int32:
+		return to == types.T_int64 || to == types.T_float64
+	case types.T_uint8, types.T_uint16, types.T_uint32:
+		return to == types.T_int64 || to == types.T_uint64 || to == types.T_float64
+	case types.T_float32:
+		return to == types.T_float64
+	}
+	return false
+}
+
+// scanFilterValue returns the value of a constant of type typ, the strings
+// cast to a date or a datetime are parsed.
+func scanFilterValue(c *plan.Const, typ types.T) *extend.ValueExtend {
+	if c.GetIsnull() {
+		return nil
+	}
+	var vec *vector.Vector
+	var err error
+	switch v := c.GetValue().(type) {
+	case *plan.Const_Ival:
+		vec = vector.New(types.Type{Oid: types.T_int64, Size: 8})
+		err = vector.Append(vec, []int64{v.Ival})
+	case *plan.Const_Dval:
+		vec = vector.New(types.Type{Oid: types.T_float64, Size: 8})
+		err = vector.Append(vec, []float64{v.Dval})
+	case *plan.Const_Sval:
+		switch typ {
+		case types.T_date:
+			var d types.Date
+			if d, err = types.ParseDate(v.Sval); err != nil {
+				return nil
+			}
+			vec = vector.New(types.Type{Oid: types.T_date, Size: 4})
+			err = vector.Append(vec, []types.Date{d})
+		case types.T_datetime:
+			var dt types.Datetime
+			if dt, err = types.ParseDatetime(v.Sval); err != nil {
+				return nil
+			}
+			vec = vector.New(types.Type{Oid: types.T_datetime, Size: 8})
+			err = vector.Append(vec, []types.Datetime{dt})
+		case types.T_char, types.T_varchar:
+			vec = vector.New(types.Type{Oid: types.T_varchar, Size: 24})
+			err = vector.Append(vec, [][]byte{[]byte(v.Sval)})
+		default:
+			return nil
+		}
+	default:
+		return nil
+	}
+	if err != nil {
+		return nil
+	}
+	return &extend.ValueExtend{V: vec}
+}
+
 func constructProjection(n *plan.Node) *projection.Argument {
 	return &projection.Argument{
 		Es: n.ProjectList,
 	}
 }
 
+// subqueryJoinTypes maps the join flags decorrelated subqueries are
+// rewritten with to the kinds of subquery join.
+var subqueryJoinTypes = map[plan.Node_JoinFlag]int{
+	plan.Node_SEMI:   subquery.Semi,
+	plan.Node_ANTI:   subquery.Anti,
+	plan.Node_MARK:   subquery.Mark,
+	plan.Node_SINGLE: subquery.Single,
+}
+
+// constructSubqueryJoin builds the join of the left child of n with the
+// decorrelated subquery right. It outputs the columns of left followed by
+// the column appended by a MARK or SINGLE join.
+func constructSubqueryJoin(n *plan.Node, typ int, left, right *plan.Node) (*subquery.Argument, error) {
+	width := int32(len(left.ProjectList))
+	arg := &subquery.Argument{
+		Typ:    typ,
+		Typs:   make([]types.Type, len(right.ProjectList)),
+		Result: make([]subquery.ResultPos, width),
+	}
+	for i := range arg.Result {
+		arg.Result[i] = subquery.ResultPos{Rel: 0, Pos: int32(i)}
+	}
+	switch typ {
+	case subquery.Mark:
+		arg.Result = append(arg.Result, subquery.ResultPos{Rel: subquery.MarkRel})
+	case subquery.Single:
+		arg.Result = append(arg.Result, subquery.ResultPos{Rel: 1, Pos: 0})
+	}
+	for i, expr := range right.ProjectList {
+		arg.Typs[i] = types.Type{
+			Oid:       types.T(expr.Typ.Id),
+			Size:      expr.Typ.Size,
+			Width:     expr.Typ.Width,
+			Scale:     expr.Typ.Scale,
+			Precision: expr.Typ.Precision,
+		}
+	}
+	conds := n.OnList
+	if typ == subquery.Mark {
+		if len(conds) == 0 {
+			return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("query '%s' not support now", n))
+		}
+		l, r, ok := splitJoinEqual(conds[0])
+		if !ok {
+			return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("query '%s' not support now", n))
+		}
+		arg.MarkCond = []*plan.Expr{l, r}
+		conds = conds[1:]
+	}
+	for _, cond := range conds {
+		if l, r, ok := splitJoinEqual(cond); ok {
+			if arg.Conditions == nil {
+				arg.Conditions = make([][]*plan.Expr, 2)
+			}
+			arg.Conditions[0] = append(arg.Conditions[0], l)
+			arg.Conditions[1] = append(arg.Conditions[1], r)
+			continue
+		}
+		arg.Cond = append(arg.Cond, rewriteJoinExpr(cond, width))
+	}
+	return arg, nil
+}
+
+// splitJoinEqual splits an equality between an expression of the left
+// columns and one of the right columns of a join.
+func splitJoinEqual(expr *plan.Expr) (*plan.Expr, *plan.Expr, bool) {
+	f, ok := expr.Expr.(*plan.Expr_F)
+	if !ok || f.F.Func.GetObjName() != "=" || len(f.F.Args) != 2 {
+		return nil, nil, false
+	}
+	l, r := f.F.Args[0], f.F.Args[1]
+	switch {
+	case joinRels(l) == 1 && joinRels(r) == 2:
+		return l, r, true
+	case joinRels(l) == 2 && joinRels(r) == 1:
+		return r, l, true
+	}
+	return nil, nil, false
+}
+
+// joinRels returns a bitmap of the children of a join the columns of expr
+// come from, the mark column counts as both.
+func joinRels(expr *plan.Expr) int {
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		if e.Col.RelPos < 0 {
+			return 3
+		}
+		return 1 << e.Col.RelPos
+	case *plan.Expr_F:
+		rels := 0
+		for _, arg := range e.F.Args {
+			rels |= joinRels(arg)
+		}
+		return rels
+	}
+	return 0
+}
+
+// rewriteJoinExpr rebinds the columns of expr, made against the children of
+// a join, to a batch holding the width columns of the left child followed by
+// the columns of the right child or the mark column.
+func rewriteJoinExpr(expr *plan.Expr, width int32) *plan.Expr {
+	expr = proto.Clone(expr).(*plan.Expr)
+	var rewrite func(*plan.Expr)
+	rewrite = func(expr *plan.Expr) {
+		switch e := expr.Expr.(type) {
+		case *plan.Expr_Col:
+			switch {
+			case e.Col.RelPos < 0:
+				e.Col.ColPos = width
+			case e.Col.RelPos > 0:
+				e.Col.ColPos += width
+			}
+			e.Col.RelPos = 0
+		case *plan.Expr_F:
+			for _, arg := range e.F.Args {
+				rewrite(arg)
+			}
+		}
+	}
+	rewrite(expr)
+	return expr
+}
+
 func constructTop(n *plan.Node, proc *process.Process) *top.Argument {
 	vec, err := colexec.EvalExpr(constBat, proc, n.Limit)
 	if err != nil {
@@ -16,16 +16,21 @@ package compile2
 
 import (
 	"context"
+	"fmt"
 	"runtime"
 
 	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
-	"github.com/matrixorigin/matrixone/pkg/sql/colexec/connector"
-	"github.com/matrixorigin/matrixone/pkg/sql/colexec/merge"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/connector"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/group"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/limit"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/merge"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/mergegroup"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/mergelimit"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/mergeoffset"
@@ -34,6 +39,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/offset"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/order"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/top"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
@@ -58,13 +64,14 @@ func (s *Scope) CreateTable(ts uint64, snapshot engine.Snapshot, engine engine.E
 	planCols := qry.GetTableDef().GetCols()
 	exeCols := planColsToExeCols(planCols)
 
-	// convert the plan's defs to the execution's defs
-	planDefs := qry.GetTableDef().GetDefs()
-	exeDefs := planDefsToExeDefs(planDefs)
-
 	if qry.GetDatabase() != "" {
 		dbName = qry.GetDatabase()
 	}
+
+	// convert the plan's defs to the execution's defs
+	planDefs := qry.GetTableDef().GetDefs()
+	exeDefs := planDefsToExeDefs(planDefs, dbName)
+
 	dbSource, err := engine.Database(dbName, nil)
 	if err != nil {
 		return err
@@ -85,15 +92,112 @@ func (s *Scope) DropTable(ts uint64, snapshot engine.Snapshot, engine engine.Eng
 	return dbSource.Delete(ts, tblName, snapshot)
 }
 
-func (s *Scope) CreateIndex(ts uint64, snapshot engine.Snapshot, engine engine.Engine) error {
-	return nil
+func (s *Scope) CreateIndex(ts uint64, snapshot engine.Snapshot, eg engine.Engine) error {
+	qry := s.Plan.GetDdl().GetCreateIndex()
+	rel, err := getRelation(qry.GetDatabase(), qry.GetTable(), snapshot, eg)
+	if err != nil {
+		return err
+	}
+	if findIndexDef(rel, qry.GetIndex(), snapshot) != nil {
+		if qry.GetIfNotExists() {
+			return nil
+		}
+		return errors.New(errno.DuplicateObject, fmt.Sprintf("index '%v' already exists", qry.GetIndex()))
+	}
+	return rel.AddTableDef(ts, &engine.IndexTableDef{
+		Typ:      engine.ZoneMap,
+		Name:     qry.GetIndex(),
+		ColNames: qry.GetColNames(),
+	}, snapshot)
 }
 
-func (s *Scope) DropIndex(ts uint64, snapshot engine.Snapshot, engine engine.Engine) error {
+func (s *Scope) DropIndex(ts uint64, snapshot engine.Snapshot, eg engine.Engine) error {
+	qry := s.Plan.GetDdl().GetDropIndex()
+	rel, err := getRelation(qry.GetDatabase(), qry.GetTable(), snapshot, eg)
+	if err != nil {
+		return err
+	}
+	def := findIndexDef(rel, qry.GetIndex(), snapshot)
+	if def == nil {
+		if qry.GetIfExists() {
+			return nil
+		}
+		return errors.New(errno.UndefinedObject, fmt.Sprintf("index '%v' doesn't exist", qry.GetIndex()))
+	}
+	return rel.DelTableDef(ts, def, snapshot)
+}
+
+func (s *Scope) AlterTable(ts uint64, snapshot engine.Snapshot, eg engine.Engine) error {
+	qry := s.Plan.GetDdl().GetAlterTable()
+	rel, err := getRelation(qry.GetDatabase(), qry.GetTable(), snapshot, eg)
+	if err != nil {
+		return err
+	}
+	if actions := qry.GetActions(); len(actions) == 1 && actions[0].GetActionType() == plan.AlterTableAction_COMPACT {
+		compactEngine, ok := eg.(engine.CompactEngine)
+		if !ok {
+			return errors.New(errno.FeatureNotSupported, fmt.Sprintf("compact table '%v' is not supported by the engine", qry.GetTable()))
+		}
+		return compactEngine.Compact(qry.GetDatabase(), qry.GetTable())
+	}
+	if actions := qry.GetActions(); len(actions) == 1 && isPartitionAction(actions[0]) {
+		partitionRel, ok := rel.(engine.PartitionRelation)
+		if !ok {
+			return errors.New(errno.FeatureNotSupported, fmt.Sprintf("alter partitions of table '%v' is not supported by the engine", qry.GetTable()))
+		}
+		switch action := actions[0]; action.GetActionType() {
+		case plan.AlterTableAction_ADD_PARTITION:
+			return partitionRel.AddPartitions(ts, planPartitionToExeDef(action.GetPartitionDef()), snapshot)
+		case plan.AlterTableAction_DROP_PARTITION:
+			return partitionRel.DropPartitions(ts, action.GetPartitionNames(), snapshot)
+		default:
+			return partitionRel.TruncatePartitions(ts, action.GetPartitionNames(), snapshot)
+		}
+	}
+	alterRel, ok := rel.(engine.SchemaChangeRelation)
+	if !ok {
+		return errors.New(errno.FeatureNotSupported, fmt.Sprintf("alter table '%v' is not supported by the engine", qry.GetTable()))
+	}
+	actions := make([]engine.AlterTableAction, len(qry.GetActions()))
+	for i, action := range qry.GetActions() {
+		actions[i].Name = action.GetName()
+		actions[i].NewName = action.GetNewName()
+		switch action.GetActionType() {
+		case plan.AlterTableAction_ADD_COLUMN:
+			actions[i].Kind = engine.AlterAddColumn
+		case plan.AlterTableAction_DROP_COLUMN:
+			actions[i].Kind = engine.AlterDropColumn
+		case plan.AlterTableAction_RENAME_COLUMN:
+			actions[i].Kind = engine.AlterRenameColumn
+		case plan.AlterTableAction_MODIFY_COLUMN:
+			actions[i].Kind = engine.AlterModifyColumn
+		}
+		if action.GetColDef() != nil {
+			actions[i].Attr = planColToExeAttr(action.GetColDef())
+		}
+	}
+	return alterRel.AlterTable(ts, actions, snapshot)
+}
+
+func getRelation(dbName, tblName string, snapshot engine.Snapshot, eg engine.Engine) (engine.Relation, error) {
+	dbSource, err := eg.Database(dbName, snapshot)
+	if err != nil {
+		return nil, err
+	}
+	return dbSource.Relation(tblName, snapshot)
+}
+
+// findIndexDef returns the definition of the index named name, or nil.
+func findIndexDef(rel engine.Relation, name string, snapshot engine.Snapshot) *engine.IndexTableDef {
+	for _, def := range rel.TableDefs(snapshot) {
+		if indexDef, ok := def.(*engine.IndexTableDef); ok && indexDef.Name == name {
+			return indexDef
+		}
+	}
 	return nil
 }
 
-func planDefsToExeDefs(planDefs []*plan.TableDef_DefType) []engine.TableDef {
+func planDefsToExeDefs(planDefs []*plan.TableDef_DefType, dbName string) []engine.TableDef {
 	exeDefs := make([]engine.TableDef, len(planDefs))
 	for i, def := range planDefs {
 		switch defVal := def.GetDef().(type) {
@@ -117,45 +221,152 @@ func planDefsToExeDefs(planDefs []*plan.TableDef_DefType) []engine.TableDef {
 			exeDefs[i] = &engine.PropertiesDef{
 				Properties: properties,
 			}
+		case *plan.TableDef_DefType_Unique:
+			exeDefs[i] = &engine.UniqueIndexDef{
+				Name:     defVal.Unique.GetName(),
+				ColNames: defVal.Unique.GetColNames(),
+			}
+		case *plan.TableDef_DefType_Check:
+			exeDefs[i] = &engine.CheckDef{
+				Name: defVal.Check.GetName(),
+				Expr: defVal.Check.GetExpr(),
+			}
+		case *plan.TableDef_DefType_Fk:
+			refDatabase := defVal.Fk.GetRefDatabase()
+			if refDatabase == "" {
+				refDatabase = dbName
+			}
+			exeDefs[i] = &engine.ForeignKeyDef{
+				Name:        defVal.Fk.GetName(),
+				ColNames:    defVal.Fk.GetColNames(),
+				RefDatabase: refDatabase,
+				RefTable:    defVal.Fk.GetRefTable(),
+				RefColNames: defVal.Fk.GetRefColNames(),
+				OnDelete:    engine.RefAction(defVal.Fk.GetOnDelete()),
+				OnUpdate:    engine.RefAction(defVal.Fk.GetOnUpdate()),
+			}
+		case *plan.TableDef_DefType_Partition:
+			exeDefs[i] = planPartitionToExeDef(defVal.Partition)
 		}
 	}
 	return exeDefs
 }
 
+func isPartitionAction(action *plan.AlterTableAction) bool {
+	switch action.GetActionType() {
+	case plan.AlterTableAction_ADD_PARTITION, plan.AlterTableAction_DROP_PARTITION, plan.AlterTableAction_TRUNCATE_PARTITION:
+		return true
+	}
+	return false
+}
+
+// planPartitionToExeDef converts the plan's partitioning to the execution's,
+// the partition values are ValueExtends.
+func planPartitionToExeDef(def *plan.PartitionByDef) *engine.PartitionByDef {
+	exeDef := &engine.PartitionByDef{
+		Fields: def.GetFields(),
+	}
+	switch def.GetType() {
+	case plan.PartitionByDef_HASH:
+		exeDef.Hash = uint64(def.GetNum())
+	case plan.PartitionByDef_RANGE:
+		for _, part := range def.GetPartitions() {
+			rng := engine.RangePartition{Name: part.GetName()}
+			for _, value := range part.GetValues() {
+				rng.To = append(rng.To, planPartitionValue(value))
+			}
+			exeDef.Range = append(exeDef.Range, rng)
+		}
+	case plan.PartitionByDef_LIST:
+		for _, part := range def.GetPartitions() {
+			list := engine.ListPartition{Name: part.GetName()}
+			for _, value := range part.GetValues() {
+				list.Extends = append(list.Extends, planPartitionValue(value))
+			}
+			exeDef.List = append(exeDef.List, list)
+		}
+	}
+	return exeDef
+}
+
+// planPartitionValue returns the ValueExtend of a partition value, NULL is a
+// vector of one null.
+func planPartitionValue(expr *plan.Expr) extend.Extend {
+	c := expr.GetC()
+	typ := types.T(expr.GetTyp().GetId())
+	var vec *vector.Vector
+	switch {
+	case c.GetIsnull():
+		vec = vector.New(types.Type{Oid: types.T_int64, Size: 8})
+		if err := vector.Append(vec, []int64{0}); err != nil {
+			return nil
+		}
+		nulls.Add(vec.Nsp, 0)
+	case typ == types.T_uint64 && c.GetIval() < 0:
+		// the unsigned values beyond the int64s are kept as their bits
+		vec = vector.New(types.Type{Oid: types.T_uint64, Size: 8})
+		if err := vector.Append(vec, []uint64{uint64(c.GetIval())}); err != nil {
+			return nil
+		}
+	default:
+		if value := scanFilterValue(c, typ); value != nil {
+			return value
+		}
+		return nil
+	}
+	return &extend.ValueExtend{V: vec}
+}
+
 func planColsToExeCols(planCols []*plan.ColDef) []engine.TableDef {
 	exeCols := make([]engine.TableDef, len(planCols))
 	for i, col := range planCols {
-		var alg compress.T
-		switch col.Alg {
-		case plan.CompressType_None:
-			alg = compress.None
-		case plan.CompressType_Lz4:
-			alg = compress.Lz4
-		}
-		colTyp := col.GetTyp()
 		exeCols[i] = &engine.AttributeDef{
-			Attr: engine.Attribute{
-				Name: col.Name,
-				Alg:  alg,
-				Type: types.Type{
-					Oid:       types.T(colTyp.GetId()),
-					Width:     colTyp.GetWidth(),
-					Precision: colTyp.GetPrecision(),
-					Scale:     colTyp.GetScale(),
-					Size:      colTyp.GetSize(),
-				},
-				Default: engine.DefaultExpr{
-					Exist:  col.GetDefault().GetExist(),
-					Value:  col.GetDefault().GetValue(),
-					IsNull: col.GetDefault().GetIsNull(),
-				},
-				Primary: col.GetPrimary(),
-			},
+			Attr: planColToExeAttr(col),
 		}
 	}
 	return exeCols
 }
 
+func planColToExeAttr(col *plan.ColDef) engine.Attribute {
+	var alg compress.T
+	switch col.Alg {
+	case plan.CompressType_None:
+		alg = compress.None
+	case plan.CompressType_Lz4:
+		alg = compress.Lz4
+	case plan.CompressType_Zstd:
+		alg = compress.Zstd
+	case plan.CompressType_Dict:
+		alg = compress.Dict
+	case plan.CompressType_Rle:
+		alg = compress.RLE
+	case plan.CompressType_Delta:
+		alg = compress.Delta
+	case plan.CompressType_Auto:
+		alg = compress.Auto
+	}
+	colTyp := col.GetTyp()
+	return engine.Attribute{
+		Name: col.Name,
+		Alg:  alg,
+		Type: types.Type{
+			Oid:       types.T(colTyp.GetId()),
+			Width:     colTyp.GetWidth(),
+			Precision: colTyp.GetPrecision(),
+			Scale:     colTyp.GetScale(),
+			Size:      colTyp.GetSize(),
+		},
+		Default: engine.DefaultExpr{
+			Exist:  col.GetDefault().GetExist(),
+			Value:  col.GetDefault().GetValue(),
+			IsNull: col.GetDefault().GetIsNull(),
+		},
+		Primary:       col.GetPrimary(),
+		AutoIncrement: col.GetAutoIncrement(),
+		NotNull:       col.GetNotNull(),
+	}
+}
+
 // Get the number of cpu's available for the current scope
 func (s *Scope) NumCPU() int {
 	return runtime.NumCPU()
@@ -248,7 +459,23 @@ func (s *Scope) ParallelRun(e engine.Engine) error {
 		if err != nil {
 			return err
 		}
-		rds = rel.NewReader(mcpu, nil, s.NodeInfo.Data, snap)
+		rds = rel.NewReader(mcpu, s.DataSource.Cond, s.NodeInfo.Data, snap)
+		if s.DataSource.LockMode != engine.RowLockNone {
+			for _, rd := range rds {
+				lr, ok := rd.(engine.LockingReader)
+				if !ok {
+					return errors.New(errno.FeatureNotSupported, "locking reads are not supported by the storage engine")
+				}
+				lr.SetRowLock(s.DataSource.LockMode, s.DataSource.LockWait)
+			}
+		}
+		if s.DataSource.Partitions != nil {
+			for _, rd := range rds {
+				if pr, ok := rd.(engine.PartitionReader); ok {
+					pr.SetPartitions(s.DataSource.Partitions)
+				}
+			}
+		}
 	}
 	ss := make([]*Scope, mcpu)
 	for i := 0; i < mcpu; i++ {
@@ -264,6 +491,9 @@ func (s *Scope) ParallelRun(e engine.Engine) error {
 		ss[i].Proc = process.New(mheap.New(s.Proc.Mp.Gm))
 		ss[i].Proc.Id = s.Proc.Id
 		ss[i].Proc.Lim = s.Proc.Lim
+		ss[i].Proc.Tr = s.Proc.Tr
+		ss[i].Proc.AnalInfos = s.Proc.AnalInfos
+		ss[i].Proc.ScopeInfos = s.Proc.ScopeInfos
 		ss[i].Proc.UnixTime = s.Proc.UnixTime
 		ss[i].Proc.Snapshot = s.Proc.Snapshot
 	}
@@ -280,7 +510,8 @@ func (s *Scope) ParallelRun(e engine.Engine) error {
 				arg := in.Arg.(*top.Argument)
 				s.Instructions = append(s.Instructions[:1], s.Instructions[i+1:]...)
 				s.Instructions[0] = vm.Instruction{
-					Op: overload.MergeTop,
+					Op:  overload.MergeTop,
+					Idx: in.Idx,
 					Arg: &mergetop.Argument{
 						Fs:    arg.Fs,
 						Limit: arg.Limit,
@@ -288,7 +519,8 @@ func (s *Scope) ParallelRun(e engine.Engine) error {
 				}
 				for i := range ss {
 					ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
-						Op: overload.Top,
+						Op:  overload.Top,
+						Idx: in.Idx,
 						Arg: &top.Argument{
 							Fs:    arg.Fs,
 							Limit: arg.Limit,
@@ -300,14 +532,16 @@ func (s *Scope) ParallelRun(e engine.Engine) error {
 				arg := in.Arg.(*order.Argument)
 				s.Instructions = append(s.Instructions[:1], s.Instructions[i+1:]...)
 				s.Instructions[0] = vm.Instruction{
-					Op: overload.MergeOrder,
+					Op:  overload.MergeOrder,
+					Idx: in.Idx,
 					Arg: &mergeorder.Argument{
 						Fs: arg.Fs,
 					},
 				}
 				for i := range ss {
 					ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
-						Op: overload.Order,
+						Op:  overload.Order,
+						Idx: in.Idx,
 						Arg: &order.Argument{
 							Fs: arg.Fs,
 						},
@@ -318,14 +552,16 @@ func (s *Scope) ParallelRun(e engine.Engine) error {
 				arg := in.Arg.(*limit.Argument)
 				s.Instructions = append(s.Instructions[:1], s.Instructions[i+1:]...)
 				s.Instructions[0] = vm.Instruction{
-					Op: overload.MergeLimit,
+					Op:  overload.MergeLimit,
+					Idx: in.Idx,
 					Arg: &mergelimit.Argument{
 						Limit: arg.Limit,
 					},
 				}
 				for i := range ss {
 					ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
-						Op: overload.Limit,
+						Op:  overload.Limit,
+						Idx: in.Idx,
 						Arg: &limit.Argument{
 							Limit: arg.Limit,
 						},
@@ -336,14 +572,16 @@ func (s *Scope) ParallelRun(e engine.Engine) error {
 				arg := in.Arg.(*group.Argument)
 				s.Instructions = append(s.Instructions[:1], s.Instructions[i+1:]...)
 				s.Instructions[0] = vm.Instruction{
-					Op: overload.MergeGroup,
+					Op:  overload.MergeGroup,
+					Idx: in.Idx,
 					Arg: &mergegroup.Argument{
 						NeedEval: false,
 					},
 				}
 				for i := range ss {
 					ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
-						Op: overload.Group,
+						Op:  overload.Group,
+						Idx: in.Idx,
 						Arg: &group.Argument{
 							Aggs:  arg.Aggs,
 							Exprs: arg.Exprs,
@@ -355,14 +593,16 @@ func (s *Scope) ParallelRun(e engine.Engine) error {
 				arg := in.Arg.(*offset.Argument)
 				s.Instructions = append(s.Instructions[:1], s.Instructions[i+1:]...)
 				s.Instructions[0] = vm.Instruction{
-					Op: overload.MergeOffset,
+					Op:  overload.MergeOffset,
+					Idx: in.Idx,
 					Arg: &mergeoffset.Argument{
 						Offset: arg.Offset,
 					},
 				}
 				for i := range ss {
 					ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
-						Op: overload.Offset,
+						Op:  overload.Offset,
+						Idx: in.Idx,
 						Arg: &offset.Argument{
 							Offset: arg.Offset,
 						},
@@ -383,6 +623,7 @@ func (s *Scope) ParallelRun(e engine.Engine) error {
 			}
 			s.Instructions[0] = vm.Instruction{
 				Op:  overload.Merge,
+				Idx: -1,
 				Arg: &merge.Argument{},
 			}
 			s.Instructions[1] = s.Instructions[len(s.Instructions)-1]
@@ -404,7 +645,8 @@ func (s *Scope) ParallelRun(e engine.Engine) error {
 	}
 	for i := range ss {
 		ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
-			Op: vm.Connector,
+			Op:  overload.Connector,
+			Idx: -1,
 			Arg: &connector.Argument{
 				Mmu: s.Proc.Mp.Gm,
 				Reg: s.Proc.Reg.MergeReceivers[i],
@@ -18,6 +18,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -35,6 +36,8 @@ const (
 	DropDatabase
 	DropTable
 	DropIndex
+	AlterTable
+	Insert
 )
 
 // Address is the ip:port of local node
@@ -47,6 +50,14 @@ type Source struct {
 	Attributes   []string
 	R            engine.Reader
 	Bat          *batch.Batch
+	// Cond is the part of the filter pushed down to the storage engine
+	Cond extend.Extend
+	// LockMode and LockWait are the row locks taken by a locking read
+	LockMode engine.RowLockMode
+	LockWait engine.RowLockWait
+	// Partitions are the partitions the planner pruned the scan to, nil
+	// if it reads all of them
+	Partitions []string
 }
 
 // Col is the information of attribute
@@ -97,4 +108,8 @@ type compile struct {
 	e engine.Engine
 	// proc stores the execution context.
 	proc *process.Process
+	// snapshots are opened by the AS OF TIMESTAMP clauses of the sql.
+	snapshots []engine.Snapshot
+	// affectRows is the number of rows written by an insert
+	affectRows uint64
 }
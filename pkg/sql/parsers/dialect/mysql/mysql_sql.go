@@ -36,411 +36,419 @@ const BY = 57358
 const LIMIT = 57359
 const OFFSET = 57360
 const FOR = 57361
-const ALL = 57362
-const DISTINCT = 57363
-const DISTINCTROW = 57364
-const AS = 57365
-const EXISTS = 57366
-const ASC = 57367
-const DESC = 57368
-const INTO = 57369
-const DUPLICATE = 57370
-const DEFAULT = 57371
-const SET = 57372
-const LOCK = 57373
-const KEYS = 57374
-const VALUES = 57375
-const LAST_INSERT_ID = 57376
-const NEXT = 57377
-const VALUE = 57378
-const SHARE = 57379
-const MODE = 57380
-const SQL_NO_CACHE = 57381
-const SQL_CACHE = 57382
-const JOIN = 57383
-const STRAIGHT_JOIN = 57384
-const LEFT = 57385
-const RIGHT = 57386
-const INNER = 57387
-const OUTER = 57388
-const CROSS = 57389
-const NATURAL = 57390
-const USE = 57391
-const FORCE = 57392
-const ON = 57393
-const USING = 57394
-const SUBQUERY_AS_EXPR = 57395
-const ID = 57396
-const AT_ID = 57397
-const AT_AT_ID = 57398
-const STRING = 57399
-const VALUE_ARG = 57400
-const LIST_ARG = 57401
-const COMMENT = 57402
-const COMMENT_KEYWORD = 57403
-const INTEGRAL = 57404
-const HEX = 57405
-const HEXNUM = 57406
-const BIT_LITERAL = 57407
-const FLOAT = 57408
-const NULL = 57409
-const TRUE = 57410
-const FALSE = 57411
-const EMPTY_FROM_CLAUSE = 57412
-const LOWER_THAN_CHARSET = 57413
-const CHARSET = 57414
-const UNIQUE = 57415
-const KEY = 57416
-const OR = 57417
-const XOR = 57418
-const AND = 57419
-const NOT = 57420
-const BETWEEN = 57421
-const CASE = 57422
-const WHEN = 57423
-const THEN = 57424
-const ELSE = 57425
-const END = 57426
-const LE = 57427
-const GE = 57428
-const NE = 57429
-const NULL_SAFE_EQUAL = 57430
-const IS = 57431
-const LIKE = 57432
-const REGEXP = 57433
-const IN = 57434
-const ASSIGNMENT = 57435
-const SHIFT_LEFT = 57436
-const SHIFT_RIGHT = 57437
-const DIV = 57438
-const MOD = 57439
-const UNARY = 57440
-const COLLATE = 57441
-const BINARY = 57442
-const UNDERSCORE_BINARY = 57443
-const INTERVAL = 57444
-const BEGIN = 57445
-const START = 57446
-const TRANSACTION = 57447
-const COMMIT = 57448
-const ROLLBACK = 57449
-const WORK = 57450
-const CONSISTENT = 57451
-const SNAPSHOT = 57452
-const CHAIN = 57453
-const NO = 57454
-const RELEASE = 57455
-const BIT = 57456
-const TINYINT = 57457
-const SMALLINT = 57458
-const MEDIUMINT = 57459
-const INT = 57460
-const INTEGER = 57461
-const BIGINT = 57462
-const INTNUM = 57463
-const REAL = 57464
-const DOUBLE = 57465
-const FLOAT_TYPE = 57466
-const DECIMAL = 57467
-const NUMERIC = 57468
-const TIME = 57469
-const TIMESTAMP = 57470
-const DATETIME = 57471
-const YEAR = 57472
-const CHAR = 57473
-const VARCHAR = 57474
-const BOOL = 57475
-const CHARACTER = 57476
-const VARBINARY = 57477
-const NCHAR = 57478
-const TEXT = 57479
-const TINYTEXT = 57480
-const MEDIUMTEXT = 57481
-const LONGTEXT = 57482
-const BLOB = 57483
-const TINYBLOB = 57484
-const MEDIUMBLOB = 57485
-const LONGBLOB = 57486
-const JSON = 57487
-const ENUM = 57488
-const GEOMETRY = 57489
-const POINT = 57490
-const LINESTRING = 57491
-const POLYGON = 57492
-const GEOMETRYCOLLECTION = 57493
-const MULTIPOINT = 57494
-const MULTILINESTRING = 57495
-const MULTIPOLYGON = 57496
-const INT1 = 57497
-const INT2 = 57498
-const INT3 = 57499
-const INT4 = 57500
-const INT8 = 57501
-const CREATE = 57502
-const ALTER = 57503
-const DROP = 57504
-const RENAME = 57505
-const ANALYZE = 57506
-const ADD = 57507
-const SCHEMA = 57508
-const TABLE = 57509
-const INDEX = 57510
-const VIEW = 57511
-const TO = 57512
-const IGNORE = 57513
-const IF = 57514
-const PRIMARY = 57515
-const COLUMN = 57516
-const CONSTRAINT = 57517
-const SPATIAL = 57518
-const FULLTEXT = 57519
-const FOREIGN = 57520
-const KEY_BLOCK_SIZE = 57521
-const SHOW = 57522
-const DESCRIBE = 57523
-const EXPLAIN = 57524
-const DATE = 57525
-const ESCAPE = 57526
-const REPAIR = 57527
-const OPTIMIZE = 57528
-const TRUNCATE = 57529
-const MAXVALUE = 57530
-const PARTITION = 57531
-const REORGANIZE = 57532
-const LESS = 57533
-const THAN = 57534
-const PROCEDURE = 57535
-const TRIGGER = 57536
-const STATUS = 57537
-const VARIABLES = 57538
-const ROLE = 57539
-const PROXY = 57540
-const AVG_ROW_LENGTH = 57541
-const STORAGE = 57542
-const DISK = 57543
-const MEMORY = 57544
-const CHECKSUM = 57545
-const COMPRESSION = 57546
-const DATA = 57547
-const DIRECTORY = 57548
-const DELAY_KEY_WRITE = 57549
-const ENCRYPTION = 57550
-const ENGINE = 57551
-const MAX_ROWS = 57552
-const MIN_ROWS = 57553
-const PACK_KEYS = 57554
-const ROW_FORMAT = 57555
-const STATS_AUTO_RECALC = 57556
-const STATS_PERSISTENT = 57557
-const STATS_SAMPLE_PAGES = 57558
-const DYNAMIC = 57559
-const COMPRESSED = 57560
-const REDUNDANT = 57561
-const COMPACT = 57562
-const FIXED = 57563
-const COLUMN_FORMAT = 57564
-const AUTO_RANDOM = 57565
-const RESTRICT = 57566
-const CASCADE = 57567
-const ACTION = 57568
-const PARTIAL = 57569
-const SIMPLE = 57570
-const CHECK = 57571
-const ENFORCED = 57572
-const RANGE = 57573
-const LIST = 57574
-const ALGORITHM = 57575
-const LINEAR = 57576
-const PARTITIONS = 57577
-const SUBPARTITION = 57578
-const SUBPARTITIONS = 57579
-const TYPE = 57580
-const PROPERTIES = 57581
-const PARSER = 57582
-const VISIBLE = 57583
-const INVISIBLE = 57584
-const BTREE = 57585
-const HASH = 57586
-const RTREE = 57587
-const BSI = 57588
-const ZONEMAP = 57589
-const EXPIRE = 57590
-const ACCOUNT = 57591
-const UNLOCK = 57592
-const DAY = 57593
-const NEVER = 57594
-const SECOND = 57595
-const ASCII = 57596
-const COALESCE = 57597
-const COLLATION = 57598
-const HOUR = 57599
-const MICROSECOND = 57600
-const MINUTE = 57601
-const MONTH = 57602
-const QUARTER = 57603
-const REPEAT = 57604
-const REVERSE = 57605
-const ROW_COUNT = 57606
-const WEEK = 57607
-const REVOKE = 57608
-const FUNCTION = 57609
-const PRIVILEGES = 57610
-const TABLESPACE = 57611
-const EXECUTE = 57612
-const SUPER = 57613
-const GRANT = 57614
-const OPTION = 57615
-const REFERENCES = 57616
-const REPLICATION = 57617
-const SLAVE = 57618
-const CLIENT = 57619
-const USAGE = 57620
-const RELOAD = 57621
-const FILE = 57622
-const TEMPORARY = 57623
-const ROUTINE = 57624
-const EVENT = 57625
-const SHUTDOWN = 57626
-const NULLX = 57627
-const AUTO_INCREMENT = 57628
-const APPROXNUM = 57629
-const SIGNED = 57630
-const UNSIGNED = 57631
-const ZEROFILL = 57632
-const USER = 57633
-const IDENTIFIED = 57634
-const CIPHER = 57635
-const ISSUER = 57636
-const X509 = 57637
-const SUBJECT = 57638
-const SAN = 57639
-const REQUIRE = 57640
-const SSL = 57641
-const NONE = 57642
-const PASSWORD = 57643
-const MAX_QUERIES_PER_HOUR = 57644
-const MAX_UPDATES_PER_HOUR = 57645
-const MAX_CONNECTIONS_PER_HOUR = 57646
-const MAX_USER_CONNECTIONS = 57647
-const FORMAT = 57648
-const VERBOSE = 57649
-const CONNECTION = 57650
-const LOAD = 57651
-const INFILE = 57652
-const TERMINATED = 57653
-const OPTIONALLY = 57654
-const ENCLOSED = 57655
-const ESCAPED = 57656
-const STARTING = 57657
-const LINES = 57658
-const DATABASES = 57659
-const TABLES = 57660
-const EXTENDED = 57661
-const FULL = 57662
-const PROCESSLIST = 57663
-const FIELDS = 57664
-const COLUMNS = 57665
-const OPEN = 57666
-const ERRORS = 57667
-const WARNINGS = 57668
-const INDEXES = 57669
-const NAMES = 57670
-const GLOBAL = 57671
-const SESSION = 57672
-const ISOLATION = 57673
-const LEVEL = 57674
-const READ = 57675
-const WRITE = 57676
-const ONLY = 57677
-const REPEATABLE = 57678
-const COMMITTED = 57679
-const UNCOMMITTED = 57680
-const SERIALIZABLE = 57681
-const LOCAL = 57682
-const EXCEPT = 57683
-const CURRENT_TIMESTAMP = 57684
-const DATABASE = 57685
-const CURRENT_TIME = 57686
-const LOCALTIME = 57687
-const LOCALTIMESTAMP = 57688
-const UTC_DATE = 57689
-const UTC_TIME = 57690
-const UTC_TIMESTAMP = 57691
-const REPLACE = 57692
-const CONVERT = 57693
-const SEPARATOR = 57694
-const CURRENT_DATE = 57695
-const CURRENT_USER = 57696
-const CURRENT_ROLE = 57697
-const SECOND_MICROSECOND = 57698
-const MINUTE_MICROSECOND = 57699
-const MINUTE_SECOND = 57700
-const HOUR_MICROSECOND = 57701
-const HOUR_SECOND = 57702
-const HOUR_MINUTE = 57703
-const DAY_MICROSECOND = 57704
-const DAY_SECOND = 57705
-const DAY_MINUTE = 57706
-const DAY_HOUR = 57707
-const YEAR_MONTH = 57708
-const SQL_TSI_HOUR = 57709
-const SQL_TSI_DAY = 57710
-const SQL_TSI_WEEK = 57711
-const SQL_TSI_MONTH = 57712
-const SQL_TSI_QUARTER = 57713
-const SQL_TSI_YEAR = 57714
-const SQL_TSI_SECOND = 57715
-const SQL_TSI_MINUTE = 57716
-const RECURSIVE = 57717
-const MATCH = 57718
-const AGAINST = 57719
-const BOOLEAN = 57720
-const LANGUAGE = 57721
-const WITH = 57722
-const QUERY = 57723
-const EXPANSION = 57724
-const ADDDATE = 57725
-const BIT_AND = 57726
-const BIT_OR = 57727
-const BIT_XOR = 57728
-const CAST = 57729
-const COUNT = 57730
-const APPROX_COUNT_DISTINCT = 57731
-const APPROX_PERCENTILE = 57732
-const CURDATE = 57733
-const CURTIME = 57734
-const DATE_ADD = 57735
-const DATE_SUB = 57736
-const EXTRACT = 57737
-const GROUP_CONCAT = 57738
-const MAX = 57739
-const MID = 57740
-const MIN = 57741
-const NOW = 57742
-const POSITION = 57743
-const SESSION_USER = 57744
-const STD = 57745
-const STDDEV = 57746
-const STDDEV_POP = 57747
-const STDDEV_SAMP = 57748
-const SUBDATE = 57749
-const SUBSTR = 57750
-const SUBSTRING = 57751
-const SUM = 57752
-const SYSDATE = 57753
-const SYSTEM_USER = 57754
-const TRANSLATE = 57755
-const TRIM = 57756
-const VARIANCE = 57757
-const VAR_POP = 57758
-const VAR_SAMP = 57759
-const AVG = 57760
-const ROW = 57761
-const OUTFILE = 57762
-const HEADER = 57763
-const MAX_FILE_SIZE = 57764
-const FORCE_QUOTE = 57765
-const UNUSED = 57766
+const OF = 57362
+const ALL = 57363
+const DISTINCT = 57364
+const DISTINCTROW = 57365
+const AS = 57366
+const EXISTS = 57367
+const ASC = 57368
+const DESC = 57369
+const INTO = 57370
+const DUPLICATE = 57371
+const DEFAULT = 57372
+const SET = 57373
+const LOCK = 57374
+const KEYS = 57375
+const VALUES = 57376
+const LAST_INSERT_ID = 57377
+const NEXT = 57378
+const VALUE = 57379
+const SHARE = 57380
+const MODE = 57381
+const SQL_NO_CACHE = 57382
+const SQL_CACHE = 57383
+const JOIN = 57384
+const STRAIGHT_JOIN = 57385
+const LEFT = 57386
+const RIGHT = 57387
+const INNER = 57388
+const OUTER = 57389
+const CROSS = 57390
+const NATURAL = 57391
+const USE = 57392
+const FORCE = 57393
+const ON = 57394
+const USING = 57395
+const SUBQUERY_AS_EXPR = 57396
+const ID = 57397
+const AT_ID = 57398
+const AT_AT_ID = 57399
+const STRING = 57400
+const VALUE_ARG = 57401
+const LIST_ARG = 57402
+const COMMENT = 57403
+const COMMENT_KEYWORD = 57404
+const INTEGRAL = 57405
+const HEX = 57406
+const HEXNUM = 57407
+const BIT_LITERAL = 57408
+const FLOAT = 57409
+const NULL = 57410
+const TRUE = 57411
+const FALSE = 57412
+const EMPTY_FROM_CLAUSE = 57413
+const LOWER_THAN_CHARSET = 57414
+const CHARSET = 57415
+const UNIQUE = 57416
+const KEY = 57417
+const OR = 57418
+const XOR = 57419
+const AND = 57420
+const NOT = 57421
+const BETWEEN = 57422
+const CASE = 57423
+const WHEN = 57424
+const THEN = 57425
+const ELSE = 57426
+const END = 57427
+const LE = 57428
+const GE = 57429
+const NE = 57430
+const NULL_SAFE_EQUAL = 57431
+const IS = 57432
+const LIKE = 57433
+const REGEXP = 57434
+const IN = 57435
+const ASSIGNMENT = 57436
+const SHIFT_LEFT = 57437
+const SHIFT_RIGHT = 57438
+const DIV = 57439
+const MOD = 57440
+const UNARY = 57441
+const COLLATE = 57442
+const BINARY = 57443
+const UNDERSCORE_BINARY = 57444
+const INTERVAL = 57445
+const BEGIN = 57446
+const START = 57447
+const TRANSACTION = 57448
+const COMMIT = 57449
+const ROLLBACK = 57450
+const WORK = 57451
+const CONSISTENT = 57452
+const SNAPSHOT = 57453
+const CHAIN = 57454
+const NO = 57455
+const RELEASE = 57456
+const BIT = 57457
+const TINYINT = 57458
+const SMALLINT = 57459
+const MEDIUMINT = 57460
+const INT = 57461
+const INTEGER = 57462
+const BIGINT = 57463
+const INTNUM = 57464
+const REAL = 57465
+const DOUBLE = 57466
+const FLOAT_TYPE = 57467
+const DECIMAL = 57468
+const NUMERIC = 57469
+const TIME = 57470
+const TIMESTAMP = 57471
+const DATETIME = 57472
+const YEAR = 57473
+const CHAR = 57474
+const VARCHAR = 57475
+const BOOL = 57476
+const CHARACTER = 57477
+const VARBINARY = 57478
+const NCHAR = 57479
+const TEXT = 57480
+const TINYTEXT = 57481
+const MEDIUMTEXT = 57482
+const LONGTEXT = 57483
+const BLOB = 57484
+const TINYBLOB = 57485
+const MEDIUMBLOB = 57486
+const LONGBLOB = 57487
+const JSON = 57488
+const ENUM = 57489
+const GEOMETRY = 57490
+const POINT = 57491
+const LINESTRING = 57492
+const POLYGON = 57493
+const GEOMETRYCOLLECTION = 57494
+const MULTIPOINT = 57495
+const MULTILINESTRING = 57496
+const MULTIPOLYGON = 57497
+const INT1 = 57498
+const INT2 = 57499
+const INT3 = 57500
+const INT4 = 57501
+const INT8 = 57502
+const CREATE = 57503
+const ALTER = 57504
+const DROP = 57505
+const RENAME = 57506
+const ANALYZE = 57507
+const ADD = 57508
+const MODIFY = 57509
+const SCHEMA = 57510
+const TABLE = 57511
+const INDEX = 57512
+const VIEW = 57513
+const TO = 57514
+const IGNORE = 57515
+const IF = 57516
+const PRIMARY = 57517
+const COLUMN = 57518
+const CONSTRAINT = 57519
+const SPATIAL = 57520
+const FULLTEXT = 57521
+const FOREIGN = 57522
+const KEY_BLOCK_SIZE = 57523
+const SHOW = 57524
+const DESCRIBE = 57525
+const EXPLAIN = 57526
+const DATE = 57527
+const ESCAPE = 57528
+const REPAIR = 57529
+const OPTIMIZE = 57530
+const TRUNCATE = 57531
+const MAXVALUE = 57532
+const PARTITION = 57533
+const REORGANIZE = 57534
+const LESS = 57535
+const THAN = 57536
+const PROCEDURE = 57537
+const TRIGGER = 57538
+const STATUS = 57539
+const VARIABLES = 57540
+const ROLE = 57541
+const PROXY = 57542
+const AVG_ROW_LENGTH = 57543
+const STORAGE = 57544
+const DISK = 57545
+const MEMORY = 57546
+const CHECKSUM = 57547
+const COMPRESSION = 57548
+const DATA = 57549
+const DIRECTORY = 57550
+const DELAY_KEY_WRITE = 57551
+const ENCRYPTION = 57552
+const ENGINE = 57553
+const MAX_ROWS = 57554
+const MIN_ROWS = 57555
+const PACK_KEYS = 57556
+const ROW_FORMAT = 57557
+const STATS_AUTO_RECALC = 57558
+const STATS_PERSISTENT = 57559
+const STATS_SAMPLE_PAGES = 57560
+const DYNAMIC = 57561
+const COMPRESSED = 57562
+const REDUNDANT = 57563
+const COMPACT = 57564
+const FIXED = 57565
+const COLUMN_FORMAT = 57566
+const AUTO_RANDOM = 57567
+const RESTRICT = 57568
+const CASCADE = 57569
+const ACTION = 57570
+const PARTIAL = 57571
+const SIMPLE = 57572
+const CHECK = 57573
+const ENFORCED = 57574
+const RANGE = 57575
+const LIST = 57576
+const ALGORITHM = 57577
+const LINEAR = 57578
+const PARTITIONS = 57579
+const SUBPARTITION = 57580
+const SUBPARTITIONS = 57581
+const TYPE = 57582
+const PROPERTIES = 57583
+const PARSER = 57584
+const VISIBLE = 57585
+const INVISIBLE = 57586
+const BTREE = 57587
+const HASH = 57588
+const RTREE = 57589
+const BSI = 57590
+const ZONEMAP = 57591
+const EXPIRE = 57592
+const ACCOUNT = 57593
+const UNLOCK = 57594
+const DAY = 57595
+const NEVER = 57596
+const SECOND = 57597
+const ASCII = 57598
+const COALESCE = 57599
+const COLLATION = 57600
+const HOUR = 57601
+const MICROSECOND = 57602
+const MINUTE = 57603
+const MONTH = 57604
+const QUARTER = 57605
+const REPEAT = 57606
+const REVERSE = 57607
+const ROW_COUNT = 57608
+const WEEK = 57609
+const REVOKE = 57610
+const FUNCTION = 57611
+const PRIVILEGES = 57612
+const TABLESPACE = 57613
+const EXECUTE = 57614
+const SUPER = 57615
+const GRANT = 57616
+const OPTION = 57617
+const REFERENCES = 57618
+const REPLICATION = 57619
+const SLAVE = 57620
+const CLIENT = 57621
+const USAGE = 57622
+const RELOAD = 57623
+const FILE = 57624
+const TEMPORARY = 57625
+const ROUTINE = 57626
+const EVENT = 57627
+const SHUTDOWN = 57628
+const NULLX = 57629
+const AUTO_INCREMENT = 57630
+const APPROXNUM = 57631
+const SIGNED = 57632
+const UNSIGNED = 57633
+const ZEROFILL = 57634
+const USER = 57635
+const IDENTIFIED = 57636
+const CIPHER = 57637
+const ISSUER = 57638
+const X509 = 57639
+const SUBJECT = 57640
+const SAN = 57641
+const REQUIRE = 57642
+const SSL = 57643
+const NONE = 57644
+const PASSWORD = 57645
+const MAX_QUERIES_PER_HOUR = 57646
+const MAX_UPDATES_PER_HOUR = 57647
+const MAX_CONNECTIONS_PER_HOUR = 57648
+const MAX_USER_CONNECTIONS = 57649
+const FORMAT = 57650
+const VERBOSE = 57651
+const CONNECTION = 57652
+const LOAD = 57653
+const INFILE = 57654
+const TERMINATED = 57655
+const OPTIONALLY = 57656
+const ENCLOSED = 57657
+const ESCAPED = 57658
+const STARTING = 57659
+const LINES = 57660
+const BACKUP = 57661
+const INCREMENTAL = 57662
+const NOWAIT = 57663
+const SKIP = 57664
+const LOCKED = 57665
+const SAVEPOINT = 57666
+const DATABASES = 57667
+const TABLES = 57668
+const EXTENDED = 57669
+const FULL = 57670
+const PROCESSLIST = 57671
+const FIELDS = 57672
+const COLUMNS = 57673
+const OPEN = 57674
+const ERRORS = 57675
+const WARNINGS = 57676
+const INDEXES = 57677
+const NAMES = 57678
+const GLOBAL = 57679
+const SESSION = 57680
+const ISOLATION = 57681
+const LEVEL = 57682
+const READ = 57683
+const WRITE = 57684
+const ONLY = 57685
+const REPEATABLE = 57686
+const COMMITTED = 57687
+const UNCOMMITTED = 57688
+const SERIALIZABLE = 57689
+const LOCAL = 57690
+const EXCEPT = 57691
+const CURRENT_TIMESTAMP = 57692
+const DATABASE = 57693
+const CURRENT_TIME = 57694
+const LOCALTIME = 57695
+const LOCALTIMESTAMP = 57696
+const UTC_DATE = 57697
+const UTC_TIME = 57698
+const UTC_TIMESTAMP = 57699
+const REPLACE = 57700
+const CONVERT = 57701
+const SEPARATOR = 57702
+const CURRENT_DATE = 57703
+const CURRENT_USER = 57704
+const CURRENT_ROLE = 57705
+const SECOND_MICROSECOND = 57706
+const MINUTE_MICROSECOND = 57707
+const MINUTE_SECOND = 57708
+const HOUR_MICROSECOND = 57709
+const HOUR_SECOND = 57710
+const HOUR_MINUTE = 57711
+const DAY_MICROSECOND = 57712
+const DAY_SECOND = 57713
+const DAY_MINUTE = 57714
+const DAY_HOUR = 57715
+const YEAR_MONTH = 57716
+const SQL_TSI_HOUR = 57717
+const SQL_TSI_DAY = 57718
+const SQL_TSI_WEEK = 57719
+const SQL_TSI_MONTH = 57720
+const SQL_TSI_QUARTER = 57721
+const SQL_TSI_YEAR = 57722
+const SQL_TSI_SECOND = 57723
+const SQL_TSI_MINUTE = 57724
+const RECURSIVE = 57725
+const MATCH = 57726
+const AGAINST = 57727
+const BOOLEAN = 57728
+const LANGUAGE = 57729
+const WITH = 57730
+const QUERY = 57731
+const EXPANSION = 57732
+const ADDDATE = 57733
+const BIT_AND = 57734
+const BIT_OR = 57735
+const BIT_XOR = 57736
+const CAST = 57737
+const COUNT = 57738
+const APPROX_COUNT_DISTINCT = 57739
+const APPROX_PERCENTILE = 57740
+const CURDATE = 57741
+const CURTIME = 57742
+const DATE_ADD = 57743
+const DATE_SUB = 57744
+const EXTRACT = 57745
+const GROUP_CONCAT = 57746
+const MAX = 57747
+const MID = 57748
+const MIN = 57749
+const NOW = 57750
+const POSITION = 57751
+const SESSION_USER = 57752
+const STD = 57753
+const STDDEV = 57754
+const STDDEV_POP = 57755
+const STDDEV_SAMP = 57756
+const SUBDATE = 57757
+const SUBSTR = 57758
+const SUBSTRING = 57759
+const SUM = 57760
+const SYSDATE = 57761
+const SYSTEM_USER = 57762
+const TRANSLATE = 57763
+const TRIM = 57764
+const VARIANCE = 57765
+const VAR_POP = 57766
+const VAR_SAMP = 57767
+const AVG = 57768
+const ROW = 57769
+const OUTFILE = 57770
+const HEADER = 57771
+const MAX_FILE_SIZE = 57772
+const FORCE_QUOTE = 57773
+const UNUSED = 57774
 
 var yyToknames = [...]string{
 	"$end",
@@ -462,6 +470,7 @@ var yyToknames = [...]string{
 	"LIMIT",
 	"OFFSET",
 	"FOR",
+	"OF",
 	"ALL",
 	"DISTINCT",
 	"DISTINCTROW",
@@ -625,6 +634,7 @@ var yyToknames = [...]string{
 	"RENAME",
 	"ANALYZE",
 	"ADD",
+	"MODIFY",
 	"SCHEMA",
 	"TABLE",
 	"INDEX",
@@ -776,6 +786,12 @@ var yyToknames = [...]string{
 	"ESCAPED",
 	"STARTING",
 	"LINES",
+	"BACKUP",
+	"INCREMENTAL",
+	"NOWAIT",
+	"SKIP",
+	"LOCKED",
+	"SAVEPOINT",
 	"DATABASES",
 	"TABLES",
 	"EXTENDED",
@@ -894,1889 +910,2186 @@ const yyEofCode = 1
 const yyErrCode = 2
 const yyInitialStackSize = 16
 
-//line mysql_sql.y:6299
+//line mysql_sql.y:6580
 
 //line yacctab:1
 var yyExca = [...]int{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 52,
-	17, 353,
-	-2, 334,
-	-1, 57,
-	185, 495,
-	-2, 531,
-	-1, 66,
-	212, 243,
-	213, 243,
-	-2, 263,
-	-1, 313,
-	58, 1287,
-	443, 1287,
-	-2, 92,
-	-1, 332,
-	58, 658,
-	443, 658,
-	-2, 493,
-	-1, 333,
-	58, 486,
-	443, 486,
-	-2, 494,
-	-1, 339,
-	17, 354,
-	-2, 317,
-	-1, 563,
-	17, 354,
-	-2, 317,
-	-1, 596,
-	54, 784,
-	-2, 1328,
+	-1, 60,
+	17, 398,
+	-2, 372,
+	-1, 65,
+	187, 543,
+	-2, 579,
+	-1, 74,
+	214, 278,
+	215, 278,
+	-2, 298,
+	-1, 330,
+	59, 1349,
+	451, 1349,
+	-2, 96,
+	-1, 349,
+	59, 709,
+	451, 709,
+	-2, 541,
+	-1, 350,
+	59, 534,
+	451, 534,
+	-2, 542,
+	-1, 357,
+	17, 399,
+	-2, 355,
 	-1, 597,
-	54, 785,
-	-2, 1329,
-	-1, 598,
-	54, 786,
-	-2, 1330,
-	-1, 600,
-	54, 793,
-	-2, 1333,
-	-1, 601,
-	54, 792,
-	-2, 1334,
-	-1, 607,
-	54, 867,
-	-2, 1232,
-	-1, 608,
-	54, 878,
-	-2, 1292,
-	-1, 609,
-	54, 880,
-	-2, 1302,
-	-1, 610,
-	54, 868,
-	-2, 1307,
-	-1, 762,
-	1, 521,
-	56, 521,
-	442, 521,
-	-2, 528,
-	-1, 879,
-	17, 353,
+	17, 399,
+	-2, 355,
+	-1, 630,
+	55, 836,
+	-2, 1390,
+	-1, 631,
+	55, 837,
+	-2, 1391,
+	-1, 632,
+	55, 838,
+	-2, 1392,
+	-1, 634,
+	55, 845,
+	-2, 1395,
+	-1, 635,
+	55, 844,
+	-2, 1396,
+	-1, 641,
+	55, 917,
+	-2, 1271,
+	-1, 642,
+	55, 921,
+	-2, 1295,
+	-1, 643,
+	55, 932,
+	-2, 1354,
+	-1, 644,
+	55, 934,
+	-2, 1364,
+	-1, 645,
+	55, 922,
+	-2, 1369,
+	-1, 802,
+	1, 569,
+	57, 569,
+	450, 569,
+	-2, 576,
+	-1, 936,
+	17, 398,
+	-2, 768,
+	-1, 985,
+	120, 1060,
+	-2, 1058,
+	-1, 987,
+	120, 483,
+	-2, 1055,
+	-1, 988,
+	120, 484,
+	-2, 1056,
+	-1, 1188,
+	1, 570,
+	57, 570,
+	450, 570,
+	-2, 576,
+	-1, 1581,
+	248, 735,
+	-2, 715,
+	-1, 1709,
+	76, 576,
+	116, 576,
+	149, 576,
+	152, 576,
+	-2, 619,
+	-1, 1735,
+	248, 735,
 	-2, 716,
-	-1, 925,
-	119, 1006,
-	-2, 1004,
-	-1, 927,
-	119, 435,
-	-2, 1001,
-	-1, 928,
-	119, 436,
-	-2, 1002,
-	-1, 1122,
-	1, 522,
-	56, 522,
-	442, 522,
-	-2, 528,
-	-1, 1542,
-	75, 528,
-	115, 528,
-	148, 528,
-	151, 528,
-	-2, 568,
-	-1, 1544,
-	246, 683,
-	-2, 664,
-	-1, 1662,
-	75, 528,
-	115, 528,
-	148, 528,
-	151, 528,
-	-2, 569,
-	-1, 1690,
-	246, 683,
-	-2, 665,
-	-1, 2081,
-	55, 543,
-	56, 543,
-	-2, 528,
-	-1, 2085,
-	55, 543,
-	56, 543,
-	-2, 528,
-	-1, 2097,
-	55, 547,
-	56, 547,
-	-2, 528,
-	-1, 2100,
-	55, 548,
-	56, 548,
-	-2, 528,
+	-1, 1830,
+	76, 576,
+	116, 576,
+	149, 576,
+	152, 576,
+	-2, 620,
+	-1, 1901,
+	56, 591,
+	57, 591,
+	-2, 576,
+	-1, 1971,
+	56, 591,
+	57, 591,
+	-2, 576,
+	-1, 2119,
+	56, 595,
+	57, 595,
+	-2, 576,
+	-1, 2163,
+	56, 596,
+	57, 596,
+	-2, 576,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 17450
+const yyLast = 20385
 
 var yyAct = [...]int{
-	752, 1174, 2087, 2085, 2084, 2092, 2058, 613, 2032, 741,
-	1922, 611, 631, 1735, 2047, 2003, 1702, 1984, 1898, 1985,
-	1901, 550, 1658, 1875, 84, 516, 1536, 289, 1109, 1733,
-	814, 1830, 1734, 548, 1886, 87, 640, 52, 1725, 454,
-	84, 302, 1803, 293, 19, 1620, 1436, 389, 1724, 334,
-	334, 300, 1338, 1621, 1603, 1623, 504, 1432, 798, 1628,
-	1632, 584, 574, 52, 1691, 1460, 1420, 1469, 1314, 1448,
-	1441, 1589, 1437, 390, 1487, 83, 1115, 1486, 1374, 411,
-	693, 295, 520, 84, 907, 821, 735, 558, 922, 916,
-	925, 908, 622, 1175, 612, 917, 1238, 3, 1252, 791,
-	292, 12, 290, 6, 291, 5, 1308, 1666, 738, 754,
-	51, 400, 402, 1123, 736, 1189, 340, 52, 339, 1176,
-	1173, 577, 492, 710, 19, 795, 1091, 1082, 767, 768,
-	766, 851, 285, 456, 420, 282, 431, 559, 816, 382,
-	727, 306, 305, 442, 296, 80, 410, 1098, 541, 471,
-	1748, 304, 1654, 1535, 749, 910, 79, 309, 309, 525,
-	1950, 79, 408, 23, 39, 24, 79, 79, 23, 39,
-	24, 1094, 1290, 1421, 341, 527, 1309, 1939, 417, 1297,
-	502, 12, 359, 6, 523, 5, 401, 79, 406, 405,
-	396, 690, 398, 79, 687, 785, 352, 491, 780, 781,
-	77, 336, 515, 1300, 75, 514, 517, 518, 369, 75,
-	770, 1972, 2007, 1970, 75, 689, 517, 518, 404, 744,
-	1397, 486, 528, 1988, 1989, 482, 1831, 1832, 1833, 1834,
-	1828, 1537, 1424, 1910, 383, 75, 1425, 1913, 1426, 1751,
-	748, 75, 1449, 1450, 1451, 1452, 1277, 434, 397, 425,
-	1317, 1315, 1312, 1316, 1318, 1470, 1311, 1310, 1473, 477,
-	1317, 1315, 792, 1316, 1318, 1094, 1096, 370, 1802, 473,
-	1453, 1711, 1710, 484, 485, 1707, 1651, 483, 472, 1532,
-	1819, 1614, 84, 424, 728, 1615, 1611, 478, 1320, 1321,
-	1322, 1323, 1949, 1998, 2077, 84, 423, 1887, 1888, 1889,
-	1891, 1890, 1974, 354, 1809, 2093, 2012, 1472, 1920, 1921,
-	730, 1924, 403, 351, 350, 1969, 1987, 2019, 1442, 1445,
-	1924, 1947, 458, 52, 52, 402, 1900, 1797, 2068, 1766,
-	1765, 434, 338, 537, 346, 1788, 459, 480, 464, 1976,
-	1977, 1930, 513, 512, 2094, 2088, 438, 2059, 1754, 419,
-	1375, 505, 526, 1908, 1952, 1953, 1294, 1145, 1298, 475,
-	524, 1102, 468, 422, 407, 1792, 756, 1445, 507, 1533,
-	1612, 476, 479, 481, 393, 294, 702, 703, 497, 334,
-	374, 474, 1630, 1629, 729, 390, 390, 390, 503, 436,
-	435, 1143, 1142, 1336, 1141, 531, 529, 530, 783, 401,
-	784, 1140, 782, 506, 371, 508, 372, 2072, 2036, 805,
-	411, 1427, 463, 580, 1760, 2050, 1348, 1288, 349, 427,
-	428, 1287, 692, 553, 1860, 864, 1415, 1446, 345, 376,
-	375, 1276, 1439, 1270, 561, 1135, 1440, 1443, 707, 1107,
-	424, 84, 84, 84, 84, 1076, 833, 395, 695, 555,
-	1328, 437, 724, 711, 421, 52, 1413, 521, 1093, 706,
-	2054, 542, 688, 1178, 1177, 540, 52, 705, 334, 334,
-	424, 334, 543, 436, 435, 1446, 1414, 458, 494, 429,
-	353, 2045, 1461, 742, 509, 1934, 1975, 309, 1444, 334,
-	334, 459, 1899, 1951, 488, 725, 517, 518, 517, 518,
-	1317, 1315, 510, 1316, 1318, 334, 579, 334, 1092, 762,
-	84, 1272, 1147, 1421, 2051, 1080, 562, 564, 398, 563,
-	793, 1613, 1610, 426, 775, 1117, 334, 1515, 1306, 761,
-	536, 759, 1097, 1253, 470, 539, 547, 828, 334, 390,
-	1790, 334, 496, 519, 1789, 522, 1799, 78, 773, 1170,
-	1183, 366, 78, 1291, 1793, 1794, 806, 78, 78, 757,
-	1171, 763, 698, 567, 568, 569, 570, 571, 334, 334,
-	813, 84, 573, 411, 397, 746, 822, 309, 78, 743,
-	831, 776, 1798, 393, 78, 712, 713, 714, 715, 751,
-	511, 758, 755, 817, 723, 764, 765, 1253, 560, 1380,
-	373, 771, 830, 828, 747, 1593, 1588, 818, 1783, 731,
-	740, 2067, 777, 73, 1349, 309, 772, 750, 881, 544,
-	545, 546, 815, 1326, 1659, 1981, 2048, 2049, 745, 1861,
-	1863, 1864, 1865, 1862, 2008, 799, 829, 830, 828, 1511,
-	1871, 799, 2083, 1869, 1517, 769, 309, 829, 830, 828,
-	808, 1867, 2066, 879, 811, 794, 395, 2064, 760, 1328,
-	863, 862, 872, 873, 865, 866, 867, 868, 869, 870,
-	871, 864, 377, 804, 2029, 834, 1870, 309, 790, 1868,
-	2013, 807, 829, 830, 828, 1857, 809, 1866, 399, 789,
-	914, 914, 919, 801, 802, 803, 363, 460, 461, 462,
-	551, 1959, 810, 1906, 364, 460, 461, 462, 1605, 822,
-	880, 1905, 882, 883, 884, 885, 886, 927, 888, 819,
-	812, 1856, 1877, 890, 554, 1855, 402, 401, 891, 1854,
-	1853, 928, 1644, 1327, 1245, 858, 52, 872, 873, 865,
-	866, 867, 868, 869, 870, 871, 864, 905, 1243, 1244,
-	1242, 549, 460, 461, 462, 551, 552, 84, 84, 1850,
-	2097, 1844, 1841, 1840, 1606, 1806, 1186, 1749, 2065, 1643,
-	289, 1383, 1385, 1743, 1382, 1188, 897, 1137, 2075, 460,
-	461, 462, 551, 1742, 1997, 913, 334, 1090, 921, 817,
-	1078, 829, 830, 828, 1077, 1741, 1740, 829, 830, 828,
-	401, 1737, 1599, 818, 1110, 1111, 334, 1598, 1112, 1114,
-	920, 552, 398, 863, 862, 872, 873, 865, 866, 867,
-	868, 869, 870, 871, 864, 580, 2053, 84, 1597, 926,
-	1596, 1409, 1074, 1167, 1168, 696, 1980, 1075, 552, 829,
-	830, 828, 1087, 1876, 1126, 1127, 1128, 1941, 1928, 1129,
-	1106, 1184, 1185, 361, 1138, 362, 369, 829, 830, 828,
-	360, 358, 357, 365, 1355, 367, 368, 1927, 1858, 1851,
-	1124, 1847, 1101, 1846, 1226, 1227, 1228, 1229, 1230, 1231,
-	1232, 1233, 1234, 1235, 1236, 1237, 1845, 1105, 1804, 1247,
-	1248, 1785, 905, 1750, 309, 799, 799, 799, 1172, 1131,
-	1260, 1133, 769, 1134, 1160, 1132, 1130, 1339, 1163, 1657,
-	829, 830, 828, 1655, 1152, 1262, 1904, 1607, 579, 829,
-	830, 828, 1164, 1165, 1166, 1458, 1488, 1148, 1149, 1150,
-	1457, 1153, 1456, 1154, 460, 461, 462, 1144, 829, 830,
-	828, 1181, 1565, 1694, 1455, 1104, 1161, 1103, 901, 1499,
-	1496, 1497, 1498, 900, 1493, 899, 1492, 1491, 1489, 867,
-	868, 869, 870, 871, 864, 1179, 1180, 1240, 1182, 697,
-	1351, 1246, 1351, 2102, 1219, 1220, 1221, 1222, 1697, 1223,
-	1224, 1225, 1956, 1254, 1692, 2042, 1257, 2096, 2095, 1388,
-	1705, 1706, 1351, 1387, 1955, 1693, 862, 872, 873, 865,
-	866, 867, 868, 869, 870, 871, 864, 1255, 1275, 1935,
-	1490, 1100, 2078, 1256, 1258, 865, 866, 867, 868, 869,
-	870, 871, 864, 1261, 1884, 1263, 1264, 1821, 1553, 1698,
-	863, 862, 872, 873, 865, 866, 867, 868, 869, 870,
-	871, 864, 1820, 1572, 1576, 1578, 1580, 1582, 1583, 1585,
-	1645, 1499, 1496, 1497, 1498, 1642, 1567, 1568, 1569, 1570,
-	1551, 1552, 1573, 1641, 1554, 1619, 1555, 1556, 1557, 1558,
-	1559, 1560, 1561, 1562, 1563, 1564, 1571, 1278, 1542, 343,
-	424, 2074, 2073, 1524, 1575, 1577, 1579, 1581, 1584, 342,
-	1100, 2062, 1475, 711, 1474, 334, 1376, 1391, 334, 1100,
-	2061, 424, 1389, 334, 1704, 1386, 1438, 1384, 1303, 1360,
-	1826, 1357, 1566, 1350, 1293, 1494, 1495, 863, 862, 872,
-	873, 865, 866, 867, 868, 869, 870, 871, 864, 1335,
-	566, 1700, 829, 830, 828, 1259, 1333, 837, 838, 839,
-	840, 841, 842, 1814, 835, 694, 334, 1638, 2035, 2034,
-	1816, 1995, 826, 1699, 1701, 726, 84, 84, 565, 1523,
-	1344, 1816, 1990, 1514, 1265, 829, 830, 828, 1508, 829,
-	830, 828, 1325, 1543, 1282, 1305, 1094, 1283, 1156, 1978,
-	1285, 829, 830, 828, 1356, 829, 830, 828, 1079, 1295,
-	829, 830, 828, 1525, 52, 1280, 824, 398, 1507, 1301,
-	1302, 19, 755, 1281, 1506, 1707, 1292, 1341, 1342, 1289,
-	1329, 1505, 1967, 1966, 1330, 1504, 1331, 1695, 1304, 1347,
-	829, 830, 828, 1816, 1945, 468, 829, 830, 828, 1503,
-	1124, 1271, 1337, 829, 830, 828, 1324, 829, 830, 828,
-	1816, 1944, 487, 1369, 1502, 1334, 466, 1340, 1816, 1943,
-	465, 829, 830, 828, 466, 1343, 1372, 1373, 12, 1485,
-	6, 1250, 5, 1816, 1942, 1332, 829, 830, 828, 914,
-	1352, 1401, 914, 1353, 1354, 1404, 79, 1484, 879, 1933,
-	1932, 829, 830, 828, 1156, 822, 467, 334, 1882, 1883,
-	1108, 334, 334, 1882, 1881, 334, 1825, 1824, 1407, 829,
-	830, 828, 1823, 1822, 52, 1483, 572, 1574, 424, 2098,
-	1249, 1398, 1408, 1362, 1363, 1364, 1365, 1366, 1367, 1368,
-	84, 1435, 1816, 1815, 75, 1396, 538, 829, 830, 828,
-	468, 1403, 829, 830, 828, 1240, 1159, 1527, 1371, 1370,
-	1351, 1509, 1351, 1500, 1377, 1351, 1359, 1381, 84, 1480,
-	1379, 2044, 401, 2038, 1400, 1351, 1358, 1393, 1392, 2020,
-	799, 1402, 1159, 1279, 1399, 1405, 799, 1406, 1410, 2017,
-	1411, 1459, 2015, 1412, 1274, 1273, 1268, 1267, 1159, 1158,
-	1958, 1419, 1454, 1100, 1099, 700, 699, 1896, 1880, 1878,
-	1873, 1835, 1462, 1463, 1622, 1812, 1811, 1810, 1807, 1416,
-	1418, 1796, 1522, 1781, 1721, 1718, 1717, 1624, 575, 1633,
-	1636, 1464, 1465, 1601, 1594, 1466, 1241, 1519, 334, 1307,
-	1284, 1266, 1521, 1157, 1146, 1139, 906, 904, 1480, 1479,
-	84, 903, 902, 898, 852, 895, 893, 892, 1513, 1587,
-	444, 447, 448, 449, 445, 1482, 446, 450, 889, 75,
-	1510, 861, 860, 1808, 859, 1501, 694, 857, 856, 855,
-	1518, 854, 853, 850, 849, 52, 848, 847, 846, 845,
-	1512, 844, 1540, 843, 1516, 708, 691, 469, 1120, 1520,
-	1618, 1541, 1526, 2025, 1604, 444, 447, 448, 449, 445,
-	439, 446, 450, 2023, 1602, 1089, 1531, 1986, 1591, 1083,
-	1084, 444, 447, 448, 449, 445, 1319, 446, 450, 1586,
-	1550, 1590, 1155, 1590, 1592, 1086, 489, 1595, 303, 720,
-	718, 1088, 1600, 717, 721, 719, 1528, 716, 2082, 1269,
-	334, 334, 2000, 556, 84, 557, 1609, 1625, 1626, 1627,
-	722, 1125, 448, 449, 424, 1663, 1422, 1608, 1110, 1111,
-	1429, 493, 1529, 1118, 779, 1631, 1752, 1435, 1634, 1530,
-	1637, 413, 415, 416, 1073, 1428, 820, 452, 335, 1178,
-	1177, 1617, 495, 1639, 499, 500, 2039, 1963, 1961, 1915,
-	1914, 1912, 1838, 1836, 1647, 1652, 1656, 1616, 1539, 1726,
-	1728, 1708, 1726, 1726, 1538, 1712, 1478, 498, 1650, 1715,
-	1716, 1688, 424, 1477, 1660, 342, 1714, 1713, 343, 1346,
-	694, 1640, 1361, 1719, 2026, 1722, 1723, 1286, 342, 799,
-	2027, 2026, 2027, 281, 451, 355, 1, 501, 1727, 704,
-	433, 875, 701, 878, 432, 430, 74, 1251, 1648, 1649,
-	1729, 1730, 1190, 641, 2040, 909, 915, 876, 877, 874,
-	1731, 863, 862, 872, 873, 865, 866, 867, 868, 869,
-	870, 871, 864, 1874, 1999, 1756, 2031, 1957, 2002, 1739,
-	630, 614, 1907, 1423, 1827, 1909, 1829, 1299, 1746, 1745,
-	1296, 490, 1394, 1395, 653, 643, 894, 644, 1732, 863,
-	862, 872, 873, 865, 866, 867, 868, 869, 870, 871,
-	864, 686, 414, 642, 1738, 1471, 1784, 344, 84, 412,
-	356, 1801, 1534, 1709, 1759, 1635, 1720, 1187, 2091, 2081,
-	1604, 2057, 2037, 1923, 2076, 1968, 2018, 2011, 1919, 1753,
-	1757, 1758, 1728, 1761, 1762, 1763, 1764, 1708, 1744, 1767,
-	1768, 1769, 1770, 1771, 1772, 1773, 1774, 1775, 1776, 1777,
-	1778, 1779, 1780, 1786, 307, 1782, 786, 532, 1839, 1800,
-	380, 1805, 1897, 387, 709, 1447, 1313, 1116, 1095, 737,
-	1813, 308, 1948, 1879, 347, 1119, 348, 1122, 1121, 836,
-	1872, 1817, 1239, 896, 887, 582, 1378, 621, 615, 52,
-	1468, 458, 1467, 1703, 774, 26, 1837, 453, 827, 923,
-	86, 1136, 924, 1916, 1747, 459, 2004, 629, 424, 1852,
-	628, 424, 424, 424, 627, 626, 1390, 424, 443, 441,
-	440, 299, 298, 1345, 1476, 1842, 1843, 823, 825, 1983,
-	1818, 1848, 1849, 1982, 1937, 1938, 1917, 1885, 1646, 1653,
-	1893, 1894, 1895, 1795, 1892, 1859, 1903, 1791, 1787, 1902,
-	1929, 1662, 1661, 1689, 1690, 1696, 1549, 1545, 1547, 1918,
-	1548, 1911, 863, 862, 872, 873, 865, 866, 867, 868,
-	869, 870, 871, 864, 84, 1546, 1925, 1926, 1544, 1433,
-	1434, 424, 1431, 863, 862, 872, 873, 865, 866, 867,
-	868, 869, 870, 871, 864, 1430, 1085, 424, 1081, 911,
-	918, 418, 753, 81, 1931, 297, 1162, 576, 11, 18,
-	1940, 863, 862, 872, 873, 865, 866, 867, 868, 869,
-	870, 871, 864, 17, 16, 815, 1946, 47, 46, 45,
-	44, 15, 8, 1954, 43, 1962, 1960, 1964, 1965, 42,
-	41, 14, 13, 37, 36, 35, 1971, 1973, 34, 33,
-	32, 31, 30, 29, 28, 27, 9, 1979, 56, 2006,
-	55, 54, 53, 1991, 1992, 1993, 1994, 20, 2010, 21,
-	22, 2005, 1936, 62, 61, 60, 59, 58, 25, 10,
-	7, 4, 2014, 2, 2016, 0, 2009, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	2021, 0, 0, 2024, 2022, 0, 0, 0, 2033, 0,
-	0, 0, 2028, 0, 0, 1996, 424, 0, 424, 0,
-	2030, 0, 0, 0, 0, 2041, 0, 2043, 0, 742,
-	0, 742, 2046, 0, 0, 0, 2006, 2056, 0, 0,
-	0, 0, 2052, 0, 0, 424, 0, 0, 2005, 0,
-	2060, 2055, 0, 0, 2063, 0, 0, 0, 742, 0,
-	0, 2033, 2069, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 2079, 0, 0, 0, 0, 0, 0,
-	0, 2080, 0, 0, 0, 0, 0, 0, 2090, 0,
-	2089, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	2101, 2100, 2099, 2090, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1041, 1027, 0, 989, 1043, 961, 977,
-	1051, 979, 980, 1014, 939, 998, 211, 975, 931, 964,
-	965, 933, 972, 934, 962, 991, 155, 960, 1030, 1001,
-	180, 1049, 182, 0, 0, 240, 195, 0, 2071, 994,
-	1032, 996, 1019, 988, 1015, 947, 1008, 1044, 976, 1012,
-	1045, 0, 0, 0, 0, 460, 461, 462, 0, 0,
-	0, 0, 138, 0, 0, 0, 0, 0, 1011, 1037,
-	974, 0, 0, 948, 1042, 995, 1013, 0, 932, 1009,
-	0, 937, 940, 1050, 1035, 969, 970, 0, 0, 0,
-	0, 0, 0, 0, 992, 997, 1016, 985, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 966, 0, 1005,
-	0, 0, 0, 942, 938, 0, 990, 0, 129, 245,
-	259, 139, 236, 272, 143, 243, 135, 210, 232, 131,
-	257, 242, 192, 174, 175, 130, 0, 227, 153, 166,
-	150, 208, 1039, 1040, 149, 275, 941, 267, 133, 134,
-	266, 207, 254, 258, 193, 187, 132, 256, 191, 186,
-	178, 157, 170, 220, 185, 221, 171, 197, 196, 198,
-	1061, 1062, 1063, 1064, 1065, 946, 0, 967, 1017, 0,
-	930, 1026, 1033, 987, 269, 1036, 984, 983, 1068, 0,
-	1067, 244, 1069, 1070, 179, 1031, 963, 973, 968, 971,
-	230, 213, 1038, 1004, 218, 228, 183, 255, 222, 260,
-	246, 268, 1020, 223, 125, 247, 152, 194, 136, 137,
-	148, 154, 156, 158, 159, 203, 204, 216, 235, 248,
-	249, 250, 151, 144, 229, 145, 168, 146, 126, 237,
-	147, 127, 217, 253, 1066, 165, 225, 190, 128, 189,
-	219, 252, 251, 276, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 162, 929, 264, 0, 209, 1028, 935,
-	945, 943, 981, 1006, 1007, 205, 280, 1022, 1025, 1023,
-	1052, 233, 1210, 0, 0, 0, 0, 173, 215, 0,
-	234, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 936, 0, 241, 262, 274, 265, 982, 954, 993,
-	273, 957, 955, 1021, 956, 1010, 1054, 199, 200, 201,
-	202, 978, 0, 142, 1002, 986, 1055, 1056, 1057, 1058,
-	1059, 1060, 959, 1034, 161, 167, 0, 169, 141, 214,
-	164, 271, 176, 206, 172, 238, 177, 184, 226, 270,
-	212, 231, 140, 261, 239, 188, 163, 953, 958, 952,
-	999, 1000, 1046, 1047, 1048, 1018, 944, 1029, 949, 951,
-	950, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1024, 1003, 124, 0, 181, 1053, 224, 160, 0, 0,
-	0, 0, 0, 1206, 0, 1203, 0, 0, 0, 1205,
-	1202, 1204, 1208, 1209, 0, 0, 0, 1207, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 79, 0,
-	649, 0, 0, 0, 1071, 1072, 277, 278, 279, 263,
-	211, 0, 0, 0, 0, 0, 623, 0, 0, 0,
-	155, 0, 0, 0, 180, 0, 182, 0, 0, 240,
-	195, 0, 0, 0, 0, 665, 671, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 616, 0, 0, 583,
-	655, 654, 632, 0, 0, 0, 138, 633, 0, 638,
-	0, 634, 637, 635, 636, 0, 0, 657, 0, 0,
-	0, 0, 0, 581, 620, 0, 624, 0, 1191, 1192,
-	1193, 1194, 1195, 1196, 1197, 1198, 1199, 1200, 1201, 1213,
-	1214, 1215, 1216, 1217, 1218, 1211, 1212, 617, 618, 0,
-	0, 0, 0, 650, 0, 619, 0, 0, 652, 0,
-	639, 0, 129, 245, 259, 139, 236, 272, 143, 243,
-	135, 210, 232, 131, 257, 242, 192, 174, 175, 130,
-	0, 227, 153, 166, 150, 208, 647, 648, 149, 609,
-	645, 267, 133, 134, 266, 207, 254, 258, 193, 187,
-	132, 256, 191, 186, 178, 157, 170, 220, 185, 221,
-	171, 197, 196, 198, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 269, 0,
-	0, 663, 0, 0, 0, 244, 0, 0, 179, 0,
-	0, 0, 646, 0, 230, 213, 674, 0, 218, 228,
-	183, 255, 222, 260, 246, 268, 0, 223, 125, 247,
-	152, 194, 136, 137, 148, 154, 156, 158, 159, 203,
-	204, 216, 235, 248, 249, 250, 151, 144, 229, 145,
-	168, 146, 126, 237, 147, 127, 217, 253, 0, 165,
-	225, 190, 128, 189, 219, 252, 251, 276, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 162, 0, 264,
-	661, 209, 673, 656, 658, 659, 662, 666, 667, 607,
-	610, 668, 670, 672, 675, 233, 0, 0, 0, 0,
-	0, 173, 215, 0, 234, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 241, 262, 274,
-	608, 0, 0, 0, 273, 0, 0, 0, 0, 0,
-	651, 199, 200, 201, 202, 664, 0, 142, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 161, 167,
-	0, 169, 141, 214, 164, 271, 176, 206, 172, 238,
-	177, 184, 226, 270, 212, 231, 140, 261, 239, 188,
-	163, 681, 660, 680, 682, 683, 679, 684, 685, 669,
-	625, 0, 677, 676, 678, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 124, 0, 181, 78,
-	224, 160, 88, 585, 586, 587, 588, 589, 590, 591,
-	96, 592, 98, 99, 593, 101, 594, 103, 595, 105,
-	106, 107, 596, 597, 598, 599, 112, 600, 601, 602,
-	603, 117, 118, 119, 120, 604, 605, 606, 649, 0,
-	277, 278, 279, 263, 0, 0, 0, 0, 211, 0,
-	0, 0, 0, 0, 623, 0, 0, 0, 155, 800,
-	0, 0, 180, 0, 182, 0, 0, 240, 195, 0,
-	0, 0, 0, 665, 671, 0, 0, 0, 0, 0,
-	0, 796, 0, 0, 616, 0, 0, 583, 655, 654,
-	632, 0, 0, 0, 138, 633, 0, 638, 0, 634,
-	637, 635, 636, 0, 0, 657, 0, 0, 0, 0,
-	0, 581, 620, 0, 624, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 617, 618, 0, 0, 0,
-	0, 650, 0, 619, 0, 0, 797, 0, 639, 0,
-	129, 245, 259, 139, 236, 272, 143, 243, 135, 210,
-	232, 131, 257, 242, 192, 174, 175, 130, 0, 227,
-	153, 166, 150, 208, 647, 648, 149, 609, 645, 267,
-	133, 134, 266, 207, 254, 258, 193, 187, 132, 256,
-	191, 186, 178, 157, 170, 220, 185, 221, 171, 197,
-	196, 198, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 269, 0, 0, 663,
-	0, 0, 0, 244, 0, 0, 179, 0, 0, 0,
-	646, 0, 230, 213, 674, 0, 218, 228, 183, 255,
-	222, 260, 246, 268, 0, 223, 125, 247, 152, 194,
-	136, 137, 148, 154, 156, 158, 159, 203, 204, 216,
-	235, 248, 249, 250, 151, 144, 229, 145, 168, 146,
-	126, 237, 147, 127, 217, 253, 0, 165, 225, 190,
-	128, 189, 219, 252, 251, 276, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 162, 0, 264, 661, 209,
-	673, 656, 658, 659, 662, 666, 667, 607, 610, 668,
-	670, 672, 675, 233, 0, 0, 0, 0, 0, 173,
-	215, 0, 234, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 241, 262, 274, 608, 0,
-	0, 0, 273, 0, 0, 0, 0, 0, 651, 199,
-	200, 201, 202, 664, 0, 142, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 161, 167, 0, 169,
-	141, 214, 164, 271, 176, 206, 172, 238, 177, 184,
-	226, 270, 212, 231, 140, 261, 239, 188, 163, 681,
-	660, 680, 682, 683, 679, 684, 685, 669, 625, 0,
-	677, 676, 678, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 124, 0, 181, 0, 224, 160,
-	88, 585, 586, 587, 588, 589, 590, 591, 96, 592,
-	98, 99, 593, 101, 594, 103, 595, 105, 106, 107,
-	596, 597, 598, 599, 112, 600, 601, 602, 603, 117,
-	118, 119, 120, 604, 605, 606, 649, 0, 277, 278,
-	279, 263, 0, 0, 0, 0, 211, 0, 0, 0,
-	0, 0, 623, 0, 0, 0, 155, 2070, 0, 0,
-	180, 0, 182, 0, 0, 240, 195, 0, 0, 0,
-	0, 665, 671, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 616, 0, 0, 583, 655, 654, 632, 0,
-	0, 0, 138, 633, 0, 638, 0, 634, 637, 635,
-	636, 0, 0, 657, 0, 0, 0, 0, 0, 581,
-	620, 0, 624, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 617, 618, 0, 0, 0, 0, 650,
-	0, 619, 0, 0, 652, 0, 639, 0, 129, 245,
-	259, 139, 236, 272, 143, 243, 135, 210, 232, 131,
-	257, 242, 192, 174, 175, 130, 0, 227, 153, 166,
-	150, 208, 647, 648, 149, 609, 645, 267, 133, 134,
-	266, 207, 254, 258, 193, 187, 132, 256, 191, 186,
-	178, 157, 170, 220, 185, 221, 171, 197, 196, 198,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 269, 0, 0, 663, 0, 0,
-	0, 244, 0, 0, 179, 0, 0, 0, 646, 0,
-	230, 213, 674, 0, 218, 228, 183, 255, 222, 260,
-	246, 268, 0, 223, 125, 247, 152, 194, 136, 137,
-	148, 154, 156, 158, 159, 203, 204, 216, 235, 248,
-	249, 250, 151, 144, 229, 145, 168, 146, 126, 237,
-	147, 127, 217, 253, 0, 165, 225, 190, 128, 189,
-	219, 252, 251, 276, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 162, 0, 264, 661, 209, 673, 656,
-	658, 659, 662, 666, 667, 607, 610, 668, 670, 672,
-	675, 233, 0, 0, 0, 0, 0, 173, 215, 0,
-	234, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 241, 262, 274, 608, 0, 0, 0,
-	273, 0, 0, 0, 0, 0, 651, 199, 200, 201,
-	202, 664, 0, 142, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 161, 167, 0, 169, 141, 214,
-	164, 271, 176, 206, 172, 238, 177, 184, 226, 270,
-	212, 231, 140, 261, 239, 188, 163, 681, 660, 680,
-	682, 683, 679, 684, 685, 669, 625, 0, 677, 676,
-	678, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 124, 0, 181, 0, 224, 160, 88, 585,
-	586, 587, 588, 589, 590, 591, 96, 592, 98, 99,
-	593, 101, 594, 103, 595, 105, 106, 107, 596, 597,
-	598, 599, 112, 600, 601, 602, 603, 117, 118, 119,
-	120, 604, 605, 606, 649, 0, 277, 278, 279, 263,
-	0, 0, 0, 0, 211, 0, 0, 0, 0, 0,
-	623, 0, 0, 0, 155, 800, 0, 0, 180, 0,
-	182, 0, 0, 240, 195, 0, 0, 0, 0, 665,
-	671, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	616, 0, 0, 583, 655, 654, 632, 0, 0, 0,
-	138, 633, 0, 638, 0, 634, 637, 635, 636, 0,
-	0, 657, 0, 0, 0, 0, 0, 581, 620, 0,
-	624, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 617, 618, 0, 0, 0, 0, 650, 0, 619,
-	0, 0, 652, 0, 639, 0, 129, 245, 259, 139,
-	236, 272, 143, 243, 135, 210, 232, 131, 257, 242,
-	192, 174, 175, 130, 0, 227, 153, 166, 150, 208,
-	647, 648, 149, 609, 645, 267, 133, 134, 266, 207,
-	254, 258, 193, 187, 132, 256, 191, 186, 178, 157,
-	170, 220, 185, 221, 171, 197, 196, 198, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 269, 0, 0, 663, 0, 0, 0, 244,
-	0, 0, 179, 0, 0, 0, 646, 0, 230, 213,
-	674, 0, 218, 228, 183, 255, 222, 260, 246, 268,
-	0, 223, 125, 247, 152, 194, 136, 137, 148, 154,
-	156, 158, 159, 203, 204, 216, 235, 248, 249, 250,
-	151, 144, 229, 145, 168, 146, 126, 237, 147, 127,
-	217, 253, 0, 165, 225, 190, 128, 189, 219, 252,
-	251, 276, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 162, 0, 264, 661, 209, 673, 656, 658, 659,
-	662, 666, 667, 607, 610, 668, 670, 672, 675, 233,
-	0, 0, 0, 0, 0, 173, 215, 0, 234, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 241, 262, 274, 608, 0, 0, 0, 273, 0,
-	0, 0, 0, 0, 651, 199, 200, 201, 202, 664,
-	0, 142, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 161, 167, 0, 169, 141, 214, 164, 271,
-	176, 206, 172, 238, 177, 184, 226, 270, 212, 231,
-	140, 261, 239, 188, 163, 681, 660, 680, 682, 683,
-	679, 684, 685, 669, 625, 0, 677, 676, 678, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	124, 0, 181, 0, 224, 160, 88, 585, 586, 587,
-	588, 589, 590, 591, 96, 592, 98, 99, 593, 101,
-	594, 103, 595, 105, 106, 107, 596, 597, 598, 599,
-	112, 600, 601, 602, 603, 117, 118, 119, 120, 604,
-	605, 606, 649, 0, 277, 278, 279, 263, 0, 0,
-	0, 0, 211, 0, 0, 0, 0, 0, 623, 0,
-	0, 0, 155, 0, 0, 0, 180, 0, 182, 0,
-	0, 240, 195, 0, 0, 0, 0, 665, 671, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 616, 0,
-	0, 583, 655, 654, 632, 0, 0, 0, 138, 633,
-	0, 638, 0, 634, 637, 635, 636, 0, 0, 657,
-	0, 0, 0, 0, 0, 581, 620, 0, 624, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 617,
-	618, 578, 0, 0, 0, 650, 0, 619, 0, 0,
-	652, 0, 639, 0, 129, 245, 259, 139, 236, 272,
-	143, 243, 135, 210, 232, 131, 257, 242, 192, 174,
-	175, 130, 0, 227, 153, 166, 150, 208, 647, 648,
-	149, 609, 645, 267, 133, 134, 266, 207, 254, 258,
-	193, 187, 132, 256, 191, 186, 178, 157, 170, 220,
-	185, 221, 171, 197, 196, 198, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	269, 0, 0, 663, 0, 0, 0, 244, 0, 0,
-	179, 0, 0, 0, 646, 0, 230, 213, 674, 0,
-	218, 228, 183, 255, 222, 260, 246, 268, 0, 223,
-	125, 247, 152, 194, 136, 137, 148, 154, 156, 158,
-	159, 203, 204, 216, 235, 248, 249, 250, 151, 144,
-	229, 145, 168, 146, 126, 237, 147, 127, 217, 253,
-	0, 165, 225, 190, 128, 189, 219, 252, 251, 276,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 162,
-	0, 264, 661, 209, 673, 656, 658, 659, 662, 666,
-	667, 607, 610, 668, 670, 672, 675, 233, 0, 0,
-	0, 0, 0, 173, 215, 0, 234, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 241,
-	262, 274, 608, 0, 0, 0, 273, 0, 0, 0,
-	0, 0, 651, 199, 200, 201, 202, 664, 0, 142,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	161, 167, 0, 169, 141, 214, 164, 271, 176, 206,
-	172, 238, 177, 184, 226, 270, 212, 231, 140, 261,
-	239, 188, 163, 681, 660, 680, 682, 683, 679, 684,
-	685, 669, 625, 0, 677, 676, 678, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 124, 0,
-	181, 0, 224, 160, 88, 585, 586, 587, 588, 589,
-	590, 591, 96, 592, 98, 99, 593, 101, 594, 103,
-	595, 105, 106, 107, 596, 597, 598, 599, 112, 600,
-	601, 602, 603, 117, 118, 119, 120, 604, 605, 606,
-	649, 0, 277, 278, 279, 263, 0, 0, 0, 0,
-	211, 0, 0, 0, 0, 0, 623, 0, 0, 0,
-	155, 0, 0, 0, 180, 0, 182, 0, 0, 240,
-	195, 0, 0, 0, 0, 665, 671, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 616, 0, 0, 583,
-	655, 654, 632, 0, 0, 0, 138, 633, 0, 638,
-	0, 634, 637, 635, 636, 0, 0, 657, 0, 0,
-	0, 0, 0, 581, 620, 0, 624, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 617, 618, 0,
-	0, 0, 0, 650, 0, 619, 0, 0, 652, 0,
-	639, 0, 129, 245, 259, 139, 236, 272, 143, 243,
-	135, 210, 232, 131, 257, 242, 192, 174, 175, 130,
-	0, 227, 153, 166, 150, 208, 647, 648, 149, 609,
-	645, 267, 133, 134, 266, 207, 254, 258, 193, 187,
-	132, 256, 191, 186, 178, 157, 170, 220, 185, 221,
-	171, 197, 196, 198, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 269, 0,
-	0, 663, 0, 0, 0, 244, 0, 0, 179, 0,
-	0, 0, 646, 0, 230, 213, 674, 0, 218, 228,
-	183, 255, 222, 260, 246, 268, 0, 223, 125, 247,
-	152, 194, 136, 137, 148, 154, 156, 158, 159, 203,
-	204, 216, 235, 248, 249, 250, 151, 144, 229, 145,
-	168, 146, 126, 237, 147, 127, 217, 253, 0, 165,
-	225, 190, 128, 189, 219, 252, 251, 276, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 162, 0, 264,
-	661, 209, 673, 656, 658, 659, 662, 666, 667, 607,
-	610, 668, 670, 672, 675, 233, 0, 0, 0, 0,
-	0, 173, 215, 0, 234, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 241, 262, 274,
-	608, 0, 0, 0, 273, 0, 0, 0, 0, 0,
-	651, 199, 200, 201, 202, 664, 0, 142, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 161, 167,
-	0, 169, 141, 214, 164, 271, 176, 206, 172, 238,
-	177, 184, 226, 270, 212, 231, 140, 261, 239, 188,
-	163, 681, 660, 680, 682, 683, 679, 684, 685, 669,
-	625, 0, 677, 676, 678, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 124, 0, 181, 0,
-	224, 160, 88, 585, 586, 587, 588, 589, 590, 591,
-	96, 592, 98, 99, 593, 101, 594, 103, 595, 105,
-	106, 107, 596, 597, 598, 599, 112, 600, 601, 602,
-	603, 117, 118, 119, 120, 604, 605, 606, 649, 0,
-	277, 278, 279, 263, 0, 0, 0, 0, 211, 0,
-	0, 0, 0, 0, 623, 0, 0, 0, 155, 0,
-	0, 0, 180, 0, 182, 0, 0, 240, 195, 0,
-	0, 0, 0, 665, 671, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 616, 0, 0, 583, 655, 654,
-	632, 0, 0, 0, 138, 633, 0, 638, 0, 634,
-	637, 635, 636, 0, 0, 657, 0, 0, 0, 0,
-	0, 0, 620, 0, 624, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 617, 618, 0, 0, 0,
-	0, 650, 0, 619, 0, 0, 652, 0, 639, 0,
-	129, 245, 259, 139, 236, 272, 143, 243, 135, 210,
-	232, 131, 257, 242, 192, 174, 175, 130, 0, 227,
-	153, 166, 150, 208, 647, 648, 149, 609, 645, 267,
-	133, 134, 266, 207, 254, 258, 193, 187, 132, 256,
-	191, 186, 178, 157, 170, 220, 185, 221, 171, 197,
-	196, 198, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 269, 0, 0, 663,
-	0, 0, 0, 244, 0, 0, 179, 0, 0, 0,
-	646, 0, 230, 213, 674, 0, 218, 228, 183, 255,
-	222, 260, 246, 268, 0, 223, 125, 247, 152, 194,
-	136, 137, 148, 154, 156, 158, 159, 203, 204, 216,
-	235, 248, 249, 250, 151, 144, 229, 145, 168, 146,
-	126, 237, 147, 127, 217, 253, 0, 165, 225, 190,
-	128, 189, 219, 252, 251, 276, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 162, 0, 264, 661, 209,
-	673, 656, 658, 659, 662, 666, 667, 607, 610, 668,
-	670, 672, 675, 233, 0, 0, 0, 0, 0, 173,
-	215, 0, 234, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 241, 262, 274, 608, 0,
-	0, 0, 273, 0, 0, 0, 0, 0, 651, 199,
-	200, 201, 202, 664, 0, 142, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 161, 167, 0, 169,
-	141, 214, 164, 271, 176, 206, 172, 238, 177, 184,
-	226, 270, 212, 231, 140, 261, 239, 188, 163, 681,
-	660, 680, 682, 683, 679, 684, 685, 669, 625, 0,
-	677, 676, 678, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 124, 0, 181, 0, 224, 160,
-	88, 585, 586, 587, 588, 589, 590, 591, 96, 592,
-	98, 99, 593, 101, 594, 103, 595, 105, 106, 107,
-	596, 597, 598, 599, 112, 600, 601, 602, 603, 117,
-	118, 119, 120, 604, 605, 606, 0, 0, 277, 278,
-	279, 263, 319, 0, 318, 322, 314, 0, 0, 0,
-	0, 0, 0, 0, 211, 0, 310, 0, 0, 0,
-	0, 0, 0, 0, 155, 0, 0, 329, 180, 0,
-	182, 0, 0, 240, 195, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 332, 0, 0, 333, 0, 0, 0,
-	138, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 319, 0, 318, 322, 314,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 310,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	329, 0, 0, 0, 0, 0, 129, 245, 259, 139,
-	236, 272, 143, 243, 135, 210, 232, 131, 257, 242,
-	192, 174, 175, 130, 0, 227, 153, 166, 150, 208,
-	0, 0, 149, 275, 0, 267, 133, 134, 266, 207,
-	254, 258, 193, 187, 132, 256, 191, 186, 178, 157,
-	170, 220, 185, 221, 171, 197, 196, 198, 0, 0,
-	0, 0, 0, 312, 311, 315, 0, 0, 0, 0,
-	0, 317, 269, 0, 0, 0, 0, 0, 0, 244,
-	0, 0, 179, 321, 0, 0, 0, 0, 230, 213,
-	0, 0, 218, 228, 183, 255, 222, 313, 246, 268,
-	0, 337, 125, 247, 152, 194, 136, 137, 148, 154,
-	156, 158, 159, 203, 204, 216, 235, 248, 249, 250,
-	151, 144, 229, 145, 168, 146, 126, 237, 147, 127,
-	217, 253, 0, 165, 225, 190, 128, 189, 219, 252,
-	251, 276, 0, 0, 0, 0, 312, 311, 315, 0,
-	0, 162, 0, 264, 317, 209, 0, 0, 0, 0,
-	0, 0, 0, 205, 280, 0, 321, 0, 0, 233,
-	0, 0, 0, 316, 320, 323, 215, 324, 325, 0,
-	732, 326, 327, 328, 0, 0, 330, 331, 0, 0,
-	0, 241, 262, 274, 265, 0, 0, 0, 273, 0,
-	0, 0, 0, 0, 0, 199, 200, 201, 202, 0,
-	0, 142, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 161, 167, 0, 169, 141, 214, 164, 271,
-	176, 206, 172, 238, 177, 184, 226, 270, 212, 231,
-	140, 261, 239, 188, 163, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 316, 320, 733, 0,
-	324, 734, 0, 0, 326, 327, 328, 0, 0, 330,
-	331, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	124, 0, 181, 0, 224, 160, 88, 89, 90, 91,
-	92, 93, 94, 95, 96, 97, 98, 99, 100, 101,
-	102, 103, 104, 105, 106, 107, 108, 109, 110, 111,
-	112, 113, 114, 115, 116, 117, 118, 119, 120, 121,
-	122, 123, 0, 0, 277, 278, 279, 263, 319, 0,
-	318, 322, 314, 0, 0, 0, 0, 0, 0, 0,
-	211, 0, 310, 0, 0, 0, 0, 0, 0, 0,
-	155, 0, 0, 329, 180, 0, 182, 0, 0, 240,
-	195, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 332,
-	0, 0, 333, 0, 0, 0, 138, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 129, 245, 259, 139, 236, 272, 143, 243,
-	135, 210, 232, 131, 257, 242, 192, 174, 175, 130,
-	0, 227, 153, 166, 150, 208, 0, 0, 149, 275,
-	0, 267, 133, 134, 266, 207, 254, 258, 193, 187,
-	132, 256, 191, 186, 178, 157, 170, 220, 185, 221,
-	171, 197, 196, 198, 0, 0, 0, 0, 0, 312,
-	311, 315, 0, 0, 0, 0, 0, 317, 269, 0,
-	0, 0, 0, 0, 0, 244, 0, 0, 179, 321,
-	0, 0, 0, 0, 230, 213, 0, 0, 218, 228,
-	183, 255, 222, 313, 246, 268, 0, 223, 125, 247,
-	152, 194, 136, 137, 148, 154, 156, 158, 159, 203,
-	204, 216, 235, 248, 249, 250, 151, 144, 229, 145,
-	168, 146, 126, 237, 147, 127, 217, 253, 0, 165,
-	225, 190, 128, 189, 219, 252, 251, 276, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 162, 0, 264,
-	0, 209, 0, 0, 0, 0, 0, 0, 0, 205,
-	280, 0, 0, 0, 0, 233, 0, 0, 0, 316,
-	320, 323, 215, 324, 325, 0, 0, 326, 327, 328,
-	0, 0, 330, 331, 0, 0, 0, 241, 262, 274,
-	265, 0, 0, 0, 273, 0, 0, 0, 0, 0,
-	0, 199, 200, 201, 202, 0, 0, 142, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 161, 167,
-	0, 169, 141, 214, 164, 271, 176, 206, 172, 238,
-	177, 184, 226, 270, 212, 231, 140, 261, 239, 188,
-	163, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 124, 0, 181, 0,
-	224, 160, 88, 89, 90, 91, 92, 93, 94, 95,
-	96, 97, 98, 99, 100, 101, 102, 103, 104, 105,
-	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
-	116, 117, 118, 119, 120, 121, 122, 123, 0, 0,
-	277, 278, 279, 263, 79, 0, 23, 39, 24, 0,
-	0, 0, 0, 0, 0, 0, 211, 283, 0, 0,
-	0, 0, 0, 0, 0, 0, 155, 0, 0, 0,
-	180, 0, 182, 0, 0, 240, 195, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 288, 0, 0, 85, 0, 0, 0, 0,
-	0, 0, 138, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 129, 245,
-	259, 139, 236, 272, 143, 243, 135, 210, 232, 131,
-	257, 242, 192, 174, 175, 130, 0, 227, 153, 166,
-	150, 208, 0, 0, 149, 275, 0, 267, 133, 134,
-	266, 207, 254, 258, 193, 187, 132, 256, 191, 186,
-	178, 157, 170, 220, 185, 221, 171, 197, 196, 198,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 287,
-	0, 0, 0, 0, 269, 0, 0, 0, 0, 0,
-	0, 244, 0, 0, 179, 0, 0, 0, 0, 0,
-	230, 213, 0, 0, 218, 228, 183, 255, 222, 260,
-	246, 268, 0, 223, 125, 247, 152, 194, 136, 137,
-	148, 154, 156, 158, 159, 203, 204, 216, 235, 248,
-	249, 250, 151, 144, 229, 145, 168, 146, 126, 237,
-	147, 127, 217, 253, 0, 165, 225, 190, 128, 189,
-	219, 252, 251, 276, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 162, 0, 264, 0, 209, 0, 0,
-	0, 0, 0, 0, 0, 205, 280, 0, 0, 0,
-	0, 233, 0, 0, 0, 0, 0, 173, 215, 0,
-	234, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 241, 262, 274, 265, 0, 0, 0,
-	273, 0, 0, 0, 0, 0, 0, 199, 200, 201,
-	202, 284, 286, 142, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 161, 167, 0, 169, 141, 214,
-	164, 271, 176, 206, 172, 238, 177, 184, 226, 270,
-	212, 231, 140, 261, 239, 188, 163, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 124, 0, 181, 78, 224, 160, 88, 89,
-	90, 91, 92, 93, 94, 95, 96, 97, 98, 99,
-	100, 101, 102, 103, 104, 105, 106, 107, 108, 109,
-	110, 111, 112, 113, 114, 115, 116, 117, 118, 119,
-	120, 121, 122, 123, 211, 0, 277, 278, 279, 263,
-	0, 0, 0, 0, 155, 0, 0, 0, 180, 0,
-	182, 0, 0, 240, 195, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 85, 0, 0, 0, 0, 0, 0,
-	138, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1442, 1445, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 129, 245, 259, 139,
-	236, 272, 143, 243, 135, 210, 232, 131, 257, 242,
-	192, 174, 175, 130, 0, 227, 153, 166, 150, 208,
-	0, 0, 149, 275, 0, 267, 133, 134, 266, 207,
-	254, 258, 193, 187, 132, 256, 191, 186, 178, 157,
-	170, 220, 185, 221, 171, 197, 196, 198, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1446, 269, 0, 0, 0, 1439, 0, 1438, 244,
-	1440, 1443, 179, 0, 0, 0, 0, 0, 230, 213,
-	0, 0, 218, 228, 183, 255, 222, 260, 246, 268,
-	0, 223, 125, 247, 152, 194, 136, 137, 148, 154,
-	156, 158, 159, 203, 204, 216, 235, 248, 249, 250,
-	151, 144, 229, 145, 168, 146, 126, 237, 147, 127,
-	217, 253, 1444, 165, 225, 190, 128, 189, 219, 252,
-	251, 276, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 162, 0, 264, 0, 209, 0, 0, 0, 0,
-	0, 0, 0, 205, 280, 0, 0, 0, 0, 233,
-	0, 0, 0, 0, 0, 173, 215, 0, 234, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 241, 262, 274, 265, 0, 0, 0, 273, 0,
-	0, 0, 0, 0, 0, 199, 200, 201, 202, 0,
-	0, 142, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 161, 167, 0, 169, 141, 214, 164, 271,
-	176, 206, 172, 238, 177, 184, 226, 270, 212, 231,
-	140, 261, 239, 188, 163, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	124, 0, 181, 0, 224, 160, 88, 89, 90, 91,
-	92, 93, 94, 95, 96, 97, 98, 99, 100, 101,
-	102, 103, 104, 105, 106, 107, 108, 109, 110, 111,
-	112, 113, 114, 115, 116, 117, 118, 119, 120, 121,
-	122, 123, 211, 0, 277, 278, 279, 263, 0, 0,
-	0, 0, 155, 379, 0, 0, 180, 0, 182, 0,
-	0, 240, 195, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 85, 391, 392, 0, 0, 0, 0, 138, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 393,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 129, 245, 259, 139, 236, 272,
-	143, 243, 135, 210, 232, 131, 257, 242, 192, 174,
-	175, 130, 0, 227, 153, 166, 150, 208, 0, 0,
-	149, 275, 395, 267, 133, 394, 266, 207, 254, 258,
-	193, 187, 132, 256, 191, 186, 178, 157, 170, 220,
-	185, 221, 171, 197, 196, 198, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	269, 0, 0, 0, 0, 0, 0, 244, 0, 0,
-	179, 0, 0, 0, 0, 0, 230, 213, 0, 0,
-	218, 228, 183, 255, 222, 260, 246, 268, 378, 223,
-	125, 247, 152, 194, 136, 137, 148, 154, 156, 158,
-	159, 203, 204, 216, 235, 248, 249, 250, 151, 144,
-	229, 145, 168, 146, 126, 237, 147, 127, 217, 253,
-	0, 165, 225, 190, 128, 189, 219, 252, 251, 276,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 162,
-	0, 264, 0, 209, 0, 0, 0, 0, 0, 0,
-	0, 205, 280, 0, 0, 0, 0, 233, 0, 0,
-	0, 0, 0, 173, 215, 0, 234, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 241,
-	262, 274, 265, 0, 0, 0, 273, 0, 0, 0,
-	0, 0, 381, 199, 200, 201, 202, 0, 0, 142,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	161, 167, 0, 169, 141, 214, 164, 271, 176, 388,
-	384, 385, 177, 184, 226, 270, 212, 231, 140, 261,
-	239, 386, 163, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 124, 0,
-	181, 0, 224, 160, 88, 89, 90, 91, 92, 93,
-	94, 95, 96, 97, 98, 99, 100, 101, 102, 103,
-	104, 105, 106, 107, 108, 109, 110, 111, 112, 113,
-	114, 115, 116, 117, 118, 119, 120, 121, 122, 123,
-	79, 0, 277, 278, 279, 263, 0, 0, 0, 0,
-	0, 0, 211, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 155, 0, 0, 0, 180, 0, 182, 0,
-	0, 240, 195, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 75, 0,
-	912, 85, 0, 0, 0, 0, 0, 0, 138, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 129, 245, 259, 139, 236, 272,
-	143, 243, 135, 210, 232, 131, 257, 242, 192, 174,
-	175, 130, 0, 227, 153, 166, 150, 208, 0, 0,
-	149, 275, 0, 267, 133, 134, 266, 207, 254, 258,
-	193, 187, 132, 256, 191, 186, 178, 157, 170, 220,
-	185, 221, 171, 197, 196, 198, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	269, 0, 0, 0, 0, 0, 0, 244, 0, 0,
-	179, 0, 0, 0, 0, 0, 230, 213, 0, 0,
-	218, 228, 183, 255, 222, 260, 246, 268, 0, 223,
-	125, 247, 152, 194, 136, 137, 148, 154, 156, 158,
-	159, 203, 204, 216, 235, 248, 249, 250, 151, 144,
-	229, 145, 168, 146, 126, 237, 147, 127, 217, 253,
-	0, 165, 225, 190, 128, 189, 219, 252, 251, 276,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 162,
-	0, 264, 0, 209, 0, 0, 0, 0, 0, 0,
-	0, 205, 280, 0, 0, 0, 0, 233, 0, 0,
-	0, 0, 0, 173, 215, 0, 234, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 241,
-	262, 274, 265, 0, 0, 0, 273, 0, 0, 0,
-	0, 0, 0, 199, 200, 201, 202, 0, 0, 142,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	161, 167, 0, 169, 141, 214, 164, 271, 176, 206,
-	172, 238, 177, 184, 226, 270, 212, 231, 140, 261,
-	239, 188, 163, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 124, 0,
-	181, 78, 224, 160, 88, 89, 90, 91, 92, 93,
-	94, 95, 96, 97, 98, 99, 100, 101, 102, 103,
-	104, 105, 106, 107, 108, 109, 110, 111, 112, 113,
-	114, 115, 116, 117, 118, 119, 120, 121, 122, 123,
-	0, 211, 277, 278, 279, 263, 832, 0, 0, 0,
-	0, 155, 0, 0, 0, 180, 0, 182, 0, 0,
-	240, 195, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	85, 0, 0, 0, 0, 0, 0, 138, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 829, 830, 828, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 129, 245, 259, 139, 236, 272, 143,
-	243, 135, 210, 232, 131, 257, 242, 192, 174, 175,
-	130, 0, 227, 153, 166, 150, 208, 0, 0, 149,
-	275, 0, 267, 133, 134, 266, 207, 254, 258, 193,
-	187, 132, 256, 191, 186, 178, 157, 170, 220, 185,
-	221, 171, 197, 196, 198, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 269,
-	0, 0, 0, 0, 0, 0, 244, 0, 0, 179,
-	0, 0, 0, 0, 0, 230, 213, 0, 0, 218,
-	228, 183, 255, 222, 260, 246, 268, 0, 223, 125,
-	247, 152, 194, 136, 137, 148, 154, 156, 158, 159,
-	203, 204, 216, 235, 248, 249, 250, 151, 144, 229,
-	145, 168, 146, 126, 237, 147, 127, 217, 253, 0,
-	165, 225, 190, 128, 189, 219, 252, 251, 276, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 162, 0,
-	264, 0, 209, 0, 0, 0, 0, 0, 0, 0,
-	205, 280, 0, 0, 0, 0, 233, 0, 0, 0,
-	0, 0, 173, 215, 0, 234, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 241, 262,
-	274, 265, 0, 0, 0, 273, 0, 0, 0, 0,
-	0, 0, 199, 200, 201, 202, 0, 0, 142, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 161,
-	167, 0, 169, 141, 214, 164, 271, 176, 206, 172,
-	238, 177, 184, 226, 270, 212, 231, 140, 261, 239,
-	188, 163, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 124, 0, 181,
-	0, 224, 160, 88, 89, 90, 91, 92, 93, 94,
-	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
+	792, 648, 2201, 780, 646, 1627, 2079, 666, 1748, 1973,
+	1868, 2171, 2192, 1971, 1826, 2109, 1260, 2060, 2108, 2044,
+	584, 2041, 542, 1703, 1175, 2026, 94, 94, 871, 582,
+	306, 1981, 317, 1970, 1259, 413, 97, 1867, 480, 2029,
+	1866, 1445, 675, 60, 94, 319, 1858, 1894, 1628, 1728,
+	530, 1550, 1736, 1857, 1574, 1547, 351, 351, 93, 93,
+	1535, 857, 1758, 310, 21, 608, 746, 1801, 1773, 1761,
+	618, 1633, 60, 1405, 1759, 730, 1562, 1555, 1714, 1551,
+	1181, 399, 967, 414, 1655, 1485, 1639, 647, 1205, 435,
+	1656, 312, 878, 94, 592, 976, 968, 982, 774, 546,
+	977, 985, 657, 1337, 1323, 3, 59, 309, 13, 850,
+	307, 7, 1235, 1220, 308, 5, 1399, 747, 1189, 1834,
+	794, 978, 358, 1548, 357, 444, 1261, 424, 426, 777,
+	775, 1274, 819, 60, 1258, 611, 518, 854, 806, 807,
+	808, 299, 873, 1144, 908, 456, 321, 434, 593, 408,
+	766, 1156, 481, 323, 21, 467, 409, 577, 322, 90,
+	1163, 1910, 1822, 496, 722, 1702, 789, 970, 359, 87,
+	1159, 2100, 432, 89, 89, 302, 25, 43, 27, 89,
+	554, 25, 43, 27, 89, 326, 326, 313, 552, 89,
+	89, 1380, 425, 1536, 1400, 2052, 441, 1387, 13, 528,
+	370, 7, 549, 353, 842, 5, 420, 516, 422, 1221,
+	430, 429, 1222, 1441, 726, 1223, 377, 723, 1510, 1440,
+	1439, 1226, 85, 85, 829, 830, 1224, 555, 85, 837,
+	840, 838, 563, 85, 2138, 543, 544, 1391, 725, 85,
+	428, 541, 2112, 2113, 540, 543, 544, 2136, 387, 810,
+	783, 511, 421, 507, 400, 1791, 1640, 1641, 788, 1982,
+	1983, 1984, 1985, 2175, 2070, 1979, 1539, 2067, 1540, 1913,
+	1541, 1704, 787, 1364, 450, 459, 1563, 1564, 1565, 1566,
+	1408, 1406, 1403, 1407, 1409, 1634, 1402, 1401, 1637, 1567,
+	1408, 1406, 1161, 1407, 1409, 1891, 1159, 851, 388, 94,
+	449, 1757, 1756, 498, 2099, 509, 510, 1753, 372, 1699,
+	448, 442, 94, 1819, 502, 508, 497, 1788, 369, 368,
+	1976, 1789, 2236, 2140, 767, 2030, 2031, 2032, 2034, 2033,
+	2154, 1946, 2180, 1785, 427, 2061, 2135, 1636, 483, 364,
+	2081, 2111, 503, 2187, 463, 2043, 60, 60, 426, 2097,
+	769, 1886, 2077, 2078, 484, 2081, 1411, 1412, 1413, 1414,
+	459, 489, 2218, 573, 1928, 1927, 2102, 2103, 355, 2087,
+	821, 823, 2195, 820, 824, 505, 1427, 823, 1975, 1426,
+	824, 2062, 1388, 2065, 550, 2142, 2143, 1207, 1486, 94,
+	447, 1880, 431, 1207, 539, 538, 822, 1629, 559, 1916,
+	1211, 562, 523, 414, 414, 351, 1786, 506, 1209, 1210,
+	443, 414, 425, 529, 500, 532, 1207, 534, 461, 460,
+	531, 551, 389, 367, 768, 488, 501, 504, 553, 825,
+	1876, 1206, 1630, 363, 435, 825, 499, 614, 1384, 1229,
+	1167, 2120, 561, 587, 356, 796, 728, 533, 1700, 1525,
+	311, 1375, 417, 613, 1153, 1559, 1803, 1802, 1218, 1217,
+	1438, 1216, 558, 744, 595, 449, 94, 94, 94, 94,
+	832, 2196, 556, 557, 833, 748, 1215, 761, 831, 452,
+	453, 390, 493, 391, 2233, 60, 2205, 1542, 1974, 60,
+	417, 371, 1922, 351, 351, 449, 351, 60, 1459, 454,
+	483, 1378, 1377, 461, 460, 781, 724, 520, 2141, 1363,
+	724, 2101, 2042, 535, 351, 351, 484, 1357, 763, 1536,
+	1408, 1406, 1201, 1407, 1409, 419, 543, 544, 1419, 522,
+	1417, 351, 1173, 351, 326, 802, 94, 1138, 791, 543,
+	544, 795, 890, 732, 589, 462, 596, 598, 422, 597,
+	815, 572, 1162, 351, 801, 495, 852, 1183, 1787, 565,
+	567, 834, 835, 419, 1560, 2011, 1419, 580, 803, 581,
+	1784, 813, 88, 88, 351, 414, 445, 351, 88, 797,
+	1381, 1881, 1882, 88, 2193, 2194, 1556, 1559, 88, 88,
+	863, 594, 421, 2121, 858, 545, 862, 548, 858, 921,
+	1528, 816, 351, 351, 870, 94, 607, 435, 1530, 446,
+	879, 729, 785, 536, 888, 513, 601, 602, 603, 604,
+	605, 393, 811, 326, 1158, 782, 874, 1450, 393, 798,
+	735, 760, 891, 576, 547, 812, 1878, 872, 804, 805,
+	1877, 1418, 875, 1263, 1262, 786, 2220, 779, 739, 740,
+	770, 826, 2214, 749, 750, 751, 752, 790, 1529, 1575,
+	2091, 578, 326, 784, 1359, 1231, 1338, 1142, 937, 451,
+	395, 394, 579, 398, 1157, 1680, 945, 395, 394, 800,
+	887, 885, 809, 1330, 885, 1657, 938, 886, 887, 885,
+	1338, 865, 1491, 83, 936, 1682, 1560, 1328, 1329, 1327,
+	868, 1553, 537, 326, 575, 1554, 1557, 853, 1626, 1623,
+	1624, 1625, 1397, 1662, 799, 1661, 1660, 1658, 1888, 861,
+	846, 1887, 1255, 839, 847, 841, 974, 974, 979, 864,
+	1268, 743, 326, 1256, 866, 1718, 397, 1713, 860, 742,
+	939, 940, 941, 942, 1505, 392, 879, 867, 981, 869,
+	1871, 1496, 2239, 987, 876, 384, 1460, 1558, 425, 2022,
+	2020, 943, 1676, 2228, 485, 486, 487, 585, 426, 988,
+	1659, 2012, 2014, 2015, 2016, 2013, 963, 2190, 423, 60,
+	1811, 2181, 915, 920, 919, 929, 930, 922, 923, 924,
+	925, 926, 927, 928, 921, 2021, 2019, 94, 94, 919,
+	929, 930, 922, 923, 924, 925, 926, 927, 928, 921,
+	306, 924, 925, 926, 927, 928, 921, 1203, 1810, 886,
+	887, 885, 1140, 586, 954, 396, 973, 1152, 874, 1178,
+	1180, 588, 425, 485, 486, 487, 1730, 1219, 1139, 2125,
+	886, 887, 885, 351, 875, 886, 887, 885, 2217, 414,
+	414, 2018, 2008, 980, 2056, 422, 858, 2055, 858, 485,
+	486, 487, 585, 351, 929, 930, 922, 923, 924, 925,
+	926, 927, 928, 921, 2006, 1663, 1664, 858, 2005, 1192,
+	1193, 1194, 614, 1137, 94, 986, 583, 2017, 2007, 2216,
+	1252, 1253, 1731, 2004, 947, 1149, 1271, 2001, 613, 948,
+	381, 1995, 1249, 1250, 1251, 1273, 1195, 1213, 1992, 382,
+	1208, 1991, 1136, 1212, 485, 486, 487, 585, 586, 1956,
+	1911, 1266, 1190, 1903, 1311, 1312, 1313, 1314, 1315, 1316,
+	1317, 1318, 1319, 1320, 1321, 1322, 1166, 2105, 1899, 1332,
+	1333, 1269, 1270, 1494, 963, 1898, 1493, 1197, 1897, 1199,
+	1196, 1198, 1893, 809, 1200, 1892, 1245, 1172, 1724, 886,
+	887, 885, 974, 1339, 1257, 1347, 1342, 1723, 1722, 886,
+	887, 885, 326, 586, 1721, 1248, 1522, 1225, 733, 1227,
+	922, 923, 924, 925, 926, 927, 928, 921, 1827, 1228,
+	1345, 2176, 1234, 2153, 2146, 1171, 2027, 2085, 1232, 2084,
+	2047, 1602, 1238, 2229, 1239, 567, 565, 894, 895, 896,
+	897, 898, 899, 1977, 892, 1176, 1177, 1246, 886, 887,
+	885, 1331, 886, 887, 885, 2054, 1466, 2009, 2002, 1264,
+	1265, 2119, 1267, 1998, 1325, 886, 887, 885, 1304, 1305,
+	1306, 1307, 1997, 1308, 1309, 1310, 1996, 1912, 920, 919,
+	929, 930, 922, 923, 924, 925, 926, 927, 928, 921,
+	1446, 1895, 1348, 1781, 379, 1883, 380, 387, 886, 887,
+	885, 378, 376, 375, 383, 1362, 385, 386, 1873, 1825,
+	1340, 886, 887, 885, 1341, 1343, 1823, 1590, 485, 486,
+	487, 1350, 1732, 1572, 1346, 1571, 1570, 1349, 1569, 1389,
+	1170, 1168, 1609, 1613, 1615, 1617, 1619, 1620, 1622, 958,
+	1626, 1623, 1624, 1625, 1951, 1604, 1605, 1606, 1607, 1588,
+	1589, 1610, 957, 1591, 956, 1592, 1593, 1594, 1595, 1596,
+	1597, 1598, 1599, 1600, 1601, 1608, 886, 887, 885, 1365,
+	734, 517, 449, 1612, 1614, 1616, 1618, 1621, 1165, 2237,
+	1499, 2199, 748, 1462, 1498, 1805, 1780, 2234, 1373, 1369,
+	351, 1688, 1370, 351, 2116, 1372, 449, 2115, 351, 2048,
+	1679, 1965, 1603, 1673, 1394, 1961, 1383, 886, 887, 885,
+	361, 1672, 1960, 886, 887, 885, 1165, 2226, 1392, 1393,
+	360, 795, 886, 887, 885, 886, 887, 885, 1671, 1165,
+	2225, 2211, 1424, 886, 887, 885, 449, 2204, 2203, 1431,
+	979, 979, 1434, 449, 1670, 1812, 1429, 1462, 2198, 1809,
+	886, 887, 885, 1429, 1462, 2166, 1416, 1953, 2151, 1808,
+	351, 1795, 600, 1241, 2144, 1709, 886, 887, 885, 2133,
+	2132, 94, 94, 1690, 1396, 1455, 920, 919, 929, 930,
+	922, 923, 924, 925, 926, 927, 928, 921, 1644, 1368,
+	2118, 2117, 1638, 1367, 2209, 422, 1385, 1953, 2114, 1953,
+	2095, 1420, 1503, 1452, 1453, 1953, 2094, 1502, 1463, 1953,
+	2093, 1464, 1465, 1953, 2092, 60, 2090, 2089, 1969, 1968,
+	1379, 1500, 1382, 1967, 1966, 1395, 1497, 1421, 1495, 1422,
+	1963, 1964, 1467, 1963, 1962, 1471, 21, 1415, 1190, 920,
+	919, 929, 930, 922, 923, 924, 925, 926, 927, 928,
+	921, 1473, 1474, 1475, 1476, 1477, 1478, 1479, 1444, 1428,
+	1436, 1432, 1433, 1468, 1483, 1484, 1435, 1425, 1442, 1423,
+	1443, 1447, 1669, 1953, 1952, 1780, 1779, 1461, 1454, 1437,
+	13, 1451, 1488, 7, 1344, 1492, 974, 5, 1514, 974,
+	765, 1480, 1517, 883, 886, 887, 885, 1504, 599, 936,
+	858, 1668, 879, 1611, 1244, 1693, 858, 351, 1462, 1674,
+	1526, 351, 351, 1667, 1520, 351, 1462, 1665, 336, 2219,
+	335, 339, 331, 886, 887, 885, 1141, 60, 512, 449,
+	1521, 1654, 491, 327, 1653, 886, 887, 885, 881, 1429,
+	1462, 94, 1652, 490, 346, 1509, 1482, 491, 1511, 1462,
+	1470, 1516, 1334, 886, 887, 885, 886, 887, 885, 492,
+	1325, 320, 1481, 425, 886, 887, 885, 1462, 1469, 1513,
+	1490, 1244, 1366, 1573, 886, 887, 885, 1361, 1360, 1506,
+	1355, 1354, 1244, 1243, 1515, 731, 1512, 1165, 1164, 94,
+	1649, 1518, 1523, 1352, 1524, 1519, 737, 736, 1710, 1159,
+	1691, 1361, 1576, 1577, 493, 1527, 1458, 493, 1568, 1651,
+	1358, 1335, 1241, 1534, 1204, 1174, 606, 574, 352, 1666,
+	89, 2213, 2207, 2188, 2185, 2183, 2124, 1578, 1579, 1141,
+	2063, 2039, 2024, 1986, 1959, 1957, 1687, 1760, 1681, 1949,
+	1531, 1533, 1948, 1685, 1947, 1944, 1208, 1943, 1885, 609,
+	1587, 1762, 1774, 1580, 1684, 1777, 1770, 1767, 1766, 1686,
+	1694, 1726, 1719, 351, 1326, 1430, 1642, 1398, 1371, 85,
+	1353, 1242, 1230, 1649, 1214, 94, 964, 962, 961, 960,
+	1648, 959, 955, 1712, 909, 952, 950, 949, 1678, 946,
+	329, 328, 332, 85, 918, 917, 916, 914, 1675, 334,
+	913, 912, 911, 910, 1677, 907, 906, 1708, 1683, 905,
+	904, 338, 903, 902, 901, 900, 60, 745, 727, 494,
+	1145, 1146, 1945, 1692, 1186, 771, 932, 1729, 935, 2159,
+	2157, 2110, 1410, 1240, 1727, 1148, 1716, 1707, 966, 514,
+	1151, 1150, 933, 934, 931, 1698, 920, 919, 929, 930,
+	922, 923, 924, 925, 926, 927, 928, 921, 754, 753,
+	1711, 1782, 1715, 1754, 1715, 1717, 1792, 759, 1720, 473,
+	474, 1725, 469, 472, 473, 474, 470, 757, 471, 475,
+	755, 1643, 758, 1764, 1765, 756, 1733, 1448, 1902, 1356,
+	2168, 1236, 1695, 1793, 1763, 590, 591, 1768, 1191, 1771,
+	1772, 333, 337, 772, 1237, 341, 773, 1544, 1351, 343,
+	344, 345, 1794, 1537, 347, 348, 1449, 1176, 1177, 91,
+	449, 519, 1184, 1775, 1696, 1778, 828, 351, 351, 1807,
+	748, 94, 1697, 1914, 1783, 437, 439, 440, 858, 731,
+	1543, 449, 1154, 877, 1859, 1861, 297, 1859, 1859, 1831,
+	1796, 1429, 764, 1798, 1799, 1800, 477, 449, 1797, 1263,
+	1262, 1374, 1804, 1820, 525, 526, 1865, 1155, 1135, 469,
+	472, 473, 474, 470, 1872, 471, 475, 1806, 521, 2208,
+	1457, 2129, 2127, 1815, 94, 2072, 1814, 2071, 2069, 1818,
+	1989, 1860, 1987, 1824, 1729, 464, 1856, 1790, 1706, 1864,
+	1862, 1863, 1705, 1647, 1828, 361, 469, 472, 473, 474,
+	470, 524, 471, 475, 731, 360, 1889, 1754, 1870, 360,
+	1646, 2161, 2160, 1874, 1472, 1376, 1169, 298, 2160, 2161,
+	1689, 476, 373, 1739, 1, 527, 741, 458, 738, 457,
+	455, 84, 1336, 1275, 676, 1896, 969, 975, 2025, 2167,
+	2200, 2123, 2170, 665, 649, 1501, 1816, 1817, 1906, 1900,
+	2064, 1538, 1905, 1978, 2066, 1980, 1390, 1907, 1742, 1386,
+	1918, 515, 1507, 1908, 1737, 1508, 689, 678, 951, 679,
+	1751, 1752, 438, 677, 1904, 1738, 1635, 362, 436, 374,
+	1890, 1701, 1755, 1776, 1769, 1861, 1272, 1813, 2059, 1901,
+	1921, 920, 919, 929, 930, 922, 923, 924, 925, 926,
+	927, 928, 921, 2206, 2080, 2235, 1955, 1919, 1920, 1743,
+	1923, 1924, 1925, 1926, 2134, 2186, 1929, 1930, 1931, 1932,
+	1933, 1934, 1935, 1936, 1937, 1938, 1939, 1940, 1941, 1942,
+	2179, 1950, 920, 919, 929, 930, 922, 923, 924, 925,
+	926, 927, 928, 921, 2076, 1915, 324, 1990, 843, 568,
+	406, 1954, 2040, 411, 965, 1561, 1404, 1182, 1160, 776,
+	325, 2098, 2023, 1958, 365, 449, 1185, 366, 449, 449,
+	449, 1188, 483, 1187, 449, 893, 1324, 60, 953, 944,
+	449, 616, 1489, 656, 650, 1750, 1632, 1552, 484, 1631,
+	2003, 1749, 814, 29, 2049, 478, 1993, 1994, 1988, 2046,
+	884, 2058, 1999, 2000, 983, 2028, 2035, 2074, 2036, 2037,
+	2038, 2045, 1745, 96, 1202, 984, 1746, 2073, 1909, 2172,
+	2053, 664, 2075, 663, 662, 2057, 1487, 661, 468, 466,
+	465, 316, 2068, 315, 1744, 1747, 1456, 1645, 880, 882,
+	2107, 2106, 2050, 2082, 2083, 94, 2051, 920, 919, 929,
+	930, 922, 923, 924, 925, 926, 927, 928, 921, 1821,
+	449, 920, 919, 929, 930, 922, 923, 924, 925, 926,
+	927, 928, 921, 2088, 1884, 2010, 1879, 872, 1875, 2086,
+	1830, 1829, 1734, 1735, 1741, 1586, 1753, 1582, 1584, 1585,
+	1583, 1581, 2104, 817, 818, 1549, 1546, 1545, 1740, 1147,
+	2096, 1143, 971, 793, 2128, 314, 2130, 2131, 1247, 610,
+	2126, 12, 20, 19, 18, 17, 54, 53, 2122, 52,
+	2137, 2139, 51, 50, 16, 9, 49, 48, 47, 46,
+	45, 15, 2147, 2148, 2149, 2150, 2145, 14, 41, 40,
+	39, 38, 37, 36, 2156, 2174, 35, 2155, 2158, 34,
+	33, 32, 2173, 2163, 2178, 31, 2162, 30, 10, 64,
+	63, 2182, 62, 2184, 2177, 61, 22, 23, 24, 70,
+	69, 68, 67, 66, 2152, 2164, 28, 11, 8, 6,
+	2189, 4, 2, 0, 0, 0, 0, 0, 0, 0,
+	2202, 0, 0, 2058, 2197, 2191, 0, 0, 0, 0,
+	0, 0, 0, 0, 449, 0, 449, 2210, 0, 2212,
+	0, 0, 0, 2215, 781, 0, 781, 0, 0, 0,
+	0, 0, 0, 0, 0, 2174, 2222, 0, 0, 0,
+	0, 0, 2173, 2223, 449, 2224, 2221, 2227, 0, 0,
+	0, 2202, 2230, 0, 781, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2238, 1103, 1089, 2232, 1049, 1105,
+	1021, 1037, 1113, 1039, 1040, 1075, 999, 1058, 228, 1035,
+	1072, 991, 1024, 1025, 993, 1032, 994, 1022, 1051, 170,
+	1020, 1092, 1061, 196, 1111, 198, 0, 0, 256, 211,
+	0, 0, 1054, 1094, 1056, 1081, 1048, 1076, 1007, 1068,
+	1106, 1036, 1073, 1107, 0, 0, 0, 0, 485, 486,
+	487, 0, 0, 0, 0, 153, 0, 0, 0, 0,
+	0, 1071, 1099, 1034, 0, 0, 1008, 1104, 1055, 1074,
+	0, 992, 1069, 0, 997, 1000, 1112, 1097, 1029, 1030,
+	0, 0, 0, 0, 0, 0, 0, 1052, 1057, 1078,
+	1045, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1026, 0, 1065, 0, 0, 0, 1002, 998, 0, 1050,
+	0, 144, 261, 275, 154, 252, 288, 158, 259, 150,
+	227, 248, 146, 273, 258, 208, 189, 190, 145, 0,
+	243, 168, 181, 165, 225, 1101, 1102, 164, 291, 1001,
+	283, 148, 149, 282, 224, 270, 274, 209, 203, 147,
+	272, 207, 202, 194, 172, 185, 236, 201, 237, 186,
+	214, 213, 215, 1123, 1124, 1125, 1126, 1127, 1006, 0,
+	1027, 1079, 0, 990, 212, 1088, 1095, 1047, 285, 1098,
+	1044, 1043, 1130, 0, 1129, 260, 1131, 1132, 195, 1093,
+	1023, 1033, 1028, 1031, 246, 230, 1100, 1064, 1077, 244,
+	199, 271, 238, 276, 262, 284, 1082, 239, 135, 263,
+	167, 210, 151, 152, 163, 169, 171, 173, 174, 220,
+	221, 233, 251, 264, 265, 266, 166, 159, 245, 160,
+	183, 161, 136, 253, 162, 137, 234, 269, 1128, 180,
+	241, 206, 138, 205, 235, 268, 267, 292, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 177, 989, 280,
+	0, 226, 1090, 995, 1005, 1003, 1041, 1066, 1067, 222,
+	296, 1084, 1087, 1085, 1114, 249, 0, 0, 0, 0,
+	0, 188, 232, 0, 250, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 996, 0, 257, 278, 290,
+	281, 1042, 1014, 1053, 289, 1017, 1015, 1083, 1016, 1070,
+	1116, 216, 217, 218, 219, 1038, 0, 157, 1062, 1046,
+	1117, 1118, 1119, 1120, 1121, 1122, 139, 191, 140, 141,
+	142, 143, 1019, 1096, 176, 182, 0, 184, 156, 231,
+	179, 287, 192, 223, 187, 254, 193, 200, 242, 286,
+	229, 247, 155, 277, 255, 204, 178, 1013, 1018, 1012,
+	1059, 1060, 1108, 1109, 1110, 1080, 1004, 1091, 1009, 1011,
+	1010, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1086, 1063, 134, 0, 197, 1115, 240, 175, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 89, 0, 684, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 228, 1133, 1134, 293, 294, 295, 279,
+	658, 0, 0, 0, 170, 0, 0, 0, 196, 686,
+	641, 0, 0, 256, 211, 0, 0, 0, 0, 701,
+	707, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	651, 0, 0, 617, 691, 690, 667, 0, 0, 0,
+	153, 668, 0, 673, 0, 669, 672, 670, 671, 0,
+	0, 693, 0, 0, 0, 0, 0, 615, 655, 0,
+	659, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 652, 653, 0, 0, 0, 0, 685, 0, 654,
+	0, 0, 688, 0, 674, 0, 144, 261, 275, 154,
+	252, 288, 158, 259, 150, 227, 248, 146, 273, 258,
+	208, 189, 190, 145, 0, 243, 168, 181, 165, 225,
+	682, 683, 164, 644, 680, 283, 148, 149, 282, 224,
+	270, 274, 209, 203, 147, 272, 207, 202, 194, 172,
+	185, 236, 201, 237, 186, 214, 213, 215, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 212,
+	0, 0, 0, 285, 0, 0, 699, 0, 0, 0,
+	260, 0, 0, 195, 0, 0, 0, 681, 0, 246,
+	230, 710, 0, 0, 244, 199, 271, 238, 276, 262,
+	284, 0, 239, 135, 263, 167, 210, 151, 152, 163,
+	169, 171, 173, 174, 220, 221, 233, 251, 264, 265,
+	266, 166, 159, 245, 160, 183, 161, 136, 253, 162,
+	137, 234, 269, 0, 180, 241, 206, 138, 205, 235,
+	268, 267, 292, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 177, 0, 280, 697, 226, 709, 692, 694,
+	695, 698, 702, 703, 642, 645, 704, 706, 708, 711,
+	249, 0, 0, 0, 0, 0, 188, 232, 0, 250,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 257, 278, 290, 643, 0, 0, 0, 289,
+	0, 0, 0, 0, 0, 687, 216, 217, 218, 219,
+	700, 0, 157, 0, 0, 0, 0, 0, 0, 0,
+	0, 139, 191, 140, 141, 142, 143, 0, 0, 176,
+	182, 0, 184, 156, 231, 179, 287, 192, 223, 187,
+	254, 193, 200, 242, 286, 229, 247, 155, 277, 255,
+	204, 178, 717, 696, 716, 718, 719, 715, 720, 721,
+	705, 660, 0, 713, 712, 714, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 134, 0, 197,
+	88, 240, 175, 98, 619, 620, 621, 622, 623, 624,
+	625, 106, 626, 108, 109, 627, 111, 628, 113, 629,
+	115, 116, 117, 630, 631, 632, 633, 122, 634, 635,
+	636, 637, 127, 128, 129, 130, 638, 639, 640, 684,
+	0, 293, 294, 295, 279, 0, 0, 0, 0, 228,
+	0, 0, 0, 0, 0, 0, 658, 0, 0, 0,
+	170, 859, 0, 0, 196, 686, 641, 0, 0, 256,
+	211, 0, 0, 0, 0, 701, 707, 0, 0, 0,
+	0, 0, 0, 855, 0, 0, 651, 0, 0, 617,
+	691, 690, 667, 0, 0, 0, 153, 668, 0, 673,
+	0, 669, 672, 670, 671, 0, 0, 693, 0, 0,
+	0, 0, 0, 615, 655, 0, 659, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 652, 653, 0,
+	0, 0, 0, 685, 0, 654, 0, 0, 856, 0,
+	674, 0, 144, 261, 275, 154, 252, 288, 158, 259,
+	150, 227, 248, 146, 273, 258, 208, 189, 190, 145,
+	0, 243, 168, 181, 165, 225, 682, 683, 164, 644,
+	680, 283, 148, 149, 282, 224, 270, 274, 209, 203,
+	147, 272, 207, 202, 194, 172, 185, 236, 201, 237,
+	186, 214, 213, 215, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 212, 0, 0, 0, 285,
+	0, 0, 699, 0, 0, 0, 260, 0, 0, 195,
+	0, 0, 0, 681, 0, 246, 230, 710, 0, 0,
+	244, 199, 271, 238, 276, 262, 284, 0, 239, 135,
+	263, 167, 210, 151, 152, 163, 169, 171, 173, 174,
+	220, 221, 233, 251, 264, 265, 266, 166, 159, 245,
+	160, 183, 161, 136, 253, 162, 137, 234, 269, 0,
+	180, 241, 206, 138, 205, 235, 268, 267, 292, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 177, 0,
+	280, 697, 226, 709, 692, 694, 695, 698, 702, 703,
+	642, 645, 704, 706, 708, 711, 249, 0, 0, 0,
+	0, 0, 188, 232, 0, 250, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 257, 278,
+	290, 643, 0, 0, 0, 289, 0, 0, 0, 0,
+	0, 687, 216, 217, 218, 219, 700, 0, 157, 0,
+	0, 0, 0, 0, 0, 0, 0, 139, 191, 140,
+	141, 142, 143, 0, 0, 176, 182, 0, 184, 156,
+	231, 179, 287, 192, 223, 187, 254, 193, 200, 242,
+	286, 229, 247, 155, 277, 255, 204, 178, 717, 696,
+	716, 718, 719, 715, 720, 721, 705, 660, 0, 713,
+	712, 714, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 134, 0, 197, 0, 240, 175, 98,
+	619, 620, 621, 622, 623, 624, 625, 106, 626, 108,
+	109, 627, 111, 628, 113, 629, 115, 116, 117, 630,
+	631, 632, 633, 122, 634, 635, 636, 637, 127, 128,
+	129, 130, 638, 639, 640, 684, 0, 293, 294, 295,
+	279, 0, 0, 0, 0, 228, 0, 0, 0, 0,
+	0, 0, 658, 0, 0, 0, 170, 2231, 0, 0,
+	196, 686, 641, 0, 0, 256, 211, 0, 0, 0,
+	0, 701, 707, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 651, 0, 0, 617, 691, 690, 667, 0,
+	0, 0, 153, 668, 0, 673, 0, 669, 672, 670,
+	671, 0, 0, 693, 0, 0, 0, 0, 0, 615,
+	655, 0, 659, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 652, 653, 0, 0, 0, 0, 685,
+	0, 654, 0, 0, 688, 0, 674, 0, 144, 261,
+	275, 154, 252, 288, 158, 259, 150, 227, 248, 146,
+	273, 258, 208, 189, 190, 145, 0, 243, 168, 181,
+	165, 225, 682, 683, 164, 644, 680, 283, 148, 149,
+	282, 224, 270, 274, 209, 203, 147, 272, 207, 202,
+	194, 172, 185, 236, 201, 237, 186, 214, 213, 215,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 212, 0, 0, 0, 285, 0, 0, 699, 0,
+	0, 0, 260, 0, 0, 195, 0, 0, 0, 681,
+	0, 246, 230, 710, 0, 0, 244, 199, 271, 238,
+	276, 262, 284, 0, 239, 135, 263, 167, 210, 151,
+	152, 163, 169, 171, 173, 174, 220, 221, 233, 251,
+	264, 265, 266, 166, 159, 245, 160, 183, 161, 136,
+	253, 162, 137, 234, 269, 0, 180, 241, 206, 138,
+	205, 235, 268, 267, 292, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 177, 0, 280, 697, 226, 709,
+	692, 694, 695, 698, 702, 703, 642, 645, 704, 706,
+	708, 711, 249, 0, 0, 0, 0, 0, 188, 232,
+	0, 250, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 257, 278, 290, 643, 0, 0,
+	0, 289, 0, 0, 0, 0, 0, 687, 216, 217,
+	218, 219, 700, 0, 157, 0, 0, 0, 0, 0,
+	0, 0, 0, 139, 191, 140, 141, 142, 143, 0,
+	0, 176, 182, 0, 184, 156, 231, 179, 287, 192,
+	223, 187, 254, 193, 200, 242, 286, 229, 247, 155,
+	277, 255, 204, 178, 717, 696, 716, 718, 719, 715,
+	720, 721, 705, 660, 0, 713, 712, 714, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 134,
+	0, 197, 0, 240, 175, 98, 619, 620, 621, 622,
+	623, 624, 625, 106, 626, 108, 109, 627, 111, 628,
+	113, 629, 115, 116, 117, 630, 631, 632, 633, 122,
+	634, 635, 636, 637, 127, 128, 129, 130, 638, 639,
+	640, 684, 0, 293, 294, 295, 279, 0, 0, 0,
+	0, 228, 0, 0, 0, 0, 0, 0, 658, 0,
+	0, 0, 170, 0, 0, 0, 196, 686, 641, 0,
+	0, 256, 211, 0, 0, 0, 0, 701, 707, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 651, 0,
+	0, 617, 691, 690, 667, 0, 0, 0, 153, 668,
+	0, 673, 0, 669, 672, 670, 671, 0, 0, 693,
+	0, 0, 0, 0, 0, 615, 655, 0, 659, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 652,
+	653, 0, 0, 0, 0, 685, 0, 654, 0, 0,
+	688, 0, 674, 0, 144, 261, 275, 154, 252, 288,
+	158, 259, 150, 227, 248, 146, 273, 258, 208, 189,
+	190, 145, 0, 243, 168, 181, 165, 225, 682, 683,
+	164, 644, 680, 283, 148, 149, 282, 224, 270, 274,
+	209, 203, 147, 272, 207, 202, 194, 172, 185, 236,
+	201, 237, 186, 214, 213, 215, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 212, 0, 0,
+	0, 285, 0, 0, 699, 0, 0, 0, 260, 0,
+	0, 195, 0, 0, 0, 681, 0, 246, 230, 710,
+	2165, 0, 244, 199, 271, 238, 276, 262, 284, 0,
+	239, 135, 263, 167, 210, 151, 152, 163, 169, 171,
+	173, 174, 220, 221, 233, 251, 264, 265, 266, 166,
+	159, 245, 160, 183, 161, 136, 253, 162, 137, 234,
+	269, 0, 180, 241, 206, 138, 205, 235, 268, 267,
+	292, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	177, 0, 280, 697, 226, 709, 692, 694, 695, 698,
+	702, 703, 642, 645, 704, 706, 708, 711, 249, 0,
+	0, 0, 0, 0, 188, 232, 0, 250, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	257, 278, 290, 643, 0, 0, 0, 289, 0, 0,
+	0, 0, 0, 687, 216, 217, 218, 219, 700, 0,
+	157, 0, 0, 0, 0, 0, 0, 0, 0, 139,
+	191, 140, 141, 142, 143, 0, 0, 176, 182, 0,
+	184, 156, 231, 179, 287, 192, 223, 187, 254, 193,
+	200, 242, 286, 229, 247, 155, 277, 255, 204, 178,
+	717, 696, 716, 718, 719, 715, 720, 721, 705, 660,
+	0, 713, 712, 714, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 134, 0, 197, 0, 240,
+	175, 98, 619, 620, 621, 622, 623, 624, 625, 106,
+	626, 108, 109, 627, 111, 628, 113, 629, 115, 116,
+	117, 630, 631, 632, 633, 122, 634, 635, 636, 637,
+	127, 128, 129, 130, 638, 639, 640, 684, 0, 293,
+	294, 295, 279, 0, 0, 0, 0, 228, 0, 0,
+	0, 0, 0, 0, 658, 0, 0, 0, 170, 859,
+	0, 0, 196, 686, 641, 0, 0, 256, 211, 0,
+	0, 0, 0, 701, 707, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 651, 0, 0, 617, 691, 690,
+	667, 0, 0, 0, 153, 668, 0, 673, 0, 669,
+	672, 670, 671, 0, 0, 693, 0, 0, 0, 0,
+	0, 615, 655, 0, 659, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 652, 653, 0, 0, 0,
+	0, 685, 0, 654, 0, 0, 688, 0, 674, 0,
+	144, 261, 275, 154, 252, 288, 158, 259, 150, 227,
+	248, 146, 273, 258, 208, 189, 190, 145, 0, 243,
+	168, 181, 165, 225, 682, 683, 164, 644, 680, 283,
+	148, 149, 282, 224, 270, 274, 209, 203, 147, 272,
+	207, 202, 194, 172, 185, 236, 201, 237, 186, 214,
+	213, 215, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 212, 0, 0, 0, 285, 0, 0,
+	699, 0, 0, 0, 260, 0, 0, 195, 0, 0,
+	0, 681, 0, 246, 230, 710, 0, 0, 244, 199,
+	271, 238, 276, 262, 284, 0, 239, 135, 263, 167,
+	210, 151, 152, 163, 169, 171, 173, 174, 220, 221,
+	233, 251, 264, 265, 266, 166, 159, 245, 160, 183,
+	161, 136, 253, 162, 137, 234, 269, 0, 180, 241,
+	206, 138, 205, 235, 268, 267, 292, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 177, 0, 280, 697,
+	226, 709, 692, 694, 695, 698, 702, 703, 642, 645,
+	704, 706, 708, 711, 249, 0, 0, 0, 0, 0,
+	188, 232, 0, 250, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 257, 278, 290, 643,
+	0, 0, 0, 289, 0, 0, 0, 0, 0, 687,
+	216, 217, 218, 219, 700, 0, 157, 0, 0, 0,
+	0, 0, 0, 0, 0, 139, 191, 140, 141, 142,
+	143, 0, 0, 176, 182, 0, 184, 156, 231, 179,
+	287, 192, 223, 187, 254, 193, 200, 242, 286, 229,
+	247, 155, 277, 255, 204, 178, 717, 696, 716, 718,
+	719, 715, 720, 721, 705, 660, 0, 713, 712, 714,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 134, 0, 197, 0, 240, 175, 98, 619, 620,
+	621, 622, 623, 624, 625, 106, 626, 108, 109, 627,
+	111, 628, 113, 629, 115, 116, 117, 630, 631, 632,
+	633, 122, 634, 635, 636, 637, 127, 128, 129, 130,
+	638, 639, 640, 684, 0, 293, 294, 295, 279, 0,
+	0, 0, 0, 228, 0, 0, 0, 0, 0, 0,
+	658, 0, 0, 0, 170, 0, 0, 0, 196, 686,
+	641, 0, 0, 256, 211, 0, 0, 0, 0, 701,
+	707, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	651, 0, 0, 617, 691, 690, 667, 0, 0, 0,
+	153, 668, 0, 673, 0, 669, 672, 670, 671, 0,
+	0, 693, 0, 0, 0, 0, 0, 615, 655, 0,
+	659, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 652, 653, 612, 0, 0, 0, 685, 0, 654,
+	0, 0, 688, 0, 674, 0, 144, 261, 275, 154,
+	252, 288, 158, 259, 150, 227, 248, 146, 273, 258,
+	208, 189, 190, 145, 0, 243, 168, 181, 165, 225,
+	682, 683, 164, 644, 680, 283, 148, 149, 282, 224,
+	270, 274, 209, 203, 147, 272, 207, 202, 194, 172,
+	185, 236, 201, 237, 186, 214, 213, 215, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 212,
+	0, 0, 0, 285, 0, 0, 699, 0, 0, 0,
+	260, 0, 0, 195, 0, 0, 0, 681, 0, 246,
+	230, 710, 0, 0, 244, 199, 271, 238, 276, 262,
+	284, 0, 239, 135, 263, 167, 210, 151, 152, 163,
+	169, 171, 173, 174, 220, 221, 233, 251, 264, 265,
+	266, 166, 159, 245, 160, 183, 161, 136, 253, 162,
+	137, 234, 269, 0, 180, 241, 206, 138, 205, 235,
+	268, 267, 292, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 177, 0, 280, 697, 226, 709, 692, 694,
+	695, 698, 702, 703, 642, 645, 704, 706, 708, 711,
+	249, 0, 0, 0, 0, 0, 188, 232, 0, 250,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 257, 278, 290, 643, 0, 0, 0, 289,
+	0, 0, 0, 0, 0, 687, 216, 217, 218, 219,
+	700, 0, 157, 0, 0, 0, 0, 0, 0, 0,
+	0, 139, 191, 140, 141, 142, 143, 0, 0, 176,
+	182, 0, 184, 156, 231, 179, 287, 192, 223, 187,
+	254, 193, 200, 242, 286, 229, 247, 155, 277, 255,
+	204, 178, 717, 696, 716, 718, 719, 715, 720, 721,
+	705, 660, 0, 713, 712, 714, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 134, 0, 197,
+	0, 240, 175, 98, 619, 620, 621, 622, 623, 624,
+	625, 106, 626, 108, 109, 627, 111, 628, 113, 629,
+	115, 116, 117, 630, 631, 632, 633, 122, 634, 635,
+	636, 637, 127, 128, 129, 130, 638, 639, 640, 684,
+	0, 293, 294, 295, 279, 0, 0, 0, 0, 228,
+	0, 0, 0, 0, 0, 0, 658, 0, 0, 0,
+	170, 0, 0, 0, 196, 686, 641, 0, 0, 256,
+	211, 0, 0, 0, 0, 701, 707, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 651, 0, 0, 617,
+	691, 690, 667, 0, 0, 0, 153, 668, 0, 673,
+	0, 669, 672, 670, 671, 0, 0, 693, 0, 0,
+	0, 0, 0, 615, 655, 0, 659, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 652, 653, 0,
+	0, 0, 0, 685, 0, 654, 0, 0, 688, 0,
+	674, 0, 144, 261, 275, 154, 252, 288, 158, 259,
+	150, 227, 248, 146, 273, 258, 208, 189, 190, 145,
+	0, 243, 168, 181, 165, 225, 682, 683, 164, 644,
+	680, 283, 148, 149, 282, 224, 270, 274, 209, 203,
+	147, 272, 207, 202, 194, 172, 185, 236, 201, 237,
+	186, 214, 213, 215, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 212, 0, 0, 0, 285,
+	0, 0, 699, 0, 0, 0, 260, 0, 0, 195,
+	0, 0, 0, 681, 0, 246, 230, 710, 0, 0,
+	244, 199, 271, 238, 276, 262, 284, 0, 239, 135,
+	263, 167, 210, 151, 152, 163, 169, 171, 173, 174,
+	220, 221, 233, 251, 264, 265, 266, 166, 159, 245,
+	160, 183, 161, 136, 253, 162, 137, 234, 269, 0,
+	180, 241, 206, 138, 205, 235, 268, 267, 292, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 177, 0,
+	280, 697, 226, 709, 692, 694, 695, 698, 702, 703,
+	642, 645, 704, 706, 708, 711, 249, 0, 0, 0,
+	0, 0, 188, 232, 0, 250, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 257, 278,
+	290, 643, 0, 0, 0, 289, 0, 0, 0, 0,
+	0, 687, 216, 217, 218, 219, 700, 0, 157, 0,
+	0, 0, 0, 0, 0, 0, 0, 139, 191, 140,
+	141, 142, 143, 0, 0, 176, 182, 0, 184, 156,
+	231, 179, 287, 192, 223, 187, 254, 193, 200, 242,
+	286, 229, 247, 155, 277, 255, 204, 178, 717, 696,
+	716, 718, 719, 715, 720, 721, 705, 660, 0, 713,
+	712, 714, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 134, 0, 197, 0, 240, 175, 98,
+	619, 620, 621, 622, 623, 624, 625, 106, 626, 108,
+	109, 627, 111, 628, 113, 629, 115, 116, 117, 630,
+	631, 632, 633, 122, 634, 635, 636, 637, 127, 128,
+	129, 130, 638, 639, 640, 684, 0, 293, 294, 295,
+	279, 0, 0, 0, 0, 228, 0, 0, 0, 0,
+	0, 0, 658, 0, 0, 0, 170, 0, 0, 0,
+	196, 686, 641, 0, 0, 256, 211, 0, 0, 0,
+	0, 701, 707, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 651, 0, 0, 617, 691, 690, 667, 0,
+	0, 0, 153, 668, 0, 673, 0, 669, 672, 670,
+	671, 0, 0, 693, 0, 0, 0, 0, 0, 0,
+	655, 0, 659, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 652, 653, 0, 0, 0, 0, 685,
+	0, 654, 0, 0, 688, 0, 674, 0, 144, 261,
+	275, 154, 252, 288, 158, 259, 150, 227, 248, 146,
+	273, 258, 208, 189, 190, 145, 0, 243, 168, 181,
+	165, 225, 682, 683, 164, 644, 680, 283, 148, 149,
+	282, 224, 270, 274, 209, 203, 147, 272, 207, 202,
+	194, 172, 185, 236, 201, 237, 186, 214, 213, 215,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 212, 0, 0, 0, 285, 0, 0, 699, 0,
+	0, 0, 260, 0, 0, 195, 0, 0, 0, 681,
+	0, 246, 230, 710, 0, 0, 244, 199, 271, 238,
+	276, 262, 284, 0, 239, 135, 263, 167, 210, 151,
+	152, 163, 169, 171, 173, 174, 220, 221, 233, 251,
+	264, 265, 266, 166, 159, 245, 160, 183, 161, 136,
+	253, 162, 137, 234, 269, 0, 180, 241, 206, 138,
+	205, 235, 268, 267, 292, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 177, 0, 280, 697, 226, 709,
+	692, 694, 695, 698, 702, 703, 642, 645, 704, 706,
+	708, 711, 249, 0, 0, 0, 0, 0, 188, 232,
+	0, 250, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 257, 278, 290, 643, 0, 0,
+	0, 289, 0, 0, 0, 0, 0, 687, 216, 217,
+	218, 219, 700, 0, 157, 0, 0, 0, 0, 0,
+	0, 0, 0, 139, 191, 140, 141, 142, 143, 0,
+	0, 176, 182, 0, 184, 156, 231, 179, 287, 192,
+	223, 187, 254, 193, 200, 242, 286, 229, 247, 155,
+	277, 255, 204, 178, 717, 696, 716, 718, 719, 715,
+	720, 721, 705, 660, 0, 713, 712, 714, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 134,
+	0, 197, 0, 240, 175, 98, 619, 620, 621, 622,
+	623, 624, 625, 106, 626, 108, 109, 627, 111, 628,
+	113, 629, 115, 116, 117, 630, 631, 632, 633, 122,
+	634, 635, 636, 637, 127, 128, 129, 130, 638, 639,
+	640, 0, 0, 293, 294, 295, 279, 336, 0, 335,
+	339, 331, 0, 0, 0, 0, 0, 0, 0, 228,
+	0, 0, 327, 0, 0, 0, 0, 0, 0, 0,
+	170, 0, 0, 346, 196, 0, 198, 0, 0, 256,
+	211, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 349,
+	0, 0, 350, 0, 0, 0, 153, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 144, 261, 275, 154, 252, 288, 158, 259,
+	150, 227, 248, 146, 273, 258, 208, 189, 190, 145,
+	0, 243, 168, 181, 165, 225, 0, 1295, 164, 291,
+	0, 283, 148, 149, 282, 224, 270, 274, 209, 203,
+	147, 272, 207, 202, 194, 172, 185, 236, 201, 237,
+	186, 214, 213, 215, 0, 0, 0, 0, 0, 329,
+	328, 332, 0, 0, 0, 212, 0, 0, 334, 285,
+	0, 0, 0, 0, 0, 0, 260, 0, 0, 195,
+	338, 0, 0, 0, 0, 246, 230, 0, 0, 0,
+	244, 199, 271, 238, 330, 262, 284, 0, 354, 135,
+	263, 167, 210, 151, 152, 163, 169, 171, 173, 174,
+	220, 221, 233, 251, 264, 265, 266, 166, 159, 245,
+	160, 183, 161, 136, 253, 162, 137, 234, 269, 0,
+	180, 241, 206, 138, 205, 235, 268, 267, 292, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 177, 1291,
+	280, 1288, 226, 0, 0, 1290, 1287, 1289, 1293, 1294,
+	222, 296, 0, 1292, 0, 0, 249, 0, 0, 0,
+	333, 337, 340, 232, 341, 342, 0, 0, 343, 344,
+	345, 0, 0, 347, 348, 0, 0, 0, 257, 278,
+	290, 281, 0, 0, 0, 289, 0, 0, 0, 0,
+	0, 0, 216, 217, 218, 219, 0, 0, 157, 0,
+	0, 0, 0, 0, 0, 0, 0, 139, 191, 140,
+	141, 142, 143, 0, 0, 176, 182, 0, 184, 156,
+	231, 179, 287, 192, 223, 187, 254, 193, 200, 242,
+	286, 229, 247, 155, 277, 255, 204, 178, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1276, 1277, 1278, 1279, 1280, 1281, 1282, 1283, 1284, 1285,
+	1286, 1298, 1299, 1300, 1301, 1302, 1303, 1296, 1297, 0,
+	0, 0, 0, 134, 0, 197, 0, 240, 175, 98,
+	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
+	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
+	119, 120, 121, 122, 123, 124, 125, 126, 127, 128,
+	129, 130, 131, 132, 133, 0, 0, 293, 294, 295,
+	279, 336, 0, 335, 339, 331, 0, 0, 0, 0,
+	0, 0, 0, 228, 0, 0, 327, 0, 0, 0,
+	0, 0, 0, 0, 170, 0, 0, 346, 196, 0,
+	198, 0, 0, 256, 211, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 349, 0, 0, 350, 0, 0, 0,
+	153, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 144, 261, 275, 154,
+	252, 288, 158, 259, 150, 227, 248, 146, 273, 258,
+	208, 189, 190, 145, 0, 243, 168, 181, 165, 225,
+	0, 0, 164, 291, 0, 283, 148, 149, 282, 224,
+	270, 274, 209, 203, 147, 272, 207, 202, 194, 172,
+	185, 236, 201, 237, 186, 214, 213, 215, 0, 0,
+	0, 0, 0, 329, 328, 332, 0, 0, 0, 212,
+	0, 0, 334, 285, 0, 0, 0, 0, 0, 0,
+	260, 0, 0, 195, 338, 0, 0, 0, 0, 246,
+	230, 0, 0, 0, 244, 199, 271, 238, 330, 262,
+	284, 0, 239, 135, 263, 167, 210, 151, 152, 163,
+	169, 171, 173, 174, 220, 221, 233, 251, 264, 265,
+	266, 166, 159, 245, 160, 183, 161, 136, 253, 162,
+	137, 234, 269, 0, 180, 241, 206, 138, 205, 235,
+	268, 267, 292, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 177, 0, 280, 0, 226, 0, 0, 0,
+	0, 0, 0, 0, 222, 296, 0, 0, 0, 0,
+	249, 0, 0, 0, 333, 337, 340, 232, 341, 342,
+	0, 0, 343, 344, 345, 0, 0, 347, 348, 0,
+	0, 0, 257, 278, 290, 281, 0, 0, 0, 289,
+	0, 0, 0, 0, 0, 0, 216, 217, 218, 219,
+	0, 0, 157, 0, 0, 0, 0, 0, 0, 0,
+	0, 139, 191, 140, 141, 142, 143, 0, 0, 176,
+	182, 0, 184, 156, 231, 179, 287, 192, 223, 187,
+	254, 193, 200, 242, 286, 229, 247, 155, 277, 255,
+	204, 178, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 134, 0, 197,
+	0, 240, 175, 98, 99, 100, 101, 102, 103, 104,
 	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
-	115, 116, 117, 118, 119, 120, 121, 122, 123, 211,
-	0, 277, 278, 279, 263, 0, 0, 0, 0, 155,
-	0, 0, 0, 180, 0, 182, 0, 0, 240, 195,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 85, 391,
-	392, 0, 0, 0, 0, 138, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 393, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 129, 245, 259, 139, 236, 272, 143, 243, 135,
-	210, 232, 131, 257, 242, 192, 174, 175, 130, 0,
-	227, 153, 166, 150, 208, 0, 0, 149, 275, 395,
-	267, 133, 394, 266, 207, 254, 258, 193, 187, 132,
-	256, 191, 186, 178, 157, 170, 220, 185, 221, 171,
-	197, 196, 198, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 269, 0, 0,
-	0, 0, 0, 0, 244, 0, 0, 179, 0, 0,
-	0, 0, 0, 230, 213, 0, 0, 218, 228, 183,
-	255, 222, 260, 246, 268, 0, 223, 125, 247, 152,
-	194, 136, 137, 148, 154, 156, 158, 159, 203, 204,
-	216, 235, 248, 249, 250, 151, 144, 229, 145, 168,
-	146, 126, 237, 147, 127, 217, 253, 0, 165, 225,
-	190, 128, 189, 219, 252, 251, 276, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 162, 0, 264, 0,
-	209, 0, 0, 0, 0, 0, 0, 0, 205, 280,
-	0, 0, 0, 0, 233, 0, 0, 0, 0, 0,
-	173, 215, 0, 234, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 241, 262, 274, 265,
-	0, 0, 0, 273, 0, 0, 0, 0, 0, 0,
-	199, 200, 201, 202, 0, 0, 142, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 161, 167, 0,
-	169, 141, 214, 164, 271, 176, 388, 384, 385, 177,
-	184, 226, 270, 212, 231, 140, 261, 239, 386, 163,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 124, 0, 181, 0, 224,
-	160, 88, 89, 90, 91, 92, 93, 94, 95, 96,
-	97, 98, 99, 100, 101, 102, 103, 104, 105, 106,
-	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
-	117, 118, 119, 120, 121, 122, 123, 0, 0, 277,
-	278, 279, 263, 211, 0, 533, 0, 0, 0, 0,
-	0, 0, 0, 155, 534, 0, 0, 180, 0, 182,
-	0, 0, 240, 195, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 332, 0, 0, 333, 0, 0, 0, 138,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 129, 245, 259, 139, 236,
-	272, 143, 243, 135, 210, 232, 131, 257, 242, 192,
-	174, 175, 130, 0, 227, 153, 166, 150, 208, 0,
-	0, 149, 275, 0, 267, 133, 134, 266, 207, 254,
-	258, 193, 187, 132, 256, 191, 186, 178, 157, 170,
-	220, 185, 221, 171, 197, 196, 198, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 269, 0, 0, 0, 0, 0, 0, 244, 0,
-	0, 179, 0, 0, 0, 0, 0, 230, 213, 0,
-	0, 218, 228, 183, 255, 222, 260, 246, 268, 0,
-	223, 125, 247, 152, 194, 136, 137, 148, 154, 156,
-	158, 159, 203, 204, 216, 235, 248, 249, 250, 151,
-	144, 229, 145, 168, 146, 126, 237, 147, 127, 217,
-	253, 0, 165, 225, 190, 128, 189, 219, 252, 251,
-	276, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	162, 0, 264, 0, 209, 0, 0, 0, 0, 0,
-	0, 0, 205, 280, 0, 0, 0, 0, 233, 0,
-	0, 0, 0, 0, 173, 215, 0, 234, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	241, 262, 274, 265, 0, 0, 0, 273, 0, 0,
-	0, 0, 535, 0, 199, 200, 201, 202, 0, 0,
-	142, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 161, 167, 0, 169, 141, 214, 164, 271, 176,
-	206, 172, 238, 177, 184, 226, 270, 212, 231, 140,
-	261, 239, 188, 163, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 124,
-	0, 181, 0, 224, 160, 88, 89, 90, 91, 92,
-	93, 94, 95, 96, 97, 98, 99, 100, 101, 102,
-	103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
-	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
-	123, 0, 0, 277, 278, 279, 263, 211, 0, 788,
-	0, 0, 0, 0, 0, 0, 0, 155, 0, 0,
-	0, 180, 0, 182, 0, 0, 240, 195, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 332, 0, 0, 333,
-	0, 0, 0, 138, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 129,
-	245, 259, 139, 236, 272, 143, 243, 135, 210, 232,
-	131, 257, 242, 192, 174, 175, 130, 0, 227, 153,
-	166, 150, 208, 0, 0, 149, 275, 0, 267, 133,
-	134, 266, 207, 254, 258, 193, 187, 132, 256, 191,
-	186, 178, 157, 170, 220, 185, 221, 171, 197, 196,
-	198, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 269, 0, 0, 0, 0,
-	0, 0, 244, 0, 0, 179, 0, 0, 0, 0,
-	0, 230, 213, 0, 0, 218, 228, 183, 255, 222,
-	260, 246, 268, 0, 223, 125, 247, 152, 194, 136,
-	137, 148, 154, 156, 158, 159, 203, 204, 216, 235,
-	248, 249, 250, 151, 144, 229, 145, 168, 146, 126,
-	237, 147, 127, 217, 253, 0, 165, 225, 190, 128,
-	189, 219, 252, 251, 276, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 162, 0, 264, 0, 209, 0,
-	0, 0, 0, 0, 0, 0, 205, 280, 0, 0,
-	0, 0, 233, 0, 0, 0, 0, 0, 173, 215,
-	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 241, 262, 274, 265, 0, 0,
-	0, 273, 0, 0, 0, 0, 787, 0, 199, 200,
-	201, 202, 0, 0, 142, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 161, 167, 0, 169, 141,
-	214, 164, 271, 176, 206, 172, 238, 177, 184, 226,
-	270, 212, 231, 140, 261, 239, 188, 163, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 124, 0, 181, 0, 224, 160, 88,
-	89, 90, 91, 92, 93, 94, 95, 96, 97, 98,
+	115, 116, 117, 118, 119, 120, 121, 122, 123, 124,
+	125, 126, 127, 128, 129, 130, 131, 132, 133, 0,
+	0, 293, 294, 295, 279, 89, 0, 25, 43, 27,
+	0, 0, 0, 0, 0, 0, 0, 228, 300, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 170, 0,
+	0, 0, 196, 0, 198, 0, 0, 256, 211, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 305, 0, 0, 95, 0, 0,
+	0, 0, 0, 0, 153, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	144, 261, 275, 154, 252, 288, 158, 259, 150, 227,
+	248, 146, 273, 258, 208, 189, 190, 145, 0, 243,
+	168, 181, 165, 225, 0, 0, 164, 291, 0, 283,
+	148, 149, 282, 224, 270, 274, 209, 203, 147, 272,
+	207, 202, 194, 172, 185, 236, 201, 237, 186, 214,
+	213, 215, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 304, 0, 212, 0, 0, 0, 285, 0, 0,
+	0, 0, 0, 0, 260, 0, 0, 195, 0, 0,
+	0, 0, 0, 246, 230, 0, 0, 0, 244, 199,
+	271, 238, 276, 262, 284, 0, 239, 135, 263, 167,
+	210, 151, 152, 163, 169, 171, 173, 174, 220, 221,
+	233, 251, 264, 265, 266, 166, 159, 245, 160, 183,
+	161, 136, 253, 162, 137, 234, 269, 0, 180, 241,
+	206, 138, 205, 235, 268, 267, 292, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 177, 0, 280, 0,
+	226, 0, 0, 0, 0, 0, 0, 0, 222, 296,
+	0, 0, 0, 0, 249, 0, 0, 0, 0, 0,
+	188, 232, 0, 250, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 257, 278, 290, 281,
+	0, 0, 0, 289, 0, 0, 0, 0, 0, 0,
+	216, 217, 218, 219, 301, 303, 157, 0, 0, 0,
+	0, 0, 0, 0, 0, 139, 191, 140, 141, 142,
+	143, 0, 0, 176, 182, 0, 184, 156, 231, 179,
+	287, 192, 223, 187, 254, 193, 200, 242, 286, 229,
+	247, 155, 277, 255, 204, 178, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 134, 0, 197, 88, 240, 175, 98, 99, 100,
+	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
+	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
+	121, 122, 123, 124, 125, 126, 127, 128, 129, 130,
+	131, 132, 133, 228, 0, 293, 294, 295, 279, 0,
+	0, 0, 0, 0, 170, 0, 0, 0, 196, 0,
+	198, 0, 0, 256, 211, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 95, 0, 0, 0, 0, 0, 0,
+	153, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1556, 1559, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 144, 261, 275, 154,
+	252, 288, 158, 259, 150, 227, 248, 146, 273, 258,
+	208, 189, 190, 145, 0, 243, 168, 181, 165, 225,
+	0, 0, 164, 291, 0, 283, 148, 149, 282, 224,
+	270, 274, 209, 203, 147, 272, 207, 202, 194, 172,
+	185, 236, 201, 237, 186, 214, 213, 215, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 212,
+	0, 0, 1560, 285, 0, 0, 0, 1553, 0, 1552,
+	260, 1554, 1557, 195, 0, 0, 0, 0, 0, 246,
+	230, 0, 0, 0, 244, 199, 271, 238, 276, 262,
+	284, 0, 239, 135, 263, 167, 210, 151, 152, 163,
+	169, 171, 173, 174, 220, 221, 233, 251, 264, 265,
+	266, 166, 159, 245, 160, 183, 161, 136, 253, 162,
+	137, 234, 269, 1558, 180, 241, 206, 138, 205, 235,
+	268, 267, 292, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 177, 0, 280, 0, 226, 0, 0, 0,
+	0, 0, 0, 0, 222, 296, 0, 0, 0, 0,
+	249, 0, 0, 0, 0, 0, 188, 232, 0, 250,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 257, 278, 290, 281, 0, 0, 0, 289,
+	0, 0, 0, 0, 0, 0, 216, 217, 218, 219,
+	0, 0, 157, 0, 0, 0, 0, 0, 0, 0,
+	0, 139, 191, 140, 141, 142, 143, 0, 0, 176,
+	182, 0, 184, 156, 231, 179, 287, 192, 223, 187,
+	254, 193, 200, 242, 286, 229, 247, 155, 277, 255,
+	204, 178, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 134, 0, 197,
+	0, 240, 175, 98, 99, 100, 101, 102, 103, 104,
+	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
+	115, 116, 117, 118, 119, 120, 121, 122, 123, 124,
+	125, 126, 127, 128, 129, 130, 131, 132, 133, 228,
+	0, 293, 294, 295, 279, 0, 0, 0, 0, 0,
+	170, 405, 0, 0, 196, 0, 198, 0, 0, 256,
+	211, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 95,
+	415, 416, 0, 0, 0, 0, 153, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 417, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 144, 261, 401, 154, 252, 288, 158, 259,
+	150, 227, 248, 146, 273, 258, 208, 189, 190, 145,
+	0, 243, 168, 181, 165, 225, 0, 0, 164, 291,
+	419, 283, 148, 418, 282, 224, 270, 274, 209, 203,
+	147, 272, 207, 202, 194, 172, 185, 236, 201, 237,
+	186, 214, 213, 215, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 212, 0, 0, 0, 285,
+	0, 0, 0, 0, 0, 0, 260, 0, 0, 195,
+	0, 0, 0, 0, 0, 246, 230, 0, 0, 0,
+	244, 199, 271, 238, 276, 262, 284, 404, 239, 135,
+	263, 167, 210, 151, 152, 163, 169, 171, 173, 174,
+	220, 221, 233, 251, 264, 265, 266, 166, 159, 245,
+	160, 183, 161, 136, 253, 162, 137, 234, 269, 0,
+	180, 241, 206, 138, 205, 235, 268, 267, 292, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 177, 0,
+	280, 0, 226, 0, 0, 0, 0, 0, 0, 0,
+	222, 296, 0, 0, 0, 0, 249, 0, 0, 0,
+	0, 0, 188, 232, 0, 250, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 257, 278,
+	290, 281, 0, 0, 0, 289, 0, 0, 0, 0,
+	0, 407, 216, 217, 218, 219, 0, 0, 157, 0,
+	0, 0, 0, 0, 0, 0, 0, 139, 191, 140,
+	141, 142, 143, 0, 0, 176, 182, 0, 184, 156,
+	231, 179, 287, 192, 412, 403, 402, 193, 200, 242,
+	286, 229, 247, 155, 277, 255, 410, 178, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 134, 0, 197, 0, 240, 175, 98,
 	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
 	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
-	119, 120, 121, 122, 123, 211, 0, 277, 278, 279,
-	263, 0, 0, 0, 0, 155, 0, 0, 0, 180,
-	0, 182, 0, 0, 240, 195, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 2001, 85, 655, 0, 0, 0, 0,
-	0, 138, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 129, 245, 259,
-	139, 236, 272, 143, 243, 135, 210, 232, 131, 257,
-	242, 192, 174, 175, 130, 0, 227, 153, 166, 150,
-	208, 0, 0, 149, 275, 0, 267, 133, 134, 266,
-	207, 254, 258, 193, 187, 132, 256, 191, 186, 178,
-	157, 170, 220, 185, 221, 171, 197, 196, 198, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 269, 0, 0, 0, 0, 0, 0,
-	244, 0, 0, 179, 0, 0, 0, 0, 0, 230,
-	213, 0, 0, 218, 228, 183, 255, 222, 260, 246,
-	268, 0, 223, 125, 247, 152, 194, 136, 137, 148,
-	154, 156, 158, 159, 203, 204, 216, 235, 248, 249,
-	250, 151, 144, 229, 145, 168, 146, 126, 237, 147,
-	127, 217, 253, 0, 165, 225, 190, 128, 189, 219,
-	252, 251, 276, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 162, 0, 264, 0, 209, 0, 0, 0,
-	0, 0, 0, 0, 205, 280, 0, 0, 0, 0,
-	233, 0, 0, 0, 0, 0, 173, 215, 0, 234,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 241, 262, 274, 265, 0, 0, 0, 273,
-	0, 0, 0, 0, 0, 0, 199, 200, 201, 202,
-	0, 0, 142, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 161, 167, 0, 169, 141, 214, 164,
-	271, 176, 206, 172, 238, 177, 184, 226, 270, 212,
-	231, 140, 261, 239, 188, 163, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 124, 0, 181, 0, 224, 160, 88, 89, 90,
-	91, 92, 93, 94, 95, 96, 97, 98, 99, 100,
+	119, 120, 121, 122, 123, 124, 125, 126, 127, 128,
+	129, 130, 131, 132, 133, 89, 0, 293, 294, 295,
+	279, 0, 0, 0, 0, 0, 0, 228, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 170, 0,
+	0, 0, 196, 0, 198, 0, 0, 256, 211, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 85, 0, 972, 95, 0, 0,
+	0, 0, 0, 0, 153, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	144, 261, 275, 154, 252, 288, 158, 259, 150, 227,
+	248, 146, 273, 258, 208, 189, 190, 145, 0, 243,
+	168, 181, 165, 225, 0, 0, 164, 291, 0, 283,
+	148, 149, 282, 224, 270, 274, 209, 203, 147, 272,
+	207, 202, 194, 172, 185, 236, 201, 237, 186, 214,
+	213, 215, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 212, 0, 0, 0, 285, 0, 0,
+	0, 0, 0, 0, 260, 0, 0, 195, 0, 0,
+	0, 0, 0, 246, 230, 0, 0, 0, 244, 199,
+	271, 238, 276, 262, 284, 0, 239, 135, 263, 167,
+	210, 151, 152, 163, 169, 171, 173, 174, 220, 221,
+	233, 251, 264, 265, 266, 166, 159, 245, 160, 183,
+	161, 136, 253, 162, 137, 234, 269, 0, 180, 241,
+	206, 138, 205, 235, 268, 267, 292, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 177, 0, 280, 0,
+	226, 0, 0, 0, 0, 0, 0, 0, 222, 296,
+	0, 0, 0, 0, 249, 0, 0, 0, 0, 0,
+	188, 232, 0, 250, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 257, 278, 290, 281,
+	0, 0, 0, 289, 0, 0, 0, 0, 0, 0,
+	216, 217, 218, 219, 0, 0, 157, 0, 0, 0,
+	0, 0, 0, 0, 0, 139, 191, 140, 141, 142,
+	143, 0, 0, 176, 182, 0, 184, 156, 231, 179,
+	287, 192, 223, 187, 254, 193, 200, 242, 286, 229,
+	247, 155, 277, 255, 204, 178, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 134, 0, 197, 88, 240, 175, 98, 99, 100,
 	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
 	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
-	121, 122, 123, 211, 0, 277, 278, 279, 263, 0,
-	0, 0, 0, 155, 0, 0, 0, 180, 0, 182,
-	0, 0, 240, 195, 0, 0, 0, 0, 0, 0,
+	121, 122, 123, 124, 125, 126, 127, 128, 129, 130,
+	131, 132, 133, 228, 0, 293, 294, 295, 279, 889,
+	0, 0, 0, 0, 170, 0, 0, 0, 196, 0,
+	198, 0, 0, 256, 211, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 95, 0, 0, 0, 0, 0, 0,
+	153, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 886, 887, 885, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 144, 261, 275, 154,
+	252, 288, 158, 259, 150, 227, 248, 146, 273, 258,
+	208, 189, 190, 145, 0, 243, 168, 181, 165, 225,
+	0, 0, 164, 291, 0, 283, 148, 149, 282, 224,
+	270, 274, 209, 203, 147, 272, 207, 202, 194, 172,
+	185, 236, 201, 237, 186, 214, 213, 215, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 212,
+	0, 0, 0, 285, 0, 0, 0, 0, 0, 0,
+	260, 0, 0, 195, 0, 0, 0, 0, 0, 246,
+	230, 0, 0, 0, 244, 199, 271, 238, 276, 262,
+	284, 0, 239, 135, 263, 167, 210, 151, 152, 163,
+	169, 171, 173, 174, 220, 221, 233, 251, 264, 265,
+	266, 166, 159, 245, 160, 183, 161, 136, 253, 162,
+	137, 234, 269, 0, 180, 241, 206, 138, 205, 235,
+	268, 267, 292, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 177, 0, 280, 0, 226, 0, 0, 0,
+	0, 0, 0, 0, 222, 296, 0, 0, 0, 0,
+	249, 0, 0, 0, 0, 0, 188, 232, 0, 250,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 257, 278, 290, 281, 0, 0, 0, 289,
+	0, 0, 0, 0, 0, 0, 216, 217, 218, 219,
+	0, 0, 157, 0, 0, 0, 0, 0, 0, 0,
+	0, 139, 191, 140, 141, 142, 143, 0, 0, 176,
+	182, 0, 184, 156, 231, 179, 287, 192, 223, 187,
+	254, 193, 200, 242, 286, 229, 247, 155, 277, 255,
+	204, 178, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 134, 0, 197,
+	0, 240, 175, 98, 99, 100, 101, 102, 103, 104,
+	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
+	115, 116, 117, 118, 119, 120, 121, 122, 123, 124,
+	125, 126, 127, 128, 129, 130, 131, 132, 133, 228,
+	0, 293, 294, 295, 279, 0, 0, 0, 0, 0,
+	170, 0, 0, 0, 196, 0, 198, 0, 0, 256,
+	211, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 95,
+	415, 416, 0, 0, 0, 0, 153, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 417, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 144, 261, 275, 154, 252, 288, 158, 259,
+	150, 227, 248, 146, 273, 258, 208, 189, 190, 145,
+	0, 243, 168, 181, 165, 225, 0, 0, 164, 291,
+	419, 283, 148, 418, 282, 224, 270, 274, 209, 203,
+	147, 272, 207, 202, 194, 172, 185, 236, 201, 237,
+	186, 214, 213, 215, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 212, 0, 0, 0, 285,
+	0, 0, 0, 0, 0, 0, 260, 0, 0, 195,
+	0, 0, 0, 0, 0, 246, 230, 0, 0, 0,
+	244, 199, 271, 238, 276, 262, 284, 0, 239, 135,
+	263, 167, 210, 151, 152, 163, 169, 171, 173, 174,
+	220, 221, 233, 251, 264, 265, 266, 166, 159, 245,
+	160, 183, 161, 136, 253, 162, 137, 234, 269, 0,
+	180, 241, 206, 138, 205, 235, 268, 267, 292, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 177, 0,
+	280, 0, 226, 0, 0, 0, 0, 0, 0, 0,
+	222, 296, 0, 0, 0, 0, 249, 0, 0, 0,
+	0, 0, 188, 232, 0, 250, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 257, 278,
+	290, 281, 0, 0, 0, 289, 0, 0, 0, 0,
+	0, 0, 216, 217, 218, 219, 0, 0, 157, 0,
+	0, 0, 0, 0, 0, 0, 0, 139, 191, 140,
+	141, 142, 143, 0, 0, 176, 182, 0, 184, 156,
+	231, 179, 287, 192, 412, 848, 849, 193, 200, 242,
+	286, 229, 247, 155, 277, 255, 410, 178, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 134, 0, 197, 0, 240, 175, 98,
+	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
+	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
+	119, 120, 121, 122, 123, 124, 125, 126, 127, 128,
+	129, 130, 131, 132, 133, 0, 0, 293, 294, 295,
+	279, 228, 0, 0, 569, 0, 0, 0, 0, 0,
+	0, 0, 170, 570, 0, 0, 196, 0, 198, 0,
+	0, 256, 211, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 85, 0, 0, 739, 0, 0, 0, 138,
+	0, 349, 0, 0, 350, 0, 0, 0, 153, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 129, 245, 259, 139, 236,
-	272, 143, 243, 135, 210, 232, 131, 257, 242, 192,
-	174, 175, 130, 0, 227, 153, 166, 150, 208, 0,
-	0, 149, 275, 0, 267, 133, 134, 266, 207, 254,
-	258, 193, 187, 132, 256, 191, 186, 178, 157, 170,
-	220, 185, 221, 171, 197, 196, 198, 0, 0, 0,
+	0, 0, 0, 0, 144, 261, 275, 154, 252, 288,
+	158, 259, 150, 227, 248, 146, 273, 258, 208, 189,
+	190, 145, 0, 243, 168, 181, 165, 225, 0, 0,
+	164, 291, 0, 283, 148, 149, 282, 224, 270, 274,
+	209, 203, 147, 272, 207, 202, 194, 172, 185, 236,
+	201, 237, 186, 214, 213, 215, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 212, 0, 0,
+	0, 285, 0, 0, 0, 0, 0, 0, 260, 0,
+	0, 195, 0, 0, 0, 0, 0, 246, 230, 0,
+	0, 0, 244, 199, 271, 238, 276, 262, 284, 0,
+	239, 135, 263, 167, 210, 151, 152, 163, 169, 171,
+	173, 174, 220, 221, 233, 251, 264, 265, 266, 166,
+	159, 245, 160, 183, 161, 136, 253, 162, 137, 234,
+	269, 0, 180, 241, 206, 138, 205, 235, 268, 267,
+	292, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	177, 0, 280, 0, 226, 0, 0, 0, 0, 0,
+	0, 0, 222, 296, 0, 0, 0, 0, 249, 0,
+	0, 0, 0, 0, 188, 232, 0, 250, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 269, 0, 0, 0, 0, 0, 0, 244, 0,
-	0, 179, 0, 0, 0, 0, 0, 230, 213, 0,
-	0, 218, 228, 183, 255, 222, 260, 246, 268, 0,
-	223, 125, 247, 152, 194, 136, 137, 148, 154, 156,
-	158, 159, 203, 204, 216, 235, 248, 249, 250, 151,
-	144, 229, 145, 168, 146, 126, 237, 147, 127, 217,
-	253, 0, 165, 225, 190, 128, 189, 219, 252, 251,
-	276, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	162, 0, 264, 0, 209, 0, 0, 0, 0, 0,
-	0, 0, 205, 280, 0, 0, 0, 0, 233, 0,
-	0, 0, 0, 0, 173, 215, 0, 234, 0, 0,
+	257, 278, 290, 281, 0, 0, 0, 289, 0, 0,
+	0, 0, 571, 0, 216, 217, 218, 219, 0, 0,
+	157, 0, 0, 0, 0, 0, 0, 0, 0, 139,
+	191, 140, 141, 142, 143, 0, 0, 176, 182, 0,
+	184, 156, 231, 179, 287, 192, 223, 187, 254, 193,
+	200, 242, 286, 229, 247, 155, 277, 255, 204, 178,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	241, 262, 274, 265, 0, 0, 0, 273, 0, 0,
-	0, 0, 0, 1417, 199, 200, 201, 202, 0, 0,
-	142, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 161, 167, 0, 169, 141, 214, 164, 271, 176,
-	206, 172, 238, 177, 184, 226, 270, 212, 231, 140,
-	261, 239, 188, 163, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 124,
-	0, 181, 0, 224, 160, 88, 89, 90, 91, 92,
-	93, 94, 95, 96, 97, 98, 99, 100, 101, 102,
-	103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
-	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
-	123, 211, 0, 277, 278, 279, 263, 0, 0, 0,
-	0, 155, 1151, 0, 0, 180, 0, 182, 0, 0,
-	240, 195, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	85, 0, 0, 739, 0, 0, 0, 138, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 129, 245, 259, 139, 236, 272, 143,
-	243, 135, 210, 232, 131, 257, 242, 192, 174, 175,
-	130, 0, 227, 153, 166, 150, 208, 0, 0, 149,
-	275, 0, 267, 133, 134, 266, 207, 254, 258, 193,
-	187, 132, 256, 191, 186, 178, 157, 170, 220, 185,
-	221, 171, 197, 196, 198, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 269,
-	0, 0, 0, 0, 0, 0, 244, 0, 0, 179,
-	0, 0, 0, 0, 0, 230, 213, 0, 0, 218,
-	228, 183, 255, 222, 260, 246, 268, 0, 223, 125,
-	247, 152, 194, 136, 137, 148, 154, 156, 158, 159,
-	203, 204, 216, 235, 248, 249, 250, 151, 144, 229,
-	145, 168, 146, 126, 237, 147, 127, 217, 253, 0,
-	165, 225, 190, 128, 189, 219, 252, 251, 276, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 162, 0,
-	264, 0, 209, 0, 0, 0, 0, 0, 0, 0,
-	205, 280, 0, 0, 0, 0, 233, 0, 0, 0,
-	0, 0, 173, 215, 0, 234, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 241, 262,
-	274, 265, 0, 0, 0, 273, 0, 0, 0, 0,
-	0, 0, 199, 200, 201, 202, 0, 0, 142, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 161,
-	167, 0, 169, 141, 214, 164, 271, 176, 206, 172,
-	238, 177, 184, 226, 270, 212, 231, 140, 261, 239,
-	188, 163, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 124, 0, 181,
-	0, 224, 160, 88, 89, 90, 91, 92, 93, 94,
-	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
-	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
-	115, 116, 117, 118, 119, 120, 121, 122, 123, 211,
-	0, 277, 278, 279, 263, 0, 0, 0, 0, 155,
-	0, 0, 0, 180, 0, 182, 0, 0, 240, 195,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 85, 655,
-	0, 0, 0, 0, 0, 138, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 129, 245, 259, 139, 236, 272, 143, 243, 135,
-	210, 232, 131, 257, 242, 192, 174, 175, 130, 0,
-	227, 153, 166, 150, 208, 0, 0, 149, 275, 0,
-	267, 133, 134, 266, 207, 254, 258, 193, 187, 132,
-	256, 191, 186, 178, 157, 170, 220, 185, 221, 171,
-	197, 196, 198, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 269, 0, 0,
-	0, 0, 0, 0, 244, 0, 0, 179, 0, 0,
-	0, 0, 0, 230, 213, 0, 0, 218, 228, 183,
-	255, 222, 260, 246, 268, 0, 223, 125, 247, 152,
-	194, 136, 137, 148, 154, 156, 158, 159, 203, 204,
-	216, 235, 248, 249, 250, 151, 144, 229, 145, 168,
-	146, 126, 237, 147, 127, 217, 253, 0, 165, 225,
-	190, 128, 189, 219, 252, 251, 276, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 162, 0, 264, 0,
-	209, 0, 0, 0, 0, 0, 0, 0, 205, 280,
-	0, 0, 0, 0, 233, 0, 0, 0, 0, 0,
-	173, 215, 0, 234, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 241, 262, 274, 265,
-	0, 0, 0, 273, 0, 0, 0, 0, 0, 0,
-	199, 200, 201, 202, 0, 0, 142, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 161, 167, 0,
-	169, 141, 214, 164, 271, 176, 206, 172, 238, 177,
-	184, 226, 270, 212, 231, 140, 261, 239, 188, 163,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 124, 0, 181, 0, 224,
-	160, 88, 89, 90, 91, 92, 93, 94, 95, 96,
-	97, 98, 99, 100, 101, 102, 103, 104, 105, 106,
+	0, 0, 0, 0, 0, 134, 0, 197, 0, 240,
+	175, 98, 99, 100, 101, 102, 103, 104, 105, 106,
 	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
-	117, 118, 119, 120, 121, 122, 123, 211, 0, 277,
-	278, 279, 263, 0, 0, 0, 0, 155, 0, 0,
-	0, 180, 0, 182, 0, 0, 240, 195, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1736, 0, 0, 85, 0, 0, 0,
-	0, 0, 0, 138, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 129,
-	245, 259, 139, 236, 272, 143, 243, 135, 210, 232,
-	131, 257, 242, 192, 174, 175, 130, 0, 227, 153,
-	166, 150, 208, 0, 0, 149, 275, 0, 267, 133,
-	134, 266, 207, 254, 258, 193, 187, 132, 256, 191,
-	186, 178, 157, 170, 220, 185, 221, 171, 197, 196,
-	198, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 269, 0, 0, 0, 0,
-	0, 0, 244, 0, 0, 179, 0, 0, 0, 0,
-	0, 230, 213, 0, 0, 218, 228, 183, 255, 222,
-	260, 246, 268, 0, 223, 125, 247, 152, 194, 136,
-	137, 148, 154, 156, 158, 159, 203, 204, 216, 235,
-	248, 249, 250, 151, 144, 229, 145, 168, 146, 126,
-	237, 147, 127, 217, 253, 0, 165, 225, 190, 128,
-	189, 219, 252, 251, 276, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 162, 0, 264, 0, 209, 0,
-	0, 0, 0, 0, 0, 0, 205, 280, 0, 0,
-	0, 0, 233, 0, 0, 0, 0, 0, 173, 215,
-	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 241, 262, 274, 265, 0, 0,
-	0, 273, 0, 0, 0, 0, 0, 0, 199, 200,
-	201, 202, 0, 0, 142, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 161, 167, 0, 169, 141,
-	214, 164, 271, 176, 206, 172, 238, 177, 184, 226,
-	270, 212, 231, 140, 261, 239, 188, 163, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 124, 0, 181, 0, 224, 160, 88,
-	89, 90, 91, 92, 93, 94, 95, 96, 97, 98,
+	117, 118, 119, 120, 121, 122, 123, 124, 125, 126,
+	127, 128, 129, 130, 131, 132, 133, 0, 0, 293,
+	294, 295, 279, 228, 0, 0, 845, 0, 0, 0,
+	0, 0, 0, 0, 170, 0, 0, 0, 196, 0,
+	198, 0, 0, 256, 211, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 349, 0, 0, 350, 0, 0, 0,
+	153, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 144, 261, 275, 154,
+	252, 288, 158, 259, 150, 227, 248, 146, 273, 258,
+	208, 189, 190, 145, 0, 243, 168, 181, 165, 225,
+	0, 0, 164, 291, 0, 283, 148, 149, 282, 224,
+	270, 274, 209, 203, 147, 272, 207, 202, 194, 172,
+	185, 236, 201, 237, 186, 214, 213, 215, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 212,
+	0, 0, 0, 285, 0, 0, 0, 0, 0, 0,
+	260, 0, 0, 195, 0, 0, 0, 0, 0, 246,
+	230, 0, 0, 0, 244, 199, 271, 238, 276, 262,
+	284, 0, 239, 135, 263, 167, 210, 151, 152, 163,
+	169, 171, 173, 174, 220, 221, 233, 251, 264, 265,
+	266, 166, 159, 245, 160, 183, 161, 136, 253, 162,
+	137, 234, 269, 0, 180, 241, 206, 138, 205, 235,
+	268, 267, 292, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 177, 0, 280, 0, 226, 0, 0, 0,
+	0, 0, 0, 0, 222, 296, 0, 0, 0, 0,
+	249, 0, 0, 0, 0, 0, 188, 232, 0, 250,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 257, 278, 290, 281, 0, 0, 0, 289,
+	0, 0, 0, 0, 844, 0, 216, 217, 218, 219,
+	0, 0, 157, 0, 0, 0, 0, 0, 0, 0,
+	0, 139, 191, 140, 141, 142, 143, 0, 0, 176,
+	182, 0, 184, 156, 231, 179, 287, 192, 223, 187,
+	254, 193, 200, 242, 286, 229, 247, 155, 277, 255,
+	204, 178, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 134, 0, 197,
+	0, 240, 175, 98, 99, 100, 101, 102, 103, 104,
+	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
+	115, 116, 117, 118, 119, 120, 121, 122, 123, 124,
+	125, 126, 127, 128, 129, 130, 131, 132, 133, 228,
+	0, 293, 294, 295, 279, 0, 0, 0, 0, 0,
+	170, 0, 0, 0, 196, 0, 198, 0, 0, 256,
+	211, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2169, 95,
+	691, 0, 0, 0, 0, 0, 153, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 144, 261, 275, 154, 252, 288, 158, 259,
+	150, 227, 248, 146, 273, 258, 208, 189, 190, 145,
+	0, 243, 168, 181, 165, 225, 0, 0, 164, 291,
+	0, 283, 148, 149, 282, 224, 270, 274, 209, 203,
+	147, 272, 207, 202, 194, 172, 185, 236, 201, 237,
+	186, 214, 213, 215, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 212, 0, 0, 0, 285,
+	0, 0, 0, 0, 0, 0, 260, 0, 0, 195,
+	0, 0, 0, 0, 0, 246, 230, 0, 0, 0,
+	244, 199, 271, 238, 276, 262, 284, 0, 239, 135,
+	263, 167, 210, 151, 152, 163, 169, 171, 173, 174,
+	220, 221, 233, 251, 264, 265, 266, 166, 159, 245,
+	160, 183, 161, 136, 253, 162, 137, 234, 269, 0,
+	180, 241, 206, 138, 205, 235, 268, 267, 292, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 177, 0,
+	280, 0, 226, 0, 0, 0, 0, 0, 0, 0,
+	222, 296, 0, 0, 0, 0, 249, 0, 0, 0,
+	0, 0, 188, 232, 0, 250, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 257, 278,
+	290, 281, 0, 0, 0, 289, 0, 0, 0, 0,
+	0, 0, 216, 217, 218, 219, 0, 0, 157, 0,
+	0, 0, 0, 0, 0, 0, 0, 139, 191, 140,
+	141, 142, 143, 0, 0, 176, 182, 0, 184, 156,
+	231, 179, 287, 192, 223, 187, 254, 193, 200, 242,
+	286, 229, 247, 155, 277, 255, 204, 178, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 134, 0, 197, 0, 240, 175, 98,
 	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
 	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
-	119, 120, 121, 122, 123, 211, 0, 277, 278, 279,
-	263, 0, 0, 0, 0, 155, 0, 0, 0, 180,
-	0, 182, 0, 0, 240, 195, 0, 0, 0, 0,
+	119, 120, 121, 122, 123, 124, 125, 126, 127, 128,
+	129, 130, 131, 132, 133, 228, 0, 293, 294, 295,
+	279, 0, 0, 0, 0, 0, 170, 0, 0, 0,
+	196, 0, 198, 0, 0, 256, 211, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 95, 0, 0, 778, 0,
+	0, 0, 153, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 144, 261,
+	275, 154, 252, 288, 158, 259, 150, 227, 248, 146,
+	273, 258, 208, 189, 190, 145, 0, 243, 168, 181,
+	165, 225, 0, 0, 164, 291, 0, 283, 148, 149,
+	282, 224, 270, 274, 209, 203, 147, 272, 207, 202,
+	194, 172, 185, 236, 201, 237, 186, 214, 213, 215,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 212, 0, 0, 0, 285, 0, 0, 0, 0,
+	0, 0, 260, 0, 0, 195, 0, 0, 0, 0,
+	0, 246, 230, 0, 0, 0, 244, 199, 271, 238,
+	276, 262, 284, 0, 239, 135, 263, 167, 210, 151,
+	152, 163, 169, 171, 173, 174, 220, 221, 233, 251,
+	264, 265, 266, 166, 159, 245, 160, 183, 161, 136,
+	253, 162, 137, 234, 269, 0, 180, 241, 206, 138,
+	205, 235, 268, 267, 292, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 177, 0, 280, 0, 226, 0,
+	0, 0, 0, 0, 0, 0, 222, 296, 0, 0,
+	0, 0, 249, 0, 0, 0, 0, 0, 188, 232,
+	0, 250, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 257, 278, 290, 281, 0, 0,
+	0, 289, 0, 0, 0, 0, 0, 1532, 216, 217,
+	218, 219, 0, 0, 157, 0, 0, 0, 0, 0,
+	0, 0, 0, 139, 191, 140, 141, 142, 143, 0,
+	0, 176, 182, 0, 184, 156, 231, 179, 287, 192,
+	223, 187, 254, 193, 200, 242, 286, 229, 247, 155,
+	277, 255, 204, 178, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 134,
+	0, 197, 0, 240, 175, 98, 99, 100, 101, 102,
+	103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
+	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
+	123, 124, 125, 126, 127, 128, 129, 130, 131, 132,
+	133, 228, 0, 293, 294, 295, 279, 0, 0, 0,
+	0, 0, 170, 1233, 0, 0, 196, 0, 198, 0,
+	0, 256, 211, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 85, 0, 0, 739, 0, 0,
-	0, 138, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 95, 0, 0, 778, 0, 0, 0, 153, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 129, 245, 259,
-	139, 236, 272, 143, 243, 135, 210, 232, 131, 257,
-	242, 192, 174, 175, 130, 0, 227, 153, 166, 150,
-	208, 0, 0, 149, 275, 0, 267, 133, 134, 266,
-	207, 254, 258, 193, 187, 132, 256, 191, 186, 178,
-	157, 170, 220, 185, 221, 171, 197, 196, 198, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 269, 0, 0, 0, 0, 0, 0,
-	244, 0, 0, 179, 0, 0, 0, 0, 0, 230,
-	213, 0, 0, 218, 228, 183, 255, 222, 260, 246,
-	268, 0, 223, 125, 247, 152, 194, 136, 137, 148,
-	154, 156, 158, 159, 203, 204, 216, 235, 248, 249,
-	250, 151, 144, 229, 145, 168, 146, 126, 237, 147,
-	127, 217, 253, 0, 165, 225, 190, 128, 189, 219,
-	252, 251, 276, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 162, 0, 264, 0, 209, 0, 0, 0,
-	0, 0, 0, 0, 205, 280, 0, 0, 0, 0,
-	233, 0, 0, 0, 0, 0, 173, 215, 0, 234,
+	0, 0, 0, 0, 144, 261, 275, 154, 252, 288,
+	158, 259, 150, 227, 248, 146, 273, 258, 208, 189,
+	190, 145, 0, 243, 168, 181, 165, 225, 0, 0,
+	164, 291, 0, 283, 148, 149, 282, 224, 270, 274,
+	209, 203, 147, 272, 207, 202, 194, 172, 185, 236,
+	201, 237, 186, 214, 213, 215, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 212, 0, 0,
+	0, 285, 0, 0, 0, 0, 0, 0, 260, 0,
+	0, 195, 0, 0, 0, 0, 0, 246, 230, 0,
+	0, 0, 244, 199, 271, 238, 276, 262, 284, 0,
+	239, 135, 263, 167, 210, 151, 152, 163, 169, 171,
+	173, 174, 220, 221, 233, 251, 264, 265, 266, 166,
+	159, 245, 160, 183, 161, 136, 253, 162, 137, 234,
+	269, 0, 180, 241, 206, 138, 205, 235, 268, 267,
+	292, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	177, 0, 280, 0, 226, 0, 0, 0, 0, 0,
+	0, 0, 222, 296, 0, 0, 0, 0, 249, 0,
+	0, 0, 0, 0, 188, 232, 0, 250, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 241, 262, 274, 265, 0, 0, 0, 273,
-	0, 0, 0, 0, 0, 0, 199, 200, 201, 202,
-	0, 0, 142, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 161, 167, 0, 169, 141, 214, 164,
-	271, 176, 206, 172, 238, 177, 184, 226, 270, 212,
-	231, 140, 261, 239, 188, 163, 0, 0, 0, 0,
+	257, 278, 290, 281, 0, 0, 0, 289, 0, 0,
+	0, 0, 0, 0, 216, 217, 218, 219, 0, 0,
+	157, 0, 0, 0, 0, 0, 0, 0, 0, 139,
+	191, 140, 141, 142, 143, 0, 0, 176, 182, 0,
+	184, 156, 231, 179, 287, 192, 223, 187, 254, 193,
+	200, 242, 286, 229, 247, 155, 277, 255, 204, 178,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 124, 0, 181, 0, 224, 160, 88, 89, 90,
-	91, 92, 93, 94, 95, 96, 97, 98, 99, 100,
+	0, 0, 0, 0, 0, 134, 0, 197, 0, 240,
+	175, 98, 99, 100, 101, 102, 103, 104, 105, 106,
+	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
+	117, 118, 119, 120, 121, 122, 123, 124, 125, 126,
+	127, 128, 129, 130, 131, 132, 133, 228, 0, 293,
+	294, 295, 279, 0, 0, 0, 0, 0, 170, 0,
+	0, 0, 196, 0, 198, 0, 0, 256, 211, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 95, 691, 0,
+	0, 0, 0, 0, 153, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	144, 261, 275, 154, 252, 288, 158, 259, 150, 227,
+	248, 146, 273, 258, 208, 189, 190, 145, 0, 243,
+	168, 181, 165, 225, 0, 0, 164, 291, 0, 283,
+	148, 149, 282, 224, 270, 274, 209, 203, 147, 272,
+	207, 202, 194, 172, 185, 236, 201, 237, 186, 214,
+	213, 215, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 212, 0, 0, 0, 285, 0, 0,
+	0, 0, 0, 0, 260, 0, 0, 195, 0, 0,
+	0, 0, 0, 246, 230, 0, 0, 0, 244, 199,
+	271, 238, 276, 262, 284, 0, 239, 135, 263, 167,
+	210, 151, 152, 163, 169, 171, 173, 174, 220, 221,
+	233, 251, 264, 265, 266, 166, 159, 245, 160, 183,
+	161, 136, 253, 162, 137, 234, 269, 0, 180, 241,
+	206, 138, 205, 235, 268, 267, 292, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 177, 0, 280, 0,
+	226, 0, 0, 0, 0, 0, 0, 0, 222, 296,
+	0, 0, 0, 0, 249, 0, 0, 0, 0, 0,
+	188, 232, 0, 250, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 257, 278, 290, 281,
+	0, 0, 0, 289, 0, 0, 0, 0, 0, 0,
+	216, 217, 218, 219, 0, 0, 157, 0, 0, 0,
+	0, 0, 0, 0, 0, 139, 191, 140, 141, 142,
+	143, 0, 0, 176, 182, 0, 184, 156, 231, 179,
+	287, 192, 223, 187, 254, 193, 200, 242, 286, 229,
+	247, 155, 277, 255, 204, 178, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 134, 0, 197, 0, 240, 175, 98, 99, 100,
 	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
 	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
-	121, 122, 123, 211, 0, 277, 278, 279, 263, 0,
-	0, 0, 0, 155, 0, 0, 0, 180, 0, 182,
-	0, 0, 240, 195, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 85, 0, 0, 0, 0, 0, 0, 138,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1481, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 129, 245, 259, 139, 236,
-	272, 143, 243, 135, 210, 232, 131, 257, 242, 192,
-	174, 175, 130, 0, 227, 153, 166, 150, 208, 0,
-	0, 149, 275, 0, 267, 133, 134, 266, 207, 254,
-	258, 193, 187, 132, 256, 191, 186, 178, 157, 170,
-	220, 185, 221, 171, 197, 196, 198, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 269, 0, 0, 0, 0, 0, 0, 244, 0,
-	0, 179, 0, 0, 0, 0, 0, 230, 213, 0,
-	0, 218, 228, 183, 255, 222, 260, 246, 268, 0,
-	223, 125, 247, 152, 194, 136, 137, 148, 154, 156,
-	158, 159, 203, 204, 216, 235, 248, 249, 250, 151,
-	144, 229, 145, 168, 146, 126, 237, 147, 127, 217,
-	253, 0, 165, 225, 190, 128, 189, 219, 252, 251,
-	276, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	162, 0, 264, 0, 209, 0, 0, 0, 0, 0,
-	0, 0, 205, 280, 0, 0, 0, 0, 233, 0,
-	0, 0, 0, 0, 173, 215, 0, 234, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	241, 262, 274, 265, 0, 0, 0, 273, 0, 0,
-	0, 0, 0, 0, 199, 200, 201, 202, 0, 0,
-	142, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 161, 167, 0, 169, 141, 214, 164, 271, 176,
-	206, 172, 238, 177, 184, 226, 270, 212, 231, 140,
-	261, 239, 188, 163, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 124,
-	0, 181, 0, 224, 160, 88, 89, 90, 91, 92,
-	93, 94, 95, 96, 97, 98, 99, 100, 101, 102,
+	121, 122, 123, 124, 125, 126, 127, 128, 129, 130,
+	131, 132, 133, 228, 0, 293, 294, 295, 279, 0,
+	0, 0, 0, 0, 170, 0, 0, 0, 196, 0,
+	198, 0, 0, 256, 211, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1869, 0, 0, 95, 0, 0, 0, 0, 0, 0,
+	153, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 144, 261, 275, 154,
+	252, 288, 158, 259, 150, 227, 248, 146, 273, 258,
+	208, 189, 190, 145, 0, 243, 168, 181, 165, 225,
+	0, 0, 164, 291, 0, 283, 148, 149, 282, 224,
+	270, 274, 209, 203, 147, 272, 207, 202, 194, 172,
+	185, 236, 201, 237, 186, 214, 213, 215, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 212,
+	0, 0, 0, 285, 0, 0, 0, 0, 0, 0,
+	260, 0, 0, 195, 0, 0, 0, 0, 0, 246,
+	230, 0, 0, 0, 244, 199, 271, 238, 276, 262,
+	284, 0, 239, 135, 263, 167, 210, 151, 152, 163,
+	169, 171, 173, 174, 220, 221, 233, 251, 264, 265,
+	266, 166, 159, 245, 160, 183, 161, 136, 253, 162,
+	137, 234, 269, 0, 180, 241, 206, 138, 205, 235,
+	268, 267, 292, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 177, 0, 280, 0, 226, 0, 0, 0,
+	0, 0, 0, 0, 222, 296, 0, 0, 0, 0,
+	249, 0, 0, 0, 0, 0, 188, 232, 0, 250,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 257, 278, 290, 281, 0, 0, 0, 289,
+	0, 0, 0, 0, 0, 0, 216, 217, 218, 219,
+	0, 0, 157, 0, 0, 0, 0, 0, 0, 0,
+	0, 139, 191, 140, 141, 142, 143, 0, 0, 176,
+	182, 0, 184, 156, 231, 179, 287, 192, 223, 187,
+	254, 193, 200, 242, 286, 229, 247, 155, 277, 255,
+	204, 178, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 134, 0, 197,
+	0, 240, 175, 98, 99, 100, 101, 102, 103, 104,
+	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
+	115, 116, 117, 118, 119, 120, 121, 122, 123, 124,
+	125, 126, 127, 128, 129, 130, 131, 132, 133, 228,
+	0, 293, 294, 295, 279, 0, 0, 0, 0, 0,
+	170, 0, 0, 0, 196, 0, 198, 0, 0, 256,
+	211, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 95,
+	0, 0, 778, 0, 0, 0, 153, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 144, 261, 275, 154, 252, 288, 158, 259,
+	150, 227, 248, 146, 273, 258, 208, 189, 190, 145,
+	0, 243, 168, 181, 165, 225, 0, 0, 164, 291,
+	0, 283, 148, 149, 282, 224, 270, 274, 209, 203,
+	147, 272, 207, 202, 194, 172, 185, 236, 201, 237,
+	186, 214, 213, 215, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 212, 0, 0, 0, 285,
+	0, 0, 0, 0, 0, 0, 260, 0, 0, 195,
+	0, 0, 0, 0, 0, 246, 230, 0, 0, 0,
+	244, 199, 271, 238, 276, 262, 284, 0, 239, 135,
+	263, 167, 210, 151, 152, 163, 169, 171, 173, 174,
+	220, 221, 233, 251, 264, 265, 266, 166, 159, 245,
+	160, 183, 161, 136, 253, 162, 137, 234, 269, 0,
+	180, 241, 206, 138, 205, 235, 268, 267, 292, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 177, 0,
+	280, 0, 226, 0, 0, 0, 0, 0, 0, 0,
+	222, 296, 0, 0, 0, 0, 249, 0, 0, 0,
+	0, 0, 188, 232, 0, 250, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 257, 278,
+	290, 281, 0, 0, 0, 289, 0, 0, 0, 0,
+	0, 0, 216, 217, 218, 219, 0, 0, 157, 0,
+	0, 0, 0, 0, 0, 0, 0, 139, 191, 140,
+	141, 142, 143, 0, 0, 176, 182, 0, 184, 156,
+	231, 179, 287, 192, 223, 187, 254, 193, 200, 242,
+	286, 229, 247, 155, 277, 255, 204, 178, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 134, 0, 197, 0, 240, 175, 98,
+	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
+	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
+	119, 120, 121, 122, 123, 124, 125, 126, 127, 128,
+	129, 130, 131, 132, 133, 228, 0, 293, 294, 295,
+	279, 0, 0, 0, 0, 0, 170, 0, 0, 0,
+	196, 0, 198, 0, 0, 256, 211, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 95, 0, 0, 0, 0,
+	0, 0, 153, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1650, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 144, 261,
+	275, 154, 252, 288, 158, 259, 150, 227, 248, 146,
+	273, 258, 208, 189, 190, 145, 0, 243, 168, 181,
+	165, 225, 0, 0, 164, 291, 0, 283, 148, 149,
+	282, 224, 270, 274, 209, 203, 147, 272, 207, 202,
+	194, 172, 185, 236, 201, 237, 186, 214, 213, 215,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 212, 0, 0, 0, 285, 0, 0, 0, 0,
+	0, 0, 260, 0, 0, 195, 0, 0, 0, 0,
+	0, 246, 230, 0, 0, 0, 244, 199, 271, 238,
+	276, 262, 284, 0, 239, 135, 263, 167, 210, 151,
+	152, 163, 169, 171, 173, 174, 220, 221, 233, 251,
+	264, 265, 266, 166, 159, 245, 160, 183, 161, 136,
+	253, 162, 137, 234, 269, 0, 180, 241, 206, 138,
+	205, 235, 268, 267, 292, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 177, 0, 280, 0, 226, 0,
+	0, 0, 0, 0, 0, 0, 222, 296, 0, 0,
+	0, 0, 249, 0, 0, 0, 0, 0, 188, 232,
+	0, 250, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 257, 278, 290, 281, 0, 0,
+	0, 289, 0, 0, 0, 0, 0, 0, 216, 217,
+	218, 219, 0, 0, 157, 0, 0, 0, 0, 0,
+	0, 0, 0, 139, 191, 140, 141, 142, 143, 0,
+	0, 176, 182, 0, 184, 156, 231, 179, 287, 192,
+	223, 187, 254, 193, 200, 242, 286, 229, 247, 155,
+	277, 255, 204, 178, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 134,
+	0, 197, 0, 240, 175, 98, 99, 100, 101, 102,
 	103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
 	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
-	123, 211, 0, 277, 278, 279, 263, 0, 0, 0,
-	0, 155, 0, 0, 0, 180, 0, 182, 0, 0,
-	240, 195, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 301, 0, 0,
-	85, 0, 0, 0, 0, 0, 0, 138, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 129, 245, 259, 139, 236, 272, 143,
-	243, 135, 210, 232, 131, 257, 242, 192, 174, 175,
-	130, 0, 227, 153, 166, 150, 208, 0, 0, 149,
-	275, 0, 267, 133, 134, 266, 207, 254, 258, 193,
-	187, 132, 256, 191, 186, 178, 157, 170, 220, 185,
-	221, 171, 197, 196, 198, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 269,
-	0, 0, 0, 0, 0, 0, 244, 0, 0, 179,
-	0, 0, 0, 0, 0, 230, 213, 0, 0, 218,
-	228, 183, 255, 222, 260, 246, 268, 0, 223, 125,
-	247, 152, 194, 136, 137, 148, 154, 156, 158, 159,
-	203, 204, 216, 235, 248, 249, 250, 151, 144, 229,
-	145, 168, 146, 126, 237, 147, 127, 217, 253, 0,
-	165, 225, 190, 128, 189, 219, 252, 251, 276, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 162, 0,
-	264, 0, 209, 0, 0, 0, 0, 0, 0, 0,
-	205, 280, 0, 0, 0, 0, 233, 0, 0, 0,
-	0, 0, 173, 215, 0, 234, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 241, 262,
-	274, 265, 0, 0, 0, 273, 0, 0, 0, 0,
-	0, 0, 199, 200, 201, 202, 0, 0, 142, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 161,
-	167, 0, 169, 141, 214, 164, 271, 176, 206, 172,
-	238, 177, 184, 226, 270, 212, 231, 140, 261, 239,
-	188, 163, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 124, 0, 181,
-	0, 224, 160, 88, 89, 90, 91, 92, 93, 94,
-	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
-	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
-	115, 116, 117, 118, 119, 120, 121, 122, 123, 211,
-	0, 277, 278, 279, 263, 0, 0, 0, 0, 155,
-	0, 0, 0, 180, 0, 182, 0, 0, 240, 195,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 85, 0,
-	0, 0, 0, 0, 0, 138, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1169, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 129, 245, 259, 139, 236, 272, 143, 243, 135,
-	210, 232, 131, 257, 242, 192, 174, 175, 130, 0,
-	227, 153, 166, 150, 208, 0, 0, 149, 275, 0,
-	267, 133, 134, 266, 207, 254, 258, 193, 187, 132,
-	256, 191, 186, 178, 157, 170, 220, 185, 221, 171,
-	197, 196, 198, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 269, 0, 0,
-	0, 0, 0, 0, 244, 0, 0, 179, 0, 0,
-	0, 0, 0, 230, 213, 0, 0, 218, 228, 183,
-	255, 222, 260, 246, 268, 0, 223, 125, 247, 152,
-	194, 136, 137, 148, 154, 156, 158, 159, 203, 204,
-	216, 235, 248, 249, 250, 151, 144, 229, 145, 168,
-	146, 126, 237, 147, 127, 217, 253, 0, 165, 225,
-	190, 128, 189, 219, 252, 251, 276, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 162, 0, 264, 0,
-	209, 0, 0, 0, 0, 0, 0, 0, 205, 280,
-	0, 0, 0, 0, 233, 0, 0, 0, 0, 0,
-	173, 215, 0, 234, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 241, 262, 274, 265,
-	0, 0, 0, 273, 0, 0, 0, 0, 0, 0,
-	199, 200, 201, 202, 0, 0, 142, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 161, 167, 0,
-	169, 141, 214, 164, 271, 176, 206, 172, 238, 177,
-	184, 226, 270, 212, 231, 140, 261, 239, 188, 163,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 124, 0, 181, 0, 224,
-	160, 88, 89, 90, 91, 92, 93, 94, 95, 96,
-	97, 98, 99, 100, 101, 102, 103, 104, 105, 106,
+	123, 124, 125, 126, 127, 128, 129, 130, 131, 132,
+	133, 228, 0, 293, 294, 295, 279, 0, 0, 0,
+	0, 0, 170, 0, 0, 0, 196, 0, 198, 0,
+	0, 256, 211, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 318, 0,
+	0, 95, 0, 0, 0, 0, 0, 0, 153, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 144, 261, 275, 154, 252, 288,
+	158, 259, 150, 227, 248, 146, 273, 258, 208, 189,
+	190, 145, 0, 243, 168, 181, 165, 225, 0, 0,
+	164, 291, 0, 283, 148, 149, 282, 224, 270, 274,
+	209, 203, 147, 272, 207, 202, 194, 172, 185, 236,
+	201, 237, 186, 214, 213, 215, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 212, 0, 0,
+	0, 285, 0, 0, 0, 0, 0, 0, 260, 0,
+	0, 195, 0, 0, 0, 0, 0, 246, 230, 0,
+	0, 0, 244, 199, 271, 238, 276, 262, 284, 0,
+	239, 135, 263, 167, 210, 151, 152, 163, 169, 171,
+	173, 174, 220, 221, 233, 251, 264, 265, 266, 166,
+	159, 245, 160, 183, 161, 136, 253, 162, 137, 234,
+	269, 0, 180, 241, 206, 138, 205, 235, 268, 267,
+	292, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	177, 0, 280, 0, 226, 0, 0, 0, 0, 0,
+	0, 0, 222, 296, 0, 0, 0, 0, 249, 0,
+	0, 0, 0, 0, 188, 232, 0, 250, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	257, 278, 290, 281, 0, 0, 0, 289, 0, 0,
+	0, 0, 0, 0, 216, 217, 218, 219, 0, 0,
+	157, 0, 0, 0, 0, 0, 0, 0, 0, 139,
+	191, 140, 141, 142, 143, 0, 0, 176, 182, 0,
+	184, 156, 231, 179, 287, 192, 223, 187, 254, 193,
+	200, 242, 286, 229, 247, 155, 277, 255, 204, 178,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 134, 0, 197, 0, 240,
+	175, 98, 99, 100, 101, 102, 103, 104, 105, 106,
 	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
-	117, 118, 119, 120, 121, 122, 123, 211, 0, 277,
-	278, 279, 263, 0, 0, 0, 0, 155, 0, 0,
-	0, 180, 0, 182, 0, 0, 240, 195, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 332, 0, 0, 333,
-	0, 0, 0, 138, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 129,
-	245, 259, 139, 236, 272, 143, 243, 135, 210, 232,
-	131, 257, 242, 192, 174, 175, 130, 0, 227, 153,
-	166, 150, 208, 0, 0, 149, 275, 0, 267, 133,
-	134, 266, 207, 254, 258, 193, 187, 132, 256, 191,
-	186, 178, 157, 170, 220, 185, 221, 171, 197, 196,
-	198, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 269, 0, 0, 0, 0,
-	0, 0, 244, 0, 0, 179, 0, 0, 0, 0,
-	0, 230, 213, 0, 0, 218, 228, 183, 255, 222,
-	260, 246, 268, 0, 223, 125, 247, 152, 194, 136,
-	137, 148, 154, 156, 158, 159, 203, 204, 216, 235,
-	248, 249, 250, 151, 144, 229, 145, 168, 146, 126,
-	237, 147, 127, 217, 253, 0, 165, 225, 190, 128,
-	189, 219, 252, 251, 276, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 162, 0, 264, 0, 209, 0,
-	0, 0, 0, 0, 0, 0, 205, 280, 0, 0,
-	0, 0, 233, 0, 0, 0, 0, 0, 173, 215,
-	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 241, 262, 274, 265, 0, 0,
-	0, 273, 0, 0, 0, 0, 0, 0, 199, 200,
-	201, 202, 0, 0, 142, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 161, 167, 0, 169, 141,
-	214, 164, 271, 176, 206, 172, 238, 177, 184, 226,
-	270, 212, 231, 140, 261, 239, 188, 163, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 124, 0, 181, 0, 224, 160, 88,
-	89, 90, 91, 92, 93, 94, 95, 96, 97, 98,
+	117, 118, 119, 120, 121, 122, 123, 124, 125, 126,
+	127, 128, 129, 130, 131, 132, 133, 228, 0, 293,
+	294, 295, 279, 0, 0, 0, 0, 0, 170, 0,
+	0, 0, 196, 0, 198, 0, 0, 256, 211, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 95, 0, 0,
+	0, 0, 0, 0, 153, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1254, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	144, 261, 275, 154, 252, 288, 158, 259, 150, 227,
+	248, 146, 273, 258, 208, 189, 190, 145, 0, 243,
+	168, 181, 165, 225, 0, 0, 164, 291, 0, 283,
+	148, 149, 282, 224, 270, 274, 209, 203, 147, 272,
+	207, 202, 194, 172, 185, 236, 201, 237, 186, 214,
+	213, 215, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 212, 0, 0, 0, 285, 0, 0,
+	0, 0, 0, 0, 260, 0, 0, 195, 0, 0,
+	0, 0, 0, 246, 230, 0, 0, 0, 244, 199,
+	271, 238, 276, 262, 284, 0, 239, 135, 263, 167,
+	210, 151, 152, 163, 169, 171, 173, 174, 220, 221,
+	233, 251, 264, 265, 266, 166, 159, 245, 160, 183,
+	161, 136, 253, 162, 137, 234, 269, 0, 180, 241,
+	206, 138, 205, 235, 268, 267, 292, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 177, 0, 280, 0,
+	226, 0, 0, 0, 0, 0, 0, 0, 222, 296,
+	0, 0, 0, 0, 249, 0, 0, 0, 0, 0,
+	188, 232, 0, 250, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 257, 278, 290, 281,
+	0, 0, 0, 289, 0, 0, 0, 0, 0, 0,
+	216, 217, 218, 219, 0, 0, 157, 0, 0, 0,
+	0, 0, 0, 0, 0, 139, 191, 140, 141, 142,
+	143, 0, 0, 176, 182, 0, 184, 156, 231, 179,
+	287, 192, 223, 187, 254, 193, 200, 242, 286, 229,
+	247, 155, 277, 255, 204, 178, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 134, 0, 197, 0, 240, 175, 98, 99, 100,
+	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
+	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
+	121, 122, 123, 124, 125, 126, 127, 128, 129, 130,
+	131, 132, 133, 228, 0, 293, 294, 295, 279, 0,
+	0, 0, 0, 0, 170, 0, 0, 0, 196, 0,
+	198, 0, 0, 256, 211, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 349, 0, 0, 350, 0, 0, 0,
+	153, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 144, 261, 275, 154,
+	252, 288, 158, 259, 150, 227, 248, 146, 273, 258,
+	208, 189, 190, 145, 0, 243, 168, 181, 165, 225,
+	0, 0, 164, 291, 0, 283, 148, 149, 282, 224,
+	270, 274, 209, 203, 147, 272, 207, 202, 194, 172,
+	185, 236, 201, 237, 186, 214, 213, 215, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 212,
+	0, 0, 0, 285, 0, 0, 0, 0, 0, 0,
+	260, 0, 0, 195, 0, 0, 0, 0, 0, 246,
+	230, 0, 0, 0, 244, 199, 271, 238, 276, 262,
+	284, 0, 239, 135, 263, 167, 210, 151, 152, 163,
+	169, 171, 173, 174, 220, 221, 233, 251, 264, 265,
+	266, 166, 159, 245, 160, 183, 161, 136, 253, 162,
+	137, 234, 269, 0, 180, 241, 206, 138, 205, 235,
+	268, 267, 292, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 177, 0, 280, 0, 226, 0, 0, 0,
+	0, 0, 0, 0, 222, 296, 0, 0, 0, 0,
+	249, 0, 0, 0, 0, 0, 188, 232, 0, 250,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 257, 278, 290, 281, 0, 0, 0, 289,
+	0, 0, 0, 0, 0, 0, 216, 217, 218, 219,
+	0, 0, 157, 0, 0, 0, 0, 0, 0, 0,
+	0, 139, 191, 140, 141, 142, 143, 0, 0, 176,
+	182, 0, 184, 156, 231, 179, 287, 192, 223, 187,
+	254, 193, 200, 242, 286, 229, 247, 155, 277, 255,
+	204, 178, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 134, 0, 197,
+	0, 240, 175, 98, 99, 100, 101, 102, 103, 104,
+	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
+	115, 116, 117, 118, 119, 120, 121, 122, 123, 124,
+	125, 126, 127, 128, 129, 130, 131, 132, 133, 228,
+	0, 293, 294, 295, 279, 0, 0, 0, 0, 0,
+	170, 0, 0, 0, 196, 0, 198, 0, 0, 256,
+	211, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 95,
+	0, 0, 0, 0, 0, 0, 153, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 144, 261, 275, 154, 252, 288, 158, 259,
+	150, 227, 248, 146, 273, 258, 208, 189, 190, 145,
+	0, 243, 168, 181, 165, 225, 0, 0, 164, 291,
+	0, 283, 148, 149, 282, 224, 270, 274, 209, 203,
+	147, 272, 207, 202, 194, 172, 185, 236, 201, 237,
+	186, 214, 213, 215, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 212, 0, 0, 0, 285,
+	0, 0, 1179, 0, 0, 0, 260, 0, 0, 195,
+	0, 0, 0, 0, 0, 246, 230, 0, 0, 0,
+	244, 199, 271, 238, 276, 262, 284, 0, 239, 135,
+	263, 167, 210, 151, 152, 163, 169, 171, 173, 174,
+	220, 221, 233, 251, 264, 265, 266, 166, 159, 245,
+	160, 183, 161, 136, 253, 162, 137, 234, 269, 0,
+	180, 241, 206, 138, 205, 235, 268, 267, 292, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 177, 0,
+	280, 0, 226, 0, 0, 0, 0, 0, 0, 0,
+	222, 296, 0, 0, 0, 0, 249, 0, 0, 0,
+	0, 0, 188, 232, 0, 250, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 257, 278,
+	290, 281, 0, 0, 0, 289, 0, 0, 0, 0,
+	0, 0, 216, 217, 218, 219, 0, 0, 157, 0,
+	0, 0, 0, 0, 0, 0, 0, 139, 191, 140,
+	141, 142, 143, 0, 0, 176, 182, 0, 184, 156,
+	231, 179, 287, 192, 223, 187, 254, 193, 200, 242,
+	286, 229, 247, 155, 277, 255, 204, 178, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 134, 0, 197, 0, 240, 175, 98,
 	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
 	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
-	119, 120, 121, 122, 123, 211, 0, 277, 278, 279,
-	263, 0, 0, 0, 0, 155, 0, 0, 0, 180,
-	0, 182, 0, 0, 240, 195, 0, 0, 0, 0,
+	119, 120, 121, 122, 123, 124, 125, 126, 127, 128,
+	129, 130, 131, 132, 133, 228, 0, 293, 294, 295,
+	279, 0, 0, 0, 0, 0, 170, 0, 0, 0,
+	196, 0, 198, 0, 0, 256, 211, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 95, 0, 0, 778, 0,
+	0, 0, 153, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 144, 261,
+	275, 154, 252, 288, 158, 259, 150, 227, 248, 146,
+	273, 258, 208, 189, 190, 145, 0, 243, 168, 181,
+	165, 225, 0, 0, 164, 291, 0, 283, 148, 149,
+	282, 224, 270, 274, 209, 203, 147, 272, 207, 202,
+	194, 172, 185, 236, 201, 237, 186, 214, 213, 215,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 212, 0, 0, 0, 285, 0, 0, 0, 0,
+	0, 0, 260, 0, 0, 195, 0, 0, 0, 0,
+	0, 246, 230, 0, 0, 0, 244, 199, 271, 238,
+	276, 262, 284, 0, 239, 135, 263, 167, 210, 151,
+	152, 163, 169, 171, 173, 174, 220, 221, 233, 251,
+	264, 265, 266, 166, 159, 245, 160, 183, 161, 136,
+	253, 162, 137, 234, 269, 0, 180, 241, 206, 138,
+	205, 235, 268, 267, 292, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 177, 0, 280, 0, 226, 0,
+	0, 0, 0, 0, 0, 0, 222, 296, 0, 0,
+	0, 0, 249, 0, 0, 0, 0, 0, 188, 232,
+	0, 250, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 257, 278, 290, 827, 0, 0,
+	0, 289, 0, 0, 0, 0, 0, 0, 216, 217,
+	218, 219, 0, 0, 157, 0, 0, 0, 0, 0,
+	0, 0, 0, 139, 191, 140, 141, 142, 143, 0,
+	0, 176, 182, 0, 184, 156, 231, 179, 287, 192,
+	223, 187, 254, 193, 200, 242, 286, 229, 247, 155,
+	277, 255, 204, 178, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 134,
+	0, 197, 0, 240, 175, 98, 99, 100, 101, 102,
+	103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
+	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
+	123, 124, 125, 126, 127, 128, 129, 130, 131, 132,
+	133, 228, 0, 293, 294, 295, 279, 0, 0, 0,
+	0, 0, 170, 0, 0, 0, 196, 0, 198, 0,
+	0, 256, 211, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 85, 0, 0, 0, 0, 0,
-	0, 138, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 95, 0, 0, 0, 0, 0, 0, 153, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 129, 245, 259,
-	139, 236, 272, 143, 243, 135, 210, 232, 131, 257,
-	242, 192, 174, 175, 130, 0, 227, 153, 166, 150,
-	208, 0, 0, 149, 275, 0, 267, 133, 134, 266,
-	207, 254, 258, 193, 187, 132, 256, 191, 186, 178,
-	157, 170, 220, 185, 221, 171, 197, 196, 198, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 269, 0, 0, 1113, 0, 0, 0,
-	244, 0, 0, 179, 0, 0, 0, 0, 0, 230,
-	213, 0, 0, 218, 228, 183, 255, 222, 260, 246,
-	268, 0, 223, 125, 247, 152, 194, 136, 137, 148,
-	154, 156, 158, 159, 203, 204, 216, 235, 248, 249,
-	250, 151, 144, 229, 145, 168, 146, 126, 237, 147,
-	127, 217, 253, 0, 165, 225, 190, 128, 189, 219,
-	252, 251, 276, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 162, 0, 264, 0, 209, 0, 0, 0,
-	0, 0, 0, 0, 205, 280, 0, 0, 0, 0,
-	233, 0, 0, 0, 0, 0, 173, 215, 0, 234,
+	0, 0, 0, 0, 144, 261, 275, 154, 252, 288,
+	158, 259, 150, 227, 248, 146, 273, 258, 208, 189,
+	190, 145, 0, 243, 168, 181, 165, 225, 0, 0,
+	164, 291, 0, 283, 148, 149, 282, 224, 270, 274,
+	209, 203, 147, 272, 207, 202, 194, 172, 185, 236,
+	201, 237, 186, 214, 213, 215, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 212, 0, 0,
+	0, 285, 0, 0, 0, 0, 0, 0, 260, 0,
+	0, 195, 0, 0, 0, 0, 0, 246, 230, 0,
+	0, 0, 244, 199, 271, 238, 276, 262, 284, 0,
+	239, 135, 263, 167, 210, 151, 152, 163, 169, 171,
+	173, 174, 220, 221, 233, 251, 264, 265, 266, 166,
+	159, 245, 160, 183, 161, 136, 253, 162, 137, 234,
+	269, 0, 180, 241, 206, 138, 205, 235, 268, 267,
+	292, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	177, 0, 280, 0, 226, 0, 0, 0, 0, 0,
+	0, 0, 222, 296, 0, 0, 0, 0, 249, 0,
+	0, 0, 0, 0, 188, 232, 0, 250, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 241, 262, 274, 265, 0, 0, 0, 273,
-	0, 0, 0, 0, 0, 0, 199, 200, 201, 202,
-	0, 0, 142, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 161, 167, 0, 169, 141, 214, 164,
-	271, 176, 206, 172, 238, 177, 184, 226, 270, 212,
-	231, 140, 261, 239, 188, 163, 0, 0, 0, 0,
+	257, 278, 290, 281, 0, 0, 0, 289, 0, 0,
+	0, 0, 0, 0, 216, 217, 218, 219, 0, 0,
+	157, 0, 0, 0, 0, 0, 0, 0, 0, 139,
+	191, 140, 141, 142, 143, 0, 0, 176, 182, 0,
+	184, 156, 231, 179, 287, 192, 223, 187, 254, 193,
+	200, 242, 286, 229, 247, 155, 277, 255, 204, 178,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 124, 0, 181, 0, 224, 160, 88, 89, 90,
-	91, 92, 93, 94, 95, 96, 97, 98, 99, 100,
+	0, 0, 0, 433, 0, 134, 0, 197, 0, 240,
+	175, 98, 99, 100, 101, 102, 103, 104, 105, 106,
+	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
+	117, 118, 119, 120, 121, 122, 123, 124, 125, 126,
+	127, 128, 129, 130, 131, 132, 133, 228, 0, 293,
+	294, 295, 279, 0, 0, 0, 0, 92, 170, 0,
+	0, 0, 196, 0, 198, 0, 0, 256, 211, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 95, 0, 0,
+	0, 0, 0, 0, 153, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	144, 261, 275, 154, 252, 288, 158, 259, 150, 227,
+	248, 146, 273, 258, 208, 189, 190, 145, 0, 243,
+	168, 181, 165, 225, 0, 0, 164, 291, 0, 283,
+	148, 149, 282, 224, 270, 274, 209, 203, 147, 272,
+	207, 202, 194, 172, 185, 236, 201, 237, 186, 214,
+	213, 215, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 212, 0, 0, 0, 285, 0, 0,
+	0, 0, 0, 0, 260, 0, 0, 195, 0, 0,
+	0, 0, 0, 246, 230, 0, 0, 0, 244, 199,
+	271, 238, 276, 262, 284, 0, 239, 135, 263, 167,
+	210, 151, 152, 163, 169, 171, 173, 174, 220, 221,
+	233, 251, 264, 265, 266, 166, 159, 245, 160, 183,
+	161, 136, 253, 162, 137, 234, 269, 0, 180, 241,
+	206, 138, 205, 235, 268, 267, 292, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 177, 0, 280, 0,
+	226, 0, 0, 0, 0, 0, 0, 0, 222, 296,
+	0, 0, 0, 0, 249, 0, 0, 0, 0, 0,
+	188, 232, 0, 250, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 257, 278, 290, 281,
+	0, 0, 0, 289, 0, 0, 0, 0, 0, 0,
+	216, 217, 218, 219, 0, 0, 157, 0, 0, 0,
+	0, 0, 0, 0, 0, 139, 191, 140, 141, 142,
+	143, 0, 0, 176, 182, 0, 184, 156, 231, 179,
+	287, 192, 223, 187, 254, 193, 200, 242, 286, 229,
+	247, 155, 277, 255, 204, 178, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 134, 0, 197, 0, 240, 175, 98, 99, 100,
 	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
 	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
-	121, 122, 123, 211, 0, 277, 278, 279, 263, 0,
-	0, 0, 0, 155, 0, 0, 0, 180, 0, 182,
-	0, 0, 240, 195, 0, 0, 0, 0, 0, 0,
+	121, 122, 123, 124, 125, 126, 127, 128, 129, 130,
+	131, 132, 133, 228, 0, 293, 294, 295, 279, 0,
+	0, 0, 0, 0, 170, 0, 0, 0, 196, 0,
+	198, 0, 0, 256, 211, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 95, 0, 0, 0, 0, 0, 0,
+	153, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 144, 261, 275, 154,
+	252, 288, 158, 259, 150, 227, 248, 146, 273, 258,
+	208, 189, 190, 145, 0, 243, 168, 181, 165, 225,
+	0, 0, 164, 291, 0, 283, 148, 149, 282, 224,
+	270, 274, 209, 203, 147, 272, 207, 202, 194, 172,
+	185, 236, 201, 237, 186, 214, 213, 215, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 212,
+	0, 0, 0, 285, 0, 0, 0, 0, 0, 0,
+	260, 0, 0, 195, 0, 0, 0, 0, 0, 246,
+	230, 0, 0, 0, 244, 199, 271, 238, 276, 262,
+	284, 0, 239, 135, 263, 167, 210, 151, 152, 163,
+	169, 171, 173, 174, 220, 221, 233, 251, 264, 265,
+	266, 166, 159, 245, 160, 183, 161, 136, 253, 162,
+	137, 234, 269, 0, 180, 241, 206, 138, 205, 235,
+	268, 267, 292, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 177, 0, 280, 0, 226, 0, 0, 0,
+	0, 0, 0, 0, 222, 296, 0, 0, 0, 0,
+	249, 0, 0, 0, 0, 0, 188, 232, 0, 250,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 257, 278, 290, 281, 0, 0, 0, 289,
+	0, 0, 0, 0, 0, 0, 216, 217, 218, 219,
+	0, 0, 157, 0, 0, 0, 0, 0, 0, 0,
+	0, 139, 191, 140, 141, 142, 143, 0, 0, 176,
+	182, 0, 184, 156, 231, 179, 287, 192, 223, 187,
+	254, 193, 200, 242, 286, 229, 247, 155, 277, 255,
+	204, 178, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 134, 0, 197,
+	0, 240, 175, 98, 99, 100, 101, 102, 103, 104,
+	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
+	115, 116, 117, 118, 119, 120, 121, 122, 123, 124,
+	125, 126, 127, 128, 129, 130, 131, 132, 133, 228,
+	0, 293, 294, 295, 279, 0, 0, 0, 0, 0,
+	170, 0, 0, 0, 196, 0, 198, 0, 0, 256,
+	211, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 95,
+	0, 0, 0, 0, 0, 0, 153, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 144, 261, 275, 154, 252, 288, 158, 259,
+	150, 227, 248, 146, 273, 258, 208, 189, 190, 145,
+	0, 243, 168, 181, 165, 225, 0, 0, 164, 291,
+	0, 283, 148, 149, 282, 224, 270, 274, 209, 203,
+	147, 272, 207, 202, 194, 172, 185, 236, 201, 237,
+	186, 214, 213, 215, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 212, 0, 0, 0, 285,
+	0, 0, 0, 0, 0, 0, 260, 0, 0, 195,
+	0, 0, 0, 0, 0, 246, 230, 0, 0, 0,
+	244, 199, 271, 238, 276, 262, 284, 0, 239, 135,
+	263, 167, 210, 151, 152, 163, 169, 171, 173, 174,
+	220, 221, 233, 251, 264, 265, 266, 166, 159, 245,
+	160, 183, 161, 136, 253, 162, 137, 234, 269, 0,
+	180, 241, 206, 138, 205, 235, 268, 267, 292, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 177, 0,
+	280, 0, 226, 0, 0, 0, 0, 0, 0, 0,
+	222, 296, 0, 0, 0, 0, 249, 0, 0, 0,
+	0, 0, 188, 232, 0, 250, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 257, 278,
+	290, 281, 0, 0, 0, 289, 0, 0, 0, 0,
+	0, 0, 216, 217, 218, 219, 0, 0, 157, 0,
+	0, 0, 0, 0, 0, 0, 0, 139, 191, 140,
+	141, 142, 836, 0, 0, 176, 182, 0, 184, 156,
+	231, 179, 287, 192, 223, 187, 254, 193, 200, 242,
+	286, 229, 247, 155, 277, 255, 204, 178, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 134, 0, 197, 0, 240, 175, 98,
+	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
+	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
+	119, 120, 121, 122, 123, 124, 125, 126, 127, 128,
+	129, 130, 131, 132, 133, 228, 0, 293, 294, 295,
+	279, 0, 0, 0, 0, 0, 170, 0, 0, 0,
+	196, 0, 198, 0, 0, 256, 211, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 95, 0, 0, 0, 0,
+	0, 0, 153, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 144, 261,
+	566, 154, 252, 288, 158, 259, 150, 227, 248, 146,
+	273, 258, 208, 189, 190, 145, 0, 243, 168, 181,
+	165, 225, 0, 0, 164, 291, 0, 283, 148, 149,
+	282, 224, 270, 274, 209, 203, 147, 272, 207, 202,
+	194, 172, 185, 236, 201, 237, 186, 214, 213, 215,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 212, 0, 0, 0, 285, 0, 0, 0, 0,
+	0, 0, 260, 0, 0, 195, 0, 0, 0, 0,
+	0, 246, 230, 0, 0, 0, 244, 199, 271, 238,
+	276, 262, 284, 0, 239, 135, 263, 167, 210, 151,
+	152, 163, 169, 171, 173, 174, 220, 221, 233, 251,
+	264, 265, 266, 166, 159, 245, 160, 183, 161, 136,
+	253, 162, 137, 234, 269, 0, 180, 241, 206, 138,
+	205, 235, 268, 267, 292, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 177, 0, 280, 0, 226, 0,
+	0, 0, 0, 0, 0, 0, 222, 296, 0, 0,
+	0, 0, 249, 0, 0, 0, 0, 0, 188, 232,
+	0, 250, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 257, 278, 290, 281, 0, 0,
+	0, 289, 0, 0, 0, 0, 0, 0, 216, 217,
+	218, 219, 0, 0, 157, 0, 0, 0, 0, 0,
+	0, 0, 0, 139, 191, 140, 141, 142, 143, 0,
+	0, 176, 182, 0, 184, 156, 231, 179, 287, 192,
+	223, 187, 254, 193, 200, 242, 286, 229, 247, 155,
+	277, 255, 204, 178, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 134,
+	0, 197, 0, 240, 175, 98, 99, 100, 101, 102,
+	103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
+	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
+	123, 124, 125, 126, 127, 128, 129, 130, 131, 132,
+	133, 228, 0, 293, 294, 295, 279, 0, 0, 0,
+	0, 0, 170, 0, 0, 0, 196, 0, 198, 0,
+	0, 256, 211, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 85, 0, 0, 739, 0, 0, 0, 138,
+	0, 95, 0, 0, 0, 0, 0, 0, 153, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 129, 245, 259, 139, 236,
-	272, 143, 243, 135, 210, 232, 131, 257, 242, 192,
-	174, 175, 130, 0, 227, 153, 166, 150, 208, 0,
-	0, 149, 275, 0, 267, 133, 134, 266, 207, 254,
-	258, 193, 187, 132, 256, 191, 186, 178, 157, 170,
-	220, 185, 221, 171, 197, 196, 198, 0, 0, 0,
+	0, 0, 0, 0, 144, 261, 564, 154, 252, 288,
+	158, 259, 150, 227, 248, 146, 273, 258, 208, 189,
+	190, 145, 0, 243, 168, 181, 165, 225, 0, 0,
+	164, 291, 0, 283, 148, 149, 282, 224, 270, 274,
+	209, 203, 147, 272, 207, 202, 194, 172, 185, 236,
+	201, 237, 186, 214, 213, 215, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 212, 0, 0,
+	0, 285, 0, 0, 0, 0, 0, 0, 260, 0,
+	0, 195, 0, 0, 0, 0, 0, 246, 230, 0,
+	0, 0, 244, 199, 271, 238, 276, 262, 284, 0,
+	239, 135, 263, 167, 210, 151, 152, 163, 169, 171,
+	173, 174, 220, 221, 233, 251, 264, 265, 266, 166,
+	159, 245, 160, 183, 161, 136, 253, 162, 137, 234,
+	269, 0, 180, 241, 206, 138, 205, 235, 268, 267,
+	292, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	177, 0, 280, 0, 226, 0, 0, 0, 0, 0,
+	0, 0, 222, 296, 0, 0, 0, 0, 249, 0,
+	0, 0, 0, 0, 188, 232, 0, 250, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 269, 0, 0, 0, 0, 0, 0, 244, 0,
-	0, 179, 0, 0, 0, 0, 0, 230, 213, 0,
-	0, 218, 228, 183, 255, 222, 260, 246, 268, 0,
-	223, 125, 247, 152, 194, 136, 137, 148, 154, 156,
-	158, 159, 203, 204, 216, 235, 248, 249, 250, 151,
-	144, 229, 145, 168, 146, 126, 237, 147, 127, 217,
-	253, 0, 165, 225, 190, 128, 189, 219, 252, 251,
-	276, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	162, 0, 264, 0, 209, 0, 0, 0, 0, 0,
-	0, 0, 205, 280, 0, 0, 0, 0, 233, 0,
-	0, 0, 0, 0, 173, 215, 0, 234, 0, 0,
+	257, 278, 290, 281, 0, 0, 0, 289, 0, 0,
+	0, 0, 0, 0, 216, 217, 218, 219, 0, 0,
+	157, 0, 0, 0, 0, 0, 0, 0, 0, 139,
+	191, 140, 141, 142, 143, 0, 0, 176, 182, 0,
+	184, 156, 231, 179, 287, 192, 223, 187, 254, 193,
+	200, 242, 286, 229, 247, 155, 277, 255, 204, 178,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	241, 262, 274, 778, 0, 0, 0, 273, 0, 0,
-	0, 0, 0, 0, 199, 200, 201, 202, 0, 0,
-	142, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 161, 167, 0, 169, 141, 214, 164, 271, 176,
-	206, 172, 238, 177, 184, 226, 270, 212, 231, 140,
-	261, 239, 188, 163, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 124,
-	0, 181, 0, 224, 160, 88, 89, 90, 91, 92,
-	93, 94, 95, 96, 97, 98, 99, 100, 101, 102,
-	103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
-	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
-	123, 211, 0, 277, 278, 279, 263, 0, 0, 0,
-	0, 155, 0, 0, 0, 180, 0, 182, 0, 0,
-	240, 195, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	85, 0, 0, 0, 0, 0, 0, 138, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 129, 245, 259, 139, 236, 272, 143,
-	243, 135, 210, 232, 131, 257, 242, 192, 174, 175,
-	130, 0, 227, 153, 166, 150, 208, 0, 0, 149,
-	275, 0, 267, 133, 134, 266, 207, 254, 258, 193,
-	187, 132, 256, 191, 186, 178, 157, 170, 220, 185,
-	221, 171, 197, 196, 198, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 269,
-	0, 0, 0, 0, 0, 0, 244, 0, 0, 179,
-	0, 0, 0, 0, 0, 230, 213, 0, 0, 218,
-	228, 183, 255, 222, 260, 246, 268, 0, 223, 125,
-	247, 152, 194, 136, 137, 148, 154, 156, 158, 159,
-	203, 204, 216, 235, 248, 249, 250, 151, 144, 229,
-	145, 168, 146, 126, 237, 147, 127, 217, 253, 0,
-	165, 225, 190, 128, 189, 219, 252, 251, 276, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 162, 0,
-	264, 0, 209, 0, 0, 0, 0, 0, 0, 0,
-	205, 280, 0, 0, 0, 0, 233, 0, 0, 0,
-	0, 0, 173, 215, 0, 234, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 241, 262,
-	274, 265, 0, 0, 0, 273, 0, 0, 0, 0,
-	0, 0, 199, 200, 201, 202, 0, 0, 142, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 161,
-	167, 0, 169, 141, 214, 164, 271, 176, 206, 172,
-	238, 177, 184, 226, 270, 212, 231, 140, 261, 239,
-	188, 163, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 409, 0, 124, 0, 181,
-	0, 224, 160, 88, 89, 90, 91, 92, 93, 94,
-	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
-	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
-	115, 116, 117, 118, 119, 120, 121, 122, 123, 211,
-	0, 277, 278, 279, 263, 0, 0, 0, 82, 155,
-	0, 0, 0, 180, 0, 182, 0, 0, 240, 195,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 85, 0,
-	0, 0, 0, 0, 0, 138, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 129, 245, 259, 139, 236, 272, 143, 243, 135,
-	210, 232, 131, 257, 242, 192, 174, 175, 130, 0,
-	227, 153, 166, 150, 208, 0, 0, 149, 275, 0,
-	267, 133, 134, 266, 207, 254, 258, 193, 187, 132,
-	256, 191, 186, 178, 157, 170, 220, 185, 221, 171,
-	197, 196, 198, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 269, 0, 0,
-	0, 0, 0, 0, 244, 0, 0, 179, 0, 0,
-	0, 0, 0, 230, 213, 0, 0, 218, 228, 183,
-	255, 222, 260, 246, 268, 0, 223, 125, 247, 152,
-	194, 136, 137, 148, 154, 156, 158, 159, 203, 204,
-	216, 235, 248, 249, 250, 151, 144, 229, 145, 168,
-	146, 126, 237, 147, 127, 217, 253, 0, 165, 225,
-	190, 128, 189, 219, 252, 251, 276, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 162, 0, 264, 0,
-	209, 0, 0, 0, 0, 0, 0, 0, 205, 280,
-	0, 0, 0, 0, 233, 0, 0, 0, 0, 0,
-	173, 215, 0, 234, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 241, 262, 274, 265,
-	0, 0, 0, 273, 0, 0, 0, 0, 0, 0,
-	199, 200, 201, 202, 0, 0, 142, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 161, 167, 0,
-	169, 141, 214, 164, 271, 176, 206, 172, 238, 177,
-	184, 226, 270, 212, 231, 140, 261, 239, 188, 163,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 124, 0, 181, 0, 224,
-	160, 88, 89, 90, 91, 92, 93, 94, 95, 96,
-	97, 98, 99, 100, 101, 102, 103, 104, 105, 106,
+	0, 0, 0, 0, 0, 134, 0, 197, 0, 240,
+	175, 98, 99, 100, 101, 102, 103, 104, 105, 106,
 	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
-	117, 118, 119, 120, 121, 122, 123, 211, 0, 277,
-	278, 279, 263, 0, 0, 0, 0, 155, 0, 0,
-	0, 180, 0, 182, 0, 0, 240, 195, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 85, 0, 0, 0,
-	0, 0, 0, 138, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 129,
-	245, 259, 139, 236, 272, 143, 243, 135, 210, 232,
-	131, 257, 242, 192, 174, 175, 130, 0, 227, 153,
-	166, 150, 208, 0, 0, 149, 275, 0, 267, 133,
-	134, 266, 207, 254, 258, 193, 187, 132, 256, 191,
-	186, 178, 157, 170, 220, 185, 221, 171, 197, 196,
-	198, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 269, 0, 0, 0, 0,
-	0, 0, 244, 0, 0, 179, 0, 0, 0, 0,
-	0, 230, 213, 0, 0, 218, 228, 183, 255, 222,
-	260, 246, 268, 0, 223, 125, 247, 152, 194, 136,
-	137, 148, 154, 156, 158, 159, 203, 204, 216, 235,
-	248, 249, 250, 151, 144, 229, 145, 168, 146, 126,
-	237, 147, 127, 217, 253, 0, 165, 225, 190, 128,
-	189, 219, 252, 251, 276, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 162, 0, 264, 0, 209, 0,
-	0, 0, 0, 0, 0, 0, 205, 280, 0, 0,
-	0, 0, 233, 0, 0, 0, 0, 0, 173, 215,
-	0, 234, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 241, 262, 274, 265, 0, 0,
-	0, 273, 0, 0, 0, 0, 0, 0, 199, 200,
-	201, 202, 0, 0, 142, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 161, 167, 0, 169, 141,
-	214, 164, 271, 176, 206, 172, 238, 177, 184, 226,
-	270, 212, 231, 140, 261, 239, 188, 163, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 124, 0, 181, 0, 224, 160, 88,
-	89, 90, 91, 92, 93, 94, 95, 96, 97, 98,
-	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
-	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
-	119, 120, 121, 122, 123, 0, 211, 277, 278, 279,
-	263, 455, 0, 0, 0, 0, 155, 0, 0, 0,
-	180, 0, 182, 0, 0, 240, 195, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 460, 461, 462, 457, 0,
-	0, 0, 138, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 129, 245,
-	259, 139, 236, 272, 143, 243, 135, 210, 232, 131,
-	257, 242, 192, 174, 175, 130, 0, 227, 153, 166,
-	150, 208, 0, 0, 149, 275, 0, 267, 133, 134,
-	266, 207, 254, 258, 193, 187, 132, 256, 191, 186,
-	178, 157, 170, 220, 185, 221, 171, 197, 196, 198,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 269, 0, 0, 0, 0, 0,
-	0, 244, 0, 0, 179, 0, 0, 0, 0, 0,
-	230, 213, 0, 0, 218, 228, 183, 255, 222, 260,
-	246, 268, 0, 223, 125, 247, 152, 194, 136, 137,
-	148, 154, 156, 158, 159, 203, 204, 216, 235, 248,
-	249, 250, 151, 144, 229, 145, 168, 146, 126, 237,
-	147, 127, 217, 253, 0, 165, 225, 190, 128, 189,
-	219, 252, 251, 276, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 162, 0, 264, 0, 209, 0, 0,
-	0, 0, 0, 0, 0, 205, 280, 0, 0, 0,
-	0, 233, 0, 0, 0, 0, 0, 173, 215, 0,
-	234, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 241, 262, 274, 265, 0, 0, 0,
-	273, 0, 0, 0, 0, 0, 0, 199, 200, 201,
-	202, 0, 0, 142, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 161, 167, 0, 169, 141, 214,
-	164, 271, 176, 206, 172, 238, 177, 184, 226, 270,
-	212, 231, 140, 261, 239, 188, 163, 0, 0, 211,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 155,
-	0, 0, 0, 180, 0, 182, 0, 0, 240, 195,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 124, 0, 181, 0, 224, 160, 460, 461,
-	462, 457, 0, 0, 0, 138, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 277, 278, 279, 263,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 129, 245, 259, 139, 236, 272, 143, 243, 135,
-	210, 232, 131, 257, 242, 192, 174, 175, 130, 0,
-	227, 153, 166, 150, 208, 0, 0, 149, 275, 0,
-	267, 133, 134, 266, 207, 254, 258, 193, 187, 132,
-	256, 191, 186, 178, 157, 170, 220, 185, 221, 171,
-	197, 196, 198, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 269, 0, 0,
-	0, 0, 0, 0, 244, 0, 0, 179, 0, 0,
-	0, 0, 0, 230, 213, 0, 0, 218, 228, 183,
-	255, 222, 260, 246, 268, 0, 223, 125, 247, 152,
-	194, 136, 137, 148, 154, 156, 158, 159, 203, 204,
-	216, 235, 248, 249, 250, 151, 144, 229, 145, 168,
-	146, 126, 237, 147, 127, 217, 253, 0, 165, 225,
-	190, 128, 189, 219, 252, 251, 276, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 162, 0, 264, 0,
-	209, 0, 0, 0, 0, 0, 0, 0, 205, 280,
-	0, 0, 0, 0, 233, 0, 0, 0, 0, 0,
-	173, 215, 0, 234, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 241, 262, 274, 265,
-	0, 0, 0, 273, 0, 0, 0, 0, 0, 0,
-	199, 200, 201, 202, 0, 0, 142, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 161, 167, 0,
-	169, 141, 214, 164, 271, 176, 206, 172, 238, 177,
-	184, 226, 270, 212, 231, 140, 261, 239, 188, 163,
-	0, 0, 211, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 155, 0, 0, 0, 180, 0, 182, 0,
-	0, 240, 195, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 124, 0, 181, 0, 224,
-	160, 460, 461, 462, 0, 0, 0, 0, 138, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 277,
-	278, 279, 263, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 129, 245, 259, 139, 236, 272,
-	143, 243, 135, 210, 232, 131, 257, 242, 192, 174,
-	175, 130, 0, 227, 153, 166, 150, 208, 0, 0,
-	149, 275, 0, 267, 133, 134, 266, 207, 254, 258,
-	193, 187, 132, 256, 191, 186, 178, 157, 170, 220,
-	185, 221, 171, 197, 196, 198, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	269, 0, 0, 0, 0, 0, 0, 244, 0, 0,
-	179, 0, 0, 0, 0, 0, 230, 213, 0, 0,
-	218, 228, 183, 255, 222, 260, 246, 268, 0, 223,
-	125, 247, 152, 194, 136, 137, 148, 154, 156, 158,
-	159, 203, 204, 216, 235, 248, 249, 250, 151, 144,
-	229, 145, 168, 146, 126, 237, 147, 127, 217, 253,
-	0, 165, 225, 190, 128, 189, 219, 252, 251, 276,
-	79, 0, 23, 39, 24, 0, 0, 0, 1686, 162,
-	0, 264, 0, 209, 0, 0, 0, 0, 0, 0,
-	65, 205, 280, 0, 72, 0, 0, 233, 0, 0,
-	0, 0, 1125, 173, 215, 0, 234, 0, 0, 0,
-	0, 0, 0, 40, 0, 0, 0, 0, 75, 241,
-	262, 274, 265, 0, 0, 0, 273, 2086, 0, 0,
-	0, 0, 0, 199, 200, 201, 202, 1668, 0, 142,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	161, 167, 0, 169, 141, 214, 164, 271, 176, 206,
-	172, 238, 177, 184, 226, 270, 212, 231, 140, 261,
-	239, 188, 163, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 68, 69, 0, 70, 71, 0,
-	1686, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 124, 1686,
-	181, 0, 224, 160, 1125, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1125, 0, 0, 0, 0, 0, 0,
-	1755, 57, 67, 76, 0, 38, 0, 0, 0, 1668,
-	0, 0, 277, 278, 279, 263, 0, 0, 1672, 0,
-	0, 66, 64, 63, 0, 0, 0, 0, 1668, 1676,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1665,
-	0, 0, 0, 1667, 1669, 1671, 0, 1673, 1674, 1675,
-	1677, 1678, 1679, 1681, 1682, 1683, 1684, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1687,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 48, 0, 0,
-	0, 0, 0, 49, 0, 0, 0, 0, 0, 1685,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1672, 0, 0, 0, 0, 0, 1664, 0, 0, 0,
-	0, 1676, 0, 0, 0, 0, 0, 0, 0, 1672,
-	50, 1680, 0, 0, 0, 0, 0, 0, 1670, 0,
-	1676, 1665, 0, 0, 0, 1667, 1669, 1671, 0, 1673,
-	1674, 1675, 1677, 1678, 1679, 1681, 1682, 1683, 1684, 0,
-	1665, 0, 0, 0, 1667, 1669, 1671, 0, 1673, 1674,
-	1675, 1677, 1678, 1679, 1681, 1682, 1683, 1684, 0, 0,
-	0, 1687, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1687, 78, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1685, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1664, 0,
-	1685, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1680, 0, 0, 0, 1664, 0, 0,
-	1670, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1680, 0, 0, 0, 0, 0, 0, 1670,
+	117, 118, 119, 120, 121, 122, 123, 124, 125, 126,
+	127, 128, 129, 130, 131, 132, 133, 228, 0, 293,
+	294, 295, 279, 0, 0, 0, 0, 0, 170, 0,
+	0, 0, 196, 0, 198, 0, 0, 256, 211, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 95, 0, 0,
+	0, 0, 0, 0, 153, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	144, 261, 275, 154, 252, 288, 158, 259, 150, 227,
+	248, 146, 273, 258, 208, 189, 190, 145, 0, 243,
+	168, 181, 165, 225, 0, 0, 164, 291, 0, 283,
+	148, 149, 282, 224, 270, 274, 209, 203, 147, 272,
+	207, 202, 194, 172, 185, 236, 201, 237, 186, 214,
+	213, 215, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 212, 0, 0, 0, 285, 0, 0,
+	0, 0, 0, 0, 260, 0, 0, 195, 0, 0,
+	0, 0, 0, 246, 230, 0, 0, 0, 244, 199,
+	271, 238, 276, 262, 284, 0, 239, 135, 263, 167,
+	210, 151, 152, 163, 169, 171, 173, 174, 220, 221,
+	233, 251, 264, 265, 266, 166, 159, 245, 160, 183,
+	161, 136, 253, 162, 137, 234, 269, 0, 180, 241,
+	206, 138, 205, 235, 268, 267, 292, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 177, 0, 280, 0,
+	226, 0, 0, 0, 0, 0, 0, 0, 222, 296,
+	0, 0, 0, 0, 249, 0, 0, 0, 0, 0,
+	188, 232, 0, 250, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 257, 278, 290, 281,
+	0, 0, 0, 289, 0, 0, 0, 0, 0, 0,
+	216, 217, 218, 219, 0, 0, 157, 0, 0, 0,
+	0, 0, 0, 0, 0, 139, 191, 140, 141, 142,
+	560, 0, 0, 176, 182, 0, 184, 156, 231, 179,
+	287, 192, 223, 187, 254, 193, 200, 242, 286, 229,
+	247, 155, 277, 255, 204, 178, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 134, 0, 197, 0, 240, 175, 98, 99, 100,
+	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
+	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
+	121, 122, 123, 124, 125, 126, 127, 128, 129, 130,
+	131, 132, 133, 0, 0, 293, 294, 295, 279, 228,
+	0, 762, 0, 0, 0, 0, 0, 0, 0, 0,
+	170, 0, 0, 0, 196, 0, 198, 0, 0, 256,
+	211, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 485,
+	486, 487, 482, 0, 0, 0, 153, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 144, 261, 275, 154, 252, 288, 158, 259,
+	150, 227, 248, 146, 273, 258, 208, 189, 190, 145,
+	0, 243, 168, 181, 165, 225, 0, 0, 164, 291,
+	0, 283, 148, 149, 282, 224, 270, 274, 209, 203,
+	147, 272, 207, 202, 194, 172, 185, 236, 201, 237,
+	186, 214, 213, 215, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 212, 0, 0, 0, 285,
+	0, 0, 0, 0, 0, 0, 260, 0, 0, 195,
+	0, 0, 0, 0, 0, 246, 230, 0, 0, 0,
+	244, 199, 271, 238, 276, 262, 284, 0, 239, 135,
+	263, 167, 210, 151, 152, 163, 169, 171, 173, 174,
+	220, 221, 233, 251, 264, 265, 266, 166, 159, 245,
+	160, 183, 161, 136, 253, 162, 137, 234, 269, 0,
+	180, 241, 206, 138, 205, 235, 268, 267, 292, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 177, 0,
+	280, 0, 226, 0, 0, 0, 0, 0, 0, 0,
+	222, 296, 0, 0, 0, 0, 249, 0, 0, 0,
+	0, 0, 188, 232, 0, 250, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 257, 278,
+	290, 281, 0, 0, 0, 289, 0, 0, 0, 0,
+	0, 0, 216, 217, 218, 219, 0, 0, 157, 0,
+	0, 0, 0, 0, 0, 0, 0, 139, 191, 140,
+	141, 142, 143, 0, 0, 176, 182, 0, 184, 156,
+	231, 179, 287, 192, 223, 187, 254, 193, 200, 242,
+	286, 229, 247, 155, 277, 255, 204, 178, 0, 228,
+	0, 0, 0, 0, 0, 479, 0, 0, 0, 0,
+	170, 0, 0, 0, 196, 0, 198, 0, 0, 256,
+	211, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 134, 0, 197, 0, 240, 175, 485,
+	486, 487, 482, 0, 0, 0, 153, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 293, 294, 295,
+	279, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 144, 261, 275, 154, 252, 288, 158, 259,
+	150, 227, 248, 146, 273, 258, 208, 189, 190, 145,
+	0, 243, 168, 181, 165, 225, 0, 0, 164, 291,
+	0, 283, 148, 149, 282, 224, 270, 274, 209, 203,
+	147, 272, 207, 202, 194, 172, 185, 236, 201, 237,
+	186, 214, 213, 215, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 212, 0, 0, 0, 285,
+	0, 0, 0, 0, 0, 0, 260, 0, 0, 195,
+	0, 0, 0, 0, 0, 246, 230, 0, 0, 0,
+	244, 199, 271, 238, 276, 262, 284, 0, 239, 135,
+	263, 167, 210, 151, 152, 163, 169, 171, 173, 174,
+	220, 221, 233, 251, 264, 265, 266, 166, 159, 245,
+	160, 183, 161, 136, 253, 162, 137, 234, 269, 0,
+	180, 241, 206, 138, 205, 235, 268, 267, 292, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 177, 0,
+	280, 0, 226, 0, 0, 0, 0, 0, 0, 0,
+	222, 296, 0, 0, 0, 0, 249, 0, 0, 0,
+	0, 0, 188, 232, 0, 250, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 257, 278,
+	290, 281, 0, 0, 0, 289, 0, 0, 0, 0,
+	0, 0, 216, 217, 218, 219, 0, 0, 157, 0,
+	0, 0, 0, 0, 0, 0, 0, 139, 191, 140,
+	141, 142, 143, 0, 0, 176, 182, 0, 184, 156,
+	231, 179, 287, 192, 223, 187, 254, 193, 200, 242,
+	286, 229, 247, 155, 277, 255, 204, 178, 0, 228,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	170, 0, 0, 0, 196, 0, 198, 0, 0, 256,
+	211, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 134, 0, 197, 0, 240, 175, 485,
+	486, 487, 482, 0, 0, 0, 153, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 293, 294, 295,
+	279, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 144, 261, 275, 154, 252, 288, 158, 259,
+	150, 227, 248, 146, 273, 258, 208, 189, 190, 145,
+	0, 243, 168, 181, 165, 225, 0, 0, 164, 291,
+	0, 283, 148, 149, 282, 224, 270, 274, 209, 203,
+	147, 272, 207, 202, 194, 172, 185, 236, 201, 237,
+	186, 214, 213, 215, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 212, 0, 0, 0, 285,
+	0, 0, 0, 0, 0, 0, 260, 0, 0, 195,
+	0, 0, 0, 0, 0, 246, 230, 0, 0, 0,
+	244, 199, 271, 238, 276, 262, 284, 0, 239, 135,
+	263, 167, 210, 151, 152, 163, 169, 171, 173, 174,
+	220, 221, 233, 251, 264, 265, 266, 166, 159, 245,
+	160, 183, 161, 136, 253, 162, 137, 234, 269, 0,
+	180, 241, 206, 138, 205, 235, 268, 267, 292, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 177, 0,
+	280, 0, 226, 0, 0, 0, 0, 0, 0, 0,
+	222, 296, 0, 0, 0, 0, 249, 0, 0, 0,
+	0, 0, 188, 232, 0, 250, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 257, 278,
+	290, 281, 0, 0, 0, 289, 0, 0, 0, 0,
+	0, 0, 216, 217, 218, 219, 0, 0, 157, 0,
+	0, 0, 0, 0, 0, 0, 0, 139, 191, 140,
+	141, 142, 143, 0, 0, 176, 182, 0, 184, 156,
+	231, 179, 287, 192, 223, 187, 254, 193, 200, 242,
+	286, 229, 247, 155, 277, 255, 204, 178, 0, 228,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	170, 0, 0, 0, 196, 0, 198, 0, 0, 256,
+	211, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 134, 0, 197, 0, 240, 175, 485,
+	486, 487, 0, 0, 0, 0, 153, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 293, 294, 295,
+	279, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 144, 261, 275, 154, 252, 288, 158, 259,
+	150, 227, 248, 146, 273, 258, 208, 189, 190, 145,
+	0, 243, 168, 181, 165, 225, 0, 0, 164, 291,
+	0, 283, 148, 149, 282, 224, 270, 274, 209, 203,
+	147, 272, 207, 202, 194, 172, 185, 236, 201, 237,
+	186, 214, 213, 215, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 212, 0, 0, 0, 285,
+	0, 0, 0, 0, 0, 0, 260, 0, 0, 195,
+	0, 0, 0, 0, 0, 246, 230, 0, 0, 0,
+	244, 199, 271, 238, 276, 262, 284, 0, 239, 135,
+	263, 167, 210, 151, 152, 163, 169, 171, 173, 174,
+	220, 221, 233, 251, 264, 265, 266, 166, 159, 245,
+	160, 183, 161, 136, 253, 162, 137, 234, 269, 0,
+	180, 241, 206, 138, 205, 235, 268, 267, 292, 0,
+	0, 0, 0, 89, 0, 25, 43, 27, 177, 1854,
+	280, 0, 226, 0, 0, 0, 0, 0, 0, 0,
+	222, 296, 0, 0, 73, 0, 249, 0, 82, 0,
+	0, 0, 188, 232, 1191, 250, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 44, 257, 278,
+	290, 281, 85, 0, 0, 289, 0, 0, 0, 1972,
+	0, 0, 216, 217, 218, 219, 0, 0, 157, 1836,
+	0, 0, 0, 0, 0, 0, 0, 139, 191, 140,
+	141, 142, 143, 0, 0, 176, 182, 0, 184, 156,
+	231, 179, 287, 192, 223, 187, 254, 193, 200, 242,
+	286, 229, 247, 155, 277, 255, 204, 178, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 76, 77,
+	0, 78, 79, 0, 0, 0, 0, 0, 81, 0,
+	0, 0, 0, 1854, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 134, 0, 197, 0, 240, 175, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1191, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 65, 75, 86, 0, 42,
+	0, 0, 1854, 0, 1917, 0, 0, 293, 294, 295,
+	279, 1840, 0, 1836, 0, 0, 74, 72, 71, 0,
+	0, 0, 1844, 0, 0, 0, 0, 1191, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1833, 0, 0, 0, 1835, 1837, 1839, 0,
+	1841, 1842, 1843, 1845, 1846, 1847, 1849, 1850, 1851, 1852,
+	0, 0, 1836, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1855, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 55, 0, 0, 0, 0, 0, 56, 0,
+	0, 0, 1853, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1832,
+	0, 0, 0, 0, 0, 1840, 0, 0, 0, 0,
+	0, 0, 0, 0, 1848, 57, 1844, 0, 0, 0,
+	0, 1838, 0, 58, 0, 0, 0, 0, 80, 0,
+	0, 0, 0, 0, 0, 0, 1833, 0, 0, 0,
+	1835, 1837, 1839, 0, 1841, 1842, 1843, 1845, 1846, 1847,
+	1849, 1850, 1851, 1852, 1840, 0, 0, 0, 0, 0,
+	0, 0, 26, 0, 0, 1844, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1855, 0, 0, 0,
+	0, 0, 0, 0, 0, 1833, 0, 0, 0, 1835,
+	1837, 1839, 88, 1841, 1842, 1843, 1845, 1846, 1847, 1849,
+	1850, 1851, 1852, 0, 0, 0, 1853, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1832, 0, 1855, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1848, 0,
+	0, 0, 0, 0, 0, 1838, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1853, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1832, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1848, 0, 0,
+	0, 0, 0, 0, 1838,
 }
 
 var yyPact = [...]int{
-	16984, -1000, -297, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	19877, -1000, -291, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 15211, 1612, -1000, 6408, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 191, 12703,
-	15629, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 5972, 5536,
-	110, -1000, 1603, -1000, -1000, -1000, -1000, 120, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 519, -41, 279, 284,
-	300, 300, 7244, 1603, 1270, 181, 4, -1000, 14793, 1541,
-	16984, 143, 15629, -1000, 335, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 16009, 16009, 1786, -1000, 7039,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 264, 13453, 16435, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 6595, 6151, 144, 255, -1000,
+	1770, -1000, -1000, -1000, -1000, 123, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 722, 112, 355, 360, 540, 547,
+	16435, -87, 7891, 1770, 1484, 167, 24, -1000, 15583, 1684,
+	19877, 202, 16435, -1000, 456, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -2795,73 +3108,77 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 12703, 15629, -76, 434, -1000, 160, 155, 150, 332,
-	-1000, -1000, -1000, -1000, 15629, 1460, -1000, -1000, -1000, 1544,
-	16048, 181, -1000, 1199, 1275, -1000, -1000, 1423, -1000, 91,
-	-7, -30, 73, -1000, -1000, 123, -1000, -1000, -1000, -1000,
-	-1000, 39, -1000, -13, -1000, -20, -1000, -1000, -1000, -114,
-	-1000, -1000, -1000, -1000, -1000, 1191, 307, 1465, -160, 1524,
-	1555, 1270, 1581, 1554, -4, 162, 162, 183, 162, -1000,
-	-1000, -1000, -1000, -1000, -1000, 491, 130, -1000, -1000, -133,
-	-129, 360, -129, 0, -1000, -1000, -1000, -1000, -1000, -1000,
-	163, -1000, -175, -1000, 268, -1000, 265, -1000, 8935, 119,
-	1271, 446, -1000, 372, 15629, 15629, 15629, 372, 722, 695,
-	330, -1000, -1000, -1000, 1503, 1505, 1555, 1270, -1000, 1603,
-	1603, 1102, 1074, 163, 163, 163, 163, 163, 1251, 15629,
-	-1000, 1354, 4244, -1000, -1000, -1000, -1000, -1000, 161, 1422,
-	-1000, 15629, 1444, -1000, 329, 770, 909, -1000, -1000, 160,
-	1330, -1000, 305, -1000, -1000, -1000, -1000, 15629, 1421, 15629,
-	12703, 12703, 12703, 12703, -1000, 1486, 1482, -1000, 1479, 1478,
-	1499, 15629, -1000, -1000, -1000, 16391, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 1099, 1603, 100, 5619, 11867, 13539, 15629,
-	11867, -1000, -1000, -1000, -1000, -1000, -116, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 100, 11867, 11867,
-	-87, -1000, -1000, -283, 1524, 4672, -1000, -1000, 4672, -1000,
-	-1000, 180, 162, -1000, 11867, 450, 13539, 877, 15629, 15629,
-	-1000, -1000, 360, 360, -1000, 491, 491, -1000, -1000, -125,
-	1598, 5100, -123, 15629, 162, 14375, 1530, -153, 276, 269,
-	272, -1000, -1000, -163, -1000, -1000, 1170, 9359, 8511, 202,
-	11867, 2960, -1000, -1000, 372, 372, 372, 2960, 294, -1000,
-	-1000, -1000, -1000, -1000, -1000, 15629, -1000, -1000, 1524, -1000,
-	-1000, -1000, 1555, 1524, 1555, -1000, -1000, 11867, 13539, 15629,
-	15629, 16734, 15629, 1251, 1543, 15629, 1141, -1000, -1000, 8093,
-	327, 4672, 1048, 1419, -1000, 1417, 1415, 1414, 1413, 1412,
-	1410, 1409, 1380, 1408, 1407, 1405, -1000, -1000, -1000, 1404,
-	-1000, -1000, 1403, 1380, 1400, 1398, 1397, -1000, -1000, -1000,
-	-1000, 1550, -1000, -1000, -1000, -1000, 2532, 5100, 5100, 5100,
-	5100, -1000, -1000, 1395, 4672, 1394, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 663,
-	-1000, 1383, 1382, 1381, 1380, 1379, 895, 893, 888, 1378,
-	1377, 1373, 5100, 1372, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 202, 13453, 16435,
+	-53, 579, -1000, 168, 173, 178, 425, -1000, -1000, -1000,
+	-1000, 16435, 1734, -1000, -1000, -1000, 1702, 18921, 167, -1000,
+	1361, 1418, -1000, -1000, 1534, -1000, 104, 29, 2, 126,
+	-1000, -1000, 159, -1000, -1000, -1000, -1000, -1000, 65, -1000,
+	23, -1000, 10, -1000, -1000, -1000, -92, -1000, -1000, -1000,
+	-1000, -1000, 1346, 426, 1557, -158, 1080, 1663, 1731, 1484,
+	1765, 1713, 13, 229, 229, 260, 229, -1000, -1000, -1000,
+	-1000, -1000, -1000, 602, 180, -1000, -1000, -102, -97, 536,
+	-97, 16, -1000, -1000, -1000, -1000, -1000, -1000, 16435, 237,
+	-1000, -178, -1000, 343, -1000, 331, -1000, 18139, 253, -1000,
+	16435, -124, 17713, 17287, 9613, 147, 1431, 614, -1000, 571,
+	16435, 571, 856, 801, 424, -1000, -1000, -1000, 1634, 1635,
+	1731, 1484, -1000, 1770, 1770, 1311, 1175, 237, 237, 237,
+	237, 237, 1430, 16435, -1000, 1464, 4835, -1000, -1000, -1000,
+	-1000, -1000, 183, 1533, -1000, 16435, 183, 1697, -1000, 423,
+	912, 1079, -1000, -1000, 168, 1410, -1000, 576, -1000, -1000,
+	-1000, -1000, 16435, 1532, 16435, 13453, 13453, 13453, 13453, -1000,
+	1587, 1586, -1000, 1608, 1605, 1595, 16435, -1000, -1000, 18571,
+	1698, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1303, 1770,
+	138, 1382, 12601, 14305, 16435, 12601, -1000, -1000, -1000, -1000,
+	-1000, -93, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 138, 12601, 12601, -57, -1000, -79, -1000, -279,
+	1663, 5271, -1000, -1000, 5271, -1000, -1000, 257, 229, -1000,
+	12601, 632, 14305, 1030, 16435, 16435, -1000, -1000, 536, 536,
+	-1000, 602, 602, -1000, -1000, -94, 1772, 5707, -112, 16435,
+	229, 190, 15157, 1671, -135, 351, 340, 345, -1000, -1000,
+	16435, 16861, -1000, -128, -125, 571, -126, 571, -1000, -162,
+	-1000, -1000, 1421, 10045, 9181, 236, 12601, 3091, -1000, -1000,
+	571, 3091, 480, -1000, -1000, -1000, -1000, -1000, -1000, 16435,
+	-1000, -1000, 1663, -1000, -1000, -1000, 1731, 1663, 1731, -1000,
+	-1000, 12601, 14305, 16435, 16435, 19621, 16435, 1430, 1689, 16435,
+	1352, -1000, -1000, 8755, 422, 5271, 917, 1530, -1000, 1529,
+	1528, 1527, 1525, 1524, 1521, 1520, 1499, 1518, 1517, 1516,
+	-1000, -1000, -1000, 1515, -1000, -1000, 1512, 1499, 1511, 1510,
+	1509, -1000, -1000, -1000, -1000, -1000, 1514, -1000, -1000, -1000,
+	-1000, 2655, 5707, 5707, 5707, 5707, -1000, -1000, 1508, 5271,
+	1504, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 833, -1000, 1502, 1501, 1500, 1499,
+	1497, 1063, 1061, 1048, 1496, 1494, 1493, 1492, 5707, 1491,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -281, -1000, 7674,
-	15629, 15629, -1000, 1590, 4672, 2108, -1000, 1545, -1000, 160,
-	66, -1000, -1000, -1000, -1000, -1000, -1000, 326, 15629, 1133,
-	-1000, 426, 1448, 1464, 1448, -1000, -1000, -1000, -1000, 1480,
-	-1000, 1454, -1000, -1000, 1354, -1000, -1000, 401, -1000, -1000,
-	-1000, -1000, -1000, -13, -20, 1121, -1000, -43, 89, -1000,
-	-1000, 1328, -1000, -1000, -1000, 401, 1121, 174, 887, 885,
-	-1000, 832, 320, 1235, -1000, 779, 13957, 15629, 210, 1529,
-	1170, 1426, 1512, 1598, 1598, 1598, 360, 16734, 491, 15629,
-	491, -1000, -1000, 491, -1000, 316, 15629, 210, 1371, -1000,
-	-1000, -1000, 274, 264, 262, 13539, 170, -1000, -1000, 1170,
-	-1000, -1000, -1000, 1370, 423, -1000, -1000, 5100, -1000, 604,
-	-1000, 2960, 2960, 2960, -1000, 10613, -1000, -1000, 1524, -1000,
-	1524, 1121, 1170, 1461, 1229, -1000, -1000, -1000, -1000, -1000,
-	1369, 1323, -1000, 1598, 4244, -1000, 12703, -1000, 4672, 4672,
-	4672, -1000, 15629, 13121, -1000, 479, 5100, -1000, -1000, -1000,
-	-1000, -1000, -1000, 4672, 1549, 1549, 1549, 4672, 443, 4672,
-	4672, -1000, 710, 2245, 1549, 1549, 1549, 1549, -1000, 1549,
-	1549, 1549, 5100, 5100, 5100, 5100, 5100, 5100, 5100, 5100,
-	5100, 5100, 5100, 5100, 1362, 651, 5100, 5100, 5100, 1074,
-	1254, 1206, -1000, -1000, -1000, -1000, -1000, 448, 604, 4672,
-	-1000, 2245, 4672, 4672, -1000, 1079, -1000, -1000, 4672, -1000,
-	-1000, -1000, 4672, 5100, 4672, -1000, 1549, 1109, -1000, 1367,
-	-1000, 1321, 1496, -1000, 314, 1176, -1000, 422, 1319, -1000,
-	1555, 604, -1000, 312, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 1556, -277, -1000, 8329, 16435, 16435, -1000, -1000,
+	1774, 5271, 2230, -1000, 1719, -1000, 168, 93, -1000, -1000,
+	-1000, -1000, -1000, -1000, 417, 16435, 1443, -1000, 577, 1538,
+	1553, 1538, -1000, -1000, -1000, -1000, 1569, -1000, 1568, -1000,
+	-1000, 1464, 308, 1688, 1717, -1000, 566, -1000, -1000, -1000,
+	-1000, -1000, 23, 10, 1413, -1000, -19, 101, -1000, -1000,
+	1401, -1000, -1000, -1000, 566, 1413, 251, 1040, 1785, 1039,
+	-1000, 939, 412, 1429, -1000, 989, 14731, 16435, 240, 1667,
+	1421, 1541, 1638, 1772, 1772, 1772, 536, 19621, 602, 16435,
+	602, -1000, -1000, 602, -1000, 402, 16435, 1428, -1000, -1000,
+	223, 200, 201, 207, 194, -1000, 240, 1489, -1000, -1000,
+	-1000, 348, 330, 328, -1000, -1000, 16435, -149, -131, 3091,
+	-136, 3091, 14305, 250, -1000, -1000, 1421, -1000, 16435, 16435,
+	-1000, -1000, 1487, 575, -1000, -1000, 5707, -1000, 766, -1000,
+	3091, -1000, 11323, -1000, 1642, 1663, -1000, 1663, 1413, 1421,
+	1551, 1426, -1000, -1000, -1000, -1000, -1000, 1486, 1396, -1000,
+	1772, 4835, -1000, 13453, -1000, 5271, 5271, 5271, -1000, 16435,
+	13879, -1000, 651, 5707, -1000, -1000, -1000, -1000, -1000, -1000,
+	5271, 1708, 1708, 1708, 5271, 622, 5271, 5271, -1000, 839,
+	6149, 1708, 1708, 1708, 1708, -1000, 1708, 1708, 1708, 5707,
+	5707, 5707, 5707, 5707, 5707, 5707, 5707, 5707, 5707, 5707,
+	5707, 1479, 599, 5707, 5707, 5707, 1175, 1365, 1425, -1000,
+	-1000, -1000, -1000, -1000, 580, 766, 5271, -1000, 6149, 5271,
+	5271, -1000, 1297, -1000, -1000, 5271, -1000, -1000, -1000, 5271,
+	5707, 16435, 5271, -1000, 1708, -1000, 1649, 1407, -1000, 1485,
+	-1000, 1394, 1625, -1000, 397, 1424, -1000, 574, 1391, -1000,
+	1731, 766, -1000, 389, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -2874,150 +3191,160 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -79, -1000, -1000, 15629, 1307, 1590, 15629,
-	4672, -1000, -1000, 4672, 1366, -1000, 4672, -1000, -1000, -1000,
-	-1000, 1606, 302, 298, 11867, -1000, 156, 11867, -1000, -1000,
-	15629, 169, 11867, -9, -136, 4672, 4672, 15629, 4672, -1000,
-	-1000, -1000, 1354, 447, 1365, -221, -1000, -60, -1000, 1455,
-	28, -1000, 1512, -1000, 508, -1000, -1000, -1000, -1000, 1598,
-	-1000, 360, -1000, 360, 491, 15629, -1000, -1000, -221, 1073,
-	-1000, -1000, -1000, 263, 1170, 11867, 847, 202, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 15629, 15629, 16984, -1000, 15629,
-	1596, -1000, 1164, 1399, -1000, 523, 457, -1000, 297, -1000,
-	-1000, 544, -1000, 1057, 915, 604, 4672, -1000, -1000, 4672,
-	4672, 841, 4672, 1055, 1300, 1290, -1000, 1053, -1000, 1601,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 4672,
-	4672, 4672, 4672, 4672, 4672, 4672, 634, 894, -1000, 852,
-	852, 313, 313, 313, 313, 313, 910, 910, -1000, -1000,
-	-1000, 2532, 1362, 5100, 5100, 5100, 149, 1810, 1016, -1000,
-	4672, 512, -1000, 4672, 719, -1000, 1051, 761, 1049, -1000,
-	937, 1046, 1761, 1041, 4672, -281, 3816, 187, 15629, -281,
-	15629, 15629, 3816, -1000, 15629, -1000, 2108, 766, -1000, -1000,
-	1555, -1000, 604, 604, 15629, 604, 11867, 349, 369, -1000,
-	10195, 11867, -1000, -1000, 11867, 116, 1519, -1000, -1000, -101,
-	-92, 604, 604, 292, -1000, 1542, 1526, 6826, -1000, -77,
-	-1000, -1000, -1000, 190, -1000, 884, 872, 870, 865, 15629,
-	-1000, -1000, -1000, -1000, -1000, 393, 393, 393, 1503, -1000,
-	1598, 1598, 360, -1000, -11, -51, -1000, 1121, 1038, -1000,
-	-1000, -1000, -1000, 1036, -1000, 1589, 1580, 12703, 12285, -1000,
-	-1000, 4672, 1249, 1221, 1203, 810, 1287, -1000, -1000, -1000,
-	-1000, 4672, 1188, 1173, 1159, 1155, 1148, 1142, 1112, 1285,
-	-1000, 149, 1810, 559, -1000, 5100, 5100, 1107, 439, -1000,
-	4672, 558, 810, 640, -1000, 4672, -1000, -1000, 640, -1000,
-	5100, -1000, 1103, -1000, 1027, 1138, -1000, -281, -1000, -1000,
-	1109, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1281, 1121, -1000, -1000, -1000, -1000, 11867, 1536, 210,
-	-1000, -10, 185, -285, -97, 1578, 1572, 15629, 181, 15629,
-	1022, 1118, -1000, -1000, -1000, 912, 242, -1000, 15629, 529,
-	290, 162, 290, 528, 1360, -1000, -1000, -77, -1000, 765,
-	763, 742, 737, -50, -1000, -1000, -1000, -1000, -1000, 1359,
-	640, -1000, 648, 857, -1000, -1000, 1598, -1000, -11, -1000,
-	255, 252, 20, 1571, -1000, -1000, -1000, 4672, 4672, 1399,
-	-1000, -1000, 604, -1000, -1000, -1000, 1009, -1000, 1340, 1353,
-	-1000, 1340, 1340, 1340, 247, 247, 1355, 1355, 1356, 1355,
-	-1000, 1091, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 5100, -1000, -1000, -1000, -1000, 604, 4672, 1007, 999,
-	713, 994, 1782, -1000, -1000, 3816, 1109, -1000, -1000, 11867,
-	11867, -224, -14, 15629, -287, 853, -1000, 1570, 849, 564,
-	-1000, 1354, 17124, 6826, 914, -34, -1000, -1000, -1000, 1340,
-	-1000, 1353, 1340, 1340, 1340, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 1352, 1351, -1000, 1340, 1350, 1340,
-	1340, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 15629, 15629,
-	-1000, 15629, 15629, 162, 4672, -1000, -1000, -1000, -1000, -1000,
-	-1000, 11449, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 736, -1000, -1000, -1000, 847, 604, 915, -1000,
-	-1000, -1000, 731, -1000, 730, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 718, -1000, -1000, 708, -1000, -1000, -1000,
-	604, -1000, -1000, -1000, 4672, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -123, -290, 702, -1000, 833, -91, -1000, -1000,
-	1533, 142, 17105, -1000, 393, 393, 299, 393, 393, 393,
-	393, 107, 106, 393, 393, 393, 393, 393, 393, 393,
-	393, 393, 393, 393, 393, 393, 393, 1349, -1000, -1000,
-	914, -1000, -1000, 538, 5100, -1000, -1000, 831, 648, 306,
-	336, 1347, -1000, 81, 505, 469, -1000, 15629, -1000, -39,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 828, 828, -1000,
-	-1000, 700, -1000, -1000, 1344, 1401, 49, 1343, -1000, 1342,
-	1341, 15629, 1087, 1267, -1000, 1340, 4672, 12, -1000, -1000,
-	986, 971, 1247, 1241, 1054, -103, -102, -1000, 1337, -1000,
-	-1000, 1567, 181, -1000, 1566, 17124, -1000, 698, 697, 393,
-	393, 696, 826, 813, 811, 393, 393, 694, 809, 16391,
-	665, 664, 660, 656, 808, 395, 622, 614, 611, 15629,
-	1336, 783, -1000, -1000, 1810, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 657, 1335, -1000, -1000,
-	1334, -1000, -1000, 1238, -1000, 1233, 968, 11449, 37, 37,
-	11449, 11449, 11449, 1333, 245, -1000, 11449, 1523, 860, -1000,
-	-1000, -1000, -1000, 646, -1000, 638, -1000, 165, -99, -102,
-	-1000, 1565, -93, 1564, 1563, 15629, 564, -1000, 60, -1000,
-	-1000, -1000, 640, 640, -1000, -1000, -1000, -1000, 807, 788,
+	-1000, -1000, -1000, -1000, -1000, -54, -1000, -1000, 16435, 1385,
+	1774, 16435, 5271, -1000, -1000, 5271, 1483, -1000, 5271, -1000,
+	-1000, -1000, -1000, 5707, 1711, 305, 1784, 382, 381, 12601,
+	-1000, 175, 12601, -1000, -1000, 16435, 249, 12601, 7, 1038,
+	-110, 5271, 5271, 16435, 5271, -1000, -1000, -1000, 1464, 630,
+	1482, -211, -1000, -32, -1000, 1550, 94, -1000, 1638, -1000,
+	414, -1000, -1000, -1000, -1000, 1772, -1000, 536, -1000, 536,
+	602, 16435, -1000, -1000, 196, 16435, 1480, -1000, 16435, 16435,
+	16435, 16435, 16435, -211, 1292, -1000, -1000, -1000, 329, -1000,
+	-1000, -143, -145, -1000, -149, -1000, -149, -1000, 1421, 12601,
+	999, 236, -1000, -1000, -1000, -1000, 1648, 527, 1642, -1000,
+	16435, 16435, 19877, -1000, 16435, 1737, -1000, 1420, 1600, -1000,
+	600, 603, -1000, 378, -1000, -1000, 685, -1000, 1290, 1354,
+	766, 5271, -1000, -1000, 5271, 5271, 1002, 5271, 1276, 1381,
+	1363, -1000, 1248, -1000, 1783, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 124, 15629, 1224, -1000, 396, 953, 4672, -216,
-	11449, -1000, 787, -1000, -1000, 1208, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 1193, 1185, 1168, 11449, -1000, -1000, -1000,
-	74, 96, -1000, -1000, 1523, 938, 926, 1326, 636, -97,
-	1562, -1000, 564, 1561, 564, 564, 1157, -1000, -1000, 63,
-	159, 157, -1000, 225, -1000, -1000, -1000, -1000, -1000, -1000,
-	121, 1123, -1000, 783, 776, -1000, 569, 1446, -1000, -21,
-	1106, -1000, -1000, -1000, -1000, -1000, 1095, -1000, -1000, 393,
-	724, 36, -1000, -1000, -1000, -1000, -1000, 1502, 9777, -121,
-	-1000, 574, -1000, 564, -1000, -1000, -1000, 15629, 53, 615,
-	5100, 1318, 5100, 1315, 67, 1305, -1000, -1000, -1000, -1000,
-	-1000, 245, -1000, -1000, 1442, 1432, 1611, -1000, -1000, -1000,
-	-1000, 96, 96, 96, 96, -16, 609, -1000, 877, -1000,
-	15629, -1000, 1093, -1000, -1000, -1000, 289, -1000, -1000, -1000,
-	-1000, 1299, 1560, -1000, 1588, 15629, 929, 15629, 1297, 392,
-	5100, -1000, -1000, 1613, -1000, 1604, 385, 385, -1000, -1000,
-	-1000, 771, -1000, 371, -1000, 11031, 15629, -1000, 141, 69,
-	-1000, 1044, -1000, 1035, 15629, 592, 712, -1000, -1000, -1000,
-	582, 85, -1000, 15629, 3388, -1000, 288, 1026, -1000, 721,
-	40, -1000, -1000, 956, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 604, 15629, -1000, 141, 1495, -1000, 577, -1000, -1000,
-	-1000, 16993, 137, -1000, -1000, 16993, 52, -1000, 135, -1000,
-	-1000, 932, -1000, 703, 1255, -1000, 52, 17124, 4672, -1000,
-	17124, 917, -1000,
+	-1000, -1000, -1000, -1000, 5271, 5271, 5271, 5271, 5271, 5271,
+	5271, 760, 696, -1000, 703, 703, 486, 486, 486, 486,
+	486, 874, 874, -1000, -1000, -1000, 2655, 1479, 5707, 5707,
+	5707, 185, 1939, 1925, -1000, 5271, 604, -1000, 5271, 890,
+	-1000, 1241, 740, 1239, -1000, 1097, 1234, 1769, 1220, 1215,
+	5271, 666, -277, 4399, 184, 16435, -277, 16435, 16435, 4399,
+	-1000, 16435, -1000, 2230, 910, -1000, -1000, 1731, -1000, 766,
+	766, 16435, 766, 1939, 303, 5707, 12601, 492, 550, -1000,
+	10897, 12601, -1000, -1000, 12601, 114, 1655, -1000, -1000, -1000,
+	-69, -62, 766, 766, 367, -1000, 1686, 1652, 7465, -1000,
+	-45, -1000, -1000, -1000, 208, -1000, 1037, 1035, 1034, 1032,
+	16435, -1000, -1000, -1000, -1000, -1000, 569, 569, 569, 1634,
+	-1000, 1772, 1772, 536, -1000, -1000, 194, 194, -1000, 970,
+	189, -1000, 1415, 1415, 243, -1000, 17, -23, -1000, -1000,
+	-1000, -1000, -1000, -1000, 1413, 1205, -1000, -1000, -82, -82,
+	1613, -1000, -1000, -1000, 1201, -1000, 1776, 1757, 13453, 13027,
+	-1000, -1000, 5271, 1355, 1347, 1344, 568, 1330, -1000, -1000,
+	-1000, -1000, 5271, 1326, 1314, 1285, 1157, 1141, 1124, 1116,
+	1322, -1000, 185, 1939, 681, -1000, 5707, 5707, 1113, 586,
+	-1000, 5271, 608, 568, 706, -1000, 5271, -1000, -1000, 706,
+	-1000, 5707, -1000, -1000, 1104, 1791, -1000, 1186, 1414, -1000,
+	-277, -1000, -1000, 1407, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 1318, 5707, 1939, 1413, -1000, -1000,
+	-1000, -1000, 12601, 1678, 240, -1000, 18, 262, -281, -59,
+	1756, 1752, 16435, 167, 16435, 1178, 1412, -1000, -1000, -1000,
+	509, -1000, 16435, 659, 377, 229, 377, 657, 1477, -1000,
+	-1000, -45, -1000, 908, 902, 901, 892, -22, -1000, -1000,
+	-1000, -1000, -1000, 1476, 706, -1000, 775, 1031, -1000, -1000,
+	1772, 1773, -6, -1000, -1000, -1000, 1452, -1000, 1466, 1452,
+	1452, 1452, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 1473, 1472, -1000, 1452, 1471, 1452, 1452, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1467, 1467, 1470, 1467, 1289, -1000, 1005,
+	16435, -1000, 17, -1000, 301, 287, 54, 1751, -1000, -1000,
+	-1000, -85, -1000, 1597, -1000, -1000, 5271, 5271, 1600, -1000,
+	-1000, 766, -1000, -1000, -1000, 1174, -1000, 1452, 1466, -1000,
+	1452, 1452, 1452, 320, 320, -1000, 1098, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 5707, -1000, -1000, -1000,
+	-1000, 766, 5271, 1172, 1162, 761, 1158, 1810, -1000, 16435,
+	-1000, 4399, 1407, -1000, 1939, -1000, 12601, 12601, -212, 21,
+	16435, -285, 1025, -1000, 1747, 1018, 927, -1000, 1464, 20057,
+	7465, -1000, -1000, 16435, 16435, -1000, 16435, 16435, 229, 5271,
+	-1000, -1000, -1000, -1000, -1000, -1000, 12175, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 1773, -1000, -1000, 679, 5707,
+	-1000, -1000, 1017, 775, 400, 361, 1004, 1463, -1000, 103,
+	643, 640, -1000, 16435, -1000, -14, -1000, -1000, -1000, -1000,
+	889, -1000, 886, -1000, -1000, -1000, 1000, 1000, -1000, -1000,
+	882, -1000, -1000, -1000, 879, -1000, -1000, 872, -1000, -1000,
+	189, 1624, -1000, -1000, -1000, -1000, 857, -1000, -1000, -1000,
+	999, -1000, -1000, 766, 1354, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 766, -1000, -1000,
+	-1000, 5271, -1000, -1000, 1340, -1000, -1000, -1000, -1000, -1000,
+	-112, -287, 854, -1000, 986, -63, -1000, -1000, 1679, 191,
+	20008, -1000, 569, 569, 376, 569, 569, 569, 569, 140,
+	139, 569, 569, 569, 569, 569, 569, 569, 569, 569,
+	569, 569, 569, 569, 569, 1462, -1000, 1460, 1539, 74,
+	1459, -1000, 1457, 1454, 16435, 1057, 1287, -1000, 1452, 5271,
+	-1000, -1000, 1939, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 853, 1450, -1000, -1000, 1449,
+	-1000, -1000, 1125, 1118, 1247, -1000, 1244, 1114, 1237, 1232,
+	-1000, 19884, 278, 50, -1000, -1000, 956, -70, -71, -1000,
+	1448, -1000, -1000, 1746, 167, -1000, 1744, 20057, -1000, 845,
+	842, 569, 569, 835, 985, 981, 972, 569, 569, 831,
+	967, 19271, 827, 812, 808, 822, 966, 535, 821, 730,
+	729, 16435, 1447, 935, 12175, 63, 63, 12175, 12175, 12175,
+	1446, 263, -1000, 12175, 1661, 943, 1112, 5271, -206, 12175,
+	-1000, -1000, -1000, 964, -1000, -1000, -1000, 791, -1000, 788,
+	-1000, 19884, 80, -1000, 170, 1445, -1000, -1000, 193, -67,
+	-71, -1000, 1742, -68, 1741, 1739, 16435, 927, -1000, 102,
+	-1000, -1000, -1000, 706, 706, -1000, -1000, -1000, -1000, 938,
+	936, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 150, 16435, 1230, -1000, 570, 1227, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 1223, 1219, 1213, 12175,
+	-1000, -1000, -1000, 100, 106, -1000, -1000, 1661, -1000, 880,
+	1549, -1000, -4, 1211, -1000, 1110, 1107, -1000, -1000, 1204,
+	-1000, 973, 386, 5271, 1441, 773, -59, 1736, -1000, 927,
+	1735, 927, 927, 1183, -1000, -1000, 82, 192, 179, -1000,
+	245, -1000, -1000, -1000, -1000, -1000, -1000, 165, 1177, -1000,
+	935, 933, -1000, -1000, -1000, -1000, 1171, -1000, -1000, 569,
+	932, 71, -1000, -1000, -1000, 263, -1000, -1000, 1548, 1547,
+	1782, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 80, 20057,
+	3963, -1000, 1168, 1629, 10471, -72, -1000, 930, -1000, 927,
+	-1000, -1000, -1000, 16435, 77, 715, 5707, 1440, 5707, 1439,
+	91, 1438, -1000, -1000, -1000, -1000, -1000, 106, 106, 106,
+	106, 14, 711, -1000, 1030, -1000, -1000, 1790, -1000, 1788,
+	341, 341, -1000, 20057, 1161, 1094, -1000, -1000, 16435, -1000,
+	1151, -1000, -1000, -1000, 366, -1000, -1000, -1000, -1000, 1437,
+	1733, -1000, 1207, 16435, 1144, 16435, 1436, 562, 5707, -1000,
+	-1000, -1000, -1000, -1000, -1000, 818, 117, -1000, -1000, -1000,
+	1333, -1000, 556, -1000, 11749, 16435, -1000, 189, 87, -1000,
+	1143, -1000, 1130, 16435, 697, 946, -1000, -1000, -1000, 16435,
+	3527, -1000, 364, 1100, 66, -1000, -1000, 1092, -1000, -1000,
+	-1000, -1000, 766, 16435, -1000, -1000, 686, -1000, -1000, -1000,
 }
 
 var yyPgo = [...]int{
-	0, 97, 1983, 1981, 104, 102, 1980, 1979, 1978, 1977,
-	1976, 1975, 1974, 1973, 1970, 1969, 1967, 1962, 1961, 1960,
-	1958, 1956, 1955, 1954, 1953, 1952, 1951, 1950, 1949, 1948,
-	1945, 1944, 1943, 100, 1942, 1941, 1940, 1939, 1934, 1932,
-	132, 1931, 1930, 1929, 1928, 1927, 1924, 1923, 1909, 1908,
-	122, 43, 110, 613, 36, 200, 1907, 121, 1906, 81,
-	144, 1905, 1903, 28, 109, 1902, 118, 116, 87, 137,
-	95, 85, 62, 1901, 1900, 1899, 127, 1898, 1896, 1895,
-	1882, 57, 1880, 72, 51, 30, 1879, 77, 1878, 1875,
-	1860, 1858, 1857, 74, 1856, 59, 64, 1855, 1854, 1853,
-	1852, 1851, 33, 1850, 54, 1848, 1847, 1845, 1843, 1839,
-	1835, 1834, 14, 17, 19, 1833, 1829, 16, 2, 1828,
-	1827, 80, 1824, 1823, 1822, 174, 1821, 1820, 1819, 143,
-	1818, 119, 1815, 1814, 1810, 1807, 13, 1806, 42, 1804,
-	1803, 1802, 47, 1801, 1800, 90, 35, 65, 88, 1799,
-	1798, 1797, 133, 21, 108, 0, 138, 39, 1795, 135,
-	129, 1794, 82, 182, 130, 52, 1793, 46, 67, 1792,
-	1790, 1788, 61, 11, 1787, 94, 1786, 93, 78, 1785,
-	96, 1784, 120, 1, 91, 1783, 131, 1782, 1779, 113,
-	1778, 1777, 56, 107, 1776, 1775, 1774, 29, 1773, 32,
-	20, 1772, 151, 141, 1771, 1769, 1768, 114, 86, 76,
-	1767, 1766, 68, 1765, 106, 69, 123, 1764, 600, 1763,
-	99, 66, 18, 1762, 139, 1760, 234, 148, 125, 1757,
-	1756, 142, 1518, 140, 1754, 126, 9, 1729, 1728, 10,
-	1727, 25, 1726, 1725, 1724, 1723, 6, 1722, 1721, 1719,
-	3, 5, 1718, 4, 92, 1717, 45, 55, 53, 1716,
-	60, 1715, 1713, 1712, 1711, 1710, 159, 1709, 1707, 1705,
-	1704, 1703, 1702, 1701, 84, 1687, 1686, 1685, 1684, 58,
-	1683, 1682, 1681, 1680, 1679, 31, 1677, 1676, 22, 1675,
-	26, 1674, 1673, 1672, 12, 1671, 1670, 15, 1668, 1667,
-	7, 8, 1666, 1664, 48, 38, 34, 71, 70, 1663,
-	23, 1646, 89, 1645, 1643, 115, 1642, 98, 1637, 1636,
-	146, 162, 1635, 136, 1634, 1632, 1630, 1629, 1627, 1626,
-	1625, 128, 1624,
-}
-
-//line mysql_sql.y:6299
+	0, 105, 2162, 2161, 114, 2159, 110, 2158, 2157, 2156,
+	2153, 2152, 2151, 2150, 2149, 2148, 2147, 2146, 2145, 2142,
+	2140, 2139, 2138, 2137, 2135, 2131, 2130, 2129, 2126, 2123,
+	2122, 2121, 2120, 2119, 2118, 107, 2117, 2111, 2110, 2109,
+	2108, 2107, 2106, 2105, 175, 2104, 2103, 2102, 2099, 2097,
+	2096, 2095, 2094, 2093, 2092, 2091, 136, 63, 106, 693,
+	42, 169, 2089, 135, 2088, 91, 187, 2085, 1689, 24,
+	120, 2083, 124, 122, 94, 148, 100, 92, 65, 311,
+	121, 2082, 143, 2081, 2079, 2077, 2076, 55, 2075, 79,
+	32, 132, 2074, 2073, 28, 123, 84, 2071, 2070, 2069,
+	2068, 2067, 90, 2065, 67, 52, 2064, 2063, 2062, 2061,
+	2060, 29, 2059, 49, 2058, 2056, 2055, 2054, 2039, 2026,
+	2022, 12, 18, 15, 2021, 2020, 8, 9, 2019, 2018,
+	75, 2017, 2016, 2013, 168, 2011, 2010, 2009, 155, 2008,
+	126, 2007, 2004, 2003, 2001, 10, 1999, 47, 1998, 1997,
+	1995, 35, 1994, 1993, 101, 36, 54, 97, 1984, 1980,
+	1975, 152, 20, 129, 0, 142, 38, 1973, 141, 140,
+	1972, 99, 216, 138, 41, 1971, 51, 71, 1969, 1966,
+	1964, 70, 4, 1963, 87, 1962, 16, 85, 1961, 104,
+	1959, 134, 34, 96, 1958, 144, 1956, 1955, 118, 1953,
+	1951, 50, 119, 1947, 1946, 1944, 40, 1943, 37, 19,
+	1941, 146, 153, 1940, 1939, 1938, 130, 98, 80, 1937,
+	1936, 73, 1935, 116, 76, 117, 66, 1934, 745, 113,
+	112, 86, 1933, 109, 60, 21, 1932, 149, 1930, 156,
+	157, 137, 1929, 1928, 158, 1431, 150, 1926, 151, 3,
+	1925, 1924, 6, 1910, 22, 1895, 1894, 1885, 1884, 48,
+	1883, 5, 1869, 13, 17, 1868, 33, 102, 1866, 62,
+	69, 74, 1864, 68, 1863, 1862, 1861, 1860, 1859, 188,
+	1858, 1857, 1856, 1854, 1853, 1852, 164, 82, 1849, 1848,
+	1847, 1846, 61, 1845, 1842, 1841, 1839, 1837, 31, 1836,
+	1835, 14, 1834, 23, 1833, 1831, 1830, 7, 1824, 1823,
+	11, 1822, 1821, 1, 2, 1820, 1819, 53, 46, 39,
+	78, 77, 1818, 25, 1817, 95, 1816, 1814, 131, 1813,
+	103, 1812, 1811, 147, 172, 1810, 145, 1809, 1808, 1807,
+	1806, 1805, 1804, 88, 1802, 139, 1801,
+}
+
+//line mysql_sql.y:6580
 type yySymType struct {
 	union interface{}
 	id    int
@@ -3031,6 +3358,16 @@ func (st *yySymType) aliasedTableExprUnion() *tree.AliasedTableExpr {
 	return v
 }
 
+func (st *yySymType) alterTableOptionUnion() tree.AlterTableOption {
+	v, _ := st.union.(tree.AlterTableOption)
+	return v
+}
+
+func (st *yySymType) alterTableOptionsUnion() []tree.AlterTableOption {
+	v, _ := st.union.([]tree.AlterTableOption)
+	return v
+}
+
 func (st *yySymType) assignmentUnion() *tree.Assignment {
 	v, _ := st.union.(*tree.Assignment)
 	return v
@@ -3226,6 +3563,11 @@ func (st *yySymType) int64ValUnion() int64 {
 	return v
 }
 
+func (st *yySymType) isolationLevelUnion() tree.IsolationLevel {
+	v, _ := st.union.(tree.IsolationLevel)
+	return v
+}
+
 func (st *yySymType) joinCondUnion() tree.JoinCond {
 	v, _ := st.union.(tree.JoinCond)
 	return v
@@ -3406,6 +3748,16 @@ func (st *yySymType) selectExprsUnion() tree.SelectExprs {
 	return v
 }
 
+func (st *yySymType) selectLockUnion() *tree.SelectLock {
+	v, _ := st.union.(*tree.SelectLock)
+	return v
+}
+
+func (st *yySymType) selectLockWaitUnion() tree.SelectLockWait {
+	v, _ := st.union.(tree.SelectLockWait)
+	return v
+}
+
 func (st *yySymType) selectStatementUnion() tree.SelectStatement {
 	v, _ := st.union.(tree.SelectStatement)
 	return v
@@ -3612,244 +3964,258 @@ func (st *yySymType) zeroFillOptUnion() bool {
 }
 
 var yyR1 = [...]int{
-	0, 329, 2, 2, 1, 1, 1, 1, 1, 1,
+	0, 342, 2, 2, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 48, 303, 303, 302, 302, 301, 301, 300, 300,
-	300, 299, 299, 299, 298, 298, 297, 297, 295, 295,
-	296, 294, 293, 293, 291, 291, 289, 289, 290, 290,
-	284, 284, 287, 287, 285, 285, 285, 285, 288, 283,
-	283, 283, 282, 282, 47, 47, 47, 221, 221, 46,
-	46, 235, 235, 235, 235, 235, 233, 233, 233, 233,
-	232, 232, 231, 231, 236, 236, 234, 234, 234, 234,
-	234, 234, 234, 234, 234, 234, 234, 234, 234, 234,
-	234, 234, 234, 234, 234, 234, 234, 234, 234, 234,
-	234, 234, 234, 234, 234, 234, 234, 234, 234, 41,
-	41, 41, 41, 44, 45, 229, 229, 229, 229, 229,
-	230, 230, 230, 42, 43, 43, 220, 220, 225, 225,
-	224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
-	224, 219, 219, 228, 228, 228, 227, 227, 226, 226,
-	35, 35, 35, 38, 37, 218, 218, 218, 218, 218,
-	218, 218, 218, 36, 36, 36, 36, 36, 36, 34,
-	34, 33, 217, 217, 216, 40, 40, 40, 40, 39,
-	39, 39, 39, 39, 39, 39, 39, 39, 158, 158,
-	158, 322, 322, 323, 324, 325, 325, 325, 49, 7,
-	32, 32, 266, 266, 169, 169, 170, 170, 168, 168,
-	168, 168, 168, 168, 269, 270, 165, 21, 21, 21,
-	21, 21, 21, 21, 21, 21, 21, 31, 330, 330,
-	330, 29, 30, 265, 265, 265, 28, 27, 26, 25,
-	25, 24, 23, 23, 162, 162, 164, 164, 160, 331,
-	331, 241, 241, 163, 163, 22, 22, 161, 161, 143,
-	159, 159, 159, 6, 8, 8, 8, 8, 8, 13,
-	12, 11, 10, 9, 5, 4, 273, 273, 273, 273,
-	273, 273, 311, 311, 311, 312, 75, 75, 70, 70,
-	274, 274, 184, 313, 313, 281, 281, 280, 280, 279,
-	279, 73, 73, 74, 74, 62, 62, 50, 50, 286,
-	286, 286, 286, 292, 292, 263, 263, 109, 109, 139,
-	139, 140, 140, 51, 51, 52, 52, 52, 52, 52,
-	52, 319, 319, 321, 321, 320, 72, 72, 68, 68,
-	69, 69, 69, 67, 67, 66, 65, 65, 64, 63,
-	63, 63, 54, 54, 53, 53, 53, 53, 53, 125,
-	125, 125, 55, 267, 267, 267, 272, 272, 122, 122,
-	123, 123, 121, 121, 56, 56, 57, 57, 57, 57,
-	120, 120, 119, 58, 58, 59, 59, 61, 61, 61,
-	61, 130, 130, 129, 129, 129, 129, 78, 78, 128,
-	127, 127, 127, 77, 77, 76, 76, 71, 71, 60,
-	60, 126, 332, 332, 124, 151, 151, 151, 157, 157,
-	150, 150, 150, 156, 156, 152, 152, 153, 153, 153,
-	3, 3, 3, 16, 16, 16, 16, 20, 20, 328,
-	328, 14, 214, 214, 213, 213, 215, 215, 215, 215,
-	209, 209, 210, 210, 210, 210, 211, 211, 211, 212,
-	212, 212, 212, 208, 208, 207, 205, 205, 205, 206,
-	206, 206, 206, 206, 206, 154, 154, 15, 202, 202,
-	203, 203, 203, 204, 204, 196, 196, 196, 196, 19,
-	200, 200, 201, 201, 201, 201, 201, 197, 197, 199,
-	199, 195, 195, 195, 195, 195, 18, 194, 194, 192,
-	192, 190, 190, 191, 191, 189, 189, 189, 193, 193,
-	17, 268, 268, 237, 237, 240, 240, 247, 247, 248,
-	248, 246, 246, 253, 253, 252, 252, 251, 251, 250,
-	250, 249, 249, 244, 244, 243, 243, 238, 238, 238,
-	238, 238, 239, 239, 242, 242, 245, 245, 100, 100,
-	101, 101, 101, 118, 118, 118, 118, 118, 118, 118,
-	118, 118, 118, 118, 118, 118, 118, 118, 118, 118,
-	118, 118, 118, 118, 118, 118, 118, 118, 118, 118,
-	118, 118, 309, 309, 310, 103, 103, 103, 107, 107,
-	107, 107, 107, 107, 102, 102, 102, 104, 104, 104,
-	85, 85, 84, 84, 79, 79, 80, 80, 81, 81,
-	82, 82, 83, 83, 83, 83, 83, 83, 223, 223,
-	307, 307, 308, 308, 304, 304, 304, 306, 306, 306,
-	306, 306, 305, 305, 86, 137, 137, 137, 155, 155,
-	155, 136, 136, 136, 99, 99, 98, 98, 96, 96,
-	96, 96, 96, 96, 96, 96, 96, 96, 96, 96,
-	96, 222, 222, 166, 166, 167, 167, 117, 115, 115,
-	116, 116, 116, 116, 113, 114, 112, 112, 112, 112,
-	112, 111, 111, 110, 110, 110, 198, 198, 108, 108,
-	106, 106, 106, 105, 105, 105, 254, 173, 173, 173,
-	173, 173, 173, 173, 173, 173, 173, 173, 173, 173,
-	175, 175, 175, 175, 175, 175, 175, 175, 175, 175,
-	175, 175, 175, 175, 175, 175, 175, 175, 175, 175,
-	176, 176, 181, 181, 318, 318, 317, 87, 87, 87,
-	87, 87, 87, 87, 87, 87, 95, 95, 95, 135,
-	135, 135, 135, 135, 135, 135, 135, 135, 135, 135,
-	135, 135, 135, 135, 278, 278, 278, 132, 132, 132,
-	132, 132, 314, 314, 315, 315, 315, 315, 315, 315,
-	315, 315, 315, 315, 315, 315, 316, 316, 316, 316,
-	316, 316, 316, 316, 316, 316, 316, 316, 316, 316,
-	316, 316, 316, 134, 134, 133, 133, 133, 133, 133,
-	133, 133, 133, 133, 133, 133, 133, 185, 185, 186,
-	186, 275, 275, 275, 275, 275, 275, 276, 276, 277,
-	277, 277, 277, 271, 271, 271, 271, 271, 271, 271,
-	271, 271, 271, 271, 271, 271, 271, 271, 271, 271,
-	271, 271, 271, 271, 271, 271, 271, 271, 271, 271,
-	271, 174, 174, 131, 131, 131, 187, 182, 182, 183,
-	183, 177, 177, 177, 177, 177, 179, 179, 179, 179,
-	172, 172, 172, 172, 172, 172, 172, 172, 172, 178,
-	178, 180, 180, 188, 188, 188, 188, 188, 188, 97,
-	97, 97, 97, 255, 171, 171, 171, 171, 171, 171,
-	171, 88, 88, 88, 88, 92, 92, 94, 94, 94,
-	94, 94, 94, 94, 94, 94, 94, 94, 94, 94,
-	94, 93, 93, 93, 93, 91, 91, 91, 91, 91,
-	89, 89, 89, 89, 89, 89, 89, 89, 89, 89,
-	89, 89, 89, 89, 89, 90, 138, 138, 256, 256,
-	259, 259, 257, 257, 258, 260, 260, 260, 261, 261,
-	261, 262, 262, 262, 264, 264, 142, 142, 142, 147,
-	147, 141, 141, 148, 148, 149, 149, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 326, 326, 326, 327, 327,
+	1, 1, 1, 54, 54, 53, 316, 316, 315, 315,
+	314, 314, 313, 313, 313, 312, 312, 312, 311, 311,
+	310, 310, 308, 308, 309, 307, 306, 306, 304, 304,
+	302, 302, 303, 303, 297, 297, 300, 300, 298, 298,
+	298, 298, 301, 296, 296, 296, 295, 295, 52, 52,
+	52, 234, 234, 51, 51, 248, 248, 248, 248, 248,
+	246, 246, 246, 246, 245, 245, 244, 244, 249, 249,
+	247, 247, 247, 247, 247, 247, 247, 247, 247, 247,
+	247, 247, 247, 247, 247, 247, 247, 247, 247, 247,
+	247, 247, 247, 247, 247, 247, 247, 247, 247, 247,
+	247, 247, 247, 45, 45, 45, 45, 45, 50, 50,
+	50, 229, 229, 229, 229, 48, 49, 242, 242, 242,
+	242, 242, 243, 243, 243, 46, 47, 47, 233, 233,
+	238, 238, 237, 237, 237, 237, 237, 237, 237, 237,
+	237, 237, 237, 232, 232, 241, 241, 241, 240, 240,
+	239, 239, 37, 37, 37, 37, 37, 40, 40, 40,
+	40, 40, 41, 42, 39, 228, 228, 228, 228, 228,
+	228, 228, 228, 38, 38, 38, 38, 38, 38, 36,
+	36, 35, 226, 226, 225, 44, 44, 44, 44, 43,
+	43, 43, 43, 43, 43, 43, 43, 43, 167, 167,
+	167, 335, 335, 336, 337, 338, 338, 338, 55, 8,
+	8, 34, 34, 93, 93, 91, 91, 91, 91, 91,
+	92, 92, 92, 343, 343, 33, 33, 279, 279, 178,
+	178, 179, 179, 177, 177, 177, 177, 177, 177, 282,
+	283, 174, 22, 22, 22, 22, 22, 22, 22, 22,
+	22, 22, 32, 344, 344, 344, 30, 31, 278, 278,
+	278, 29, 28, 27, 26, 26, 25, 24, 24, 171,
+	171, 173, 173, 169, 345, 345, 254, 254, 172, 172,
+	23, 23, 170, 170, 152, 168, 168, 168, 7, 9,
+	9, 9, 9, 9, 14, 13, 12, 11, 10, 6,
+	4, 5, 227, 227, 286, 286, 286, 286, 286, 286,
+	324, 324, 324, 325, 81, 81, 76, 76, 287, 287,
+	193, 326, 326, 294, 294, 293, 293, 292, 292, 79,
+	79, 80, 80, 68, 68, 56, 56, 299, 299, 299,
+	299, 305, 305, 276, 276, 118, 118, 148, 148, 149,
+	149, 57, 57, 230, 230, 230, 230, 231, 231, 231,
+	58, 58, 58, 58, 58, 58, 332, 332, 334, 334,
+	333, 78, 78, 74, 74, 75, 75, 75, 73, 73,
+	72, 71, 71, 70, 69, 69, 69, 60, 60, 59,
+	59, 59, 59, 59, 134, 134, 134, 61, 280, 280,
+	280, 285, 285, 131, 131, 132, 132, 130, 130, 62,
+	62, 63, 63, 63, 63, 129, 129, 128, 64, 64,
+	65, 65, 67, 67, 67, 67, 139, 139, 138, 138,
+	138, 138, 84, 84, 137, 136, 136, 136, 83, 83,
+	82, 82, 77, 77, 66, 66, 135, 346, 346, 133,
+	133, 133, 133, 160, 160, 160, 166, 166, 159, 159,
+	159, 165, 165, 161, 161, 162, 162, 162, 3, 3,
+	3, 17, 17, 17, 17, 21, 21, 341, 341, 15,
+	223, 223, 222, 222, 224, 224, 224, 224, 218, 218,
+	219, 219, 219, 219, 220, 220, 220, 221, 221, 221,
+	221, 217, 217, 216, 214, 214, 214, 215, 215, 215,
+	215, 215, 215, 163, 163, 16, 211, 211, 212, 212,
+	212, 213, 213, 205, 205, 205, 205, 20, 209, 209,
+	210, 210, 210, 210, 210, 206, 206, 208, 208, 204,
+	204, 204, 204, 204, 19, 203, 203, 201, 201, 199,
+	199, 200, 200, 198, 198, 198, 202, 202, 18, 281,
+	281, 250, 250, 253, 253, 260, 260, 261, 261, 259,
+	259, 266, 266, 265, 265, 264, 264, 263, 263, 262,
+	262, 262, 262, 262, 257, 257, 256, 256, 251, 251,
+	251, 251, 251, 252, 252, 255, 255, 258, 258, 109,
+	109, 110, 110, 110, 127, 127, 127, 127, 127, 127,
+	127, 127, 127, 127, 127, 127, 127, 127, 127, 127,
+	127, 127, 127, 127, 127, 127, 127, 127, 127, 127,
+	127, 127, 127, 322, 322, 323, 112, 112, 112, 116,
+	116, 116, 116, 116, 116, 111, 111, 111, 113, 113,
+	113, 94, 94, 90, 90, 85, 85, 86, 86, 87,
+	87, 88, 88, 89, 89, 89, 89, 89, 89, 236,
+	236, 320, 320, 321, 321, 317, 317, 317, 319, 319,
+	319, 319, 319, 318, 318, 95, 146, 146, 146, 164,
+	164, 164, 145, 145, 145, 108, 108, 107, 107, 105,
+	105, 105, 105, 105, 105, 105, 105, 105, 105, 105,
+	105, 105, 105, 235, 235, 175, 175, 176, 176, 126,
+	124, 124, 125, 125, 125, 125, 122, 123, 121, 121,
+	121, 121, 121, 120, 120, 119, 119, 119, 207, 207,
+	117, 117, 115, 115, 115, 114, 114, 114, 267, 182,
+	182, 182, 182, 182, 182, 182, 182, 182, 182, 182,
+	182, 182, 184, 184, 184, 184, 184, 184, 184, 184,
+	184, 184, 184, 184, 184, 184, 184, 184, 184, 184,
+	184, 184, 185, 185, 190, 190, 331, 331, 330, 96,
+	96, 96, 96, 96, 96, 96, 96, 96, 104, 104,
+	104, 144, 144, 144, 144, 144, 144, 144, 144, 144,
+	144, 144, 144, 144, 144, 144, 291, 291, 291, 141,
+	141, 141, 141, 141, 327, 327, 328, 328, 328, 328,
+	328, 328, 328, 328, 328, 328, 328, 328, 329, 329,
+	329, 329, 329, 329, 329, 329, 329, 329, 329, 329,
+	329, 329, 329, 329, 329, 143, 143, 142, 142, 142,
+	142, 142, 142, 142, 142, 142, 142, 142, 142, 142,
+	194, 194, 195, 195, 288, 288, 288, 288, 288, 288,
+	289, 289, 290, 290, 290, 290, 284, 284, 284, 284,
+	284, 284, 284, 284, 284, 284, 284, 284, 284, 284,
+	284, 284, 284, 284, 284, 284, 284, 284, 284, 284,
+	284, 284, 284, 284, 284, 183, 183, 140, 140, 140,
+	196, 191, 191, 192, 192, 186, 186, 186, 186, 186,
+	188, 188, 188, 188, 181, 181, 181, 181, 181, 181,
+	181, 181, 181, 187, 187, 189, 189, 197, 197, 197,
+	197, 197, 197, 106, 106, 106, 106, 268, 180, 180,
+	180, 180, 180, 180, 180, 97, 97, 97, 97, 101,
+	101, 103, 103, 103, 103, 103, 103, 103, 103, 103,
+	103, 103, 103, 103, 103, 102, 102, 102, 102, 100,
+	100, 100, 100, 100, 98, 98, 98, 98, 98, 98,
+	98, 98, 98, 98, 98, 98, 98, 98, 98, 99,
+	147, 147, 269, 269, 272, 272, 270, 270, 271, 273,
+	273, 273, 274, 274, 274, 275, 275, 275, 277, 277,
+	151, 151, 151, 156, 156, 150, 150, 157, 157, 158,
+	158, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 155, 155, 155,
+	155, 155, 155, 155, 155, 155, 155, 155, 155, 155,
+	155, 155, 155, 155, 155, 155, 155, 155, 155, 155,
+	155, 155, 155, 155, 155, 155, 155, 155, 155, 155,
+	155, 155, 155, 155, 155, 155, 155, 155, 155, 155,
+	155, 155, 155, 155, 155, 155, 155, 155, 155, 155,
+	155, 155, 155, 155, 155, 155, 155, 155, 155, 155,
+	155, 155, 155, 155, 155, 155, 155, 155, 155, 155,
+	155, 155, 155, 155, 155, 155, 155, 155, 155, 155,
+	155, 155, 155, 155, 155, 155, 155, 155, 155, 155,
+	155, 155, 155, 155, 155, 155, 155, 155, 155, 155,
+	155, 155, 155, 155, 155, 155, 155, 155, 155, 155,
+	155, 155, 155, 155, 155, 155, 155, 155, 155, 155,
+	155, 155, 155, 155, 155, 155, 155, 155, 155, 155,
+	155, 155, 155, 155, 155, 155, 155, 155, 155, 155,
+	155, 155, 155, 155, 155, 155, 155, 155, 155, 155,
+	155, 155, 155, 155, 155, 155, 155, 155, 155, 155,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 339, 339, 339, 340,
+	340,
 }
 
 var yyR2 = [...]int{
 	0, 1, 1, 3, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	0, 14, 0, 2, 1, 3, 3, 3, 1, 3,
-	5, 0, 2, 3, 1, 3, 1, 1, 1, 1,
-	1, 1, 0, 3, 0, 3, 0, 3, 0, 3,
-	0, 2, 1, 2, 3, 4, 3, 3, 1, 0,
-	1, 1, 0, 1, 9, 4, 7, 0, 3, 7,
-	4, 1, 3, 3, 3, 1, 0, 1, 1, 1,
-	1, 3, 1, 4, 1, 3, 1, 2, 1, 1,
-	2, 2, 1, 1, 1, 1, 1, 1, 1, 1,
-	2, 1, 2, 1, 2, 2, 1, 1, 1, 3,
-	2, 2, 2, 2, 2, 2, 2, 1, 1, 1,
-	1, 1, 1, 3, 6, 3, 1, 1, 1, 1,
-	1, 1, 1, 2, 4, 6, 1, 4, 1, 3,
-	3, 4, 4, 4, 3, 2, 4, 4, 2, 2,
-	2, 1, 1, 1, 1, 1, 1, 1, 1, 3,
-	1, 1, 1, 2, 2, 0, 4, 2, 4, 1,
+	1, 1, 0, 3, 6, 14, 0, 2, 1, 3,
+	3, 3, 1, 3, 5, 0, 2, 3, 1, 3,
+	1, 1, 1, 1, 1, 1, 0, 3, 0, 3,
+	0, 3, 0, 3, 0, 2, 1, 2, 3, 4,
+	3, 3, 1, 0, 1, 1, 0, 1, 9, 4,
+	7, 0, 3, 7, 4, 1, 3, 3, 3, 1,
+	0, 1, 1, 1, 1, 3, 1, 4, 1, 3,
+	1, 2, 1, 1, 2, 2, 1, 1, 1, 1,
+	1, 1, 1, 1, 2, 1, 2, 1, 2, 2,
+	1, 1, 1, 3, 2, 2, 2, 2, 2, 2,
+	2, 1, 1, 1, 1, 1, 1, 1, 5, 6,
+	6, 2, 2, 2, 1, 3, 6, 3, 1, 1,
+	1, 1, 1, 1, 1, 2, 4, 6, 1, 4,
+	1, 3, 3, 4, 4, 4, 3, 2, 4, 4,
+	2, 2, 2, 1, 1, 1, 1, 1, 1, 1,
+	1, 3, 1, 1, 1, 1, 1, 2, 3, 4,
+	4, 5, 2, 3, 2, 0, 4, 2, 4, 1,
 	5, 3, 2, 1, 2, 2, 4, 4, 5, 2,
 	1, 7, 1, 3, 3, 1, 1, 1, 1, 2,
 	3, 4, 7, 2, 3, 3, 4, 5, 1, 1,
 	1, 1, 3, 2, 1, 1, 1, 1, 6, 1,
-	7, 9, 0, 2, 0, 1, 1, 2, 2, 2,
-	1, 4, 2, 2, 3, 0, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 5, 1, 1,
-	1, 5, 5, 0, 1, 1, 2, 2, 3, 6,
-	7, 4, 7, 8, 0, 2, 0, 2, 2, 1,
-	1, 1, 1, 0, 1, 4, 5, 1, 3, 1,
-	1, 3, 5, 1, 1, 1, 1, 1, 1, 4,
-	4, 6, 4, 4, 6, 4, 2, 1, 5, 4,
-	4, 2, 0, 1, 3, 3, 1, 3, 1, 3,
-	1, 3, 4, 0, 1, 0, 1, 1, 3, 1,
-	1, 0, 4, 1, 3, 2, 1, 0, 8, 0,
-	4, 7, 4, 0, 2, 0, 2, 0, 2, 0,
-	4, 1, 3, 1, 1, 4, 3, 4, 5, 4,
-	5, 2, 3, 1, 3, 6, 0, 3, 0, 1,
-	2, 4, 4, 0, 1, 3, 1, 3, 2, 0,
-	1, 1, 3, 3, 1, 3, 3, 3, 3, 1,
-	2, 2, 7, 0, 1, 1, 1, 1, 0, 2,
-	0, 3, 0, 2, 1, 3, 1, 2, 3, 5,
-	0, 1, 2, 1, 3, 1, 1, 4, 4, 4,
-	3, 2, 2, 2, 3, 2, 3, 0, 2, 1,
-	1, 2, 2, 0, 1, 2, 4, 1, 3, 1,
-	4, 3, 0, 1, 2, 0, 1, 2, 1, 1,
-	0, 1, 2, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 8, 11, 0,
-	1, 6, 0, 2, 1, 2, 2, 2, 2, 2,
-	0, 1, 2, 2, 2, 2, 1, 3, 2, 2,
-	2, 2, 2, 1, 3, 2, 1, 3, 2, 0,
-	3, 3, 5, 5, 4, 1, 1, 4, 1, 3,
-	1, 3, 2, 1, 1, 0, 1, 1, 1, 11,
-	0, 2, 3, 2, 3, 1, 1, 1, 3, 3,
-	4, 0, 2, 2, 2, 2, 5, 1, 1, 0,
-	3, 0, 1, 1, 2, 4, 4, 4, 0, 1,
-	10, 0, 1, 0, 6, 0, 4, 0, 3, 1,
-	3, 4, 5, 0, 3, 1, 3, 2, 3, 1,
-	2, 0, 6, 0, 2, 0, 2, 4, 5, 4,
-	5, 1, 6, 5, 0, 3, 0, 1, 0, 1,
-	1, 3, 2, 3, 3, 4, 4, 3, 3, 3,
-	3, 4, 4, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 4,
-	5, 4, 1, 3, 3, 0, 2, 2, 1, 1,
+	1, 4, 4, 1, 3, 3, 3, 5, 3, 1,
+	5, 3, 3, 0, 1, 7, 9, 0, 2, 0,
+	1, 1, 2, 2, 2, 1, 4, 2, 2, 3,
+	0, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 5, 1, 1, 1, 5, 5, 0, 1,
+	1, 2, 2, 3, 6, 7, 4, 7, 8, 0,
+	2, 0, 2, 2, 1, 1, 1, 1, 0, 1,
+	4, 5, 1, 3, 1, 1, 3, 5, 1, 1,
+	1, 1, 1, 1, 4, 4, 6, 4, 4, 6,
+	5, 4, 0, 5, 2, 1, 5, 4, 4, 2,
+	0, 1, 3, 3, 1, 3, 1, 3, 1, 3,
+	4, 0, 1, 0, 1, 1, 3, 1, 1, 0,
+	4, 1, 3, 2, 1, 0, 8, 0, 4, 7,
+	4, 0, 2, 0, 2, 0, 2, 0, 4, 1,
+	3, 1, 1, 0, 3, 3, 4, 0, 1, 2,
+	5, 3, 4, 6, 4, 5, 2, 3, 1, 3,
+	6, 0, 3, 0, 1, 2, 4, 4, 0, 1,
+	3, 1, 3, 2, 0, 1, 1, 3, 3, 1,
+	3, 3, 3, 3, 1, 2, 2, 7, 0, 1,
+	1, 1, 1, 0, 2, 0, 3, 0, 2, 1,
+	3, 1, 2, 3, 5, 0, 1, 2, 1, 3,
+	1, 1, 4, 4, 4, 3, 2, 2, 2, 3,
+	2, 3, 0, 2, 1, 1, 2, 2, 0, 1,
+	2, 4, 1, 3, 1, 4, 3, 0, 1, 2,
+	5, 6, 7, 0, 1, 2, 1, 1, 0, 1,
+	2, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 8, 11, 0, 1, 6,
+	0, 2, 1, 2, 2, 2, 2, 2, 0, 1,
+	2, 2, 2, 2, 1, 3, 2, 2, 2, 2,
+	2, 1, 3, 2, 1, 3, 2, 0, 3, 3,
+	5, 5, 4, 1, 1, 4, 1, 3, 1, 3,
+	2, 1, 1, 0, 1, 1, 1, 11, 0, 2,
+	3, 2, 3, 1, 1, 1, 3, 3, 4, 0,
+	2, 2, 2, 2, 5, 1, 1, 0, 3, 0,
+	1, 1, 2, 4, 4, 4, 0, 1, 10, 0,
+	1, 0, 6, 0, 4, 0, 3, 1, 3, 4,
+	5, 0, 3, 1, 3, 2, 3, 1, 2, 0,
+	6, 4, 6, 5, 0, 2, 0, 2, 4, 5,
+	4, 5, 1, 6, 5, 0, 3, 0, 1, 0,
+	1, 1, 3, 2, 3, 3, 4, 4, 3, 3,
+	3, 3, 4, 4, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	4, 5, 4, 1, 3, 3, 0, 2, 2, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 3, 1, 3, 0, 1, 1, 3, 1, 1,
-	2, 1, 7, 7, 7, 7, 8, 5, 0, 1,
-	0, 1, 1, 1, 1, 3, 3, 1, 1, 1,
-	1, 1, 0, 1, 3, 1, 3, 5, 1, 1,
-	1, 1, 3, 5, 0, 1, 1, 2, 1, 2,
-	2, 1, 1, 2, 2, 2, 2, 2, 1, 5,
-	6, 1, 2, 0, 1, 1, 2, 5, 0, 1,
-	1, 1, 2, 2, 3, 3, 1, 1, 2, 2,
-	2, 0, 1, 2, 2, 2, 0, 3, 0, 3,
-	1, 1, 1, 1, 1, 1, 1, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 1,
-	1, 1, 1, 3, 5, 2, 2, 2, 2, 1,
-	1, 2, 5, 6, 6, 6, 1, 1, 1, 1,
-	0, 2, 0, 1, 1, 2, 4, 1, 2, 2,
-	1, 2, 2, 2, 2, 2, 0, 1, 1, 5,
-	4, 4, 5, 5, 5, 5, 4, 5, 5, 5,
-	5, 5, 5, 5, 1, 1, 1, 4, 4, 6,
-	8, 6, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 3, 1, 3, 0, 1, 1, 3, 1,
+	1, 2, 1, 7, 7, 7, 7, 8, 5, 0,
+	1, 0, 1, 1, 1, 1, 3, 3, 1, 1,
+	1, 1, 1, 0, 1, 3, 1, 3, 5, 1,
+	1, 1, 1, 3, 5, 0, 1, 1, 2, 1,
+	2, 2, 1, 1, 2, 2, 2, 2, 2, 2,
+	1, 5, 6, 1, 2, 0, 1, 1, 2, 5,
+	0, 1, 1, 1, 2, 2, 3, 3, 1, 1,
+	2, 2, 2, 0, 1, 2, 2, 2, 0, 3,
+	0, 3, 1, 1, 1, 1, 1, 1, 1, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 1, 1, 1, 1, 3, 5, 2, 2, 2,
+	2, 1, 1, 2, 5, 6, 6, 6, 1, 1,
+	1, 1, 0, 2, 0, 1, 1, 2, 4, 1,
+	2, 2, 1, 2, 2, 2, 2, 2, 0, 1,
+	1, 5, 4, 4, 5, 5, 5, 5, 4, 5,
+	5, 5, 5, 5, 5, 5, 1, 1, 1, 4,
+	4, 6, 8, 6, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 2, 2, 4, 2, 2, 4, 6,
-	2, 2, 2, 4, 6, 4, 2, 0, 1, 2,
-	3, 1, 1, 1, 1, 1, 1, 0, 2, 1,
+	1, 1, 1, 1, 1, 2, 2, 4, 2, 2,
+	4, 6, 2, 2, 2, 4, 6, 4, 4, 2,
+	0, 1, 2, 3, 1, 1, 1, 1, 1, 1,
+	0, 2, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 2, 3, 0, 1, 1,
+	3, 0, 1, 1, 3, 3, 3, 3, 2, 1,
+	3, 4, 3, 1, 3, 4, 4, 5, 3, 4,
+	5, 6, 1, 0, 2, 1, 1, 1, 1, 1,
+	1, 1, 1, 2, 2, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 3, 1, 1, 1, 2,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 2, 3, 0, 1, 1, 3, 0, 1, 1,
-	3, 3, 3, 3, 2, 1, 3, 4, 3, 1,
-	3, 4, 4, 5, 3, 4, 5, 6, 1, 0,
-	2, 1, 1, 1, 1, 1, 1, 1, 1, 2,
-	2, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 3, 1, 1, 1, 2, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 2, 2, 2, 2, 1,
+	2, 2, 2, 2, 2, 2, 2, 2, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 4, 4, 1,
+	1, 3, 0, 1, 0, 3, 0, 3, 3, 0,
+	3, 5, 0, 3, 5, 0, 1, 1, 0, 1,
+	1, 2, 2, 0, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 2, 2, 2, 2, 1, 2, 2, 2, 2,
-	2, 2, 2, 2, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 4, 4, 1, 1, 3, 0, 1,
-	0, 3, 0, 3, 3, 0, 3, 5, 0, 3,
-	5, 0, 1, 1, 0, 1, 1, 2, 2, 0,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -3884,451 +4250,477 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1,
 }
 
 var yyChk = [...]int{
-	-1000, -329, -2, -1, -3, -4, -5, -6, -39, -21,
-	-7, -49, -33, -34, -35, -41, -46, -47, -48, -51,
-	-16, -15, -14, 8, 10, -8, -158, -22, -23, -24,
-	-25, -26, -27, -28, -29, -30, -31, -32, 181, 9,
-	49, -36, -37, -38, -42, -43, -44, -45, 283, 289,
-	326, -52, -54, -17, -18, -19, -20, 177, -9, -10,
-	-11, -12, -13, 199, 198, 26, 197, 178, 120, 121,
-	123, 124, 30, -53, -319, 54, 179, -55, 397, 6,
-	442, -62, 27, -84, -155, 57, -144, -146, 400, 401,
-	402, 403, 404, 405, 406, 407, 408, 409, 410, 411,
-	412, 413, 414, 415, 416, 417, 418, 419, 420, 421,
-	422, 423, 424, 425, 426, 427, 428, 429, 430, 431,
-	432, 433, 434, 435, 394, 216, 240, 243, 250, 120,
-	137, 131, 158, 150, 151, 128, 220, 221, 64, 123,
-	354, 340, 325, 126, 235, 237, 239, 242, 222, 146,
-	142, 234, 218, 140, 223, 28, 224, 163, 225, 226,
-	399, 336, 265, 358, 342, 247, 141, 337, 238, 339,
-	164, 168, 346, 289, 135, 136, 344, 348, 162, 196,
-	32, 396, 34, 208, 349, 166, 161, 157, 357, 251,
-	249, 160, 134, 156, 219, 38, 170, 169, 171, 319,
-	320, 321, 322, 227, 228, 277, 345, 153, 143, 269,
-	129, 18, 352, 203, 341, 290, 229, 244, 206, 252,
-	165, 167, 210, 215, 398, 248, 350, 139, 207, 236,
-	202, 353, 130, 283, 292, 230, 124, 241, 347, 356,
-	37, 305, 133, 127, 193, 121, 212, 217, 231, 232,
-	233, 254, 253, 245, 154, 209, 159, 132, 155, 122,
-	211, 355, 306, 441, 267, 308, 152, 149, 213, 186,
-	351, 343, 125, 312, 307, 147, 255, 438, 439, 440,
-	278, 11, -159, 19, 323, -40, 324, 181, 54, -155,
-	-5, -4, -33, -51, 184, -59, -60, -61, -124, -126,
-	-84, 54, -155, -232, -202, -231, -203, -234, -204, -154,
-	20, 178, 177, 211, 10, 179, 287, 185, 8, 6,
-	288, 197, 9, 289, 291, 292, 295, 296, 297, 31,
-	300, 301, 57, 60, -155, -232, -202, 215, 222, -66,
-	-67, -125, 15, 5, -268, 308, 214, -196, -194, 298,
-	194, 193, 76, 360, 183, -330, -265, 343, 342, -163,
-	341, 334, 336, 177, 185, 344, 32, 346, 347, 337,
-	308, 125, 122, -218, 80, 130, 129, -218, 214, 29,
-	-225, 318, -224, -226, 346, 347, 357, -219, 345, -142,
-	-155, 58, 59, 75, 151, 148, -67, -125, -66, -53,
-	-54, -52, -54, 308, 214, 185, 184, 360, -321, 392,
-	-320, -155, -267, 20, -272, 21, 22, -1, -73, 206,
-	-84, 119, -59, -136, -155, 325, 89, -40, -40, 324,
-	-322, -323, -324, -326, 181, 324, 323, 119, -84, 30,
-	-127, -128, -129, -130, 41, 45, 47, 42, 43, 44,
-	48, -332, 23, -151, -157, 23, -152, 60, -153, -146,
-	57, 58, 59, -52, -54, 51, 55, 11, 55, 54,
-	443, 58, 285, 299, 308, 286, 298, 186, 214, 299,
-	214, 334, 186, 290, 293, 294, 335, 51, 187, 51,
-	-282, 357, -50, 27, -69, 17, -55, -54, 16, 20,
-	21, -328, 184, 392, -192, 189, -192, 185, -192, -331,
-	11, 99, 213, 212, 338, 335, -241, 339, 340, -163,
-	-162, 97, -163, 184, 360, -266, 189, 350, 397, 128,
-	129, 130, -229, 20, 29, 317, -202, 214, 55, 89,
-	19, -227, 89, 100, -226, -226, -226, -227, -102, 29,
-	-153, 60, 116, -102, 29, 119, 30, 30, -68, -69,
-	-55, -54, -67, -66, -67, 56, 56, -266, -266, -266,
-	-266, -266, 55, -321, -72, 54, -56, -57, 107, -177,
-	-155, 81, -179, 57, -172, 401, 402, 403, 404, 405,
-	406, 407, 409, 412, 414, 416, 420, 421, 422, 423,
-	425, 426, 427, 428, 433, 434, 435, 277, 308, 147,
-	278, -173, -175, -300, -295, -171, 54, 105, 106, 113,
-	82, -174, -254, 24, 84, 368, -132, -133, -134, -135,
-	-296, -294, 60, 65, 69, 71, 72, 70, 67, 118,
-	-54, -314, -271, -277, -275, 148, 200, 144, 145, 8,
-	111, 318, 116, -278, 59, 58, 271, 75, 272, 273,
-	360, 268, 274, 189, 323, 43, 275, 276, 279, 367,
-	280, 44, 281, 270, 204, 282, 371, 370, 372, 364,
-	361, 359, 362, 363, 365, 366, -273, 33, -51, 54,
-	30, 54, -155, -121, 12, 119, 65, 60, -40, 56,
-	55, -325, 71, 72, -327, 162, 154, -155, 54, -217,
-	-216, -136, -60, -60, -60, -60, 41, 41, 41, 46,
-	41, 46, 41, -129, -155, -157, 56, -233, 184, 284,
-	210, -231, 211, 289, 292, -208, -207, -205, -154, 60,
-	-203, -236, -136, -154, 335, -233, -208, -207, 327, 437,
-	-50, -177, -155, -65, -64, -177, 186, -192, -208, 81,
-	-202, -153, -155, -84, -162, -162, -164, -331, -160, -331,
-	335, -121, -175, -241, -161, -155, -192, -208, 308, 24,
-	351, 352, 126, 129, 128, 358, -230, 317, 20, -202,
-	-224, -220, 60, 318, -207, -228, 51, 116, -279, -177,
-	29, -227, -227, -227, -228, 115, -155, -50, -68, -50,
-	-69, -208, -202, -155, -85, -84, -156, -153, -146, -320,
-	23, -71, -155, -120, 55, -119, 11, -150, 80, 78,
-	79, -155, 23, 119, -177, 96, -188, 89, 90, 91,
-	92, 93, 94, 54, 54, 54, 54, 54, 54, 54,
-	54, -186, 54, 54, 54, 54, 54, 54, -186, 54,
-	54, 54, 102, 101, 112, 105, 106, 107, 108, 109,
-	110, 111, 103, 104, 99, 81, 97, 98, 83, -54,
-	-177, -183, -175, -175, -175, -175, -254, -181, -177, 54,
-	60, 65, 54, 54, -276, 54, -185, -186, 54, 60,
-	60, 60, 54, 54, 54, -175, 54, -274, -184, -313,
-	436, -75, 56, -70, -155, -311, -312, -70, -74, -155,
-	-67, -177, -148, -149, -141, -145, -152, -153, -146, 266,
-	182, 20, 80, 23, 25, 271, 303, 83, 116, 16,
-	84, 148, 115, 273, 368, 272, 177, 47, 75, 370,
-	372, 371, 361, 359, 310, 314, 316, 313, 360, 334,
-	29, 10, 26, 198, 21, 22, 109, 179, 200, 87,
-	88, 201, 24, 199, 72, 19, 50, 11, 323, 13,
-	14, 274, 309, 189, 188, 99, 327, 185, 45, 8,
-	118, 27, 96, 311, 41, 77, 43, 97, 17, 362,
-	363, 31, 326, 393, 205, 111, 275, 276, 48, 81,
-	317, 70, 51, 78, 15, 46, 98, 180, 367, 44,
-	214, 315, 279, 281, 392, 280, 183, 6, 270, 369,
-	30, 197, 42, 184, 335, 86, 187, 71, 204, 144,
-	145, 5, 76, 9, 49, 52, 364, 365, 366, 33,
-	85, 12, 282, 397, 318, 328, 329, 330, 331, 332,
-	333, 172, 173, 174, 175, 176, 246, 192, 190, 194,
-	195, 436, 437, 19, -40, -323, 119, -71, -121, 55,
-	89, -77, -76, 51, 52, -78, 51, -76, 41, 41,
-	-72, -235, 107, 57, 55, -206, 309, 443, 58, 56,
-	55, -235, 187, 60, 60, 55, 18, 119, 55, -63,
-	25, 26, -84, 189, -84, -209, -210, 315, 24, -195,
-	52, -190, -191, -189, -193, 29, -121, -121, -121, -162,
-	-156, -164, -159, -164, -160, 119, -143, -155, -209, 54,
-	127, 130, 130, 129, -202, 187, 54, 89, -228, -228,
-	-228, 29, -154, -50, -50, 51, 55, 54, 56, 55,
-	-121, -57, -58, -59, -177, -177, -177, -155, -155, 107,
-	70, 81, -172, -182, -183, -177, -131, 21, 20, -131,
-	-131, -177, -131, 107, -183, -183, 56, -255, 65, -315,
-	-316, 373, 374, 375, 376, 377, 378, 379, 380, 381,
-	382, 383, 275, 270, 276, 274, 268, 282, 277, 278,
-	147, 390, 391, 384, 385, 386, 387, 388, 389, -131,
-	-131, -131, -131, -131, -131, -131, -173, -173, -173, -173,
-	-173, -173, -173, -173, -173, -173, -173, -173, -180, -187,
-	-254, 54, 99, 97, 98, 83, -175, -173, -173, 56,
-	55, -318, -317, 85, -177, -315, -182, -177, -182, 56,
-	-183, -182, -173, -182, -131, 55, 54, 56, 55, 33,
-	119, 55, 89, 56, 55, -68, 119, 325, -155, 56,
-	-67, -216, -177, -177, 54, -177, 11, 119, 119, -207,
-	16, 397, -154, -136, 187, -208, -283, 188, 367, -286,
-	339, -177, -177, -155, -64, -72, 81, 54, -214, 397,
-	317, 316, 312, -211, -212, 311, 313, 310, 314, 51,
-	260, 261, 262, 263, -189, -142, 115, 225, 151, -121,
-	-162, -162, -164, -155, -214, 56, 130, -208, -165, 60,
-	-220, -84, -84, -1, -155, -123, 13, 55, 119, 70,
-	56, 55, -177, -177, -177, 23, -183, 56, 56, 56,
-	56, 11, -177, -177, -177, -177, -177, -177, -177, -183,
-	-180, -175, -173, -173, -178, 201, 80, -177, -176, -317,
-	87, -177, 55, 52, 56, 11, 56, 56, 52, 56,
-	55, 56, -177, -184, -281, -280, -279, 33, -51, -70,
-	-274, -155, -312, -279, -155, -148, -145, -153, -146, 65,
-	-68, -71, -208, 107, 107, 57, -154, 318, -154, -208,
-	-221, 397, 27, -292, 333, 328, 330, 119, 23, 24,
-	-79, -80, -81, -86, -82, -136, -167, -83, 192, 190,
-	194, -308, 76, 195, 246, 77, 185, -213, -215, 319,
-	320, 321, 322, 80, -212, 60, 60, 60, 60, -84,
-	-147, 89, -147, -147, -121, -121, -162, -169, -170, -168,
-	266, -269, 318, 309, 56, 56, -122, 14, 16, -59,
-	-155, 107, -177, 56, 56, 56, -87, -93, 116, 148,
-	200, 147, 146, 144, 305, 306, 140, 141, 142, 139,
-	56, -177, 56, 56, 56, 56, 56, 56, 56, 56,
-	-178, 80, -175, -172, 56, 88, -177, 86, -87, -102,
-	-177, -102, -173, 56, 56, 55, -274, 56, -154, 16,
-	23, -209, 289, 184, -263, 438, -290, 328, 16, 16,
-	-51, -84, 56, 55, -88, -92, -89, -91, -90, -94,
-	-93, 148, 149, 116, 152, 154, 155, 156, 157, 158,
-	159, 160, 161, 162, 163, 30, 200, 144, 145, 146,
-	147, 164, 131, 150, 395, 172, 132, 173, 133, 174,
-	134, 175, 135, 136, 176, 137, -83, -155, 77, -307,
-	-308, -192, -307, 77, 54, -215, 65, 65, 65, 65,
-	-212, 54, -102, -104, -153, 60, 116, 60, -121, -168,
-	267, 31, 118, 269, 29, 265, 16, -177, -183, 56,
-	-256, -258, 54, -257, 54, -256, -256, -256, -95, 136,
-	135, -95, -260, 54, -260, -261, 54, -260, 56, -172,
-	-177, 56, 56, 56, 19, 56, 56, -279, -154, -154,
-	-221, 290, -84, -109, 439, 60, 16, 60, -288, 60,
-	-72, -100, -101, -118, 303, 216, -193, 220, 64, 221,
-	325, 222, 185, 224, 225, 226, 196, 227, 228, 229,
-	318, 230, 231, 232, 233, 286, 5, 256, -81, -99,
-	-98, -96, 70, 81, 29, 303, -97, 64, 115, 239,
-	217, 240, -117, -166, 190, 76, 77, 291, -167, -262,
-	306, 305, -256, -257, -258, -256, -256, 54, 54, -256,
-	-259, 54, -256, -256, -304, -305, -155, -305, -155, -304,
-	-304, -192, -177, -197, -199, -136, 54, 65, -270, -165,
-	65, 65, 65, 65, -177, -284, -241, -139, 440, 65,
-	60, 330, 23, -237, 206, 55, -118, -147, -147, -142,
-	115, -147, -147, -147, -147, 223, 223, -147, -147, -147,
-	-147, -147, -147, -147, -147, -147, -147, -147, -147, -147,
-	-147, 54, -96, 70, -173, 60, -104, -105, 29, 238,
-	234, -106, 29, 218, 219, -108, 54, 246, 77, 77,
-	-84, -264, 307, -138, 60, -138, 65, 54, 52, 255,
-	54, 54, 54, -305, 56, 56, 55, -256, -177, 268,
-	56, 56, 56, 55, 56, 55, 56, -291, 333, -287,
-	-285, 328, 329, 330, 331, 54, 16, -51, 16, -118,
-	65, 65, -147, -147, 65, 60, 60, 60, -147, -147,
-	65, 60, -157, 65, 65, 65, 65, 29, 60, -107,
-	29, 234, 238, 235, 236, 237, 65, 29, 65, 29,
-	65, 29, -155, 54, -309, -310, 60, 65, 54, -198,
-	54, 56, 55, 56, 56, -197, -306, 260, 261, 262,
-	264, 263, -306, -197, -197, -197, 54, -223, -222, 247,
-	81, -200, -199, -63, 56, 65, 65, -293, 188, -289,
-	332, -285, 16, 330, 16, 16, -140, -155, -288, -238,
-	248, 249, -239, -245, 251, -102, -102, 60, 60, -103,
-	217, -85, 56, 55, 89, 56, -177, -111, -110, 393,
-	-197, 60, 56, 56, 56, 56, -197, 247, -201, 196,
-	64, 397, 258, 259, -63, 56, 56, -299, 54, 65,
-	-290, 16, -288, 16, -288, -288, 56, 55, -243, 252,
-	54, -241, 54, -241, 77, 261, 218, 219, 56, -310,
-	60, 56, -115, -116, -113, -114, 51, 337, 244, 245,
-	56, -200, -200, -200, -200, 56, -147, 60, 257, -303,
-	30, 56, -298, -297, -137, -294, -155, 333, 60, -288,
-	-155, -240, 253, 65, -173, 54, -173, 54, -242, 250,
-	54, -222, -114, 51, -113, 51, 10, 9, -117, 65,
-	-153, -302, -301, -300, 56, 55, 119, -247, 54, 16,
-	56, -236, 56, -236, 54, 89, -173, -112, 241, 242,
-	30, 129, -112, 55, 89, -297, -155, -248, -246, 206,
-	-239, 56, 56, -236, 65, 56, 70, 29, 243, -301,
-	29, -177, 119, 56, 55, 57, -244, 254, 56, -155,
-	-246, -249, 33, 65, -253, -250, 54, -118, 208, -253,
-	-118, -252, -251, 253, 209, 56, 55, 57, 54, -251,
-	-250, -183, 56,
+	-1000, -342, -2, -1, -3, -4, -5, -6, -7, -43,
+	-22, -8, -55, -35, -36, -37, -45, -51, -52, -53,
+	-54, -57, -17, -16, -15, 8, 375, 10, -9, -167,
+	-23, -24, -25, -26, -27, -28, -29, -30, -31, -32,
+	-33, -34, 182, 9, 50, -38, -39, -40, -41, -42,
+	-46, -47, -48, -49, -50, 285, 291, 328, 336, -58,
+	-60, -18, -19, -20, -21, 178, -10, -11, -12, -13,
+	-14, 201, 200, 27, 199, 179, 121, 122, 124, 125,
+	341, 131, 31, -59, -332, 55, 180, -61, 405, 6,
+	450, -68, 28, -90, -164, 58, -153, -155, 408, 409,
+	410, 411, 412, 413, 414, 415, 416, 417, 418, 419,
+	420, 421, 422, 423, 424, 425, 426, 427, 428, 429,
+	430, 431, 432, 433, 434, 435, 436, 437, 438, 439,
+	440, 441, 442, 443, 402, 218, 242, 245, 252, 336,
+	338, 339, 340, 341, 121, 138, 132, 159, 151, 152,
+	129, 222, 223, 65, 124, 362, 348, 327, 127, 237,
+	239, 241, 244, 224, 147, 143, 236, 220, 141, 225,
+	29, 226, 164, 227, 228, 407, 344, 267, 366, 350,
+	249, 142, 345, 240, 347, 165, 169, 354, 291, 136,
+	137, 337, 352, 356, 163, 198, 33, 404, 35, 210,
+	357, 167, 162, 158, 365, 253, 251, 161, 135, 157,
+	221, 39, 184, 171, 170, 172, 321, 322, 323, 324,
+	229, 230, 279, 353, 154, 144, 271, 130, 18, 360,
+	205, 349, 292, 231, 246, 254, 166, 168, 212, 217,
+	406, 250, 358, 140, 209, 238, 204, 361, 131, 285,
+	294, 232, 125, 243, 355, 364, 38, 307, 134, 128,
+	195, 122, 214, 219, 233, 234, 235, 256, 255, 247,
+	155, 211, 160, 133, 156, 123, 213, 363, 308, 449,
+	269, 310, 153, 150, 215, 188, 359, 351, 126, 314,
+	309, 148, 257, 446, 447, 448, 280, -68, 11, -168,
+	19, 325, -44, 326, 182, 55, -164, -6, -4, -35,
+	-57, 186, -65, -66, -67, -133, -135, -90, 55, -164,
+	-245, -211, -244, -212, -247, -213, -163, 21, 179, 178,
+	213, 10, 180, 289, 187, 8, 6, 290, 199, 9,
+	291, 293, 294, 297, 298, 299, 32, 302, 303, 58,
+	61, -164, -245, -211, 217, 224, 189, -72, -73, -134,
+	15, 5, -281, 310, 216, -205, -203, 300, 196, 195,
+	77, 368, 185, -344, -278, 351, 350, -172, 349, 342,
+	344, 178, 187, 352, 33, 354, 355, 345, 186, 310,
+	126, 123, -228, 81, 131, 130, -228, 189, 126, -164,
+	341, 123, 355, 354, 216, 30, -238, 320, -237, -239,
+	365, -232, 353, -151, -164, 59, 60, 76, 152, 149,
+	-73, -134, -72, -59, -60, -58, -60, 310, 216, 187,
+	186, 368, -334, 400, -333, -164, -280, 21, -285, 22,
+	23, -1, -79, 208, -90, 120, -79, -65, -145, -164,
+	327, 90, -44, -44, 326, -335, -336, -337, -339, 182,
+	326, 325, 120, -90, 31, -136, -137, -138, -139, 42,
+	46, 48, 43, 44, 45, 49, -346, 24, -160, 24,
+	-166, -161, 61, -162, -155, 58, 59, 60, -58, -60,
+	52, 56, 11, 56, 55, 451, 59, 287, 301, 310,
+	288, 300, 188, 216, 301, 216, 342, 188, 292, 295,
+	296, 343, 52, 189, 52, -295, 365, 61, -56, 28,
+	-75, 17, -61, -60, 16, 21, 22, -341, 186, 400,
+	-201, 191, -201, 187, -201, -345, 11, 100, 215, 214,
+	346, 343, -254, 347, 348, -172, -171, 98, -172, 186,
+	368, -90, -279, 191, 358, 405, 129, 130, 131, -164,
+	341, 189, -164, 356, 123, -239, 123, -239, -242, 21,
+	30, 319, -211, 216, 56, 90, 19, -240, 90, 101,
+	-239, -240, -111, 30, -162, 61, 117, -111, 30, 120,
+	31, 31, -74, -75, -61, -60, -73, -72, -73, 57,
+	57, -279, -279, -279, -279, -279, 56, -334, -78, 55,
+	-62, -63, 108, -186, -164, 82, -188, 58, -181, 409,
+	410, 411, 412, 413, 414, 415, 417, 420, 422, 424,
+	428, 429, 430, 431, 433, 434, 435, 436, 441, 442,
+	443, 35, 279, 310, 148, 280, -182, -184, -313, -308,
+	-180, 55, 106, 107, 114, 83, -183, -267, 25, 85,
+	376, -141, -142, -143, -144, -309, -307, 61, 66, 70,
+	72, 73, 71, 68, 119, -60, -327, -284, -290, -288,
+	149, 202, 145, 146, 8, 112, 34, 320, 117, -291,
+	60, 59, 273, 76, 274, 275, 368, 270, 276, 191,
+	325, 44, 277, 278, 281, 375, 282, 45, 283, 272,
+	206, 284, 379, 378, 380, 372, 369, 367, 370, 371,
+	373, 374, -286, 34, -57, 55, 31, 55, -164, -286,
+	-130, 12, 120, 66, 61, -44, 57, 56, -338, 72,
+	73, -340, 163, 155, -164, 55, -226, -225, -145, -66,
+	-66, -66, -66, 42, 42, 42, 47, 42, 47, 42,
+	-138, -164, 20, -166, 24, 57, -246, 186, 286, 212,
+	-244, 213, 291, 294, -217, -216, -214, -163, 61, -212,
+	-249, -145, -163, 343, -246, -217, -216, 329, 337, 445,
+	-56, -186, -164, -71, -70, -186, 188, -201, -217, 82,
+	-211, -162, -164, -90, -171, -171, -173, -345, -169, -345,
+	343, -130, -184, -254, -170, -164, -201, -93, -92, -91,
+	183, 180, 206, 181, 184, 239, -217, 310, 25, 359,
+	360, 127, 130, 129, -164, -164, 341, 357, 356, -240,
+	356, -240, 366, -243, 319, 21, -211, -237, 354, 355,
+	-233, 61, 320, -216, -241, 52, 117, -292, -186, 30,
+	-240, -241, 116, -164, -56, -74, -56, -75, -217, -211,
+	-164, -94, -90, -165, -162, -155, -333, 24, -77, -164,
+	-129, 56, -128, 11, -159, 81, 79, 80, -164, 24,
+	120, -186, 97, -197, 90, 91, 92, 93, 94, 95,
+	55, 55, 55, 55, 55, 55, 55, 55, -195, 55,
+	55, 55, 55, 55, 55, -195, 55, 55, 55, 103,
+	102, 113, 106, 107, 108, 109, 110, 111, 112, 104,
+	105, 100, 82, 98, 99, 84, -60, -186, -192, -184,
+	-184, -184, -184, -267, -190, -186, 55, 61, 66, 55,
+	55, -289, 55, -194, -195, 55, 61, 61, 61, 55,
+	55, 55, 55, -184, 55, -227, 52, -287, -193, -326,
+	444, -81, 57, -76, -164, -324, -325, -76, -80, -164,
+	-73, -186, -157, -158, -150, -154, -161, -162, -155, 268,
+	183, 21, 81, 24, 26, 273, 305, 84, 117, 16,
+	85, 149, 116, 275, 376, 274, 178, 48, 76, 378,
+	380, 379, 369, 367, 312, 316, 318, 315, 368, 342,
+	30, 10, 27, 200, 22, 23, 110, 180, 202, 88,
+	89, 203, 25, 201, 73, 19, 51, 11, 325, 13,
+	14, 276, 311, 191, 190, 100, 329, 187, 46, 8,
+	119, 28, 97, 313, 42, 78, 44, 98, 17, 370,
+	371, 32, 328, 401, 207, 112, 277, 278, 49, 82,
+	319, 71, 20, 52, 79, 15, 47, 208, 99, 181,
+	375, 45, 216, 317, 281, 283, 400, 282, 185, 6,
+	272, 377, 31, 199, 43, 186, 343, 87, 189, 72,
+	206, 145, 146, 5, 77, 9, 50, 53, 372, 373,
+	374, 34, 86, 12, 284, 405, 320, 330, 331, 332,
+	333, 334, 335, 173, 174, 175, 176, 177, 248, 194,
+	192, 196, 197, 444, 445, 19, -44, -336, 120, -77,
+	-130, 56, 90, -83, -82, 52, 53, -84, 52, -82,
+	42, 42, -78, 146, 24, 20, -248, 108, 58, 56,
+	-215, 311, 451, 59, 57, 56, -248, 189, 61, 11,
+	61, 56, 18, 120, 56, -69, 26, 27, -90, 191,
+	-90, -218, -219, 317, 25, -204, 53, -199, -200, -198,
+	-202, 30, -130, -130, -130, -171, -165, -173, -168, -173,
+	-169, 120, -152, -164, 56, -343, 208, 193, -343, 208,
+	208, 193, -343, -218, 55, 128, 131, 131, 130, -164,
+	-229, 358, 361, 364, 357, -241, 357, -241, -211, 189,
+	55, 90, -241, 30, -163, -230, 19, 32, -56, -56,
+	52, 56, 55, 57, 56, -130, -63, -64, -65, -186,
+	-186, -186, -164, -164, 108, 71, 82, -181, -191, -192,
+	-186, -140, 22, 21, -140, -140, -186, -140, 108, -192,
+	-192, 57, -268, 66, -328, -329, 381, 382, 383, 384,
+	385, 386, 387, 388, 389, 390, 391, 277, 272, 278,
+	276, 270, 284, 279, 280, 148, 398, 399, 392, 393,
+	394, 395, 396, 397, -140, -140, -140, -140, -140, -140,
+	-140, -182, -182, -182, -182, -182, -182, -182, -182, -182,
+	-182, -182, -182, -189, -196, -267, 55, 100, 98, 99,
+	84, -184, -182, -182, 57, 56, -331, -330, 86, -186,
+	-328, -191, -186, -191, 57, -192, -191, -182, -76, -191,
+	-140, 29, 56, 55, 57, 56, 34, 120, 56, 90,
+	57, 56, -74, 120, 327, -164, 57, -73, -225, -186,
+	-186, 55, -186, -182, 20, 146, 11, 120, 120, -216,
+	16, 405, -163, -145, 189, -217, -296, 190, 375, 61,
+	-299, 347, -186, -186, -164, -70, -78, 82, 55, -223,
+	405, 319, 318, 314, -220, -221, 313, 315, 312, 316,
+	52, 262, 263, 264, 265, -198, -151, 116, 227, 152,
+	-130, -171, -171, -173, -164, -91, 183, 180, -95, -145,
+	55, -164, -80, -80, -164, -95, -223, 57, 131, 363,
+	362, 358, -229, -229, -217, -174, 61, -233, 9, 38,
+	100, -230, -90, -90, -1, -164, -132, 13, 56, 120,
+	71, 57, 56, -186, -186, -186, 24, -192, 57, 57,
+	57, 57, 11, -186, -186, -186, -186, -186, -186, -186,
+	-192, -189, -184, -182, -182, -187, 203, 81, -186, -185,
+	-330, 88, -186, 56, 53, 57, 11, 57, 57, 53,
+	57, 56, 57, 57, -186, 78, -193, -294, -293, -292,
+	34, -57, -76, -287, -164, -325, -292, -164, -157, -154,
+	-162, -155, 66, -74, -77, 146, -182, -217, 108, 108,
+	58, -163, 320, -163, -217, -234, 405, 28, -305, 335,
+	330, 332, 120, 24, 25, -85, -86, -87, -95, -88,
+	-176, -89, 194, 192, 196, -321, 77, 197, 248, 78,
+	187, -222, -224, 321, 322, 323, 324, 81, -221, 61,
+	61, 61, 61, -90, -156, 90, -156, -156, -130, -130,
+	-171, -97, -101, -98, -100, -99, -103, -102, 149, 150,
+	117, 153, 155, 156, 157, 158, 159, 160, 161, 162,
+	163, 164, 31, 202, 145, 146, 147, 148, 165, 132,
+	151, 403, 173, 133, 174, 134, 175, 135, 176, 136,
+	137, 177, 138, 141, 142, 143, 140, -261, -259, 208,
+	189, -178, -179, -177, 268, -282, 320, 311, 57, -231,
+	338, 339, -231, 38, 57, -131, 14, 16, -65, -164,
+	108, -186, 57, 57, 57, -96, -102, 117, 149, 202,
+	148, 147, 145, 307, 308, 57, -186, 57, 57, 57,
+	57, 57, 57, 57, 57, -187, 81, -184, -181, 57,
+	89, -186, 87, -96, -111, -186, -111, -182, 57, 9,
+	57, 56, -287, 57, -182, -163, 16, 24, -218, 291,
+	186, -276, 446, -303, 330, 16, 16, -57, -90, 57,
+	56, -89, -164, 78, -320, -321, -201, -320, 78, 55,
+	-224, 66, 66, 66, 66, -221, 55, -111, -113, -162,
+	61, 117, 61, -130, -108, -107, -105, 71, 82, 30,
+	305, -106, 65, 116, 241, 219, 223, 242, -126, -175,
+	192, 77, 78, 293, -176, -275, 308, 307, -269, -271,
+	55, -270, 55, -271, -269, -269, 55, 55, -269, -272,
+	55, -269, -269, -273, 55, -273, -274, 55, -273, 57,
+	56, 58, -164, -177, 269, 32, 119, 271, 30, 267,
+	16, 340, 39, -186, -192, 57, -269, -270, -269, -269,
+	-269, -104, 137, 136, -104, 57, -181, -186, 57, 57,
+	57, 19, 57, 57, -226, -292, -163, -163, -234, 292,
+	-90, -118, 447, 61, 16, 61, -301, 61, -78, -109,
+	-110, -127, 305, 218, -202, 222, 65, 223, 327, 224,
+	187, 226, 227, 228, 198, 229, 230, 231, 320, 232,
+	233, 234, 235, 288, 5, 258, -87, -317, -318, -164,
+	-318, -164, -317, -317, -201, -186, -206, -208, -145, 55,
+	-105, 71, -182, 61, -113, -114, 30, 240, 236, -115,
+	30, 220, 221, 61, -117, 55, 248, 78, 78, -90,
+	-277, 309, 66, 66, -147, 61, -147, 66, 66, 66,
+	-259, -262, 34, 66, -283, -174, -186, -297, -254, -148,
+	448, 66, 61, 332, 24, -250, 208, 56, -127, -156,
+	-156, -151, 116, -156, -156, -156, -156, 225, 225, -156,
+	-156, -156, -156, -156, -156, -156, -156, -156, -156, -156,
+	-156, -156, -156, 55, 55, 53, 257, 55, 55, 55,
+	-318, 57, 57, 56, -269, -186, 66, 55, -207, 55,
+	57, 57, 57, 56, 57, 57, 57, 56, 57, 56,
+	-266, -263, 55, -127, 210, 100, 270, 57, -304, 335,
+	-300, -298, 330, 331, 332, 333, 55, 16, -57, 16,
+	-127, 66, 66, -156, -156, 66, 61, 61, 61, -156,
+	-156, 66, 61, -166, 66, 66, 66, 66, 30, 61,
+	-116, 30, 236, 240, 237, 238, 239, 66, 30, 66,
+	30, 66, 30, -164, 55, -322, -323, 61, -206, -319,
+	262, 263, 264, 266, 265, -319, -206, -206, -206, 55,
+	-236, -235, 249, 82, -209, -208, -69, 57, 57, -186,
+	-120, -119, 401, -206, 61, 66, 66, -266, -127, -265,
+	-264, 255, 211, 55, -306, 190, -302, 334, -298, 16,
+	332, 16, 16, -149, -164, -301, -251, 250, 251, -252,
+	-258, 253, -111, -111, 61, 61, -112, 219, -94, 57,
+	56, 90, 57, 57, 57, 57, -206, 249, -210, 198,
+	65, 405, 260, 261, -69, 57, -124, -125, -122, -123,
+	52, 345, 246, 247, 57, 57, 57, 57, 56, 58,
+	55, 207, -192, -312, 55, 66, -303, 16, -301, 16,
+	-301, -301, 57, 56, -256, 254, 55, -254, 55, -254,
+	78, 263, 220, 221, 57, -323, 61, -209, -209, -209,
+	-209, 57, -156, 61, 259, -235, -123, 52, -122, 52,
+	10, 9, -264, -263, -192, 207, 57, -316, 31, 57,
+	-311, -310, -146, -307, -164, 335, 61, -301, -164, -253,
+	255, 66, -182, 55, -182, 55, -255, 252, 55, -126,
+	66, -162, -121, 243, 244, 31, 130, -121, 57, 57,
+	-315, -314, -313, 57, 56, 120, -260, 55, 16, 57,
+	-249, 57, -249, 55, 90, -182, 71, 30, 245, 56,
+	90, -310, -164, -261, -252, 57, 57, -249, 66, 57,
+	-314, 30, -186, 120, 57, -257, 256, 57, -164, 66,
 }
 
 var yyDef = [...]int{
-	20, -2, 1, 2, 4, 5, 6, 7, 8, 9,
+	22, -2, 1, 2, 4, 5, 6, 7, 8, 9,
 	10, 11, 12, 13, 14, 15, 16, 17, 18, 19,
-	440, 441, 442, 0, 0, 273, 0, 227, 228, 229,
-	230, 231, 232, 233, 234, 235, 236, 209, 0, 0,
-	180, 160, 161, 162, 119, 120, 121, 122, 0, 0,
-	0, 333, -2, 443, 444, 445, 446, -2, 274, 275,
-	276, 277, 278, 198, 199, 200, -2, 0, 173, 0,
-	165, 165, 0, 353, 0, 0, 0, 364, 0, 373,
-	20, 311, 0, 316, 622, 658, 659, 660, 1308, 1309,
-	1310, 1311, 1312, 1313, 1314, 1315, 1316, 1317, 1318, 1319,
-	1320, 1321, 1322, 1323, 1324, 1325, 1326, 1327, 1328, 1329,
-	1330, 1331, 1332, 1333, 1334, 1335, 1336, 1337, 1338, 1339,
-	1340, 1341, 1342, 1343, 1151, 1152, 1153, 1154, 1155, 1156,
-	1157, 1158, 1159, 1160, 1161, 1162, 1163, 1164, 1165, 1166,
-	1167, 1168, 1169, 1170, 1171, 1172, 1173, 1174, 1175, 1176,
-	1177, 1178, 1179, 1180, 1181, 1182, 1183, 1184, 1185, 1186,
-	1187, 1188, 1189, 1190, 1191, 1192, 1193, 1194, 1195, 1196,
-	1197, 1198, 1199, 1200, 1201, 1202, 1203, 1204, 1205, 1206,
-	1207, 1208, 1209, 1210, 1211, 1212, 1213, 1214, 1215, 1216,
-	1217, 1218, 1219, 1220, 1221, 1222, 1223, 1224, 1225, 1226,
-	1227, 1228, 1229, 1230, 1231, 1232, 1233, 1234, 1235, 1236,
-	1237, 1238, 1239, 1240, 1241, 1242, 1243, 1244, 1245, 1246,
-	1247, 1248, 1249, 1250, 1251, 1252, 1253, 1254, 1255, 1256,
-	1257, 1258, 1259, 1260, 1261, 1262, 1263, 1264, 1265, 1266,
-	1267, 1268, 1269, 1270, 1271, 1272, 1273, 1274, 1275, 1276,
-	1277, 1278, 1279, 1280, 1281, 1282, 1283, 1284, 1285, 1286,
-	1287, 1288, 1289, 1290, 1291, 1292, 1293, 1294, 1295, 1296,
-	1297, 1298, 1299, 1300, 1301, 1302, 1303, 1304, 1305, 1306,
-	1307, 0, 189, 0, 0, 193, 0, 0, 0, 270,
-	185, 186, 187, 188, 0, 0, 395, 396, 419, 422,
-	425, 0, 179, 0, 0, 80, 488, 82, 490, 0,
-	86, 88, 89, -2, 93, 94, 95, 96, 97, 98,
-	99, 0, 101, 1200, 103, 1261, 106, 107, 108, 0,
-	117, 118, -2, -2, 485, 0, 0, 1250, 62, -2,
-	0, 0, 0, 369, 449, 519, 519, 0, 519, 532,
-	496, 497, 498, 517, 518, 0, 0, 246, 247, 0,
-	263, 254, 263, 0, 238, 239, 240, 244, 245, 264,
-	212, 174, 175, 164, 0, 169, 0, 163, 0, 0,
-	133, 0, 138, 0, 1199, 1265, 1215, 0, 1233, 0,
-	158, 151, 152, 996, 1161, 0, 348, 0, 354, 353,
-	353, 0, 353, 212, 212, 212, 212, 212, 341, 0,
-	343, 346, 0, 374, 375, 376, 377, 3, 0, 0,
-	315, 0, 382, 190, 661, 0, 0, 194, 195, 0,
-	0, 201, 0, 204, 1344, 1345, 1346, 0, 0, 0,
-	0, 0, 0, 0, 410, 0, 0, 409, 0, 0,
-	0, 0, 423, 424, 426, 0, 428, 429, 435, 436,
-	437, 438, 439, 0, 353, 76, 0, 0, 0, 0,
-	0, 492, 87, 116, 90, 91, 0, 111, 113, 115,
-	114, 100, 112, 102, 104, 105, 110, 76, 0, 0,
-	0, 63, 336, 0, 317, 0, 366, 368, 0, 370,
-	371, 0, 519, 450, 0, 0, 0, 0, 0, 0,
-	259, 260, 254, 254, 248, 256, 0, 261, 262, 0,
-	382, 0, 0, 0, 519, 0, 0, 0, 0, 167,
-	0, 172, 123, 128, 126, 127, 129, 0, 0, 0,
-	0, 0, 156, 157, 0, 0, 0, 0, 145, 148,
-	614, 615, 616, 149, 150, 0, 997, 998, 317, 349,
-	365, 367, 348, -2, 0, 362, 363, 0, 0, 0,
-	0, 0, 0, 342, 0, 0, 390, 384, 386, 430,
-	28, 0, 895, 658, 899, 1309, 1310, 1311, 1312, 1313,
-	1314, 1315, 1317, 1320, 1322, 1324, -2, -2, -2, 1331,
-	-2, -2, 1335, 1336, 1341, 1342, 1343, -2, -2, -2,
-	-2, 908, 729, 730, 731, 732, 0, 0, 0, 0,
-	0, 739, 740, 0, 752, 0, 746, 747, 748, 749,
-	38, 39, 924, 925, 926, 927, 928, 929, 930, 862,
-	716, 0, 0, 847, 837, 0, 857, 875, 876, 0,
-	0, 0, 0, 0, 40, 41, 853, 854, 855, 856,
-	858, 859, 860, 861, 863, 864, 865, 866, 869, 870,
-	871, 872, 873, 874, 877, 879, 849, 850, 851, 852,
-	841, 842, 843, 844, 845, 846, 285, 303, 287, 0,
-	292, 0, 623, 353, 0, 0, 191, 0, 196, 0,
-	0, 203, 205, 206, 207, 1347, 1348, 271, 0, 382,
-	182, 0, 413, 407, 0, 400, 411, 412, 403, 0,
-	405, 0, 401, 402, 346, 427, 421, 0, 77, 78,
-	79, 81, 92, 0, 0, 70, 473, 479, 476, 486,
-	489, 0, 84, 491, 109, 0, 65, 0, 0, 0,
-	337, 350, 28, 355, 356, 359, 0, 0, 460, 0,
-	487, 511, -2, 382, 382, 382, 254, 0, 256, 0,
-	256, 251, 255, 0, 265, 267, 0, 460, 1292, 213,
-	176, 177, 0, 0, 171, 0, 0, 130, 131, 132,
-	139, 134, 136, 0, 0, 140, 153, 154, 155, 309,
-	310, 0, 0, 0, 144, 0, 159, 335, 317, 339,
-	317, 279, 280, 0, 282, 620, 283, 433, 434, 344,
-	0, 0, 417, 382, 0, 391, 0, 387, 0, 0,
-	0, 431, 0, 0, 894, 0, 0, 913, 914, 915,
-	916, 917, 918, 887, 883, 883, 883, 0, 883, 0,
-	0, 823, 0, 0, 883, 883, 883, 883, 824, 883,
-	883, 883, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, -2,
-	889, 0, 735, 736, 737, 738, 741, 0, 753, 0,
-	881, 0, 887, 887, 826, 0, 827, 838, 0, 830,
-	831, 832, 887, 0, 887, 836, 883, 286, 300, 0,
-	304, 0, 0, 296, 298, 291, 293, 0, 0, 313,
-	348, 383, 662, 0, 1003, -2, 1005, -2, -2, 1007,
-	1008, 1009, 1010, 1011, 1012, 1013, 1014, 1015, 1016, 1017,
-	1018, 1019, 1020, 1021, 1022, 1023, 1024, 1025, 1026, 1027,
-	1028, 1029, 1030, 1031, 1032, 1033, 1034, 1035, 1036, 1037,
-	1038, 1039, 1040, 1041, 1042, 1043, 1044, 1045, 1046, 1047,
-	1048, 1049, 1050, 1051, 1052, 1053, 1054, 1055, 1056, 1057,
-	1058, 1059, 1060, 1061, 1062, 1063, 1064, 1065, 1066, 1067,
-	1068, 1069, 1070, 1071, 1072, 1073, 1074, 1075, 1076, 1077,
-	1078, 1079, 1080, 1081, 1082, 1083, 1084, 1085, 1086, 1087,
-	1088, 1089, 1090, 1091, 1092, 1093, 1094, 1095, 1096, 1097,
-	1098, 1099, 1100, 1101, 1102, 1103, 1104, 1105, 1106, 1107,
-	1108, 1109, 1110, 1111, 1112, 1113, 1114, 1115, 1116, 1117,
-	1118, 1119, 1120, 1121, 1122, 1123, 1124, 1125, 1126, 1127,
-	1128, 1129, 1130, 1131, 1132, 1133, 1134, 1135, 1136, 1137,
-	1138, 1139, 1140, 1141, 1142, 1143, 1144, 1145, 1146, 1147,
-	1148, 1149, 1150, 0, 197, 202, 0, 0, 353, 0,
-	0, 397, 414, 0, 0, 398, 0, 399, 404, 406,
-	420, 0, 71, 75, 0, 475, 0, 0, 478, 83,
-	0, 0, 0, 59, 319, 0, 0, 0, 0, 358,
-	360, 361, 346, 0, 0, 452, 461, 0, 520, 0,
-	0, 516, -2, 523, 0, 529, 237, 241, 242, 382,
-	257, 254, 258, 254, 256, 0, 266, 269, 452, 0,
-	178, 166, 168, 0, 125, 0, 0, 0, 141, 142,
-	143, 146, 147, 338, 340, 0, 0, 20, 347, 0,
-	380, 385, 392, 393, 891, 892, 893, 432, 29, 388,
-	896, 0, 898, 0, 888, 889, 0, 884, 885, 0,
-	0, 0, 0, 0, 0, 0, 839, 0, 923, 0,
-	794, 795, 796, 797, 798, 799, 800, 801, 802, 803,
-	804, 805, 806, 807, 808, 809, 810, 811, 812, 813,
-	814, 815, 816, 817, 818, 819, 820, 821, 822, 0,
-	0, 0, 0, 0, 0, 0, 717, 718, 719, 720,
-	721, 722, 723, 724, 725, 726, 727, 728, 900, 911,
-	912, 0, 0, 0, 0, 0, 909, 904, 0, 733,
-	0, 750, 754, 0, 0, 882, 0, 889, 0, 848,
-	0, 0, 0, 0, 0, 303, 305, 0, 0, 303,
-	0, 0, 0, 312, 0, 284, 0, 0, 272, 208,
-	348, 183, 184, 415, 0, 408, 0, 0, 0, 474,
-	0, 0, 477, 85, 0, 67, 0, 60, 61, 323,
-	0, 351, 352, 29, 357, 0, 0, 624, 451, 0,
-	462, 463, 464, 465, 466, 0, 0, 0, 0, 0,
-	512, 513, 514, 515, 524, 999, 999, 999, 0, 249,
-	382, 382, 254, 268, 214, 0, 170, 124, 0, 226,
-	135, 281, 621, 0, 418, 378, 0, 0, 0, 897,
-	787, 0, 0, 0, 0, 0, 0, 776, 770, 771,
-	840, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	901, 909, 905, 0, 902, 0, 0, 890, 0, 755,
-	0, 0, 0, 0, 788, 0, 825, 828, 0, 833,
-	0, 835, 0, 301, 0, 306, 307, 303, 290, 297,
-	289, 299, 294, 295, 314, 663, 1004, 1001, 1002, 192,
-	181, 0, 69, 72, 73, 74, 480, 0, 481, 460,
-	66, 0, 0, 325, 48, 0, 0, 0, 0, 0,
-	0, 625, 626, 628, 629, 0, 0, 631, 685, 0,
-	640, 519, 640, 0, 0, 642, 643, 453, 454, 0,
-	0, 0, 0, 0, 468, 469, 470, 471, 472, 0,
-	0, 1000, 0, 0, 252, 250, 382, 210, 215, 216,
-	0, 220, 0, 0, 137, 345, 372, 0, 0, 394,
-	30, 389, 890, 772, 773, 774, 0, 757, 978, 982,
-	760, 978, 978, 978, 766, 766, 985, 985, 988, 985,
-	775, 0, 777, 778, 781, 779, 782, 783, 769, 886,
-	903, 0, 910, 906, 734, 742, 751, 0, 0, 0,
-	0, 0, 0, 780, 302, 0, 288, 416, 484, 0,
-	0, 67, 0, 0, 327, 0, 324, 0, 0, 0,
-	447, 346, -2, 0, -2, 991, 932, 933, 934, 978,
-	936, 982, 0, 978, 978, 964, 965, 966, 967, 968,
-	969, 970, 971, 972, 0, 0, 955, 978, 980, 978,
-	978, 975, 937, 938, 939, 940, 941, 942, 943, 944,
-	945, 946, 947, 948, 949, 950, 630, 686, 652, 652,
-	641, 652, 652, 519, 0, 455, 456, 457, 458, 459,
-	467, 0, 525, 526, 617, 618, 619, 527, 253, 217,
-	218, 219, 0, 222, 223, 225, 0, 379, 381, 743,
-	758, 979, 0, 759, 0, 761, 762, 763, 764, 767,
-	768, 765, 951, 0, 952, 953, 0, 954, 791, 907,
-	756, 744, 745, 789, 0, 829, 834, 308, 482, 483,
-	64, 68, 50, 329, 0, 326, 0, 320, 322, 58,
-	0, 533, -2, 570, 999, 999, 0, 999, 999, 999,
-	999, 0, 0, 999, 999, 999, 999, 999, 999, 999,
-	999, 999, 999, 999, 999, 999, 999, 0, 627, 654,
-	-2, 666, 668, 0, 0, 671, 672, 0, 0, 0,
-	0, 708, 678, 0, 0, 921, 922, 0, 684, 994,
-	992, 993, 935, 960, 961, 962, 963, 0, 0, 956,
-	957, 0, 958, 959, 0, 644, 653, 0, 653, 0,
-	0, 652, 0, 0, 507, 978, 0, 0, 224, 211,
-	0, 0, 0, 0, 0, 44, 0, 318, 0, 328,
-	49, 0, 0, 530, 0, 528, 572, 0, 0, 999,
-	999, 0, 0, 0, 0, 999, 999, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 667, 669, 670, 673, 674, 675, 713, 714,
-	715, 676, 710, 711, 712, 677, 0, 0, 919, 920,
-	706, 931, 995, 0, 976, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 638, 500, 0, 359, 0, 221,
-	984, 983, 986, 0, 989, 0, 790, 42, 46, 51,
-	52, 0, 0, 0, 0, 0, 0, 448, 566, 571,
-	573, 574, 0, 0, 577, 578, 579, 580, 0, 0,
-	583, 584, 585, 586, 587, 588, 589, 590, 591, 592,
-	608, 609, 610, 611, 612, 613, 593, 594, 595, 596,
-	597, 598, 605, 0, 0, 602, 0, 0, 0, 701,
-	0, 973, 0, 974, 981, 0, 645, 647, 648, 649,
-	650, 651, 646, 0, 0, 0, 0, 637, 639, 681,
-	0, 499, 508, 509, 359, 0, 0, 31, 0, 48,
-	0, 53, 0, 0, 0, 0, 0, 331, 321, 555,
-	0, 0, 561, 0, 567, 575, 576, 581, 582, 599,
-	0, 0, 601, 0, 0, 709, 0, 688, 702, 0,
-	0, 977, 500, 500, 500, 500, 0, 682, 501, 999,
-	0, 0, 505, 506, 510, 987, 990, 22, 0, 0,
-	45, 0, 54, 0, 56, 57, 330, 0, 535, 0,
-	0, 0, 0, 0, 564, 0, 606, 607, 600, 603,
-	604, 679, 687, 689, 690, 691, 0, 703, 704, 705,
-	707, 632, 633, 634, 635, 0, 0, 503, 0, 21,
-	0, 32, 0, 34, 36, 37, 655, 43, 47, 55,
-	332, 537, 0, 556, 0, 0, 0, 0, 0, 0,
-	0, 680, 692, 0, 693, 0, 0, 0, 636, 502,
-	504, 23, 24, 0, 33, 0, 0, 534, 0, 566,
-	557, 0, 559, 0, 0, 0, 0, 694, 696, 697,
-	0, 0, 695, 0, 0, 35, 656, 0, 539, 0,
-	553, 558, 560, 0, 565, 563, 698, 700, 699, 25,
-	26, 27, 0, 538, 0, 551, 536, 0, 562, 657,
-	540, -2, 0, 554, 541, -2, 0, 549, 0, 542,
-	550, 0, 545, 0, 0, 544, 0, -2, 0, 546,
-	-2, 0, 552,
+	20, 21, 488, 489, 490, 0, 0, 0, 308, 0,
+	262, 263, 264, 265, 266, 267, 268, 269, 270, 271,
+	229, 230, 0, 0, 200, 172, 173, 174, 175, 176,
+	123, 124, 125, 126, 127, 0, 0, 0, 0, 371,
+	-2, 491, 492, 493, 494, -2, 309, 310, 311, 312,
+	313, 218, 219, 220, -2, 0, 193, 0, 185, 185,
+	0, 0, 0, 398, 0, 0, 0, 409, 0, 418,
+	22, 349, 0, 354, 673, 709, 710, 711, 1370, 1371,
+	1372, 1373, 1374, 1375, 1376, 1377, 1378, 1379, 1380, 1381,
+	1382, 1383, 1384, 1385, 1386, 1387, 1388, 1389, 1390, 1391,
+	1392, 1393, 1394, 1395, 1396, 1397, 1398, 1399, 1400, 1401,
+	1402, 1403, 1404, 1405, 1207, 1208, 1209, 1210, 1211, 1212,
+	1213, 1214, 1215, 1216, 1217, 1218, 1219, 1220, 1221, 1222,
+	1223, 1224, 1225, 1226, 1227, 1228, 1229, 1230, 1231, 1232,
+	1233, 1234, 1235, 1236, 1237, 1238, 1239, 1240, 1241, 1242,
+	1243, 1244, 1245, 1246, 1247, 1248, 1249, 1250, 1251, 1252,
+	1253, 1254, 1255, 1256, 1257, 1258, 1259, 1260, 1261, 1262,
+	1263, 1264, 1265, 1266, 1267, 1268, 1269, 1270, 1271, 1272,
+	1273, 1274, 1275, 1276, 1277, 1278, 1279, 1280, 1281, 1282,
+	1283, 1284, 1285, 1286, 1287, 1288, 1289, 1290, 1291, 1292,
+	1293, 1294, 1295, 1296, 1297, 1298, 1299, 1300, 1301, 1302,
+	1303, 1304, 1305, 1306, 1307, 1308, 1309, 1310, 1311, 1312,
+	1313, 1314, 1315, 1316, 1317, 1318, 1319, 1320, 1321, 1322,
+	1323, 1324, 1325, 1326, 1327, 1328, 1329, 1330, 1331, 1332,
+	1333, 1334, 1335, 1336, 1337, 1338, 1339, 1340, 1341, 1342,
+	1343, 1344, 1345, 1346, 1347, 1348, 1349, 1350, 1351, 1352,
+	1353, 1354, 1355, 1356, 1357, 1358, 1359, 1360, 1361, 1362,
+	1363, 1364, 1365, 1366, 1367, 1368, 1369, 349, 0, 209,
+	0, 0, 213, 0, 0, 0, 305, 205, 206, 207,
+	208, 0, 0, 440, 441, 464, 467, 473, 0, 199,
+	0, 0, 84, 536, 86, 538, 0, 90, 92, 93,
+	-2, 97, 98, 99, 100, 101, 102, 103, 0, 105,
+	1261, 107, 1323, 110, 111, 112, 0, 121, 122, -2,
+	-2, 533, 0, 0, 1312, 66, 0, -2, 0, 0,
+	0, 414, 497, 567, 567, 0, 567, 580, 544, 545,
+	546, 565, 566, 0, 0, 281, 282, 0, 298, 289,
+	298, 0, 273, 274, 275, 279, 280, 299, 0, 247,
+	194, 195, 184, 0, 189, 0, 177, 0, 0, 182,
+	0, 1348, 1327, 1260, 0, 0, 145, 0, 150, 0,
+	1277, 0, 1296, 0, 170, 163, 164, 1050, 1222, 0,
+	393, 0, 399, 398, 398, 0, 398, 247, 247, 247,
+	247, 247, 386, 0, 388, 391, 0, 419, 420, 421,
+	422, 3, 0, 0, 353, 0, 0, 427, 210, 712,
+	0, 0, 214, 215, 0, 0, 221, 0, 224, 1406,
+	1407, 1408, 0, 0, 0, 0, 0, 0, 0, 455,
+	0, 0, 454, 0, 0, 0, 0, 468, 469, 0,
+	474, 476, 477, 483, 484, 485, 486, 487, 0, 398,
+	80, 0, 0, 0, 0, 0, 540, 91, 120, 94,
+	95, 0, 115, 117, 119, 118, 104, 116, 106, 108,
+	109, 114, 80, 0, 0, 0, 67, 23, 381, 0,
+	355, 0, 411, 413, 0, 415, 416, 0, 567, 498,
+	0, 0, 0, 0, 0, 0, 294, 295, 289, 289,
+	283, 291, 0, 296, 297, 0, 427, 0, 0, 0,
+	567, 0, 0, 0, 0, 0, 187, 0, 192, 178,
+	1216, 0, 183, 0, 1348, 0, 1348, 0, 135, 140,
+	138, 139, 141, 0, 0, 0, 0, 0, 168, 169,
+	0, 0, 157, 160, 665, 666, 667, 161, 162, 0,
+	1051, 1052, 355, 394, 410, 412, 393, -2, 0, 407,
+	408, 0, 0, 0, 0, 0, 0, 387, 0, 0,
+	435, 429, 431, 478, 32, 0, 949, 709, 953, 1371,
+	1372, 1373, 1374, 1375, 1376, 1377, 1379, 1382, 1384, 1386,
+	-2, -2, -2, 1393, -2, -2, 1397, 1398, 1403, 1404,
+	1405, -2, -2, -2, -2, -2, 962, 781, 782, 783,
+	784, 0, 0, 0, 0, 0, 791, 792, 0, 804,
+	0, 798, 799, 800, 801, 42, 43, 978, 979, 980,
+	981, 982, 983, 984, 915, 768, 0, 0, 900, 890,
+	0, 910, 929, 930, 0, 0, 0, 0, 0, 0,
+	44, 45, 906, 907, 908, 909, 911, 912, 913, 914,
+	916, 918, 919, 920, 923, 924, 925, 926, 927, 928,
+	931, 933, 902, 903, 904, 905, 894, 895, 896, 897,
+	898, 899, 322, 341, 325, 0, 330, 0, 674, 321,
+	398, 0, 0, 211, 0, 216, 0, 0, 223, 225,
+	226, 227, 1409, 1410, 306, 0, 427, 202, 0, 458,
+	452, 0, 445, 456, 457, 448, 0, 450, 0, 446,
+	447, 391, 0, 475, 0, 466, 0, 81, 82, 83,
+	85, 96, 0, 0, 74, 521, 527, 524, 534, 537,
+	0, 88, 539, 113, 0, 69, 0, 0, 0, 0,
+	382, 395, 32, 400, 401, 404, 0, 0, 508, 0,
+	535, 559, -2, 427, 427, 427, 289, 0, 291, 0,
+	291, 286, 290, 0, 300, 302, 0, 231, 232, 233,
+	243, 243, 0, 0, 243, 239, 508, 1354, 248, 196,
+	197, 0, 0, 191, 179, 180, 1216, 0, 0, 0,
+	0, 0, 0, 0, 142, 143, 144, 151, 1260, 1327,
+	146, 148, 0, 0, 152, 165, 166, 167, 347, 348,
+	0, 156, 0, 171, 373, 355, 384, 355, 314, 315,
+	0, 317, 671, 318, 481, 482, 389, 0, 0, 462,
+	427, 0, 436, 0, 432, 0, 0, 0, 479, 0,
+	0, 948, 0, 0, 967, 968, 969, 970, 971, 972,
+	941, 937, 937, 937, 0, 937, 0, 0, 875, 0,
+	0, 937, 937, 937, 937, 876, 937, 937, 937, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, -2, 943, 0, 787,
+	788, 789, 790, 793, 0, 805, 0, 935, 0, 941,
+	941, 878, 0, 879, 891, 0, 882, 883, 884, 941,
+	0, 0, 941, 889, 937, 320, 0, 324, 338, 0,
+	342, 0, 0, 334, 336, 329, 331, 0, 0, 351,
+	393, 428, 713, 0, 1057, -2, 1059, -2, -2, 1061,
+	1062, 1063, 1064, 1065, 1066, 1067, 1068, 1069, 1070, 1071,
+	1072, 1073, 1074, 1075, 1076, 1077, 1078, 1079, 1080, 1081,
+	1082, 1083, 1084, 1085, 1086, 1087, 1088, 1089, 1090, 1091,
+	1092, 1093, 1094, 1095, 1096, 1097, 1098, 1099, 1100, 1101,
+	1102, 1103, 1104, 1105, 1106, 1107, 1108, 1109, 1110, 1111,
+	1112, 1113, 1114, 1115, 1116, 1117, 1118, 1119, 1120, 1121,
+	1122, 1123, 1124, 1125, 1126, 1127, 1128, 1129, 1130, 1131,
+	1132, 1133, 1134, 1135, 1136, 1137, 1138, 1139, 1140, 1141,
+	1142, 1143, 1144, 1145, 1146, 1147, 1148, 1149, 1150, 1151,
+	1152, 1153, 1154, 1155, 1156, 1157, 1158, 1159, 1160, 1161,
+	1162, 1163, 1164, 1165, 1166, 1167, 1168, 1169, 1170, 1171,
+	1172, 1173, 1174, 1175, 1176, 1177, 1178, 1179, 1180, 1181,
+	1182, 1183, 1184, 1185, 1186, 1187, 1188, 1189, 1190, 1191,
+	1192, 1193, 1194, 1195, 1196, 1197, 1198, 1199, 1200, 1201,
+	1202, 1203, 1204, 1205, 1206, 0, 217, 222, 0, 0,
+	398, 0, 0, 442, 459, 0, 0, 443, 0, 444,
+	449, 451, 465, 0, 0, 0, 0, 75, 79, 0,
+	523, 0, 0, 526, 87, 0, 0, 0, 63, 0,
+	357, 0, 0, 0, 0, 403, 405, 406, 391, 0,
+	0, 500, 509, 0, 568, 0, 0, 564, -2, 571,
+	0, 577, 272, 276, 277, 427, 292, 289, 293, 289,
+	291, 0, 301, 304, 0, 0, 0, 244, 0, 0,
+	0, 0, 0, 500, 0, 198, 186, 188, 0, 181,
+	128, 0, 0, 134, 0, 154, 0, 153, 137, 0,
+	0, 0, 155, 158, 159, 380, 0, 0, 373, 385,
+	0, 0, 22, 392, 0, 425, 430, 437, 438, 945,
+	946, 947, 480, 33, 433, 950, 0, 952, 0, 942,
+	943, 0, 938, 939, 0, 0, 0, 0, 0, 0,
+	0, 892, 0, 977, 0, 846, 847, 848, 849, 850,
+	851, 852, 853, 854, 855, 856, 857, 858, 859, 860,
+	861, 862, 863, 864, 865, 866, 867, 868, 869, 870,
+	871, 872, 873, 874, 0, 0, 0, 0, 0, 0,
+	0, 769, 770, 771, 772, 773, 774, 775, 776, 777,
+	778, 779, 780, 954, 965, 966, 0, 0, 0, 0,
+	0, 963, 958, 0, 785, 0, 802, 806, 0, 0,
+	936, 0, 943, 0, 901, 0, 0, 0, 0, 0,
+	0, 0, 341, 343, 0, 0, 341, 0, 0, 0,
+	350, 0, 319, 0, 0, 307, 228, 393, 203, 204,
+	460, 0, 453, 470, 0, 0, 0, 0, 0, 522,
+	0, 0, 525, 89, 0, 71, 0, 64, 65, 24,
+	361, 0, 396, 397, 33, 402, 0, 0, 675, 499,
+	0, 510, 511, 512, 513, 514, 0, 0, 0, 0,
+	0, 560, 561, 562, 563, 572, 1053, 1053, 1053, 0,
+	284, 427, 427, 289, 303, 234, 243, 243, 235, 0,
+	0, 236, 241, 242, 0, 238, 249, 0, 190, 131,
+	132, 133, 129, 130, 136, 0, 261, 147, 377, 377,
+	0, 383, 316, 672, 0, 463, 423, 0, 0, 0,
+	951, 839, 0, 0, 0, 0, 0, 0, 828, 822,
+	823, 893, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 955, 963, 959, 0, 956, 0, 0, 944, 0,
+	807, 0, 0, 0, 0, 840, 0, 877, 880, 0,
+	885, 0, 887, 888, 0, 0, 339, 0, 344, 345,
+	341, 328, 335, 327, 337, 332, 333, 352, 714, 1058,
+	1055, 1056, 212, 201, 0, 0, 471, 73, 76, 77,
+	78, 528, 0, 529, 508, 70, 0, 0, 363, 52,
+	0, 0, 0, 0, 0, 0, 676, 677, 679, 680,
+	0, 682, 737, 0, 691, 567, 691, 0, 0, 693,
+	694, 501, 502, 0, 0, 0, 0, 0, 516, 517,
+	518, 519, 520, 0, 0, 1054, 0, 0, 287, 285,
+	427, -2, 1045, 986, 987, 988, 1032, 990, 1036, 0,
+	1032, 1032, 1018, 1019, 1020, 1021, 1022, 1023, 1024, 1025,
+	1026, 0, 0, 1009, 1032, 1034, 1032, 1032, 1029, 991,
+	992, 993, 994, 995, 996, 997, 998, 999, 1000, 1001,
+	1002, 1003, 1004, 1039, 1039, 1042, 1039, 0, 587, 0,
+	0, 245, 250, 251, 0, 255, 0, 0, 149, 374,
+	378, 0, 375, 0, 390, 417, 0, 0, 439, 34,
+	434, 944, 824, 825, 826, 0, 809, 1032, 1036, 812,
+	1032, 1032, 1032, 818, 818, 827, 0, 829, 830, 833,
+	831, 834, 835, 821, 940, 957, 0, 964, 960, 786,
+	794, 803, 0, 0, 0, 0, 0, 0, 832, 0,
+	340, 0, 326, 461, 472, 532, 0, 0, 71, 0,
+	0, 365, 0, 362, 0, 0, 0, 495, 391, -2,
+	0, 681, 738, 703, 703, 692, 703, 703, 567, 0,
+	503, 504, 505, 506, 507, 515, 0, 573, 574, 668,
+	669, 670, 575, 288, 705, -2, 717, 719, 0, 0,
+	722, 723, 0, 0, 0, 0, 0, 760, 730, 0,
+	0, 975, 976, 0, 736, 1048, 1046, 1047, 989, 1033,
+	0, 1014, 0, 1015, 1016, 1017, 0, 0, 1010, 1011,
+	0, 1012, 1013, 1005, 0, 1006, 1007, 0, 1008, 240,
+	0, 599, 237, 252, 253, 254, 0, 257, 258, 260,
+	0, 379, 376, 424, 426, 795, 810, 811, 813, 814,
+	815, 816, 819, 820, 817, 843, 961, 808, 796, 797,
+	841, 0, 881, 886, 323, 346, 530, 531, 68, 72,
+	54, 367, 0, 364, 0, 358, 360, 62, 0, 581,
+	-2, 621, 1053, 1053, 0, 1053, 1053, 1053, 1053, 0,
+	0, 1053, 1053, 1053, 1053, 1053, 1053, 1053, 1053, 1053,
+	1053, 1053, 1053, 1053, 1053, 0, 678, 0, 695, 704,
+	0, 704, 0, 0, 703, 0, 0, 555, 1032, 0,
+	718, 720, 721, 724, 725, 726, 765, 766, 767, 727,
+	762, 763, 764, 728, 729, 0, 0, 973, 974, 758,
+	985, 1049, 0, 0, 0, 1030, 0, 0, 0, 0,
+	588, -2, 0, 0, 259, 246, 0, 48, 0, 356,
+	0, 366, 53, 0, 0, 578, 0, 576, 623, 0,
+	0, 1053, 1053, 0, 0, 0, 0, 1053, 1053, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 689, 548, 0, 404, 0, 0, 0, 753, 0,
+	1038, 1037, 1027, 0, 1028, 1035, 1040, 0, 1043, 0,
+	589, -2, 0, 597, 0, 0, 256, 842, 46, 50,
+	55, 56, 0, 0, 0, 0, 0, 0, 496, 617,
+	622, 624, 625, 0, 0, 628, 629, 630, 631, 0,
+	0, 634, 635, 636, 637, 638, 639, 640, 641, 642,
+	643, 659, 660, 661, 662, 663, 664, 644, 645, 646,
+	647, 648, 649, 656, 0, 0, 653, 0, 0, 696,
+	698, 699, 700, 701, 702, 697, 0, 0, 0, 0,
+	688, 690, 733, 0, 547, 556, 557, 404, 761, 0,
+	740, 754, 0, 0, 1031, 0, 0, 590, 598, 0,
+	593, 0, 0, 0, 35, 0, 52, 0, 57, 0,
+	0, 0, 0, 0, 369, 359, 606, 0, 0, 612,
+	0, 618, 626, 627, 632, 633, 650, 0, 0, 652,
+	0, 0, 548, 548, 548, 548, 0, 734, 549, 1053,
+	0, 0, 553, 554, 558, 731, 739, 741, 742, 743,
+	0, 755, 756, 757, 759, 1041, 1044, 592, 0, -2,
+	0, 601, 0, 26, 0, 0, 49, 0, 58, 0,
+	60, 61, 368, 0, 583, 0, 0, 0, 0, 0,
+	615, 0, 657, 658, 651, 654, 655, 683, 684, 685,
+	686, 0, 0, 551, 0, 732, 744, 0, 745, 0,
+	0, 0, 594, -2, 0, 0, 603, 25, 0, 36,
+	0, 38, 40, 41, 706, 47, 51, 59, 370, 585,
+	0, 607, 0, 0, 0, 0, 0, 0, 0, 687,
+	550, 552, 746, 748, 749, 0, 0, 747, 600, 602,
+	27, 28, 0, 37, 0, 0, 582, 0, 617, 608,
+	0, 610, 0, 0, 0, 0, 750, 752, 751, 0,
+	0, 39, 707, 0, 604, 609, 611, 0, 616, 614,
+	29, 30, 31, 0, 586, 584, 0, 613, 708, 605,
 }
 
 var yyTok1 = [...]int{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 82, 3, 3, 3, 110, 102, 3,
-	54, 56, 107, 105, 55, 106, 119, 108, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 442,
-	90, 89, 91, 3, 443, 3, 3, 3, 3, 3,
+	3, 3, 3, 83, 3, 3, 3, 111, 103, 3,
+	55, 57, 108, 106, 56, 107, 120, 109, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 450,
+	91, 90, 92, 3, 451, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 112, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 113, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 101, 3, 113,
+	3, 3, 3, 3, 102, 3, 114,
 }
 
 var yyTok2 = [...]int{
@@ -4337,12 +4729,12 @@ var yyTok2 = [...]int{
 	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
 	32, 33, 34, 35, 36, 37, 38, 39, 40, 41,
 	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
-	52, 53, 57, 58, 59, 60, 61, 62, 63, 64,
+	52, 53, 54, 58, 59, 60, 61, 62, 63, 64,
 	65, 66, 67, 68, 69, 70, 71, 72, 73, 74,
-	75, 76, 77, 78, 79, 80, 81, 83, 84, 85,
-	86, 87, 88, 92, 93, 94, 95, 96, 97, 98,
-	99, 100, 103, 104, 109, 111, 114, 115, 116, 117,
-	118, 120, 121, 122, 123, 124, 125, 126, 127, 128,
+	75, 76, 77, 78, 79, 80, 81, 82, 84, 85,
+	86, 87, 88, 89, 93, 94, 95, 96, 97, 98,
+	99, 100, 101, 104, 105, 110, 112, 115, 116, 117,
+	118, 119, 121, 122, 123, 124, 125, 126, 127, 128,
 	129, 130, 131, 132, 133, 134, 135, 136, 137, 138,
 	139, 140, 141, 142, 143, 144, 145, 146, 147, 148,
 	149, 150, 151, 152, 153, 154, 155, 156, 157, 158,
@@ -4394,7 +4786,9 @@ var yyTok3 = [...]int{
 	57750, 425, 57751, 426, 57752, 427, 57753, 428, 57754, 429,
 	57755, 430, 57756, 431, 57757, 432, 57758, 433, 57759, 434,
 	57760, 435, 57761, 436, 57762, 437, 57763, 438, 57764, 439,
-	57765, 440, 57766, 441, 0,
+	57765, 440, 57766, 441, 57767, 442, 57768, 443, 57769, 444,
+	57770, 445, 57771, 446, 57772, 447, 57773, 448, 57774, 449,
+	0,
 }
 
 var yyErrorMessages = [...]struct {
@@ -4744,7 +5138,7 @@ yydefault:
 
 	case 2:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:502
+//line mysql_sql.y:520
 		{
 			if yyDollar[1].statementUnion() != nil {
 				yylex.(*Lexer).AppendStmt(yyDollar[1].statementUnion())
@@ -4752,32 +5146,48 @@ yydefault:
 		}
 	case 3:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:508
+//line mysql_sql.y:526
 		{
 			if yyDollar[3].statementUnion() != nil {
 				yylex.(*Lexer).AppendStmt(yyDollar[3].statementUnion())
 			}
 		}
-	case 19:
+	case 21:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:531
+//line mysql_sql.y:551
 		{
 			yyLOCAL = yyDollar[1].selectUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 20:
+	case 22:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:535
+//line mysql_sql.y:555
 		{
 			yyLOCAL = tree.Statement(nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 21:
+	case 23:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:561
+		{
+			yyLOCAL = tree.NewBackup(yyDollar[3].str, "")
+		}
+		yyVAL.union = yyLOCAL
+	case 24:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:565
+		{
+			yyLOCAL = tree.NewBackup(yyDollar[3].str, yyDollar[6].str)
+		}
+		yyVAL.union = yyLOCAL
+	case 25:
 		yyDollar = yyS[yypt-14 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:541
+//line mysql_sql.y:571
 		{
 			yyLOCAL = &tree.Load{
 				Local:             yyDollar[3].boolValUnion(),
@@ -4792,42 +5202,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 22:
+	case 26:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:556
+//line mysql_sql.y:586
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 23:
+	case 27:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:560
+//line mysql_sql.y:590
 		{
 			yyLOCAL = yyDollar[2].updateExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 24:
+	case 28:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:566
+//line mysql_sql.y:596
 		{
 			yyLOCAL = tree.UpdateExprs{yyDollar[1].updateExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 25:
+	case 29:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:570
+//line mysql_sql.y:600
 		{
 			yyLOCAL = append(yyDollar[1].updateExprsUnion(), yyDollar[3].updateExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 26:
+	case 30:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:576
+//line mysql_sql.y:606
 		{
 			yyLOCAL = &tree.UpdateExpr{
 				Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()},
@@ -4835,10 +5245,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 27:
+	case 31:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:583
+//line mysql_sql.y:613
 		{
 			yyLOCAL = &tree.UpdateExpr{
 				Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()},
@@ -4846,58 +5256,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 28:
+	case 32:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:592
+//line mysql_sql.y:622
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 29:
+	case 33:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:596
+//line mysql_sql.y:626
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 30:
+	case 34:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:600
+//line mysql_sql.y:630
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str, yyDollar[5].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 31:
+	case 35:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:605
+//line mysql_sql.y:635
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 32:
+	case 36:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:609
+//line mysql_sql.y:639
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 33:
+	case 37:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:613
+//line mysql_sql.y:643
 		{
 			yyLOCAL = yyDollar[2].loadColumnsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 34:
+	case 38:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:619
+//line mysql_sql.y:649
 		{
 			switch yyDollar[1].loadColumnUnion().(type) {
 			case *tree.UnresolvedName:
@@ -4907,10 +5317,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 35:
+	case 39:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:628
+//line mysql_sql.y:658
 		{
 			switch yyDollar[3].loadColumnUnion().(type) {
 			case *tree.UnresolvedName:
@@ -4920,42 +5330,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 36:
+	case 40:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.LoadColumn
-//line mysql_sql.y:639
+//line mysql_sql.y:669
 		{
 			yyLOCAL = yyDollar[1].unresolvedNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 37:
+	case 41:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.LoadColumn
-//line mysql_sql.y:643
+//line mysql_sql.y:673
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 38:
+	case 42:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:649
+//line mysql_sql.y:679
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 39:
+	case 43:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:653
+//line mysql_sql.y:683
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 40:
+	case 44:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:659
+//line mysql_sql.y:689
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var isGlobal bool
@@ -4978,10 +5388,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 41:
+	case 45:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:683
+//line mysql_sql.y:713
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var r string
@@ -5000,34 +5410,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 42:
+	case 46:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:702
+//line mysql_sql.y:732
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 43:
+	case 47:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:706
+//line mysql_sql.y:736
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 44:
+	case 48:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:711
+//line mysql_sql.y:741
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 45:
+	case 49:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:715
+//line mysql_sql.y:745
 		{
 			yyLOCAL = &tree.Lines{
 				StartingBy:   yyDollar[2].str,
@@ -5035,42 +5445,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 46:
+	case 50:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:723
+//line mysql_sql.y:753
 		{
 			yyVAL.str = ""
 		}
-	case 47:
+	case 51:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:727
+//line mysql_sql.y:757
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 48:
+	case 52:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:732
+//line mysql_sql.y:762
 		{
 			yyVAL.str = "\n"
 		}
-	case 49:
+	case 53:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:736
+//line mysql_sql.y:766
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 50:
+	case 54:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:741
+//line mysql_sql.y:771
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 51:
+	case 55:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:745
+//line mysql_sql.y:775
 		{
 			res := &tree.Fields{
 				Terminated: "\t",
@@ -5093,36 +5503,36 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 52:
+	case 56:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Fields
-//line mysql_sql.y:769
+//line mysql_sql.y:799
 		{
 			yyLOCAL = []*tree.Fields{yyDollar[1].fieldsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 53:
+	case 57:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.Fields
-//line mysql_sql.y:773
+//line mysql_sql.y:803
 		{
 			yyLOCAL = append(yyDollar[1].fieldsListUnion(), yyDollar[2].fieldsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 54:
+	case 58:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:779
+//line mysql_sql.y:809
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: yyDollar[3].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 55:
+	case 59:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:785
+//line mysql_sql.y:815
 		{
 			str := yyDollar[4].str
 			if str != "\\" && len(str) > 1 {
@@ -5141,10 +5551,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 56:
+	case 60:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:803
+//line mysql_sql.y:833
 		{
 			str := yyDollar[3].str
 			if str != "\\" && len(str) > 1 {
@@ -5162,10 +5572,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 57:
+	case 61:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:820
+//line mysql_sql.y:850
 		{
 			str := yyDollar[3].str
 			if str != "\\" && len(str) > 1 {
@@ -5183,50 +5593,50 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 59:
+	case 63:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:843
+//line mysql_sql.y:873
 		{
 			yyLOCAL = &tree.DuplicateKeyError{}
 		}
 		yyVAL.union = yyLOCAL
-	case 60:
+	case 64:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:847
+//line mysql_sql.y:877
 		{
 			yyLOCAL = &tree.DuplicateKeyIgnore{}
 		}
 		yyVAL.union = yyLOCAL
-	case 61:
+	case 65:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:851
+//line mysql_sql.y:881
 		{
 			yyLOCAL = &tree.DuplicateKeyReplace{}
 		}
 		yyVAL.union = yyLOCAL
-	case 62:
+	case 66:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:856
+//line mysql_sql.y:886
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 63:
+	case 67:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:860
+//line mysql_sql.y:890
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 64:
+	case 68:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:866
+//line mysql_sql.y:896
 		{
 			yyLOCAL = &tree.Grant{
 				Privileges:  yyDollar[2].privilegesUnion(),
@@ -5237,10 +5647,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 65:
+	case 69:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:876
+//line mysql_sql.y:906
 		{
 			yyLOCAL = &tree.Grant{
 				IsGrantRole:      true,
@@ -5249,10 +5659,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 66:
+	case 70:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:884
+//line mysql_sql.y:914
 		{
 			yyLOCAL = &tree.Grant{
 				IsProxy:     true,
@@ -5262,26 +5672,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 67:
+	case 71:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:894
+//line mysql_sql.y:924
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 68:
+	case 72:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:898
+//line mysql_sql.y:928
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 69:
+	case 73:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:908
+//line mysql_sql.y:938
 		{
 			yyLOCAL = &tree.Revoke{
 				Privileges: yyDollar[2].privilegesUnion(),
@@ -5292,10 +5702,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 70:
+	case 74:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:918
+//line mysql_sql.y:948
 		{
 			yyLOCAL = &tree.Revoke{
 				IsRevokeRole:      true,
@@ -5304,30 +5714,30 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 71:
+	case 75:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:928
+//line mysql_sql.y:958
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level: tree.PRIVILEGE_LEVEL_TYPE_DATABASE,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 72:
+	case 76:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:934
+//line mysql_sql.y:964
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level: tree.PRIVILEGE_LEVEL_TYPE_GLOBAL,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 73:
+	case 77:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:940
+//line mysql_sql.y:970
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:  tree.PRIVILEGE_LEVEL_TYPE_DATABASE,
@@ -5335,10 +5745,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 74:
+	case 78:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:947
+//line mysql_sql.y:977
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:   tree.PRIVILEGE_LEVEL_TYPE_TABLE,
@@ -5347,10 +5757,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 75:
+	case 79:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:955
+//line mysql_sql.y:985
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:   tree.PRIVILEGE_LEVEL_TYPE_TABLE,
@@ -5358,58 +5768,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 76:
+	case 80:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:963
+//line mysql_sql.y:993
 		{
 			yyLOCAL = tree.OBJECT_TYPE_NONE
 		}
 		yyVAL.union = yyLOCAL
-	case 77:
+	case 81:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:967
+//line mysql_sql.y:997
 		{
 			yyLOCAL = tree.OBJECT_TYPE_TABLE
 		}
 		yyVAL.union = yyLOCAL
-	case 78:
+	case 82:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:971
+//line mysql_sql.y:1001
 		{
 			yyLOCAL = tree.OBJECT_TYPE_FUNCTION
 		}
 		yyVAL.union = yyLOCAL
-	case 79:
+	case 83:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:975
+//line mysql_sql.y:1005
 		{
 			yyLOCAL = tree.OBJECT_TYPE_PROCEDURE
 		}
 		yyVAL.union = yyLOCAL
-	case 80:
+	case 84:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Privilege
-//line mysql_sql.y:981
+//line mysql_sql.y:1011
 		{
 			yyLOCAL = []*tree.Privilege{yyDollar[1].privilegeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 81:
+	case 85:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Privilege
-//line mysql_sql.y:985
+//line mysql_sql.y:1015
 		{
 			yyLOCAL = append(yyDollar[1].privilegesUnion(), yyDollar[3].privilegeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 82:
+	case 86:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Privilege
-//line mysql_sql.y:991
+//line mysql_sql.y:1021
 		{
 			yyLOCAL = &tree.Privilege{
 				Type:       yyDollar[1].privilegeTypeUnion(),
@@ -5417,10 +5827,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 83:
+	case 87:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Privilege
-//line mysql_sql.y:998
+//line mysql_sql.y:1028
 		{
 			yyLOCAL = &tree.Privilege{
 				Type:       yyDollar[1].privilegeTypeUnion(),
@@ -5428,418 +5838,474 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 84:
+	case 88:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.UnresolvedName
-//line mysql_sql.y:1007
+//line mysql_sql.y:1037
 		{
 			yyLOCAL = []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 85:
+	case 89:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.UnresolvedName
-//line mysql_sql.y:1011
+//line mysql_sql.y:1041
 		{
 			yyLOCAL = append(yyDollar[1].unresolveNamesUnion(), yyDollar[3].unresolvedNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 86:
+	case 90:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1017
+//line mysql_sql.y:1047
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 87:
+	case 91:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1021
+//line mysql_sql.y:1051
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 88:
+	case 92:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1025
+//line mysql_sql.y:1055
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER
 		}
 		yyVAL.union = yyLOCAL
-	case 89:
+	case 93:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1029
+//line mysql_sql.y:1059
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE
 		}
 		yyVAL.union = yyLOCAL
-	case 90:
+	case 94:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1033
+//line mysql_sql.y:1063
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_USER
 		}
 		yyVAL.union = yyLOCAL
-	case 91:
+	case 95:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1037
+//line mysql_sql.y:1067
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_TABLESPACE
 		}
 		yyVAL.union = yyLOCAL
-	case 92:
+	case 96:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1041
+//line mysql_sql.y:1071
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_TRIGGER
 		}
 		yyVAL.union = yyLOCAL
-	case 93:
+	case 97:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1045
+//line mysql_sql.y:1075
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DELETE
 		}
 		yyVAL.union = yyLOCAL
-	case 94:
+	case 98:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1049
+//line mysql_sql.y:1079
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP
 		}
 		yyVAL.union = yyLOCAL
-	case 95:
+	case 99:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1053
+//line mysql_sql.y:1083
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_EXECUTE
 		}
 		yyVAL.union = yyLOCAL
-	case 96:
+	case 100:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1057
+//line mysql_sql.y:1087
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_INDEX
 		}
 		yyVAL.union = yyLOCAL
-	case 97:
+	case 101:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1061
+//line mysql_sql.y:1091
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_INSERT
 		}
 		yyVAL.union = yyLOCAL
-	case 98:
+	case 102:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1065
+//line mysql_sql.y:1095
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SELECT
 		}
 		yyVAL.union = yyLOCAL
-	case 99:
+	case 103:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1069
+//line mysql_sql.y:1099
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SUPER
 		}
 		yyVAL.union = yyLOCAL
-	case 100:
+	case 104:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1073
+//line mysql_sql.y:1103
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHOW_DATABASES
 		}
 		yyVAL.union = yyLOCAL
-	case 101:
+	case 105:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1077
+//line mysql_sql.y:1107
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_UPDATE
 		}
 		yyVAL.union = yyLOCAL
-	case 102:
+	case 106:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1081
+//line mysql_sql.y:1111
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_GRANT_OPTION
 		}
 		yyVAL.union = yyLOCAL
-	case 103:
+	case 107:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1085
+//line mysql_sql.y:1115
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REFERENCES
 		}
 		yyVAL.union = yyLOCAL
-	case 104:
+	case 108:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1089
+//line mysql_sql.y:1119
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REPLICATION_SLAVE
 		}
 		yyVAL.union = yyLOCAL
-	case 105:
+	case 109:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1093
+//line mysql_sql.y:1123
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REPLICATION_CLIENT
 		}
 		yyVAL.union = yyLOCAL
-	case 106:
+	case 110:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1097
+//line mysql_sql.y:1127
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_USAGE
 		}
 		yyVAL.union = yyLOCAL
-	case 107:
+	case 111:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1101
+//line mysql_sql.y:1131
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_RELOAD
 		}
 		yyVAL.union = yyLOCAL
-	case 108:
+	case 112:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1105
+//line mysql_sql.y:1135
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_FILE
 		}
 		yyVAL.union = yyLOCAL
-	case 109:
+	case 113:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1109
+//line mysql_sql.y:1139
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_TEMPORARY_TABLES
 		}
 		yyVAL.union = yyLOCAL
-	case 110:
+	case 114:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1113
+//line mysql_sql.y:1143
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_LOCK_TABLES
 		}
 		yyVAL.union = yyLOCAL
-	case 111:
+	case 115:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1117
+//line mysql_sql.y:1147
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_VIEW
 		}
 		yyVAL.union = yyLOCAL
-	case 112:
+	case 116:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1121
+//line mysql_sql.y:1151
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHOW_VIEW
 		}
 		yyVAL.union = yyLOCAL
-	case 113:
+	case 117:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1125
+//line mysql_sql.y:1155
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_ROLE
 		}
 		yyVAL.union = yyLOCAL
-	case 114:
+	case 118:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1129
+//line mysql_sql.y:1159
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP_ROLE
 		}
 		yyVAL.union = yyLOCAL
-	case 115:
+	case 119:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1133
+//line mysql_sql.y:1163
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_ROUTINE
 		}
 		yyVAL.union = yyLOCAL
-	case 116:
+	case 120:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1137
+//line mysql_sql.y:1167
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER_ROUTINE
 		}
 		yyVAL.union = yyLOCAL
-	case 117:
+	case 121:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1141
+//line mysql_sql.y:1171
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_EVENT
 		}
 		yyVAL.union = yyLOCAL
-	case 118:
+	case 122:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1145
+//line mysql_sql.y:1175
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHUTDOWN
 		}
 		yyVAL.union = yyLOCAL
-	case 123:
+	case 128:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1188
+		{
+			yyLOCAL = tree.NewSetTransaction(tree.SET_TRANSACTION_SCOPE_NEXT, yyDollar[5].isolationLevelUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 129:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1192
+		{
+			yyLOCAL = tree.NewSetTransaction(tree.SET_TRANSACTION_SCOPE_SESSION, yyDollar[6].isolationLevelUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 130:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1196
+		{
+			yyLOCAL = tree.NewSetTransaction(tree.SET_TRANSACTION_SCOPE_GLOBAL, yyDollar[6].isolationLevelUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 131:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.IsolationLevel
+//line mysql_sql.y:1202
+		{
+			yyLOCAL = tree.ISOLATION_LEVEL_READ_UNCOMMITTED
+		}
+		yyVAL.union = yyLOCAL
+	case 132:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.IsolationLevel
+//line mysql_sql.y:1206
+		{
+			yyLOCAL = tree.ISOLATION_LEVEL_READ_COMMITTED
+		}
+		yyVAL.union = yyLOCAL
+	case 133:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.IsolationLevel
+//line mysql_sql.y:1210
+		{
+			yyLOCAL = tree.ISOLATION_LEVEL_REPEATABLE_READ
+		}
+		yyVAL.union = yyLOCAL
+	case 134:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.IsolationLevel
+//line mysql_sql.y:1214
+		{
+			yyLOCAL = tree.ISOLATION_LEVEL_SERIALIZABLE
+		}
+		yyVAL.union = yyLOCAL
+	case 135:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1157
+//line mysql_sql.y:1220
 		{
 			yyLOCAL = yyDollar[3].setRoleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 124:
+	case 136:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1163
+//line mysql_sql.y:1226
 		{
 			dr := yyDollar[4].setDefaultRoleUnion()
 			dr.Users = yyDollar[6].usersUnion()
 			yyLOCAL = dr
 		}
 		yyVAL.union = yyLOCAL
-	case 125:
+	case 137:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1171
+//line mysql_sql.y:1234
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_ALL_EXCEPT, Roles: yyDollar[3].rolesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 126:
+	case 138:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1175
+//line mysql_sql.y:1238
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_DEFAULT, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 127:
+	case 139:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1179
+//line mysql_sql.y:1242
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_NONE, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 128:
+	case 140:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1183
+//line mysql_sql.y:1246
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_ALL, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 129:
+	case 141:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1187
+//line mysql_sql.y:1250
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_NORMAL, Roles: yyDollar[1].rolesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 130:
+	case 142:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1193
+//line mysql_sql.y:1256
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_NONE, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 131:
+	case 143:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1197
+//line mysql_sql.y:1260
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_ALL, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 132:
+	case 144:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1201
+//line mysql_sql.y:1264
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_NORMAL, Roles: yyDollar[1].rolesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 133:
+	case 145:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1207
+//line mysql_sql.y:1270
 		{
 			yyLOCAL = &tree.SetVar{Assignments: yyDollar[2].varAssignmentExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 134:
+	case 146:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1213
+//line mysql_sql.y:1276
 		{
 			yyLOCAL = &tree.SetPassword{Password: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 135:
+	case 147:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1217
+//line mysql_sql.y:1280
 		{
 			yyLOCAL = &tree.SetPassword{User: yyDollar[4].userUnion(), Password: yyDollar[6].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 137:
+	case 149:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line mysql_sql.y:1224
+//line mysql_sql.y:1287
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 138:
+	case 150:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.VarAssignmentExpr
-//line mysql_sql.y:1230
+//line mysql_sql.y:1293
 		{
 			yyLOCAL = []*tree.VarAssignmentExpr{yyDollar[1].varAssignmentExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 139:
+	case 151:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.VarAssignmentExpr
-//line mysql_sql.y:1234
+//line mysql_sql.y:1297
 		{
 			yyLOCAL = append(yyDollar[1].varAssignmentExprsUnion(), yyDollar[3].varAssignmentExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 140:
+	case 152:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1240
+//line mysql_sql.y:1303
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -5848,10 +6314,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 141:
+	case 153:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1248
+//line mysql_sql.y:1311
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -5861,10 +6327,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 142:
+	case 154:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1257
+//line mysql_sql.y:1320
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -5873,10 +6339,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 143:
+	case 155:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1265
+//line mysql_sql.y:1328
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -5885,10 +6351,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 144:
+	case 156:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1273
+//line mysql_sql.y:1336
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var isGlobal bool
@@ -5912,10 +6378,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 145:
+	case 157:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1296
+//line mysql_sql.y:1359
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -5923,10 +6389,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 146:
+	case 158:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1303
+//line mysql_sql.y:1366
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -5934,10 +6400,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 147:
+	case 159:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1310
+//line mysql_sql.y:1373
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:     yyDollar[1].str,
@@ -5946,10 +6412,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 148:
+	case 160:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1318
+//line mysql_sql.y:1381
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -5957,10 +6423,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 149:
+	case 161:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1325
+//line mysql_sql.y:1388
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -5968,10 +6434,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 150:
+	case 162:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1332
+//line mysql_sql.y:1395
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -5979,198 +6445,246 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 153:
+	case 165:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:1345
+//line mysql_sql.y:1408
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false)
 		}
 		yyVAL.union = yyLOCAL
-	case 154:
+	case 166:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:1349
+//line mysql_sql.y:1412
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false)
 		}
 		yyVAL.union = yyLOCAL
-	case 155:
+	case 167:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:1353
+//line mysql_sql.y:1416
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 156:
+	case 168:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1359
+//line mysql_sql.y:1422
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 157:
+	case 169:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1363
+//line mysql_sql.y:1426
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 159:
+	case 171:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:1370
+//line mysql_sql.y:1433
 		{
 			yyVAL.str = yyDollar[1].str + "." + yyDollar[3].str
 		}
-	case 163:
+	case 177:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1381
+//line mysql_sql.y:1446
 		{
 			yyLOCAL = &tree.RollbackTransaction{Type: yyDollar[2].completionTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 164:
+	case 178:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1450
+		{
+			yyLOCAL = &tree.RollbackToSavepoint{Name: yyDollar[3].str}
+		}
+		yyVAL.union = yyLOCAL
+	case 179:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1454
+		{
+			yyLOCAL = &tree.RollbackToSavepoint{Name: yyDollar[4].str}
+		}
+		yyVAL.union = yyLOCAL
+	case 180:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1458
+		{
+			yyLOCAL = &tree.RollbackToSavepoint{Name: yyDollar[4].str}
+		}
+		yyVAL.union = yyLOCAL
+	case 181:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1462
+		{
+			yyLOCAL = &tree.RollbackToSavepoint{Name: yyDollar[5].str}
+		}
+		yyVAL.union = yyLOCAL
+	case 182:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1387
+//line mysql_sql.y:1468
+		{
+			yyLOCAL = &tree.Savepoint{Name: yyDollar[2].str}
+		}
+		yyVAL.union = yyLOCAL
+	case 183:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1474
+		{
+			yyLOCAL = &tree.ReleaseSavepoint{Name: yyDollar[3].str}
+		}
+		yyVAL.union = yyLOCAL
+	case 184:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1480
 		{
 			yyLOCAL = &tree.CommitTransaction{Type: yyDollar[2].completionTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 165:
+	case 185:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1392
+//line mysql_sql.y:1485
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 166:
+	case 186:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1396
+//line mysql_sql.y:1489
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 167:
+	case 187:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1400
+//line mysql_sql.y:1493
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 168:
+	case 188:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1404
+//line mysql_sql.y:1497
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_RELEASE
 		}
 		yyVAL.union = yyLOCAL
-	case 169:
+	case 189:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1408
+//line mysql_sql.y:1501
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_RELEASE
 		}
 		yyVAL.union = yyLOCAL
-	case 170:
+	case 190:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1412
+//line mysql_sql.y:1505
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 171:
+	case 191:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1416
+//line mysql_sql.y:1509
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 172:
+	case 192:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1420
+//line mysql_sql.y:1513
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 173:
+	case 193:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1426
+//line mysql_sql.y:1519
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 174:
+	case 194:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1430
+//line mysql_sql.y:1523
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 175:
+	case 195:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1434
+//line mysql_sql.y:1527
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 176:
+	case 196:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1438
+//line mysql_sql.y:1531
 		{
 			m := tree.MakeTransactionModes(tree.READ_WRITE_MODE_READ_WRITE)
 			yyLOCAL = &tree.BeginTransaction{Modes: m}
 		}
 		yyVAL.union = yyLOCAL
-	case 177:
+	case 197:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1443
+//line mysql_sql.y:1536
 		{
 			m := tree.MakeTransactionModes(tree.READ_WRITE_MODE_READ_ONLY)
 			yyLOCAL = &tree.BeginTransaction{Modes: m}
 		}
 		yyVAL.union = yyLOCAL
-	case 178:
+	case 198:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1448
+//line mysql_sql.y:1541
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 179:
+	case 199:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1454
+//line mysql_sql.y:1547
 		{
 			yyLOCAL = &tree.Use{Name: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 180:
+	case 200:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1458
+//line mysql_sql.y:1551
 		{
 			yyLOCAL = &tree.Use{}
 		}
 		yyVAL.union = yyLOCAL
-	case 181:
+	case 201:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1464
+//line mysql_sql.y:1557
 		{
 			yyLOCAL = &tree.Update{
 				Table:   yyDollar[2].tableExprUnion(),
@@ -6181,84 +6695,84 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 182:
+	case 202:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:1477
+//line mysql_sql.y:1570
 		{
 			yyLOCAL = tree.UpdateExprs{yyDollar[1].updateExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 183:
+	case 203:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:1481
+//line mysql_sql.y:1574
 		{
 			yyLOCAL = append(yyDollar[1].updateExprsUnion(), yyDollar[3].updateExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 184:
+	case 204:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:1487
+//line mysql_sql.y:1580
 		{
 			yyLOCAL = &tree.UpdateExpr{Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()}, Expr: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 188:
+	case 208:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1496
+//line mysql_sql.y:1589
 		{
 			yyLOCAL = yyDollar[1].selectUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 189:
+	case 209:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1502
+//line mysql_sql.y:1595
 		{
 			st := &tree.ShowColumns{Table: yyDollar[2].unresolvedObjectNameUnion()}
 			yyLOCAL = tree.NewExplainStmt(st, "")
 		}
 		yyVAL.union = yyLOCAL
-	case 190:
+	case 210:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1507
+//line mysql_sql.y:1600
 		{
 			st := &tree.ShowColumns{Table: yyDollar[2].unresolvedObjectNameUnion(), ColName: yyDollar[3].unresolvedNameUnion()}
 			yyLOCAL = tree.NewExplainStmt(st, "")
 		}
 		yyVAL.union = yyLOCAL
-	case 191:
+	case 211:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1512
+//line mysql_sql.y:1605
 		{
 			yyLOCAL = tree.NewExplainFor("", uint64(yyDollar[4].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 192:
+	case 212:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1516
+//line mysql_sql.y:1609
 		{
 			yyLOCAL = tree.NewExplainFor(yyDollar[4].str, uint64(yyDollar[7].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 193:
+	case 213:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1520
+//line mysql_sql.y:1613
 		{
 			yyLOCAL = tree.NewExplainStmt(yyDollar[2].statementUnion(), "text")
 		}
 		yyVAL.union = yyLOCAL
-	case 194:
+	case 214:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1524
+//line mysql_sql.y:1617
 		{
 			explainStmt := tree.NewExplainStmt(yyDollar[3].statementUnion(), "text")
 			optionElem := tree.MakeOptionElem("verbose", "NULL")
@@ -6268,10 +6782,10 @@ yydefault:
 
 		}
 		yyVAL.union = yyLOCAL
-	case 195:
+	case 215:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1533
+//line mysql_sql.y:1626
 		{
 			explainStmt := tree.NewExplainStmt(yyDollar[3].statementUnion(), "text")
 			optionElem := tree.MakeOptionElem("analyze", "NULL")
@@ -6280,10 +6794,10 @@ yydefault:
 			yyLOCAL = explainStmt
 		}
 		yyVAL.union = yyLOCAL
-	case 196:
+	case 216:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1541
+//line mysql_sql.y:1634
 		{
 			explainStmt := tree.NewExplainStmt(yyDollar[4].statementUnion(), "text")
 			optionElem1 := tree.MakeOptionElem("analyze", "NULL")
@@ -6294,76 +6808,177 @@ yydefault:
 			yyLOCAL = explainStmt
 		}
 		yyVAL.union = yyLOCAL
-	case 197:
+	case 217:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1551
+//line mysql_sql.y:1644
 		{
 			explainStmt := tree.NewExplainStmt(yyDollar[5].statementUnion(), "text")
 			explainStmt.Options = yyDollar[3].epxlainOptionsUnion()
 			yyLOCAL = explainStmt
 		}
 		yyVAL.union = yyLOCAL
-	case 201:
+	case 221:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.OptionElem
-//line mysql_sql.y:1564
+//line mysql_sql.y:1657
 		{
 			yyLOCAL = tree.MakeOptions(yyDollar[1].epxlainOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 202:
+	case 222:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.OptionElem
-//line mysql_sql.y:1568
+//line mysql_sql.y:1661
 		{
 			yyLOCAL = append(yyDollar[1].epxlainOptionsUnion(), yyDollar[3].epxlainOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 203:
+	case 223:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.OptionElem
-//line mysql_sql.y:1574
+//line mysql_sql.y:1667
 		{
 			yyLOCAL = tree.MakeOptionElem(yyDollar[1].str, yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 204:
+	case 224:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1580
+//line mysql_sql.y:1673
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 205:
+	case 225:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1585
+//line mysql_sql.y:1678
 		{
 			yyVAL.str = "true"
 		}
-	case 206:
+	case 226:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1586
+//line mysql_sql.y:1679
 		{
 			yyVAL.str = "false"
 		}
-	case 207:
+	case 227:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1587
+//line mysql_sql.y:1680
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 208:
+	case 228:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1592
+//line mysql_sql.y:1685
 		{
 			yyLOCAL = tree.NewAnalyzeStmt(yyDollar[3].tableNameUnion(), yyDollar[5].identifierListUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 210:
+	case 231:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1696
+		{
+			yyLOCAL = tree.NewAlterTable(yyDollar[3].tableNameUnion(), yyDollar[4].alterTableOptionsUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 232:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1700
+		{
+			yyLOCAL = tree.NewAlterTable(yyDollar[3].tableNameUnion(), []tree.AlterTableOption{yyDollar[4].alterTableOptionUnion()})
+		}
+		yyVAL.union = yyLOCAL
+	case 233:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []tree.AlterTableOption
+//line mysql_sql.y:1706
+		{
+			yyLOCAL = []tree.AlterTableOption{yyDollar[1].alterTableOptionUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 234:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []tree.AlterTableOption
+//line mysql_sql.y:1710
+		{
+			yyLOCAL = append(yyDollar[1].alterTableOptionsUnion(), yyDollar[3].alterTableOptionUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 235:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:1716
+		{
+			yyLOCAL = &tree.AlterTableAddColumn{Column: yyDollar[3].columnTableDefUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 236:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:1720
+		{
+			yyLOCAL = &tree.AlterTableDropColumn{Name: tree.Identifier(yyDollar[3].str)}
+		}
+		yyVAL.union = yyLOCAL
+	case 237:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:1724
+		{
+			yyLOCAL = &tree.AlterTableRenameColumn{Name: tree.Identifier(yyDollar[3].str), NewName: tree.Identifier(yyDollar[5].str)}
+		}
+		yyVAL.union = yyLOCAL
+	case 238:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:1728
+		{
+			yyLOCAL = &tree.AlterTableModifyColumn{Column: yyDollar[3].columnTableDefUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 239:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:1732
+		{
+			yyLOCAL = &tree.AlterTableCompact{}
+		}
+		yyVAL.union = yyLOCAL
+	case 240:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:1738
+		{
+			yyLOCAL = &tree.AlterTableAddPartition{Partitions: yyDollar[4].partitionsUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 241:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:1742
+		{
+			yyLOCAL = &tree.AlterTableDropPartition{Names: yyDollar[3].identifierListUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 242:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:1746
+		{
+			yyLOCAL = &tree.AlterTableTruncatePartition{Names: yyDollar[3].identifierListUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 243:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line mysql_sql.y:1751
+		{
+		}
+	case 245:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1602
+//line mysql_sql.y:1756
 		{
 			yyLOCAL = &tree.AlterUser{
 				IfExists:   yyDollar[3].boolValUnion(),
@@ -6375,10 +6990,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 211:
+	case 246:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1613
+//line mysql_sql.y:1767
 		{
 			auth := &tree.User{
 				AuthString: yyDollar[9].str,
@@ -6391,118 +7006,118 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 212:
+	case 247:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1626
+//line mysql_sql.y:1780
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 213:
+	case 248:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1630
+//line mysql_sql.y:1784
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 214:
+	case 249:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.UserMiscOption
-//line mysql_sql.y:1635
+//line mysql_sql.y:1789
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 215:
+	case 250:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.UserMiscOption
-//line mysql_sql.y:1639
+//line mysql_sql.y:1793
 		{
 			yyLOCAL = yyDollar[1].userMiscOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 216:
+	case 251:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.UserMiscOption
-//line mysql_sql.y:1645
+//line mysql_sql.y:1799
 		{
 			yyLOCAL = []tree.UserMiscOption{yyDollar[1].userMiscOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 217:
+	case 252:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.UserMiscOption
-//line mysql_sql.y:1649
+//line mysql_sql.y:1803
 		{
 			yyLOCAL = append(yyDollar[1].userMiscOptionsUnion(), yyDollar[2].userMiscOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 218:
+	case 253:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1655
+//line mysql_sql.y:1809
 		{
 			yyLOCAL = &tree.UserMiscOptionAccountUnlock{}
 		}
 		yyVAL.union = yyLOCAL
-	case 219:
+	case 254:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1659
+//line mysql_sql.y:1813
 		{
 			yyLOCAL = &tree.UserMiscOptionAccountLock{}
 		}
 		yyVAL.union = yyLOCAL
-	case 220:
+	case 255:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1663
+//line mysql_sql.y:1817
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireNone{}
 		}
 		yyVAL.union = yyLOCAL
-	case 221:
+	case 256:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1667
+//line mysql_sql.y:1821
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireInterval{Value: yyDollar[3].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 222:
+	case 257:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1671
+//line mysql_sql.y:1825
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireNever{}
 		}
 		yyVAL.union = yyLOCAL
-	case 223:
+	case 258:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1675
+//line mysql_sql.y:1829
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireDefault{}
 		}
 		yyVAL.union = yyLOCAL
-	case 224:
+	case 259:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:1681
+//line mysql_sql.y:1835
 		{
 			yyVAL.item = nil
 		}
-	case 225:
+	case 260:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:1686
+//line mysql_sql.y:1840
 		{
 			yyVAL.item = nil
 		}
-	case 237:
+	case 272:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1707
+//line mysql_sql.y:1861
 		{
 			yyLOCAL = &tree.ShowIndex{
 				TableName: *yyDollar[4].tableNameUnion(),
@@ -6510,10 +7125,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 241:
+	case 276:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1721
+//line mysql_sql.y:1875
 		{
 			yyLOCAL = &tree.ShowVariables{
 				Global: yyDollar[2].boolValUnion(),
@@ -6522,10 +7137,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 242:
+	case 277:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1731
+//line mysql_sql.y:1885
 		{
 			yyLOCAL = &tree.ShowStatus{
 				Global: yyDollar[2].boolValUnion(),
@@ -6534,58 +7149,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 243:
+	case 278:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1740
+//line mysql_sql.y:1894
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 244:
+	case 279:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1744
+//line mysql_sql.y:1898
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 245:
+	case 280:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1748
+//line mysql_sql.y:1902
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 246:
+	case 281:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1754
+//line mysql_sql.y:1908
 		{
 			yyLOCAL = &tree.ShowWarnings{}
 		}
 		yyVAL.union = yyLOCAL
-	case 247:
+	case 282:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1760
+//line mysql_sql.y:1914
 		{
 			yyLOCAL = &tree.ShowErrors{}
 		}
 		yyVAL.union = yyLOCAL
-	case 248:
+	case 283:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1766
+//line mysql_sql.y:1920
 		{
 			yyLOCAL = &tree.ShowProcessList{Full: yyDollar[2].fullOptUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 249:
+	case 284:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1772
+//line mysql_sql.y:1926
 		{
 			yyLOCAL = &tree.ShowTables{
 				Open:   false,
@@ -6596,10 +7211,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 250:
+	case 285:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1782
+//line mysql_sql.y:1936
 		{
 			yyLOCAL = &tree.ShowTables{
 				Open:   true,
@@ -6610,18 +7225,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 251:
+	case 286:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1794
+//line mysql_sql.y:1948
 		{
 			yyLOCAL = &tree.ShowDatabases{Like: yyDollar[3].comparisionExprUnion(), Where: yyDollar[4].whereUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 252:
+	case 287:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1800
+//line mysql_sql.y:1954
 		{
 			yyLOCAL = &tree.ShowColumns{
 				Ext:   false,
@@ -6634,10 +7249,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 253:
+	case 288:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1812
+//line mysql_sql.y:1966
 		{
 			yyLOCAL = &tree.ShowColumns{
 				Ext:   true,
@@ -6650,118 +7265,118 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 254:
+	case 289:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ComparisonExpr
-//line mysql_sql.y:1825
+//line mysql_sql.y:1979
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 255:
+	case 290:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ComparisonExpr
-//line mysql_sql.y:1829
+//line mysql_sql.y:1983
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.LIKE, nil, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 256:
+	case 291:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:1834
+//line mysql_sql.y:1988
 		{
 			yyVAL.str = ""
 		}
-	case 257:
+	case 292:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:1838
+//line mysql_sql.y:1992
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 258:
+	case 293:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1844
+//line mysql_sql.y:1998
 		{
 			yyLOCAL = yyDollar[2].unresolvedObjectNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 263:
+	case 298:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1857
+//line mysql_sql.y:2011
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 264:
+	case 299:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1861
+//line mysql_sql.y:2015
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 265:
+	case 300:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1867
+//line mysql_sql.y:2021
 		{
 			yyLOCAL = &tree.ShowCreateTable{Name: yyDollar[4].unresolvedObjectNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 266:
+	case 301:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1871
+//line mysql_sql.y:2025
 		{
 			yyLOCAL = &tree.ShowCreateDatabase{IfNotExists: yyDollar[4].ifNotExistsUnion(), Name: yyDollar[5].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 267:
+	case 302:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1877
+//line mysql_sql.y:2031
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(1, [3]string{yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 268:
+	case 303:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1881
+//line mysql_sql.y:2035
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(2, [3]string{yyDollar[3].str, yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 270:
+	case 305:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1890
+//line mysql_sql.y:2044
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(1, [3]string{yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 271:
+	case 306:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1894
+//line mysql_sql.y:2048
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(2, [3]string{yyDollar[3].str, yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 272:
+	case 307:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1898
+//line mysql_sql.y:2052
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(3, [3]string{yyDollar[5].str, yyDollar[3].str, yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 279:
+	case 314:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1914
+//line mysql_sql.y:2068
 		{
 			yyLOCAL = &tree.DropUser{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -6769,10 +7384,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 280:
+	case 315:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1923
+//line mysql_sql.y:2077
 		{
 			yyLOCAL = &tree.DropRole{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -6780,10 +7395,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 281:
+	case 316:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1932
+//line mysql_sql.y:2086
 		{
 			yyLOCAL = &tree.DropIndex{
 				Name:      tree.Identifier(yyDollar[4].str),
@@ -6792,26 +7407,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 282:
+	case 317:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1942
+//line mysql_sql.y:2096
 		{
 			yyLOCAL = &tree.DropTable{IfExists: yyDollar[3].boolValUnion(), Names: yyDollar[4].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 283:
+	case 318:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1948
+//line mysql_sql.y:2102
 		{
 			yyLOCAL = &tree.DropDatabase{Name: tree.Identifier(yyDollar[4].str), IfExists: yyDollar[3].boolValUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 284:
+	case 319:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1954
+//line mysql_sql.y:2108
 		{
 			yyLOCAL = &tree.Delete{
 				Table:   yyDollar[3].tableExprUnion(),
@@ -6821,21 +7436,50 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 285:
+	case 320:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:2123
+		{
+			ins := yyDollar[4].insertUnion()
+			ins.Table = yyDollar[2].tableExprUnion()
+			ins.PartitionNames = yyDollar[3].identifierListUnion()
+			ins.OnDuplicateUpdate = yyDollar[5].updateExprsUnion()
+			yyLOCAL = ins
+		}
+		yyVAL.union = yyLOCAL
+	case 321:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1969
+//line mysql_sql.y:2133
 		{
 			ins := yyDollar[4].insertUnion()
 			ins.Table = yyDollar[2].tableExprUnion()
 			ins.PartitionNames = yyDollar[3].identifierListUnion()
+			ins.IsReplace = true
 			yyLOCAL = ins
 		}
 		yyVAL.union = yyLOCAL
-	case 286:
+	case 322:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.UpdateExprs
+//line mysql_sql.y:2142
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 323:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.UpdateExprs
+//line mysql_sql.y:2146
+		{
+			yyLOCAL = yyDollar[5].updateExprsUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 324:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:1978
+//line mysql_sql.y:2152
 		{
 			vc := tree.NewValuesClause(yyDollar[2].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -6843,20 +7487,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 287:
+	case 325:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:1985
+//line mysql_sql.y:2159
 		{
 			yyLOCAL = &tree.Insert{
 				Rows: tree.NewSelect(yyDollar[1].selectUnion(), nil, nil),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 288:
+	case 326:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:1991
+//line mysql_sql.y:2165
 		{
 			vc := tree.NewValuesClause(yyDollar[5].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -6865,10 +7509,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 289:
+	case 327:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:1999
+//line mysql_sql.y:2173
 		{
 			vc := tree.NewValuesClause(yyDollar[4].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -6876,10 +7520,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 290:
+	case 328:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:2006
+//line mysql_sql.y:2180
 		{
 			yyLOCAL = &tree.Insert{
 				Columns: yyDollar[2].identifierListUnion(),
@@ -6887,10 +7531,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 291:
+	case 329:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:2013
+//line mysql_sql.y:2187
 		{
 			if yyDollar[2].assignmentsUnion() == nil {
 				yylex.Error("the set list of insert can not be empty")
@@ -6909,34 +7553,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 292:
+	case 330:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:2032
+//line mysql_sql.y:2206
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 293:
+	case 331:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:2036
+//line mysql_sql.y:2210
 		{
 			yyLOCAL = []*tree.Assignment{yyDollar[1].assignmentUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 294:
+	case 332:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:2040
+//line mysql_sql.y:2214
 		{
 			yyLOCAL = append(yyDollar[1].assignmentsUnion(), yyDollar[3].assignmentUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 295:
+	case 333:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Assignment
-//line mysql_sql.y:2046
+//line mysql_sql.y:2220
 		{
 			yyLOCAL = &tree.Assignment{
 				Column: tree.Identifier(yyDollar[1].str),
@@ -6944,155 +7588,155 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 296:
+	case 334:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2055
+//line mysql_sql.y:2229
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 297:
+	case 335:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2059
+//line mysql_sql.y:2233
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 298:
+	case 336:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2065
+//line mysql_sql.y:2239
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 299:
+	case 337:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:2069
+//line mysql_sql.y:2243
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 300:
+	case 338:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.Exprs
-//line mysql_sql.y:2075
+//line mysql_sql.y:2249
 		{
 			yyLOCAL = []tree.Exprs{yyDollar[1].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 301:
+	case 339:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.Exprs
-//line mysql_sql.y:2079
+//line mysql_sql.y:2253
 		{
 			yyLOCAL = append(yyDollar[1].rowsExprsUnion(), yyDollar[3].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 302:
+	case 340:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:2085
+//line mysql_sql.y:2259
 		{
 			yyLOCAL = yyDollar[3].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 303:
+	case 341:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2090
+//line mysql_sql.y:2264
 		{
 		}
-	case 305:
+	case 343:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:2094
+//line mysql_sql.y:2268
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 307:
+	case 345:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:2101
+//line mysql_sql.y:2275
 		{
 			yyLOCAL = tree.Exprs{yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 308:
+	case 346:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:2105
+//line mysql_sql.y:2279
 		{
 			yyLOCAL = append(yyDollar[1].exprsUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 310:
+	case 348:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:2112
+//line mysql_sql.y:2286
 		{
 			yyLOCAL = &tree.DefaultVal{}
 		}
 		yyVAL.union = yyLOCAL
-	case 311:
+	case 349:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2117
+//line mysql_sql.y:2291
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 312:
+	case 350:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2121
+//line mysql_sql.y:2295
 		{
 			yyLOCAL = yyDollar[3].identifierListUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 313:
+	case 351:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2127
+//line mysql_sql.y:2301
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 314:
+	case 352:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2131
+//line mysql_sql.y:2305
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 315:
+	case 353:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2137
+//line mysql_sql.y:2311
 		{
 			yyLOCAL = yyDollar[2].tableNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 316:
+	case 354:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2141
+//line mysql_sql.y:2315
 		{
 			yyLOCAL = yyDollar[1].tableNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 317:
+	case 355:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ExportParam
-//line mysql_sql.y:2146
+//line mysql_sql.y:2320
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 318:
+	case 356:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *tree.ExportParam
-//line mysql_sql.y:2150
+//line mysql_sql.y:2324
 		{
 			yyLOCAL = &tree.ExportParam{
 				Outfile:     true,
@@ -7105,10 +7749,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 319:
+	case 357:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:2163
+//line mysql_sql.y:2337
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: ",",
@@ -7116,10 +7760,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 320:
+	case 358:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:2170
+//line mysql_sql.y:2344
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: yyDollar[4].str,
@@ -7127,10 +7771,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 321:
+	case 359:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:2177
+//line mysql_sql.y:2351
 		{
 			str := yyDollar[7].str
 			if str != "\\" && len(str) > 1 {
@@ -7149,10 +7793,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 322:
+	case 360:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:2195
+//line mysql_sql.y:2369
 		{
 			str := yyDollar[4].str
 			if str != "\\" && len(str) > 1 {
@@ -7171,38 +7815,38 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 323:
+	case 361:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:2214
+//line mysql_sql.y:2388
 		{
 			yyLOCAL = &tree.Lines{
 				TerminatedBy: "\n",
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 324:
+	case 362:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:2220
+//line mysql_sql.y:2394
 		{
 			yyLOCAL = &tree.Lines{
 				TerminatedBy: yyDollar[2].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 325:
+	case 363:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2227
+//line mysql_sql.y:2401
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 326:
+	case 364:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2231
+//line mysql_sql.y:2405
 		{
 			str := strings.ToLower(yyDollar[2].str)
 			if str == "true" {
@@ -7215,115 +7859,171 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 327:
+	case 365:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:2244
+//line mysql_sql.y:2418
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 328:
+	case 366:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:2248
+//line mysql_sql.y:2422
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 329:
+	case 367:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2253
+//line mysql_sql.y:2427
 		{
 			yyLOCAL = []string{}
 		}
 		yyVAL.union = yyLOCAL
-	case 330:
+	case 368:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2257
+//line mysql_sql.y:2431
 		{
 			yyLOCAL = yyDollar[3].strsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 331:
+	case 369:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2264
+//line mysql_sql.y:2438
 		{
 			yyLOCAL = make([]string, 0, 4)
 			yyLOCAL = append(yyLOCAL, yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 332:
+	case 370:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2269
+//line mysql_sql.y:2443
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 334:
+	case 372:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2276
+//line mysql_sql.y:2450
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 335:
+	case 373:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *tree.SelectLock
+//line mysql_sql.y:2455
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 374:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.SelectLock
+//line mysql_sql.y:2459
+		{
+			yyLOCAL = &tree.SelectLock{Mode: tree.SELECT_LOCK_FOR_UPDATE, Wait: yyDollar[3].selectLockWaitUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 375:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.SelectLock
+//line mysql_sql.y:2463
+		{
+			yyLOCAL = &tree.SelectLock{Mode: tree.SELECT_LOCK_FOR_SHARE, Wait: yyDollar[3].selectLockWaitUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 376:
 		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.SelectLock
+//line mysql_sql.y:2467
+		{
+			yyLOCAL = &tree.SelectLock{Mode: tree.SELECT_LOCK_IN_SHARE_MODE}
+		}
+		yyVAL.union = yyLOCAL
+	case 377:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.SelectLockWait
+//line mysql_sql.y:2472
+		{
+			yyLOCAL = tree.SELECT_LOCK_WAIT
+		}
+		yyVAL.union = yyLOCAL
+	case 378:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.SelectLockWait
+//line mysql_sql.y:2476
+		{
+			yyLOCAL = tree.SELECT_LOCK_NOWAIT
+		}
+		yyVAL.union = yyLOCAL
+	case 379:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.SelectLockWait
+//line mysql_sql.y:2480
+		{
+			yyLOCAL = tree.SELECT_LOCK_SKIP_LOCKED
+		}
+		yyVAL.union = yyLOCAL
+	case 380:
+		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2282
+//line mysql_sql.y:2486
 		{
-			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Limit: yyDollar[3].limitUnion(), Ep: yyDollar[4].exportParmUnion()}
+			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Limit: yyDollar[3].limitUnion(), Ep: yyDollar[4].exportParmUnion(), Lock: yyDollar[5].selectLockUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 336:
+	case 381:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2286
+//line mysql_sql.y:2490
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Ep: yyDollar[3].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 337:
+	case 382:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2290
+//line mysql_sql.y:2494
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Limit: yyDollar[3].limitUnion(), Ep: yyDollar[4].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 338:
-		yyDollar = yyS[yypt-5 : yypt+1]
+	case 383:
+		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2294
+//line mysql_sql.y:2498
 		{
-			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Limit: yyDollar[4].limitUnion(), Ep: yyDollar[5].exportParmUnion(), With: yyDollar[1].withClauseUnion()}
+			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Limit: yyDollar[4].limitUnion(), Ep: yyDollar[5].exportParmUnion(), With: yyDollar[1].withClauseUnion(), Lock: yyDollar[6].selectLockUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 339:
+	case 384:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2298
+//line mysql_sql.y:2502
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Ep: yyDollar[4].exportParmUnion(), With: yyDollar[1].withClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 340:
+	case 385:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2302
+//line mysql_sql.y:2506
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Limit: yyDollar[4].limitUnion(), Ep: yyDollar[5].exportParmUnion(), With: yyDollar[1].withClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 341:
+	case 386:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.With
-//line mysql_sql.y:2308
+//line mysql_sql.y:2512
 		{
 			yyLOCAL = &tree.With{
 				IsRecursive: false,
@@ -7331,10 +8031,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 342:
+	case 387:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.With
-//line mysql_sql.y:2315
+//line mysql_sql.y:2519
 		{
 			yyLOCAL = &tree.With{
 				IsRecursive: true,
@@ -7342,26 +8042,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 343:
+	case 388:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.CTE
-//line mysql_sql.y:2324
+//line mysql_sql.y:2528
 		{
 			yyLOCAL = []*tree.CTE{yyDollar[1].cteUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 344:
+	case 389:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.CTE
-//line mysql_sql.y:2328
+//line mysql_sql.y:2532
 		{
 			yyLOCAL = append(yyDollar[1].cteListUnion(), yyDollar[3].cteUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 345:
+	case 390:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.CTE
-//line mysql_sql.y:2334
+//line mysql_sql.y:2538
 		{
 			yyLOCAL = &tree.CTE{
 				Name: &tree.AliasClause{Alias: tree.Identifier(yyDollar[1].str), Cols: yyDollar[2].identifierListUnion()},
@@ -7369,162 +8069,162 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 346:
+	case 391:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2342
+//line mysql_sql.y:2546
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 347:
+	case 392:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2346
+//line mysql_sql.y:2550
 		{
 			yyLOCAL = yyDollar[2].identifierListUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 348:
+	case 393:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2351
+//line mysql_sql.y:2555
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 349:
+	case 394:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2355
+//line mysql_sql.y:2559
 		{
 			yyLOCAL = yyDollar[1].limitUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 350:
+	case 395:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2361
+//line mysql_sql.y:2565
 		{
 			yyLOCAL = &tree.Limit{Count: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 351:
+	case 396:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2365
+//line mysql_sql.y:2569
 		{
 			yyLOCAL = &tree.Limit{Offset: yyDollar[2].exprUnion(), Count: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 352:
+	case 397:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2369
+//line mysql_sql.y:2573
 		{
 			yyLOCAL = &tree.Limit{Offset: yyDollar[4].exprUnion(), Count: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 353:
+	case 398:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2374
+//line mysql_sql.y:2578
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 354:
+	case 399:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2378
+//line mysql_sql.y:2582
 		{
 			yyLOCAL = yyDollar[1].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 355:
+	case 400:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2384
+//line mysql_sql.y:2588
 		{
 			yyLOCAL = yyDollar[3].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 356:
+	case 401:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2390
+//line mysql_sql.y:2594
 		{
 			yyLOCAL = tree.OrderBy{yyDollar[1].orderUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 357:
+	case 402:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2394
+//line mysql_sql.y:2598
 		{
 			yyLOCAL = append(yyDollar[1].orderByUnion(), yyDollar[3].orderUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 358:
+	case 403:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Order
-//line mysql_sql.y:2400
+//line mysql_sql.y:2604
 		{
 			yyLOCAL = &tree.Order{Expr: yyDollar[1].exprUnion(), Direction: yyDollar[2].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 359:
+	case 404:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:2405
+//line mysql_sql.y:2609
 		{
 			yyLOCAL = tree.DefaultDirection
 		}
 		yyVAL.union = yyLOCAL
-	case 360:
+	case 405:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:2409
+//line mysql_sql.y:2613
 		{
 			yyLOCAL = tree.Ascending
 		}
 		yyVAL.union = yyLOCAL
-	case 361:
+	case 406:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:2413
+//line mysql_sql.y:2617
 		{
 			yyLOCAL = tree.Descending
 		}
 		yyVAL.union = yyLOCAL
-	case 362:
+	case 407:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2420
+//line mysql_sql.y:2624
 		{
 			yyLOCAL = &tree.ParenSelect{Select: yyDollar[2].selectUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 363:
+	case 408:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2424
+//line mysql_sql.y:2628
 		{
 			yyLOCAL = &tree.ParenSelect{Select: &tree.Select{Select: yyDollar[2].selectStatementUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 364:
+	case 409:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2430
+//line mysql_sql.y:2634
 		{
 			yyLOCAL = yyDollar[1].selectStatementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 365:
+	case 410:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2434
+//line mysql_sql.y:2638
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -7535,10 +8235,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 366:
+	case 411:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2444
+//line mysql_sql.y:2648
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -7549,10 +8249,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 367:
+	case 412:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2454
+//line mysql_sql.y:2658
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -7563,10 +8263,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 368:
+	case 413:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2464
+//line mysql_sql.y:2668
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -7577,10 +8277,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 369:
+	case 414:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2476
+//line mysql_sql.y:2680
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -7589,10 +8289,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 370:
+	case 415:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2484
+//line mysql_sql.y:2688
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -7601,10 +8301,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 371:
+	case 416:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2492
+//line mysql_sql.y:2696
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -7613,10 +8313,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 372:
+	case 417:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2502
+//line mysql_sql.y:2706
 		{
 			yyLOCAL = &tree.SelectClause{
 				Distinct: yyDollar[2].boolValUnion(),
@@ -7628,130 +8328,130 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 373:
+	case 418:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2514
+//line mysql_sql.y:2718
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 374:
+	case 419:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2518
+//line mysql_sql.y:2722
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 375:
+	case 420:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2522
+//line mysql_sql.y:2726
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 378:
+	case 423:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:2531
+//line mysql_sql.y:2735
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 379:
+	case 424:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:2535
+//line mysql_sql.y:2739
 		{
 			yyLOCAL = &tree.Where{Type: tree.AstHaving, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 380:
+	case 425:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.GroupBy
-//line mysql_sql.y:2540
+//line mysql_sql.y:2744
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 381:
+	case 426:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.GroupBy
-//line mysql_sql.y:2544
+//line mysql_sql.y:2748
 		{
 			yyLOCAL = tree.GroupBy(yyDollar[3].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 382:
+	case 427:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:2549
+//line mysql_sql.y:2753
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 383:
+	case 428:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:2553
+//line mysql_sql.y:2757
 		{
 			yyLOCAL = &tree.Where{Type: tree.AstWhere, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 384:
+	case 429:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectExprs
-//line mysql_sql.y:2559
+//line mysql_sql.y:2763
 		{
 			yyLOCAL = tree.SelectExprs{yyDollar[1].selectExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 385:
+	case 430:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectExprs
-//line mysql_sql.y:2563
+//line mysql_sql.y:2767
 		{
 			yyLOCAL = append(yyDollar[1].selectExprsUnion(), yyDollar[3].selectExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 386:
+	case 431:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:2569
+//line mysql_sql.y:2773
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.StarExpr()}
 		}
 		yyVAL.union = yyLOCAL
-	case 387:
+	case 432:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:2573
+//line mysql_sql.y:2777
 		{
 			yyLOCAL = tree.SelectExpr{Expr: yyDollar[1].exprUnion(), As: tree.UnrestrictedIdentifier(yyDollar[2].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 388:
+	case 433:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:2577
+//line mysql_sql.y:2781
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.SetUnresolvedNameWithStar(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 389:
+	case 434:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:2581
+//line mysql_sql.y:2785
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.SetUnresolvedNameWithStar(yyDollar[3].str, yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 390:
+	case 435:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:2587
+//line mysql_sql.y:2791
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			tn := tree.NewTableName(tree.Identifier("dual"), prefix)
@@ -7760,44 +8460,44 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 391:
+	case 436:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:2595
+//line mysql_sql.y:2799
 		{
 			yyLOCAL = yyDollar[1].fromUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 392:
+	case 437:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:2601
+//line mysql_sql.y:2805
 		{
 			yyLOCAL = &tree.From{
 				Tables: yyDollar[2].tableExprsUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 393:
+	case 438:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExprs
-//line mysql_sql.y:2609
+//line mysql_sql.y:2813
 		{
 			yyLOCAL = tree.TableExprs{yyDollar[1].tableExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 394:
+	case 439:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableExprs
-//line mysql_sql.y:2613
+//line mysql_sql.y:2817
 		{
 			yyLOCAL = append(yyDollar[1].tableExprsUnion(), yyDollar[3].tableExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 397:
+	case 442:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2623
+//line mysql_sql.y:2827
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -7807,10 +8507,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 398:
+	case 443:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2632
+//line mysql_sql.y:2836
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -7820,10 +8520,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 399:
+	case 444:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2642
+//line mysql_sql.y:2846
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -7833,10 +8533,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 400:
+	case 445:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2651
+//line mysql_sql.y:2855
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -7845,15 +8545,15 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 401:
+	case 446:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2661
+//line mysql_sql.y:2865
 		{
 			yyVAL.str = tree.JOIN_TYPE_NATURAL
 		}
-	case 402:
+	case 447:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2665
+//line mysql_sql.y:2869
 		{
 			if yyDollar[2].str == tree.JOIN_TYPE_LEFT {
 				yyVAL.str = tree.JOIN_TYPE_NATURAL_LEFT
@@ -7861,130 +8561,130 @@ yydefault:
 				yyVAL.str = tree.JOIN_TYPE_NATURAL_RIGHT
 			}
 		}
-	case 403:
+	case 448:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2675
+//line mysql_sql.y:2879
 		{
 			yyVAL.str = tree.JOIN_TYPE_LEFT
 		}
-	case 404:
+	case 449:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:2679
+//line mysql_sql.y:2883
 		{
 			yyVAL.str = tree.JOIN_TYPE_LEFT
 		}
-	case 405:
+	case 450:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2683
+//line mysql_sql.y:2887
 		{
 			yyVAL.str = tree.JOIN_TYPE_RIGHT
 		}
-	case 406:
+	case 451:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:2687
+//line mysql_sql.y:2891
 		{
 			yyVAL.str = tree.JOIN_TYPE_RIGHT
 		}
-	case 407:
+	case 452:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2693
+//line mysql_sql.y:2897
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 408:
+	case 453:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2697
+//line mysql_sql.y:2901
 		{
 			yyLOCAL = &tree.OnJoinCond{Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 409:
+	case 454:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2703
+//line mysql_sql.y:2907
 		{
 			yyVAL.str = tree.JOIN_TYPE_STRAIGHT
 		}
-	case 410:
+	case 455:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2709
+//line mysql_sql.y:2913
 		{
 			yyVAL.str = tree.JOIN_TYPE_INNER
 		}
-	case 411:
+	case 456:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2713
+//line mysql_sql.y:2917
 		{
 			yyVAL.str = tree.JOIN_TYPE_INNER
 		}
-	case 412:
+	case 457:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2717
+//line mysql_sql.y:2921
 		{
 			yyVAL.str = tree.JOIN_TYPE_CROSS
 		}
-	case 413:
+	case 458:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2723
+//line mysql_sql.y:2927
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 414:
+	case 459:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2727
+//line mysql_sql.y:2931
 		{
 			yyLOCAL = yyDollar[1].joinCondUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 415:
+	case 460:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2733
+//line mysql_sql.y:2937
 		{
 			yyLOCAL = &tree.OnJoinCond{Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 416:
+	case 461:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2737
+//line mysql_sql.y:2941
 		{
 			yyLOCAL = &tree.UsingJoinCond{Cols: yyDollar[3].identifierListUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 417:
+	case 462:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2743
+//line mysql_sql.y:2947
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 418:
+	case 463:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2747
+//line mysql_sql.y:2951
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 419:
+	case 464:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2753
+//line mysql_sql.y:2957
 		{
 			yyLOCAL = yyDollar[1].aliasedTableExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 420:
+	case 465:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2757
+//line mysql_sql.y:2961
 		{
 			yyLOCAL = &tree.AliasedTableExpr{
 				Expr: yyDollar[1].parenTableExprUnion(),
@@ -7995,28 +8695,28 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 421:
+	case 466:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.ParenTableExpr
-//line mysql_sql.y:2770
+//line mysql_sql.y:2974
 		{
 			yyLOCAL = &tree.ParenTableExpr{Expr: yyDollar[2].selectUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 422:
+	case 467:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2775
+//line mysql_sql.y:2979
 		{
 		}
-	case 423:
+	case 468:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2776
+//line mysql_sql.y:2980
 		{
 		}
-	case 424:
+	case 469:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.AliasedTableExpr
-//line mysql_sql.y:2780
+//line mysql_sql.y:2984
 		{
 			yyLOCAL = &tree.AliasedTableExpr{
 				Expr: yyDollar[1].tableNameUnion(),
@@ -8026,46 +8726,85 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 425:
+	case 470:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.AliasedTableExpr
+//line mysql_sql.y:2993
+		{
+			yyLOCAL = &tree.AliasedTableExpr{
+				Expr: yyDollar[1].tableNameUnion(),
+				AsOf: &tree.AsOfClause{Expr: yyDollar[5].exprUnion()},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 471:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL *tree.AliasedTableExpr
+//line mysql_sql.y:3000
+		{
+			yyLOCAL = &tree.AliasedTableExpr{
+				Expr: yyDollar[1].tableNameUnion(),
+				As: tree.AliasClause{
+					Alias: tree.Identifier(yyDollar[2].str),
+				},
+				AsOf: &tree.AsOfClause{Expr: yyDollar[6].exprUnion()},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 472:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL *tree.AliasedTableExpr
+//line mysql_sql.y:3010
+		{
+			yyLOCAL = &tree.AliasedTableExpr{
+				Expr: yyDollar[1].tableNameUnion(),
+				As: tree.AliasClause{
+					Alias: tree.Identifier(yyDollar[3].str),
+				},
+				AsOf: &tree.AsOfClause{Expr: yyDollar[7].exprUnion()},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 473:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2792
+//line mysql_sql.y:3023
 		{
 			yyVAL.str = ""
 		}
-	case 426:
+	case 474:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2796
+//line mysql_sql.y:3027
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 427:
+	case 475:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2800
+//line mysql_sql.y:3031
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 430:
+	case 478:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2809
+//line mysql_sql.y:3040
 		{
 			yyVAL.str = ""
 		}
-	case 431:
+	case 479:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2813
+//line mysql_sql.y:3044
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 432:
+	case 480:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2817
+//line mysql_sql.y:3048
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 447:
+	case 495:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2847
+//line mysql_sql.y:3078
 		{
 			yyLOCAL = &tree.CreateView{
 				Name:        yyDollar[5].tableNameUnion(),
@@ -8076,10 +8815,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 448:
+	case 496:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2857
+//line mysql_sql.y:3088
 		{
 			yyLOCAL = &tree.CreateView{
 				Name:        yyDollar[8].tableNameUnion(),
@@ -8090,15 +8829,15 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 449:
+	case 497:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2868
+//line mysql_sql.y:3099
 		{
 		}
-	case 451:
+	case 499:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2873
+//line mysql_sql.y:3104
 		{
 			yyLOCAL = &tree.CreateUser{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -8108,189 +8847,189 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 452:
+	case 500:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.ResourceOption
-//line mysql_sql.y:2883
+//line mysql_sql.y:3114
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 453:
+	case 501:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.ResourceOption
-//line mysql_sql.y:2887
+//line mysql_sql.y:3118
 		{
 			yyLOCAL = yyDollar[2].resourceOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 454:
+	case 502:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.ResourceOption
-//line mysql_sql.y:2893
+//line mysql_sql.y:3124
 		{
 			yyLOCAL = []tree.ResourceOption{yyDollar[1].resourceOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 455:
+	case 503:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.ResourceOption
-//line mysql_sql.y:2897
+//line mysql_sql.y:3128
 		{
 			yyLOCAL = append(yyDollar[1].resourceOptionsUnion(), yyDollar[2].resourceOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 456:
+	case 504:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ResourceOption
-//line mysql_sql.y:2903
+//line mysql_sql.y:3134
 		{
 			yyLOCAL = &tree.ResourceOptionMaxQueriesPerHour{Count: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 457:
+	case 505:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ResourceOption
-//line mysql_sql.y:2907
+//line mysql_sql.y:3138
 		{
 			yyLOCAL = &tree.ResourceOptionMaxUpdatesPerHour{Count: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 458:
+	case 506:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ResourceOption
-//line mysql_sql.y:2911
+//line mysql_sql.y:3142
 		{
 			yyLOCAL = &tree.ResourceOptionMaxConnectionPerHour{Count: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 459:
+	case 507:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ResourceOption
-//line mysql_sql.y:2915
+//line mysql_sql.y:3146
 		{
 			yyLOCAL = &tree.ResourceOptionMaxUserConnections{Count: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 460:
+	case 508:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2921
+//line mysql_sql.y:3152
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 462:
+	case 510:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2928
+//line mysql_sql.y:3159
 		{
 			t := &tree.TlsOptionNone{}
 			yyLOCAL = []tree.TlsOption{t}
 		}
 		yyVAL.union = yyLOCAL
-	case 463:
+	case 511:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2933
+//line mysql_sql.y:3164
 		{
 			t := &tree.TlsOptionSSL{}
 			yyLOCAL = []tree.TlsOption{t}
 		}
 		yyVAL.union = yyLOCAL
-	case 464:
+	case 512:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2938
+//line mysql_sql.y:3169
 		{
 			t := &tree.TlsOptionX509{}
 			yyLOCAL = []tree.TlsOption{t}
 		}
 		yyVAL.union = yyLOCAL
-	case 465:
+	case 513:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2943
+//line mysql_sql.y:3174
 		{
 			yyLOCAL = yyDollar[2].tlsOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 466:
+	case 514:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2949
+//line mysql_sql.y:3180
 		{
 			yyLOCAL = []tree.TlsOption{yyDollar[1].tlsOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 467:
+	case 515:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2953
+//line mysql_sql.y:3184
 		{
 			yyLOCAL = append(yyDollar[1].tlsOptionsUnion(), yyDollar[3].tlsOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 468:
+	case 516:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2957
+//line mysql_sql.y:3188
 		{
 			yyLOCAL = append(yyDollar[1].tlsOptionsUnion(), yyDollar[2].tlsOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 469:
+	case 517:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TlsOption
-//line mysql_sql.y:2963
+//line mysql_sql.y:3194
 		{
 			yyLOCAL = &tree.TlsOptionIssuer{Issuer: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 470:
+	case 518:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TlsOption
-//line mysql_sql.y:2967
+//line mysql_sql.y:3198
 		{
 			yyLOCAL = &tree.TlsOptionSubject{Subject: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 471:
+	case 519:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TlsOption
-//line mysql_sql.y:2971
+//line mysql_sql.y:3202
 		{
 			yyLOCAL = &tree.TlsOptionCipher{Cipher: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 472:
+	case 520:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TlsOption
-//line mysql_sql.y:2975
+//line mysql_sql.y:3206
 		{
 			yyLOCAL = &tree.TlsOptionSan{San: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 473:
+	case 521:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:2981
+//line mysql_sql.y:3212
 		{
 			yyLOCAL = []*tree.User{yyDollar[1].userUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 474:
+	case 522:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:2985
+//line mysql_sql.y:3216
 		{
 			yyLOCAL = append(yyDollar[1].usersUnion(), yyDollar[3].userUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 475:
+	case 523:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.User
-//line mysql_sql.y:2991
+//line mysql_sql.y:3222
 		{
 			yyLOCAL = &tree.User{
 				Username:   yyDollar[1].usernameRecordUnion().Username,
@@ -8302,42 +9041,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 476:
+	case 524:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:3004
+//line mysql_sql.y:3235
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: "%"}
 		}
 		yyVAL.union = yyLOCAL
-	case 477:
+	case 525:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:3008
+//line mysql_sql.y:3239
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 478:
+	case 526:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:3012
+//line mysql_sql.y:3243
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 479:
+	case 527:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:3017
+//line mysql_sql.y:3248
 		{
 			yyLOCAL = &tree.AuthRecord{}
 		}
 		yyVAL.union = yyLOCAL
-	case 480:
+	case 528:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:3021
+//line mysql_sql.y:3252
 		{
 			yyLOCAL = &tree.AuthRecord{
 				AuthString: yyDollar[3].str,
@@ -8345,20 +9084,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 481:
+	case 529:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:3028
+//line mysql_sql.y:3259
 		{
 			yyLOCAL = &tree.AuthRecord{
 				AuthPlugin: yyDollar[3].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 482:
+	case 530:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:3034
+//line mysql_sql.y:3265
 		{
 			yyLOCAL = &tree.AuthRecord{
 				AuthPlugin: yyDollar[3].str,
@@ -8367,10 +9106,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 483:
+	case 531:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:3042
+//line mysql_sql.y:3273
 		{
 			yyLOCAL = &tree.AuthRecord{
 				AuthPlugin: yyDollar[3].str,
@@ -8378,20 +9117,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 484:
+	case 532:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:3049
+//line mysql_sql.y:3280
 		{
 			yyLOCAL = &tree.AuthRecord{
 				HashString: yyDollar[4].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 487:
+	case 535:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3061
+//line mysql_sql.y:3292
 		{
 			yyLOCAL = &tree.CreateRole{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -8399,82 +9138,82 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 488:
+	case 536:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Role
-//line mysql_sql.y:3070
+//line mysql_sql.y:3301
 		{
 			yyLOCAL = []*tree.Role{yyDollar[1].roleUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 489:
+	case 537:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Role
-//line mysql_sql.y:3074
+//line mysql_sql.y:3305
 		{
 			yyLOCAL = append(yyDollar[1].rolesUnion(), yyDollar[3].roleUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 490:
+	case 538:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:3080
+//line mysql_sql.y:3311
 		{
 			yyLOCAL = &tree.Role{UserName: yyDollar[1].str, HostName: "%"}
 		}
 		yyVAL.union = yyLOCAL
-	case 491:
+	case 539:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:3084
+//line mysql_sql.y:3315
 		{
 			yyLOCAL = &tree.Role{UserName: yyDollar[1].str, HostName: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 492:
+	case 540:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:3088
+//line mysql_sql.y:3319
 		{
 			yyLOCAL = &tree.Role{UserName: yyDollar[1].str, HostName: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 495:
+	case 543:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:3097
+//line mysql_sql.y:3328
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_NONE
 		}
 		yyVAL.union = yyLOCAL
-	case 496:
+	case 544:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:3101
+//line mysql_sql.y:3332
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_FULLTEXT
 		}
 		yyVAL.union = yyLOCAL
-	case 497:
+	case 545:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:3105
+//line mysql_sql.y:3336
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_SPATIAL
 		}
 		yyVAL.union = yyLOCAL
-	case 498:
+	case 546:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:3109
+//line mysql_sql.y:3340
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_UNIQUE
 		}
 		yyVAL.union = yyLOCAL
-	case 499:
+	case 547:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3115
+//line mysql_sql.y:3346
 		{
 			var io *tree.IndexOption = nil
 			if yyDollar[11].indexOptionUnion() == nil && yyDollar[5].indexTypeUnion() != tree.INDEX_TYPE_INVALID {
@@ -8493,18 +9232,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 500:
+	case 548:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3134
+//line mysql_sql.y:3365
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 501:
+	case 549:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3138
+//line mysql_sql.y:3369
 		{
 			// Merge the options
 			if yyDollar[1].indexOptionUnion() == nil {
@@ -8525,123 +9264,123 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 502:
+	case 550:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3160
+//line mysql_sql.y:3391
 		{
 			yyLOCAL = &tree.IndexOption{KeyBlockSize: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 503:
+	case 551:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3164
+//line mysql_sql.y:3395
 		{
 			yyLOCAL = &tree.IndexOption{Comment: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 504:
+	case 552:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3168
+//line mysql_sql.y:3399
 		{
 			yyLOCAL = &tree.IndexOption{ParserName: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 505:
+	case 553:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3172
+//line mysql_sql.y:3403
 		{
 			yyLOCAL = &tree.IndexOption{Visible: tree.VISIBLE_TYPE_VISIBLE}
 		}
 		yyVAL.union = yyLOCAL
-	case 506:
+	case 554:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3176
+//line mysql_sql.y:3407
 		{
 			yyLOCAL = &tree.IndexOption{Visible: tree.VISIBLE_TYPE_INVISIBLE}
 		}
 		yyVAL.union = yyLOCAL
-	case 507:
+	case 555:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:3182
+//line mysql_sql.y:3413
 		{
 			yyLOCAL = []*tree.KeyPart{yyDollar[1].keyPartUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 508:
+	case 556:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:3186
+//line mysql_sql.y:3417
 		{
 			yyLOCAL = append(yyDollar[1].keyPartsUnion(), yyDollar[3].keyPartUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 509:
+	case 557:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.KeyPart
-//line mysql_sql.y:3192
+//line mysql_sql.y:3423
 		{
 			// Order is parsed but just ignored as MySQL did.
 			yyLOCAL = &tree.KeyPart{ColName: yyDollar[1].unresolvedNameUnion(), Length: int(yyDollar[2].lengthOptUnion()), Direction: yyDollar[3].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 510:
+	case 558:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.KeyPart
-//line mysql_sql.y:3197
+//line mysql_sql.y:3428
 		{
 			yyLOCAL = &tree.KeyPart{Expr: yyDollar[2].exprUnion(), Direction: yyDollar[4].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 511:
+	case 559:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3202
+//line mysql_sql.y:3433
 		{
 			yyLOCAL = tree.INDEX_TYPE_INVALID
 		}
 		yyVAL.union = yyLOCAL
-	case 512:
+	case 560:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3206
+//line mysql_sql.y:3437
 		{
 			yyLOCAL = tree.INDEX_TYPE_BTREE
 		}
 		yyVAL.union = yyLOCAL
-	case 513:
+	case 561:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3210
+//line mysql_sql.y:3441
 		{
 			yyLOCAL = tree.INDEX_TYPE_HASH
 		}
 		yyVAL.union = yyLOCAL
-	case 514:
+	case 562:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3214
+//line mysql_sql.y:3445
 		{
 			yyLOCAL = tree.INDEX_TYPE_RTREE
 		}
 		yyVAL.union = yyLOCAL
-	case 515:
+	case 563:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3218
+//line mysql_sql.y:3449
 		{
 			yyLOCAL = tree.INDEX_TYPE_BSI
 		}
 		yyVAL.union = yyLOCAL
-	case 516:
+	case 564:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3224
+//line mysql_sql.y:3455
 		{
 			yyLOCAL = &tree.CreateDatabase{
 				IfNotExists:   yyDollar[3].ifNotExistsUnion(),
@@ -8650,98 +9389,98 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 519:
+	case 567:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3238
+//line mysql_sql.y:3469
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 520:
+	case 568:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3242
+//line mysql_sql.y:3473
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 521:
+	case 569:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:3247
+//line mysql_sql.y:3478
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 522:
+	case 570:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:3251
+//line mysql_sql.y:3482
 		{
 			yyLOCAL = yyDollar[1].createOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 523:
+	case 571:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:3257
+//line mysql_sql.y:3488
 		{
 			yyLOCAL = []tree.CreateOption{yyDollar[1].createOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 524:
+	case 572:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:3261
+//line mysql_sql.y:3492
 		{
 			yyLOCAL = append(yyDollar[1].createOptionsUnion(), yyDollar[2].createOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 525:
+	case 573:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:3267
+//line mysql_sql.y:3498
 		{
 			yyLOCAL = &tree.CreateOptionCharset{IsDefault: yyDollar[1].defaultOptionalUnion(), Charset: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 526:
+	case 574:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:3271
+//line mysql_sql.y:3502
 		{
 			yyLOCAL = &tree.CreateOptionCollate{IsDefault: yyDollar[1].defaultOptionalUnion(), Collate: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 527:
+	case 575:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:3275
+//line mysql_sql.y:3506
 		{
 			yyLOCAL = &tree.CreateOptionEncryption{Encrypt: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 528:
+	case 576:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3280
+//line mysql_sql.y:3511
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 529:
+	case 577:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3284
+//line mysql_sql.y:3515
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 530:
+	case 578:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3290
+//line mysql_sql.y:3521
 		{
 			yyLOCAL = &tree.CreateTable{
 				Temporary:       yyDollar[2].boolValUnion(),
@@ -8753,34 +9492,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 531:
+	case 579:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3302
+//line mysql_sql.y:3533
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 532:
+	case 580:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3306
+//line mysql_sql.y:3537
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 533:
+	case 581:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.PartitionOption
-//line mysql_sql.y:3311
+//line mysql_sql.y:3542
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 534:
+	case 582:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.PartitionOption
-//line mysql_sql.y:3315
+//line mysql_sql.y:3546
 		{
 			yyDollar[3].partitionByUnion().Num = uint64(yyDollar[4].int64ValUnion())
 			yyLOCAL = &tree.PartitionOption{
@@ -8790,18 +9529,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 535:
+	case 583:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3325
+//line mysql_sql.y:3556
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 536:
+	case 584:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3329
+//line mysql_sql.y:3560
 		{
 			yyLOCAL = &tree.PartitionBy{
 				IsSubPartition: true,
@@ -8810,42 +9549,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 537:
+	case 585:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:3338
+//line mysql_sql.y:3569
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 538:
+	case 586:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:3342
+//line mysql_sql.y:3573
 		{
 			yyLOCAL = yyDollar[2].partitionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 539:
+	case 587:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:3348
+//line mysql_sql.y:3579
 		{
 			yyLOCAL = []*tree.Partition{yyDollar[1].partitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 540:
+	case 588:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:3352
+//line mysql_sql.y:3583
 		{
 			yyLOCAL = append(yyDollar[1].partitionsUnion(), yyDollar[3].partitionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 541:
+	case 589:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Partition
-//line mysql_sql.y:3358
+//line mysql_sql.y:3589
 		{
 			yyLOCAL = &tree.Partition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -8855,10 +9594,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 542:
+	case 590:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Partition
-//line mysql_sql.y:3367
+//line mysql_sql.y:3598
 		{
 			yyLOCAL = &tree.Partition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -8868,42 +9607,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 543:
+	case 591:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:3377
+//line mysql_sql.y:3608
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 544:
+	case 592:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:3381
+//line mysql_sql.y:3612
 		{
 			yyLOCAL = yyDollar[2].subPartitionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 545:
+	case 593:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:3387
+//line mysql_sql.y:3618
 		{
 			yyLOCAL = []*tree.SubPartition{yyDollar[1].subPartitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 546:
+	case 594:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:3391
+//line mysql_sql.y:3622
 		{
 			yyLOCAL = append(yyDollar[1].subPartitionsUnion(), yyDollar[3].subPartitionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 547:
+	case 595:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.SubPartition
-//line mysql_sql.y:3397
+//line mysql_sql.y:3628
 		{
 			yyLOCAL = &tree.SubPartition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -8911,10 +9650,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 548:
+	case 596:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.SubPartition
-//line mysql_sql.y:3404
+//line mysql_sql.y:3635
 		{
 			yyLOCAL = &tree.SubPartition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -8922,50 +9661,82 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 549:
+	case 597:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3413
+//line mysql_sql.y:3644
 		{
 			yyLOCAL = []tree.TableOption{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 550:
+	case 598:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3417
+//line mysql_sql.y:3648
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[2].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 551:
+	case 599:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:3422
+//line mysql_sql.y:3653
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 552:
+	case 600:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:3426
+//line mysql_sql.y:3657
 		{
 			yyLOCAL = &tree.ValuesLessThan{ValueList: yyDollar[5].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 553:
+	case 601:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Values
+//line mysql_sql.y:3661
+		{
+			yyLOCAL = &tree.ValuesLessThan{ValueList: tree.Exprs{tree.NewMaxValue()}}
+		}
+		yyVAL.union = yyLOCAL
+	case 602:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL tree.Values
+//line mysql_sql.y:3665
+		{
+			yyLOCAL = &tree.ValuesLessThan{ValueList: tree.Exprs{tree.NewMaxValue()}}
+		}
+		yyVAL.union = yyLOCAL
+	case 603:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Values
+//line mysql_sql.y:3669
+		{
+			valueList := make([]tree.Exprs, len(yyDollar[4].exprsUnion()))
+			for i, e := range yyDollar[4].exprsUnion() {
+				if tuple, ok := e.(*tree.Tuple); ok {
+					valueList[i] = tuple.Exprs
+				} else {
+					valueList[i] = tree.Exprs{e}
+				}
+			}
+			yyLOCAL = &tree.ValuesIn{ValueList: valueList}
+		}
+		yyVAL.union = yyLOCAL
+	case 604:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3431
+//line mysql_sql.y:3682
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 554:
+	case 605:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3435
+//line mysql_sql.y:3686
 		{
 			res := yyDollar[2].item.(int64)
 			if res == 0 {
@@ -8975,18 +9746,18 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 555:
+	case 606:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3445
+//line mysql_sql.y:3696
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 556:
+	case 607:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3449
+//line mysql_sql.y:3700
 		{
 			res := yyDollar[2].item.(int64)
 			if res == 0 {
@@ -8996,10 +9767,10 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 557:
+	case 608:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3460
+//line mysql_sql.y:3711
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.RangeType{
@@ -9008,10 +9779,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 558:
+	case 609:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3468
+//line mysql_sql.y:3719
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.RangeType{
@@ -9020,10 +9791,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 559:
+	case 610:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3476
+//line mysql_sql.y:3727
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.ListType{
@@ -9032,10 +9803,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 560:
+	case 611:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3484
+//line mysql_sql.y:3735
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.ListType{
@@ -9044,10 +9815,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 562:
+	case 613:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3495
+//line mysql_sql.y:3746
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.KeyType{
@@ -9058,10 +9829,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 563:
+	case 614:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3505
+//line mysql_sql.y:3756
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.HashType{
@@ -9071,500 +9842,508 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 564:
+	case 615:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3515
+//line mysql_sql.y:3766
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 565:
+	case 616:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3519
+//line mysql_sql.y:3770
 		{
 			yyLOCAL = yyDollar[3].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 566:
+	case 617:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3524
+//line mysql_sql.y:3775
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 567:
+	case 618:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3528
+//line mysql_sql.y:3779
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 568:
+	case 619:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3533
+//line mysql_sql.y:3784
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 569:
+	case 620:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3537
+//line mysql_sql.y:3788
 		{
 			yyLOCAL = yyDollar[1].tableOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 570:
+	case 621:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3543
+//line mysql_sql.y:3794
 		{
 			yyLOCAL = []tree.TableOption{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 571:
+	case 622:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3547
+//line mysql_sql.y:3798
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[3].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 572:
+	case 623:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3551
+//line mysql_sql.y:3802
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[2].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 573:
+	case 624:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3557
+//line mysql_sql.y:3808
 		{
 			yyLOCAL = tree.NewTableOptionAutoIncrement(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 574:
+	case 625:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3561
+//line mysql_sql.y:3812
 		{
 			yyLOCAL = tree.NewTableOptionAvgRowLength(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 575:
+	case 626:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3565
+//line mysql_sql.y:3816
 		{
 			yyLOCAL = tree.NewTableOptionCharset(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 576:
+	case 627:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3569
+//line mysql_sql.y:3820
 		{
 			yyLOCAL = tree.NewTableOptionCollate(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 577:
+	case 628:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3573
+//line mysql_sql.y:3824
 		{
 			yyLOCAL = tree.NewTableOptionChecksum(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 578:
+	case 629:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3577
+//line mysql_sql.y:3828
 		{
 			yyLOCAL = tree.NewTableOptionComment(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 579:
+	case 630:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3581
+//line mysql_sql.y:3832
 		{
 			yyLOCAL = tree.NewTableOptionCompression(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 580:
+	case 631:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3585
+//line mysql_sql.y:3836
 		{
 			yyLOCAL = tree.NewTableOptionConnection(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 581:
+	case 632:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3589
+//line mysql_sql.y:3840
 		{
 			yyLOCAL = tree.NewTableOptionDataDirectory(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 582:
+	case 633:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3593
+//line mysql_sql.y:3844
 		{
 			yyLOCAL = tree.NewTableOptionIndexDirectory(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 583:
+	case 634:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3597
+//line mysql_sql.y:3848
 		{
 			yyLOCAL = tree.NewTableOptionDelayKeyWrite(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 584:
+	case 635:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3601
+//line mysql_sql.y:3852
 		{
 			yyLOCAL = tree.NewTableOptionEncryption(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 585:
+	case 636:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3605
+//line mysql_sql.y:3856
 		{
 			yyLOCAL = tree.NewTableOptionEngine(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 586:
+	case 637:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3609
+//line mysql_sql.y:3860
 		{
 			yyLOCAL = tree.NewTableOptionKeyBlockSize(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 587:
+	case 638:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3613
+//line mysql_sql.y:3864
 		{
 			yyLOCAL = tree.NewTableOptionMaxRows(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 588:
+	case 639:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3617
+//line mysql_sql.y:3868
 		{
 			yyLOCAL = tree.NewTableOptionMinRows(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 589:
+	case 640:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3621
+//line mysql_sql.y:3872
 		{
 			yyLOCAL = &tree.TableOptionPackKeys{Value: yyDollar[3].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 590:
+	case 641:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3625
+//line mysql_sql.y:3876
 		{
 			yyLOCAL = &tree.TableOptionPackKeys{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 591:
+	case 642:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3629
+//line mysql_sql.y:3880
 		{
 			yyLOCAL = tree.NewTableOptionPassword(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 592:
+	case 643:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3633
+//line mysql_sql.y:3884
 		{
 			yyLOCAL = tree.NewTableOptionRowFormat(yyDollar[3].rowFormatTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 593:
+	case 644:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3637
+//line mysql_sql.y:3888
 		{
 			yyLOCAL = &tree.TableOptionStatsAutoRecalc{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 594:
+	case 645:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3641
+//line mysql_sql.y:3892
 		{
 			yyLOCAL = &tree.TableOptionStatsAutoRecalc{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 595:
+	case 646:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3645
+//line mysql_sql.y:3896
 		{
 			yyLOCAL = &tree.TableOptionStatsPersistent{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 596:
+	case 647:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3649
+//line mysql_sql.y:3900
 		{
 			yyLOCAL = &tree.TableOptionStatsPersistent{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 597:
+	case 648:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3653
+//line mysql_sql.y:3904
 		{
 			yyLOCAL = &tree.TableOptionStatsSamplePages{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 598:
+	case 649:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3657
+//line mysql_sql.y:3908
 		{
 			yyLOCAL = &tree.TableOptionStatsSamplePages{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 599:
+	case 650:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3661
+//line mysql_sql.y:3912
 		{
 			yyLOCAL = tree.NewTableOptionTablespace(yyDollar[3].str, yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 600:
+	case 651:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3665
+//line mysql_sql.y:3916
 		{
 			yyLOCAL = tree.NewTableOptionUnion(yyDollar[4].tableNamesUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 601:
+	case 652:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3669
+//line mysql_sql.y:3920
 		{
 			yyLOCAL = &tree.TableOptionProperties{Preperties: yyDollar[3].propertiesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 602:
+	case 653:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.Property
-//line mysql_sql.y:3676
+//line mysql_sql.y:3927
 		{
 			yyLOCAL = []tree.Property{yyDollar[1].propertyUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 603:
+	case 654:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.Property
-//line mysql_sql.y:3680
+//line mysql_sql.y:3931
 		{
 			yyLOCAL = append(yyDollar[1].propertiesUnion(), yyDollar[3].propertyUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 604:
+	case 655:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Property
-//line mysql_sql.y:3686
+//line mysql_sql.y:3937
 		{
 			yyLOCAL = tree.Property{Key: yyDollar[1].str, Value: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 605:
+	case 656:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3691
+//line mysql_sql.y:3942
 		{
 			yyVAL.str = ""
 		}
-	case 606:
+	case 657:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3695
+//line mysql_sql.y:3946
 		{
 			yyVAL.str = " " + yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 607:
+	case 658:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3699
+//line mysql_sql.y:3950
 		{
 			yyVAL.str = " " + yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 608:
+	case 659:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3705
+//line mysql_sql.y:3956
 		{
 			yyLOCAL = tree.ROW_FORMAT_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 609:
+	case 660:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3709
+//line mysql_sql.y:3960
 		{
 			yyLOCAL = tree.ROW_FORMAT_DYNAMIC
 		}
 		yyVAL.union = yyLOCAL
-	case 610:
+	case 661:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3713
+//line mysql_sql.y:3964
 		{
 			yyLOCAL = tree.ROW_FORMAT_FIXED
 		}
 		yyVAL.union = yyLOCAL
-	case 611:
+	case 662:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3717
+//line mysql_sql.y:3968
 		{
 			yyLOCAL = tree.ROW_FORMAT_COMPRESSED
 		}
 		yyVAL.union = yyLOCAL
-	case 612:
+	case 663:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3721
+//line mysql_sql.y:3972
 		{
 			yyLOCAL = tree.ROW_FORMAT_REDUNDANT
 		}
 		yyVAL.union = yyLOCAL
-	case 613:
+	case 664:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3725
+//line mysql_sql.y:3976
 		{
 			yyLOCAL = tree.ROW_FORMAT_COMPACT
 		}
 		yyVAL.union = yyLOCAL
-	case 620:
+	case 671:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableNames
-//line mysql_sql.y:3741
+//line mysql_sql.y:3992
 		{
 			yyLOCAL = tree.TableNames{yyDollar[1].tableNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 621:
+	case 672:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableNames
-//line mysql_sql.y:3745
+//line mysql_sql.y:3996
 		{
 			yyLOCAL = append(yyDollar[1].tableNamesUnion(), yyDollar[3].tableNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 622:
+	case 673:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:3754
+//line mysql_sql.y:4005
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[1].str), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 623:
+	case 674:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:3759
+//line mysql_sql.y:4010
 		{
 			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[1].str), ExplicitSchema: true}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[3].str), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 624:
+	case 675:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:3765
+//line mysql_sql.y:4016
 		{
 			yyLOCAL = tree.TableDefs(nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 626:
+	case 677:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:3772
+//line mysql_sql.y:4023
 		{
 			yyLOCAL = tree.TableDefs{yyDollar[1].tableDefUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 627:
+	case 678:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:3776
+//line mysql_sql.y:4027
 		{
 			yyLOCAL = append(yyDollar[1].tableDefsUnion(), yyDollar[3].tableDefUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 628:
+	case 679:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3782
+//line mysql_sql.y:4033
 		{
 			yyLOCAL = tree.TableDef(yyDollar[1].columnTableDefUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 629:
+	case 680:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3786
+//line mysql_sql.y:4037
 		{
 			yyLOCAL = yyDollar[1].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 630:
+	case 681:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3792
+//line mysql_sql.y:4043
 		{
 			if yyDollar[1].str != "" {
 				switch v := yyDollar[2].tableDefUnion().(type) {
 				case *tree.PrimaryKeyIndex:
 					v.Name = yyDollar[1].str
+				case *tree.UniqueIndex:
+					if v.Name == "" {
+						v.Name = yyDollar[1].str
+					}
+				case *tree.ForeignKey:
+					v.Name = yyDollar[1].str
+				case *tree.CheckIndex:
+					v.Name = yyDollar[1].str
 				}
 			}
 			yyLOCAL = yyDollar[2].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 631:
+	case 682:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3802
+//line mysql_sql.y:4061
 		{
 			yyLOCAL = yyDollar[1].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 632:
+	case 683:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3808
+//line mysql_sql.y:4067
 		{
 			yyLOCAL = &tree.PrimaryKeyIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -9574,10 +10353,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 633:
+	case 684:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3817
+//line mysql_sql.y:4076
 		{
 			yyLOCAL = &tree.FullTextIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -9587,10 +10366,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 634:
+	case 685:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3826
+//line mysql_sql.y:4085
 		{
 			keyTyp := tree.INDEX_TYPE_INVALID
 			if yyDollar[3].strsUnion()[1] != "" {
@@ -9614,10 +10393,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 635:
+	case 686:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3849
+//line mysql_sql.y:4108
 		{
 			yyLOCAL = &tree.UniqueIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -9627,10 +10406,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 636:
+	case 687:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3858
+//line mysql_sql.y:4117
 		{
 			yyLOCAL = &tree.ForeignKey{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -9641,10 +10420,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 637:
+	case 688:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3868
+//line mysql_sql.y:4127
 		{
 			yyLOCAL = &tree.CheckIndex{
 				Expr:     yyDollar[3].exprUnion(),
@@ -9652,298 +10431,306 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 638:
+	case 689:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3876
+//line mysql_sql.y:4135
 		{
-			yyLOCAL = false
+			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 640:
+	case 691:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3882
+//line mysql_sql.y:4141
 		{
 			yyVAL.str = ""
 		}
-	case 641:
+	case 692:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:3886
+//line mysql_sql.y:4145
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 644:
+	case 695:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:3896
+//line mysql_sql.y:4155
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].str
 			yyLOCAL[1] = ""
 		}
 		yyVAL.union = yyLOCAL
-	case 645:
+	case 696:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:3902
+//line mysql_sql.y:4161
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].str
 			yyLOCAL[1] = yyDollar[3].str
 		}
 		yyVAL.union = yyLOCAL
-	case 646:
+	case 697:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:3908
+//line mysql_sql.y:4167
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].str
 			yyLOCAL[1] = yyDollar[3].str
 		}
 		yyVAL.union = yyLOCAL
-	case 652:
+	case 703:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3922
+//line mysql_sql.y:4181
 		{
 			yyVAL.str = ""
 		}
-	case 654:
+	case 705:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.ColumnTableDef
-//line mysql_sql.y:3929
+//line mysql_sql.y:4188
 		{
 			yyLOCAL = tree.NewColumnTableDef(yyDollar[1].unresolvedNameUnion(), yyDollar[2].columnTypeUnion(), yyDollar[3].columnAttributesUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 655:
+	case 706:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3935
+//line mysql_sql.y:4194
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 656:
+	case 707:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3939
+//line mysql_sql.y:4198
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 657:
+	case 708:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3943
+//line mysql_sql.y:4202
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str, yyDollar[5].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 661:
+	case 712:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3954
+//line mysql_sql.y:4213
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 662:
+	case 713:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3958
+//line mysql_sql.y:4217
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 663:
+	case 714:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3962
+//line mysql_sql.y:4221
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str, yyDollar[5].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 664:
+	case 715:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:3967
+//line mysql_sql.y:4226
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 665:
+	case 716:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:3971
+//line mysql_sql.y:4230
 		{
 			yyLOCAL = yyDollar[1].columnAttributesUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 666:
+	case 717:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:3977
+//line mysql_sql.y:4236
 		{
 			yyLOCAL = []tree.ColumnAttribute{yyDollar[1].columnAttributeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 667:
+	case 718:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:3981
+//line mysql_sql.y:4240
 		{
 			yyLOCAL = append(yyDollar[1].columnAttributesUnion(), yyDollar[2].columnAttributeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 668:
+	case 719:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3987
+//line mysql_sql.y:4246
 		{
 			yyLOCAL = tree.NewAttributeNull(true)
 		}
 		yyVAL.union = yyLOCAL
-	case 669:
+	case 720:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3991
+//line mysql_sql.y:4250
 		{
 			yyLOCAL = tree.NewAttributeNull(false)
 		}
 		yyVAL.union = yyLOCAL
-	case 670:
+	case 721:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3995
+//line mysql_sql.y:4254
 		{
 			yyLOCAL = tree.NewAttributeDefault(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 671:
+	case 722:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3999
+//line mysql_sql.y:4258
 		{
 			yyLOCAL = tree.NewAttributeAutoIncrement()
 		}
 		yyVAL.union = yyLOCAL
-	case 672:
+	case 723:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4003
+//line mysql_sql.y:4262
 		{
 			yyLOCAL = yyDollar[1].columnAttributeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 673:
+	case 724:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4007
+//line mysql_sql.y:4266
 		{
 			yyLOCAL = tree.NewAttributeComment(tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false))
 		}
 		yyVAL.union = yyLOCAL
-	case 674:
+	case 725:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4011
+//line mysql_sql.y:4270
 		{
 			yyLOCAL = tree.NewAttributeCollate(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 675:
+	case 726:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4015
+//line mysql_sql.y:4274
 		{
 			yyLOCAL = tree.NewAttributeColumnFormat(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 676:
+	case 727:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4019
+//line mysql_sql.y:4278
 		{
 			yyLOCAL = tree.NewAttributeStorage(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 677:
+	case 728:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4023
+//line mysql_sql.y:4282
+		{
+			yyLOCAL = tree.NewAttributeCompression(yyDollar[2].str)
+		}
+		yyVAL.union = yyLOCAL
+	case 729:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.ColumnAttribute
+//line mysql_sql.y:4286
 		{
 			yyLOCAL = tree.NewAttributeAutoRandom(int(yyDollar[2].int64ValUnion()))
 		}
 		yyVAL.union = yyLOCAL
-	case 678:
+	case 730:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4027
+//line mysql_sql.y:4290
 		{
 			yyLOCAL = yyDollar[1].attributeReferenceUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 679:
+	case 731:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4031
+//line mysql_sql.y:4294
 		{
-			yyLOCAL = tree.NewAttributeCheck(yyDollar[4].exprUnion(), false, yyDollar[1].str)
+			yyLOCAL = tree.NewAttributeCheck(yyDollar[4].exprUnion(), true, yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 680:
+	case 732:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4035
+//line mysql_sql.y:4298
 		{
 			yyLOCAL = tree.NewAttributeCheck(yyDollar[4].exprUnion(), yyDollar[6].boolValUnion(), yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 681:
+	case 733:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:4045
+//line mysql_sql.y:4308
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 682:
+	case 734:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:4049
+//line mysql_sql.y:4312
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 683:
+	case 735:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:4054
+//line mysql_sql.y:4317
 		{
 			yyVAL.str = ""
 		}
-	case 684:
+	case 736:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4058
+//line mysql_sql.y:4321
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 685:
+	case 737:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4064
+//line mysql_sql.y:4327
 		{
 			yyVAL.str = ""
 		}
-	case 686:
+	case 738:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4068
+//line mysql_sql.y:4331
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 687:
+	case 739:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.AttributeReference
-//line mysql_sql.y:4074
+//line mysql_sql.y:4337
 		{
 			yyLOCAL = &tree.AttributeReference{
 				TableName: yyDollar[2].tableNameUnion(),
@@ -9954,10 +10741,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 688:
+	case 740:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:4085
+//line mysql_sql.y:4348
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: tree.REFERENCE_OPTION_INVALID,
@@ -9965,10 +10752,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 690:
+	case 742:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:4095
+//line mysql_sql.y:4358
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[1].referenceOptionTypeUnion(),
@@ -9976,10 +10763,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 691:
+	case 743:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:4102
+//line mysql_sql.y:4365
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: tree.REFERENCE_OPTION_INVALID,
@@ -9987,10 +10774,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 692:
+	case 744:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:4109
+//line mysql_sql.y:4372
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[1].referenceOptionTypeUnion(),
@@ -9998,10 +10785,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 693:
+	case 745:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:4116
+//line mysql_sql.y:4379
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[2].referenceOptionTypeUnion(),
@@ -10009,339 +10796,339 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 694:
+	case 746:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4125
+//line mysql_sql.y:4388
 		{
 			yyLOCAL = yyDollar[3].referenceOptionTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 695:
+	case 747:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4131
+//line mysql_sql.y:4394
 		{
 			yyLOCAL = yyDollar[3].referenceOptionTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 696:
+	case 748:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4137
+//line mysql_sql.y:4400
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_RESTRICT
 		}
 		yyVAL.union = yyLOCAL
-	case 697:
+	case 749:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4141
+//line mysql_sql.y:4404
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_CASCADE
 		}
 		yyVAL.union = yyLOCAL
-	case 698:
+	case 750:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4145
+//line mysql_sql.y:4408
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_SET_NULL
 		}
 		yyVAL.union = yyLOCAL
-	case 699:
+	case 751:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4149
+//line mysql_sql.y:4412
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_NO_ACTION
 		}
 		yyVAL.union = yyLOCAL
-	case 700:
+	case 752:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4153
+//line mysql_sql.y:4416
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_SET_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 701:
+	case 753:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:4158
+//line mysql_sql.y:4421
 		{
 			yyLOCAL = tree.MATCH_INVALID
 		}
 		yyVAL.union = yyLOCAL
-	case 703:
+	case 755:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:4165
+//line mysql_sql.y:4428
 		{
 			yyLOCAL = tree.MATCH_FULL
 		}
 		yyVAL.union = yyLOCAL
-	case 704:
+	case 756:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:4169
+//line mysql_sql.y:4432
 		{
 			yyLOCAL = tree.MATCH_PARTIAL
 		}
 		yyVAL.union = yyLOCAL
-	case 705:
+	case 757:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:4173
+//line mysql_sql.y:4436
 		{
 			yyLOCAL = tree.MATCH_SIMPLE
 		}
 		yyVAL.union = yyLOCAL
-	case 706:
+	case 758:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:4178
+//line mysql_sql.y:4441
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 707:
+	case 759:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:4182
+//line mysql_sql.y:4445
 		{
 			yyLOCAL = yyDollar[2].keyPartsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 708:
+	case 760:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:4187
+//line mysql_sql.y:4450
 		{
 			yyLOCAL = -1
 		}
 		yyVAL.union = yyLOCAL
-	case 709:
+	case 761:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:4191
+//line mysql_sql.y:4454
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 716:
+	case 768:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Subquery
-//line mysql_sql.y:4207
+//line mysql_sql.y:4470
 		{
 			yyLOCAL = &tree.Subquery{Select: yyDollar[1].selectStatementUnion(), Exists: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 717:
+	case 769:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4213
+//line mysql_sql.y:4476
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_AND, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 718:
+	case 770:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4217
+//line mysql_sql.y:4480
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_OR, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 719:
+	case 771:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4221
+//line mysql_sql.y:4484
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_XOR, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 720:
+	case 772:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4225
+//line mysql_sql.y:4488
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.PLUS, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 721:
+	case 773:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4229
+//line mysql_sql.y:4492
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MINUS, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 722:
+	case 774:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4233
+//line mysql_sql.y:4496
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MULTI, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 723:
+	case 775:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4237
+//line mysql_sql.y:4500
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.DIV, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 724:
+	case 776:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4241
+//line mysql_sql.y:4504
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.INTEGER_DIV, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 725:
+	case 777:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4245
+//line mysql_sql.y:4508
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MOD, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 726:
+	case 778:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4249
+//line mysql_sql.y:4512
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MOD, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 727:
+	case 779:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4253
+//line mysql_sql.y:4516
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.LEFT_SHIFT, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 728:
+	case 780:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4257
+//line mysql_sql.y:4520
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.RIGHT_SHIFT, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 729:
+	case 781:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4261
+//line mysql_sql.y:4524
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 730:
+	case 782:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4267
+//line mysql_sql.y:4530
 		{
 			yyLOCAL = yyDollar[1].unresolvedNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 731:
+	case 783:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4271
+//line mysql_sql.y:4534
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 732:
+	case 784:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4275
+//line mysql_sql.y:4538
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 733:
+	case 785:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4279
+//line mysql_sql.y:4542
 		{
 			yyLOCAL = tree.NewParenExpr(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 734:
+	case 786:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4283
+//line mysql_sql.y:4546
 		{
 			yyLOCAL = tree.NewTuple(append(yyDollar[2].exprsUnion(), yyDollar[4].exprUnion()))
 		}
 		yyVAL.union = yyLOCAL
-	case 735:
+	case 787:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4287
+//line mysql_sql.y:4550
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_PLUS, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 736:
+	case 788:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4291
+//line mysql_sql.y:4554
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_MINUS, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 737:
+	case 789:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4295
+//line mysql_sql.y:4558
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_TILDE, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 738:
+	case 790:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4299
+//line mysql_sql.y:4562
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_MARK, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 739:
+	case 791:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4303
+//line mysql_sql.y:4566
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 740:
+	case 792:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4307
+//line mysql_sql.y:4570
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 741:
+	case 793:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4311
+//line mysql_sql.y:4574
 		{
 			yyDollar[2].subqueryUnion().Exists = true
 			yyLOCAL = yyDollar[2].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 742:
+	case 794:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4316
+//line mysql_sql.y:4579
 		{
 			yyLOCAL = &tree.CaseExpr{
 				Expr:  yyDollar[2].exprUnion(),
@@ -10350,26 +11137,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 743:
+	case 795:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4324
+//line mysql_sql.y:4587
 		{
 			yyLOCAL = tree.NewCastExpr(yyDollar[3].exprUnion(), yyDollar[5].columnTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 744:
+	case 796:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4328
+//line mysql_sql.y:4591
 		{
 			yyLOCAL = tree.NewCastExpr(yyDollar[3].exprUnion(), yyDollar[5].columnTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 745:
+	case 797:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4332
+//line mysql_sql.y:4595
 		{
 			name := tree.SetUnresolvedName("convert")
 			es := tree.NewNumVal(constant.MakeString(yyDollar[5].str), yyDollar[5].str, false)
@@ -10379,90 +11166,90 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 746:
+	case 798:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4341
+//line mysql_sql.y:4604
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 747:
+	case 799:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4345
+//line mysql_sql.y:4608
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 748:
+	case 800:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4349
+//line mysql_sql.y:4612
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 749:
+	case 801:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4353
+//line mysql_sql.y:4616
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 750:
+	case 802:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4358
+//line mysql_sql.y:4621
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 751:
+	case 803:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4362
+//line mysql_sql.y:4625
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 752:
+	case 804:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4367
+//line mysql_sql.y:4630
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 753:
+	case 805:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4371
+//line mysql_sql.y:4634
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 754:
+	case 806:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.When
-//line mysql_sql.y:4377
+//line mysql_sql.y:4640
 		{
 			yyLOCAL = []*tree.When{yyDollar[1].whenClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 755:
+	case 807:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.When
-//line mysql_sql.y:4381
+//line mysql_sql.y:4644
 		{
 			yyLOCAL = append(yyDollar[1].whenClauseListUnion(), yyDollar[2].whenClauseUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 756:
+	case 808:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.When
-//line mysql_sql.y:4387
+//line mysql_sql.y:4650
 		{
 			yyLOCAL = &tree.When{
 				Cond: yyDollar[2].exprUnion(),
@@ -10470,10 +11257,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 758:
+	case 810:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4397
+//line mysql_sql.y:4660
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10487,10 +11274,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 759:
+	case 811:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4410
+//line mysql_sql.y:4673
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10504,10 +11291,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 760:
+	case 812:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4423
+//line mysql_sql.y:4686
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10520,10 +11307,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 761:
+	case 813:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4435
+//line mysql_sql.y:4698
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10538,10 +11325,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 762:
+	case 814:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4449
+//line mysql_sql.y:4712
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10557,10 +11344,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 763:
+	case 815:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4464
+//line mysql_sql.y:4727
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10576,10 +11363,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 764:
+	case 816:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4479
+//line mysql_sql.y:4742
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10593,10 +11380,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 765:
+	case 817:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4492
+//line mysql_sql.y:4755
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10611,15 +11398,15 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 766:
+	case 818:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:4507
+//line mysql_sql.y:4770
 		{
 		}
-	case 769:
+	case 821:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4513
+//line mysql_sql.y:4776
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10629,10 +11416,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 770:
+	case 822:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4522
+//line mysql_sql.y:4785
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10641,10 +11428,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 771:
+	case 823:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4530
+//line mysql_sql.y:4793
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10653,10 +11440,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 772:
+	case 824:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4538
+//line mysql_sql.y:4801
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10666,10 +11453,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 773:
+	case 825:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4547
+//line mysql_sql.y:4810
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10679,10 +11466,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 774:
+	case 826:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4556
+//line mysql_sql.y:4819
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10692,10 +11479,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 775:
+	case 827:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4565
+//line mysql_sql.y:4828
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10705,10 +11492,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 776:
+	case 828:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4574
+//line mysql_sql.y:4837
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			es := tree.NewNumVal(constant.MakeString("*"), "*", false)
@@ -10718,10 +11505,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 777:
+	case 829:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4583
+//line mysql_sql.y:4846
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10731,10 +11518,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 778:
+	case 830:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4592
+//line mysql_sql.y:4855
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10744,10 +11531,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 779:
+	case 831:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4601
+//line mysql_sql.y:4864
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10757,10 +11544,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 780:
+	case 832:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4610
+//line mysql_sql.y:4873
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10770,10 +11557,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 781:
+	case 833:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4619
+//line mysql_sql.y:4882
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10783,10 +11570,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 782:
+	case 834:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4628
+//line mysql_sql.y:4891
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10796,10 +11583,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 783:
+	case 835:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4637
+//line mysql_sql.y:4900
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10809,10 +11596,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 787:
+	case 839:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4653
+//line mysql_sql.y:4916
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10821,10 +11608,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 788:
+	case 840:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4661
+//line mysql_sql.y:4924
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10833,10 +11620,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 789:
+	case 841:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4669
+//line mysql_sql.y:4932
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10845,10 +11632,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 790:
+	case 842:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4677
+//line mysql_sql.y:4940
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10857,10 +11644,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 791:
+	case 843:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4685
+//line mysql_sql.y:4948
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			timeUinit := tree.SetUnresolvedName(strings.ToLower(yyDollar[3].str))
@@ -10870,16 +11657,16 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 794:
+	case 846:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4700
+//line mysql_sql.y:4963
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 823:
+	case 875:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4736
+//line mysql_sql.y:4999
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -10892,10 +11679,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 824:
+	case 876:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4748
+//line mysql_sql.y:5011
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -10908,10 +11695,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 825:
+	case 877:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4762
+//line mysql_sql.y:5025
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10920,10 +11707,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 826:
+	case 878:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4770
+//line mysql_sql.y:5033
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10931,10 +11718,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 827:
+	case 879:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4777
+//line mysql_sql.y:5040
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -10947,10 +11734,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 828:
+	case 880:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4789
+//line mysql_sql.y:5052
 		{
 			name := tree.SetUnresolvedName("char")
 			yyLOCAL = &tree.FuncExpr{
@@ -10959,10 +11746,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 829:
+	case 881:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4797
+//line mysql_sql.y:5060
 		{
 			cn := tree.NewNumVal(constant.MakeString(yyDollar[5].str), yyDollar[5].str, false)
 			es := yyDollar[3].exprsUnion()
@@ -10974,10 +11761,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 830:
+	case 882:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4808
+//line mysql_sql.y:5071
 		{
 			val := tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false)
 			name := tree.SetUnresolvedName("date")
@@ -10987,10 +11774,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 831:
+	case 883:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4817
+//line mysql_sql.y:5080
 		{
 			val := tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false)
 			name := tree.SetUnresolvedName("time")
@@ -11000,10 +11787,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 832:
+	case 884:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4826
+//line mysql_sql.y:5089
 		{
 			val := tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false)
 			name := tree.SetUnresolvedName("timestamp")
@@ -11013,10 +11800,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 833:
+	case 885:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4835
+//line mysql_sql.y:5098
 		{
 			name := tree.SetUnresolvedName("insert")
 			yyLOCAL = &tree.FuncExpr{
@@ -11025,10 +11812,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 834:
+	case 886:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4843
+//line mysql_sql.y:5106
 		{
 			es := tree.Exprs{yyDollar[3].exprUnion()}
 			es = append(es, yyDollar[5].exprUnion())
@@ -11039,10 +11826,23 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 835:
+	case 887:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:5116
+		{
+			name := tree.SetUnresolvedName("values")
+			arg := tree.SetUnresolvedName(strings.ToLower(yyDollar[3].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{arg},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 888:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4853
+//line mysql_sql.y:5125
 		{
 			name := tree.SetUnresolvedName("password")
 			yyLOCAL = &tree.FuncExpr{
@@ -11051,10 +11851,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 836:
+	case 889:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4861
+//line mysql_sql.y:5133
 		{
 			name := tree.SetUnresolvedName("binary")
 			yyLOCAL = &tree.FuncExpr{
@@ -11063,52 +11863,52 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 837:
+	case 890:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4870
+//line mysql_sql.y:5142
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 838:
+	case 891:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4874
+//line mysql_sql.y:5146
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 839:
+	case 892:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4880
+//line mysql_sql.y:5152
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 840:
+	case 893:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4884
+//line mysql_sql.y:5156
 		{
 			yyLOCAL = yyDollar[2].numValUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 847:
+	case 900:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:4897
+//line mysql_sql.y:5169
 		{
 		}
-	case 848:
+	case 901:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4899
+//line mysql_sql.y:5171
 		{
 		}
-	case 881:
+	case 935:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4939
+//line mysql_sql.y:5212
 		{
 			name := tree.SetUnresolvedName("interval")
 			es := tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false)
@@ -11118,10 +11918,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 882:
+	case 936:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4948
+//line mysql_sql.y:5221
 		{
 			name := tree.SetUnresolvedName("interval")
 			ival := util.GetUint64(yyDollar[2].item)
@@ -11134,314 +11934,314 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 883:
+	case 937:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:4961
+//line mysql_sql.y:5234
 		{
 			yyLOCAL = tree.FUNC_TYPE_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 884:
+	case 938:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:4965
+//line mysql_sql.y:5238
 		{
 			yyLOCAL = tree.FUNC_TYPE_DISTINCT
 		}
 		yyVAL.union = yyLOCAL
-	case 885:
+	case 939:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:4969
+//line mysql_sql.y:5242
 		{
 			yyLOCAL = tree.FUNC_TYPE_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 886:
+	case 940:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Tuple
-//line mysql_sql.y:4975
+//line mysql_sql.y:5248
 		{
 			yyLOCAL = tree.NewTuple(yyDollar[2].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 887:
+	case 941:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:4980
+//line mysql_sql.y:5253
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 888:
+	case 942:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:4984
+//line mysql_sql.y:5257
 		{
 			yyLOCAL = yyDollar[1].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 889:
+	case 943:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:4990
+//line mysql_sql.y:5263
 		{
 			yyLOCAL = tree.Exprs{yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 890:
+	case 944:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:4994
+//line mysql_sql.y:5267
 		{
 			yyLOCAL = append(yyDollar[1].exprsUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 891:
+	case 945:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5001
+//line mysql_sql.y:5274
 		{
 			yyLOCAL = tree.NewAndExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 892:
+	case 946:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5005
+//line mysql_sql.y:5278
 		{
 			yyLOCAL = tree.NewOrExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 893:
+	case 947:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5009
+//line mysql_sql.y:5282
 		{
 			yyLOCAL = tree.NewXorExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 894:
+	case 948:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5013
+//line mysql_sql.y:5286
 		{
 			yyLOCAL = tree.NewNotExpr(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 895:
+	case 949:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5017
+//line mysql_sql.y:5290
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 896:
+	case 950:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5023
+//line mysql_sql.y:5296
 		{
 			yyLOCAL = tree.NewIsNullExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 897:
+	case 951:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5027
+//line mysql_sql.y:5300
 		{
 			yyLOCAL = tree.NewIsNotNullExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 898:
+	case 952:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5031
+//line mysql_sql.y:5304
 		{
 			yyLOCAL = tree.NewComparisonExpr(yyDollar[2].comparisonOpUnion(), yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 900:
+	case 954:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5038
+//line mysql_sql.y:5311
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.IN, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 901:
+	case 955:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5042
+//line mysql_sql.y:5315
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.NOT_IN, yyDollar[1].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 902:
+	case 956:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5046
+//line mysql_sql.y:5319
 		{
 			yyLOCAL = tree.NewComparisonExprWithEscape(tree.LIKE, yyDollar[1].exprUnion(), yyDollar[3].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 903:
+	case 957:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5050
+//line mysql_sql.y:5323
 		{
 			yyLOCAL = tree.NewComparisonExprWithEscape(tree.NOT_LIKE, yyDollar[1].exprUnion(), yyDollar[4].exprUnion(), yyDollar[5].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 904:
+	case 958:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5054
+//line mysql_sql.y:5327
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.REG_MATCH, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 905:
+	case 959:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5058
+//line mysql_sql.y:5331
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.NOT_REG_MATCH, yyDollar[1].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 906:
+	case 960:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5062
+//line mysql_sql.y:5335
 		{
 			yyLOCAL = tree.NewRangeCond(false, yyDollar[1].exprUnion(), yyDollar[3].exprUnion(), yyDollar[5].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 907:
+	case 961:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5066
+//line mysql_sql.y:5339
 		{
 			yyLOCAL = tree.NewRangeCond(true, yyDollar[1].exprUnion(), yyDollar[4].exprUnion(), yyDollar[6].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 909:
+	case 963:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5072
+//line mysql_sql.y:5345
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 910:
+	case 964:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5076
+//line mysql_sql.y:5349
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 911:
+	case 965:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5082
+//line mysql_sql.y:5355
 		{
 			yyLOCAL = yyDollar[1].tupleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 912:
+	case 966:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5086
+//line mysql_sql.y:5359
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 913:
+	case 967:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5093
+//line mysql_sql.y:5366
 		{
 			yyLOCAL = tree.EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 914:
+	case 968:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5097
+//line mysql_sql.y:5370
 		{
 			yyLOCAL = tree.LESS_THAN
 		}
 		yyVAL.union = yyLOCAL
-	case 915:
+	case 969:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5101
+//line mysql_sql.y:5374
 		{
 			yyLOCAL = tree.GREAT_THAN
 		}
 		yyVAL.union = yyLOCAL
-	case 916:
+	case 970:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5105
+//line mysql_sql.y:5378
 		{
 			yyLOCAL = tree.LESS_THAN_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 917:
+	case 971:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5109
+//line mysql_sql.y:5382
 		{
 			yyLOCAL = tree.GREAT_THAN_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 918:
+	case 972:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5113
+//line mysql_sql.y:5386
 		{
 			yyLOCAL = tree.NOT_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 919:
+	case 973:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:5120
+//line mysql_sql.y:5393
 		{
 			yyLOCAL = tree.NewAttributePrimaryKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 920:
+	case 974:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:5124
+//line mysql_sql.y:5397
 		{
 			yyLOCAL = tree.NewAttributeUniqueKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 921:
+	case 975:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:5128
+//line mysql_sql.y:5401
 		{
 			yyLOCAL = tree.NewAttributeUnique()
 		}
 		yyVAL.union = yyLOCAL
-	case 922:
+	case 976:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:5132
+//line mysql_sql.y:5405
 		{
 			yyLOCAL = tree.NewAttributeKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 923:
+	case 977:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.NumVal
-//line mysql_sql.y:5138
+//line mysql_sql.y:5411
 		{
 			ival, errStr := util.GetInt64(yyDollar[1].item)
 			if errStr != "" {
@@ -11455,96 +12255,96 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 924:
+	case 978:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5153
+//line mysql_sql.y:5426
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false)
 		}
 		yyVAL.union = yyLOCAL
-	case 925:
+	case 979:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5157
+//line mysql_sql.y:5430
 		{
 			ival := util.GetUint64(yyDollar[1].item)
 			yyLOCAL = tree.NewNumVal(constant.MakeUint64(ival), yylex.(*Lexer).scanner.LastToken, false)
 		}
 		yyVAL.union = yyLOCAL
-	case 926:
+	case 980:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5162
+//line mysql_sql.y:5435
 		{
 			fval := yyDollar[1].item.(float64)
 			yyLOCAL = tree.NewNumValWithResFoalt(constant.MakeFloat64(fval), yylex.(*Lexer).scanner.LastToken, false, fval)
 		}
 		yyVAL.union = yyLOCAL
-	case 927:
+	case 981:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5167
+//line mysql_sql.y:5440
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeBool(true), "", false)
 		}
 		yyVAL.union = yyLOCAL
-	case 928:
+	case 982:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5171
+//line mysql_sql.y:5444
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeBool(false), "", false)
 		}
 		yyVAL.union = yyLOCAL
-	case 929:
+	case 983:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5175
+//line mysql_sql.y:5448
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeUnknown(), "", false)
 		}
 		yyVAL.union = yyLOCAL
-	case 930:
+	case 984:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5179
+//line mysql_sql.y:5452
 		{
 			ival := util.GetUint64(yyDollar[1].item)
 			yyLOCAL = tree.NewNumVal(constant.MakeUint64(ival), yylex.(*Lexer).scanner.LastToken, false)
 		}
 		yyVAL.union = yyLOCAL
-	case 931:
+	case 985:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5189
+//line mysql_sql.y:5462
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 			yyLOCAL.InternalType.Unsigned = yyDollar[2].unsignedOptUnion()
 			yyLOCAL.InternalType.Zerofill = yyDollar[3].zeroFillOptUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 935:
+	case 989:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5200
+//line mysql_sql.y:5473
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 			yyLOCAL.InternalType.DisplayWith = yyDollar[2].lengthOptUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 936:
+	case 990:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5205
+//line mysql_sql.y:5478
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 937:
+	case 991:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5211
+//line mysql_sql.y:5484
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11557,10 +12357,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 938:
+	case 992:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5223
+//line mysql_sql.y:5496
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11573,10 +12373,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 939:
+	case 993:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5235
+//line mysql_sql.y:5508
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11589,10 +12389,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 940:
+	case 994:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5247
+//line mysql_sql.y:5520
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11606,10 +12406,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 941:
+	case 995:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5260
+//line mysql_sql.y:5533
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11623,10 +12423,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 942:
+	case 996:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5273
+//line mysql_sql.y:5546
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11640,10 +12440,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 943:
+	case 997:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5286
+//line mysql_sql.y:5559
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11657,10 +12457,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 944:
+	case 998:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5299
+//line mysql_sql.y:5572
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11674,10 +12474,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 945:
+	case 999:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5312
+//line mysql_sql.y:5585
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11691,10 +12491,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 946:
+	case 1000:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5325
+//line mysql_sql.y:5598
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11708,10 +12508,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 947:
+	case 1001:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5338
+//line mysql_sql.y:5611
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11725,10 +12525,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 948:
+	case 1002:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5351
+//line mysql_sql.y:5624
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11742,10 +12542,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 949:
+	case 1003:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5364
+//line mysql_sql.y:5637
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11759,10 +12559,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 950:
+	case 1004:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5377
+//line mysql_sql.y:5650
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11776,10 +12576,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 951:
+	case 1005:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5392
+//line mysql_sql.y:5665
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().DisplayWith > 255 {
@@ -11803,10 +12603,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 952:
+	case 1006:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5415
+//line mysql_sql.y:5688
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().Precision != tree.NotDefineDec && yyDollar[2].lengthScaleOptUnion().Precision > yyDollar[2].lengthScaleOptUnion().DisplayWith {
@@ -11843,10 +12643,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 953:
+	case 1007:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5452
+//line mysql_sql.y:5725
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().Precision != tree.NotDefineDec && yyDollar[2].lengthScaleOptUnion().Precision > yyDollar[2].lengthScaleOptUnion().DisplayWith {
@@ -11883,10 +12683,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 954:
+	case 1008:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5500
+//line mysql_sql.y:5773
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11902,10 +12702,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 955:
+	case 1009:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5517
+//line mysql_sql.y:5790
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11918,10 +12718,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 956:
+	case 1010:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5529
+//line mysql_sql.y:5802
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11937,10 +12737,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 957:
+	case 1011:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5544
+//line mysql_sql.y:5817
 		{
 			locale := ""
 			if yyDollar[2].lengthOptUnion() < 0 || yyDollar[2].lengthOptUnion() > 6 {
@@ -11961,10 +12761,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 958:
+	case 1012:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5564
+//line mysql_sql.y:5837
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11980,10 +12780,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 959:
+	case 1013:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5579
+//line mysql_sql.y:5852
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11998,10 +12798,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 960:
+	case 1014:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5595
+//line mysql_sql.y:5868
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12015,10 +12815,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 961:
+	case 1015:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5608
+//line mysql_sql.y:5881
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12032,10 +12832,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 962:
+	case 1016:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5621
+//line mysql_sql.y:5894
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12049,10 +12849,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 963:
+	case 1017:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5634
+//line mysql_sql.y:5907
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12066,10 +12866,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 964:
+	case 1018:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5647
+//line mysql_sql.y:5920
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12082,10 +12882,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 965:
+	case 1019:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5659
+//line mysql_sql.y:5932
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12098,10 +12898,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 966:
+	case 1020:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5671
+//line mysql_sql.y:5944
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12114,10 +12914,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 967:
+	case 1021:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5683
+//line mysql_sql.y:5956
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12130,10 +12930,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 968:
+	case 1022:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5695
+//line mysql_sql.y:5968
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12146,10 +12946,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 969:
+	case 1023:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5707
+//line mysql_sql.y:5980
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12162,10 +12962,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 970:
+	case 1024:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5719
+//line mysql_sql.y:5992
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12178,10 +12978,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 971:
+	case 1025:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5731
+//line mysql_sql.y:6004
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12194,10 +12994,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 972:
+	case 1026:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5743
+//line mysql_sql.y:6016
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12210,10 +13010,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 973:
+	case 1027:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5755
+//line mysql_sql.y:6028
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12227,10 +13027,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 974:
+	case 1028:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5768
+//line mysql_sql.y:6041
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12244,10 +13044,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 975:
+	case 1029:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5783
+//line mysql_sql.y:6056
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12260,75 +13060,75 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 976:
+	case 1030:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5806
+//line mysql_sql.y:6079
 		{
 			yyLOCAL = make([]string, 0, 4)
 			yyLOCAL = append(yyLOCAL, yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 977:
+	case 1031:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5811
+//line mysql_sql.y:6084
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 978:
+	case 1032:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:5817
+//line mysql_sql.y:6090
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 980:
+	case 1034:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:5824
+//line mysql_sql.y:6097
 		{
 			yyLOCAL = 6
 		}
 		yyVAL.union = yyLOCAL
-	case 981:
+	case 1035:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:5828
+//line mysql_sql.y:6101
 		{
 			yyLOCAL = int32(yyDollar[2].item.(int64))
 		}
 		yyVAL.union = yyLOCAL
-	case 982:
+	case 1036:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:5833
+//line mysql_sql.y:6106
 		{
 			yyLOCAL = int32(-1)
 		}
 		yyVAL.union = yyLOCAL
-	case 983:
+	case 1037:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:5837
+//line mysql_sql.y:6110
 		{
 			yyLOCAL = int32(yyDollar[2].item.(int64))
 		}
 		yyVAL.union = yyLOCAL
-	case 984:
+	case 1038:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:5843
+//line mysql_sql.y:6116
 		{
 			yyLOCAL = tree.GetDisplayWith(int32(yyDollar[2].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 985:
+	case 1039:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:5849
+//line mysql_sql.y:6122
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.NotDefineDisplayWidth,
@@ -12336,10 +13136,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 986:
+	case 1040:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:5856
+//line mysql_sql.y:6129
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -12347,10 +13147,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 987:
+	case 1041:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:5863
+//line mysql_sql.y:6136
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -12358,10 +13158,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 988:
+	case 1042:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:5872
+//line mysql_sql.y:6145
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: 10, // this is the default precision for decimal
@@ -12369,10 +13169,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 989:
+	case 1043:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:5879
+//line mysql_sql.y:6152
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -12380,10 +13180,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 990:
+	case 1044:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:5886
+//line mysql_sql.y:6159
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -12391,52 +13191,52 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 991:
+	case 1045:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5895
+//line mysql_sql.y:6168
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 992:
+	case 1046:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5899
+//line mysql_sql.y:6172
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 993:
+	case 1047:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5903
+//line mysql_sql.y:6176
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 994:
+	case 1048:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:5909
+//line mysql_sql.y:6182
 		{
 		}
-	case 995:
+	case 1049:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5911
+//line mysql_sql.y:6184
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 999:
+	case 1053:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:5921
+//line mysql_sql.y:6194
 		{
 			yyVAL.str = ""
 		}
-	case 1000:
+	case 1054:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:5925
+//line mysql_sql.y:6198
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
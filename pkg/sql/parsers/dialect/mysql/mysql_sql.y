@@ -43,6 +43,8 @@ import (
     columnTableDef *tree.ColumnTableDef
     tableOption tree.TableOption
     tableOptions []tree.TableOption
+    alterTableOption tree.AlterTableOption
+    alterTableOptions []tree.AlterTableOption
     tableExprs tree.TableExprs
     tableExpr tree.TableExpr
     rowFormatType tree.RowFormatType
@@ -98,6 +100,9 @@ import (
     updateExpr *tree.UpdateExpr
     updateExprs tree.UpdateExprs
     completionType tree.CompletionType
+    isolationLevel tree.IsolationLevel
+    selectLock *tree.SelectLock
+    selectLockWait tree.SelectLockWait
     varAssignmentExpr *tree.VarAssignmentExpr
     varAssignmentExprs []*tree.VarAssignmentExpr
     setRole *tree.SetRole
@@ -166,7 +171,7 @@ import (
 
 %token LEX_ERROR
 %left <str> UNION
-%token <str> SELECT STREAM INSERT UPDATE DELETE FROM WHERE GROUP HAVING ORDER BY LIMIT OFFSET FOR
+%token <str> SELECT STREAM INSERT UPDATE DELETE FROM WHERE GROUP HAVING ORDER BY LIMIT OFFSET FOR OF
 %token <str> ALL DISTINCT DISTINCTROW AS EXISTS ASC DESC INTO DUPLICATE DEFAULT SET LOCK KEYS
 %token <str> VALUES LAST_INSERT_ID
 %token <str> NEXT VALUE SHARE MODE
@@ -215,7 +220,7 @@ import (
 %token <str> INT1 INT2 INT3 INT4 INT8
 
 // Create Table
-%token <str> CREATE ALTER DROP RENAME ANALYZE ADD
+%token <str> CREATE ALTER DROP RENAME ANALYZE ADD MODIFY
 %token <str> SCHEMA TABLE INDEX VIEW TO IGNORE IF PRIMARY COLUMN CONSTRAINT SPATIAL FULLTEXT FOREIGN KEY_BLOCK_SIZE
 %token <str> SHOW DESCRIBE EXPLAIN DATE ESCAPE REPAIR OPTIMIZE TRUNCATE
 %token <str> MAXVALUE PARTITION REORGANIZE LESS THAN PROCEDURE TRIGGER
@@ -258,6 +263,13 @@ import (
 // Load
 %token <str> LOAD INFILE TERMINATED OPTIONALLY ENCLOSED ESCAPED STARTING LINES
 
+// Backup
+%token <str> BACKUP INCREMENTAL
+
+// Locking Read
+%token <str> NOWAIT SKIP LOCKED
+%token <str> SAVEPOINT
+
 // Supported SHOW tokens
 %token <str> DATABASES TABLES EXTENDED FULL PROCESSLIST FIELDS COLUMNS OPEN ERRORS WARNINGS INDEXES
 
@@ -299,7 +311,7 @@ import (
 
 %type <statement> stmt
 %type <statements> stmt_list
-%type <statement> create_stmt insert_stmt delete_stmt drop_stmt alter_stmt
+%type <statement> create_stmt insert_stmt replace_stmt delete_stmt drop_stmt alter_stmt
 %type <statement> drop_ddl_stmt drop_database_stmt drop_table_stmt drop_index_stmt
 %type <statement> drop_role_stmt drop_user_stmt
 %type <statement> create_user_stmt create_role_stmt
@@ -307,12 +319,13 @@ import (
 %type <statement> show_stmt show_create_stmt show_columns_stmt show_databases_stmt
 %type <statement> show_tables_stmt show_process_stmt show_errors_stmt show_warnings_stmt
 %type <statement> show_variables_stmt show_status_stmt show_index_stmt
-%type <statement> alter_user_stmt update_stmt use_stmt
+%type <statement> alter_user_stmt alter_table_stmt update_stmt use_stmt
 %type <statement> transaction_stmt begin_stmt commit_stmt rollback_stmt
+%type <statement> savepoint_stmt release_savepoint_stmt
 %type <statement> explain_stmt explainable_stmt
-%type <statement> set_stmt set_variable_stmt set_password_stmt set_role_stmt set_default_role_stmt
+%type <statement> set_stmt set_variable_stmt set_password_stmt set_role_stmt set_default_role_stmt set_transaction_stmt
 %type <statement> revoke_stmt grant_stmt
-%type <statement> load_data_stmt
+%type <statement> load_data_stmt backup_stmt
 %type <statement> analyze_stmt
 %type <exportParm> export_data_param_opt
 
@@ -333,6 +346,8 @@ import (
 %type <tableDefs> table_elem_list_opt table_elem_list
 %type <tableDef> table_elem constaint_def constraint_elem
 %type <tableName> table_name
+%type <alterTableOption> alter_table_option alter_partition_option
+%type <alterTableOptions> alter_table_option_list
 %type <tableNames> table_name_list
 %type <columnTableDef> column_def
 %type <columnType> cast_type
@@ -411,8 +426,11 @@ import (
 %type <resourceOptions> conn_option_list conn_options
 %type <resourceOption> conn_option
 %type <updateExpr> update_expression
-%type <updateExprs> update_list
+%type <updateExprs> update_list on_duplicate_key_update_opt
 %type <completionType> completion_type
+%type <isolationLevel> isolation_level
+%type <selectLock> select_lock_opt
+%type <selectLockWait> select_lock_wait_opt
 %type <str> id_prefix_at password_opt
 %type <boolVal> grant_option_opt enforce enforce_opt
 
@@ -514,6 +532,7 @@ stmt_list:
 stmt:
     create_stmt
 |   insert_stmt
+|   replace_stmt
 |   delete_stmt
 |   drop_stmt
 |   explain_stmt
@@ -527,6 +546,7 @@ stmt:
 |   revoke_stmt
 |   grant_stmt
 |   load_data_stmt
+|   backup_stmt
 |   select_stmt
     {
         $$ = $1
@@ -536,6 +556,16 @@ stmt:
         $$ = tree.Statement(nil)
     }
 
+backup_stmt:
+    BACKUP TO STRING
+    {
+        $$ = tree.NewBackup($3, "")
+    }
+|   BACKUP TO STRING INCREMENTAL FROM STRING
+    {
+        $$ = tree.NewBackup($3, $6)
+    }
+
 load_data_stmt:
     LOAD DATA local_opt INFILE STRING duplicate_opt INTO TABLE table_name load_fields load_lines ignore_lines columns_or_variable_list_opt load_set_spec_opt
     {
@@ -1151,6 +1181,39 @@ set_stmt:
 |   set_password_stmt
 |   set_role_stmt
 |   set_default_role_stmt
+|   set_transaction_stmt
+
+set_transaction_stmt:
+    SET TRANSACTION ISOLATION LEVEL isolation_level
+    {
+        $$ = tree.NewSetTransaction(tree.SET_TRANSACTION_SCOPE_NEXT, $5)
+    }
+|   SET SESSION TRANSACTION ISOLATION LEVEL isolation_level
+    {
+        $$ = tree.NewSetTransaction(tree.SET_TRANSACTION_SCOPE_SESSION, $6)
+    }
+|   SET GLOBAL TRANSACTION ISOLATION LEVEL isolation_level
+    {
+        $$ = tree.NewSetTransaction(tree.SET_TRANSACTION_SCOPE_GLOBAL, $6)
+    }
+
+isolation_level:
+    READ UNCOMMITTED
+    {
+        $$ = tree.ISOLATION_LEVEL_READ_UNCOMMITTED
+    }
+|   READ COMMITTED
+    {
+        $$ = tree.ISOLATION_LEVEL_READ_COMMITTED
+    }
+|   REPEATABLE READ
+    {
+        $$ = tree.ISOLATION_LEVEL_REPEATABLE_READ
+    }
+|   SERIALIZABLE
+    {
+        $$ = tree.ISOLATION_LEVEL_SERIALIZABLE
+    }
 
 set_role_stmt:
     SET ROLE set_role_opt
@@ -1375,12 +1438,42 @@ transaction_stmt:
     begin_stmt
 |   commit_stmt
 |   rollback_stmt
+|   savepoint_stmt
+|   release_savepoint_stmt
 
 rollback_stmt:
     ROLLBACK completion_type
     {
         $$ = &tree.RollbackTransaction{Type: $2}
     }
+|   ROLLBACK TO ident
+    {
+        $$ = &tree.RollbackToSavepoint{Name: $3}
+    }
+|   ROLLBACK TO SAVEPOINT ident
+    {
+        $$ = &tree.RollbackToSavepoint{Name: $4}
+    }
+|   ROLLBACK WORK TO ident
+    {
+        $$ = &tree.RollbackToSavepoint{Name: $4}
+    }
+|   ROLLBACK WORK TO SAVEPOINT ident
+    {
+        $$ = &tree.RollbackToSavepoint{Name: $5}
+    }
+
+savepoint_stmt:
+    SAVEPOINT ident
+    {
+        $$ = &tree.Savepoint{Name: $2}
+    }
+
+release_savepoint_stmt:
+    RELEASE SAVEPOINT ident
+    {
+        $$ = &tree.ReleaseSavepoint{Name: $3}
+    }
 
 commit_stmt:
     COMMIT completion_type
@@ -1595,8 +1688,69 @@ analyze_stmt:
 
 alter_stmt:
     alter_user_stmt
+|   alter_table_stmt
 // |    alter_ddl_stmt
 
+alter_table_stmt:
+    ALTER TABLE table_name alter_table_option_list
+    {
+        $$ = tree.NewAlterTable($3, $4)
+    }
+|   ALTER TABLE table_name alter_partition_option
+    {
+        $$ = tree.NewAlterTable($3, []tree.AlterTableOption{$4})
+    }
+
+alter_table_option_list:
+    alter_table_option
+    {
+        $$ = []tree.AlterTableOption{$1}
+    }
+|   alter_table_option_list ',' alter_table_option
+    {
+        $$ = append($1, $3)
+    }
+
+alter_table_option:
+    ADD column_keyword_opt column_def
+    {
+        $$ = &tree.AlterTableAddColumn{Column: $3}
+    }
+|   DROP column_keyword_opt ident
+    {
+        $$ = &tree.AlterTableDropColumn{Name: tree.Identifier($3)}
+    }
+|   RENAME COLUMN ident TO ident
+    {
+        $$ = &tree.AlterTableRenameColumn{Name: tree.Identifier($3), NewName: tree.Identifier($5)}
+    }
+|   MODIFY column_keyword_opt column_def
+    {
+        $$ = &tree.AlterTableModifyColumn{Column: $3}
+    }
+|   COMPACT
+    {
+        $$ = &tree.AlterTableCompact{}
+    }
+
+alter_partition_option:
+    ADD PARTITION '(' partition_list ')'
+    {
+        $$ = &tree.AlterTableAddPartition{Partitions: $4}
+    }
+|   DROP PARTITION partition_id_list
+    {
+        $$ = &tree.AlterTableDropPartition{Names: $3}
+    }
+|   TRUNCATE PARTITION partition_id_list
+    {
+        $$ = &tree.AlterTableTruncatePartition{Names: $3}
+    }
+
+column_keyword_opt:
+    {}
+|   COLUMN
+
 alter_user_stmt:
     ALTER USER exists_opt user_spec_list require_clause_opt conn_options pwd_or_lck_opt
     {
@@ -1965,14 +2119,34 @@ delete_stmt:
 // |   DELETE comment_opt ignore_opt delete_table_list from_or_using table_references where_expression_opt
 
 insert_stmt:
-    INSERT into_table_name partition_clause_opt insert_data
+    INSERT into_table_name partition_clause_opt insert_data on_duplicate_key_update_opt
     {
         ins := $4
         ins.Table = $2
         ins.PartitionNames = $3
+        ins.OnDuplicateUpdate = $5
         $$ = ins
     }
 
+replace_stmt:
+    REPLACE into_table_name partition_clause_opt insert_data
+    {
+        ins := $4
+        ins.Table = $2
+        ins.PartitionNames = $3
+        ins.IsReplace = true
+        $$ = ins
+    }
+
+on_duplicate_key_update_opt:
+    {
+        $$ = nil
+    }
+|   ON DUPLICATE KEY UPDATE update_list
+    {
+        $$ = $5
+    }
+
 insert_data:
     VALUES values_list
     {
@@ -2277,10 +2451,40 @@ select_stmt:
         $$ = &tree.Select{Select: $1}
     }
 
+select_lock_opt:
+    {
+        $$ = nil
+    }
+|   FOR UPDATE select_lock_wait_opt
+    {
+        $$ = &tree.SelectLock{Mode: tree.SELECT_LOCK_FOR_UPDATE, Wait: $3}
+    }
+|   FOR SHARE select_lock_wait_opt
+    {
+        $$ = &tree.SelectLock{Mode: tree.SELECT_LOCK_FOR_SHARE, Wait: $3}
+    }
+|   LOCK IN SHARE MODE
+    {
+        $$ = &tree.SelectLock{Mode: tree.SELECT_LOCK_IN_SHARE_MODE}
+    }
+
+select_lock_wait_opt:
+    {
+        $$ = tree.SELECT_LOCK_WAIT
+    }
+|   NOWAIT
+    {
+        $$ = tree.SELECT_LOCK_NOWAIT
+    }
+|   SKIP LOCKED
+    {
+        $$ = tree.SELECT_LOCK_SKIP_LOCKED
+    }
+
 select_no_parens:
-    simple_select order_by_opt limit_opt export_data_param_opt // select_lock_opt
+    simple_select order_by_opt limit_opt export_data_param_opt select_lock_opt
     {
-        $$ = &tree.Select{Select: $1, OrderBy: $2, Limit: $3, Ep: $4}
+        $$ = &tree.Select{Select: $1, OrderBy: $2, Limit: $3, Ep: $4, Lock: $5}
     }
 |   select_with_parens order_by_clause export_data_param_opt
     {
@@ -2290,9 +2494,9 @@ select_no_parens:
     {
         $$ = &tree.Select{Select: $1, OrderBy: $2, Limit: $3, Ep: $4}
     }
-|	with_clause simple_select order_by_opt limit_opt export_data_param_opt // select_lock_opt
+|	with_clause simple_select order_by_opt limit_opt export_data_param_opt select_lock_opt
     {
-        $$ = &tree.Select{Select: $2, OrderBy: $3, Limit: $4, Ep: $5, With: $1}
+        $$ = &tree.Select{Select: $2, OrderBy: $3, Limit: $4, Ep: $5, With: $1, Lock: $6}
     }
 |   with_clause select_with_parens order_by_clause export_data_param_opt
     {
@@ -2785,6 +2989,33 @@ aliased_table_name:
             },
         }
     }
+|   table_name AS OF TIMESTAMP bit_expr
+    {
+        $$ = &tree.AliasedTableExpr{
+            Expr: $1,
+            AsOf: &tree.AsOfClause{Expr: $5},
+        }
+    }
+|   table_name table_alias AS OF TIMESTAMP bit_expr
+    {
+        $$ = &tree.AliasedTableExpr{
+            Expr: $1,
+            As: tree.AliasClause{
+                Alias: tree.Identifier($2),
+            },
+            AsOf: &tree.AsOfClause{Expr: $6},
+        }
+    }
+|   table_name AS table_alias AS OF TIMESTAMP bit_expr
+    {
+        $$ = &tree.AliasedTableExpr{
+            Expr: $1,
+            As: tree.AliasClause{
+                Alias: tree.Identifier($3),
+            },
+            AsOf: &tree.AsOfClause{Expr: $7},
+        }
+    }
 // |   table_name PARTITION '(' partition_id_list ')' as_opt_id index_hint_list
 
 
@@ -3426,6 +3657,26 @@ values_opt:
     {
         $$ = &tree.ValuesLessThan{ValueList: $5}
     }
+|   VALUES LESS THAN MAXVALUE
+    {
+        $$ = &tree.ValuesLessThan{ValueList: tree.Exprs{tree.NewMaxValue()}}
+    }
+|   VALUES LESS THAN '(' MAXVALUE ')'
+    {
+        $$ = &tree.ValuesLessThan{ValueList: tree.Exprs{tree.NewMaxValue()}}
+    }
+|   VALUES IN '(' expression_list ')'
+    {
+        valueList := make([]tree.Exprs, len($4))
+        for i, e := range $4 {
+            if tuple, ok := e.(*tree.Tuple); ok {
+                valueList[i] = tuple.Exprs
+            } else {
+                valueList[i] = tree.Exprs{e}
+            }
+        }
+        $$ = &tree.ValuesIn{ValueList: valueList}
+    }
 
 sub_partition_num_opt:
     {
@@ -3794,6 +4045,14 @@ constaint_def:
 			switch v := $2.(type) {
             case *tree.PrimaryKeyIndex:
             	v.Name = $1
+            case *tree.UniqueIndex:
+            	if v.Name == "" {
+            		v.Name = $1
+            	}
+            case *tree.ForeignKey:
+            	v.Name = $1
+            case *tree.CheckIndex:
+            	v.Name = $1
             }
 		}
 		$$ = $2
@@ -3874,7 +4133,7 @@ constraint_elem:
 
 enforce_opt:
 	{
-		$$ = false
+		$$ = true
 	}
 |	enforce
 
@@ -4019,6 +4278,10 @@ column_attribute_elem:
     {
         $$ = tree.NewAttributeStorage($2)
     }
+|   COMPRESSION STRING
+    {
+        $$ = tree.NewAttributeCompression($2)
+    }
 |   AUTO_RANDOM field_length_opt
     {
         $$ = tree.NewAttributeAutoRandom(int($2))
@@ -4029,7 +4292,7 @@ column_attribute_elem:
     }
 |   constraint_keyword_opt CHECK '(' expression ')'
     {
-        $$ = tree.NewAttributeCheck($4, false, $1)
+        $$ = tree.NewAttributeCheck($4, true, $1)
     }
 |   constraint_keyword_opt CHECK '(' expression ')' enforce
     {
@@ -4849,6 +5112,15 @@ function_call_keyword:
             Exprs: es,
         }
     }
+|   VALUES '(' insert_column ')'
+    {
+        name := tree.SetUnresolvedName("values")
+        arg := tree.SetUnresolvedName(strings.ToLower($3))
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{arg},
+        }
+    }
 |   PASSWORD '(' expression_list_opt ')'
     {
         name := tree.SetUnresolvedName("password")
@@ -4916,6 +5188,7 @@ name_confict:
 |   IF
 |   INTERVAL
 |   FORMAT
+|   LAST_INSERT_ID
 |   LEFT
 |   MICROSECOND
 |   MINUTE
@@ -6022,10 +6295,12 @@ reserved_keyword:
 |   NOT
 |   NONE
 |   NULL
+|   OF
 |   ON
 |   OR
 |   ORDER
 |   OUTER
+|   PARTITION
 |   REGEXP
 |   RENAME
 |   REPLACE
@@ -6090,6 +6365,11 @@ non_reserved_keyword:
 |   AUTO_RANDOM
 |   ACTION
 |   ALGORITHM
+|   BACKUP
+|   NOWAIT
+|   SKIP
+|   LOCKED
+|   SAVEPOINT
 |   BEGIN
 |   BIGINT
 |   BIT
@@ -6137,6 +6417,7 @@ non_reserved_keyword:
 |   GRANT
 |   INT
 |   INTEGER
+|   INCREMENTAL
 |   INDEXES
 |   ISOLATION
 |   JSON
@@ -6157,6 +6438,7 @@ non_reserved_keyword:
 |   MEDIUMTEXT
 |   MEMORY
 |   MODE
+|   MODIFY
 |   MULTILINESTRING
 |   MULTIPOINT
 |   MULTIPOLYGON
@@ -6179,7 +6461,6 @@ non_reserved_keyword:
 |   OPTION
 |   PACK_KEYS
 |   PARTIAL
-|   PARTITION
 |   PARTITIONS
 |   POINT
 |   POLYGON
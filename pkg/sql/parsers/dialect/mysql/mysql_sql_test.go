@@ -58,6 +58,12 @@ var (
 	}{{
 		input:  "SELECT md.datname as `Database` FROM TT md",
 		output: "select md.datname as Database from tt as md",
+	}, {
+		input:  "select a from t as of timestamp '2022-05-01 10:00:00'",
+		output: "select a from t as of timestamp 2022-05-01 10:00:00",
+	}, {
+		input:  "select t1.a from t1 x as of timestamp '2022-05-01' join t2 as y as of timestamp '2022-05-01' on x.a = y.a",
+		output: "select t1.a from t1 as x as of timestamp 2022-05-01 inner join t2 as y as of timestamp 2022-05-01 on x.a = y.a",
 	}, {
 		input:  "select * from t where a = `Hello`",
 		output: "select * from t where a = Hello",
@@ -113,6 +119,9 @@ var (
 	}, {
 		input:  "create table t (a int, b char, key idx1 type zonemap (a, b))",
 		output: "create table t (a int, b char, index idx1 using zonemap (a, b))",
+	}, {
+		input:  "create table t (a int compression 'delta', b varchar(20) compression 'dict')",
+		output: "create table t (a int compression delta, b varchar(20) compression dict)",
 	}, {
 		input: "create table t (a int, index idx1 using zonemap (a))",
 	}, {
@@ -146,6 +155,15 @@ var (
 	}, {
 		input:  "INSERT INTO t1 SET f1 = -1;",
 		output: "insert into t1 (f1) values (-1)",
+	}, {
+		input:  "insert into t1 (a, b) values (1, 2), (3, 4) on duplicate key update b = b + values(b), c = 1",
+		output: "insert into t1 (a, b) values (1, 2), (3, 4) on duplicate key update b = b + values(b), c = 1",
+	}, {
+		input:  "replace into t1 values (1, 2)",
+		output: "replace into t1 values (1, 2)",
+	}, {
+		input:  "REPLACE t1 (a) SELECT a FROM t2",
+		output: "replace into t1 (a) select a from t2",
 	}, {
 		input:  "insert into t1 values (18446744073709551615), (0xFFFFFFFFFFFFFFFE), (18446744073709551613), (18446744073709551612)",
 		output: "insert into t1 values (18446744073709551615), (0xfffffffffffffffe), (18446744073709551613), (18446744073709551612)",
@@ -160,6 +178,20 @@ var (
 		output: "load data infile /root/lineorder_flat_10.tbl into table lineorder_flat fields terminated by \t optionally enclosed by \u0000 lines",
 	}, {
 		input: "create table t (a int, b char, check (1 + 1) enforced)",
+	}, {
+		input:  "create table t (a int, b char, constraint c1 check (a > 0))",
+		output: "create table t (a int, b char, constraint c1 check (a > 0) enforced)",
+	}, {
+		input: "create table t (a int, b char, check (a > 0) not enforced)",
+	}, {
+		input:  "create table t (a int, b char, constraint u1 unique (a))",
+		output: "create table t (a int, b char, unique key u1 (a))",
+	}, {
+		input:  "create table t (a int, b int, constraint fk1 foreign key (b) references p(a) on delete set null)",
+		output: "create table t (a int, b int, foreign key fk1 (b) references p(a) on delete set null)",
+	}, {
+		input:  "create table t (a int not null check (a > 0))",
+		output: "create table t (a int not null constraint check (a > 0) enforced)",
 	}, {
 		input: "create table t (a int, b char, foreign key sdf (a, b) references b(a asc, b desc))",
 	}, {
@@ -387,6 +419,13 @@ var (
 		input: "create table a (a int) partition by range columns (a, b, db.t.c)",
 	}, {
 		input: "create table a (a int) partition by range(1 + 21)",
+	}, {
+		input:  "create table a (a int) partition by range(a) (partition p0 values less than (10), partition p1 values less than maxvalue)",
+		output: "create table a (a int) partition by range(a) (partition p0 values less than (10), partition p1 values less than (maxvalue))",
+	}, {
+		input: "create table a (a int) partition by list(a) (partition p0 values in (1, 3), partition p1 values in (2, null))",
+	}, {
+		input: "create table a (a int, b int) partition by list columns (a, b) (partition p0 values in ((1, 2), (3, 4)))",
 	}, {
 		input: "create table a (a int storage disk constraint cx check (b + c) enforced)",
 	}, {
@@ -468,12 +507,56 @@ var (
 		output: "commit",
 	}, {
 		input: "commit",
+	}, {
+		input: "savepoint sp1",
+	}, {
+		input: "rollback to savepoint sp1",
+	}, {
+		input:  "rollback work to sp1",
+		output: "rollback to savepoint sp1",
+	}, {
+		input: "release savepoint sp1",
+	}, {
+		input:  "rollback to savepoint",
+		output: "rollback to savepoint savepoint",
+	}, {
+		input:  "select savepoint from t",
+		output: "select savepoint from t",
+	}, {
+		input:  "select last_insert_id()",
+		output: "select last_insert_id() from dual",
+	}, {
+		input:  "create table t (a bigint auto_increment primary key, b int) auto_increment = 100",
+		output: "create table t (a bigint auto_increment primary key, b int) auto_increment = 100",
 	}, {
 		input: "start transaction read only",
 	}, {
 		input: "start transaction read write",
 	}, {
 		input: "start transaction",
+	}, {
+		input: "set transaction isolation level serializable",
+	}, {
+		input: "select a from t where b = 1 for update",
+	}, {
+		input:  "SELECT a FROM t WHERE b = 1 FOR SHARE NOWAIT",
+		output: "select a from t where b = 1 for share nowait",
+	}, {
+		input: "select a from t order by a limit 1 for update skip locked",
+	}, {
+		input: "select a from t lock in share mode",
+	}, {
+		input: "with c as (select a from t) select * from c for update",
+	}, {
+		input:  "SET SESSION TRANSACTION ISOLATION LEVEL REPEATABLE READ",
+		output: "set session transaction isolation level repeatable read",
+	}, {
+		input: "set global transaction isolation level read committed",
+	}, {
+		input: "set transaction isolation level read uncommitted",
+	}, {
+		input:  "set session transaction_isolation = 'SERIALIZABLE'",
+		output: "set transaction_isolation = SERIALIZABLE",
 	}, {
 		input: "use db1",
 	}, {
@@ -509,6 +592,28 @@ var (
 	}, {
 		input:  "alter user if exists user() identified by 'test'",
 		output: "alter user if exists user() identified by test",
+	}, {
+		input:  "alter table t1 add c int default 1, drop column b",
+		output: "alter table t1 add column c int default 1, drop column b",
+	}, {
+		input: "alter table db.t1 rename column a to b",
+	}, {
+		input:  "alter table t1 modify a bigint",
+		output: "alter table t1 modify column a bigint",
+	}, {
+		input: "alter table db.t1 compact",
+	}, {
+		input: "alter table t1 add partition (partition p2 values less than (30), partition p3 values less than (maxvalue))",
+	}, {
+		input: "alter table t1 drop partition p0, p1",
+	}, {
+		input: "alter table t1 truncate partition p2",
+	}, {
+		input:  "backup to '/data/backup/full'",
+		output: "backup to /data/backup/full",
+	}, {
+		input:  "backup to '/data/backup/inc' incremental from '/data/backup/full'",
+		output: "backup to /data/backup/inc incremental from /data/backup/full",
 	}, {
 		input: "show index from t where true",
 	}, {
@@ -62,6 +62,12 @@ func initTokens(dialectType dialect.DialectType) {
 		ESCAPED = MYSQL_ESCAPED
 		LOAD = MYSQL_LOAD
 		INFILE = MYSQL_INFILE
+		BACKUP = MYSQL_BACKUP
+		INCREMENTAL = MYSQL_INCREMENTAL
+		NOWAIT = MYSQL_NOWAIT
+		SKIP = MYSQL_SKIP
+		LOCKED = MYSQL_LOCKED
+		SAVEPOINT = MYSQL_SAVEPOINT
 		OUTFILE = MYSQL_OUTFILE
 		HEADER = MYSQL_HEADER
 		MAX_FILE_SIZE = MYSQL_MAX_FILE_SIZE
@@ -228,6 +234,7 @@ func initTokens(dialectType dialect.DialectType) {
 		LIMIT = MYSQL_LIMIT
 		OFFSET = MYSQL_OFFSET
 		FOR = MYSQL_FOR
+		OF = MYSQL_OF
 		ALL = MYSQL_ALL
 		DISTINCT = MYSQL_DISTINCT
 		AS = MYSQL_AS
@@ -354,6 +361,7 @@ func initTokens(dialectType dialect.DialectType) {
 		RENAME = MYSQL_RENAME
 		ANALYZE = MYSQL_ANALYZE
 		ADD = MYSQL_ADD
+		MODIFY = MYSQL_MODIFY
 		SCHEMA = MYSQL_SCHEMA
 		TABLE = MYSQL_TABLE
 		INDEX = MYSQL_INDEX
@@ -465,6 +473,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"avg":                      AVG,
 		"bsi":                      BSI,
 		"before":                   UNUSED,
+		"backup":                   BACKUP,
 		"begin":                    BEGIN,
 		"between":                  BETWEEN,
 		"bigint":                   BIGINT,
@@ -602,6 +611,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"if":                       IF,
 		"ignore":                   IGNORE,
 		"in":                       IN,
+		"incremental":              INCREMENTAL,
 		"index":                    INDEX,
 		"indexes":                  INDEXES,
 		"infile":                   INFILE,
@@ -647,6 +657,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"localtime":                LOCALTIME,
 		"localtimestamp":           LOCALTIMESTAMP,
 		"lock":                     LOCK,
+		"locked":                   LOCKED,
 		"long":                     UNUSED,
 		"longblob":                 LONGBLOB,
 		"longtext":                 LONGTEXT,
@@ -664,6 +675,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"microsecond":              MICROSECOND,
 		"mod":                      MOD,
 		"month":                    MONTH,
+		"modify":                   MODIFY,
 		"mode":                     MODE,
 		"memory":                   MEMORY,
 		"modifies":                 UNUSED,
@@ -687,7 +699,9 @@ func initTokens(dialectType dialect.DialectType) {
 		"null":                     NULL,
 		"numeric":                  NUMERIC,
 		"none":                     NONE,
+		"nowait":                   NOWAIT,
 		"offset":                   OFFSET,
+		"of":                       OF,
 		"on":                       ON,
 		"only":                     ONLY,
 		"optimize":                 OPTIMIZE,
@@ -752,6 +766,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"row_format":               ROW_FORMAT,
 		"row_count":                ROW_COUNT,
 		"rtree":                    RTREE,
+		"savepoint":                SAVEPOINT,
 		"schema":                   SCHEMA,
 		"schemas":                  UNUSED,
 		"second":                   SECOND,
@@ -762,6 +777,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"session":                  SESSION,
 		"set":                      SET,
 		"share":                    SHARE,
+		"skip":                     SKIP,
 		"show":                     SHOW,
 		"shutdown":                 SHUTDOWN,
 		"signal":                   UNUSED,
@@ -1085,6 +1101,7 @@ var (
 	LIMIT                    int
 	OFFSET                   int
 	FOR                      int
+	OF                       int
 	ALL                      int
 	DISTINCT                 int
 	AS                       int
@@ -1211,6 +1228,7 @@ var (
 	RENAME                   int
 	ANALYZE                  int
 	ADD                      int
+	MODIFY                   int
 	SCHEMA                   int
 	TABLE                    int
 	INDEX                    int
@@ -1298,6 +1316,12 @@ var (
 	AVG                      int
 	LOAD                     int
 	INFILE                   int
+	BACKUP                   int
+	INCREMENTAL              int
+	NOWAIT                   int
+	SKIP                     int
+	LOCKED                   int
+	SAVEPOINT                int
 	OUTFILE                  int
 	HEADER                   int
 	MAX_FILE_SIZE            int
@@ -82,3 +82,129 @@ func NewAlterUser(ife bool, iuf bool, uf *User, u []*User, r []*Role, t []TlsOpt
 		MiscOpts:   m,
 	}
 }
+
+type AlterTable struct {
+	statementImpl
+	Table   *TableName
+	Options []AlterTableOption
+}
+
+func (node *AlterTable) Format(ctx *FmtCtx) {
+	ctx.WriteString("alter table ")
+	node.Table.Format(ctx)
+	prefix := " "
+	for _, o := range node.Options {
+		ctx.WriteString(prefix)
+		o.Format(ctx)
+		prefix = ", "
+	}
+}
+
+func NewAlterTable(t *TableName, o []AlterTableOption) *AlterTable {
+	return &AlterTable{
+		Table:   t,
+		Options: o,
+	}
+}
+
+type AlterTableOption interface {
+	NodeFormatter
+}
+
+type alterTableOptionImpl struct {
+	AlterTableOption
+}
+
+// ADD COLUMN
+type AlterTableAddColumn struct {
+	alterTableOptionImpl
+	Column *ColumnTableDef
+}
+
+func (node *AlterTableAddColumn) Format(ctx *FmtCtx) {
+	ctx.WriteString("add column ")
+	node.Column.Format(ctx)
+}
+
+// DROP COLUMN
+type AlterTableDropColumn struct {
+	alterTableOptionImpl
+	Name Identifier
+}
+
+func (node *AlterTableDropColumn) Format(ctx *FmtCtx) {
+	ctx.WriteString("drop column ")
+	ctx.WriteString(string(node.Name))
+}
+
+// RENAME COLUMN
+type AlterTableRenameColumn struct {
+	alterTableOptionImpl
+	Name    Identifier
+	NewName Identifier
+}
+
+func (node *AlterTableRenameColumn) Format(ctx *FmtCtx) {
+	ctx.WriteString("rename column ")
+	ctx.WriteString(string(node.Name))
+	ctx.WriteString(" to ")
+	ctx.WriteString(string(node.NewName))
+}
+
+// MODIFY COLUMN
+type AlterTableModifyColumn struct {
+	alterTableOptionImpl
+	Column *ColumnTableDef
+}
+
+func (node *AlterTableModifyColumn) Format(ctx *FmtCtx) {
+	ctx.WriteString("modify column ")
+	node.Column.Format(ctx)
+}
+
+// COMPACT
+type AlterTableCompact struct {
+	alterTableOptionImpl
+}
+
+func (node *AlterTableCompact) Format(ctx *FmtCtx) {
+	ctx.WriteString("compact")
+}
+
+// ADD PARTITION
+type AlterTableAddPartition struct {
+	alterTableOptionImpl
+	Partitions []*Partition
+}
+
+func (node *AlterTableAddPartition) Format(ctx *FmtCtx) {
+	prefix := "add partition ("
+	for _, p := range node.Partitions {
+		ctx.WriteString(prefix)
+		p.Format(ctx)
+		prefix = ", "
+	}
+	ctx.WriteByte(')')
+}
+
+// DROP PARTITION
+type AlterTableDropPartition struct {
+	alterTableOptionImpl
+	Names IdentifierList
+}
+
+func (node *AlterTableDropPartition) Format(ctx *FmtCtx) {
+	ctx.WriteString("drop partition ")
+	node.Names.Format(ctx)
+}
+
+// TRUNCATE PARTITION
+type AlterTableTruncatePartition struct {
+	alterTableOptionImpl
+	Names IdentifierList
+}
+
+func (node *AlterTableTruncatePartition) Format(ctx *FmtCtx) {
+	ctx.WriteString("truncate partition ")
+	node.Names.Format(ctx)
+}
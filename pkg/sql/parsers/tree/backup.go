@@ -0,0 +1,37 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+// Backup statement
+type Backup struct {
+	statementImpl
+	// Dir is the directory the backup is written to
+	Dir string
+	// Base is the directory of the backup an incremental backup is based on
+	Base string
+}
+
+func (node *Backup) Format(ctx *FmtCtx) {
+	ctx.WriteString("backup to ")
+	ctx.WriteString(node.Dir)
+	if node.Base != "" {
+		ctx.WriteString(" incremental from ")
+		ctx.WriteString(node.Base)
+	}
+}
+
+func NewBackup(dir, base string) *Backup {
+	return &Backup{Dir: dir, Base: base}
+}
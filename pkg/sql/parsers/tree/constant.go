@@ -41,6 +41,12 @@ type NumVal struct {
 }
 
 func (node *NumVal) Format(ctx *FmtCtx) {
+	if ctx.quoteString && node.Value.Kind() == constant.String {
+		ctx.WriteByte('\'')
+		ctx.WriteString(strings.NewReplacer(`\`, `\\`, `'`, `''`).Replace(constant.StringVal(node.Value)))
+		ctx.WriteByte('\'')
+		return
+	}
 	if node.origString != "" {
 		ctx.WriteString(node.origString)
 		return
@@ -100,3 +106,24 @@ func (node *StrVal) Format(ctx *FmtCtx) {
 func NewStrVal(s string) *StrVal {
 	return &StrVal{str: s}
 }
+
+// ParamExpr represents a parameter of a parameterized statement.
+type ParamExpr struct {
+	Constant
+	// Offset is the position of the parameter, starting from 1.
+	Offset int
+	// Value is the literal replaced by the parameter.
+	Value *NumVal
+}
+
+func (node *ParamExpr) Format(ctx *FmtCtx) {
+	ctx.WriteByte('?')
+}
+
+func (node *ParamExpr) String() string {
+	return "?"
+}
+
+func NewParamExpr(offset int, value *NumVal) *ParamExpr {
+	return &ParamExpr{Offset: offset, Value: value}
+}
@@ -374,6 +374,22 @@ func NewAttributeStorage(s string) *AttributeStorage {
 	}
 }
 
+type AttributeCompression struct {
+	columnAttributeImpl
+	Compression string
+}
+
+func (node *AttributeCompression) Format(ctx *FmtCtx) {
+	ctx.WriteString("compression ")
+	ctx.WriteString(node.Compression)
+}
+
+func NewAttributeCompression(c string) *AttributeCompression {
+	return &AttributeCompression{
+		Compression: c,
+	}
+}
+
 type AttributeCheckConstraint struct {
 	columnAttributeImpl
 	Name     string
@@ -904,16 +920,24 @@ func NewFullTextIndex(k []*KeyPart, n string, e bool, io *IndexOption) *FullText
 
 type CheckIndex struct {
 	tableDefImpl
+	Name     string
 	Expr     Expr
 	Enforced bool
 }
 
 func (node *CheckIndex) Format(ctx *FmtCtx) {
+	if node.Name != "" {
+		ctx.WriteString("constraint ")
+		ctx.WriteString(node.Name)
+		ctx.WriteByte(' ')
+	}
 	ctx.WriteString("check (")
 	node.Expr.Format(ctx)
 	ctx.WriteByte(')')
 	if node.Enforced {
 		ctx.WriteString(" enforced")
+	} else {
+		ctx.WriteString(" not enforced")
 	}
 }
 
@@ -1613,6 +1637,22 @@ type ValuesIn struct {
 	ValueList []Exprs
 }
 
+func (node *ValuesIn) Format(ctx *FmtCtx) {
+	prefix := "values in ("
+	for _, e := range node.ValueList {
+		ctx.WriteString(prefix)
+		if len(e) == 1 {
+			e.Format(ctx)
+		} else {
+			ctx.WriteByte('(')
+			e.Format(ctx)
+			ctx.WriteByte(')')
+		}
+		prefix = ", "
+	}
+	ctx.WriteByte(')')
+}
+
 func NewValuesIn(vl []Exprs) *ValuesIn {
 	return &ValuesIn{
 		ValueList: vl,
@@ -787,6 +787,10 @@ type MaxValue struct {
 	exprImpl
 }
 
+func (node *MaxValue) Format(ctx *FmtCtx) {
+	ctx.WriteString("maxvalue")
+}
+
 func NewMaxValue() *MaxValue {
 	return &MaxValue{}
 }
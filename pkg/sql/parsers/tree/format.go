@@ -24,6 +24,8 @@ import (
 type FmtCtx struct {
 	*strings.Builder
 	dialectType dialect.DialectType
+	// quoteString is true if the string literals are written quoted
+	quoteString bool
 }
 
 func NewFmtCtx(dialectType dialect.DialectType) *FmtCtx {
@@ -48,6 +50,19 @@ func String(node NodeFormatter, dialectType dialect.DialectType) string {
 	return ctx.String()
 }
 
+// StringWithQuote is String with the string literals quoted and escaped,
+// so that the result can be parsed again.
+func StringWithQuote(node NodeFormatter, dialectType dialect.DialectType) string {
+	if node == nil {
+		return "<nil>"
+	}
+
+	ctx := NewFmtCtx(dialectType)
+	ctx.quoteString = true
+	node.Format(ctx)
+	return ctx.String()
+}
+
 func (ctx *FmtCtx) PrintExpr(currentExpr Expr, expr Expr, left bool) {
 	if precedenceFor(currentExpr) == Syntactic {
 		expr.Format(ctx)
@@ -21,10 +21,18 @@ type Insert struct {
 	PartitionNames IdentifierList
 	Columns        IdentifierList
 	Rows           *Select
+	//the REPLACE statement, the rows with the same primary key are replaced
+	IsReplace bool
+	//the assignments of ON DUPLICATE KEY UPDATE
+	OnDuplicateUpdate UpdateExprs
 }
 
 func (node *Insert) Format(ctx *FmtCtx) {
-	ctx.WriteString("insert into ")
+	if node.IsReplace {
+		ctx.WriteString("replace into ")
+	} else {
+		ctx.WriteString("insert into ")
+	}
 	node.Table.Format(ctx)
 
 	if node.PartitionNames != nil {
@@ -42,6 +50,10 @@ func (node *Insert) Format(ctx *FmtCtx) {
 		ctx.WriteByte(' ')
 		node.Rows.Format(ctx)
 	}
+	if node.OnDuplicateUpdate != nil {
+		ctx.WriteString(" on duplicate key update ")
+		node.OnDuplicateUpdate.Format(ctx)
+	}
 }
 
 func NewInsert(t TableExpr, c IdentifierList, r *Select, p IdentifierList) *Insert {
@@ -31,6 +31,7 @@ type Select struct {
 	Limit   *Limit
 	With    *With
 	Ep      *ExportParam
+	Lock    *SelectLock
 }
 
 func (node *Select) Format(ctx *FmtCtx) {
@@ -51,6 +52,10 @@ func (node *Select) Format(ctx *FmtCtx) {
 		ctx.WriteByte(' ')
 		node.Ep.Format(ctx)
 	}
+	if node.Lock != nil {
+		ctx.WriteByte(' ')
+		node.Lock.Format(ctx)
+	}
 }
 
 func NewSelect(s SelectStatement, o OrderBy, l *Limit) *Select {
@@ -61,6 +66,54 @@ func NewSelect(s SelectStatement, o OrderBy, l *Limit) *Select {
 	}
 }
 
+// SelectLockMode is the row lock taken by a locking read.
+type SelectLockMode int
+
+const (
+	SELECT_LOCK_FOR_UPDATE SelectLockMode = iota
+	SELECT_LOCK_FOR_SHARE
+	SELECT_LOCK_IN_SHARE_MODE
+)
+
+// SelectLockWait is what a locking read does on a row locked by another
+// transaction.
+type SelectLockWait int
+
+const (
+	SELECT_LOCK_WAIT SelectLockWait = iota
+	SELECT_LOCK_NOWAIT
+	SELECT_LOCK_SKIP_LOCKED
+)
+
+// SelectLock represents the locking clause of a SELECT, FOR UPDATE,
+// FOR SHARE or LOCK IN SHARE MODE.
+type SelectLock struct {
+	Mode SelectLockMode
+	Wait SelectLockWait
+}
+
+func (node *SelectLock) Format(ctx *FmtCtx) {
+	switch node.Mode {
+	case SELECT_LOCK_FOR_UPDATE:
+		ctx.WriteString("for update")
+	case SELECT_LOCK_FOR_SHARE:
+		ctx.WriteString("for share")
+	case SELECT_LOCK_IN_SHARE_MODE:
+		ctx.WriteString("lock in share mode")
+	}
+	switch node.Wait {
+	case SELECT_LOCK_NOWAIT:
+		ctx.WriteString(" nowait")
+	case SELECT_LOCK_SKIP_LOCKED:
+		ctx.WriteString(" skip locked")
+	}
+}
+
+// Exclusive returns true if the rows are locked for update.
+func (node *SelectLock) Exclusive() bool {
+	return node.Mode == SELECT_LOCK_FOR_UPDATE
+}
+
 // OrderBy represents an ORDER BY clause.
 type OrderBy []*Order
 
@@ -402,6 +455,7 @@ type AliasedTableExpr struct {
 	TableExpr
 	Expr TableExpr
 	As   AliasClause
+	AsOf *AsOfClause
 }
 
 func (node *AliasedTableExpr) Format(ctx *FmtCtx) {
@@ -410,6 +464,22 @@ func (node *AliasedTableExpr) Format(ctx *FmtCtx) {
 		ctx.WriteString(" as ")
 		node.As.Format(ctx)
 	}
+	if node.AsOf != nil {
+		ctx.WriteByte(' ')
+		node.AsOf.Format(ctx)
+	}
+}
+
+//The AS OF TIMESTAMP clause of a table:
+// "AS OF TIMESTAMP expr", the table is read as it was at the timestamp.
+type AsOfClause struct {
+	NodeFormatter
+	Expr Expr
+}
+
+func (node *AsOfClause) Format(ctx *FmtCtx) {
+	ctx.WriteString("as of timestamp ")
+	node.Expr.Format(ctx)
 }
 
 func NewAliasedTableExpr(e TableExpr, a AliasClause) *AliasedTableExpr {
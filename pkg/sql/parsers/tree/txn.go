@@ -94,3 +94,93 @@ func (node *RollbackTransaction) Format(ctx *FmtCtx) {
 func NewRollbackTransaction(t CompletionType) *RollbackTransaction {
 	return &RollbackTransaction{Type: t}
 }
+
+//Savepoint statement
+type Savepoint struct {
+	statementImpl
+	Name string
+}
+
+func (node *Savepoint) Format(ctx *FmtCtx) {
+	ctx.WriteString("savepoint ")
+	ctx.WriteString(node.Name)
+}
+
+//RollbackToSavepoint statement
+type RollbackToSavepoint struct {
+	statementImpl
+	Name string
+}
+
+func (node *RollbackToSavepoint) Format(ctx *FmtCtx) {
+	ctx.WriteString("rollback to savepoint ")
+	ctx.WriteString(node.Name)
+}
+
+//ReleaseSavepoint statement
+type ReleaseSavepoint struct {
+	statementImpl
+	Name string
+}
+
+func (node *ReleaseSavepoint) Format(ctx *FmtCtx) {
+	ctx.WriteString("release savepoint ")
+	ctx.WriteString(node.Name)
+}
+
+//the isolation level for a transaction.
+type IsolationLevel int
+
+const (
+	ISOLATION_LEVEL_READ_UNCOMMITTED IsolationLevel = iota
+	ISOLATION_LEVEL_READ_COMMITTED
+	ISOLATION_LEVEL_REPEATABLE_READ
+	ISOLATION_LEVEL_SERIALIZABLE
+)
+
+func (level IsolationLevel) String() string {
+	switch level {
+	case ISOLATION_LEVEL_READ_UNCOMMITTED:
+		return "read uncommitted"
+	case ISOLATION_LEVEL_READ_COMMITTED:
+		return "read committed"
+	case ISOLATION_LEVEL_REPEATABLE_READ:
+		return "repeatable read"
+	case ISOLATION_LEVEL_SERIALIZABLE:
+		return "serializable"
+	}
+	return ""
+}
+
+//the scope of the characteristics set by SET TRANSACTION.
+type SetTransactionScope int
+
+const (
+	//the next transaction in the session
+	SET_TRANSACTION_SCOPE_NEXT SetTransactionScope = iota
+	SET_TRANSACTION_SCOPE_SESSION
+	SET_TRANSACTION_SCOPE_GLOBAL
+)
+
+//SetTransaction statement
+type SetTransaction struct {
+	statementImpl
+	Scope SetTransactionScope
+	Level IsolationLevel
+}
+
+func (node *SetTransaction) Format(ctx *FmtCtx) {
+	ctx.WriteString("set ")
+	switch node.Scope {
+	case SET_TRANSACTION_SCOPE_SESSION:
+		ctx.WriteString("session ")
+	case SET_TRANSACTION_SCOPE_GLOBAL:
+		ctx.WriteString("global ")
+	}
+	ctx.WriteString("transaction isolation level ")
+	ctx.WriteString(node.Level.String())
+}
+
+func NewSetTransaction(s SetTransactionScope, l IsolationLevel) *SetTransaction {
+	return &SetTransaction{Scope: s, Level: l}
+}
@@ -29,6 +29,9 @@ func BuildPlan(ctx CompilerContext, stmt tree.Statement) (*Plan, error) {
 		query, binderCtx := newQueryAndSelectCtx(plan.Query_SELECT)
 		nodeId, err := buildSelect(stmt, ctx, query, binderCtx)
 		query.Steps = append(query.Steps, nodeId)
+		if err == nil {
+			prunePartitions(query)
+		}
 		return &Plan{
 			Plan: &plan.Plan_Query{
 				Query: query,
@@ -61,6 +64,8 @@ func BuildPlan(ctx CompilerContext, stmt tree.Statement) (*Plan, error) {
 		return buildCreateTable(stmt, ctx)
 	case *tree.DropTable:
 		return buildDropTable(stmt, ctx)
+	case *tree.AlterTable:
+		return buildAlterTable(stmt, ctx)
 	case *tree.CreateIndex:
 		return buildCreateIndex(stmt, ctx)
 	case *tree.DropIndex:
@@ -16,12 +16,15 @@ package plan2
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 )
 
 func buildCreateTable(stmt *tree.CreateTable, ctx CompilerContext) (*Plan, error) {
@@ -87,9 +90,33 @@ func buildCreateTable(stmt *tree.CreateTable, ctx CompilerContext) (*Plan, error
 					},
 				},
 			})
+		case *tree.TableOptionAutoIncrement:
+			// The engines only read the first properties of a table, the
+			// property is added to them if any
+			property := &plan.Property{
+				Key:   engine.AutoIncrementProperty,
+				Value: strconv.FormatUint(opt.Value, 10),
+			}
+			added := false
+			for _, def := range createTable.TableDef.Defs {
+				if propertiesDef, ok := def.Def.(*plan.TableDef_DefType_Properties); ok {
+					propertiesDef.Properties.Properties = append(propertiesDef.Properties.Properties, property)
+					added = true
+					break
+				}
+			}
+			if !added {
+				createTable.TableDef.Defs = append(createTable.TableDef.Defs, &plan.TableDef_DefType{
+					Def: &plan.TableDef_DefType_Properties{
+						Properties: &plan.PropertiesDef{
+							Properties: []*plan.Property{property},
+						},
+					},
+				})
+			}
 		// these table options is not support in plan
 		// case *tree.TableOptionEngine, *tree.TableOptionSecondaryEngine, *tree.TableOptionCharset,
-		// 	*tree.TableOptionCollate, *tree.TableOptionAutoIncrement, *tree.TableOptionComment,
+		// 	*tree.TableOptionCollate, *tree.TableOptionComment,
 		// 	*tree.TableOptionAvgRowLength, *tree.TableOptionChecksum, *tree.TableOptionCompression,
 		// 	*tree.TableOptionConnection, *tree.TableOptionPassword, *tree.TableOptionKeyBlockSize,
 		// 	*tree.TableOptionMaxRows, *tree.TableOptionMinRows, *tree.TableOptionDelayKeyWrite,
@@ -103,9 +130,13 @@ func buildCreateTable(stmt *tree.CreateTable, ctx CompilerContext) (*Plan, error
 		}
 	}
 
-	// set partition(unsupport now)
+	// set partition
 	if stmt.PartitionOption != nil {
-		return nil, errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("partition unsupport now; statement: '%v'", tree.String(stmt, dialect.MYSQL)))
+		def, err := buildPartitionDef(stmt.PartitionOption, createTable.TableDef)
+		if err != nil {
+			return nil, err
+		}
+		createTable.TableDef.Defs = append(createTable.TableDef.Defs, def)
 	}
 
 	return &Plan{
@@ -122,29 +153,38 @@ func buildCreateTable(stmt *tree.CreateTable, ctx CompilerContext) (*Plan, error
 
 func buildTableDefs(defs tree.TableDefs, ctx CompilerContext, tableDef *TableDef) error {
 	var primaryKeys []string
+	autoIncrements := 0
 	for _, item := range defs {
 		switch def := item.(type) {
 		case *tree.ColumnTableDef:
-			colType, err := getTypeFromAst(def.Type)
+			col, err := buildColumnDef(def)
 			if err != nil {
 				return err
 			}
-			defultValue, err := getDefaultExprFromColumn(def, colType)
-			if err != nil {
-				return err
-			}
-			col := &ColDef{
-				Name:    def.Name.Parts[0],
-				Alg:     plan.CompressType_Lz4,
-				Typ:     colType,
-				Default: defultValue,
+			if col.AutoIncrement {
+				if autoIncrements++; autoIncrements > 1 {
+					return errors.New(errno.InvalidTableDefinition, "there can be only one auto column")
+				}
 			}
 			tableDef.Cols = append(tableDef.Cols, col)
 
 			var pks []string
 			for _, attr := range def.Attributes {
-				if _, ok := attr.(*tree.AttributePrimaryKey); ok {
+				switch a := attr.(type) {
+				case *tree.AttributePrimaryKey:
 					pks = append(pks, def.Name.Parts[0])
+				case *tree.AttributeUnique, *tree.AttributeUniqueKey:
+					tableDef.Defs = append(tableDef.Defs, buildUniqueDef("", []string{col.Name}))
+				case *tree.AttributeCheckConstraint:
+					if a.Enforced {
+						tableDef.Defs = append(tableDef.Defs, buildCheckDef(a.Name, a.Expr))
+					}
+				case *tree.AttributeReference:
+					fk, err := buildForeignKeyDef("", []string{col.Name}, a)
+					if err != nil {
+						return err
+					}
+					tableDef.Defs = append(tableDef.Defs, fk)
 				}
 			}
 			if len(pks) > 0 {
@@ -200,7 +240,27 @@ func buildTableDefs(defs tree.TableDefs, ctx CompilerContext, tableDef *TableDef
 					Idx: idxDef,
 				},
 			})
-		case *tree.UniqueIndex, *tree.CheckIndex, *tree.ForeignKey, *tree.FullTextIndex:
+		case *tree.UniqueIndex:
+			names, err := getKeyPartNames(def.KeyParts)
+			if err != nil {
+				return err
+			}
+			tableDef.Defs = append(tableDef.Defs, buildUniqueDef(def.Name, names))
+		case *tree.CheckIndex:
+			if def.Enforced {
+				tableDef.Defs = append(tableDef.Defs, buildCheckDef(def.Name, def.Expr))
+			}
+		case *tree.ForeignKey:
+			names, err := getKeyPartNames(def.KeyParts)
+			if err != nil {
+				return err
+			}
+			fk, err := buildForeignKeyDef(def.Name, names, def.Refer)
+			if err != nil {
+				return err
+			}
+			tableDef.Defs = append(tableDef.Defs, fk)
+		case *tree.FullTextIndex:
 			// unsupport in plan. will support in next version.
 			return errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unsupport table def: '%v'", def))
 		default:
@@ -209,6 +269,14 @@ func buildTableDefs(defs tree.TableDefs, ctx CompilerContext, tableDef *TableDef
 	}
 
 	if len(primaryKeys) > 0 {
+		// primary key columns are implicitly NOT NULL
+		for _, name := range primaryKeys {
+			for _, col := range tableDef.Cols {
+				if col.Name == name {
+					col.NotNull = true
+				}
+			}
+		}
 		tableDef.Defs = append(tableDef.Defs, &plan.TableDef_DefType{
 			Def: &plan.TableDef_DefType_Pk{
 				Pk: &plan.PrimaryKeyDef{
@@ -218,9 +286,344 @@ func buildTableDefs(defs tree.TableDefs, ctx CompilerContext, tableDef *TableDef
 		})
 	}
 
+	return checkConstraintDefs(ctx, tableDef)
+}
+
+func getKeyPartNames(keys []*tree.KeyPart) ([]string, error) {
+	names := make([]string, len(keys))
+	nameMap := map[string]bool{}
+	for i, key := range keys {
+		name := key.ColName.Parts[0]
+		if _, ok := nameMap[name]; ok {
+			return nil, errors.New(errno.InvalidTableDefinition, fmt.Sprintf("Duplicate column name '%s'", name))
+		}
+		names[i] = name
+		nameMap[name] = true
+	}
+	return names, nil
+}
+
+func buildUniqueDef(name string, colNames []string) *plan.TableDef_DefType {
+	return &plan.TableDef_DefType{
+		Def: &plan.TableDef_DefType_Unique{
+			Unique: &plan.UniqueIndexDef{
+				Name:     name,
+				ColNames: colNames,
+			},
+		},
+	}
+}
+
+func buildCheckDef(name string, expr tree.Expr) *plan.TableDef_DefType {
+	return &plan.TableDef_DefType{
+		Def: &plan.TableDef_DefType_Check{
+			Check: &plan.CheckDef{
+				Name: name,
+				Expr: tree.StringWithQuote(expr, dialect.MYSQL),
+			},
+		},
+	}
+}
+
+func buildForeignKeyDef(name string, colNames []string, refer *tree.AttributeReference) (*plan.TableDef_DefType, error) {
+	refColNames, err := getKeyPartNames(refer.KeyParts)
+	if err != nil {
+		return nil, err
+	}
+	onDelete, err := getRefAction(refer.OnDelete)
+	if err != nil {
+		return nil, err
+	}
+	onUpdate, err := getRefAction(refer.OnUpdate)
+	if err != nil {
+		return nil, err
+	}
+	return &plan.TableDef_DefType{
+		Def: &plan.TableDef_DefType_Fk{
+			Fk: &plan.ForeignKeyDef{
+				Name:        name,
+				ColNames:    colNames,
+				RefDatabase: string(refer.TableName.SchemaName),
+				RefTable:    string(refer.TableName.ObjectName),
+				RefColNames: refColNames,
+				OnDelete:    onDelete,
+				OnUpdate:    onUpdate,
+			},
+		},
+	}, nil
+}
+
+func getRefAction(typ tree.ReferenceOptionType) (plan.ForeignKeyDef_RefAction, error) {
+	switch typ {
+	case tree.REFERENCE_OPTION_INVALID, tree.REFERENCE_OPTION_RESTRICT, tree.REFERENCE_OPTION_NO_ACTION:
+		return plan.ForeignKeyDef_RESTRICT, nil
+	case tree.REFERENCE_OPTION_CASCADE:
+		return plan.ForeignKeyDef_CASCADE, nil
+	case tree.REFERENCE_OPTION_SET_NULL:
+		return plan.ForeignKeyDef_SET_NULL, nil
+	default:
+		return 0, errors.New(errno.FeatureNotSupported, fmt.Sprintf("unsupport reference option '%s'", typ.ToString()))
+	}
+}
+
+// checkConstraintDefs validates the unique, check and foreign key
+// definitions against the columns of the table and assigns default
+// names to the anonymous ones the way MySQL does.
+func checkConstraintDefs(ctx CompilerContext, tableDef *TableDef) error {
+	cols := make(map[string]*ColDef, len(tableDef.Cols))
+	for _, col := range tableDef.Cols {
+		cols[col.Name] = col
+	}
+	checks, fks := 0, 0
+	for _, def := range tableDef.Defs {
+		switch d := def.Def.(type) {
+		case *plan.TableDef_DefType_Unique:
+			for _, name := range d.Unique.ColNames {
+				if _, ok := cols[name]; !ok {
+					return errors.New(errno.UndefinedColumn, fmt.Sprintf("Key column '%s' doesn't exist in table", name))
+				}
+			}
+			if d.Unique.Name == "" {
+				d.Unique.Name = d.Unique.ColNames[0]
+			}
+		case *plan.TableDef_DefType_Check:
+			if checks++; d.Check.Name == "" {
+				d.Check.Name = fmt.Sprintf("%s_chk_%d", tableDef.Name, checks)
+			}
+		case *plan.TableDef_DefType_Fk:
+			fk := d.Fk
+			if fks++; fk.Name == "" {
+				fk.Name = fmt.Sprintf("%s_ibfk_%d", tableDef.Name, fks)
+			}
+			if len(fk.ColNames) != len(fk.RefColNames) {
+				return errors.New(errno.InvalidForeignKey, fmt.Sprintf("Incorrect foreign key definition for '%s': Key reference and table reference don't match", fk.Name))
+			}
+			refCols := cols
+			if fk.RefTable != tableDef.Name || (fk.RefDatabase != "" && fk.RefDatabase != ctx.DefaultDatabase()) {
+				_, refDef := ctx.Resolve(fk.RefDatabase, fk.RefTable)
+				if refDef == nil {
+					return errors.New(errno.UndefinedTable, fmt.Sprintf("Failed to open the referenced table '%s'", fk.RefTable))
+				}
+				refCols = make(map[string]*ColDef, len(refDef.Cols))
+				for _, col := range refDef.Cols {
+					refCols[col.Name] = col
+				}
+			}
+			for i, name := range fk.ColNames {
+				col, ok := cols[name]
+				if !ok {
+					return errors.New(errno.UndefinedColumn, fmt.Sprintf("Key column '%s' doesn't exist in table", name))
+				}
+				refCol, ok := refCols[fk.RefColNames[i]]
+				if !ok {
+					return errors.New(errno.InvalidForeignKey, fmt.Sprintf("Failed to add the foreign key constraint. Missing column '%s' for constraint '%s' in the referenced table '%s'", fk.RefColNames[i], fk.Name, fk.RefTable))
+				}
+				if col.Typ.Id != refCol.Typ.Id {
+					return errors.New(errno.InvalidForeignKey, fmt.Sprintf("Referencing column '%s' and referenced column '%s' in foreign key constraint '%s' are incompatible", name, refCol.Name, fk.Name))
+				}
+				if fk.OnDelete == plan.ForeignKeyDef_SET_NULL || fk.OnUpdate == plan.ForeignKeyDef_SET_NULL {
+					if col.NotNull {
+						return errors.New(errno.InvalidForeignKey, fmt.Sprintf("Column '%s' cannot be NOT NULL: needed in a foreign key constraint '%s' SET NULL", name, fk.Name))
+					}
+				}
+			}
+		}
+	}
 	return nil
 }
 
+func buildColumnDef(def *tree.ColumnTableDef) (*ColDef, error) {
+	colType, err := getTypeFromAst(def.Type)
+	if err != nil {
+		return nil, err
+	}
+	defultValue, err := getDefaultExprFromColumn(def, colType)
+	if err != nil {
+		return nil, err
+	}
+	alg := plan.CompressType_Lz4
+	autoIncrement := false
+	notNull := false
+	for _, attr := range def.Attributes {
+		switch a := attr.(type) {
+		case *tree.AttributeCompression:
+			if alg, err = getCompressType(a.Compression, colType); err != nil {
+				return nil, err
+			}
+		case *tree.AttributeNull:
+			notNull = !a.Is
+		case *tree.AttributeAutoIncrement:
+			switch colType.Id {
+			case plan.Type_INT8, plan.Type_INT16, plan.Type_INT32, plan.Type_INT64,
+				plan.Type_UINT8, plan.Type_UINT16, plan.Type_UINT32, plan.Type_UINT64:
+			default:
+				return nil, errors.New(errno.InvalidTableDefinition, fmt.Sprintf("incorrect column specifier for column '%s'", def.Name.Parts[0]))
+			}
+			autoIncrement = true
+		}
+	}
+	return &ColDef{
+		Name:          def.Name.Parts[0],
+		Alg:           alg,
+		Typ:           colType,
+		Default:       defultValue,
+		AutoIncrement: autoIncrement,
+		NotNull:       notNull,
+	}, nil
+}
+
+var compressTypes = map[string]plan.CompressType{
+	"none":  plan.CompressType_None,
+	"lz4":   plan.CompressType_Lz4,
+	"zstd":  plan.CompressType_Zstd,
+	"dict":  plan.CompressType_Dict,
+	"rle":   plan.CompressType_Rle,
+	"delta": plan.CompressType_Delta,
+	"auto":  plan.CompressType_Auto,
+}
+
+// getCompressType returns the compress algorithm of a column. Dictionary
+// encoding is limited to strings and delta encoding to integers and time
+// types, the other algorithms apply to all types.
+func getCompressType(name string, typ *plan.Type) (plan.CompressType, error) {
+	alg, ok := compressTypes[strings.ToLower(name)]
+	if !ok {
+		return alg, errors.New(errno.InvalidTableDefinition, fmt.Sprintf("unknown compression '%s'", name))
+	}
+	applicable := true
+	switch alg {
+	case plan.CompressType_Dict:
+		switch typ.Id {
+		case plan.Type_CHAR, plan.Type_VARCHAR:
+		default:
+			applicable = false
+		}
+	case plan.CompressType_Delta:
+		switch typ.Id {
+		case plan.Type_INT8, plan.Type_INT16, plan.Type_INT32, plan.Type_INT64,
+			plan.Type_UINT8, plan.Type_UINT16, plan.Type_UINT32, plan.Type_UINT64,
+			plan.Type_DATE, plan.Type_DATETIME, plan.Type_TIMESTAMP:
+		default:
+			applicable = false
+		}
+	}
+	if !applicable {
+		return alg, errors.New(errno.InvalidTableDefinition, fmt.Sprintf("compression '%s' does not apply to type %s", name, typ.Id))
+	}
+	return alg, nil
+}
+
+func buildAlterTable(stmt *tree.AlterTable, ctx CompilerContext) (*Plan, error) {
+	alterTable := &plan.AlterTable{
+		Database: string(stmt.Table.SchemaName),
+		Table:    string(stmt.Table.ObjectName),
+	}
+	if alterTable.Database == "" {
+		alterTable.Database = ctx.DefaultDatabase()
+	}
+	_, tableDef := ctx.Resolve(alterTable.Database, alterTable.Table)
+	if tableDef == nil {
+		return nil, errors.New(errno.UndefinedTable, fmt.Sprintf("table '%v' doesn't exist", alterTable.Table))
+	}
+	// columns are checked against the table as it is after the preceding actions
+	cols := make(map[string]bool, len(tableDef.Cols))
+	for _, col := range tableDef.Cols {
+		cols[col.Name] = true
+	}
+	checkColumn := func(name string) error {
+		if !cols[name] {
+			return errors.New(errno.UndefinedColumn, fmt.Sprintf("column '%v' doesn't exist", name))
+		}
+		return nil
+	}
+	checkNewColumn := func(name string) error {
+		if cols[name] {
+			return errors.New(errno.DuplicateColumn, fmt.Sprintf("Duplicate column name '%s'", name))
+		}
+		return nil
+	}
+	for _, option := range stmt.Options {
+		action := &plan.AlterTableAction{}
+		switch opt := option.(type) {
+		case *tree.AlterTableAddColumn:
+			col, err := buildColumnDef(opt.Column)
+			if err != nil {
+				return nil, err
+			}
+			if err = checkNewColumn(col.Name); err != nil {
+				return nil, err
+			}
+			for _, attr := range opt.Column.Attributes {
+				if _, ok := attr.(*tree.AttributePrimaryKey); ok {
+					return nil, errors.New(errno.FeatureNotSupported, "add primary key column is not supported now")
+				}
+			}
+			cols[col.Name] = true
+			action.ActionType = plan.AlterTableAction_ADD_COLUMN
+			action.Name = col.Name
+			action.ColDef = col
+		case *tree.AlterTableDropColumn:
+			name := string(opt.Name)
+			if err := checkColumn(name); err != nil {
+				return nil, err
+			}
+			delete(cols, name)
+			action.ActionType = plan.AlterTableAction_DROP_COLUMN
+			action.Name = name
+		case *tree.AlterTableRenameColumn:
+			name, newName := string(opt.Name), string(opt.NewName)
+			if err := checkColumn(name); err != nil {
+				return nil, err
+			}
+			if err := checkNewColumn(newName); err != nil {
+				return nil, err
+			}
+			delete(cols, name)
+			cols[newName] = true
+			action.ActionType = plan.AlterTableAction_RENAME_COLUMN
+			action.Name = name
+			action.NewName = newName
+		case *tree.AlterTableModifyColumn:
+			col, err := buildColumnDef(opt.Column)
+			if err != nil {
+				return nil, err
+			}
+			if err = checkColumn(col.Name); err != nil {
+				return nil, err
+			}
+			action.ActionType = plan.AlterTableAction_MODIFY_COLUMN
+			action.Name = col.Name
+			action.ColDef = col
+		case *tree.AlterTableCompact:
+			if len(stmt.Options) > 1 {
+				return nil, errors.New(errno.FeatureNotSupported, "compact can not be combined with other alter table options")
+			}
+			action.ActionType = plan.AlterTableAction_COMPACT
+		case *tree.AlterTableAddPartition, *tree.AlterTableDropPartition, *tree.AlterTableTruncatePartition:
+			if len(stmt.Options) > 1 {
+				return nil, errors.New(errno.FeatureNotSupported, "partition options can not be combined with other alter table options")
+			}
+			if err := buildAlterPartition(opt, tableDef, action); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unsupport alter table option: '%v'", tree.String(stmt, dialect.MYSQL)))
+		}
+		alterTable.Actions = append(alterTable.Actions, action)
+	}
+
+	return &Plan{
+		Plan: &plan.Plan_Ddl{
+			Ddl: &plan.DataDefinition{
+				DdlType: plan.DataDefinition_ALTER_TABLE,
+				Definition: &plan.DataDefinition_AlterTable{
+					AlterTable: alterTable,
+				},
+			},
+		},
+	}, nil
+}
+
 func buildDropTable(stmt *tree.DropTable, ctx CompilerContext) (*Plan, error) {
 	dropTable := &plan.DropTable{
 		IfExists: stmt.IfExists,
@@ -303,36 +706,75 @@ func buildDropDatabase(stmt *tree.DropDatabase, ctx CompilerContext) (*Plan, err
 }
 
 func buildCreateIndex(stmt *tree.CreateIndex, ctx CompilerContext) (*Plan, error) {
-	return nil, errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unexpected statement: '%v'", tree.String(stmt, dialect.MYSQL)))
-	// todo unsupport now
-	// createIndex := &plan.CreateIndex{}
-	// return &Plan{
-	// 	Plan: &plan.Plan_Ddl{
-	// 		Ddl: &plan.DataDefinition{
-	// 			DdlType: plan.DataDefinition_CREATE_INDEX,
-	// 			Definition: &plan.DataDefinition_CreateIndex{
-	// 				CreateIndex: createIndex,
-	// 			},
-	// 		},
-	// 	},
-	// }, nil
+	if stmt.IndexCat != tree.INDEX_CATEGORY_NONE {
+		return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("%s index is not supported now", stmt.IndexCat.ToString()))
+	}
+	createIndex := &plan.CreateIndex{
+		IfNotExists: stmt.IfNotExists,
+		Index:       string(stmt.Name),
+		Database:    string(stmt.Table.SchemaName),
+		Table:       string(stmt.Table.ObjectName),
+	}
+	if createIndex.Database == "" {
+		createIndex.Database = ctx.DefaultDatabase()
+	}
+	_, tableDef := ctx.Resolve(createIndex.Database, createIndex.Table)
+	if tableDef == nil {
+		return nil, errors.New(errno.UndefinedTable, fmt.Sprintf("table '%v' doesn't exist", createIndex.Table))
+	}
+	for _, keyPart := range stmt.KeyParts {
+		if keyPart.ColName == nil {
+			return nil, errors.New(errno.FeatureNotSupported, "index on expression is not supported now")
+		}
+		colName := keyPart.ColName.Parts[0]
+		found := false
+		for _, col := range tableDef.Cols {
+			if col.Name == colName {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, errors.New(errno.UndefinedColumn, fmt.Sprintf("column '%v' doesn't exist", colName))
+		}
+		createIndex.ColNames = append(createIndex.ColNames, colName)
+	}
+
+	return &Plan{
+		Plan: &plan.Plan_Ddl{
+			Ddl: &plan.DataDefinition{
+				DdlType: plan.DataDefinition_CREATE_INDEX,
+				Definition: &plan.DataDefinition_CreateIndex{
+					CreateIndex: createIndex,
+				},
+			},
+		},
+	}, nil
 }
 
 func buildDropIndex(stmt *tree.DropIndex, ctx CompilerContext) (*Plan, error) {
-	return nil, errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unexpected statement: '%v'", tree.String(stmt, dialect.MYSQL)))
-	// todo unsupport now
-	// dropIndex := &plan.DropIndex{
-	// 	IfExists: stmt.IfExists,
-	// 	Index:    string(stmt.Name),
-	// }
-	// return &Plan{
-	// 	Plan: &plan.Plan_Ddl{
-	// 		Ddl: &plan.DataDefinition{
-	// 			DdlType: plan.DataDefinition_DROP_INDEX,
-	// 			Definition: &plan.DataDefinition_DropIndex{
-	// 				DropIndex: dropIndex,
-	// 			},
-	// 		},
-	// 	},
-	// }, nil
+	dropIndex := &plan.DropIndex{
+		IfExists: stmt.IfExists,
+		Index:    string(stmt.Name),
+		Database: string(stmt.TableName.SchemaName),
+		Table:    string(stmt.TableName.ObjectName),
+	}
+	if dropIndex.Database == "" {
+		dropIndex.Database = ctx.DefaultDatabase()
+	}
+	_, tableDef := ctx.Resolve(dropIndex.Database, dropIndex.Table)
+	if tableDef == nil {
+		return nil, errors.New(errno.UndefinedTable, fmt.Sprintf("table '%v' doesn't exist", dropIndex.Table))
+	}
+
+	return &Plan{
+		Plan: &plan.Plan_Ddl{
+			Ddl: &plan.DataDefinition{
+				DdlType: plan.DataDefinition_DROP_INDEX,
+				Definition: &plan.DataDefinition_DropIndex{
+					DropIndex: dropIndex,
+				},
+			},
+		},
+	}, nil
 }
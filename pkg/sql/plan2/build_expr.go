@@ -46,6 +46,8 @@ func buildExpr(stmt tree.Expr, ctx CompilerContext, query *Query, node *Node, bi
 	switch astExpr := stmt.(type) {
 	case *tree.NumVal:
 		return buildNumVal(astExpr.Value)
+	case *tree.ParamExpr:
+		return buildParamExpr(astExpr)
 	case *tree.ParenExpr:
 		return buildExpr(astExpr.Expr, ctx, query, node, binderCtx)
 	case *tree.OrExpr:
@@ -296,9 +298,45 @@ func buildFunctionExpr(expr *tree.FuncExpr, ctx CompilerContext, query *Query, n
 	// 	}, nil
 	// }
 
+	if funcName == "VALUES" {
+		return buildValuesExpr(expr, binderCtx)
+	}
+
 	return getFunctionExprByNameAndAstExprs(funcName, expr.Exprs, ctx, query, node, binderCtx)
 }
 
+// buildValuesExpr builds VALUES(col) of ON DUPLICATE KEY UPDATE, the value the
+// insert tried to write to col. It refers to the column with RelPos 1.
+func buildValuesExpr(expr *tree.FuncExpr, binderCtx *BinderContext) (*Expr, error) {
+	if binderCtx.insertTable == nil {
+		return nil, errors.New(errno.FeatureNotSupported, "VALUES() is only supported in ON DUPLICATE KEY UPDATE")
+	}
+	if len(expr.Exprs) != 1 {
+		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("invalid VALUES() expr '%v'", expr))
+	}
+	name, ok := expr.Exprs[0].(*tree.UnresolvedName)
+	if !ok || name.NumParts != 1 {
+		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("invalid VALUES() expr '%v'", expr))
+	}
+	tableDef := binderCtx.insertTable
+	for idx, col := range tableDef.Cols {
+		if col.Name == name.Parts[0] {
+			return &Expr{
+				TableName: tableDef.Name,
+				ColName:   col.Name,
+				Expr: &plan.Expr_Col{
+					Col: &ColRef{
+						RelPos: 1,
+						ColPos: int32(idx),
+					},
+				},
+				Typ: col.Typ,
+			}, nil
+		}
+	}
+	return nil, errors.New(errno.InvalidColumnReference, fmt.Sprintf("column '%v' does not exist", name.Parts[0]))
+}
+
 func buildComparisonExpr(expr *tree.ComparisonExpr, ctx CompilerContext, query *Query, node *Node, binderCtx *BinderContext) (*Expr, error) {
 	switch expr.Op {
 	case tree.EQUAL:
@@ -367,6 +405,20 @@ func buildBinaryExpr(expr *tree.BinaryExpr, ctx CompilerContext, query *Query, n
 	return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not support now", expr))
 }
 
+// buildParamExpr builds a parameter reference, which has the type of the literal it replaces
+func buildParamExpr(astExpr *tree.ParamExpr) (*Expr, error) {
+	expr, err := buildNumVal(astExpr.Value.Value)
+	if err != nil {
+		return nil, err
+	}
+	expr.Expr = &plan.Expr_P{
+		P: &plan.ParamRef{
+			Pos: int32(astExpr.Offset),
+		},
+	}
+	return expr, nil
+}
+
 func buildNumVal(val constant.Value) (*Expr, error) {
 	switch val.Kind() {
 	case constant.Int:
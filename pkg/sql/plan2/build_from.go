@@ -16,11 +16,14 @@ package plan2
 
 import (
 	"fmt"
+	"go/constant"
 	"strings"
+	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 )
 
@@ -114,6 +117,16 @@ func buildTable(stmt tree.TableExpr, ctx CompilerContext, query *Query, binderCt
 		if err != nil {
 			return
 		}
+		if tbl.AsOf != nil {
+			node := query.Nodes[nodeId]
+			if node.NodeType != plan.Node_TABLE_SCAN {
+				return 0, errors.New(errno.FeatureNotSupported, fmt.Sprintf("AS OF TIMESTAMP is only supported on tables: %v", tree.String(tbl, dialect.MYSQL)))
+			}
+			node.AsOfTimestamp, err = buildAsOfTimestamp(tbl.AsOf)
+			if err != nil {
+				return
+			}
+		}
 		nodeId, err = fillTableProjectList(query, nodeId, tbl.As)
 
 		return
@@ -126,6 +139,39 @@ func buildTable(stmt tree.TableExpr, ctx CompilerContext, query *Query, binderCt
 	return 0, errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unsupport table expr: %T", stmt))
 }
 
+// asOfTimestampLayouts are the accepted formats of the timestamp of an AS OF
+// TIMESTAMP clause, it is interpreted in the local time zone.
+var asOfTimestampLayouts = []string{
+	"2006-01-02 15:04:05.999999",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+// buildAsOfTimestamp returns the unix nanoseconds of the timestamp of the AS OF
+// TIMESTAMP clause, which must be a string literal, optionally wrapped by the
+// timestamp, datetime or date function.
+func buildAsOfTimestamp(asOf *tree.AsOfClause) (int64, error) {
+	expr := asOf.Expr
+	if funcExpr, ok := expr.(*tree.FuncExpr); ok && len(funcExpr.Exprs) == 1 {
+		if funcReference, ok := funcExpr.Func.FunctionReference.(*tree.UnresolvedName); ok {
+			switch strings.ToUpper(funcReference.Parts[0]) {
+			case "TIMESTAMP", "DATETIME", "DATE":
+				expr = funcExpr.Exprs[0]
+			}
+		}
+	}
+	if numVal, ok := expr.(*tree.NumVal); ok && numVal.Value.Kind() == constant.String {
+		str := constant.StringVal(numVal.Value)
+		for _, layout := range asOfTimestampLayouts {
+			if ts, err := time.ParseInLocation(layout, str, time.Local); err == nil {
+				return ts.UnixNano(), nil
+			}
+		}
+		return 0, errors.New(errno.DataException, fmt.Sprintf("invalid timestamp '%v' in AS OF TIMESTAMP", str))
+	}
+	return 0, errors.New(errno.FeatureNotSupported, fmt.Sprintf("AS OF TIMESTAMP only supports timestamp literals: %v", tree.String(asOf, dialect.MYSQL)))
+}
+
 func buildJoinTable(tbl *tree.JoinTableExpr, ctx CompilerContext, query *Query, binderCtx *BinderContext) (nodeId int32, err error) {
 	var leftJoinType plan.Node_JoinFlag
 	var rightJoinType plan.Node_JoinFlag
@@ -104,6 +104,11 @@ func getFunctionExprByNameAndAstExprs(name string, exprs []tree.Expr, ctx Compil
 		}
 		args[idx] = expr
 	}
+	if name != "in" {
+		for _, arg := range args {
+			setScalarSubqueryType(query, arg)
+		}
+	}
 
 	// deal with special function
 	switch name {
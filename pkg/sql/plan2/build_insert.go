@@ -22,6 +22,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 )
 
 func buildInsert(stmt *tree.Insert, ctx CompilerContext) (p *Plan, err error) {
@@ -107,6 +108,15 @@ func buildInsert(stmt *tree.Insert, ctx CompilerContext) (p *Plan, err error) {
 		TableDef: tableDef,
 		Children: []int32{nodeId},
 	}
+	if stmt.IsReplace {
+		node.OnDuplicate = int32(engine.OnDuplicateReplace)
+	} else if stmt.OnDuplicateUpdate != nil {
+		node.OnDuplicate = int32(engine.OnDuplicateUpdate)
+		node.UpdateList, err = buildOnDuplicateUpdate(stmt.OnDuplicateUpdate, tableDef, ctx, query)
+		if err != nil {
+			return nil, err
+		}
+	}
 	appendQueryNode(query, node)
 
 	preNode := query.Nodes[len(query.Nodes)-1]
@@ -123,6 +133,65 @@ func buildInsert(stmt *tree.Insert, ctx CompilerContext) (p *Plan, err error) {
 	}, nil
 }
 
+// buildOnDuplicateUpdate builds the assignments of ON DUPLICATE KEY UPDATE. A
+// column refers to the existing row with RelPos 0 and VALUES(col) to the row
+// inserted with RelPos 1, ColPos is the index of the column in the table.
+func buildOnDuplicateUpdate(exprs tree.UpdateExprs, tableDef *TableDef, ctx CompilerContext, query *Query) (*plan.UpdateList, error) {
+	// the existing row is bound like the row of a table scan
+	scanDef := &TableDef{
+		Name:  tableDef.Name,
+		Alias: tableDef.Name,
+		Cols:  make([]*ColDef, len(tableDef.Cols)),
+	}
+	for idx, col := range tableDef.Cols {
+		scanDef.Cols[idx] = &ColDef{
+			Name:  col.Name,
+			Alias: col.Name,
+			Typ:   col.Typ,
+		}
+	}
+	scanNode := &Node{
+		NodeType: plan.Node_TABLE_SCAN,
+		TableDef: scanDef,
+	}
+	binderCtx := &BinderContext{
+		columnAlias: make(map[string]*Expr),
+		insertTable: tableDef,
+	}
+
+	columns := make([]*Expr, 0, len(exprs))
+	values := make([]*Expr, 0, len(exprs))
+	for _, expr := range exprs {
+		if len(expr.Names) != 1 || expr.Names[0].NumParts != 1 {
+			return nil, errors.New(errno.CaseNotFound, "the set list of update must be one")
+		}
+		column, err := buildUnresolvedName(query, scanNode, expr.Names[0].Parts[0], "", binderCtx)
+		if err != nil {
+			return nil, err
+		}
+		column.TableName = tableDef.Name
+
+		value, err := buildExpr(expr.Expr, ctx, query, scanNode, binderCtx)
+		if err != nil {
+			return nil, err
+		}
+		// cast value type
+		if column.Typ.Id != value.Typ.Id {
+			value, err = appendCastExpr(value, column.Typ)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		columns = append(columns, column)
+		values = append(values, value)
+	}
+	return &plan.UpdateList{
+		Columns: columns,
+		Values:  values,
+	}, nil
+}
+
 func getValues(rowset *RowsetData, rows *tree.ValuesClause, columnCount int) error {
 	setColData := func(col *plan.ColData, typ *plan.Type, val constant.Value) error {
 		switch val.Kind() {
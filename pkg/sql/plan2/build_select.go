@@ -23,6 +23,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 )
 
 func buildSelect(stmt *tree.Select, ctx CompilerContext, query *Query, binderCtx *BinderContext) (nodeId int32, err error) {
@@ -36,10 +37,14 @@ func buildSelect(stmt *tree.Select, ctx CompilerContext, query *Query, binderCtx
 
 	switch selectClause := stmt.Select.(type) {
 	case *tree.SelectClause:
+		firstNode := len(query.Nodes)
 		nodeId, projList, err = buildSelectClause(selectClause, ctx, query, binderCtx)
 		if err != nil {
 			return
 		}
+		if stmt.Lock != nil {
+			buildSelectLock(stmt.Lock, query.Nodes[firstNode:])
+		}
 	case *tree.ParenSelect:
 		return buildSelect(selectClause.Select, ctx, query, binderCtx)
 	default:
@@ -125,6 +130,28 @@ func buildSelect(stmt *tree.Select, ctx CompilerContext, query *Query, binderCtx
 	return
 }
 
+// buildSelectLock makes the table scans of a locking read lock the rows
+// they read.
+func buildSelectLock(lock *tree.SelectLock, nodes []*Node) {
+	mode := int32(engine.RowLockShare)
+	if lock.Exclusive() {
+		mode = int32(engine.RowLockUpdate)
+	}
+	wait := int32(engine.RowLockWaitDefault)
+	switch lock.Wait {
+	case tree.SELECT_LOCK_NOWAIT:
+		wait = int32(engine.RowLockNoWait)
+	case tree.SELECT_LOCK_SKIP_LOCKED:
+		wait = int32(engine.RowLockSkipLocked)
+	}
+	for _, node := range nodes {
+		if node.NodeType == plan.Node_TABLE_SCAN {
+			node.LockMode = mode
+			node.LockWait = wait
+		}
+	}
+}
+
 func buildCTE(withExpr *tree.With, ctx CompilerContext, query *Query, binderCtx *BinderContext) error {
 	if withExpr == nil {
 		return nil
@@ -220,6 +247,8 @@ func buildSelectClause(stmt *tree.SelectClause, ctx CompilerContext, query *Quer
 		if err != nil {
 			return
 		}
+		nodeId = decorrelateWhereList(query, nodeId)
+		node = query.Nodes[nodeId]
 	}
 
 	// FIXME: Agg (group by && having)
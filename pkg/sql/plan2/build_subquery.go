@@ -68,3 +68,17 @@ func buildSubQuery(subquery *tree.Subquery, ctx CompilerContext, query *Query, n
 	}
 	return returnExpr, nil
 }
+
+// setScalarSubqueryType gives a subquery used as a value the type of its only
+// column, so the function using it is resolved on that type. The subqueries
+// of IN and those with more columns stay TUPLE.
+func setScalarSubqueryType(query *Query, expr *Expr) {
+	sub, ok := expr.Expr.(*plan.Expr_Sub)
+	if !ok {
+		return
+	}
+	projectList := query.Nodes[sub.Sub.NodeId].ProjectList
+	if len(projectList) == 1 {
+		expr.Typ = projectList[0].Typ
+	}
+}
@@ -17,13 +17,19 @@ package plan2
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
+	"os"
+	"path/filepath"
 	"reflect"
+	"runtime"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 )
 
 //only use in developing
@@ -267,12 +273,17 @@ func TestNodeTree(t *testing.T) {
 		},
 		// uncorrelated subquery
 		"SELECT * FROM NATION where N_REGIONKEY > (select max(R_REGIONKEY) from REGION)": {
-			steps: []int32{0},
+			steps: []int32{3},
 			nodeType: map[int]plan.Node_NodeType{
-				0: plan.Node_TABLE_SCAN, //nodeid = 1  here is the subquery
-				1: plan.Node_TABLE_SCAN, //nodeid = 0, here is SELECT * FROM NATION where N_REGIONKEY > [subquery]
+				0: plan.Node_TABLE_SCAN, //SELECT * FROM NATION
+				1: plan.Node_TABLE_SCAN, //the subquery
+				2: plan.Node_JOIN,       //single join with the subquery
+				3: plan.Node_PROJECT,    //N_REGIONKEY > [subquery]
+			},
+			children: map[int][]int32{
+				2: {0, 1},
+				3: {2},
 			},
-			children: map[int][]int32{},
 		},
 		// correlated subquery
 		`SELECT * FROM NATION where N_REGIONKEY >
@@ -410,6 +421,73 @@ func TestJoinTableSqlBuilder(t *testing.T) {
 	runTestShouldError(mock, t, sqls)
 }
 
+//test time-travel reads plan building
+func TestAsOfTimestamp(t *testing.T) {
+	mock := NewMockOptimizer()
+
+	logicPlan, err := runOneStmt(mock, t, "SELECT N_NAME FROM NATION AS OF TIMESTAMP '2022-05-01 10:00:00.5' WHERE N_REGIONKEY > 0")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	expected := time.Date(2022, 5, 1, 10, 0, 0, 500000000, time.Local).UnixNano()
+	found := false
+	for _, node := range logicPlan.GetQuery().Nodes {
+		if node.NodeType == plan.Node_TABLE_SCAN {
+			if node.AsOfTimestamp != expected {
+				t.Fatalf("as of timestamp is %v, expected %v", node.AsOfTimestamp, expected)
+			}
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("table scan not found")
+	}
+
+	// should pass
+	sqls := []string{
+		"SELECT a.N_NAME FROM NATION a AS OF TIMESTAMP TIMESTAMP '2022-05-01 10:00:00'",
+		"SELECT N_NAME, R_NAME FROM NATION AS a AS OF TIMESTAMP '2022-05-01' join REGION b on a.N_REGIONKEY = b.R_REGIONKEY",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	// should error
+	sqls = []string{
+		"SELECT N_NAME FROM NATION AS OF TIMESTAMP 'yesterday'", //invalid timestamp
+		"SELECT N_NAME FROM NATION AS OF TIMESTAMP N_NAME",      //not a literal
+		"SELECT N_NAME FROM NATION AS OF TIMESTAMP 20220501",    //not a literal
+		"SELECT N_NAME FROM dual AS OF TIMESTAMP '2022-05-01'",  //not a table
+	}
+	runTestShouldError(mock, t, sqls)
+}
+
+//test locking reads plan building
+func TestSelectLock(t *testing.T) {
+	mock := NewMockOptimizer()
+
+	cases := []struct {
+		sql  string
+		mode int32
+		wait int32
+	}{
+		{"SELECT N_NAME FROM NATION WHERE N_NATIONKEY = 1 FOR UPDATE", int32(engine.RowLockUpdate), int32(engine.RowLockWaitDefault)},
+		{"SELECT N_NAME FROM NATION FOR SHARE NOWAIT", int32(engine.RowLockShare), int32(engine.RowLockNoWait)},
+		{"SELECT N_NAME FROM NATION LOCK IN SHARE MODE", int32(engine.RowLockShare), int32(engine.RowLockWaitDefault)},
+		{"SELECT N_NAME, R_NAME FROM NATION join REGION on N_REGIONKEY = R_REGIONKEY FOR UPDATE SKIP LOCKED", int32(engine.RowLockUpdate), int32(engine.RowLockSkipLocked)},
+		{"SELECT N_NAME FROM NATION", int32(engine.RowLockNone), int32(engine.RowLockWaitDefault)},
+	}
+	for _, c := range cases {
+		logicPlan, err := runOneStmt(mock, t, c.sql)
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		for _, node := range logicPlan.GetQuery().Nodes {
+			if node.NodeType == plan.Node_TABLE_SCAN && (node.LockMode != c.mode || node.LockWait != c.wait) {
+				t.Fatalf("%s: lock is %v %v, expected %v %v", c.sql, node.LockMode, node.LockWait, c.mode, c.wait)
+			}
+		}
+	}
+}
+
 //test derived table plan building
 func TestDerivedTableSqlBuilder(t *testing.T) {
 	mock := NewMockOptimizer()
@@ -443,6 +521,10 @@ func TestInsert(t *testing.T) {
 		"INSERT NATION VALUES (1, 'NAME1',21, 'COMMENT1'), (2, 'NAME2', 22, 'COMMENT2')",
 		"INSERT NATION (N_NATIONKEY, N_REGIONKEY, N_NAME) VALUES (1, 21, 'NAME1'), (2, 22, 'NAME2')",
 		"INSERT INTO NATION SELECT * FROM NATION2",
+		"REPLACE NATION VALUES (1, 'NAME1',21, 'COMMENT1'), (2, 'NAME2', 22, 'COMMENT2')",
+		"REPLACE INTO NATION SELECT * FROM NATION2",
+		"INSERT NATION VALUES (1, 'NAME1',21, 'COMMENT1') ON DUPLICATE KEY UPDATE N_NAME = VALUES(N_NAME), N_REGIONKEY = N_REGIONKEY + 1",
+		"INSERT INTO NATION SELECT * FROM NATION2 ON DUPLICATE KEY UPDATE N_COMMENT = 'COMMENT'",
 	}
 	runTestShouldPass(mock, t, sqls, false, false)
 
@@ -456,10 +538,41 @@ func TestInsert(t *testing.T) {
 		"INSERT NATION (N_NATIONKEY, N_REGIONKEY, N_NAME2222) VALUES (1, 2.22, 'NAME1'), (2, 22, 'NAME2')",           // column type not match
 		"INSERT NATION (N_NATIONKEY, N_REGIONKEY, N_NAME2222) VALUES (1, 2, 'NAME1'), (2, 22, 'NAME2')",              // function expr not support now
 		"INSERT INTO region SELECT * FROM NATION2",                                                                   // column length not match
+		"INSERT NATION VALUES (1, 'NAME1',21, 'COMMENT1') ON DUPLICATE KEY UPDATE N_NAME2 = 'NAME2'",                 // column not exist
+		"INSERT NATION VALUES (1, 'NAME1',21, 'COMMENT1') ON DUPLICATE KEY UPDATE N_NAME = VALUES(N_NAME2)",          // column not exist
+		"SELECT VALUES(N_NAME) FROM NATION",                                                                          // VALUES() outside ON DUPLICATE KEY UPDATE
 	}
 	runTestShouldError(mock, t, sqls)
 }
 
+func TestInsertOnDuplicate(t *testing.T) {
+	mock := NewMockOptimizer()
+
+	cases := []struct {
+		sql         string
+		onDuplicate int32
+		updates     int
+	}{
+		{"INSERT NATION VALUES (1, 'NAME1',21, 'COMMENT1')", int32(engine.OnDuplicateError), 0},
+		{"REPLACE NATION VALUES (1, 'NAME1',21, 'COMMENT1')", int32(engine.OnDuplicateReplace), 0},
+		{"INSERT NATION VALUES (1, 'NAME1',21, 'COMMENT1') ON DUPLICATE KEY UPDATE N_NAME = VALUES(N_NAME), N_REGIONKEY = N_REGIONKEY + VALUES(N_REGIONKEY)", int32(engine.OnDuplicateUpdate), 2},
+	}
+	for _, c := range cases {
+		logicPlan, err := runOneStmt(mock, t, c.sql)
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		nodes := logicPlan.GetQuery().Nodes
+		node := nodes[len(nodes)-1]
+		if node.NodeType != plan.Node_INSERT || node.OnDuplicate != c.onDuplicate {
+			t.Fatalf("%s: on duplicate is %v, expected %v", c.sql, node.OnDuplicate, c.onDuplicate)
+		}
+		if len(node.UpdateList.GetColumns()) != c.updates || len(node.UpdateList.GetValues()) != c.updates {
+			t.Fatalf("%s: update list is %v", c.sql, node.UpdateList)
+		}
+	}
+}
+
 func TestUpdate(t *testing.T) {
 	mock := NewMockOptimizer()
 	// should pass
@@ -533,6 +646,80 @@ func TestSubQuery(t *testing.T) {
 	runTestShouldError(mock, t, sqls)
 }
 
+func TestSubQueryDecorrelation(t *testing.T) {
+	mock := NewMockOptimizer()
+	// map[sql string]join flags expected on the left child of the joins
+	sqls := map[string][]plan.Node_JoinFlag{
+		"SELECT * FROM NATION where exists (select * from REGION where R_REGIONKEY = N_REGIONKEY)":                    {plan.Node_SEMI},
+		"SELECT * FROM NATION where not exists (select * from REGION where R_REGIONKEY = N_REGIONKEY)":                {plan.Node_ANTI},
+		"SELECT * FROM NATION where N_REGIONKEY in (select R_REGIONKEY from REGION where R_NAME = N_NAME)":            {plan.Node_SEMI},
+		"SELECT * FROM NATION where N_REGIONKEY not in (select R_REGIONKEY from REGION)":                              {plan.Node_MARK},
+		"SELECT * FROM NATION where N_NATIONKEY > 1 or N_REGIONKEY in (select R_REGIONKEY from REGION)":               {plan.Node_MARK},
+		"SELECT * FROM NATION where N_REGIONKEY > (select max(R_REGIONKEY) from REGION)":                              {plan.Node_SINGLE},
+		"SELECT * FROM NATION where N_REGIONKEY > (select max(R_REGIONKEY) from REGION where R_NAME = N_NAME)":        {plan.Node_SINGLE},
+		"SELECT * FROM NATION where N_REGIONKEY > (select R_REGIONKEY from REGION where R_NAME = N_NAME)":             {plan.Node_SINGLE},
+		"SELECT * FROM NATION where N_REGIONKEY > (select count(*) from REGION where R_NAME = N_NAME)":                nil, // count bug
+		"SELECT * FROM NATION where N_REGIONKEY > (select max(R_REGIONKEY) from REGION where R_NAME < N_NAME)":        nil, // non-equal correlation
+		"SELECT * FROM NATION where N_NATIONKEY > 1 or exists (select * from REGION where R_REGIONKEY = N_REGIONKEY)": nil,
+	}
+	for sql, flags := range sqls {
+		logicPlan, err := runOneStmt(mock, t, sql)
+		if err != nil {
+			t.Fatalf("%+v, sql=%v", err, sql)
+		}
+		query := logicPlan.GetQuery()
+		gotFlags, nested := getDecorrelatedJoins(query)
+		if nested != (flags == nil) {
+			t.Fatalf("sql:%+v, nested subquery left: %v", sql, nested)
+		}
+		if !reflect.DeepEqual(gotFlags, flags) {
+			t.Fatalf("sql:%+v, join flags should be %v but now are %v", sql, flags, gotFlags)
+		}
+	}
+
+	_, fn, _, _ := runtime.Caller(0)
+	dir := filepath.Dir(fn)
+	for _, qn := range []int{2, 4, 17, 20, 21, 22} {
+		sql, err := os.ReadFile(fmt.Sprintf("%s/tpch/q%d.sql", dir, qn))
+		if err != nil {
+			t.Fatalf("Cannot open file of query %d, error %v", qn, err)
+		}
+		logicPlan, err := runOneStmt(mock, t, string(sql))
+		if err != nil {
+			t.Fatalf("%+v, query %d", err, qn)
+		}
+		if _, nested := getDecorrelatedJoins(logicPlan.GetQuery()); nested {
+			t.Fatalf("query %d should not have nested subqueries", qn)
+		}
+	}
+}
+
+//getDecorrelatedJoins returns the join flags of the joins reachable from the
+//query steps, and whether a subquery is still nested in an expression.
+func getDecorrelatedJoins(query *Query) ([]plan.Node_JoinFlag, bool) {
+	var flags []plan.Node_JoinFlag
+	nested := false
+	var visit func(nodeId int32)
+	visit = func(nodeId int32) {
+		node := query.Nodes[nodeId]
+		for _, child := range node.Children {
+			visit(child)
+		}
+		if node.NodeType == plan.Node_JOIN {
+			flags = append(flags, query.Nodes[node.Children[0]].JoinType)
+		}
+		for _, list := range [][]*Expr{node.ProjectList, node.OnList, node.WhereList, node.GroupBy} {
+			for _, expr := range list {
+				nested = nested || hasSubquery(expr)
+			}
+		}
+	}
+	for _, step := range query.Steps {
+		visit(step)
+	}
+	return flags, nested
+}
+
 func TestTcl(t *testing.T) {
 	mock := NewMockOptimizer()
 	// should pass
@@ -570,6 +757,26 @@ func TestDdl(t *testing.T) {
 		"drop table tpch.nation",
 		"drop table if exists tpch.tbl_not_exist",
 		"drop table if exists db_not_exist.tbl",
+
+		"create index idx1 on nation(n_name)",
+		"create index idx1 on tpch.nation(n_name)",
+		"drop index idx1 on nation",
+		"drop index if exists idx1 on tpch.nation",
+
+		"alter table nation add column n_flag int default 1",
+		"alter table tpch.nation drop column n_comment, rename column n_name to n_title",
+		"alter table nation modify n_regionkey bigint",
+		"alter table nation drop n_comment, add n_comment varchar(200)",
+		"alter table nation add column n_flag char(1) compression 'dict'",
+		"alter table tpch.nation compact",
+
+		"create table tbl_name (a int compression 'delta', b varchar(20) compression 'DICT', c double compression 'zstd', d date compression 'auto')",
+		"create table tbl_name (a bigint auto_increment primary key, b varchar(20)) auto_increment = 100",
+
+		"create table tbl_name (a int not null, b varchar(20) unique, c int check (c > 0), constraint u1 unique (a), constraint c1 check (a < c) not enforced)",
+		"create table tbl_name (a int primary key, b int references nation(n_nationkey) on delete cascade on update set null)",
+		"create table tbl_name (a int primary key, b int, constraint fk1 foreign key (b) references tpch.nation(n_nationkey) on delete no action)",
+		"create table tbl_name (a int primary key, b int, foreign key (b) references tbl_name(a) on delete set null)",
 	}
 	runTestShouldPass(mock, t, sqls, false, false)
 
@@ -582,13 +789,153 @@ func TestDdl(t *testing.T) {
 		"drop table tbl_name",           //table not exists in tpch
 		"drop table tpch.tbl_not_exist", //database not exists
 		"drop table db_not_exist.tbl",   //table not exists
+		"create table tbl_name (a int compression 'snappy')",        //unknown compression
+		"create table tbl_name (a varchar(20) compression 'delta')", //delta applies to integers only
+		"create table tbl_name (a int compression 'dict')",          //dict applies to strings only
+
+		"create table tbl_name (a int auto_increment, b int auto_increment)", //only one auto column
+		"create table tbl_name (a varchar(20) auto_increment)",               //auto column must be integer
 
-		"create index idx1 using bsi on a(a)", //unsupport now
-		"drop index idx1 on tbl",              //unsupport now
+		"create table tbl_name (a int, unique (b))",                                                       //unique column not exists
+		"create table tbl_name (a int, b int references tbl_not_exist(a))",                                //referenced table not exists
+		"create table tbl_name (a int, b int references nation(col_not_exist))",                           //referenced column not exists
+		"create table tbl_name (a int, b varchar(20) references nation(n_nationkey))",                     //column type mismatch
+		"create table tbl_name (a int, b int, foreign key (a, b) references nation(n_nationkey))",         //column count mismatch
+		"create table tbl_name (a int, b int not null references nation(n_nationkey) on delete set null)", //set null on not null column
+		"create table tbl_name (a int, b int references nation(n_nationkey) on delete set default)",       //set default unsupported
+
+		"create index idx1 using bsi on a(a)",        //table not exists
+		"create index idx1 on nation(col_not_exist)", //column not exists
+		"create unique index idx1 on nation(n_name)", //unsupport now
+		"drop index idx1 on tbl",                     //table not exists
+
+		"alter table tbl add column a int",                           //table not exists
+		"alter table nation add column n_name int",                   //column exists
+		"alter table nation drop column col_not_exist",               //column not exists
+		"alter table nation rename column n_name to n_comment",       //column exists
+		"alter table nation drop n_name, modify n_name varchar(100)", //column dropped
+		"alter table nation compact, drop n_comment",                 //compact with other options
 	}
 	runTestShouldError(mock, t, sqls)
 }
 
+func TestAutoIncrementProperty(t *testing.T) {
+	mock := NewMockOptimizer()
+	// The engines read the first properties of a table only
+	logicPlan, err := runOneStmt(mock, t, "create table tbl_name (a bigint auto_increment primary key) comment 'c' auto_increment = 100")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	var properties []*plan.PropertiesDef
+	for _, def := range logicPlan.GetDdl().GetCreateTable().GetTableDef().GetDefs() {
+		if def.GetProperties() != nil {
+			properties = append(properties, def.GetProperties())
+		}
+	}
+	if len(properties) != 1 || len(properties[0].GetProperties()) != 2 {
+		t.Fatalf("unexpected properties %v", properties)
+	}
+}
+
+func TestPartition(t *testing.T) {
+	mock := NewMockOptimizer()
+	// should pass
+	sqls := []string{
+		"create table tbl_name (a int, b varchar(20)) partition by range (a) (partition p0 values less than (10), partition p1 values less than maxvalue)",
+		"create table tbl_name (a date, b int) partition by range columns (a) (partition p0 values less than ('2022-01-01'), partition p1 values less than ('2023-01-01'))",
+		"create table tbl_name (a int, b int) partition by list (a) (partition p0 values in (1, 3, null), partition p1 values in (2, 4))",
+		"create table tbl_name (a varchar(20), b int) partition by list columns (a) (partition p0 values in ('a', 'b'), partition p1 values in ('c'))",
+		"create table tbl_name (a int, b int) partition by hash (a) partitions 4",
+
+		"alter table orders add partition (partition p1996 values less than ('1997-01-01'))",
+		"alter table nation add partition (partition p3 values in (5, 6))",
+		"alter table nation drop partition p0, p1",
+		"alter table orders truncate partition p1993",
+		"alter table customer truncate partition p3",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	// should error
+	sqls = []string{
+		"create table tbl_name (a int, b int) partition by range (c) (partition p0 values less than (10))",                                          //column not exists
+		"create table tbl_name (a int, b int) partition by range (a + b) (partition p0 values less than (10))",                                      //expression unsupported
+		"create table tbl_name (a int, b int) partition by key (a) partitions 4",                                                                    //key unsupported
+		"create table tbl_name (a varchar(20), b int) partition by hash (a) partitions 4",                                                           //hash of a string
+		"create table tbl_name (a double, b int) partition by range (a) (partition p0 values less than (10))",                                       //range of a float
+		"create table tbl_name (a int, b int) partition by range (a) (partition p0 values less than (10), partition p0 values less than (20))",      //duplicate name
+		"create table tbl_name (a int, b int) partition by range (a) (partition p0 values in (10))",                                                 //values in of a range
+		"create table tbl_name (a int, b int) partition by list (a) (partition p0 values less than (10))",                                           //values less than of a list
+		"create table tbl_name (a int, b int) partition by range (a) (partition p0 values less than ('a'))",                                         //value not an int
+		"create table tbl_name (a tinyint, b int) partition by range (a) (partition p0 values less than (1000))",                                    //value out of range
+		"create table tbl_name (a int, b int) partition by range (a) subpartition by hash (b) subpartitions 2 (partition p0 values less than (10))", //subpartition unsupported
+
+		"alter table region add partition (partition p0 values in (1))",        //not partitioned
+		"alter table nation add partition (partition p0 values in (5))",        //duplicate name
+		"alter table nation add partition (partition p3 values less than (5))", //values less than of a list
+		"alter table customer add partition (partition p4)",                    //hash
+		"alter table nation drop partition p4",                                 //partition not exists
+		"alter table customer drop partition p0",                               //hash
+	}
+	runTestShouldError(mock, t, sqls)
+}
+
+func TestPartitionPruning(t *testing.T) {
+	mock := NewMockOptimizer()
+
+	cases := []struct {
+		sql        string
+		pruned     bool
+		partitions []string
+	}{
+		{"SELECT O_ORDERKEY FROM ORDERS WHERE O_ORDERDATE >= DATE '1995-01-01'", true, []string{"p1995", "pmax"}},
+		{"SELECT O_ORDERKEY FROM ORDERS WHERE O_ORDERDATE < DATE '1994-06-01'", true, []string{"p1993", "p1994"}},
+		{"SELECT O_ORDERKEY FROM ORDERS WHERE O_ORDERDATE BETWEEN DATE '1994-03-01' AND DATE '1994-06-01'", true, []string{"p1994"}},
+		{"SELECT O_ORDERKEY FROM ORDERS WHERE O_ORDERKEY > 10", false, nil},
+		{"SELECT N_NAME FROM NATION WHERE N_REGIONKEY = 2", true, []string{"p1"}},
+		{"SELECT N_NAME FROM NATION WHERE N_REGIONKEY IN (0, 4)", true, []string{"p0", "p2"}},
+		{"SELECT N_NAME FROM NATION WHERE N_REGIONKEY = 2 OR N_REGIONKEY = 4", true, []string{"p1", "p2"}},
+		{"SELECT N_NAME FROM NATION WHERE 3 < N_REGIONKEY", true, []string{"p2"}},
+		{"SELECT N_NAME FROM NATION WHERE N_REGIONKEY = 5", true, nil},
+		{"SELECT N_NAME FROM NATION WHERE N_REGIONKEY = 2 OR N_NAME = 'A'", false, nil},
+		{"SELECT C_NAME FROM CUSTOMER WHERE C_NATIONKEY = 5", true, []string{"p1"}},
+		{"SELECT C_NAME FROM CUSTOMER WHERE C_NATIONKEY IN (1, 2) AND C_CUSTKEY > 10", true, []string{"p1", "p2"}},
+		{"SELECT C_NAME FROM CUSTOMER WHERE C_NATIONKEY > 5", false, nil},
+	}
+	for _, c := range cases {
+		logicPlan, err := runOneStmt(mock, t, c.sql)
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		for _, node := range logicPlan.GetQuery().Nodes {
+			if node.NodeType != plan.Node_TABLE_SCAN {
+				continue
+			}
+			if node.PartitionPruned != c.pruned || !reflect.DeepEqual(node.Partitions, c.partitions) {
+				t.Fatalf("%s: partitions are %v %v, expected %v %v", c.sql, node.PartitionPruned, node.Partitions, c.pruned, c.partitions)
+			}
+		}
+	}
+
+	// the parameters of a cached plan are pruned once bound
+	stmt, err := mysql.ParseOne("SELECT N_NAME FROM NATION WHERE N_REGIONKEY = 2")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	ps := Parameterize(stmt)
+	logicPlan, err := BuildPlan(mock.CurrentContext(), stmt)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if logicPlan, err = BindParams(logicPlan, ps.Params); err != nil {
+		t.Fatalf("%+v", err)
+	}
+	for _, node := range logicPlan.GetQuery().Nodes {
+		if node.NodeType == plan.Node_TABLE_SCAN && !reflect.DeepEqual(node.Partitions, []string{"p1"}) {
+			t.Fatalf("partitions are %v, expected [p1]", node.Partitions)
+		}
+	}
+}
+
 func TestShow(t *testing.T) {
 	mock := NewMockOptimizer()
 	// should pass
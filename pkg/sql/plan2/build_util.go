@@ -275,8 +275,12 @@ func buildUnresolvedName(query *Query, node *Node, colName string, tableName str
 			}
 		}
 	} else {
-		// Search name from children
-		for i, child := range node.Children {
+		// Search name from children, joins built from subqueries only expose their left child
+		children := node.Children
+		if node.NodeType == plan.Node_JOIN && query.Nodes[children[0]].JoinType&decorrelatedJoinFlags != 0 {
+			children = children[:1]
+		}
+		for i, child := range children {
 			for j, col := range query.Nodes[child].ProjectList {
 				if matchName(col) {
 					if colRef.RelPos != -1 {
@@ -327,6 +331,7 @@ func buildUnresolvedName(query *Query, node *Node, colName string, tableName str
 			}
 		}
 		if corrRef.ColPos != -1 {
+			binderCtx.subqueryIsCorrelated = true
 			return corrExpr, nil
 		}
 	}
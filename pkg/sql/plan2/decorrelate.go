@@ -0,0 +1,739 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan2/function"
+)
+
+// Subqueries in a where list are rewritten into joins against the node the
+// where list belongs to (the outer node).  The join flag is set on the left
+// child, like fillJoinProjectList expects for SEMI and ANTI joins:
+//
+//	EXISTS (...)        SEMI join, the correlated predicates become the OnList
+//	NOT EXISTS (...)    ANTI join
+//	x IN (...)          SEMI join on x = first column of the subquery
+//	x NOT IN (...)      MARK join, then filter on NOT mark
+//	scalar subquery     SINGLE join, correlated scalar aggregates are grouped
+//	                    by the correlated columns first
+//
+// A MARK join keeps every left row and appends a nullable boolean mark
+// column.  OnList[0] is the IN comparison, the rest are the correlated
+// predicates.  The mark is TRUE if a right row satisfies every condition,
+// NULL if no row does but the comparison was NULL for a row satisfying the
+// correlated predicates, FALSE otherwise.
+//
+// A SINGLE join keeps every left row and appends the first column of the
+// single matching right row, NULL if none matches. More than one match is
+// a runtime error.
+//
+// Subqueries that don't fit these shapes are left nested.
+const (
+	// markColName and scalarColName name the column MARK and SINGLE joins
+	// append to their left input. Neither is a valid bare identifier, so a
+	// user column never resolves to them.
+	markColName   = "#mark"
+	scalarColName = "#scalar"
+
+	// markRelPos is the RelPos referencing the mark column of a MARK join,
+	// which is produced by the join itself rather than by a child.
+	markRelPos = -1
+
+	decorrelatedJoinFlags = plan.Node_SEMI | plan.Node_ANTI | plan.Node_MARK | plan.Node_SINGLE
+)
+
+// subqueryJoin describes how a subquery becomes the right child of a join.
+type subqueryJoin struct {
+	rootId int32
+	// filterId is the node whose where list holds the predicates correlated
+	// with the outer node, -1 for an uncorrelated subquery.
+	filterId int32
+	// path holds the nodes from rootId down to filterId.
+	path      []int32
+	corrConds []*Expr
+	// aggregated is set for correlated scalar aggregates, which need to be
+	// grouped by the correlated columns before joining.
+	aggregated bool
+}
+
+// subqueryPred is a subquery found inside a where condition.
+type subqueryPred struct {
+	expr *Expr
+	sub  *plan.SubQuery
+	// in is the left side of an IN predicate, nil for scalar subqueries, and
+	// eq compares it with the first column of the subquery.
+	in   *Expr
+	eq   *Expr
+	join *subqueryJoin
+}
+
+//decorrelateWhereList rewrites the subqueries in the where list of node nodeId
+//into joins stacked on top of it, and returns the new top node.
+func decorrelateWhereList(query *Query, nodeId int32) int32 {
+	outer := query.Nodes[nodeId]
+	conds := outer.WhereList
+	outer.WhereList = nil
+
+	var pending []*Expr
+	for _, cond := range conds {
+		if hasSubquery(cond) {
+			pending = append(pending, cond)
+		} else {
+			outer.WhereList = append(outer.WhereList, cond)
+		}
+	}
+
+	for _, cond := range pending {
+		topId, ok := decorrelateCond(query, outer, nodeId, cond)
+		if !ok {
+			outer.WhereList = append(outer.WhereList, cond)
+			continue
+		}
+		nodeId = topId
+	}
+	return nodeId
+}
+
+func decorrelateCond(query *Query, outer *Node, topId int32, cond *Expr) (int32, bool) {
+	// every node stacked on the outer node passes its columns through first,
+	// so bind the condition to its project list.
+	cond, ok := bindToProjectList(cond, outer.ProjectList)
+	if !ok {
+		return topId, false
+	}
+
+	if sub, flag, ok := getExistsSubquery(cond); ok {
+		join, ok := analyzeSubquery(query, outer.NodeId, sub, false)
+		if !ok {
+			return topId, false
+		}
+		rightId, onList := applySubqueryJoin(query, join)
+		return appendDecorrelatedJoin(query, topId, rightId, flag, onList, nil), true
+	}
+
+	if f, ok := cond.Expr.(*plan.Expr_F); ok && isSubqueryIn(f) {
+		sub := f.F.Args[1].Expr.(*plan.Expr_Sub).Sub
+		join, ok := analyzeSubquery(query, outer.NodeId, sub, false)
+		if !ok {
+			return topId, false
+		}
+		eq, err := getFunctionExprByNameAndPlanExprs("=", []*Expr{f.F.Args[0], getSubqueryValue(query, sub.NodeId)})
+		if err != nil {
+			return topId, false
+		}
+		rightId, onList := applySubqueryJoin(query, join)
+		onList = append([]*Expr{eq}, onList...)
+		return appendDecorrelatedJoin(query, topId, rightId, plan.Node_SEMI, onList, nil), true
+	}
+
+	preds, ok := findSubqueryPreds(cond, nil)
+	if !ok || len(preds) == 0 {
+		return topId, false
+	}
+	for _, pred := range preds {
+		pred.join, ok = analyzeSubquery(query, outer.NodeId, pred.sub, pred.in == nil)
+		if !ok {
+			return topId, false
+		}
+		if pred.in != nil {
+			var err error
+			pred.eq, err = getFunctionExprByNameAndPlanExprs("=", []*Expr{pred.in, getSubqueryValue(query, pred.sub.NodeId)})
+			if err != nil {
+				return topId, false
+			}
+		}
+	}
+
+	width := int32(len(outer.ProjectList))
+	replaces := make(map[*Expr]*Expr, len(preds))
+	for _, pred := range preds {
+		value := getSubqueryValue(query, pred.sub.NodeId)
+		rightId, onList := applySubqueryJoin(query, pred.join)
+		ref := &Expr{
+			Expr: &plan.Expr_Col{
+				Col: &ColRef{
+					RelPos: 0,
+					ColPos: width,
+				},
+			},
+		}
+		if pred.in == nil {
+			ref.Typ = value.Typ
+			ref.ColName = scalarColName
+			topId = appendDecorrelatedJoin(query, topId, rightId, plan.Node_SINGLE, onList, &Expr{
+				Typ:     value.Typ,
+				ColName: scalarColName,
+				Expr:    value.Expr,
+			})
+		} else {
+			onList = append([]*Expr{pred.eq}, onList...)
+			ref.Typ = &plan.Type{
+				Id:       plan.Type_BOOL,
+				Nullable: true,
+			}
+			ref.ColName = markColName
+			topId = appendDecorrelatedJoin(query, topId, rightId, plan.Node_MARK, onList, &Expr{
+				Typ:     ref.Typ,
+				ColName: markColName,
+				Expr: &plan.Expr_Col{
+					Col: &ColRef{
+						RelPos: markRelPos,
+					},
+				},
+			})
+		}
+		replaces[pred.expr] = ref
+		width++
+	}
+
+	cond = mapExpr(cond, func(expr *Expr) *Expr {
+		return replaces[expr]
+	})
+
+	// filter the joined rows and trim the appended columns
+	filter := &Node{
+		NodeType:  plan.Node_PROJECT,
+		Children:  []int32{topId},
+		WhereList: []*Expr{cond},
+	}
+	filter.ProjectList = make([]*Expr, len(outer.ProjectList))
+	for i, expr := range outer.ProjectList {
+		filter.ProjectList[i] = &Expr{
+			Typ:       expr.Typ,
+			TableName: expr.TableName,
+			ColName:   expr.ColName,
+			Expr: &plan.Expr_Col{
+				Col: &ColRef{
+					RelPos: 0,
+					ColPos: int32(i),
+				},
+			},
+		}
+	}
+	return appendQueryNode(query, filter), true
+}
+
+//getExistsSubquery returns the subquery of a top level [NOT] EXISTS condition
+//and the join flag it is decorrelated with.
+func getExistsSubquery(cond *Expr) (*plan.SubQuery, plan.Node_JoinFlag, bool) {
+	flag := plan.Node_SEMI
+	f, ok := cond.Expr.(*plan.Expr_F)
+	if !ok {
+		return nil, flag, false
+	}
+	if f.F.Func.GetObjName() == "not" && len(f.F.Args) == 1 {
+		flag = plan.Node_ANTI
+		if f, ok = f.F.Args[0].Expr.(*plan.Expr_F); !ok {
+			return nil, flag, false
+		}
+	}
+	if f.F.Func.GetObjName() != "exists" || len(f.F.Args) != 1 {
+		return nil, flag, false
+	}
+	sub, ok := f.F.Args[0].Expr.(*plan.Expr_Sub)
+	if !ok {
+		return nil, flag, false
+	}
+	return sub.Sub, flag, true
+}
+
+func isSubqueryIn(f *plan.Expr_F) bool {
+	if f.F.Func.GetObjName() != "in" || len(f.F.Args) != 2 {
+		return false
+	}
+	_, ok := f.F.Args[1].Expr.(*plan.Expr_Sub)
+	return ok && !hasSubquery(f.F.Args[0])
+}
+
+//findSubqueryPreds collects the scalar subqueries and IN subqueries of expr.
+//It fails on nested EXISTS, whose NULL semantics a MARK join can't express.
+func findSubqueryPreds(expr *Expr, preds []*subqueryPred) ([]*subqueryPred, bool) {
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_Sub:
+		return append(preds, &subqueryPred{expr: expr, sub: e.Sub}), true
+	case *plan.Expr_F:
+		if e.F.Func.GetObjName() == "exists" {
+			return preds, false
+		}
+		if isSubqueryIn(e) {
+			return append(preds, &subqueryPred{
+				expr: expr,
+				sub:  e.F.Args[1].Expr.(*plan.Expr_Sub).Sub,
+				in:   e.F.Args[0],
+			}), true
+		}
+		for _, arg := range e.F.Args {
+			var ok bool
+			if preds, ok = findSubqueryPreds(arg, preds); !ok {
+				return preds, false
+			}
+		}
+	case *plan.Expr_List:
+		for _, item := range e.List.List {
+			var ok bool
+			if preds, ok = findSubqueryPreds(item, preds); !ok {
+				return preds, false
+			}
+		}
+	}
+	return preds, true
+}
+
+//analyzeSubquery checks whether subquery sub, correlated with node outerId,
+//can be joined with it. Nothing is changed until applySubqueryJoin.
+func analyzeSubquery(query *Query, outerId int32, sub *plan.SubQuery, scalar bool) (*subqueryJoin, bool) {
+	join := &subqueryJoin{
+		rootId:   sub.NodeId,
+		filterId: -1,
+	}
+	if !findCorrelatedConds(query, sub.NodeId, outerId, join, make(map[int32]bool)) {
+		return nil, false
+	}
+	if join.filterId == -1 {
+		return join, true
+	}
+
+	// the correlated predicates can only be pulled up through nodes which
+	// pass their first child through
+	nodeId := join.rootId
+	for {
+		node := query.Nodes[nodeId]
+		if node.Limit != nil || node.Offset != nil {
+			return nil, false
+		}
+		join.path = append(join.path, nodeId)
+		if nodeId == join.filterId {
+			break
+		}
+		if len(node.Children) == 0 || !passesFirstChild(query, node) {
+			return nil, false
+		}
+		nodeId = node.Children[0]
+	}
+
+	root := query.Nodes[join.rootId]
+	aggregated := false
+	for _, expr := range root.ProjectList {
+		if hasAggregate(expr, false) {
+			aggregated = true
+		}
+	}
+	if !aggregated {
+		return join, true
+	}
+	if !scalar || root.NodeType == plan.Node_AGG {
+		return nil, false
+	}
+	// aggregates are grouped by the inner side of the correlated equalities,
+	// COUNT would yield NULL instead of 0 for the missing groups.
+	for _, expr := range root.ProjectList {
+		if hasAggregate(expr, true) {
+			return nil, false
+		}
+	}
+	for _, cond := range join.corrConds {
+		if _, _, ok := splitCorrelatedEqual(cond); !ok {
+			return nil, false
+		}
+	}
+	join.aggregated = true
+	return join, true
+}
+
+//findCorrelatedConds looks for the predicates correlated with node outerId in
+//the subtree of nodeId, including nested subqueries. They must all be in the
+//where list of a single node.
+func findCorrelatedConds(query *Query, nodeId, outerId int32, join *subqueryJoin, visited map[int32]bool) bool {
+	if visited[nodeId] {
+		return true
+	}
+	visited[nodeId] = true
+	node := query.Nodes[nodeId]
+
+	var subIds []int32
+	other := make([]*Expr, 0, len(node.ProjectList)+len(node.OnList)+len(node.GroupBy))
+	other = append(other, node.ProjectList...)
+	other = append(other, node.OnList...)
+	other = append(other, node.GroupBy...)
+	other = append(other, node.AggList...)
+	for _, orderBy := range node.OrderBy {
+		other = append(other, orderBy.Expr)
+	}
+	if node.Limit != nil {
+		other = append(other, node.Limit)
+	}
+	if node.Offset != nil {
+		other = append(other, node.Offset)
+	}
+	for _, expr := range other {
+		if isCorrelatedWith(expr, outerId) {
+			return false
+		}
+		subIds = collectSubqueryIds(expr, subIds)
+	}
+
+	for _, cond := range node.WhereList {
+		subIds = collectSubqueryIds(cond, subIds)
+		if !isCorrelatedWith(cond, outerId) {
+			continue
+		}
+		if hasSubquery(cond) || (join.filterId != -1 && join.filterId != nodeId) {
+			return false
+		}
+		join.filterId = nodeId
+		join.corrConds = append(join.corrConds, cond)
+	}
+
+	for _, id := range append(subIds, node.Children...) {
+		if !findCorrelatedConds(query, id, outerId, join, visited) {
+			return false
+		}
+	}
+	return true
+}
+
+//passesFirstChild reports whether node outputs its first child's columns at
+//the same positions, so that more of them can be appended to its project list.
+func passesFirstChild(query *Query, node *Node) bool {
+	switch node.NodeType {
+	case plan.Node_PROJECT:
+		return len(node.Children) == 1
+	case plan.Node_JOIN:
+		return query.Nodes[node.Children[0]].JoinType&decorrelatedJoinFlags != 0
+	}
+	return false
+}
+
+//getSubqueryValue returns the first column of subquery nodeId as seen from
+//the join it is the right child of.
+func getSubqueryValue(query *Query, nodeId int32) *Expr {
+	return newColRefExpr(query.Nodes[nodeId].ProjectList[0], 1, 0)
+}
+
+//applySubqueryJoin moves the correlated predicates of a subquery out into the
+//returned OnList and returns the node to join with.
+func applySubqueryJoin(query *Query, join *subqueryJoin) (int32, []*Expr) {
+	if join.filterId == -1 {
+		return join.rootId, nil
+	}
+
+	filter := query.Nodes[join.filterId]
+	removed := make(map[*Expr]bool, len(join.corrConds))
+	for _, cond := range join.corrConds {
+		removed[cond] = true
+	}
+	whereList := make([]*Expr, 0, len(filter.WhereList))
+	for _, cond := range filter.WhereList {
+		if !removed[cond] {
+			whereList = append(whereList, cond)
+		}
+	}
+	filter.WhereList = whereList
+
+	bindCorr := func(expr *Expr) *Expr {
+		if e, ok := expr.Expr.(*plan.Expr_Corr); ok {
+			return newColRefExpr(expr, 0, e.Corr.ColPos)
+		}
+		return nil
+	}
+
+	var onList []*Expr
+	if !join.aggregated {
+		for _, cond := range join.corrConds {
+			onList = append(onList, mapExpr(cond, func(expr *Expr) *Expr {
+				if _, ok := expr.Expr.(*plan.Expr_Col); ok {
+					return newColRefExpr(expr, 1, exposeColumn(query, join.path, expr))
+				}
+				return bindCorr(expr)
+			}))
+		}
+		return join.rootId, onList
+	}
+
+	// group the aggregates by the inner columns of the correlated equalities
+	root := query.Nodes[join.rootId]
+	aggProjects := root.ProjectList
+	root.ProjectList = getPassThroughList(query, root)
+	aggNode := &Node{
+		NodeType: plan.Node_AGG,
+		Children: []int32{join.rootId},
+	}
+	for _, expr := range aggProjects {
+		aggNode.ProjectList = append(aggNode.ProjectList, mapExpr(expr, func(expr *Expr) *Expr {
+			if _, ok := expr.Expr.(*plan.Expr_Col); ok {
+				return newColRefExpr(expr, 0, findColumn(root.ProjectList, expr))
+			}
+			return nil
+		}))
+	}
+	for _, cond := range join.corrConds {
+		inner, _, _ := splitCorrelatedEqual(cond)
+		key := newColRefExpr(inner, 0, exposeColumn(query, join.path, inner))
+		aggNode.GroupBy = append(aggNode.GroupBy, key)
+		aggNode.ProjectList = append(aggNode.ProjectList, key)
+
+		keyPos := int32(len(aggNode.ProjectList) - 1)
+		onList = append(onList, mapExpr(cond, func(expr *Expr) *Expr {
+			if expr == inner {
+				return newColRefExpr(expr, 1, keyPos)
+			}
+			return bindCorr(expr)
+		}))
+	}
+	return appendQueryNode(query, aggNode), onList
+}
+
+//splitCorrelatedEqual splits `inner column = outer expression`.
+func splitCorrelatedEqual(cond *Expr) (*Expr, *Expr, bool) {
+	f, ok := cond.Expr.(*plan.Expr_F)
+	if !ok || f.F.Func.GetObjName() != "=" || len(f.F.Args) != 2 {
+		return nil, nil, false
+	}
+	left, right := f.F.Args[0], f.F.Args[1]
+	if _, ok := left.Expr.(*plan.Expr_Col); !ok {
+		left, right = right, left
+	}
+	if _, ok := left.Expr.(*plan.Expr_Col); !ok {
+		return nil, nil, false
+	}
+	innerRef := false
+	walkExpr(right, func(expr *Expr) {
+		if _, ok := expr.Expr.(*plan.Expr_Col); ok {
+			innerRef = true
+		}
+	})
+	return left, right, !innerRef
+}
+
+//exposeColumn makes expr, bound to the input of the last node of path, part
+//of the output of the first one and returns its position there.
+func exposeColumn(query *Query, path []int32, expr *Expr) int32 {
+	var pos int32
+	for i := len(path) - 1; i >= 0; i-- {
+		node := query.Nodes[path[i]]
+		if i < len(path)-1 {
+			expr = newColRefExpr(expr, 0, pos)
+		}
+		pos = findColumn(node.ProjectList, expr)
+		if pos == -1 {
+			col := expr.Expr.(*plan.Expr_Col).Col
+			pos = int32(len(node.ProjectList))
+			node.ProjectList = append(node.ProjectList, newColRefExpr(expr, col.RelPos, col.ColPos))
+		}
+	}
+	return pos
+}
+
+//getPassThroughList builds a project list outputting the input of node as is.
+func getPassThroughList(query *Query, node *Node) []*Expr {
+	var list []*Expr
+	if node.NodeType == plan.Node_TABLE_SCAN {
+		for i, col := range node.TableDef.Cols {
+			name := col.Alias
+			if name == "" {
+				name = col.Name
+			}
+			list = append(list, &Expr{
+				Typ:       col.Typ,
+				TableName: node.TableDef.Alias,
+				ColName:   name,
+				Expr: &plan.Expr_Col{
+					Col: &ColRef{
+						RelPos: 0,
+						ColPos: int32(i),
+					},
+				},
+			})
+		}
+		return list
+	}
+
+	children := node.Children
+	if node.NodeType == plan.Node_JOIN && query.Nodes[children[0]].JoinType&decorrelatedJoinFlags != 0 {
+		children = children[:1]
+	}
+	for i, child := range children {
+		for j, expr := range query.Nodes[child].ProjectList {
+			list = append(list, newColRefExpr(expr, int32(i), int32(j)))
+		}
+	}
+	return list
+}
+
+//appendDecorrelatedJoin joins the subquery node rightId to leftId, extra is
+//the mark or scalar column appended by MARK and SINGLE joins.
+func appendDecorrelatedJoin(query *Query, leftId, rightId int32, flag plan.Node_JoinFlag, onList []*Expr, extra *Expr) int32 {
+	left := query.Nodes[leftId]
+	left.JoinType = flag
+	query.Nodes[rightId].JoinType = plan.Node_INNER
+
+	node := &Node{
+		NodeType: plan.Node_JOIN,
+		Children: []int32{leftId, rightId},
+		OnList:   onList,
+	}
+	node.ProjectList = make([]*Expr, 0, len(left.ProjectList)+1)
+	for i, expr := range left.ProjectList {
+		node.ProjectList = append(node.ProjectList, newColRefExpr(expr, 0, int32(i)))
+	}
+	if extra != nil {
+		node.ProjectList = append(node.ProjectList, extra)
+	}
+	return appendQueryNode(query, node)
+}
+
+//bindToProjectList rebinds the column references of expr, made against the
+//input of a node, to the project list of that node.
+func bindToProjectList(expr *Expr, projectList []*Expr) (*Expr, bool) {
+	ok := true
+	expr = mapExpr(expr, func(expr *Expr) *Expr {
+		if _, isCol := expr.Expr.(*plan.Expr_Col); !isCol {
+			return nil
+		}
+		pos := findColumn(projectList, expr)
+		if pos == -1 {
+			ok = false
+			return nil
+		}
+		return newColRefExpr(expr, 0, pos)
+	})
+	return expr, ok
+}
+
+//findColumn returns the position of the column reference expr in list, -1 if
+//it is not there.
+func findColumn(list []*Expr, expr *Expr) int32 {
+	col := expr.Expr.(*plan.Expr_Col).Col
+	for i, item := range list {
+		if c, ok := item.Expr.(*plan.Expr_Col); ok && c.Col.RelPos == col.RelPos && c.Col.ColPos == col.ColPos {
+			return int32(i)
+		}
+	}
+	return -1
+}
+
+func newColRefExpr(expr *Expr, relPos, colPos int32) *Expr {
+	return &Expr{
+		Typ:       expr.Typ,
+		TableName: expr.TableName,
+		ColName:   expr.ColName,
+		Expr: &plan.Expr_Col{
+			Col: &ColRef{
+				RelPos: relPos,
+				ColPos: colPos,
+			},
+		},
+	}
+}
+
+//mapExpr returns a copy of expr where every sub expression for which fn
+//returns non nil is replaced by the result.
+func mapExpr(expr *Expr, fn func(*Expr) *Expr) *Expr {
+	if e := fn(expr); e != nil {
+		return e
+	}
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_F:
+		args := make([]*Expr, len(e.F.Args))
+		for i, arg := range e.F.Args {
+			args[i] = mapExpr(arg, fn)
+		}
+		return &Expr{
+			Typ:       expr.Typ,
+			TableName: expr.TableName,
+			ColName:   expr.ColName,
+			Expr: &plan.Expr_F{
+				F: &plan.Function{
+					Func: e.F.Func,
+					Args: args,
+				},
+			},
+		}
+	case *plan.Expr_List:
+		list := make([]*Expr, len(e.List.List))
+		for i, item := range e.List.List {
+			list[i] = mapExpr(item, fn)
+		}
+		return &Expr{
+			Typ:       expr.Typ,
+			TableName: expr.TableName,
+			ColName:   expr.ColName,
+			Expr: &plan.Expr_List{
+				List: &plan.ExprList{
+					List: list,
+				},
+			},
+		}
+	}
+	return expr
+}
+
+func walkExpr(expr *Expr, fn func(*Expr)) {
+	fn(expr)
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_F:
+		for _, arg := range e.F.Args {
+			walkExpr(arg, fn)
+		}
+	case *plan.Expr_List:
+		for _, item := range e.List.List {
+			walkExpr(item, fn)
+		}
+	}
+}
+
+func hasSubquery(expr *Expr) bool {
+	return len(collectSubqueryIds(expr, nil)) > 0
+}
+
+func collectSubqueryIds(expr *Expr, ids []int32) []int32 {
+	walkExpr(expr, func(expr *Expr) {
+		if e, ok := expr.Expr.(*plan.Expr_Sub); ok {
+			ids = append(ids, e.Sub.NodeId)
+		}
+	})
+	return ids
+}
+
+func isCorrelatedWith(expr *Expr, nodeId int32) bool {
+	correlated := false
+	walkExpr(expr, func(expr *Expr) {
+		if e, ok := expr.Expr.(*plan.Expr_Corr); ok && e.Corr.NodeId == nodeId {
+			correlated = true
+		}
+	})
+	return correlated
+}
+
+//hasAggregate reports whether expr calls an aggregate function, or only COUNT
+//if onlyCount is set.
+func hasAggregate(expr *Expr, onlyCount bool) bool {
+	found := false
+	walkExpr(expr, func(expr *Expr) {
+		e, ok := expr.Expr.(*plan.Expr_F)
+		if !ok {
+			return
+		}
+		if onlyCount {
+			found = found || e.F.Func.GetObjName() == "count"
+			return
+		}
+		if f, err := function.GetFunctionByID(e.F.Func.GetObj()); err == nil && f.IsAggregate() {
+			found = true
+		}
+	})
+	return found
+}
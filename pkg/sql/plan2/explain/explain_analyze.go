@@ -0,0 +1,190 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package explain
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// jsonNode is a plan node in the json format
+type jsonNode struct {
+	Node     string       `json:"node"`
+	Output   []string     `json:"output,omitempty"`
+	Details  []string     `json:"details,omitempty"`
+	Analyze  *jsonAnalyze `json:"analyze,omitempty"`
+	Scopes   []*jsonScope `json:"scopes,omitempty"`
+	Children []*jsonNode  `json:"children,omitempty"`
+}
+
+// jsonAnalyze is the runtime statistics of a plan node in the json format
+type jsonAnalyze struct {
+	TimeConsumed  float64 `json:"timeConsumedMs"`
+	InputRows     int64   `json:"inputRows"`
+	OutputRows    int64   `json:"outputRows"`
+	InputBatches  int64   `json:"inputBatches"`
+	OutputBatches int64   `json:"outputBatches"`
+	MemoryPeak    int64   `json:"memoryPeak"`
+	InputSize     int64   `json:"inputSize"`
+	InputBlocks   int64   `json:"inputBlocks,omitempty"`
+	PrunedBlocks  int64   `json:"prunedBlocks,omitempty"`
+}
+
+// jsonScope is the runtime statistics of a scope in the json format
+type jsonScope struct {
+	Id            int32   `json:"id"`
+	TimeConsumed  float64 `json:"timeConsumedMs"`
+	InputRows     int64   `json:"inputRows"`
+	InputBatches  int64   `json:"inputBatches"`
+	InputSize     int64   `json:"inputSize"`
+	OutputRows    int64   `json:"outputRows"`
+	OutputBatches int64   `json:"outputBatches"`
+	MemoryPeak    int64   `json:"memoryPeak"`
+}
+
+func getAnalyzeInfo(infos []*process.AnalyzeInfo, node *plan.Node) *process.AnalyzeInfo {
+	if node.NodeId < 0 || int(node.NodeId) >= len(infos) {
+		return nil
+	}
+	return infos[node.NodeId]
+}
+
+func describeAnalyzeInfo(info *process.AnalyzeInfo) string {
+	s := fmt.Sprintf("Analyze: timeConsumed=%.3fms inputRows=%d outputRows=%d inputBatches=%d outputBatches=%d memoryPeak=%dbytes inputSize=%dbytes",
+		float64(info.TimeConsumed)/1e6, info.InputRows, info.OutputRows, info.InputBatches, info.OutputBatches, info.MemoryPeak, info.InputSize)
+	// only the scans of an engine which prunes blocks have block counts
+	if info.InputBlocks > 0 {
+		s += fmt.Sprintf(" inputBlocks=%d prunedBlocks=%d", info.InputBlocks, info.PrunedBlocks)
+	}
+	return s
+}
+
+// groupScopeInfos groups the statistics of the scopes by the node their output
+// comes from, the scopes without a node go to the root node of the first step.
+func groupScopeInfos(qry *plan.Query, infos []*process.ScopeAnalyzeInfo) map[int32][]*process.ScopeAnalyzeInfo {
+	if len(infos) == 0 || len(qry.Steps) == 0 {
+		return nil
+	}
+	scopes := make(map[int32][]*process.ScopeAnalyzeInfo)
+	for _, info := range infos {
+		nodeId := info.NodeId
+		if nodeId < 0 {
+			nodeId = qry.Steps[0]
+		}
+		scopes[nodeId] = append(scopes[nodeId], info)
+	}
+	return scopes
+}
+
+func describeScopeAnalyzeInfo(info *process.ScopeAnalyzeInfo) string {
+	return fmt.Sprintf("Scope %d: timeConsumed=%.3fms inputRows=%d inputBatches=%d inputSize=%dbytes outputRows=%d outputBatches=%d memoryPeak=%dbytes",
+		info.Id, float64(info.TimeConsumed)/1e6, info.InputRows, info.InputBatches, info.InputSize, info.OutputRows, info.OutputBatches, info.MemoryPeak)
+}
+
+// explainJson pushes the plan as an indented json array holding the root node of every step.
+func explainJson(qry *plan.Query, buffer *ExplainDataBuffer, options *ExplainOptions, infos []*process.AnalyzeInfo, scopes map[int32][]*process.ScopeAnalyzeInfo) error {
+	// the descriptions of the nodes are the ones of the text format
+	textOptions := *options
+	textOptions.Format = EXPLAIN_FORMAT_TEXT
+
+	steps := make([]*jsonNode, len(qry.Steps))
+	for i, rootNodeId := range qry.Steps {
+		step, err := buildJsonNode(qry.Nodes[rootNodeId], qry.Nodes, &textOptions, infos, scopes)
+		if err != nil {
+			return err
+		}
+		steps[i] = step
+	}
+	var data bytes.Buffer
+	enc := json.NewEncoder(&data)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(steps); err != nil {
+		return err
+	}
+	for _, line := range strings.Split(strings.TrimSpace(data.String()), "\n") {
+		buffer.PushNewLine(line, true, 0)
+	}
+	return nil
+}
+
+func buildJsonNode(node *plan.Node, Nodes []*plan.Node, options *ExplainOptions, infos []*process.AnalyzeInfo, scopes map[int32][]*process.ScopeAnalyzeInfo) (*jsonNode, error) {
+	nodedescImpl := NewNodeDescriptionImpl(node)
+	basicNodeInfo, err := nodedescImpl.GetNodeBasicInfo(options)
+	if err != nil {
+		return nil, err
+	}
+	jn := &jsonNode{Node: basicNodeInfo}
+	if options.Verbose {
+		if node.GetProjectList() != nil {
+			projectInfo, err := nodedescImpl.GetProjectListInfo(options)
+			if err != nil {
+				return nil, err
+			}
+			jn.Output = append(jn.Output, projectInfo)
+		}
+		if node.NodeType == plan.Node_VALUE_SCAN {
+			rowsetDataDescImpl := &RowsetDataDescribeImpl{
+				RowsetData: node.RowsetData,
+			}
+			rowsetInfo, err := rowsetDataDescImpl.GetDescription(options)
+			if err != nil {
+				return nil, err
+			}
+			jn.Output = append(jn.Output, "Output: "+rowsetInfo)
+		}
+	}
+	if jn.Details, err = nodedescImpl.GetExtraInfo(options); err != nil {
+		return nil, err
+	}
+	if info := getAnalyzeInfo(infos, node); info != nil {
+		jn.Analyze = &jsonAnalyze{
+			TimeConsumed:  float64(info.TimeConsumed) / 1e6,
+			InputRows:     info.InputRows,
+			OutputRows:    info.OutputRows,
+			InputBatches:  info.InputBatches,
+			OutputBatches: info.OutputBatches,
+			MemoryPeak:    info.MemoryPeak,
+			InputSize:     info.InputSize,
+			InputBlocks:   info.InputBlocks,
+			PrunedBlocks:  info.PrunedBlocks,
+		}
+	}
+	for _, info := range scopes[node.NodeId] {
+		jn.Scopes = append(jn.Scopes, &jsonScope{
+			Id:            info.Id,
+			TimeConsumed:  float64(info.TimeConsumed) / 1e6,
+			InputRows:     info.InputRows,
+			InputBatches:  info.InputBatches,
+			InputSize:     info.InputSize,
+			OutputRows:    info.OutputRows,
+			OutputBatches: info.OutputBatches,
+			MemoryPeak:    info.MemoryPeak,
+		})
+	}
+	for _, childIndex := range node.Children {
+		child, err := buildJsonNode(Nodes[childIndex], Nodes, options, infos, scopes)
+		if err != nil {
+			return nil, err
+		}
+		jn.Children = append(jn.Children, child)
+	}
+	return jn, nil
+}
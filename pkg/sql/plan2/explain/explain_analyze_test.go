@@ -0,0 +1,94 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package explain
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan2"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExplainAnalyze(t *testing.T) {
+	sql := "SELECT N_NAME, N_REGIONKEY FROM NATION WHERE N_NATIONKEY > 0 ORDER BY N_NAME LIMIT 10"
+	stmt, err := mysql.ParseOne(sql)
+	require.NoError(t, err)
+	qry, err := plan2.NewMockOptimizer().Optimize(stmt)
+	require.NoError(t, err)
+
+	infos := process.NewAnalyzeInfos(len(qry.Nodes))
+	for i, info := range infos {
+		info.OutputRows = int64(i + 1)
+		info.TimeConsumed = 1500000
+	}
+	infos[0].InputBlocks = 4
+	infos[0].PrunedBlocks = 3
+	explainQuery := NewExplainQueryImpl(qry)
+	explainQuery.AnalyzeInfos = infos
+	explainQuery.ScopeInfos = []*process.ScopeAnalyzeInfo{
+		{Id: 0, NodeId: -1, OutputRows: 9, TimeConsumed: 2500000},
+		{Id: 1, NodeId: 0, InputRows: 25, OutputRows: 24, MemoryPeak: 128},
+	}
+
+	// text
+	buffer := NewExplainDataBuffer()
+	require.NoError(t, explainQuery.ExplainAnalyze(buffer, NewExplainDefaultOptions()))
+	cnt, pruned, scopes := 0, 0, 0
+	for _, line := range buffer.Lines {
+		if strings.Contains(line, "Scope 0: timeConsumed=2.500ms") ||
+			strings.Contains(line, "Scope 1: timeConsumed=0.000ms inputRows=25") {
+			scopes++
+		}
+		if strings.Contains(line, "Analyze: timeConsumed=1.500ms") {
+			cnt++
+		}
+		if strings.Contains(line, "inputBlocks=4 prunedBlocks=3") {
+			pruned++
+		}
+	}
+	require.Equal(t, len(qry.Nodes), cnt, strings.Join(buffer.Lines, "\n"))
+	require.Equal(t, 1, pruned, strings.Join(buffer.Lines, "\n"))
+	require.Equal(t, 2, scopes, strings.Join(buffer.Lines, "\n"))
+
+	// json
+	options := NewExplainDefaultOptions()
+	options.Format = EXPLAIN_FORMAT_JSON
+	buffer = NewExplainDataBuffer()
+	require.NoError(t, explainQuery.ExplainAnalyze(buffer, options))
+	var steps []*jsonNode
+	require.NoError(t, json.Unmarshal([]byte(strings.Join(buffer.Lines, "\n")), &steps))
+	require.Equal(t, len(qry.Steps), len(steps))
+	root := steps[0]
+	require.NotNil(t, root.Analyze)
+	require.Equal(t, int64(qry.Steps[0]+1), root.Analyze.OutputRows)
+	require.Equal(t, 1.5, root.Analyze.TimeConsumed)
+	require.Equal(t, 1, len(root.Children))
+	// the scope without a node goes to the root
+	require.Equal(t, 1, len(root.Scopes))
+	require.Equal(t, int64(9), root.Scopes[0].OutputRows)
+	require.Equal(t, 2.5, root.Scopes[0].TimeConsumed)
+
+	// json without statistics
+	buffer = NewExplainDataBuffer()
+	require.NoError(t, explainQuery.ExplainPlan(buffer, options))
+	steps = nil
+	require.NoError(t, json.Unmarshal([]byte(strings.Join(buffer.Lines, "\n")), &steps))
+	require.Nil(t, steps[0].Analyze)
+	require.Nil(t, steps[0].Scopes)
+}
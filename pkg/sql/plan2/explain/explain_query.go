@@ -19,12 +19,18 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
 var _ ExplainQuery = &ExplainQueryImpl{}
 
 type ExplainQueryImpl struct {
 	QueryPlan *plan.Query
+	// AnalyzeInfos, runtime statistics of the plan indexed by node id,
+	// ScopeInfos, those of the compiled scopes, filled by the executor for
+	// ExplainAnalyze.
+	AnalyzeInfos []*process.AnalyzeInfo
+	ScopeInfos   []*process.ScopeAnalyzeInfo
 }
 
 func NewExplainQueryImpl(query *plan.Query) *ExplainQueryImpl {
@@ -34,14 +40,30 @@ func NewExplainQueryImpl(query *plan.Query) *ExplainQueryImpl {
 }
 
 func (e *ExplainQueryImpl) ExplainPlan(buffer *ExplainDataBuffer, options *ExplainOptions) error {
+	return e.explain(buffer, options, nil, nil)
+}
+
+// ExplainAnalyze explains the plan together with the runtime statistics of its
+// nodes and of the scopes they are compiled into, a scope is shown under the
+// node its output comes from.
+func (e *ExplainQueryImpl) ExplainAnalyze(buffer *ExplainDataBuffer, options *ExplainOptions) error {
+	return e.explain(buffer, options, e.AnalyzeInfos, groupScopeInfos(e.QueryPlan, e.ScopeInfos))
+}
+
+func (e *ExplainQueryImpl) explain(buffer *ExplainDataBuffer, options *ExplainOptions, infos []*process.AnalyzeInfo, scopes map[int32][]*process.ScopeAnalyzeInfo) error {
 	var Nodes []*plan.Node = e.QueryPlan.Nodes
+	if options.Format == EXPLAIN_FORMAT_JSON {
+		return explainJson(e.QueryPlan, buffer, options, infos, scopes)
+	}
 	for index, rootNodeId := range e.QueryPlan.Steps {
 		logutil.Infof("------------------------------------Query Plan-%v ---------------------------------------------", index)
 		settings := FormatSettings{
-			buffer: buffer,
-			offset: 0,
-			indent: 2,
-			level:  0,
+			buffer:       buffer,
+			offset:       0,
+			indent:       2,
+			level:        0,
+			analyzeInfos: infos,
+			scopeInfos:   scopes,
 		}
 		err := traversalPlan(Nodes[rootNodeId], Nodes, &settings, options)
 		if err != nil {
@@ -51,11 +73,6 @@ func (e *ExplainQueryImpl) ExplainPlan(buffer *ExplainDataBuffer, options *Expla
 	return nil
 }
 
-func (e *ExplainQueryImpl) ExplainAnalyze(buffer *ExplainDataBuffer, options *ExplainOptions) error {
-	// TODO implement me
-	panic("implement me")
-}
-
 func explainStep(step *plan.Node, settings *FormatSettings, options *ExplainOptions) error {
 	nodedescImpl := NewNodeDescriptionImpl(step)
 
@@ -97,6 +114,14 @@ func explainStep(step *plan.Node, settings *FormatSettings, options *ExplainOpti
 		for _, line := range extraInfo {
 			settings.buffer.PushNewLine(line, false, settings.level)
 		}
+
+		// Process analyze information, "Analyze:"
+		if info := getAnalyzeInfo(settings.analyzeInfos, step); info != nil {
+			settings.buffer.PushNewLine(describeAnalyzeInfo(info), false, settings.level)
+		}
+		for _, info := range settings.scopeInfos[step.NodeId] {
+			settings.buffer.PushNewLine(describeScopeAnalyzeInfo(info), false, settings.level)
+		}
 	} else if options.Format == EXPLAIN_FORMAT_JSON {
 		return errors.New(errno.FeatureNotSupported, "unimplement explain format json")
 	} else if options.Format == EXPLAIN_FORMAT_DOT {
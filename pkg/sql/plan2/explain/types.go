@@ -18,6 +18,7 @@ import (
 	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
 type ExplainQuery interface {
@@ -44,6 +45,11 @@ type FormatSettings struct {
 	offset int
 	indent int
 	level  int
+	// analyzeInfos, runtime statistics of the nodes, nil for a plain explain.
+	analyzeInfos []*process.AnalyzeInfo
+	// scopeInfos, runtime statistics of the scopes by the node their output
+	// comes from.
+	scopeInfos map[int32][]*process.ScopeAnalyzeInfo
 }
 
 type ExplainDataBuffer struct {
@@ -0,0 +1,201 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"bytes"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"golang.org/x/exp/constraints"
+)
+
+var boolType = types.Type{Oid: types.T_bool, Size: 1}
+
+// compareFn returns the Fn of the comparison operator op on two arguments of
+// typ. The result of a row is NULL if either side of it is NULL.
+func compareFn(op int, typ types.T) func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	switch typ {
+	case types.T_int8:
+		return orderedCompareFn[int8](op)
+	case types.T_int16:
+		return orderedCompareFn[int16](op)
+	case types.T_int32:
+		return orderedCompareFn[int32](op)
+	case types.T_int64:
+		return orderedCompareFn[int64](op)
+	case types.T_uint8:
+		return orderedCompareFn[uint8](op)
+	case types.T_uint16:
+		return orderedCompareFn[uint16](op)
+	case types.T_uint32:
+		return orderedCompareFn[uint32](op)
+	case types.T_uint64:
+		return orderedCompareFn[uint64](op)
+	case types.T_float32:
+		return orderedCompareFn[float32](op)
+	case types.T_float64:
+		return orderedCompareFn[float64](op)
+	case types.T_date:
+		return orderedCompareFn[types.Date](op)
+	case types.T_datetime:
+		return orderedCompareFn[types.Datetime](op)
+	case types.T_char, types.T_varchar:
+		return func(vs []*vector.Vector, _ *process.Process) (*vector.Vector, error) {
+			lvs, rvs := vs[0].Col.(*types.Bytes), vs[1].Col.(*types.Bytes)
+			return compareRows(vs[0], vs[1], func(i, j int) bool {
+				return compareResult(op, bytes.Compare(lvs.Get(int64(i)), rvs.Get(int64(j))))
+			}), nil
+		}
+	}
+	panic("unsupported type of comparison operator")
+}
+
+func orderedCompareFn[T constraints.Ordered](op int) func(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return func(vs []*vector.Vector, _ *process.Process) (*vector.Vector, error) {
+		lvs, rvs := vs[0].Col.([]T), vs[1].Col.([]T)
+		return compareRows(vs[0], vs[1], func(i, j int) bool {
+			switch {
+			case lvs[i] < rvs[j]:
+				return compareResult(op, -1)
+			case lvs[i] > rvs[j]:
+				return compareResult(op, 1)
+			}
+			return compareResult(op, 0)
+		}), nil
+	}
+}
+
+// compareResult returns the result of op on two values, the order of which
+// is cmp like bytes.Compare.
+func compareResult(op int, cmp int) bool {
+	switch op {
+	case EQUAL:
+		return cmp == 0
+	case NOT_EQUAL:
+		return cmp != 0
+	case GREAT_THAN:
+		return cmp > 0
+	case GREAT_EQUAL:
+		return cmp >= 0
+	case LESS_THAN:
+		return cmp < 0
+	case LESS_EQUAL:
+		return cmp <= 0
+	}
+	panic("unsupported comparison operator")
+}
+
+// compareRows returns the bool vector of fn on the rows of lv and rv, fn gets
+// the indexes of the values of both sides, which are 0 for a const vector.
+func compareRows(lv, rv *vector.Vector, fn func(i, j int) bool) *vector.Vector {
+	n := rowCount(lv, rv)
+	vec := vector.New(boolType)
+	rs := make([]bool, n)
+	for k := 0; k < n; k++ {
+		if isNull(lv, k) || isNull(rv, k) {
+			nulls.Add(vec.Nsp, uint64(k))
+			continue
+		}
+		rs[k] = fn(valueIndex(lv, k), valueIndex(rv, k))
+	}
+	vec.Col = rs
+	return vec
+}
+
+// notFn is the Fn of NOT, NOT NULL is NULL.
+func notFn(vs []*vector.Vector, _ *process.Process) (*vector.Vector, error) {
+	v := vs[0]
+	n := rowCount(v)
+	vec := vector.New(boolType)
+	rs := make([]bool, n)
+	for k := 0; k < n; k++ {
+		if isNull(v, k) {
+			nulls.Add(vec.Nsp, uint64(k))
+			continue
+		}
+		rs[k] = !v.Col.([]bool)[valueIndex(v, k)]
+	}
+	vec.Col = rs
+	return vec, nil
+}
+
+// andFn is the Fn of AND, a false side makes the row false even if the other
+// side is NULL.
+func andFn(vs []*vector.Vector, _ *process.Process) (*vector.Vector, error) {
+	return logicalRows(vs[0], vs[1], false), nil
+}
+
+// orFn is the Fn of OR, a true side makes the row true even if the other side
+// is NULL.
+func orFn(vs []*vector.Vector, _ *process.Process) (*vector.Vector, error) {
+	return logicalRows(vs[0], vs[1], true), nil
+}
+
+// logicalRows returns AND of the rows of lv and rv if dominant is false, OR
+// if it is true. A side equal to dominant decides the row, otherwise the row
+// is NULL if a side is NULL.
+func logicalRows(lv, rv *vector.Vector, dominant bool) *vector.Vector {
+	n := rowCount(lv, rv)
+	vec := vector.New(boolType)
+	rs := make([]bool, n)
+	for k := 0; k < n; k++ {
+		lnull, rnull := isNull(lv, k), isNull(rv, k)
+		var l, r bool
+		if !lnull {
+			l = lv.Col.([]bool)[valueIndex(lv, k)]
+		}
+		if !rnull {
+			r = rv.Col.([]bool)[valueIndex(rv, k)]
+		}
+		switch {
+		case (!lnull && l == dominant) || (!rnull && r == dominant):
+			rs[k] = dominant
+		case lnull || rnull:
+			nulls.Add(vec.Nsp, uint64(k))
+		default:
+			rs[k] = !dominant
+		}
+	}
+	vec.Col = rs
+	return vec
+}
+
+// rowCount returns the number of rows of the arguments of a function, the
+// const ones have the length of the batch.
+func rowCount(vs ...*vector.Vector) int {
+	for _, v := range vs {
+		if !v.IsConst && !v.IsConstNull {
+			return vector.Length(v)
+		}
+	}
+	return vs[0].Length
+}
+
+func isNull(v *vector.Vector, row int) bool {
+	if v.IsConstNull {
+		return true
+	}
+	return nulls.Contains(v.Nsp, uint64(valueIndex(v, row)))
+}
+
+func valueIndex(v *vector.Vector, row int) int {
+	if v.IsConst {
+		return 0
+	}
+	return row
+}
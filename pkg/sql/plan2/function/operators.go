@@ -48,7 +48,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(EQUAL, types.T_uint8),
 		},
 		{
 			Index:  1,
@@ -60,7 +60,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(EQUAL, types.T_uint16),
 		},
 		{
 			Index:  2,
@@ -72,7 +72,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(EQUAL, types.T_uint32),
 		},
 		{
 			Index:  3,
@@ -84,7 +84,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(EQUAL, types.T_uint64),
 		},
 		{
 			Index:  4,
@@ -96,7 +96,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(EQUAL, types.T_int8),
 		},
 		{
 			Index:  5,
@@ -108,7 +108,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(EQUAL, types.T_int16),
 		},
 		{
 			Index:  6,
@@ -120,7 +120,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(EQUAL, types.T_int32),
 		},
 		{
 			Index:  7,
@@ -132,7 +132,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(EQUAL, types.T_int64),
 		},
 		{
 			Index:  8,
@@ -144,7 +144,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(EQUAL, types.T_float32),
 		},
 		{
 			Index:  9,
@@ -156,7 +156,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(EQUAL, types.T_float64),
 		},
 		{
 			Index:  10,
@@ -192,7 +192,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(EQUAL, types.T_varchar),
 		},
 		{
 			Index:  13,
@@ -204,7 +204,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(EQUAL, types.T_char),
 		},
 		{
 			Index:  14,
@@ -216,7 +216,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(EQUAL, types.T_date),
 		},
 	},
 	GREAT_THAN: {
@@ -230,7 +230,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(GREAT_THAN, types.T_uint8),
 		},
 		{
 			Index:  1,
@@ -242,7 +242,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(GREAT_THAN, types.T_uint16),
 		},
 		{
 			Index:  2,
@@ -254,7 +254,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(GREAT_THAN, types.T_uint32),
 		},
 		{
 			Index:  3,
@@ -266,7 +266,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(GREAT_THAN, types.T_uint64),
 		},
 		{
 			Index:  4,
@@ -278,7 +278,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(GREAT_THAN, types.T_int8),
 		},
 		{
 			Index:  5,
@@ -290,7 +290,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(GREAT_THAN, types.T_int16),
 		},
 		{
 			Index:  6,
@@ -302,7 +302,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(GREAT_THAN, types.T_int32),
 		},
 		{
 			Index:  7,
@@ -314,7 +314,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(GREAT_THAN, types.T_int64),
 		},
 		{
 			Index:  8,
@@ -326,7 +326,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(GREAT_THAN, types.T_float32),
 		},
 		{
 			Index:  9,
@@ -338,7 +338,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(GREAT_THAN, types.T_float64),
 		},
 		{
 			Index:  10,
@@ -374,7 +374,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(GREAT_THAN, types.T_varchar),
 		},
 		{
 			Index:  13,
@@ -386,7 +386,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(GREAT_THAN, types.T_char),
 		},
 		{
 			Index:  14,
@@ -398,7 +398,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(GREAT_THAN, types.T_date),
 		},
 	},
 	GREAT_EQUAL: {
@@ -412,7 +412,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(GREAT_EQUAL, types.T_uint8),
 		},
 		{
 			Index:  1,
@@ -424,7 +424,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(GREAT_EQUAL, types.T_uint16),
 		},
 		{
 			Index:  2,
@@ -436,7 +436,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(GREAT_EQUAL, types.T_uint32),
 		},
 		{
 			Index:  3,
@@ -448,7 +448,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(GREAT_EQUAL, types.T_uint64),
 		},
 		{
 			Index:  4,
@@ -460,7 +460,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(GREAT_EQUAL, types.T_int8),
 		},
 		{
 			Index:  5,
@@ -472,7 +472,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(GREAT_EQUAL, types.T_int16),
 		},
 		{
 			Index:  6,
@@ -484,7 +484,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(GREAT_EQUAL, types.T_int32),
 		},
 		{
 			Index:  7,
@@ -496,7 +496,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(GREAT_EQUAL, types.T_int64),
 		},
 		{
 			Index:  8,
@@ -508,7 +508,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(GREAT_EQUAL, types.T_float32),
 		},
 		{
 			Index:  9,
@@ -520,7 +520,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(GREAT_EQUAL, types.T_float64),
 		},
 		{
 			Index:  10,
@@ -556,7 +556,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(GREAT_EQUAL, types.T_varchar),
 		},
 		{
 			Index:  13,
@@ -568,7 +568,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(GREAT_EQUAL, types.T_char),
 		},
 		{
 			Index:  14,
@@ -580,7 +580,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(GREAT_EQUAL, types.T_date),
 		},
 	},
 	LESS_THAN: {
@@ -594,7 +594,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(LESS_THAN, types.T_uint8),
 		},
 		{
 			Index:  1,
@@ -606,7 +606,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(LESS_THAN, types.T_uint16),
 		},
 		{
 			Index:  2,
@@ -618,7 +618,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(LESS_THAN, types.T_uint32),
 		},
 		{
 			Index:  3,
@@ -630,7 +630,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(LESS_THAN, types.T_uint64),
 		},
 		{
 			Index:  4,
@@ -642,7 +642,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(LESS_THAN, types.T_int8),
 		},
 		{
 			Index:  5,
@@ -654,7 +654,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(LESS_THAN, types.T_int16),
 		},
 		{
 			Index:  6,
@@ -666,7 +666,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(LESS_THAN, types.T_int32),
 		},
 		{
 			Index:  7,
@@ -678,7 +678,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(LESS_THAN, types.T_int64),
 		},
 		{
 			Index:  8,
@@ -690,7 +690,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(LESS_THAN, types.T_float32),
 		},
 		{
 			Index:  9,
@@ -702,7 +702,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(LESS_THAN, types.T_float64),
 		},
 		{
 			Index:  10,
@@ -738,7 +738,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(LESS_THAN, types.T_varchar),
 		},
 		{
 			Index:  13,
@@ -750,7 +750,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(LESS_THAN, types.T_char),
 		},
 		{
 			Index:  14,
@@ -762,7 +762,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(LESS_THAN, types.T_date),
 		},
 	},
 	LESS_EQUAL: {
@@ -776,7 +776,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(LESS_EQUAL, types.T_uint8),
 		},
 		{
 			Index:  1,
@@ -788,7 +788,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(LESS_EQUAL, types.T_uint16),
 		},
 		{
 			Index:  2,
@@ -800,7 +800,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(LESS_EQUAL, types.T_uint32),
 		},
 		{
 			Index:  3,
@@ -812,7 +812,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(LESS_EQUAL, types.T_uint64),
 		},
 		{
 			Index:  4,
@@ -824,7 +824,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(LESS_EQUAL, types.T_int8),
 		},
 		{
 			Index:  5,
@@ -836,7 +836,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(LESS_EQUAL, types.T_int16),
 		},
 		{
 			Index:  6,
@@ -848,7 +848,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(LESS_EQUAL, types.T_int32),
 		},
 		{
 			Index:  7,
@@ -860,7 +860,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(LESS_EQUAL, types.T_int64),
 		},
 		{
 			Index:  8,
@@ -872,7 +872,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(LESS_EQUAL, types.T_float32),
 		},
 		{
 			Index:  9,
@@ -884,7 +884,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(LESS_EQUAL, types.T_float64),
 		},
 		{
 			Index:  10,
@@ -920,7 +920,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(LESS_EQUAL, types.T_varchar),
 		},
 		{
 			Index:  13,
@@ -932,7 +932,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(LESS_EQUAL, types.T_char),
 		},
 		{
 			Index:  14,
@@ -944,7 +944,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(LESS_EQUAL, types.T_date),
 		},
 	},
 	NOT_EQUAL: {
@@ -958,7 +958,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(NOT_EQUAL, types.T_uint8),
 		},
 		{
 			Index:  1,
@@ -970,7 +970,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(NOT_EQUAL, types.T_uint16),
 		},
 		{
 			Index:  2,
@@ -982,7 +982,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(NOT_EQUAL, types.T_uint32),
 		},
 		{
 			Index:  3,
@@ -994,7 +994,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(NOT_EQUAL, types.T_uint64),
 		},
 		{
 			Index:  4,
@@ -1006,7 +1006,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(NOT_EQUAL, types.T_int8),
 		},
 		{
 			Index:  5,
@@ -1018,7 +1018,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(NOT_EQUAL, types.T_int16),
 		},
 		{
 			Index:  6,
@@ -1030,7 +1030,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(NOT_EQUAL, types.T_int32),
 		},
 		{
 			Index:  7,
@@ -1042,7 +1042,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(NOT_EQUAL, types.T_int64),
 		},
 		{
 			Index:  8,
@@ -1054,7 +1054,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(NOT_EQUAL, types.T_float32),
 		},
 		{
 			Index:  9,
@@ -1066,7 +1066,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(NOT_EQUAL, types.T_float64),
 		},
 		{
 			Index:  10,
@@ -1102,7 +1102,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(NOT_EQUAL, types.T_varchar),
 		},
 		{
 			Index:  13,
@@ -1114,7 +1114,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(NOT_EQUAL, types.T_char),
 		},
 		{
 			Index:  14,
@@ -1126,7 +1126,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          compareFn(NOT_EQUAL, types.T_date),
 		},
 	},
 	LIKE: {
@@ -1339,7 +1339,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          andFn,
 		},
 	},
 	OR: {
@@ -1353,7 +1353,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          orFn,
 		},
 	},
 	NOT: {
@@ -1366,7 +1366,7 @@ var operators = map[int][]Function{
 			},
 			ReturnTyp:   types.T_bool,
 			TypeCheckFn: strictTypeCheck,
-			Fn:          nil,
+			Fn:          notFn,
 		},
 	},
 	// arithmetic operator
@@ -195,6 +195,53 @@ func NewMockCompilerContext() *MockCompilerContext {
 		}
 	}
 
+	// partition some of the tables for the partition pruning tests
+	dateValue := func(s string) *Expr {
+		return &Expr{
+			Expr: &plan.Expr_C{C: &Const{Value: &plan.Const_Sval{Sval: s}}},
+			Typ:  &plan.Type{Id: plan.Type_DATE},
+		}
+	}
+	intValue := func(i int64) *Expr {
+		return &Expr{
+			Expr: &plan.Expr_C{C: &Const{Value: &plan.Const_Ival{Ival: i}}},
+			Typ:  &plan.Type{Id: plan.Type_INT32},
+		}
+	}
+	partitions := map[string]*plan.PartitionByDef{
+		"orders": {
+			Type:   plan.PartitionByDef_RANGE,
+			Fields: []string{"o_orderdate"},
+			Partitions: []*plan.PartitionByDef_Partition{
+				{Name: "p1993", Values: []*Expr{dateValue("1994-01-01")}},
+				{Name: "p1994", Values: []*Expr{dateValue("1995-01-01")}},
+				{Name: "p1995", Values: []*Expr{dateValue("1996-01-01")}},
+				{Name: "pmax"},
+			},
+		},
+		"nation": {
+			Type:   plan.PartitionByDef_LIST,
+			Fields: []string{"n_regionkey"},
+			Partitions: []*plan.PartitionByDef_Partition{
+				{Name: "p0", Values: []*Expr{intValue(0), intValue(1)}},
+				{Name: "p1", Values: []*Expr{intValue(2), intValue(3)}},
+				{Name: "p2", Values: []*Expr{intValue(4)}},
+			},
+		},
+		"customer": {
+			Type:   plan.PartitionByDef_HASH,
+			Fields: []string{"c_nationkey"},
+			Num:    4,
+		},
+	}
+	for tableName, def := range partitions {
+		tables[tableName].Defs = append(tables[tableName].Defs, &plan.TableDef_DefType{
+			Def: &plan.TableDef_DefType_Partition{
+				Partition: def,
+			},
+		})
+	}
+
 	return &MockCompilerContext{
 		objects: objects,
 		tables:  tables,
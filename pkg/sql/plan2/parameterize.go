@@ -0,0 +1,246 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"fmt"
+	"go/constant"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ParamStmt is a select statement whose literals in the predicates are
+// replaced by parameters, so that statements only differing in these
+// literals share the same plan.
+type ParamStmt struct {
+	// Key is the normalized sql of the statement together with the types
+	// of its parameters.
+	Key string
+	// Params are the values of the parameters.
+	Params []*Expr
+
+	slots    []*tree.Expr
+	literals []tree.Expr
+}
+
+// Parameterize replaces the numeric and string literals in the where, having
+// and join conditions of a select statement by parameters. It returns nil if
+// the plan of the statement can not be cached.
+func Parameterize(stmt tree.Statement) *ParamStmt {
+	sel, ok := stmt.(*tree.Select)
+	if !ok || sel.Ep != nil {
+		return nil
+	}
+	ps := &ParamStmt{}
+	ps.parameterizeSelect(sel)
+	kinds := make([]string, len(ps.Params))
+	for i, param := range ps.Params {
+		kinds[i] = param.Typ.Id.String()
+	}
+	ps.Key = tree.String(stmt, dialect.MYSQL) + "/" + strings.Join(kinds, ",")
+	return ps
+}
+
+// Restore puts the literals back into the statement.
+func (ps *ParamStmt) Restore() {
+	for i, slot := range ps.slots {
+		*slot = ps.literals[i]
+	}
+}
+
+func (ps *ParamStmt) parameterizeSelect(sel *tree.Select) {
+	if sel.With != nil {
+		for _, cte := range sel.With.CTEs {
+			ps.parameterizeStatement(cte.Stmt)
+		}
+	}
+	ps.parameterizeSelectStatement(sel.Select)
+}
+
+func (ps *ParamStmt) parameterizeStatement(stmt tree.Statement) {
+	switch stmt := stmt.(type) {
+	case *tree.Select:
+		ps.parameterizeSelect(stmt)
+	case *tree.ParenSelect:
+		ps.parameterizeSelect(stmt.Select)
+	}
+}
+
+func (ps *ParamStmt) parameterizeSelectStatement(stmt tree.SelectStatement) {
+	switch stmt := stmt.(type) {
+	case *tree.Select:
+		ps.parameterizeSelect(stmt)
+	case *tree.ParenSelect:
+		ps.parameterizeSelect(stmt.Select)
+	case *tree.UnionClause:
+		ps.parameterizeSelectStatement(stmt.Left)
+		ps.parameterizeSelectStatement(stmt.Right)
+	case *tree.SelectClause:
+		if stmt.From != nil {
+			for _, table := range stmt.From.Tables {
+				ps.parameterizeTable(table)
+			}
+		}
+		if stmt.Where != nil {
+			ps.parameterizeExpr(&stmt.Where.Expr)
+		}
+		if stmt.Having != nil {
+			ps.parameterizeExpr(&stmt.Having.Expr)
+		}
+	}
+}
+
+func (ps *ParamStmt) parameterizeTable(table tree.TableExpr) {
+	switch table := table.(type) {
+	case *tree.JoinTableExpr:
+		ps.parameterizeTable(table.Left)
+		ps.parameterizeTable(table.Right)
+		if cond, ok := table.Cond.(*tree.OnJoinCond); ok {
+			ps.parameterizeExpr(&cond.Expr)
+		}
+	case *tree.ParenTableExpr:
+		ps.parameterizeTable(table.Expr)
+	case *tree.AliasedTableExpr:
+		ps.parameterizeTable(table.Expr)
+	case *tree.Subquery:
+		ps.parameterizeSelectStatement(table.Select)
+	case *tree.StatementSource:
+		ps.parameterizeStatement(table.Statement)
+	}
+}
+
+func (ps *ParamStmt) parameterizeExpr(slot *tree.Expr) {
+	switch expr := (*slot).(type) {
+	case *tree.NumVal:
+		switch expr.Value.Kind() {
+		case constant.Int, constant.Float, constant.String:
+		default:
+			return
+		}
+		val, err := buildNumVal(expr.Value)
+		if err != nil {
+			return
+		}
+		ps.Params = append(ps.Params, val)
+		ps.slots = append(ps.slots, slot)
+		ps.literals = append(ps.literals, expr)
+		*slot = tree.NewParamExpr(len(ps.Params), expr)
+	case *tree.ParenExpr:
+		ps.parameterizeExpr(&expr.Expr)
+	case *tree.AndExpr:
+		ps.parameterizeExpr(&expr.Left)
+		ps.parameterizeExpr(&expr.Right)
+	case *tree.OrExpr:
+		ps.parameterizeExpr(&expr.Left)
+		ps.parameterizeExpr(&expr.Right)
+	case *tree.NotExpr:
+		ps.parameterizeExpr(&expr.Expr)
+	case *tree.UnaryExpr:
+		ps.parameterizeExpr(&expr.Expr)
+	case *tree.BinaryExpr:
+		ps.parameterizeExpr(&expr.Left)
+		ps.parameterizeExpr(&expr.Right)
+	case *tree.ComparisonExpr:
+		ps.parameterizeExpr(&expr.Left)
+		ps.parameterizeExpr(&expr.Right)
+	case *tree.RangeCond:
+		ps.parameterizeExpr(&expr.Left)
+		ps.parameterizeExpr(&expr.From)
+		ps.parameterizeExpr(&expr.To)
+	case *tree.IsNullExpr:
+		ps.parameterizeExpr(&expr.Expr)
+	case *tree.IsNotNullExpr:
+		ps.parameterizeExpr(&expr.Expr)
+	case *tree.CastExpr:
+		ps.parameterizeExpr(&expr.Expr)
+	case *tree.Tuple:
+		for i := range expr.Exprs {
+			ps.parameterizeExpr(&expr.Exprs[i])
+		}
+	case *tree.CaseExpr:
+		if expr.Expr != nil {
+			ps.parameterizeExpr(&expr.Expr)
+		}
+		for _, when := range expr.Whens {
+			ps.parameterizeExpr(&when.Cond)
+			ps.parameterizeExpr(&when.Val)
+		}
+		if expr.Else != nil {
+			ps.parameterizeExpr(&expr.Else)
+		}
+	case *tree.FuncExpr:
+		// the arguments of interval and extract are needed while building the plan
+		if name, ok := expr.Func.FunctionReference.(*tree.UnresolvedName); ok {
+			switch strings.ToLower(name.Parts[0]) {
+			case "interval", "extract":
+				return
+			}
+		}
+		for i := range expr.Exprs {
+			ps.parameterizeExpr(&expr.Exprs[i])
+		}
+	case *tree.Subquery:
+		ps.parameterizeSelectStatement(expr.Select)
+	}
+}
+
+// BindParams returns a copy of a plan whose parameter references are replaced
+// by the values of params.
+func BindParams(pn *Plan, params []*Expr) (*Plan, error) {
+	pn = proto.Clone(pn).(*Plan)
+	if err := bindParams(pn.ProtoReflect(), params); err != nil {
+		return nil, err
+	}
+	// the conjuncts with parameters are pruned by their values now
+	if query := pn.GetQuery(); query != nil {
+		prunePartitions(query)
+	}
+	return pn, nil
+}
+
+func bindParams(m protoreflect.Message, params []*Expr) error {
+	if expr, ok := m.Interface().(*Expr); ok {
+		if p, ok := expr.Expr.(*plan.Expr_P); ok {
+			pos := int(p.P.Pos)
+			if pos < 1 || pos > len(params) {
+				return errors.New(errno.UndefinedParameter, fmt.Sprintf("parameter %d is not bound", pos))
+			}
+			expr.Expr = params[pos-1].Expr
+			return nil
+		}
+	}
+	var err error
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.Message() == nil || fd.IsMap():
+		case fd.IsList():
+			list := v.List()
+			for i := 0; i < list.Len() && err == nil; i++ {
+				err = bindParams(list.Get(i).Message(), params)
+			}
+		default:
+			err = bindParams(v.Message(), params)
+		}
+		return err == nil
+	})
+	return err
+}
@@ -0,0 +1,666 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// buildPartitionDef builds the partitioning of a table by the range, the
+// list or the hash of one of its columns. Key partitioning, expressions of
+// the columns and subpartitions are not supported.
+func buildPartitionDef(opt *tree.PartitionOption, tableDef *TableDef) (*plan.TableDef_DefType, error) {
+	if opt.SubPartBy != nil {
+		return nil, errors.New(errno.FeatureNotSupported, "subpartition is not supported now")
+	}
+	def := &plan.PartitionByDef{}
+	var expr tree.Expr
+	var columnList []*tree.UnresolvedName
+	switch typ := opt.PartBy.PType.(type) {
+	case *tree.RangeType:
+		def.Type = plan.PartitionByDef_RANGE
+		expr, columnList = typ.Expr, typ.ColumnList
+	case *tree.ListType:
+		def.Type = plan.PartitionByDef_LIST
+		expr, columnList = typ.Expr, typ.ColumnList
+	case *tree.HashType:
+		if typ.Linear {
+			return nil, errors.New(errno.FeatureNotSupported, "linear hash partition is not supported now")
+		}
+		def.Type = plan.PartitionByDef_HASH
+		expr = typ.Expr
+	default:
+		return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("partition by %s is not supported now", tree.String(typ, dialect.MYSQL)))
+	}
+	col, err := getPartitionColumn(def.Type, expr, columnList, tableDef)
+	if err != nil {
+		return nil, err
+	}
+	def.Fields = []string{col.Name}
+
+	if def.Type == plan.PartitionByDef_HASH {
+		def.Num = int32(opt.PartBy.Num)
+		for _, part := range opt.Partitions {
+			if part.Values != nil {
+				return nil, errors.New(errno.InvalidTableDefinition, "Only RANGE PARTITIONING can use VALUES LESS THAN and only LIST PARTITIONING can use VALUES IN in partition definition")
+			}
+		}
+		if def.Num == 0 {
+			def.Num = int32(len(opt.Partitions))
+		}
+		if def.Num == 0 {
+			def.Num = 1
+		}
+	} else if err = buildPartitions(def, col, opt.Partitions); err != nil {
+		return nil, err
+	}
+	return &plan.TableDef_DefType{
+		Def: &plan.TableDef_DefType_Partition{
+			Partition: def,
+		},
+	}, nil
+}
+
+// getPartitionColumn returns the column a table is partitioned by, which is
+// an integer, a date, a datetime or a string, hash partitioning is limited
+// to integers.
+func getPartitionColumn(typ plan.PartitionByDef_PartitionType, expr tree.Expr, columnList []*tree.UnresolvedName, tableDef *TableDef) (*ColDef, error) {
+	if expr != nil {
+		columnList = nil
+		if name, ok := expr.(*tree.UnresolvedName); ok {
+			columnList = []*tree.UnresolvedName{name}
+		}
+	}
+	if len(columnList) != 1 || columnList[0].NumParts != 1 {
+		return nil, errors.New(errno.FeatureNotSupported, "only partition by one column is supported now")
+	}
+	name := columnList[0].Parts[0]
+	var col *ColDef
+	for _, c := range tableDef.Cols {
+		if strings.EqualFold(c.Name, name) {
+			col = c
+		}
+	}
+	if col == nil {
+		return nil, errors.New(errno.UndefinedColumn, fmt.Sprintf("Unknown column '%s' in 'partition function'", name))
+	}
+	switch col.Typ.Id {
+	case plan.Type_INT8, plan.Type_INT16, plan.Type_INT32, plan.Type_INT64,
+		plan.Type_UINT8, plan.Type_UINT16, plan.Type_UINT32, plan.Type_UINT64:
+		return col, nil
+	case plan.Type_DATE, plan.Type_DATETIME, plan.Type_CHAR, plan.Type_VARCHAR:
+		if typ != plan.PartitionByDef_HASH {
+			return col, nil
+		}
+	}
+	return nil, errors.New(errno.InvalidTableDefinition, fmt.Sprintf("Field '%s' is of a not allowed type for this type of partitioning", col.Name))
+}
+
+// buildPartitions appends the range or list partitions parts of a table
+// partitioned by col to def.
+func buildPartitions(def *plan.PartitionByDef, col *ColDef, parts []*tree.Partition) error {
+	if len(parts) == 0 {
+		return errors.New(errno.InvalidTableDefinition, fmt.Sprintf("For %s partitions each partition must be defined", def.Type))
+	}
+	names := make(map[string]bool, len(def.Partitions)+len(parts))
+	for _, part := range def.Partitions {
+		names[part.Name] = true
+	}
+	for _, part := range parts {
+		name := string(part.Name)
+		if names[name] {
+			return errors.New(errno.DuplicateObject, fmt.Sprintf("Duplicate partition name %s", name))
+		}
+		names[name] = true
+		if len(part.Subs) > 0 {
+			return errors.New(errno.FeatureNotSupported, "subpartition is not supported now")
+		}
+		partition := &plan.PartitionByDef_Partition{Name: name}
+		switch values := part.Values.(type) {
+		case *tree.ValuesLessThan:
+			if def.Type != plan.PartitionByDef_RANGE {
+				return errors.New(errno.InvalidTableDefinition, "Only RANGE PARTITIONING can use VALUES LESS THAN in partition definition")
+			}
+			if len(values.ValueList) != 1 {
+				return errors.New(errno.InvalidTableDefinition, "Inconsistency in usage of column lists for partitioning")
+			}
+			if _, ok := values.ValueList[0].(*tree.MaxValue); !ok {
+				value, err := buildPartitionValue(col, values.ValueList[0])
+				if err != nil {
+					return err
+				}
+				if value.GetC().GetIsnull() {
+					return errors.New(errno.InvalidTableDefinition, "Not allowed to use NULL value in VALUES LESS THAN")
+				}
+				partition.Values = []*Expr{value}
+			}
+		case *tree.ValuesIn:
+			if def.Type != plan.PartitionByDef_LIST {
+				return errors.New(errno.InvalidTableDefinition, "Only LIST PARTITIONING can use VALUES IN in partition definition")
+			}
+			for _, exprs := range values.ValueList {
+				if len(exprs) != 1 {
+					return errors.New(errno.InvalidTableDefinition, "Inconsistency in usage of column lists for partitioning")
+				}
+				value, err := buildPartitionValue(col, exprs[0])
+				if err != nil {
+					return err
+				}
+				partition.Values = append(partition.Values, value)
+			}
+		default:
+			return errors.New(errno.InvalidTableDefinition, fmt.Sprintf("%s PARTITIONING requires definition of VALUES for each partition", def.Type))
+		}
+		def.Partitions = append(def.Partitions, partition)
+	}
+	return nil
+}
+
+// buildPartitionValue returns the constant of a partition value of col. The
+// dates and the datetimes are kept as strings, the way they are written in
+// the predicates.
+func buildPartitionValue(col *ColDef, expr tree.Expr) (*Expr, error) {
+	value, err := buildConstant(col.Typ, expr)
+	if err == nil && value != nil {
+		_, err = rangeCheck(value, col.Typ, col.Name, 0)
+	}
+	if err != nil {
+		return nil, errors.New(errno.InvalidTableDefinition, fmt.Sprintf("Invalid partition value '%s' for column '%s'", tree.String(expr, dialect.MYSQL), col.Name))
+	}
+	c := &Const{}
+	switch v := value.(type) {
+	case nil:
+		c.Isnull = true
+	case int64:
+		c.Value = &plan.Const_Ival{Ival: v}
+	case uint64:
+		c.Value = &plan.Const_Ival{Ival: int64(v)}
+	case string:
+		c.Value = &plan.Const_Sval{Sval: v}
+	case types.Date:
+		c.Value = &plan.Const_Sval{Sval: v.String()}
+	case types.Datetime:
+		c.Value = &plan.Const_Sval{Sval: v.String()}
+	default:
+		return nil, errors.New(errno.InvalidTableDefinition, fmt.Sprintf("Invalid partition value '%s' for column '%s'", tree.String(expr, dialect.MYSQL), col.Name))
+	}
+	return &Expr{
+		Expr: &plan.Expr_C{C: c},
+		Typ:  col.Typ,
+	}, nil
+}
+
+// getPartitionByDef returns the partitioning of a table, nil if it is not
+// partitioned.
+func getPartitionByDef(tableDef *TableDef) *plan.PartitionByDef {
+	for _, def := range tableDef.GetDefs() {
+		if partition := def.GetPartition(); partition != nil {
+			return partition
+		}
+	}
+	return nil
+}
+
+// partitionNames returns the names of the partitions of def, the hash
+// partitions are named p0 to pN-1.
+func partitionNames(def *plan.PartitionByDef) []string {
+	if def.Type == plan.PartitionByDef_HASH {
+		names := make([]string, def.Num)
+		for i := range names {
+			names[i] = fmt.Sprintf("p%d", i)
+		}
+		return names
+	}
+	names := make([]string, len(def.Partitions))
+	for i, part := range def.Partitions {
+		names[i] = part.Name
+	}
+	return names
+}
+
+// prunePartitions limits the table scans of partitioned tables in query to
+// the partitions the conjuncts of their filters on the partition column can
+// match. The conjuncts with parameters are left out, the plans are pruned
+// again once the parameters are bound.
+func prunePartitions(query *Query) {
+	for _, node := range query.Nodes {
+		if node.NodeType != plan.Node_TABLE_SCAN || node.TableDef == nil || len(node.WhereList) == 0 {
+			continue
+		}
+		def := getPartitionByDef(node.TableDef)
+		if def == nil || len(def.Fields) != 1 {
+			continue
+		}
+		p := &partitionPruner{def: def, colPos: -1}
+		for i, col := range node.TableDef.Cols {
+			if col.Name == def.Fields[0] {
+				p.colPos, p.typ = int32(i), col.Typ
+			}
+		}
+		if p.colPos < 0 {
+			continue
+		}
+		names := partitionNames(def)
+		var matched []bool
+		for _, expr := range node.WhereList {
+			if m := p.match(expr); m != nil {
+				matched = intersectPartitions(matched, m)
+			}
+		}
+		if matched == nil {
+			continue
+		}
+		node.PartitionPruned = true
+		node.Partitions = node.Partitions[:0]
+		for i, ok := range matched {
+			if ok {
+				node.Partitions = append(node.Partitions, names[i])
+			}
+		}
+	}
+}
+
+func intersectPartitions(left, right []bool) []bool {
+	if left == nil {
+		return right
+	}
+	for i := range left {
+		left[i] = left[i] && right[i]
+	}
+	return left
+}
+
+type partitionPruner struct {
+	def    *plan.PartitionByDef
+	colPos int32
+	typ    *plan.Type
+}
+
+func (p *partitionPruner) count() int {
+	if p.def.Type == plan.PartitionByDef_HASH {
+		return int(p.def.Num)
+	}
+	return len(p.def.Partitions)
+}
+
+// match returns the partitions the rows matching expr are in, nil if they
+// can be in all of them.
+func (p *partitionPruner) match(expr *Expr) []bool {
+	f, ok := expr.Expr.(*plan.Expr_F)
+	if !ok {
+		return nil
+	}
+	args := f.F.Args
+	switch name := f.F.Func.GetObjName(); name {
+	case "and":
+		var matched []bool
+		for _, arg := range args {
+			if m := p.match(arg); m != nil {
+				matched = intersectPartitions(matched, m)
+			}
+		}
+		return matched
+	case "or":
+		matched := make([]bool, p.count())
+		for _, arg := range args {
+			m := p.match(arg)
+			if m == nil {
+				return nil
+			}
+			for i := range matched {
+				matched[i] = matched[i] || m[i]
+			}
+		}
+		return matched
+	case "=", "<", "<=", ">", ">=":
+		if len(args) != 2 {
+			return nil
+		}
+		col, c := args[0], args[1]
+		if p.isColumn(c) {
+			col, c = c, col
+			name = flippedOps[name]
+		}
+		if !p.isColumn(col) {
+			return nil
+		}
+		v, ok := p.value(c)
+		if !ok {
+			return nil
+		}
+		return p.matchValues(name, []interface{}{v})
+	case "in":
+		if len(args) != 2 || !p.isColumn(args[0]) {
+			return nil
+		}
+		list, ok := args[1].Expr.(*plan.Expr_List)
+		if !ok {
+			return nil
+		}
+		values := make([]interface{}, len(list.List.List))
+		for i, e := range list.List.List {
+			if values[i], ok = p.value(e); !ok {
+				return nil
+			}
+		}
+		return p.matchValues("=", values)
+	case "ifnull":
+		// is null is built as ifnull of the single operand
+		if len(args) != 1 || !p.isColumn(args[0]) {
+			return nil
+		}
+		return p.matchNull()
+	}
+	return nil
+}
+
+var flippedOps = map[string]string{
+	"=":  "=",
+	"<":  ">",
+	"<=": ">=",
+	">":  "<",
+	">=": "<=",
+}
+
+// isColumn returns true if expr is the partition column, or a cast of it
+// which keeps the values and their order.
+func (p *partitionPruner) isColumn(expr *Expr) bool {
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		return e.Col.RelPos == 0 && e.Col.ColPos == p.colPos
+	case *plan.Expr_F:
+		if e.F.Func.GetObjName() != "cast" || len(e.F.Args) != 1 {
+			return false
+		}
+		switch p.typ.Id {
+		case plan.Type_INT8, plan.Type_INT16, plan.Type_INT32, plan.Type_INT64,
+			plan.Type_UINT8, plan.Type_UINT16, plan.Type_UINT32:
+			switch expr.Typ.Id {
+			case plan.Type_INT64, plan.Type_FLOAT64:
+				return p.isColumn(e.F.Args[0])
+			}
+		}
+		return expr.Typ.Id == p.typ.Id && p.isColumn(e.F.Args[0])
+	}
+	return false
+}
+
+// value returns the value of a constant in the type of the partition
+// column, nil for NULL. The integers are int64s or float64s, the dates and
+// the datetimes int64s, the strings strings.
+func (p *partitionPruner) value(expr *Expr) (interface{}, bool) {
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_C:
+		return constPartitionValue(e.C, p.typ)
+	case *plan.Expr_F:
+		if e.F.Func.GetObjName() == "cast" && len(e.F.Args) == 1 {
+			if c, ok := e.F.Args[0].Expr.(*plan.Expr_C); ok {
+				return constPartitionValue(c.C, p.typ)
+			}
+		}
+	}
+	return nil, false
+}
+
+func constPartitionValue(c *Const, typ *plan.Type) (interface{}, bool) {
+	if c.GetIsnull() {
+		return nil, true
+	}
+	switch v := c.GetValue().(type) {
+	case *plan.Const_Ival:
+		switch typ.Id {
+		case plan.Type_DATE, plan.Type_DATETIME, plan.Type_CHAR, plan.Type_VARCHAR:
+			return nil, false
+		}
+		return v.Ival, true
+	case *plan.Const_Dval:
+		switch typ.Id {
+		case plan.Type_DATE, plan.Type_DATETIME, plan.Type_CHAR, plan.Type_VARCHAR:
+			return nil, false
+		}
+		return v.Dval, true
+	case *plan.Const_Sval:
+		switch typ.Id {
+		case plan.Type_DATE:
+			d, err := types.ParseDate(v.Sval)
+			return int64(d), err == nil
+		case plan.Type_DATETIME:
+			dt, err := types.ParseDatetime(v.Sval)
+			return int64(dt), err == nil
+		case plan.Type_CHAR, plan.Type_VARCHAR:
+			return v.Sval, true
+		}
+	}
+	return nil, false
+}
+
+// comparePartitionValues compares the non-NULL values a and b of the
+// partition column.
+func comparePartitionValues(a, b interface{}) int {
+	switch x := a.(type) {
+	case int64:
+		switch y := b.(type) {
+		case int64:
+			return compareOrdered(x, y)
+		case float64:
+			return compareOrdered(float64(x), y)
+		}
+	case float64:
+		switch y := b.(type) {
+		case int64:
+			return compareOrdered(x, float64(y))
+		case float64:
+			return compareOrdered(x, y)
+		}
+	case string:
+		return strings.Compare(x, b.(string))
+	}
+	return 0
+}
+
+func compareOrdered[T int64 | float64](x, y T) int {
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+	return 0
+}
+
+func satisfies(op string, cmp int) bool {
+	switch op {
+	case "=":
+		return cmp == 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	}
+	return false
+}
+
+// matchValues returns the partitions which can have a value comparing to
+// one of values by op, nil if it is not known.
+func (p *partitionPruner) matchValues(op string, values []interface{}) []bool {
+	matched := make([]bool, p.count())
+	for _, v := range values {
+		// NULL compares to nothing
+		if v == nil {
+			continue
+		}
+		switch p.def.Type {
+		case plan.PartitionByDef_RANGE:
+			for i, part := range p.def.Partitions {
+				matched[i] = matched[i] || p.inRange(i, part, op, v)
+			}
+		case plan.PartitionByDef_LIST:
+			for i, part := range p.def.Partitions {
+				for _, e := range part.Values {
+					if x, ok := constPartitionValue(e.GetC(), p.typ); ok && x != nil && satisfies(op, comparePartitionValues(x, v)) {
+						matched[i] = true
+					}
+				}
+			}
+		case plan.PartitionByDef_HASH:
+			i, ok := v.(int64)
+			if op != "=" || !ok {
+				return nil
+			}
+			n := int64(p.def.Num)
+			switch p.typ.Id {
+			case plan.Type_UINT8, plan.Type_UINT16, plan.Type_UINT32, plan.Type_UINT64:
+				if i < 0 {
+					return nil
+				}
+			}
+			mod := i % n
+			if mod < 0 {
+				mod += n
+			}
+			matched[mod] = true
+		}
+	}
+	return matched
+}
+
+// inRange returns true if the range partition i, from the bound of the
+// partition before it to its own bound, can have a value comparing to v by
+// op.
+func (p *partitionPruner) inRange(i int, part *plan.PartitionByDef_Partition, op string, v interface{}) bool {
+	var lower, upper interface{}
+	if i > 0 {
+		if values := p.def.Partitions[i-1].Values; len(values) > 0 {
+			lower, _ = constPartitionValue(values[0].GetC(), p.typ)
+		}
+	}
+	if len(part.Values) > 0 {
+		upper, _ = constPartitionValue(part.Values[0].GetC(), p.typ)
+	}
+	aboveLower := func(strict bool) bool {
+		if lower == nil {
+			return true
+		}
+		cmp := comparePartitionValues(lower, v)
+		return cmp < 0 || (!strict && cmp == 0)
+	}
+	belowUpper := upper == nil || comparePartitionValues(v, upper) < 0
+	switch op {
+	case "=":
+		return aboveLower(false) && belowUpper
+	case "<":
+		return aboveLower(true)
+	case "<=":
+		return aboveLower(false)
+	case ">", ">=":
+		return upper == nil || comparePartitionValues(upper, v) > 0
+	}
+	return true
+}
+
+// matchNull returns the partitions the NULLs are in: the first range
+// partition, the list partitions of NULL and the first hash partition.
+func (p *partitionPruner) matchNull() []bool {
+	matched := make([]bool, p.count())
+	switch p.def.Type {
+	case plan.PartitionByDef_RANGE, plan.PartitionByDef_HASH:
+		if len(matched) > 0 {
+			matched[0] = true
+		}
+	case plan.PartitionByDef_LIST:
+		for i, part := range p.def.Partitions {
+			for _, e := range part.Values {
+				if e.GetC().GetIsnull() {
+					matched[i] = true
+				}
+			}
+		}
+	}
+	return matched
+}
+
+// buildAlterPartition builds the action adding, dropping or truncating
+// partitions of a partitioned table. Only range and list partitions can be
+// added, the hash partitions are fixed.
+func buildAlterPartition(opt tree.AlterTableOption, tableDef *TableDef, action *plan.AlterTableAction) error {
+	def := getPartitionByDef(tableDef)
+	if def == nil {
+		return errors.New(errno.InvalidTableDefinition, "Partition management on a not partitioned table is not possible")
+	}
+	checkNames := func(names tree.IdentifierList) ([]string, error) {
+		exists := make(map[string]bool)
+		for _, name := range partitionNames(def) {
+			exists[name] = true
+		}
+		list := make([]string, len(names))
+		for i, name := range names {
+			if !exists[string(name)] {
+				return nil, errors.New(errno.UndefinedObject, fmt.Sprintf("Error in list of partitions to %s", name))
+			}
+			list[i] = string(name)
+		}
+		return list, nil
+	}
+	var err error
+	switch opt := opt.(type) {
+	case *tree.AlterTableAddPartition:
+		if def.Type == plan.PartitionByDef_HASH {
+			return errors.New(errno.FeatureNotSupported, "add partition to a hash partitioned table is not supported now")
+		}
+		var col *ColDef
+		for _, c := range tableDef.Cols {
+			if c.Name == def.Fields[0] {
+				col = c
+			}
+		}
+		if col == nil {
+			return errors.New(errno.UndefinedColumn, fmt.Sprintf("column '%v' doesn't exist", def.Fields[0]))
+		}
+		// the names of the partitions added are checked against the existing ones
+		partitionDef := &plan.PartitionByDef{
+			Type:       def.Type,
+			Fields:     def.Fields,
+			Partitions: append([]*plan.PartitionByDef_Partition{}, def.Partitions...),
+		}
+		if err = buildPartitions(partitionDef, col, opt.Partitions); err != nil {
+			return err
+		}
+		partitionDef.Partitions = partitionDef.Partitions[len(def.Partitions):]
+		action.ActionType = plan.AlterTableAction_ADD_PARTITION
+		action.PartitionDef = partitionDef
+	case *tree.AlterTableDropPartition:
+		if def.Type == plan.PartitionByDef_HASH {
+			return errors.New(errno.FeatureNotSupported, "drop partition of a hash partitioned table is not supported now")
+		}
+		action.ActionType = plan.AlterTableAction_DROP_PARTITION
+		action.PartitionNames, err = checkNames(opt.Names)
+	case *tree.AlterTableTruncatePartition:
+		action.ActionType = plan.AlterTableAction_TRUNCATE_PARTITION
+		action.PartitionNames, err = checkNames(opt.Names)
+	}
+	return err
+}
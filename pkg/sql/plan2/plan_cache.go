@@ -0,0 +1,200 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"container/list"
+	"sync"
+	"sync/atomic"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// PlanCache is a bounded LRU cache of the plans of parameterized statements,
+// keyed by ParamStmt.Key. Every plan keeps the definitions of the tables
+// resolved while building it, and is only returned to a compiler context
+// which still resolves them to the same definitions. So the DDL of other
+// sessions or other nodes, and the DDL rolled back, never leave a stale plan
+// behind.
+type PlanCache struct {
+	sync.Mutex
+	capacity int
+	lru      *list.List
+	items    map[string]*list.Element
+
+	hits   int64
+	misses int64
+}
+
+type planCacheEntry struct {
+	key    string
+	plan   *Plan
+	tables []resolvedTable
+}
+
+// resolvedTable is a table resolved while building a plan, def holds the
+// encoded object reference and table definition, empty if it didn't exist.
+type resolvedTable struct {
+	schemaName string
+	tableName  string
+	def        string
+}
+
+// NewPlanCache creates a plan cache holding at most capacity plans, nothing
+// is cached if capacity <= 0.
+func NewPlanCache(capacity int) *PlanCache {
+	return &PlanCache{
+		capacity: capacity,
+		lru:      list.New(),
+		items:    make(map[string]*list.Element),
+	}
+}
+
+// All methods can be called on a nil cache, which caches nothing.
+
+// Get returns the plan cached for key if ctx resolves the tables it was
+// built with to the same definitions, the returned plan must not be
+// modified.
+func (c *PlanCache) Get(ctx CompilerContext, key string) *Plan {
+	if c == nil {
+		return nil
+	}
+	c.Lock()
+	elem, ok := c.items[key]
+	var entry *planCacheEntry
+	if ok {
+		entry = elem.Value.(*planCacheEntry)
+	}
+	c.Unlock()
+	// resolve the tables out of the lock, it may have to read the catalog
+	if entry != nil && entry.valid(ctx) {
+		c.Lock()
+		if elem, ok := c.items[key]; ok && elem.Value == entry {
+			c.lru.MoveToFront(elem)
+		}
+		c.Unlock()
+		atomic.AddInt64(&c.hits, 1)
+		return entry.plan
+	}
+	atomic.AddInt64(&c.misses, 1)
+	return nil
+}
+
+// Put caches the plan built for key with the compiler context rec.
+func (c *PlanCache) Put(key string, pn *Plan, rec *ResolveRecorder) {
+	if c == nil || c.capacity <= 0 {
+		return
+	}
+	entry := &planCacheEntry{key: key, plan: pn, tables: rec.tables}
+	c.Lock()
+	defer c.Unlock()
+	if elem, ok := c.items[key]; ok {
+		elem.Value = entry
+		c.lru.MoveToFront(elem)
+		return
+	}
+	c.items[key] = c.lru.PushFront(entry)
+	for c.lru.Len() > c.capacity {
+		c.remove(c.lru.Back())
+	}
+}
+
+// Len returns the number of cached plans.
+func (c *PlanCache) Len() int {
+	if c == nil {
+		return 0
+	}
+	c.Lock()
+	defer c.Unlock()
+	return c.lru.Len()
+}
+
+// Hits returns the number of lookups which found a plan.
+func (c *PlanCache) Hits() int64 {
+	if c == nil {
+		return 0
+	}
+	return atomic.LoadInt64(&c.hits)
+}
+
+// Misses returns the number of lookups which found no plan.
+func (c *PlanCache) Misses() int64 {
+	if c == nil {
+		return 0
+	}
+	return atomic.LoadInt64(&c.misses)
+}
+
+func (c *PlanCache) remove(elem *list.Element) {
+	c.lru.Remove(elem)
+	delete(c.items, elem.Value.(*planCacheEntry).key)
+}
+
+func (e *planCacheEntry) valid(ctx CompilerContext) bool {
+	for _, tbl := range e.tables {
+		if encodeResolved(ctx.Resolve(tbl.schemaName, tbl.tableName)) != tbl.def {
+			return false
+		}
+	}
+	return true
+}
+
+// ResolveRecorder is a compiler context recording the tables resolved
+// through it, the plans built with it are cached with them.
+type ResolveRecorder struct {
+	CompilerContext
+	tables []resolvedTable
+}
+
+func NewResolveRecorder(ctx CompilerContext) *ResolveRecorder {
+	return &ResolveRecorder{CompilerContext: ctx}
+}
+
+func (r *ResolveRecorder) Resolve(schemaName string, tableName string) (*ObjectRef, *TableDef) {
+	obj, def := r.CompilerContext.Resolve(schemaName, tableName)
+	for _, tbl := range r.tables {
+		if tbl.schemaName == schemaName && tbl.tableName == tableName {
+			return obj, def
+		}
+	}
+	r.tables = append(r.tables, resolvedTable{
+		schemaName: schemaName,
+		tableName:  tableName,
+		def:        encodeResolved(obj, def),
+	})
+	return obj, def
+}
+
+// encodeResolved encodes a resolved table without the aliases, the builder
+// sets them on the returned definition per query.
+func encodeResolved(obj *ObjectRef, def *TableDef) string {
+	if obj == nil || def == nil {
+		return ""
+	}
+	def = proto.Clone(def).(*TableDef)
+	def.Alias = ""
+	for _, col := range def.Cols {
+		col.Alias = ""
+	}
+	opts := proto.MarshalOptions{Deterministic: true}
+	data, err := opts.Marshal(obj)
+	if err != nil {
+		return ""
+	}
+	if data, err = opts.MarshalAppend(append(data, '/'), def); err != nil {
+		return ""
+	}
+	return string(data)
+}
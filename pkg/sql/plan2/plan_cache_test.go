@@ -0,0 +1,137 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestParameterize(t *testing.T) {
+	mock := NewMockOptimizer()
+	sqls := []string{
+		"SELECT N_NAME, N_REGIONKEY FROM NATION WHERE N_NATIONKEY > 10 AND N_NAME LIKE '%AA' ORDER BY N_NAME LIMIT 10",
+		"SELECT N_NAME FROM NATION WHERE N_REGIONKEY BETWEEN 1 AND 3 OR N_NATIONKEY IN (1, 2, 3)",
+		"SELECT N_NAME, MAX(N_REGIONKEY) FROM NATION GROUP BY N_NAME HAVING MAX(N_REGIONKEY) > 10",
+		"SELECT N_NAME, R_NAME FROM NATION JOIN REGION ON N_REGIONKEY = R_REGIONKEY AND R_REGIONKEY > 2",
+		"SELECT N_NAME FROM NATION WHERE N_REGIONKEY > (SELECT MAX(R_REGIONKEY) + 1 FROM REGION WHERE R_NAME = 'ASIA')",
+	}
+	for _, sql := range sqls {
+		stmt, err := mysql.ParseOne(sql)
+		require.NoError(t, err)
+		expected, err := BuildPlan(mock.CurrentContext(), stmt)
+		require.NoError(t, err, sql)
+
+		ps := Parameterize(stmt)
+		require.NotNil(t, ps)
+		require.NotEmpty(t, ps.Params, sql)
+		require.Contains(t, ps.Key, "?", sql)
+		pn, err := BuildPlan(mock.CurrentContext(), stmt)
+		require.NoError(t, err, sql)
+		bound, err := BindParams(pn, ps.Params)
+		require.NoError(t, err)
+		require.True(t, proto.Equal(expected, bound), sql)
+		require.False(t, proto.Equal(expected, pn), sql)
+
+		ps.Restore()
+		restored, err := BuildPlan(mock.CurrentContext(), stmt)
+		require.NoError(t, err, sql)
+		require.True(t, proto.Equal(expected, restored), sql)
+	}
+
+	// statements only differing in literals share the key
+	stmt0, err := mysql.ParseOne("SELECT N_NAME FROM NATION WHERE N_NATIONKEY > 10")
+	require.NoError(t, err)
+	stmt1, err := mysql.ParseOne("SELECT N_NAME FROM NATION WHERE N_NATIONKEY > 20")
+	require.NoError(t, err)
+	stmt2, err := mysql.ParseOne("SELECT N_NAME FROM NATION WHERE N_NATIONKEY > 2.5")
+	require.NoError(t, err)
+	require.Equal(t, Parameterize(stmt0).Key, Parameterize(stmt1).Key)
+	require.NotEqual(t, Parameterize(stmt0).Key, Parameterize(stmt2).Key)
+
+	stmt, err := mysql.ParseOne("INSERT INTO NATION VALUES (1, 'A', 2, 'B')")
+	require.NoError(t, err)
+	require.Nil(t, Parameterize(stmt))
+}
+
+// alterContext resolves the tables of the mock context, except the ones
+// altered or dropped by the test.
+type alterContext struct {
+	CompilerContext
+	altered map[string]*TableDef
+}
+
+func (c *alterContext) Resolve(schemaName string, tableName string) (*ObjectRef, *TableDef) {
+	if def, ok := c.altered[tableName]; ok {
+		if def == nil {
+			return nil, nil
+		}
+		obj, _ := c.CompilerContext.Resolve(schemaName, tableName)
+		return obj, def
+	}
+	return c.CompilerContext.Resolve(schemaName, tableName)
+}
+
+func TestPlanCache(t *testing.T) {
+	ctx := &alterContext{
+		CompilerContext: NewMockOptimizer().CurrentContext(),
+		altered:         make(map[string]*TableDef),
+	}
+	build := func(sql string) (*Plan, *ResolveRecorder) {
+		stmt, err := mysql.ParseOne(sql)
+		require.NoError(t, err)
+		rec := NewResolveRecorder(ctx)
+		pn, err := BuildPlan(rec, stmt)
+		require.NoError(t, err, sql)
+		return pn, rec
+	}
+	cache := NewPlanCache(2)
+
+	require.Nil(t, cache.Get(ctx, "a"))
+	pa, ra := build("SELECT N_NAME FROM NATION")
+	pb, rb := build("SELECT R_NAME FROM REGION")
+	pc, rc := build("SELECT N_NAME, R_NAME FROM NATION JOIN REGION ON N_REGIONKEY = R_REGIONKEY")
+	require.Len(t, rc.tables, 2)
+	cache.Put("a", pa, ra)
+	cache.Put("b", pb, rb)
+	require.Equal(t, pa, cache.Get(ctx, "a"))
+	cache.Put("c", pc, rc)
+	require.Nil(t, cache.Get(ctx, "b"))
+	require.Equal(t, pa, cache.Get(ctx, "a"))
+	require.Equal(t, 2, cache.Len())
+	require.Equal(t, int64(2), cache.Hits())
+	require.Equal(t, int64(2), cache.Misses())
+
+	// altering a table, by this session or any other, misses the plans
+	// using it until its definition is restored, as by a rollback
+	_, def := ctx.CompilerContext.Resolve("", "region")
+	altered := proto.Clone(def).(*TableDef)
+	altered.Cols = altered.Cols[:len(altered.Cols)-1]
+	ctx.altered["region"] = altered
+	require.Equal(t, pa, cache.Get(ctx, "a"))
+	require.Nil(t, cache.Get(ctx, "c"))
+	ctx.altered["region"] = nil
+	require.Nil(t, cache.Get(ctx, "c"))
+	delete(ctx.altered, "region")
+	require.Equal(t, pc, cache.Get(ctx, "c"))
+
+	var nilCache *PlanCache
+	nilCache.Put("a", pa, ra)
+	require.Nil(t, nilCache.Get(ctx, "a"))
+	require.Equal(t, 0, nilCache.Len())
+}
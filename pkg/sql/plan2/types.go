@@ -61,4 +61,7 @@ type BinderContext struct {
 	// subqueryIsScalar     bool
 
 	subqueryParentIds []int32
+
+	// when build_insert builds ON DUPLICATE KEY UPDATE, VALUES(col) refers to the column of insertTable
+	insertTable *TableDef
 }
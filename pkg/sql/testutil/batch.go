@@ -0,0 +1,66 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testutil
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// MakeBatch returns a batch of rows rows of the types ts, the value of the
+// column col at row is value(col, row), in decimal for the string columns.
+// The int64 columns are allocated from the memory pool of proc, so that the
+// operators limiting their memory, such as the spilling ones, see them.
+func MakeBatch(proc *process.Process, ts []types.Type, rows int64, value func(col int, row int64) int64) (*batch.Batch, error) {
+	bat := batch.NewWithSize(len(ts))
+	bat.InitZsOne(int(rows))
+	for i, typ := range ts {
+		vec := vector.New(typ)
+		switch typ.Oid {
+		case types.T_int64:
+			data, err := mheap.Alloc(proc.Mp, rows*8)
+			if err != nil {
+				bat.Clean(proc.Mp)
+				return nil, err
+			}
+			vs := encoding.DecodeInt64Slice(data)[:rows]
+			for j := range vs {
+				vs[j] = value(i, int64(j))
+			}
+			vec.Data, vec.Col = data, vs
+		case types.T_char, types.T_varchar:
+			vs := make([][]byte, rows)
+			for j := range vs {
+				vs[j] = []byte(strconv.FormatInt(value(i, int64(j)), 10))
+			}
+			if err := vector.Append(vec, vs); err != nil {
+				bat.Clean(proc.Mp)
+				return nil, err
+			}
+		default:
+			bat.Clean(proc.Mp)
+			return nil, fmt.Errorf("unsupported type %s", typ)
+		}
+		bat.Vecs[i] = vec
+	}
+	return bat, nil
+}
@@ -52,6 +52,7 @@ func Transfer(sid, tid, typ uint64, name string,
 		}
 	}
 	tbl.Indices = IndexDefs(sid, tid, mp, defs)
+	tbl.Checks, tbl.ForeignKeys = ConstraintDefs(defs)
 	tbl.Properties, _ = PropertyDef(defs)
 	data, err := PartitionDef(defs)
 	if err != nil {
@@ -78,15 +79,24 @@ func UnTransfer(tbl aoe.TableInfo) (uint64, uint64, uint64, string, []engine.Tab
 	for _, col := range tbl.Columns {
 		defs = append(defs, &engine.AttributeDef{
 			Attr: engine.Attribute{
-				Alg:     compress.T(col.Alg),
-				Name:    col.Name,
-				Type:    col.Type,
-				Default: col.Default,
-				Primary: col.PrimaryKey,
+				Alg:           compress.T(col.Alg),
+				Name:          col.Name,
+				Type:          col.Type,
+				Default:       col.Default,
+				Primary:       col.PrimaryKey,
+				AutoIncrement: col.AutoIncrement,
+				NotNull:       col.NullAbility,
 			},
 		})
 	}
 	for _, idx := range tbl.Indices {
+		if idx.Type == aoe.Unique {
+			defs = append(defs, &engine.UniqueIndexDef{
+				Name:     idx.Name,
+				ColNames: idx.ColumnNames,
+			})
+			continue
+		}
 		var tp engine.IndexT
 		switch idx.Type {
 		case aoe.ZoneMap:
@@ -106,6 +116,12 @@ func UnTransfer(tbl aoe.TableInfo) (uint64, uint64, uint64, string, []engine.Tab
 			Name:     idx.Name,
 		})
 	}
+	for i := range tbl.Checks {
+		defs = append(defs, &tbl.Checks[i])
+	}
+	for i := range tbl.ForeignKeys {
+		defs = append(defs, &tbl.ForeignKeys[i])
+	}
 	if tbl.Comment != nil {
 		comment.Comment = string(tbl.Comment)
 		defs = append(defs, comment)
@@ -167,10 +183,39 @@ func IndexDefs(sid, tid uint64, mp map[string]uint64, defs []engine.TableDef) []
 			idxs = append(idxs, idx)
 			id++
 		}
+		if v, ok := def.(*engine.UniqueIndexDef); ok {
+			idx := aoe.IndexInfo{
+				SchemaId: sid,
+				TableId:  tid,
+				Type:     aoe.Unique,
+				Name:     v.Name,
+			}
+			for _, name := range v.ColNames {
+				idx.ColumnNames = append(idx.ColumnNames, name)
+				if mp != nil {
+					idx.Columns = append(idx.Columns, mp[name])
+				}
+			}
+			idxs = append(idxs, idx)
+			id++
+		}
 	}
 	return idxs
 }
 
+// ConstraintDefs returns the CHECK and FOREIGN KEY constraints of defs.
+func ConstraintDefs(defs []engine.TableDef) (checks []engine.CheckDef, fks []engine.ForeignKeyDef) {
+	for _, def := range defs {
+		switch v := def.(type) {
+		case *engine.CheckDef:
+			checks = append(checks, *v)
+		case *engine.ForeignKeyDef:
+			fks = append(fks, *v)
+		}
+	}
+	return
+}
+
 func ColumnDefs(sid, tid uint64, defs []engine.TableDef) []aoe.ColumnInfo {
 	var id uint64
 	var cols []aoe.ColumnInfo
@@ -183,13 +228,15 @@ func ColumnDefs(sid, tid uint64, defs []engine.TableDef) []aoe.ColumnInfo {
 	for _, def := range defs {
 		if v, ok := def.(*engine.AttributeDef); ok {
 			col := aoe.ColumnInfo{
-				SchemaId: sid,
-				TableID:  tid,
-				Id:       id,
-				Name:     v.Attr.Name,
-				Alg:      int(v.Attr.Alg),
-				Type:     v.Attr.Type,
-				Default:  v.Attr.Default,
+				SchemaId:      sid,
+				TableID:       tid,
+				Id:            id,
+				Name:          v.Attr.Name,
+				Alg:           int(v.Attr.Alg),
+				Type:          v.Attr.Type,
+				Default:       v.Attr.Default,
+				AutoIncrement: v.Attr.AutoIncrement,
+				NullAbility:   v.Attr.NotNull,
 			}
 			for _, primaryKey := range primaryKeys {
 				if col.Name == primaryKey {
@@ -63,6 +63,9 @@ type TableInfo struct {
 	Partition  []byte       `json:"partition"`
 	Properties []Property
 	Epoch      uint64 `json:"epoch"`
+	// Checks and ForeignKeys are the constraints of the table
+	Checks      []engine.CheckDef      `json:"checks"`
+	ForeignKeys []engine.ForeignKeyDef `json:"foreign_keys"`
 }
 
 type Property struct {
@@ -87,7 +90,9 @@ type ColumnInfo struct {
 	Alg         int                `json:"alg"`
 	Epoch       uint64             `json:"epoch"`
 	PrimaryKey  bool               `json:"primary_key"` // PrimaryKey is the name of the column of the primary key
-	NullAbility bool               `json:"nullability"`
+	NullAbility bool               `json:"nullability"` // NullAbility is true if the column is NOT NULL
+	// AutoIncrement is true if the values are generated by a sequence
+	AutoIncrement bool `json:"auto_increment"`
 }
 
 type IndexInfo struct {
@@ -109,6 +114,8 @@ const (
 	NumBsi
 	FixStrBsi
 	Invalid
+	// Unique is the index of a UNIQUE constraint
+	Unique
 )
 
 type SegmentInfo struct {
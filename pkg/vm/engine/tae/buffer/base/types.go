@@ -68,6 +68,14 @@ type INode interface {
 	Expand(uint64, func() error) error
 }
 
+// Stats are the counters of a node manager. Hits and Misses count the pins
+// of the nodes loaded and not loaded, Evicts the nodes unloaded to make room.
+type Stats struct {
+	Hits   uint64
+	Misses uint64
+	Evicts uint64
+}
+
 type INodeManager interface {
 	ISizeLimiter
 	sync.Locker
@@ -81,6 +89,7 @@ type INodeManager interface {
 	TryPin(INode, time.Duration) (INodeHandle, error)
 	Unpin(INode)
 	MakeRoom(uint64) bool
+	Stats() Stats
 }
 
 type ISizeLimiter interface {
@@ -91,6 +100,7 @@ type ISizeLimiter interface {
 
 type IEvictHandle interface {
 	sync.Locker
+	GetID() common.ID
 	IsClosed() bool
 	Unload()
 	Unloadable() bool
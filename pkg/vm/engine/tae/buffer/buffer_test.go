@@ -106,3 +106,93 @@ func TestHandle(t *testing.T) {
 	assert.Equal(t, uint64(0), mgr.Total())
 	t.Log(mgr.String())
 }
+
+func TestNewEvictHolder(t *testing.T) {
+	for _, spec := range []string{"", "fifo", "lru-k", "LRU-K:3", "2q-clock"} {
+		_, err := NewEvictHolder(spec)
+		assert.NoError(t, err, spec)
+	}
+	// The default is fifo
+	evicter, err := NewEvictHolder("")
+	assert.NoError(t, err)
+	assert.IsType(t, &SimpleEvictHolder{}, evicter)
+	for _, spec := range []string{"lru", "lru-k:0", "lru-k:x", "fifo:1", "clock", "2q-clock:2"} {
+		_, err := NewEvictHolder(spec)
+		assert.ErrorIs(t, err, ErrBadEvictPolicy, spec)
+	}
+}
+
+func TestScanResistance(t *testing.T) {
+	for _, spec := range []string{"lru-k", "2q-clock"} {
+		evicter, err := NewEvictHolder(spec)
+		assert.NoError(t, err)
+		mgr := NewNodeManager(100, evicter)
+		baseId := common.ID{}
+		newNode := func() *testNodeHandle {
+			n := newTestNodeHandle(mgr, baseId.NextBlock(), 10, t)
+			mgr.RegisterNode(n)
+			return n
+		}
+		access := func(n *testNodeHandle) {
+			h := mgr.Pin(n)
+			assert.NotNil(t, h)
+			h.Close()
+		}
+
+		hot := []*testNodeHandle{newNode(), newNode()}
+		for i := 0; i < 3; i++ {
+			for _, n := range hot {
+				access(n)
+			}
+		}
+		// A scan through more nodes than the cache holds
+		for i := 0; i < 30; i++ {
+			access(newNode())
+		}
+		for _, n := range hot {
+			assert.True(t, n.IsLoaded(), spec)
+		}
+
+		stats := mgr.Stats()
+		assert.Equal(t, uint64(4), stats.Hits, spec)
+		assert.Equal(t, uint64(32), stats.Misses, spec)
+		assert.Equal(t, uint64(22), stats.Evicts, spec)
+	}
+}
+
+func TestTwoQueueClockDemote(t *testing.T) {
+	evicter := NewTwoQueueClockEvictHolder()
+	mgr := NewNodeManager(30, evicter)
+	baseId := common.ID{}
+	nodes := make([]*testNodeHandle, 4)
+	for i := range nodes {
+		nodes[i] = newTestNodeHandle(mgr, baseId.NextBlock(), 10, t)
+		mgr.RegisterNode(nodes[i])
+	}
+	access := func(n *testNodeHandle) {
+		h := mgr.Pin(n)
+		assert.NotNil(t, h)
+		h.Close()
+	}
+	// All the loaded nodes are hot, the one not accessed since the hand
+	// passed it is unloaded
+	for _, n := range nodes[:3] {
+		access(n)
+		access(n)
+	}
+	access(nodes[3])
+	assert.Equal(t, uint64(1), mgr.Stats().Evicts)
+	loaded := 0
+	for _, n := range nodes[:3] {
+		if n.IsLoaded() {
+			loaded++
+		}
+	}
+	assert.Equal(t, 2, loaded)
+
+	for _, n := range nodes {
+		n.Close()
+	}
+	assert.Equal(t, 0, len(evicter.entries))
+	assert.Equal(t, 0, evicter.hot.Len()+evicter.cold.Len())
+}
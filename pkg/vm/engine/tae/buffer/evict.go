@@ -27,10 +27,15 @@ type EvictNode struct {
 	Iter   uint64
 }
 
+// IEvictHolder holds the unpinned nodes and decides the order they are
+// unloaded in. Enqueue is called each time a node is unpinned by its last
+// user, Dequeue returns the next node to unload and Remove forgets a node
+// once it is unregistered.
 type IEvictHolder interface {
 	sync.Locker
 	Enqueue(n *EvictNode)
 	Dequeue() *EvictNode
+	Remove(h base.IEvictHandle)
 }
 
 type SimpleEvictHolder struct {
@@ -72,6 +77,9 @@ func (holder *SimpleEvictHolder) Dequeue() *EvictNode {
 	return r.(*EvictNode)
 }
 
+// Remove is a noop, the closed nodes are skipped when they are dequeued
+func (holder *SimpleEvictHolder) Remove(base.IEvictHandle) {}
+
 func (node *EvictNode) String() string {
 	return fmt.Sprintf("EvictNode(%v, %d)", node.Handle, node.Iter)
 }
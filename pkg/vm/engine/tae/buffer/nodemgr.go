@@ -32,7 +32,9 @@ type nodeManager struct {
 	evicter         IEvictHolder
 	unregistertimes int64
 	loadtimes       int64
-	evicttimes      int64
+	hits            uint64
+	misses          uint64
+	evicts          uint64
 }
 
 func NewNodeManager(maxsize uint64, evicter IEvictHolder) *nodeManager {
@@ -51,8 +53,9 @@ func (mgr *nodeManager) String() string {
 	mgr.RLock()
 	defer mgr.RUnlock()
 	loaded := 0
-	s := fmt.Sprintf("<nodeManager>[%s][Nodes:%d,LoadTimes:%d,EvictTimes:%d,UnregisterTimes:%d]:", mgr.sizeLimiter.String(), len(mgr.nodes),
-		atomic.LoadInt64(&mgr.loadtimes), atomic.LoadInt64(&mgr.evicttimes), atomic.LoadInt64(&mgr.unregistertimes))
+	stats := mgr.Stats()
+	s := fmt.Sprintf("<nodeManager>[%s][Nodes:%d,LoadTimes:%d,Hits:%d,Misses:%d,Evicts:%d,UnregisterTimes:%d]:", mgr.sizeLimiter.String(), len(mgr.nodes),
+		atomic.LoadInt64(&mgr.loadtimes), stats.Hits, stats.Misses, stats.Evicts, atomic.LoadInt64(&mgr.unregistertimes))
 	for _, node := range mgr.nodes {
 		id := node.GetID()
 		node.RLock()
@@ -67,6 +70,14 @@ func (mgr *nodeManager) String() string {
 	return s
 }
 
+func (mgr *nodeManager) Stats() base.Stats {
+	return base.Stats{
+		Hits:   atomic.LoadUint64(&mgr.hits),
+		Misses: atomic.LoadUint64(&mgr.misses),
+		Evicts: atomic.LoadUint64(&mgr.evicts),
+	}
+}
+
 func (mgr *nodeManager) Count() int {
 	mgr.RLock()
 	defer mgr.RUnlock()
@@ -89,6 +100,7 @@ func (mgr *nodeManager) UnregisterNode(node base.INode) {
 	defer mgr.Unlock()
 	atomic.AddInt64(&mgr.unregistertimes, int64(1))
 	delete(mgr.nodes, node.GetID())
+	mgr.evicter.Remove(node)
 	node.Destroy()
 }
 
@@ -119,6 +131,7 @@ func (mgr *nodeManager) MakeRoom(size uint64) bool {
 			}
 			evicted.Handle.Unload()
 			evicted.Handle.Unlock()
+			atomic.AddUint64(&mgr.evicts, uint64(1))
 		}
 		ok = mgr.sizeLimiter.ApplyQuota(size)
 	}
@@ -155,6 +168,7 @@ func (mgr *nodeManager) Pin(node base.INode) base.INodeHandle {
 	if node.IsLoaded() {
 		node.Ref()
 		node.RUnlock()
+		atomic.AddUint64(&mgr.hits, uint64(1))
 		return node.MakeHandle()
 	}
 	node.RUnlock()
@@ -163,8 +177,10 @@ func (mgr *nodeManager) Pin(node base.INode) base.INodeHandle {
 	defer node.Unlock()
 	if node.IsLoaded() {
 		node.Ref()
+		atomic.AddUint64(&mgr.hits, uint64(1))
 		return node.MakeHandle()
 	}
+	atomic.AddUint64(&mgr.misses, uint64(1))
 	ok := mgr.MakeRoom(node.Size())
 	if !ok {
 		return nil
@@ -182,6 +198,5 @@ func (mgr *nodeManager) Unpin(node base.INode) {
 	if node.RefCount() == 0 {
 		toevict := &EvictNode{Handle: node, Iter: node.IncIteration()}
 		mgr.evicter.Enqueue(toevict)
-		atomic.AddInt64(&mgr.evicttimes, int64(1))
 	}
 }
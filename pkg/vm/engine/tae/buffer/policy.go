@@ -0,0 +1,317 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package buffer
+
+import (
+	"container/heap"
+	"container/list"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/buffer/base"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+)
+
+var (
+	ErrBadEvictPolicy = errors.New("buffer: bad evict policy")
+)
+
+const (
+	DefaultLRUK = 2
+)
+
+// NewEvictHolder returns the evict holder of spec, which is one of
+//
+//	fifo       unload the nodes in the order they were unpinned
+//	lru-k[:k]  unload the node with the oldest k-th most recent access first,
+//	           the nodes accessed less than k times before the others
+//	2q-clock   unload the nodes accessed once first, in a FIFO queue, then
+//	           the nodes not accessed since the hand of the clock of the nodes
+//	           accessed again last passed them
+//
+// An empty spec is fifo, the policy before the others were added, see
+// options.CacheCfg.EvictPolicy for why it stays the default. Both lru-k and 2q-clock admit a node accessed for
+// the first time as cold, so a sequential scan only evicts what it loaded
+// itself before it evicts the nodes accessed again and again.
+func NewEvictHolder(spec string) (IEvictHolder, error) {
+	name, arg := spec, ""
+	if i := strings.IndexByte(spec, ':'); i >= 0 {
+		name, arg = spec[:i], spec[i+1:]
+	}
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "", "fifo":
+		if arg != "" {
+			break
+		}
+		return NewSimpleEvictHolder(), nil
+	case "lru-k", "lruk":
+		k := DefaultLRUK
+		if arg != "" {
+			n, err := strconv.Atoi(arg)
+			if err != nil || n < 1 {
+				break
+			}
+			k = n
+		}
+		return NewLRUKEvictHolder(k), nil
+	case "2q-clock":
+		if arg != "" {
+			break
+		}
+		return NewTwoQueueClockEvictHolder(), nil
+	}
+	return nil, fmt.Errorf("%w: %q", ErrBadEvictPolicy, spec)
+}
+
+type lruKEntry struct {
+	handle base.IEvictHandle
+	iter   uint64
+	// last k access times, the most recent first
+	history []uint64
+	// position in the heap, -1 if not queued
+	pos int
+}
+
+// kth returns the k-th most recent access time, 0 if there were less than k
+func (e *lruKEntry) kth(k int) uint64 {
+	if len(e.history) < k {
+		return 0
+	}
+	return e.history[k-1]
+}
+
+type lruKHeap struct {
+	k       int
+	entries []*lruKEntry
+}
+
+func (h *lruKHeap) Len() int { return len(h.entries) }
+
+func (h *lruKHeap) Less(i, j int) bool {
+	a, b := h.entries[i], h.entries[j]
+	if ka, kb := a.kth(h.k), b.kth(h.k); ka != kb {
+		return ka < kb
+	}
+	return a.history[0] < b.history[0]
+}
+
+func (h *lruKHeap) Swap(i, j int) {
+	h.entries[i], h.entries[j] = h.entries[j], h.entries[i]
+	h.entries[i].pos = i
+	h.entries[j].pos = j
+}
+
+func (h *lruKHeap) Push(x interface{}) {
+	e := x.(*lruKEntry)
+	e.pos = len(h.entries)
+	h.entries = append(h.entries, e)
+}
+
+func (h *lruKHeap) Pop() interface{} {
+	n := len(h.entries)
+	e := h.entries[n-1]
+	h.entries[n-1] = nil
+	h.entries = h.entries[:n-1]
+	e.pos = -1
+	return e
+}
+
+// LRUKEvictHolder unloads the node with the largest backward k-distance
+// first. The access history of a node is kept until it is removed, so a node
+// reloaded after being unloaded is not taken for a new one.
+type LRUKEvictHolder struct {
+	sync.Mutex
+	clock   uint64
+	entries map[common.ID]*lruKEntry
+	queue   lruKHeap
+}
+
+func NewLRUKEvictHolder(k int) *LRUKEvictHolder {
+	return &LRUKEvictHolder{
+		entries: make(map[common.ID]*lruKEntry),
+		queue:   lruKHeap{k: k},
+	}
+}
+
+func (holder *LRUKEvictHolder) Enqueue(node *EvictNode) {
+	holder.Lock()
+	defer holder.Unlock()
+	holder.clock++
+	e := holder.entries[node.Handle.GetID()]
+	if e == nil {
+		e = &lruKEntry{
+			handle:  node.Handle,
+			history: make([]uint64, 0, holder.queue.k),
+			pos:     -1,
+		}
+		holder.entries[node.Handle.GetID()] = e
+	}
+	if len(e.history) < holder.queue.k {
+		e.history = append(e.history, 0)
+	}
+	copy(e.history[1:], e.history)
+	e.history[0] = holder.clock
+	e.iter = node.Iter
+	if e.pos < 0 {
+		heap.Push(&holder.queue, e)
+	} else {
+		heap.Fix(&holder.queue, e.pos)
+	}
+}
+
+func (holder *LRUKEvictHolder) Dequeue() *EvictNode {
+	holder.Lock()
+	defer holder.Unlock()
+	if holder.queue.Len() == 0 {
+		return nil
+	}
+	e := heap.Pop(&holder.queue).(*lruKEntry)
+	return &EvictNode{Handle: e.handle, Iter: e.iter}
+}
+
+func (holder *LRUKEvictHolder) Remove(h base.IEvictHandle) {
+	holder.Lock()
+	defer holder.Unlock()
+	e := holder.entries[h.GetID()]
+	if e == nil {
+		return
+	}
+	if e.pos >= 0 {
+		heap.Remove(&holder.queue, e.pos)
+	}
+	delete(holder.entries, h.GetID())
+}
+
+type clockEntry struct {
+	handle base.IEvictHandle
+	iter   uint64
+	ref    bool
+	hot    bool
+	// element in the cold or the hot ring, nil if not queued
+	elem *list.Element
+}
+
+// TwoQueueClockEvictHolder is a plain two-queue clock. It keeps the nodes
+// accessed once in a cold FIFO queue and the nodes accessed again in a hot
+// ring swept by a hand. The cold nodes are unloaded first, in the order they
+// were enqueued, but those accessed again meanwhile are moved to the hot
+// ring. The hand gives the hot nodes accessed since it last passed them a
+// second chance. Unlike CLOCK-Pro, it keeps no history of the unloaded nodes
+// and does not adapt the sizes of the queues.
+type TwoQueueClockEvictHolder struct {
+	sync.Mutex
+	entries map[common.ID]*clockEntry
+	cold    *list.List
+	hot     *list.List
+	hand    *list.Element
+}
+
+func NewTwoQueueClockEvictHolder() *TwoQueueClockEvictHolder {
+	return &TwoQueueClockEvictHolder{
+		entries: make(map[common.ID]*clockEntry),
+		cold:    list.New(),
+		hot:     list.New(),
+	}
+}
+
+func (holder *TwoQueueClockEvictHolder) Enqueue(node *EvictNode) {
+	holder.Lock()
+	defer holder.Unlock()
+	e := holder.entries[node.Handle.GetID()]
+	if e == nil {
+		e = &clockEntry{handle: node.Handle}
+		holder.entries[node.Handle.GetID()] = e
+	} else {
+		e.ref = true
+	}
+	e.iter = node.Iter
+	if e.elem != nil {
+		return
+	}
+	if e.hot {
+		holder.pushHot(e)
+	} else {
+		e.elem = holder.cold.PushBack(e)
+	}
+}
+
+func (holder *TwoQueueClockEvictHolder) Dequeue() *EvictNode {
+	holder.Lock()
+	defer holder.Unlock()
+	for holder.cold.Len() > 0 {
+		e := holder.cold.Remove(holder.cold.Front()).(*clockEntry)
+		e.elem = nil
+		if e.ref {
+			e.ref = false
+			e.hot = true
+			holder.pushHot(e)
+			continue
+		}
+		return &EvictNode{Handle: e.handle, Iter: e.iter}
+	}
+	for holder.hot.Len() > 0 {
+		if holder.hand == nil {
+			holder.hand = holder.hot.Front()
+		}
+		e := holder.hand.Value.(*clockEntry)
+		if e.ref {
+			e.ref = false
+			holder.hand = holder.hand.Next()
+			continue
+		}
+		holder.unlink(e)
+		e.hot = false
+		return &EvictNode{Handle: e.handle, Iter: e.iter}
+	}
+	return nil
+}
+
+func (holder *TwoQueueClockEvictHolder) Remove(h base.IEvictHandle) {
+	holder.Lock()
+	defer holder.Unlock()
+	e := holder.entries[h.GetID()]
+	if e == nil {
+		return
+	}
+	if e.elem != nil {
+		holder.unlink(e)
+	}
+	delete(holder.entries, h.GetID())
+}
+
+// pushHot inserts e right behind the hand, the last place it reaches
+func (holder *TwoQueueClockEvictHolder) pushHot(e *clockEntry) {
+	if holder.hand == nil {
+		e.elem = holder.hot.PushBack(e)
+	} else {
+		e.elem = holder.hot.InsertBefore(e, holder.hand)
+	}
+}
+
+func (holder *TwoQueueClockEvictHolder) unlink(e *clockEntry) {
+	if !e.hot {
+		holder.cold.Remove(e.elem)
+		e.elem = nil
+		return
+	}
+	if holder.hand == e.elem {
+		holder.hand = e.elem.Next()
+	}
+	holder.hot.Remove(e.elem)
+	e.elem = nil
+}
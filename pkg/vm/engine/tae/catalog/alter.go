@@ -0,0 +1,367 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/wal"
+)
+
+type AlterOpT int8
+
+const (
+	AlterAddColumn AlterOpT = iota
+	AlterDropColumn
+	AlterRenameColumn
+	AlterModifyColumn
+	AlterAddPartition
+	AlterDropPartition
+)
+
+// AlterAction is one change of an ALTER TABLE. Name is the column to drop,
+// rename or modify, or the partition to drop. NewName is the new name of a
+// renamed column. Def is the definition of an added or modified column.
+// Partitions are the added partitions.
+type AlterAction struct {
+	Op         AlterOpT
+	Name       string
+	NewName    string
+	Def        *ColDef
+	Partitions []*PartitionDef
+}
+
+// IsWideningType returns true if every value of type from can be represented
+// by type to without loss, which is the only type change of a column allowed
+// by alter.
+func IsWideningType(from, to types.Type) bool {
+	if from.Oid == to.Oid {
+		switch from.Oid {
+		case types.T_char, types.T_varchar:
+			return to.Width >= from.Width
+		}
+		return true
+	}
+	switch from.Oid {
+	case types.T_int8, types.T_int16, types.T_int32:
+		switch to.Oid {
+		case types.T_int16, types.T_int32, types.T_int64:
+			return to.Size > from.Size
+		case types.T_float64:
+			return true
+		}
+	case types.T_int64:
+		return false
+	case types.T_uint8, types.T_uint16, types.T_uint32:
+		switch to.Oid {
+		case types.T_uint16, types.T_uint32, types.T_uint64:
+			return to.Size > from.Size
+		case types.T_int16, types.T_int32, types.T_int64:
+			return to.Size > from.Size
+		case types.T_float64:
+			return true
+		}
+	case types.T_float32:
+		return to.Oid == types.T_float64
+	case types.T_char:
+		return to.Oid == types.T_varchar && to.Width >= from.Width
+	case types.T_date:
+		return to.Oid == types.T_datetime
+	}
+	return false
+}
+
+// Clone returns a copy of the schema which can be altered without changing s.
+func (s *Schema) Clone() *Schema {
+	cloned := *s
+	cloned.ColDefs = make([]*ColDef, len(s.ColDefs))
+	for i, colDef := range s.ColDefs {
+		def := *colDef
+		cloned.ColDefs[i] = &def
+	}
+	cloned.Indexes = make([]*IndexInfo, len(s.Indexes))
+	for i, index := range s.Indexes {
+		info := *index
+		info.Columns = append([]uint16(nil), index.Columns...)
+		cloned.Indexes[i] = &info
+	}
+	cloned.Checks = append([]*CheckInfo(nil), s.Checks...)
+	cloned.ForeignKeys = append([]*ForeignKeyInfo(nil), s.ForeignKeys...)
+	if s.Partition != nil {
+		cloned.Partition = s.Partition.clone()
+	}
+	cloned.NameIndex = make(map[string]int, len(s.NameIndex))
+	for name, idx := range s.NameIndex {
+		cloned.NameIndex[name] = idx
+	}
+	return &cloned
+}
+
+// GetColIdxBySeqNum returns the index of the column identified by seqNum if
+// found, otherwise returns -1.
+func (s *Schema) GetColIdxBySeqNum(seqNum uint16) int {
+	for _, colDef := range s.ColDefs {
+		if colDef.SeqNum == seqNum {
+			return colDef.Idx
+		}
+	}
+	return -1
+}
+
+// Alter returns the next version of the schema with actions applied in order.
+// Secondary indexes covering a dropped column are dropped as well. The
+// columns read by checks and foreign keys, and the TTL column, cannot be
+// dropped or change type, neither can the partition column.
+func (s *Schema) Alter(actions []*AlterAction) (altered *Schema, err error) {
+	altered = s.Clone()
+	altered.Version++
+	pkSeqNum := s.GetPKColumnDef().SeqNum
+	for _, action := range actions {
+		if err = altered.applyAlter(action, pkSeqNum); err != nil {
+			return nil, err
+		}
+	}
+	altered.NameIndex = make(map[string]int, len(altered.ColDefs))
+	for i, colDef := range altered.ColDefs {
+		colDef.Idx = i
+		altered.NameIndex[colDef.Name] = i
+	}
+	altered.PrimaryKey = int32(altered.GetColIdxBySeqNum(pkSeqNum))
+
+	// Index columns are positions in s, remap them to positions in altered
+	indexes := make([]*IndexInfo, 0, len(altered.Indexes))
+	for _, index := range altered.Indexes {
+		dropped := false
+		for i, col := range index.Columns {
+			idx := altered.GetColIdxBySeqNum(s.ColDefs[col].SeqNum)
+			if idx < 0 {
+				dropped = true
+				break
+			}
+			index.Columns[i] = uint16(idx)
+		}
+		if !dropped {
+			indexes = append(indexes, index)
+		}
+	}
+	altered.Indexes = indexes
+	if !altered.Valid() {
+		return nil, ErrValidation
+	}
+	return
+}
+
+func (s *Schema) findCol(name string) int {
+	for i, colDef := range s.ColDefs {
+		if colDef.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func (s *Schema) applyAlter(action *AlterAction, pkSeqNum uint16) error {
+	switch action.Op {
+	case AlterAddColumn:
+		if s.findCol(action.Def.Name) >= 0 {
+			return ErrDuplicate
+		}
+		colDef := *action.Def
+		colDef.SeqNum = s.ColSeqNum
+		s.ColSeqNum++
+		s.ColDefs = append(s.ColDefs, &colDef)
+	case AlterDropColumn:
+		pos := s.findCol(action.Name)
+		if pos < 0 {
+			return ErrNotFound
+		}
+		if s.ColDefs[pos].SeqNum == pkSeqNum || len(s.ColDefs) == 1 {
+			return ErrNotPermitted
+		}
+		if s.isConstrained(s.ColDefs[pos].SeqNum) {
+			return ErrNotPermitted
+		}
+		s.ColDefs = append(s.ColDefs[:pos], s.ColDefs[pos+1:]...)
+	case AlterRenameColumn:
+		pos := s.findCol(action.Name)
+		if pos < 0 {
+			return ErrNotFound
+		}
+		if s.findCol(action.NewName) >= 0 {
+			return ErrDuplicate
+		}
+		s.ColDefs[pos].Name = action.NewName
+	case AlterModifyColumn:
+		pos := s.findCol(action.Name)
+		if pos < 0 {
+			return ErrNotFound
+		}
+		colDef := s.ColDefs[pos]
+		if !IsWideningType(colDef.Type, action.Def.Type) {
+			return ErrNotPermitted
+		}
+		if colDef.SeqNum == pkSeqNum && colDef.Type != action.Def.Type {
+			return ErrNotPermitted
+		}
+		if s.isConstrained(colDef.SeqNum) && colDef.Type != action.Def.Type {
+			return ErrNotPermitted
+		}
+		colDef.Type = action.Def.Type
+		colDef.NullAbility = action.Def.NullAbility
+		colDef.Comment = action.Def.Comment
+		colDef.Default = action.Def.Default
+		colDef.Compress = action.Def.Compress
+	case AlterAddPartition:
+		if s.Partition == nil {
+			return fmt.Errorf("%w: table is not partitioned", ErrNotPermitted)
+		}
+		if s.Partition.Method == PartitionHash {
+			return fmt.Errorf("%w: cannot add a hash partition", ErrNotPermitted)
+		}
+		return s.Partition.add(action.Partitions)
+	case AlterDropPartition:
+		if s.Partition == nil {
+			return fmt.Errorf("%w: table is not partitioned", ErrNotPermitted)
+		}
+		return s.Partition.drop([]string{action.Name})
+	default:
+		panic("not supported")
+	}
+	return nil
+}
+
+// isConstrained returns true if the column of seqNum is read by a check or
+// a foreign key of the schema, or it is the TTL or the partition column.
+func (s *Schema) isConstrained(seqNum uint16) bool {
+	if s.TTL != 0 && s.TTLSeqNum == seqNum {
+		return true
+	}
+	if s.Partition != nil && s.Partition.SeqNum == seqNum {
+		return true
+	}
+	for _, check := range s.Checks {
+		for _, col := range check.Pred.Columns() {
+			if col == seqNum {
+				return true
+			}
+		}
+	}
+	for _, fk := range s.ForeignKeys {
+		if fk.Column == seqNum || (fk.IsSelfRef() && fk.RefColumn == seqNum) {
+			return true
+		}
+	}
+	return false
+}
+
+// AlterEntry is the txn entry of altering the columns of a table. The new
+// schema becomes the current schema of the table when the txn is about to
+// commit, the blocks written with older schemas are read through them.
+type AlterEntry struct {
+	sync.RWMutex
+	table  *TableEntry
+	txn    txnif.AsyncTxn
+	base   uint32
+	schema *Schema
+}
+
+// GetSchema returns the schema of the table after the alter.
+func (e *AlterEntry) GetSchema() *Schema { return e.schema }
+
+// CreateAlterEntry creates the next schema version of the table by actions,
+// which is installed when txn commits.
+func (entry *TableEntry) CreateAlterEntry(actions []*AlterAction, txn txnif.AsyncTxn) (created *AlterEntry, err error) {
+	entry.RLock()
+	defer entry.RUnlock()
+	schema, err := entry.schema.Alter(actions)
+	if err != nil {
+		return
+	}
+	created = &AlterEntry{
+		table:  entry,
+		txn:    txn,
+		base:   entry.schema.Version,
+		schema: schema,
+	}
+	return
+}
+
+// GetSchemaByVersion returns the schema of the given version, which is the
+// physical layout of the blocks created with it.
+func (entry *TableEntry) GetSchemaByVersion(version uint32) *Schema {
+	entry.RLock()
+	defer entry.RUnlock()
+	if entry.schema.Version == version {
+		return entry.schema
+	}
+	for _, schema := range entry.schemas {
+		if schema.Version == version {
+			return schema
+		}
+	}
+	panic("schema version not found")
+}
+
+// addSchemaLocked makes schema the current schema of the table and keeps the
+// previous one for the blocks still written with it.
+func (entry *TableEntry) addSchemaLocked(schema *Schema) {
+	if schema.Version <= entry.schema.Version {
+		return
+	}
+	entry.schemas = append(entry.schemas, entry.schema)
+	entry.schema = schema
+}
+
+func (e *AlterEntry) PrepareCommit() error {
+	e.table.RLock()
+	defer e.table.RUnlock()
+	if e.table.schema.Version != e.base {
+		return ErrSchemaChanged
+	}
+	return nil
+}
+
+// Apply installs the new schema. It is called when the txn has been
+// prepared, so that the appends prepared after it see the new schema.
+func (e *AlterEntry) Apply() {
+	e.table.Lock()
+	defer e.table.Unlock()
+	e.table.addSchemaLocked(e.schema)
+}
+
+func (e *AlterEntry) ApplyCommit(_ *wal.Index) error { return nil }
+func (e *AlterEntry) PrepareRollback() error         { return nil }
+func (e *AlterEntry) ApplyRollback() error           { return nil }
+
+func (e *AlterEntry) MakeCommand(id uint32) (txnif.TxnCmd, error) {
+	return newAlterCmd(id, e.table, e.schema), nil
+}
+
+func (catalog *Catalog) onReplayAlter(cmd *EntryCommand) {
+	db, err := catalog.GetDatabaseByID(cmd.DBID)
+	if err != nil {
+		panic(err)
+	}
+	tbl, err := db.GetTableEntryByID(cmd.TableID)
+	if err != nil {
+		panic(err)
+	}
+	tbl.Lock()
+	defer tbl.Unlock()
+	tbl.addSchemaLocked(cmd.Schema)
+}
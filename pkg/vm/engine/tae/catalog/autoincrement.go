@@ -0,0 +1,166 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/wal"
+)
+
+// AutoIncrementBatch is the count of values of an auto increment column
+// reserved in the log at a time.
+const AutoIncrementBatch = uint64(1000)
+
+// sequence allocates the values of the auto increment column of a table.
+// The values below limit are reserved, and the values below persisted are
+// reserved in the log by committed txns. The first value allocated after a
+// restart is the replayed limit, the values reserved but not allocated
+// before are skipped.
+type sequence struct {
+	sync.Mutex
+	// next is the next value to allocate, 0 if not initialized
+	next      uint64
+	limit     uint64
+	persisted uint64
+}
+
+// initLocked starts the sequence from the start value of the schema, or
+// from the replayed limit if it is larger.
+func (seq *sequence) initLocked(start uint64) {
+	if seq.next != 0 {
+		return
+	}
+	seq.next = start
+	if seq.next == 0 {
+		seq.next = 1
+	}
+	if seq.next < seq.limit {
+		seq.next = seq.limit
+	}
+}
+
+// reserveLocked makes sure the values below end are reserved. It returns
+// true if end is beyond the values reserved in the log, in which case the
+// txn allocating the values has to log the limit.
+func (seq *sequence) reserveLocked(end uint64) bool {
+	if end > seq.limit {
+		seq.limit = end + AutoIncrementBatch
+	}
+	return end > seq.persisted
+}
+
+// replay makes the values below limit reserved in the log.
+func (seq *sequence) replay(limit uint64) {
+	seq.Lock()
+	defer seq.Unlock()
+	if limit > seq.limit {
+		seq.limit = limit
+	}
+	if limit > seq.persisted {
+		seq.persisted = limit
+	}
+}
+
+func (seq *sequence) getLimit() uint64 {
+	seq.Lock()
+	defer seq.Unlock()
+	return seq.limit
+}
+
+// AllocAutoIncrement allocates cnt consecutive values of the auto increment
+// column and returns the first one. logged is true if the txn has to log the
+// reserved values by an AutoIncrementEntry. The allocated values are never
+// given back even if the txn is rolled back.
+func (entry *TableEntry) AllocAutoIncrement(cnt uint64) (first uint64, logged bool) {
+	start := entry.GetSchema().AutoIncrement
+	seq := entry.seq
+	seq.Lock()
+	defer seq.Unlock()
+	seq.initLocked(start)
+	first = seq.next
+	seq.next += cnt
+	logged = seq.reserveLocked(seq.next)
+	return
+}
+
+// ObserveAutoIncrement makes the values allocated later greater than v, which
+// is an explicit value of the auto increment column. logged has the same
+// meaning as in AllocAutoIncrement.
+func (entry *TableEntry) ObserveAutoIncrement(v uint64) (logged bool) {
+	start := entry.GetSchema().AutoIncrement
+	seq := entry.seq
+	seq.Lock()
+	defer seq.Unlock()
+	seq.initLocked(start)
+	if v < seq.next {
+		return v >= seq.persisted
+	}
+	seq.next = v + 1
+	return seq.reserveLocked(seq.next)
+}
+
+// PeekAutoIncrement returns the value the auto increment column allocates
+// next.
+func (entry *TableEntry) PeekAutoIncrement() uint64 {
+	start := entry.GetSchema().AutoIncrement
+	seq := entry.seq
+	seq.Lock()
+	defer seq.Unlock()
+	seq.initLocked(start)
+	return seq.next
+}
+
+// AutoIncrementEntry is the txn entry logging the values of the auto
+// increment column reserved when the txn allocated them. A txn allocating
+// values already reserved in the log by committed txns does not need it.
+type AutoIncrementEntry struct {
+	sync.RWMutex
+	table *TableEntry
+	limit uint64
+}
+
+func NewAutoIncrementEntry(table *TableEntry) *AutoIncrementEntry {
+	return &AutoIncrementEntry{table: table}
+}
+
+func (e *AutoIncrementEntry) PrepareCommit() error   { return nil }
+func (e *AutoIncrementEntry) PrepareRollback() error { return nil }
+func (e *AutoIncrementEntry) ApplyRollback() error   { return nil }
+
+// MakeCommand logs the current limit of the sequence, which covers all the
+// values allocated by the txn.
+func (e *AutoIncrementEntry) MakeCommand(id uint32) (txnif.TxnCmd, error) {
+	e.limit = e.table.seq.getLimit()
+	return newAutoIncrementCmd(id, e.table, e.limit), nil
+}
+
+func (e *AutoIncrementEntry) ApplyCommit(_ *wal.Index) error {
+	e.table.seq.replay(e.limit)
+	return nil
+}
+
+func (catalog *Catalog) onReplayAutoIncrement(cmd *EntryCommand) {
+	db, err := catalog.GetDatabaseByID(cmd.DBID)
+	if err != nil {
+		panic(err)
+	}
+	tbl, err := db.GetTableEntryByID(cmd.TableID)
+	if err != nil {
+		panic(err)
+	}
+	tbl.seq.replay(cmd.AutoIncrement)
+}
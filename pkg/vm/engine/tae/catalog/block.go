@@ -32,6 +32,9 @@ type BlockEntry struct {
 	segment *SegmentEntry
 	state   EntryState
 	blkData data.Block
+	// schema is the schema of the table when the block was created, which
+	// is the physical layout of the block
+	schema *Schema
 }
 
 func NewReplayBlockEntry() *BlockEntry {
@@ -53,6 +56,7 @@ func NewBlockEntry(segment *SegmentEntry, txn txnif.AsyncTxn, state EntryState,
 		},
 		segment: segment,
 		state:   state,
+		schema:  segment.GetTable().GetSchema(),
 	}
 	if dataFactory != nil {
 		e.blkData = dataFactory(e)
@@ -72,6 +76,7 @@ func NewStandaloneBlock(segment *SegmentEntry, id uint64, ts uint64) *BlockEntry
 		},
 		segment: segment,
 		state:   ES_Appendable,
+		schema:  segment.GetTable().GetSchema(),
 	}
 	return e
 }
@@ -88,6 +93,7 @@ func NewSysBlockEntry(segment *SegmentEntry, id uint64) *BlockEntry {
 		},
 		segment: segment,
 		state:   ES_Appendable,
+		schema:  segment.GetTable().GetSchema(),
 	}
 	return e
 }
@@ -146,7 +152,13 @@ func (entry *BlockEntry) AsCommonID() *common.ID {
 }
 
 func (entry *BlockEntry) GetBlockData() data.Block { return entry.blkData }
-func (entry *BlockEntry) GetSchema() *Schema       { return entry.GetSegment().GetTable().GetSchema() }
+func (entry *BlockEntry) GetSchema() *Schema       { return entry.schema }
+
+// IsSchemaStale returns true if the table was altered after the block was
+// created.
+func (entry *BlockEntry) IsSchemaStale() bool {
+	return entry.schema.Version != entry.GetSegment().GetTable().GetSchema().Version
+}
 
 func (entry *BlockEntry) PrepareRollback() (err error) {
 	entry.RLock()
@@ -170,7 +182,10 @@ func (entry *BlockEntry) WriteTo(w io.Writer) (n int64, err error) {
 	if err = binary.Write(w, binary.BigEndian, entry.state); err != nil {
 		return
 	}
-	n += 1
+	if err = binary.Write(w, binary.BigEndian, entry.schema.Version); err != nil {
+		return
+	}
+	n += 1 + 4
 	return
 }
 
@@ -178,8 +193,12 @@ func (entry *BlockEntry) ReadFrom(r io.Reader) (n int64, err error) {
 	if n, err = entry.BaseEntry.ReadFrom(r); err != nil {
 		return
 	}
-	err = binary.Read(r, binary.BigEndian, &entry.state)
-	n += 1
+	if err = binary.Read(r, binary.BigEndian, &entry.state); err != nil {
+		return
+	}
+	entry.schema = new(Schema)
+	err = binary.Read(r, binary.BigEndian, &entry.schema.Version)
+	n += 1 + 4
 	return
 }
 
@@ -192,6 +211,7 @@ func (entry *BlockEntry) Clone() CheckpointItem {
 		BaseEntry: entry.BaseEntry.Clone(),
 		state:     entry.state,
 		segment:   entry.segment,
+		schema:    entry.schema,
 	}
 	return cloned
 }
@@ -201,6 +221,7 @@ func (entry *BlockEntry) CloneCreate() CheckpointItem {
 		BaseEntry: entry.BaseEntry.CloneCreate(),
 		state:     entry.state,
 		segment:   entry.segment,
+		schema:    entry.schema,
 	}
 	return cloned
 }
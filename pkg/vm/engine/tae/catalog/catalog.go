@@ -143,6 +143,15 @@ func (catalog *Catalog) ReplayCmd(txncmd txnif.TxnCmd, datafactory DataFactory,
 	case CmdLogDatabase:
 		cmd := txncmd.(*EntryCommand)
 		catalog.onReplayDatabase(cmd)
+	case CmdCreateIndex, CmdDropIndex:
+		cmd := txncmd.(*EntryCommand)
+		catalog.onReplayIndex(cmd)
+	case CmdAlterTable:
+		cmd := txncmd.(*EntryCommand)
+		catalog.onReplayAlter(cmd)
+	case CmdAutoIncrement:
+		cmd := txncmd.(*EntryCommand)
+		catalog.onReplayAutoIncrement(cmd)
 	case CmdCreateDatabase:
 		cmd := txncmd.(*EntryCommand)
 		catalog.onReplayCreateDatabase(cmd, idxCtx, observer)
@@ -387,6 +396,7 @@ func (catalog *Catalog) onReplayCreateBlock(cmd *EntryCommand, datafactory DataF
 	cmd.Block.RWMutex = new(sync.RWMutex)
 	cmd.Block.CurrOp = OpCreate
 	cmd.Block.segment = seg
+	cmd.Block.schema = tbl.GetSchemaByVersion(cmd.Block.schema.Version)
 	cmd.Block.blkData = datafactory.MakeBlockFactory(seg.segData.GetSegmentFile())(cmd.Block)
 	catalog.OnReplayBlockID(cmd.Block.ID)
 	cmd.Block.LogIndex = idx
@@ -436,6 +446,7 @@ func (catalog *Catalog) onReplayBlock(cmd *EntryCommand, datafactory DataFactory
 		panic(err)
 	}
 	cmd.Block.segment = seg
+	cmd.Block.schema = rel.GetSchemaByVersion(cmd.Block.schema.Version)
 	if cmd.Block.CurrOp == OpCreate {
 		cmd.Block.blkData = datafactory.MakeBlockFactory(seg.segData.GetSegmentFile())(cmd.Block)
 		catalog.OnReplayBlockID(cmd.Block.ID)
@@ -16,11 +16,16 @@ package catalog
 
 import (
 	"bytes"
+	"encoding/binary"
+	"errors"
 	"fmt"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/compress"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
@@ -465,3 +470,184 @@ func TestSegment1(t *testing.T) {
 	t.Log(seg1.String())
 	t.Log(tb.String())
 }
+
+func TestSchemaTTL(t *testing.T) {
+	for spec, ttl := range map[string]time.Duration{"d:90d": 90 * 24 * time.Hour, "a:b:36h": 36 * time.Hour} {
+		_, parsed, err := ParseTTL(spec)
+		assert.Nil(t, err)
+		assert.Equal(t, ttl, parsed)
+	}
+	for _, spec := range []string{"", "90d", ":90d", "d:", "d:0d", "d:-1h", "d:xd"} {
+		_, _, err := ParseTTL(spec)
+		assert.True(t, errors.Is(err, ErrValidation))
+	}
+
+	schema := MockSchemaAll(12)
+	schema.PrimaryKey = 3
+	assert.Nil(t, schema.GetTTLColumnDef())
+	assert.True(t, errors.Is(schema.SetTTL("missing:1d"), ErrNotFound))
+	assert.True(t, errors.Is(schema.SetTTL("mock_3:1d"), ErrValidation))
+	assert.Nil(t, schema.SetTTL("mock_10:1d"))
+	assert.Equal(t, "mock_10", schema.GetTTLColumnDef().Name)
+	now := time.Date(2022, 3, 1, 12, 0, 0, 0, time.Local)
+	assert.Equal(t, types.FromCalendar(2022, 2, 28), schema.TTLCutoff(now))
+	assert.Nil(t, schema.SetTTL("mock_11:1h"))
+	assert.Equal(t, types.FromClock(2022, 3, 1, 11, 0, 0, 0), schema.TTLCutoff(now))
+
+	buf, err := schema.Marshal()
+	assert.Nil(t, err)
+	replayed := NewEmptySchema("")
+	_, err = replayed.ReadFrom(bytes.NewBuffer(buf))
+	assert.Nil(t, err)
+	assert.Equal(t, schema.TTL, replayed.TTL)
+	assert.Equal(t, "mock_11", replayed.GetTTLColumnDef().Name)
+
+	// the TTL column can be renamed but not dropped
+	_, err = schema.Alter([]*AlterAction{{Op: AlterDropColumn, Name: "mock_11"}})
+	assert.Equal(t, ErrNotPermitted, err)
+	altered, err := schema.Alter([]*AlterAction{{Op: AlterRenameColumn, Name: "mock_11", NewName: "ts"}})
+	assert.Nil(t, err)
+	assert.Equal(t, "ts", altered.GetTTLColumnDef().Name)
+}
+
+func TestSchemaPartition(t *testing.T) {
+	schema := MockSchemaAll(12)
+	schema.PrimaryKey = 3
+	assert.False(t, schema.IsPartitioned())
+	bounds := []*PartitionDef{{Name: "p0", Bound: int32(10)}, {Name: "p1", Bound: int32(20)}}
+	assert.True(t, errors.Is(schema.SetPartition(PartitionRange, "missing", bounds), ErrNotFound))
+	assert.True(t, errors.Is(schema.SetPartition(PartitionRange, "mock_2", nil), ErrValidation))
+	assert.True(t, errors.Is(schema.SetPartition(PartitionHash, "mock_10", bounds), ErrValidation))
+	unordered := []*PartitionDef{{Name: "p0", Bound: int32(20)}, {Name: "p1", Bound: int32(10)}}
+	assert.True(t, errors.Is(schema.SetPartition(PartitionRange, "mock_2", unordered), ErrValidation))
+	assert.Nil(t, schema.SetPartition(PartitionRange, "mock_2", bounds))
+	assert.Equal(t, "mock_2", schema.GetPartitionColumnDef().Name)
+
+	info := schema.Partition
+	for v, name := range map[interface{}]string{nil: "p0", int32(-5): "p0", int32(10): "p1", int32(19): "p1"} {
+		part, err := info.Locate(v)
+		assert.Nil(t, err)
+		assert.Equal(t, name, part.Name)
+	}
+	_, err := info.Locate(int32(20))
+	assert.True(t, errors.Is(err, ErrNoPartition))
+
+	// partitions are added above the last one, up to MAXVALUE
+	_, err = schema.Alter([]*AlterAction{{Op: AlterAddPartition, Partitions: []*PartitionDef{{Name: "p2", Bound: int32(15)}}}})
+	assert.True(t, errors.Is(err, ErrValidation))
+	_, err = schema.Alter([]*AlterAction{{Op: AlterAddPartition, Partitions: []*PartitionDef{{Name: "p1", Bound: int32(30)}}}})
+	assert.True(t, errors.Is(err, ErrDuplicate))
+	altered, err := schema.Alter([]*AlterAction{{Op: AlterAddPartition, Partitions: []*PartitionDef{{Name: "pmax"}}}})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(schema.Partition.Partitions))
+	part, err := altered.Partition.Locate(int32(100))
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(3), part.ID)
+	_, err = altered.Alter([]*AlterAction{{Op: AlterAddPartition, Partitions: []*PartitionDef{{Name: "p3"}}}})
+	assert.True(t, errors.Is(err, ErrValidation))
+
+	// the IDs of the dropped partitions are not reused
+	altered, err = altered.Alter([]*AlterAction{{Op: AlterDropPartition, Name: "p1"}})
+	assert.Nil(t, err)
+	part, err = altered.Partition.Locate(int32(15))
+	assert.Nil(t, err)
+	assert.Equal(t, "pmax", part.Name)
+	_, err = altered.Alter([]*AlterAction{{Op: AlterDropPartition, Name: "p0"}, {Op: AlterDropPartition, Name: "pmax"}})
+	assert.True(t, errors.Is(err, ErrNotPermitted))
+	_, err = altered.Alter([]*AlterAction{{Op: AlterDropColumn, Name: "mock_2"}})
+	assert.Equal(t, ErrNotPermitted, err)
+
+	buf, err := altered.Marshal()
+	assert.Nil(t, err)
+	replayed := NewEmptySchema("")
+	_, err = replayed.ReadFrom(bytes.NewBuffer(buf))
+	assert.Nil(t, err)
+	assert.Equal(t, altered.Partition, replayed.Partition)
+
+	list := MockSchemaAll(12)
+	list.PrimaryKey = 3
+	dup := []*PartitionDef{{Name: "a", Values: []interface{}{int8(1)}}, {Name: "b", Values: []interface{}{int8(2), int8(1)}}}
+	assert.True(t, errors.Is(list.SetPartition(PartitionList, "mock_0", dup), ErrDuplicate))
+	lists := []*PartitionDef{{Name: "a", Values: []interface{}{int8(1), nil}}, {Name: "b", Values: []interface{}{int8(2)}}}
+	assert.Nil(t, list.SetPartition(PartitionList, "mock_0", lists))
+	part, err = list.Partition.Locate(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "a", part.Name)
+	_, err = list.Partition.Locate(int8(3))
+	assert.True(t, errors.Is(err, ErrNoPartition))
+	buf, err = list.Marshal()
+	assert.Nil(t, err)
+	replayed = NewEmptySchema("")
+	_, err = replayed.ReadFrom(bytes.NewBuffer(buf))
+	assert.Nil(t, err)
+	assert.Equal(t, list.Partition, replayed.Partition)
+
+	hash := MockSchemaAll(12)
+	hash.PrimaryKey = 3
+	assert.Nil(t, hash.SetPartition(PartitionHash, "mock_3", []*PartitionDef{{Name: "p0"}, {Name: "p1"}, {Name: "p2"}}))
+	for v, name := range map[interface{}]string{nil: "p0", int64(4): "p1", int64(-4): "p2"} {
+		part, err := hash.Partition.Locate(v)
+		assert.Nil(t, err)
+		assert.Equal(t, name, part.Name)
+	}
+	_, err = hash.Alter([]*AlterAction{{Op: AlterDropPartition, Name: "p0"}})
+	assert.True(t, errors.Is(err, ErrNotPermitted))
+}
+
+func TestSchemaFormat(t *testing.T) {
+	schema := MockSchemaAll(4)
+	schema.PrimaryKey = 2
+	schema.BlockMaxRows = 1000
+	schema.SegmentMaxBlocks = 10
+
+	// the format before the version marker
+	var w bytes.Buffer
+	assert.Nil(t, binary.Write(&w, binary.BigEndian, schema.BlockMaxRows))
+	assert.Nil(t, binary.Write(&w, binary.BigEndian, schema.PrimaryKey))
+	assert.Nil(t, binary.Write(&w, binary.BigEndian, schema.SegmentMaxBlocks))
+	_, err := common.WriteString(schema.Name, &w)
+	assert.Nil(t, err)
+	_, err = common.WriteString(schema.Comment, &w)
+	assert.Nil(t, err)
+	assert.Nil(t, binary.Write(&w, binary.BigEndian, uint16(len(schema.ColDefs))))
+	for _, colDef := range schema.ColDefs {
+		w.Write(encoding.EncodeType(colDef.Type))
+		_, err = common.WriteString(colDef.Name, &w)
+		assert.Nil(t, err)
+		_, err = common.WriteString(colDef.Comment, &w)
+		assert.Nil(t, err)
+		w.Write([]byte{byte(colDef.NullAbility), byte(colDef.Hidden), byte(colDef.AutoIncrement)})
+	}
+	// followed by the next entry
+	w.WriteString("next")
+	r := bytes.NewBuffer(w.Bytes())
+	replayed := NewEmptySchema("")
+	_, err = replayed.ReadFrom(r)
+	assert.Nil(t, err)
+	assert.Equal(t, "next", r.String())
+	assert.Equal(t, schema.Name, replayed.Name)
+	assert.Equal(t, schema.BlockMaxRows, replayed.BlockMaxRows)
+	assert.Equal(t, schema.PrimaryKey, replayed.PrimaryKey)
+	assert.Equal(t, schema.ColSeqNum, replayed.ColSeqNum)
+	for i, colDef := range replayed.ColDefs {
+		assert.Equal(t, schema.ColDefs[i].Name, colDef.Name)
+		assert.Equal(t, schema.ColDefs[i].Type, colDef.Type)
+		assert.Equal(t, schema.ColDefs[i].SeqNum, colDef.SeqNum)
+		assert.Equal(t, uint8(compress.Lz4), colDef.Compress)
+		assert.Equal(t, i, replayed.GetColIdx(colDef.Name))
+	}
+	assert.True(t, replayed.Valid())
+
+	buf, err := schema.Marshal()
+	assert.Nil(t, err)
+	replayed = NewEmptySchema("")
+	_, err = replayed.ReadFrom(bytes.NewBuffer(buf))
+	assert.Nil(t, err)
+	assert.Equal(t, schema.BlockMaxRows, replayed.BlockMaxRows)
+	assert.Equal(t, len(schema.ColDefs), len(replayed.ColDefs))
+
+	// a format written by a later version is refused
+	binary.BigEndian.PutUint16(buf[4:], SchemaFormat+1)
+	_, err = NewEmptySchema("").ReadFrom(bytes.NewBuffer(buf))
+	assert.True(t, errors.Is(err, ErrValidation))
+}
@@ -37,6 +37,10 @@ const (
 	CmdLogTable
 	CmdLogSegment
 	CmdLogBlock
+	CmdCreateIndex
+	CmdDropIndex
+	CmdAlterTable
+	CmdAutoIncrement
 )
 
 func init() {
@@ -76,6 +80,18 @@ func init() {
 	txnif.RegisterCmdFactory(CmdLogDatabase, func(cmdType int16) txnif.TxnCmd {
 		return newEmptyEntryCmd(cmdType)
 	})
+	txnif.RegisterCmdFactory(CmdCreateIndex, func(cmdType int16) txnif.TxnCmd {
+		return newEmptyEntryCmd(cmdType)
+	})
+	txnif.RegisterCmdFactory(CmdDropIndex, func(cmdType int16) txnif.TxnCmd {
+		return newEmptyEntryCmd(cmdType)
+	})
+	txnif.RegisterCmdFactory(CmdAlterTable, func(cmdType int16) txnif.TxnCmd {
+		return newEmptyEntryCmd(cmdType)
+	})
+	txnif.RegisterCmdFactory(CmdAutoIncrement, func(cmdType int16) txnif.TxnCmd {
+		return newEmptyEntryCmd(cmdType)
+	})
 }
 
 type EntryCommand struct {
@@ -89,6 +105,11 @@ type EntryCommand struct {
 	Table     *TableEntry
 	Segment   *SegmentEntry
 	Block     *BlockEntry
+	Index     *IndexInfo
+	Schema    *Schema
+	// AutoIncrement is the limit of the values reserved by the auto
+	// increment column
+	AutoIncrement uint64
 }
 
 func newEmptyEntryCmd(cmdType int16) *EntryCommand {
@@ -131,6 +152,42 @@ func newTableCmd(id uint32, cmdType int16, entry *TableEntry) *EntryCommand {
 	return impl
 }
 
+func newIndexCmd(id uint32, cmdType int16, table *TableEntry, index *IndexInfo) *EntryCommand {
+	impl := &EntryCommand{
+		DB:      table.GetDB(),
+		Table:   table,
+		Index:   index,
+		cmdType: cmdType,
+		entry:   table.BaseEntry,
+	}
+	impl.BaseCustomizedCmd = txnbase.NewBaseCustomizedCmd(id, impl)
+	return impl
+}
+
+func newAlterCmd(id uint32, table *TableEntry, schema *Schema) *EntryCommand {
+	impl := &EntryCommand{
+		DB:      table.GetDB(),
+		Table:   table,
+		Schema:  schema,
+		cmdType: CmdAlterTable,
+		entry:   table.BaseEntry,
+	}
+	impl.BaseCustomizedCmd = txnbase.NewBaseCustomizedCmd(id, impl)
+	return impl
+}
+
+func newAutoIncrementCmd(id uint32, table *TableEntry, limit uint64) *EntryCommand {
+	impl := &EntryCommand{
+		DB:            table.GetDB(),
+		Table:         table,
+		AutoIncrement: limit,
+		cmdType:       CmdAutoIncrement,
+		entry:         table.BaseEntry,
+	}
+	impl.BaseCustomizedCmd = txnbase.NewBaseCustomizedCmd(id, impl)
+	return impl
+}
+
 func newDBCmd(id uint32, cmdType int16, entry *DBEntry) *EntryCommand {
 	impl := &EntryCommand{
 		DB:      entry,
@@ -193,6 +250,44 @@ func (cmd *EntryCommand) WriteTo(w io.Writer) (n int64, err error) {
 		sn, err = cmd.DB.WriteTo(w)
 		n += sn
 		return
+	case CmdCreateIndex, CmdDropIndex:
+		if err = binary.Write(w, binary.BigEndian, cmd.DB.ID); err != nil {
+			return
+		}
+		if err = binary.Write(w, binary.BigEndian, cmd.Table.ID); err != nil {
+			return
+		}
+		sn, err = cmd.Index.WriteTo(w)
+		n += sn + 8 + 8
+		return
+	case CmdAlterTable:
+		if err = binary.Write(w, binary.BigEndian, cmd.DB.ID); err != nil {
+			return
+		}
+		if err = binary.Write(w, binary.BigEndian, cmd.Table.ID); err != nil {
+			return
+		}
+		var schemaBuf []byte
+		if schemaBuf, err = cmd.Schema.Marshal(); err != nil {
+			return
+		}
+		if _, err = w.Write(schemaBuf); err != nil {
+			return
+		}
+		n += int64(len(schemaBuf)) + 8 + 8
+		return
+	case CmdAutoIncrement:
+		if err = binary.Write(w, binary.BigEndian, cmd.DB.ID); err != nil {
+			return
+		}
+		if err = binary.Write(w, binary.BigEndian, cmd.Table.ID); err != nil {
+			return
+		}
+		if err = binary.Write(w, binary.BigEndian, cmd.AutoIncrement); err != nil {
+			return
+		}
+		n += 8 + 8 + 8
+		return
 	}
 
 	if err = binary.Write(w, binary.BigEndian, cmd.entry.GetID()); err != nil {
@@ -243,10 +338,13 @@ func (cmd *EntryCommand) WriteTo(w io.Writer) (n int64, err error) {
 		if err = binary.Write(w, binary.BigEndian, cmd.Segment.state); err != nil {
 			return
 		}
+		if err = binary.Write(w, binary.BigEndian, cmd.Segment.partition); err != nil {
+			return
+		}
 		if err = binary.Write(w, binary.BigEndian, cmd.entry.CreateAt); err != nil {
 			return
 		}
-		n += 8 + 8 + 8
+		n += 8 + 8 + 8 + 4
 	case CmdCreateBlock:
 		if err = binary.Write(w, binary.BigEndian, cmd.DB.ID); err != nil {
 			return
@@ -266,7 +364,10 @@ func (cmd *EntryCommand) WriteTo(w io.Writer) (n int64, err error) {
 		if err = binary.Write(w, binary.BigEndian, cmd.entry.CreateAt); err != nil {
 			return
 		}
-		n += 8 + 8 + 8 + 8 + 8
+		if err = binary.Write(w, binary.BigEndian, cmd.Block.schema.Version); err != nil {
+			return
+		}
+		n += 8 + 8 + 8 + 8 + 8 + 4
 	case CmdDropTable:
 		if err = binary.Write(w, binary.BigEndian, cmd.Table.db.ID); err != nil {
 			return
@@ -374,6 +475,40 @@ func (cmd *EntryCommand) ReadFrom(r io.Reader) (n int64, err error) {
 		cn, err = cmd.DB.ReadFrom(r)
 		n += cn
 		return
+	case CmdCreateIndex, CmdDropIndex:
+		cmd.Index = new(IndexInfo)
+		if err = binary.Read(r, binary.BigEndian, &cmd.DBID); err != nil {
+			return
+		}
+		if err = binary.Read(r, binary.BigEndian, &cmd.TableID); err != nil {
+			return
+		}
+		cn, err = cmd.Index.ReadFrom(r)
+		n += cn + 16
+		return
+	case CmdAlterTable:
+		cmd.Schema = NewEmptySchema("")
+		if err = binary.Read(r, binary.BigEndian, &cmd.DBID); err != nil {
+			return
+		}
+		if err = binary.Read(r, binary.BigEndian, &cmd.TableID); err != nil {
+			return
+		}
+		cn, err = cmd.Schema.ReadFrom(r)
+		n += cn + 16
+		return
+	case CmdAutoIncrement:
+		if err = binary.Read(r, binary.BigEndian, &cmd.DBID); err != nil {
+			return
+		}
+		if err = binary.Read(r, binary.BigEndian, &cmd.TableID); err != nil {
+			return
+		}
+		if err = binary.Read(r, binary.BigEndian, &cmd.AutoIncrement); err != nil {
+			return
+		}
+		n += 8 + 8 + 8
+		return
 	}
 
 	cmd.entry = NewReplayBaseEntry()
@@ -429,6 +564,10 @@ func (cmd *EntryCommand) ReadFrom(r io.Reader) (n int64, err error) {
 		if err = binary.Read(r, binary.BigEndian, &state); err != nil {
 			return
 		}
+		var partition uint32
+		if err = binary.Read(r, binary.BigEndian, &partition); err != nil {
+			return
+		}
 		if err = binary.Read(r, binary.BigEndian, &cmd.entry.CreateAt); err != nil {
 			return
 		}
@@ -436,8 +575,9 @@ func (cmd *EntryCommand) ReadFrom(r io.Reader) (n int64, err error) {
 		cmd.Segment = &SegmentEntry{
 			BaseEntry: cmd.entry,
 			state:     state,
+			partition: partition,
 		}
-		n += 8 + 8 + 8
+		n += 8 + 8 + 8 + 4
 	case CmdCreateBlock:
 		if err = binary.Read(r, binary.BigEndian, &cmd.DBID); err != nil {
 			return
@@ -458,12 +598,17 @@ func (cmd *EntryCommand) ReadFrom(r io.Reader) (n int64, err error) {
 		if err = binary.Read(r, binary.BigEndian, &cmd.entry.CreateAt); err != nil {
 			return
 		}
+		schema := new(Schema)
+		if err = binary.Read(r, binary.BigEndian, &schema.Version); err != nil {
+			return
+		}
 		cmd.entry.CurrOp = OpCreate
 		cmd.Block = &BlockEntry{
 			BaseEntry: cmd.entry,
 			state:     state,
+			schema:    schema,
 		}
-		n += 8 + 8 + 8 + 8
+		n += 8 + 8 + 8 + 8 + 4
 	case CmdDropTable:
 		if err = binary.Read(r, binary.BigEndian, &cmd.DBID); err != nil {
 			return
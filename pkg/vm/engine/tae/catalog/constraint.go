@@ -0,0 +1,375 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+)
+
+// RefAction is the action taken on the referencing rows of a foreign key
+// when the referenced row is deleted or its key updated.
+type RefAction uint8
+
+const (
+	RefRestrict RefAction = iota
+	RefCascade
+	RefSetNull
+)
+
+// ForeignKeyInfo is a single column foreign key. Column and RefColumn are
+// the SeqNum of the referencing and the referenced column, which do not
+// change with alters. RefTable 0 is the table itself.
+type ForeignKeyInfo struct {
+	Name      string
+	Column    uint16
+	RefDB     uint64
+	RefTable  uint64
+	RefColumn uint16
+	OnDelete  RefAction
+	OnUpdate  RefAction
+}
+
+// IsSelfRef returns true if the foreign key references its own table.
+func (fk *ForeignKeyInfo) IsSelfRef() bool {
+	return fk.RefTable == 0
+}
+
+func (fk *ForeignKeyInfo) WriteTo(w io.Writer) (n int64, err error) {
+	if n, err = common.WriteString(fk.Name, w); err != nil {
+		return
+	}
+	if err = binary.Write(w, binary.BigEndian, fk.Column); err != nil {
+		return
+	}
+	if err = binary.Write(w, binary.BigEndian, fk.RefDB); err != nil {
+		return
+	}
+	if err = binary.Write(w, binary.BigEndian, fk.RefTable); err != nil {
+		return
+	}
+	if err = binary.Write(w, binary.BigEndian, fk.RefColumn); err != nil {
+		return
+	}
+	if err = binary.Write(w, binary.BigEndian, fk.OnDelete); err != nil {
+		return
+	}
+	if err = binary.Write(w, binary.BigEndian, fk.OnUpdate); err != nil {
+		return
+	}
+	n += 2 + 8 + 8 + 2 + 1 + 1
+	return
+}
+
+func (fk *ForeignKeyInfo) ReadFrom(r io.Reader) (n int64, err error) {
+	if fk.Name, n, err = common.ReadString(r); err != nil {
+		return
+	}
+	if err = binary.Read(r, binary.BigEndian, &fk.Column); err != nil {
+		return
+	}
+	if err = binary.Read(r, binary.BigEndian, &fk.RefDB); err != nil {
+		return
+	}
+	if err = binary.Read(r, binary.BigEndian, &fk.RefTable); err != nil {
+		return
+	}
+	if err = binary.Read(r, binary.BigEndian, &fk.RefColumn); err != nil {
+		return
+	}
+	if err = binary.Read(r, binary.BigEndian, &fk.OnDelete); err != nil {
+		return
+	}
+	if err = binary.Read(r, binary.BigEndian, &fk.OnUpdate); err != nil {
+		return
+	}
+	n += 2 + 8 + 8 + 2 + 1 + 1
+	return
+}
+
+// CheckInfo is an enforced CHECK constraint. Expr is the SQL text of the
+// constraint and Pred is the predicate compiled from it.
+type CheckInfo struct {
+	Name string
+	Expr string
+	Pred *CheckExpr
+}
+
+func (check *CheckInfo) WriteTo(w io.Writer) (n int64, err error) {
+	var sn int64
+	if n, err = common.WriteString(check.Name, w); err != nil {
+		return
+	}
+	if sn, err = common.WriteString(check.Expr, w); err != nil {
+		return
+	}
+	n += sn
+	if sn, err = check.Pred.WriteTo(w); err != nil {
+		return
+	}
+	n += sn
+	return
+}
+
+func (check *CheckInfo) ReadFrom(r io.Reader) (n int64, err error) {
+	var sn int64
+	if check.Name, n, err = common.ReadString(r); err != nil {
+		return
+	}
+	if check.Expr, sn, err = common.ReadString(r); err != nil {
+		return
+	}
+	n += sn
+	check.Pred = new(CheckExpr)
+	if sn, err = check.Pred.ReadFrom(r); err != nil {
+		return
+	}
+	n += sn
+	return
+}
+
+type CheckOp uint8
+
+const (
+	CheckAnd CheckOp = iota
+	CheckOr
+	CheckNot
+	CheckEq
+	CheckNe
+	CheckLt
+	CheckLe
+	CheckGt
+	CheckGe
+	CheckIsNull
+	CheckIsNotNull
+	// CheckIn is true if the first arg equals one of the others
+	CheckIn
+	// CheckColumn is the value of the column of SeqNum
+	CheckColumn
+	// CheckConst is the constant Val encoded as a key of type Typ, nil
+	// Val is NULL
+	CheckConst
+)
+
+// CheckExpr is a node of the predicate of a CHECK constraint. The operands
+// of a comparison are of the same type.
+type CheckExpr struct {
+	Op     CheckOp
+	SeqNum uint16
+	Typ    types.Type
+	Val    []byte
+	Args   []*CheckExpr
+}
+
+// Three-valued results of the predicates
+const (
+	CheckFalse   int8 = 0
+	CheckTrue    int8 = 1
+	CheckUnknown int8 = -1
+)
+
+// Eval evaluates the predicate on a row, value returns the value of the
+// column of seqNum in the row, nil for NULL. The result is CheckTrue,
+// CheckFalse or CheckUnknown, a row violates the constraint only if the
+// result is CheckFalse.
+func (e *CheckExpr) Eval(value func(seqNum uint16) interface{}) int8 {
+	switch e.Op {
+	case CheckAnd:
+		res := CheckTrue
+		for _, arg := range e.Args {
+			switch arg.Eval(value) {
+			case CheckFalse:
+				return CheckFalse
+			case CheckUnknown:
+				res = CheckUnknown
+			}
+		}
+		return res
+	case CheckOr:
+		res := CheckFalse
+		for _, arg := range e.Args {
+			switch arg.Eval(value) {
+			case CheckTrue:
+				return CheckTrue
+			case CheckUnknown:
+				res = CheckUnknown
+			}
+		}
+		return res
+	case CheckNot:
+		res := e.Args[0].Eval(value)
+		if res == CheckUnknown {
+			return res
+		}
+		return 1 - res
+	case CheckIsNull, CheckIsNotNull:
+		isNull := e.Args[0].value(value) == nil
+		if isNull == (e.Op == CheckIsNull) {
+			return CheckTrue
+		}
+		return CheckFalse
+	case CheckIn:
+		v := e.Args[0].value(value)
+		if v == nil {
+			return CheckUnknown
+		}
+		res := CheckFalse
+		for _, arg := range e.Args[1:] {
+			c := arg.value(value)
+			if c == nil {
+				res = CheckUnknown
+			} else if common.CompareGeneric(v, c, e.Args[0].Typ) == 0 {
+				return CheckTrue
+			}
+		}
+		return res
+	case CheckEq, CheckNe, CheckLt, CheckLe, CheckGt, CheckGe:
+		a, b := e.Args[0].value(value), e.Args[1].value(value)
+		if a == nil || b == nil {
+			return CheckUnknown
+		}
+		cmp := common.CompareGeneric(a, b, e.Args[0].Typ)
+		var ok bool
+		switch e.Op {
+		case CheckEq:
+			ok = cmp == 0
+		case CheckNe:
+			ok = cmp != 0
+		case CheckLt:
+			ok = cmp < 0
+		case CheckLe:
+			ok = cmp <= 0
+		case CheckGt:
+			ok = cmp > 0
+		case CheckGe:
+			ok = cmp >= 0
+		}
+		if ok {
+			return CheckTrue
+		}
+		return CheckFalse
+	}
+	panic("not a predicate")
+}
+
+func (e *CheckExpr) value(value func(seqNum uint16) interface{}) interface{} {
+	switch e.Op {
+	case CheckColumn:
+		return value(e.SeqNum)
+	case CheckConst:
+		if e.Val == nil {
+			return nil
+		}
+		return common.DecodeKey(e.Val, e.Typ)
+	}
+	panic("not a value")
+}
+
+// Columns returns the SeqNum of the columns the predicate reads.
+func (e *CheckExpr) Columns() (cols []uint16) {
+	if e.Op == CheckColumn {
+		return []uint16{e.SeqNum}
+	}
+	for _, arg := range e.Args {
+		cols = append(cols, arg.Columns()...)
+	}
+	return
+}
+
+func (e *CheckExpr) WriteTo(w io.Writer) (n int64, err error) {
+	if err = binary.Write(w, binary.BigEndian, e.Op); err != nil {
+		return
+	}
+	if err = binary.Write(w, binary.BigEndian, e.SeqNum); err != nil {
+		return
+	}
+	if _, err = w.Write(encoding.EncodeType(e.Typ)); err != nil {
+		return
+	}
+	n = 1 + 2 + int64(encoding.TypeSize)
+	hasVal := int8(0)
+	if e.Val != nil {
+		hasVal = 1
+	}
+	if err = binary.Write(w, binary.BigEndian, hasVal); err != nil {
+		return
+	}
+	n += 1
+	var sn int64
+	if hasVal == 1 {
+		if sn, err = common.WriteString(string(e.Val), w); err != nil {
+			return
+		}
+		n += sn
+	}
+	if err = binary.Write(w, binary.BigEndian, uint16(len(e.Args))); err != nil {
+		return
+	}
+	n += 2
+	for _, arg := range e.Args {
+		if sn, err = arg.WriteTo(w); err != nil {
+			return
+		}
+		n += sn
+	}
+	return
+}
+
+func (e *CheckExpr) ReadFrom(r io.Reader) (n int64, err error) {
+	if err = binary.Read(r, binary.BigEndian, &e.Op); err != nil {
+		return
+	}
+	if err = binary.Read(r, binary.BigEndian, &e.SeqNum); err != nil {
+		return
+	}
+	typBuf := make([]byte, encoding.TypeSize)
+	if _, err = io.ReadFull(r, typBuf); err != nil {
+		return
+	}
+	e.Typ = encoding.DecodeType(typBuf)
+	n = 1 + 2 + int64(encoding.TypeSize)
+	hasVal := int8(0)
+	if err = binary.Read(r, binary.BigEndian, &hasVal); err != nil {
+		return
+	}
+	n += 1
+	var sn int64
+	if hasVal == 1 {
+		var val string
+		if val, sn, err = common.ReadString(r); err != nil {
+			return
+		}
+		n += sn
+		e.Val = []byte(val)
+	}
+	argCnt := uint16(0)
+	if err = binary.Read(r, binary.BigEndian, &argCnt); err != nil {
+		return
+	}
+	n += 2
+	e.Args = make([]*CheckExpr, argCnt)
+	for i := range e.Args {
+		e.Args[i] = new(CheckExpr)
+		if sn, err = e.Args[i].ReadFrom(r); err != nil {
+			return
+		}
+		n += sn
+	}
+	return
+}
@@ -22,7 +22,11 @@ var (
 	ErrCheckpoint   = errors.New("tae catalog: checkpoint")
 	ErrNotPermitted = errors.New("tae catalog: operation not permitted")
 
+	ErrSchemaChanged = errors.New("tae catalog: schema changed")
+
 	ErrValidation = errors.New("tae catalog: validataion")
 
+	ErrNoPartition = errors.New("tae catalog: no partition for value")
+
 	ErrStopCurrRecur = errors.New("tae catalog: stop current recursion")
 )
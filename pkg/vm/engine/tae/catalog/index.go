@@ -0,0 +1,165 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/wal"
+)
+
+// IndexEntry is the txn entry of creating or dropping a secondary index.
+// The schema of the table is only changed when the txn commits.
+type IndexEntry struct {
+	sync.RWMutex
+	table *TableEntry
+	txn   txnif.AsyncTxn
+	index *IndexInfo
+	op    OpT
+}
+
+func newIndexEntry(table *TableEntry, txn txnif.AsyncTxn, index *IndexInfo, op OpT) *IndexEntry {
+	return &IndexEntry{
+		table: table,
+		txn:   txn,
+		index: index,
+		op:    op,
+	}
+}
+
+// GetIndex returns the index created or dropped by the entry.
+func (e *IndexEntry) GetIndex() *IndexInfo { return e.index }
+
+// IsDrop returns true if the entry drops the index.
+func (e *IndexEntry) IsDrop() bool { return e.op == OpSoftDelete }
+
+// CreateIndexEntry creates a secondary index on the table when txn commits.
+// The blocks flushed after get its indexes when flushed, the blocks flushed
+// before are backfilled by the db in the background.
+func (entry *TableEntry) CreateIndexEntry(index *IndexInfo, txn txnif.AsyncTxn) (created *IndexEntry, err error) {
+	entry.RLock()
+	defer entry.RUnlock()
+	if entry.schema.GetIndex(index.Name) != nil {
+		err = ErrDuplicate
+		return
+	}
+	for _, col := range index.Columns {
+		if int(col) >= len(entry.schema.ColDefs) {
+			err = ErrNotFound
+			return
+		}
+	}
+	created = newIndexEntry(entry, txn, index, OpCreate)
+	return
+}
+
+// DropIndexEntry drops the secondary index named name when txn commits.
+func (entry *TableEntry) DropIndexEntry(name string, txn txnif.AsyncTxn) (deleted *IndexEntry, err error) {
+	entry.RLock()
+	defer entry.RUnlock()
+	index := entry.schema.GetIndex(name)
+	if index == nil {
+		err = ErrNotFound
+		return
+	}
+	deleted = newIndexEntry(entry, txn, index, OpSoftDelete)
+	return
+}
+
+// GetIndexes returns the secondary indexes of the table.
+func (entry *TableEntry) GetIndexes() []*IndexInfo {
+	entry.RLock()
+	defer entry.RUnlock()
+	return entry.schema.Indexes
+}
+
+// applyIndexChangeLocked adds or removes a secondary index. The index slice
+// is copied on write so that the slices returned by GetIndexes never change.
+func (entry *TableEntry) applyIndexChangeLocked(index *IndexInfo, op OpT) {
+	indexes := make([]*IndexInfo, 0, len(entry.schema.Indexes)+1)
+	for _, existed := range entry.schema.Indexes {
+		if existed.Name != index.Name {
+			indexes = append(indexes, existed)
+		}
+	}
+	if op == OpCreate {
+		indexes = append(indexes, index)
+	}
+	entry.schema.Indexes = indexes
+}
+
+func (e *IndexEntry) PrepareCommit() error {
+	e.table.RLock()
+	defer e.table.RUnlock()
+	existed := e.table.schema.GetIndex(e.index.Name)
+	if e.op == OpCreate {
+		if existed != nil {
+			return ErrDuplicate
+		}
+		for _, index := range e.table.schema.Indexes {
+			if index.Id >= e.index.Id {
+				e.index.Id = index.Id + 1
+			}
+		}
+	} else if existed == nil {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (e *IndexEntry) ApplyCommit(_ *wal.Index) error {
+	e.table.Lock()
+	defer e.table.Unlock()
+	e.table.applyIndexChangeLocked(e.index, e.op)
+	return nil
+}
+
+func (e *IndexEntry) PrepareRollback() error { return nil }
+func (e *IndexEntry) ApplyRollback() error   { return nil }
+
+func (e *IndexEntry) MakeCommand(id uint32) (txnif.TxnCmd, error) {
+	cmdType := CmdCreateIndex
+	if e.op == OpSoftDelete {
+		cmdType = CmdDropIndex
+	}
+	return newIndexCmd(id, cmdType, e.table, e.index), nil
+}
+
+func (catalog *Catalog) onReplayIndex(cmd *EntryCommand) {
+	db, err := catalog.GetDatabaseByID(cmd.DBID)
+	if err != nil {
+		panic(err)
+	}
+	tbl, err := db.GetTableEntryByID(cmd.TableID)
+	if err != nil {
+		panic(err)
+	}
+	op := OpCreate
+	if cmd.GetType() == CmdDropIndex {
+		op = OpSoftDelete
+	}
+	tbl.Lock()
+	defer tbl.Unlock()
+	tbl.applyIndexChangeLocked(cmd.Index, op)
+}
+
+// GetIndexedColumns returns the columns covered by the secondary indexes of
+// the table, see Schema.IndexedColumns.
+func (entry *TableEntry) GetIndexedColumns() []int {
+	entry.RLock()
+	defer entry.RUnlock()
+	return entry.schema.IndexedColumns()
+}
@@ -0,0 +1,445 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+)
+
+// PartitionMethod is how the rows of a table are mapped to its partitions
+type PartitionMethod uint8
+
+const (
+	PartitionNone PartitionMethod = iota
+	// PartitionRange maps a row to the first partition whose Bound is
+	// greater than the value of the partition column
+	PartitionRange
+	// PartitionList maps a row to the partition listing the value of the
+	// partition column
+	PartitionList
+	// PartitionHash maps a row to the partition of the value of the
+	// partition column modulo the number of partitions
+	PartitionHash
+)
+
+func (m PartitionMethod) String() string {
+	switch m {
+	case PartitionRange:
+		return "range"
+	case PartitionList:
+		return "list"
+	case PartitionHash:
+		return "hash"
+	}
+	return "none"
+}
+
+// PartitionDef is a partition of a table. Bound is the exclusive upper
+// bound of a range partition, nil is MAXVALUE. Values are the values of a
+// list partition, a nil one is NULL. The values are of the type of the
+// partition column, []byte for char and varchar.
+type PartitionDef struct {
+	ID     uint32
+	Name   string
+	Bound  interface{}
+	Values []interface{}
+}
+
+// PartitionInfo is the partitioning of a table. Each partition is stored
+// in its own segments, identified by the ID of the partition, so that a
+// partition can be dropped or truncated as a whole and the readers can
+// skip the partitions a query does not need. IDs start from 1 and are
+// never reused, 0 is the ID of the segments of an unpartitioned table.
+type PartitionInfo struct {
+	Method     PartitionMethod
+	SeqNum     uint16
+	Typ        types.Type
+	Partitions []*PartitionDef
+	NextID     uint32
+}
+
+// IsPartitionType returns true if a column of typ can be the partition
+// column of a table partitioned by method.
+func IsPartitionType(method PartitionMethod, typ types.Type) bool {
+	switch typ.Oid {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
+		return true
+	case types.T_date, types.T_datetime, types.T_char, types.T_varchar:
+		return method != PartitionHash
+	}
+	return false
+}
+
+// SetPartition partitions the table by the column col. parts are the range
+// or list partitions, only their names are used for hash.
+func (s *Schema) SetPartition(method PartitionMethod, col string, parts []*PartitionDef) error {
+	idx := s.GetColIdx(col)
+	if idx < 0 {
+		return fmt.Errorf("%w: partition column %s", ErrNotFound, col)
+	}
+	colDef := s.ColDefs[idx]
+	if method == PartitionNone || !IsPartitionType(method, colDef.Type) {
+		return fmt.Errorf("%w: cannot partition by %s on column %s", ErrValidation, method, col)
+	}
+	if len(parts) == 0 {
+		return fmt.Errorf("%w: no partitions", ErrValidation)
+	}
+	info := &PartitionInfo{
+		Method: method,
+		SeqNum: colDef.SeqNum,
+		Typ:    colDef.Type,
+		NextID: 1,
+	}
+	if err := info.add(parts); err != nil {
+		return err
+	}
+	s.Partition = info
+	return nil
+}
+
+// GetPartitionColumnDef returns the partition column, or nil if the table
+// is not partitioned
+func (s *Schema) GetPartitionColumnDef() *ColDef {
+	if s.Partition == nil {
+		return nil
+	}
+	idx := s.GetColIdxBySeqNum(s.Partition.SeqNum)
+	if idx < 0 {
+		return nil
+	}
+	return s.ColDefs[idx]
+}
+
+// IsPartitioned returns true if the table is partitioned
+func (s *Schema) IsPartitioned() bool {
+	return s.Partition != nil
+}
+
+// add appends parts with new IDs. A range partition must be above the last
+// one and a list partition cannot list a value listed by another one.
+func (info *PartitionInfo) add(parts []*PartitionDef) error {
+	for _, part := range parts {
+		if part.Name == "" || info.GetByName(part.Name) != nil {
+			return fmt.Errorf("%w: partition %q", ErrDuplicate, part.Name)
+		}
+		switch info.Method {
+		case PartitionRange:
+			if n := len(info.Partitions); n > 0 {
+				last := info.Partitions[n-1].Bound
+				if last == nil || (part.Bound != nil && common.CompareGeneric(part.Bound, last, info.Typ) <= 0) {
+					return fmt.Errorf("%w: partition %s must be above the last partition", ErrValidation, part.Name)
+				}
+			}
+		case PartitionList:
+			if len(part.Values) == 0 {
+				return fmt.Errorf("%w: list partition %s has no values", ErrValidation, part.Name)
+			}
+			for i, v := range part.Values {
+				if info.locateList(v) != nil {
+					return fmt.Errorf("%w: partition %s lists a value of another partition", ErrDuplicate, part.Name)
+				}
+				for _, prev := range part.Values[:i] {
+					if info.equal(prev, v) {
+						return fmt.Errorf("%w: partition %s lists a value twice", ErrDuplicate, part.Name)
+					}
+				}
+			}
+		}
+		def := &PartitionDef{
+			ID:     info.NextID,
+			Name:   part.Name,
+			Bound:  part.Bound,
+			Values: part.Values,
+		}
+		info.NextID++
+		info.Partitions = append(info.Partitions, def)
+	}
+	return nil
+}
+
+func (info *PartitionInfo) drop(names []string) error {
+	if info.Method == PartitionHash {
+		return fmt.Errorf("%w: cannot drop a hash partition", ErrNotPermitted)
+	}
+	dropped := make(map[string]bool, len(names))
+	for _, name := range names {
+		if info.GetByName(name) == nil {
+			return fmt.Errorf("%w: partition %s", ErrNotFound, name)
+		}
+		dropped[name] = true
+	}
+	if len(dropped) == len(info.Partitions) {
+		return fmt.Errorf("%w: cannot drop all the partitions", ErrNotPermitted)
+	}
+	parts := info.Partitions[:0]
+	for _, part := range info.Partitions {
+		if !dropped[part.Name] {
+			parts = append(parts, part)
+		}
+	}
+	info.Partitions = parts
+	return nil
+}
+
+func (info *PartitionInfo) clone() *PartitionInfo {
+	cloned := *info
+	cloned.Partitions = make([]*PartitionDef, len(info.Partitions))
+	for i, part := range info.Partitions {
+		def := *part
+		cloned.Partitions[i] = &def
+	}
+	return &cloned
+}
+
+// GetByName returns the partition named name, or nil if not found
+func (info *PartitionInfo) GetByName(name string) *PartitionDef {
+	for _, part := range info.Partitions {
+		if part.Name == name {
+			return part
+		}
+	}
+	return nil
+}
+
+// GetByID returns the partition of id, or nil if it has been dropped
+func (info *PartitionInfo) GetByID(id uint32) *PartitionDef {
+	for _, part := range info.Partitions {
+		if part.ID == id {
+			return part
+		}
+	}
+	return nil
+}
+
+func (info *PartitionInfo) equal(a, b interface{}) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return common.CompareGeneric(a, b, info.Typ) == 0
+}
+
+func (info *PartitionInfo) locateList(v interface{}) *PartitionDef {
+	for _, part := range info.Partitions {
+		for _, val := range part.Values {
+			if info.equal(val, v) {
+				return part
+			}
+		}
+	}
+	return nil
+}
+
+// Locate returns the partition of a row whose value of the partition
+// column is v, nil for NULL. NULL is below any value of a range partition
+// and hashes to the first partition, as in MySQL. It returns
+// ErrNoPartition if no partition takes v.
+func (info *PartitionInfo) Locate(v interface{}) (*PartitionDef, error) {
+	switch info.Method {
+	case PartitionRange:
+		for _, part := range info.Partitions {
+			if v == nil || part.Bound == nil || common.CompareGeneric(v, part.Bound, info.Typ) < 0 {
+				return part, nil
+			}
+		}
+	case PartitionList:
+		if part := info.locateList(v); part != nil {
+			return part, nil
+		}
+	case PartitionHash:
+		if v == nil {
+			return info.Partitions[0], nil
+		}
+		return info.Partitions[hashMod(v, uint64(len(info.Partitions)))], nil
+	}
+	return nil, fmt.Errorf("%w: %v", ErrNoPartition, v)
+}
+
+// hashMod returns the non-negative remainder of the integer v divided by n
+func hashMod(v interface{}, n uint64) uint64 {
+	var i int64
+	switch val := v.(type) {
+	case int8:
+		i = int64(val)
+	case int16:
+		i = int64(val)
+	case int32:
+		i = int64(val)
+	case int64:
+		i = val
+	case uint8:
+		return uint64(val) % n
+	case uint16:
+		return uint64(val) % n
+	case uint32:
+		return uint64(val) % n
+	case uint64:
+		return val % n
+	default:
+		panic("not supported")
+	}
+	mod := i % int64(n)
+	if mod < 0 {
+		mod += int64(n)
+	}
+	return uint64(mod)
+}
+
+func (info *PartitionInfo) writeValue(v interface{}, w io.Writer) (n int64, err error) {
+	hasVal := int8(0)
+	if v != nil {
+		hasVal = 1
+	}
+	if err = binary.Write(w, binary.BigEndian, hasVal); err != nil {
+		return
+	}
+	n = 1
+	if hasVal == 0 {
+		return
+	}
+	var key []byte
+	if key, err = common.EncodeKey(v, info.Typ); err != nil {
+		return
+	}
+	var sn int64
+	if sn, err = common.WriteString(string(key), w); err != nil {
+		return
+	}
+	n += sn
+	return
+}
+
+func (info *PartitionInfo) readValue(r io.Reader) (v interface{}, n int64, err error) {
+	hasVal := int8(0)
+	if err = binary.Read(r, binary.BigEndian, &hasVal); err != nil {
+		return
+	}
+	n = 1
+	if hasVal == 0 {
+		return
+	}
+	var key string
+	var sn int64
+	if key, sn, err = common.ReadString(r); err != nil {
+		return
+	}
+	n += sn
+	v = common.DecodeKey([]byte(key), info.Typ)
+	return
+}
+
+func (info *PartitionInfo) WriteTo(w io.Writer) (n int64, err error) {
+	if err = binary.Write(w, binary.BigEndian, info.Method); err != nil {
+		return
+	}
+	if err = binary.Write(w, binary.BigEndian, info.SeqNum); err != nil {
+		return
+	}
+	if _, err = w.Write(encoding.EncodeType(info.Typ)); err != nil {
+		return
+	}
+	if err = binary.Write(w, binary.BigEndian, info.NextID); err != nil {
+		return
+	}
+	if err = binary.Write(w, binary.BigEndian, uint16(len(info.Partitions))); err != nil {
+		return
+	}
+	n = 1 + 2 + int64(encoding.TypeSize) + 4 + 2
+	var sn int64
+	for _, part := range info.Partitions {
+		if err = binary.Write(w, binary.BigEndian, part.ID); err != nil {
+			return
+		}
+		n += 4
+		if sn, err = common.WriteString(part.Name, w); err != nil {
+			return
+		}
+		n += sn
+		if sn, err = info.writeValue(part.Bound, w); err != nil {
+			return
+		}
+		n += sn
+		if err = binary.Write(w, binary.BigEndian, uint16(len(part.Values))); err != nil {
+			return
+		}
+		n += 2
+		for _, v := range part.Values {
+			if sn, err = info.writeValue(v, w); err != nil {
+				return
+			}
+			n += sn
+		}
+	}
+	return
+}
+
+func (info *PartitionInfo) ReadFrom(r io.Reader) (n int64, err error) {
+	if err = binary.Read(r, binary.BigEndian, &info.Method); err != nil {
+		return
+	}
+	if err = binary.Read(r, binary.BigEndian, &info.SeqNum); err != nil {
+		return
+	}
+	typBuf := make([]byte, encoding.TypeSize)
+	if _, err = io.ReadFull(r, typBuf); err != nil {
+		return
+	}
+	info.Typ = encoding.DecodeType(typBuf)
+	if err = binary.Read(r, binary.BigEndian, &info.NextID); err != nil {
+		return
+	}
+	cnt := uint16(0)
+	if err = binary.Read(r, binary.BigEndian, &cnt); err != nil {
+		return
+	}
+	n = 1 + 2 + int64(encoding.TypeSize) + 4 + 2
+	var sn int64
+	for i := uint16(0); i < cnt; i++ {
+		part := new(PartitionDef)
+		if err = binary.Read(r, binary.BigEndian, &part.ID); err != nil {
+			return
+		}
+		n += 4
+		if part.Name, sn, err = common.ReadString(r); err != nil {
+			return
+		}
+		n += sn
+		if part.Bound, sn, err = info.readValue(r); err != nil {
+			return
+		}
+		n += sn
+		valCnt := uint16(0)
+		if err = binary.Read(r, binary.BigEndian, &valCnt); err != nil {
+			return
+		}
+		n += 2
+		for j := uint16(0); j < valCnt; j++ {
+			var v interface{}
+			if v, sn, err = info.readValue(r); err != nil {
+				return
+			}
+			n += sn
+			part.Values = append(part.Values, v)
+		}
+		info.Partitions = append(info.Partitions, part)
+	}
+	return
+}
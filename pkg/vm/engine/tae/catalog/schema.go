@@ -21,8 +21,10 @@ import (
 	"fmt"
 	"io"
 	"math/rand"
+	"sort"
 	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/encoding"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
@@ -32,8 +34,16 @@ type IndexT uint16
 
 const (
 	ZoneMap IndexT = iota
+	// Unique is a zonemap index whose column values are unique, except
+	// NULL. The values are deduplicated with the indexes of the blocks like
+	// the primary key.
+	Unique
 )
 
+// IndexInfo is a secondary index of a table. It only prunes blocks: the
+// non-appendable blocks have a zonemap and a static filter of the indexed
+// columns that tell a lookup the block can not contain a value, there is no
+// map from a value to its rows. Appendable blocks are never pruned.
 type IndexInfo struct {
 	Id      uint64
 	Name    string
@@ -53,14 +63,70 @@ func NewIndexInfo(name string, typ IndexT, colIdx ...int) *IndexInfo {
 	return index
 }
 
+func (index *IndexInfo) WriteTo(w io.Writer) (n int64, err error) {
+	if err = binary.Write(w, binary.BigEndian, index.Id); err != nil {
+		return
+	}
+	if err = binary.Write(w, binary.BigEndian, index.Type); err != nil {
+		return
+	}
+	var sn int64
+	if sn, err = common.WriteString(index.Name, w); err != nil {
+		return
+	}
+	n = sn + 8 + 2
+	if err = binary.Write(w, binary.BigEndian, uint16(len(index.Columns))); err != nil {
+		return
+	}
+	if err = binary.Write(w, binary.BigEndian, index.Columns); err != nil {
+		return
+	}
+	n += 2 + 2*int64(len(index.Columns))
+	return
+}
+
+func (index *IndexInfo) ReadFrom(r io.Reader) (n int64, err error) {
+	if err = binary.Read(r, binary.BigEndian, &index.Id); err != nil {
+		return
+	}
+	if err = binary.Read(r, binary.BigEndian, &index.Type); err != nil {
+		return
+	}
+	var sn int64
+	if index.Name, sn, err = common.ReadString(r); err != nil {
+		return
+	}
+	n = sn + 8 + 2
+	colCnt := uint16(0)
+	if err = binary.Read(r, binary.BigEndian, &colCnt); err != nil {
+		return
+	}
+	index.Columns = make([]uint16, colCnt)
+	if err = binary.Read(r, binary.BigEndian, index.Columns); err != nil {
+		return
+	}
+	n += 2 + 2*int64(colCnt)
+	return
+}
+
 type ColDef struct {
 	Name          string
 	Idx           int
 	Type          types.Type
 	Hidden        int8
-	NullAbility   int8
+	NullAbility   int8 // 1 if the column is NOT NULL
 	AutoIncrement int8
 	Comment       string
+	// SeqNum identifies the column across schema versions. It is assigned
+	// when the column is added and never reused, so that blocks written with
+	// an older schema can still be mapped to the latest one.
+	SeqNum uint16
+	// Default is the encoded value read for rows written before the column
+	// was added. nil means NULL.
+	Default []byte
+	// Compress is the compress algorithm of the column data in the blocks
+	// written later. See pkg/compress for the algorithms.
+	Compress uint8
 }
 
 type Schema struct {
@@ -71,8 +137,39 @@ type Schema struct {
 	PrimaryKey       int32          `json:"primarykey"`
 	SegmentMaxBlocks uint16         `json:"segblocks"`
 	Comment          string         `json:"comment"`
+	Indexes          []*IndexInfo   `json:"indexes"`
+	Version          uint32         `json:"version"`
+	ColSeqNum        uint16         `json:"colseqnum"`
+	// AutoIncrement is the first value of the auto increment column, 0 means 1
+	AutoIncrement uint64            `json:"autoincrement"`
+	Checks        []*CheckInfo      `json:"checks"`
+	ForeignKeys   []*ForeignKeyInfo `json:"fks"`
+	// MergePolicy overrides the merge policy of the DB for the table, see
+	// checkpoint.NewMergePolicy for the format. Empty means the DB one.
+	MergePolicy string `json:"mergepolicy"`
+	// TTL is how long the rows live by the date or datetime column of
+	// TTLSeqNum, see SetTTL. 0 means the rows never expire.
+	TTL       time.Duration `json:"ttl"`
+	TTLSeqNum uint16        `json:"ttlseqnum"`
+	// Partition is the partitioning of the table, nil if not partitioned
+	Partition *PartitionInfo `json:"partition"`
 }
 
+// The schema format versions. SchemaFormatV1 is the format before the
+// version was written, it has no column sequence numbers, compression,
+// indexes, constraints, TTL nor partitioning. Later formats start with
+// schemaMagic followed by the version.
+const (
+	SchemaFormatV1 uint16 = iota + 1
+	SchemaFormatV2
+
+	SchemaFormat = SchemaFormatV2
+)
+
+// schemaMagic takes the place of BlockMaxRows, which was the first field of
+// SchemaFormatV1 and never that large.
+const schemaMagic = uint32(0xffffffff)
+
 func NewEmptySchema(name string) *Schema {
 	return &Schema{
 		Name:      name,
@@ -85,6 +182,20 @@ func (s *Schema) ReadFrom(r io.Reader) (n int64, err error) {
 	if err = binary.Read(r, binary.BigEndian, &s.BlockMaxRows); err != nil {
 		return
 	}
+	format := SchemaFormatV1
+	if s.BlockMaxRows == schemaMagic {
+		if err = binary.Read(r, binary.BigEndian, &format); err != nil {
+			return
+		}
+		if format > SchemaFormat {
+			err = fmt.Errorf("%w: unknown schema format %d", ErrValidation, format)
+			return
+		}
+		if err = binary.Read(r, binary.BigEndian, &s.BlockMaxRows); err != nil {
+			return
+		}
+		n += 4 + 2
+	}
 	if err = binary.Read(r, binary.BigEndian, &s.PrimaryKey); err != nil {
 		return
 	}
@@ -95,15 +206,45 @@ func (s *Schema) ReadFrom(r io.Reader) (n int64, err error) {
 	if s.Name, sn, err = common.ReadString(r); err != nil {
 		return
 	}
-	n = sn + 4 + 4 + 4 + 2
+	n += sn + 4 + 4 + 4 + 2
 	if s.Comment, sn, err = common.ReadString(r); err != nil {
 		return
 	}
 	n += sn
+	if format == SchemaFormatV1 {
+		var cn int64
+		cn, err = s.readColDefsV1(r)
+		n += cn
+		return
+	}
+	if err = binary.Read(r, binary.BigEndian, &s.Version); err != nil {
+		return
+	}
+	if err = binary.Read(r, binary.BigEndian, &s.ColSeqNum); err != nil {
+		return
+	}
+	if err = binary.Read(r, binary.BigEndian, &s.AutoIncrement); err != nil {
+		return
+	}
+	n += 4 + 2 + 8
+	if s.MergePolicy, sn, err = common.ReadString(r); err != nil {
+		return
+	}
+	n += sn
+	if err = binary.Read(r, binary.BigEndian, &s.TTL); err != nil {
+		return
+	}
+	if err = binary.Read(r, binary.BigEndian, &s.TTLSeqNum); err != nil {
+		return
+	}
+	n += 8 + 2
 	colCnt := uint16(0)
 	if err = binary.Read(r, binary.BigEndian, &colCnt); err != nil {
 		return
 	}
+	if s.NameIndex == nil {
+		s.NameIndex = make(map[string]int)
+	}
 	colBuf := make([]byte, encoding.TypeSize)
 	for i := uint16(0); i < colCnt; i++ {
 		if _, err = r.Read(colBuf); err != nil {
@@ -132,14 +273,141 @@ func (s *Schema) ReadFrom(r io.Reader) (n int64, err error) {
 			return
 		}
 		n += 1
+		if err = binary.Read(r, binary.BigEndian, &colDef.SeqNum); err != nil {
+			return
+		}
+		n += 2
+		if err = binary.Read(r, binary.BigEndian, &colDef.Compress); err != nil {
+			return
+		}
+		n += 1
+		hasDefault := int8(0)
+		if err = binary.Read(r, binary.BigEndian, &hasDefault); err != nil {
+			return
+		}
+		n += 1
+		if hasDefault == 1 {
+			var def string
+			if def, sn, err = common.ReadString(r); err != nil {
+				return
+			}
+			n += sn
+			colDef.Default = []byte(def)
+		}
 		s.ColDefs = append(s.ColDefs, colDef)
 		colDef.Idx = int(i)
+		s.NameIndex[colDef.Name] = colDef.Idx
+	}
+	idxCnt := uint16(0)
+	if err = binary.Read(r, binary.BigEndian, &idxCnt); err != nil {
+		return
+	}
+	n += 2
+	for i := uint16(0); i < idxCnt; i++ {
+		index := new(IndexInfo)
+		if sn, err = index.ReadFrom(r); err != nil {
+			return
+		}
+		n += sn
+		s.Indexes = append(s.Indexes, index)
+	}
+	checkCnt := uint16(0)
+	if err = binary.Read(r, binary.BigEndian, &checkCnt); err != nil {
+		return
+	}
+	n += 2
+	for i := uint16(0); i < checkCnt; i++ {
+		check := new(CheckInfo)
+		if sn, err = check.ReadFrom(r); err != nil {
+			return
+		}
+		n += sn
+		s.Checks = append(s.Checks, check)
+	}
+	fkCnt := uint16(0)
+	if err = binary.Read(r, binary.BigEndian, &fkCnt); err != nil {
+		return
+	}
+	n += 2
+	for i := uint16(0); i < fkCnt; i++ {
+		fk := new(ForeignKeyInfo)
+		if sn, err = fk.ReadFrom(r); err != nil {
+			return
+		}
+		n += sn
+		s.ForeignKeys = append(s.ForeignKeys, fk)
+	}
+	hasPartition := int8(0)
+	if err = binary.Read(r, binary.BigEndian, &hasPartition); err != nil {
+		return
+	}
+	n += 1
+	if hasPartition == 1 {
+		s.Partition = new(PartitionInfo)
+		if sn, err = s.Partition.ReadFrom(r); err != nil {
+			return
+		}
+		n += sn
+	}
+	return
+}
+
+// readColDefsV1 reads the columns of SchemaFormatV1, the data of the blocks
+// was always compressed with lz4.
+func (s *Schema) readColDefsV1(r io.Reader) (n int64, err error) {
+	colCnt := uint16(0)
+	if err = binary.Read(r, binary.BigEndian, &colCnt); err != nil {
+		return
+	}
+	n += 2
+	if s.NameIndex == nil {
+		s.NameIndex = make(map[string]int)
+	}
+	colBuf := make([]byte, encoding.TypeSize)
+	var sn int64
+	for i := uint16(0); i < colCnt; i++ {
+		if _, err = r.Read(colBuf); err != nil {
+			return
+		}
+		n += int64(encoding.TypeSize)
+		colDef := new(ColDef)
+		colDef.Type = encoding.DecodeType(colBuf)
+		if colDef.Name, sn, err = common.ReadString(r); err != nil {
+			return
+		}
+		n += sn
+		if colDef.Comment, sn, err = common.ReadString(r); err != nil {
+			return
+		}
+		n += sn
+		if err = binary.Read(r, binary.BigEndian, &colDef.NullAbility); err != nil {
+			return
+		}
+		if err = binary.Read(r, binary.BigEndian, &colDef.Hidden); err != nil {
+			return
+		}
+		if err = binary.Read(r, binary.BigEndian, &colDef.AutoIncrement); err != nil {
+			return
+		}
+		n += 3
+		colDef.Idx = int(i)
+		colDef.SeqNum = i
+		colDef.Compress = compress.Lz4
+		s.ColDefs = append(s.ColDefs, colDef)
+		s.NameIndex[colDef.Name] = colDef.Idx
 	}
+	s.ColSeqNum = colCnt
 	return
 }
 
 func (s *Schema) Marshal() (buf []byte, err error) {
 	var w bytes.Buffer
+	if err = binary.Write(&w, binary.BigEndian, schemaMagic); err != nil {
+		return
+	}
+	if err = binary.Write(&w, binary.BigEndian, SchemaFormat); err != nil {
+		return
+	}
 	if err = binary.Write(&w, binary.BigEndian, s.BlockMaxRows); err != nil {
 		return
 	}
@@ -155,6 +423,24 @@ func (s *Schema) Marshal() (buf []byte, err error) {
 	if _, err = common.WriteString(s.Comment, &w); err != nil {
 		return
 	}
+	if err = binary.Write(&w, binary.BigEndian, s.Version); err != nil {
+		return
+	}
+	if err = binary.Write(&w, binary.BigEndian, s.ColSeqNum); err != nil {
+		return
+	}
+	if err = binary.Write(&w, binary.BigEndian, s.AutoIncrement); err != nil {
+		return
+	}
+	if _, err = common.WriteString(s.MergePolicy, &w); err != nil {
+		return
+	}
+	if err = binary.Write(&w, binary.BigEndian, s.TTL); err != nil {
+		return
+	}
+	if err = binary.Write(&w, binary.BigEndian, s.TTLSeqNum); err != nil {
+		return
+	}
 	if err = binary.Write(&w, binary.BigEndian, uint16(len(s.ColDefs))); err != nil {
 		return
 	}
@@ -177,6 +463,60 @@ func (s *Schema) Marshal() (buf []byte, err error) {
 		if err = binary.Write(&w, binary.BigEndian, colDef.AutoIncrement); err != nil {
 			return
 		}
+		if err = binary.Write(&w, binary.BigEndian, colDef.SeqNum); err != nil {
+			return
+		}
+		if err = binary.Write(&w, binary.BigEndian, colDef.Compress); err != nil {
+			return
+		}
+		hasDefault := int8(0)
+		if colDef.Default != nil {
+			hasDefault = 1
+		}
+		if err = binary.Write(&w, binary.BigEndian, hasDefault); err != nil {
+			return
+		}
+		if hasDefault == 1 {
+			if _, err = common.WriteString(string(colDef.Default), &w); err != nil {
+				return
+			}
+		}
+	}
+	if err = binary.Write(&w, binary.BigEndian, uint16(len(s.Indexes))); err != nil {
+		return
+	}
+	for _, index := range s.Indexes {
+		if _, err = index.WriteTo(&w); err != nil {
+			return
+		}
+	}
+	if err = binary.Write(&w, binary.BigEndian, uint16(len(s.Checks))); err != nil {
+		return
+	}
+	for _, check := range s.Checks {
+		if _, err = check.WriteTo(&w); err != nil {
+			return
+		}
+	}
+	if err = binary.Write(&w, binary.BigEndian, uint16(len(s.ForeignKeys))); err != nil {
+		return
+	}
+	for _, fk := range s.ForeignKeys {
+		if _, err = fk.WriteTo(&w); err != nil {
+			return
+		}
+	}
+	hasPartition := int8(0)
+	if s.Partition != nil {
+		hasPartition = 1
+	}
+	if err = binary.Write(&w, binary.BigEndian, hasPartition); err != nil {
+		return
+	}
+	if hasPartition == 1 {
+		if _, err = s.Partition.WriteTo(&w); err != nil {
+			return
+		}
 	}
 	buf = w.Bytes()
 	return
@@ -184,10 +524,13 @@ func (s *Schema) Marshal() (buf []byte, err error) {
 
 func (s *Schema) AppendCol(name string, typ types.Type) {
 	colDef := &ColDef{
-		Name: name,
-		Type: typ,
-		Idx:  len(s.ColDefs),
+		Name:     name,
+		Type:     typ,
+		Idx:      len(s.ColDefs),
+		SeqNum:   s.ColSeqNum,
+		Compress: compress.Lz4,
 	}
+	s.ColSeqNum++
 	s.ColDefs = append(s.ColDefs, colDef)
 	s.NameIndex[name] = colDef.Idx
 }
@@ -234,19 +577,146 @@ func (s *Schema) Valid() bool {
 	}
 
 	names := make(map[string]bool)
+	autoIncrements := 0
 	for idx, colDef := range s.ColDefs {
 		if idx != colDef.Idx {
 			return false
 		}
+		if colDef.AutoIncrement == 1 {
+			if autoIncrements++; autoIncrements > 1 || !IsAutoIncrementType(colDef.Type) {
+				return false
+			}
+		}
 		_, ok := names[colDef.Name]
 		if ok {
 			return false
 		}
 		names[colDef.Name] = true
 	}
+	for _, index := range s.Indexes {
+		if index.Type == Unique && len(index.Columns) != 1 {
+			return false
+		}
+	}
+	for _, check := range s.Checks {
+		for _, seqNum := range check.Pred.Columns() {
+			if s.GetColIdxBySeqNum(seqNum) < 0 {
+				return false
+			}
+		}
+	}
+	for _, fk := range s.ForeignKeys {
+		if s.GetColIdxBySeqNum(fk.Column) < 0 {
+			return false
+		}
+		if fk.IsSelfRef() && s.GetColIdxBySeqNum(fk.RefColumn) < 0 {
+			return false
+		}
+	}
+	if s.TTL != 0 {
+		if colDef := s.GetTTLColumnDef(); colDef == nil || !IsTTLType(colDef.Type) {
+			return false
+		}
+	}
+	if s.Partition != nil {
+		colDef := s.GetPartitionColumnDef()
+		if colDef == nil || colDef.Type != s.Partition.Typ || len(s.Partition.Partitions) == 0 {
+			return false
+		}
+	}
 	return true
 }
 
+// GetIndex returns the secondary index named name, or nil if not found.
+func (s *Schema) GetIndex(name string) *IndexInfo {
+	for _, index := range s.Indexes {
+		if index.Name == name {
+			return index
+		}
+	}
+	return nil
+}
+
+// IndexedColumns returns the columns covered by secondary indexes and
+// foreign keys except the primary key, which is always indexed.
+func (s *Schema) IndexedColumns() (cols []int) {
+	seen := make(map[uint16]bool)
+	add := func(col uint16) {
+		if seen[col] || s.IsPartOfPK(int(col)) {
+			return
+		}
+		seen[col] = true
+		cols = append(cols, int(col))
+	}
+	for _, index := range s.Indexes {
+		for _, col := range index.Columns {
+			add(col)
+		}
+	}
+	for _, fk := range s.ForeignKeys {
+		add(uint16(s.GetColIdxBySeqNum(fk.Column)))
+	}
+	sort.Ints(cols)
+	return
+}
+
+// UniqueColumns returns the columns of the unique indexes.
+func (s *Schema) UniqueColumns() (cols []int) {
+	for _, index := range s.Indexes {
+		if index.Type == Unique {
+			cols = append(cols, int(index.Columns[0]))
+		}
+	}
+	return
+}
+
+// IsUniqueColumn returns true if the column of idx is the primary key or
+// the column of a unique index.
+func (s *Schema) IsUniqueColumn(idx int) bool {
+	if s.IsPartOfPK(idx) {
+		return true
+	}
+	for _, col := range s.UniqueColumns() {
+		if col == idx {
+			return true
+		}
+	}
+	return false
+}
+
+// HasConstraints returns true if the rows written to the table are checked
+// against NOT NULL, CHECK, UNIQUE or FOREIGN KEY constraints.
+func (s *Schema) HasConstraints() bool {
+	for _, colDef := range s.ColDefs {
+		if colDef.NullAbility == 1 {
+			return true
+		}
+	}
+	return len(s.Checks) > 0 || len(s.ForeignKeys) > 0 || len(s.UniqueColumns()) > 0
+}
+
+// GetAutoIncrementIdx returns the index of the auto increment column if
+// found, otherwise returns -1.
+func (s *Schema) GetAutoIncrementIdx() int {
+	for i, colDef := range s.ColDefs {
+		if colDef.AutoIncrement == 1 {
+			return i
+		}
+	}
+	return -1
+}
+
+// IsAutoIncrementType returns true if a column of type typ can be an auto
+// increment column.
+func IsAutoIncrementType(typ types.Type) bool {
+	switch typ.Oid {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
+		return true
+	}
+	return false
+}
+
 // GetColIdx returns column index for the given column name
 // if found, otherwise returns -1.
 func (s *Schema) GetColIdx(attr string) int {
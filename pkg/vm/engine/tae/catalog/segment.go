@@ -35,6 +35,9 @@ type SegmentEntry struct {
 	link    *common.Link
 	state   EntryState
 	segData data.Segment
+	// partition is the ID of the table partition the segment stores, 0 if
+	// the table is not partitioned
+	partition uint32
 }
 
 func NewSegmentEntry(table *TableEntry, txn txnif.AsyncTxn, state EntryState, dataFactory SegmentDataFactory) *SegmentEntry {
@@ -185,6 +188,11 @@ func (entry *SegmentEntry) IsAppendable() bool {
 	return entry.state == ES_Appendable
 }
 
+// GetPartition returns the ID of the table partition of the segment
+func (entry *SegmentEntry) GetPartition() uint32 {
+	return entry.partition
+}
+
 func (entry *SegmentEntry) GetTable() *TableEntry {
 	return entry.table
 }
@@ -301,7 +309,10 @@ func (entry *SegmentEntry) WriteTo(w io.Writer) (n int64, err error) {
 	if err = binary.Write(w, binary.BigEndian, entry.state); err != nil {
 		return
 	}
-	n = sn + 1
+	if err = binary.Write(w, binary.BigEndian, entry.partition); err != nil {
+		return
+	}
+	n = sn + 1 + 4
 	return
 }
 
@@ -309,8 +320,11 @@ func (entry *SegmentEntry) ReadFrom(r io.Reader) (n int64, err error) {
 	if n, err = entry.BaseEntry.ReadFrom(r); err != nil {
 		return
 	}
-	err = binary.Read(r, binary.BigEndian, &entry.state)
-	n += 1
+	if err = binary.Read(r, binary.BigEndian, &entry.state); err != nil {
+		return
+	}
+	err = binary.Read(r, binary.BigEndian, &entry.partition)
+	n += 1 + 4
 	return
 }
 
@@ -323,6 +337,7 @@ func (entry *SegmentEntry) Clone() CheckpointItem {
 		BaseEntry: entry.BaseEntry.Clone(),
 		state:     entry.state,
 		table:     entry.table,
+		partition: entry.partition,
 	}
 	return cloned
 }
@@ -332,6 +347,7 @@ func (entry *SegmentEntry) CloneCreate() CheckpointItem {
 		BaseEntry: entry.BaseEntry.CloneCreate(),
 		state:     entry.state,
 		table:     entry.table,
+		partition: entry.partition,
 	}
 	return cloned
 }
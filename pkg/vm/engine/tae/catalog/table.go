@@ -15,6 +15,7 @@
 package catalog
 
 import (
+	"encoding/binary"
 	"fmt"
 	"io"
 	"sync"
@@ -33,6 +34,11 @@ type TableEntry struct {
 	entries   map[uint64]*common.DLNode
 	link      *common.Link
 	tableData data.Table
+
+	// schemas are the previous versions of schema, oldest first
+	schemas []*Schema
+	// seq allocates the values of the auto increment column
+	seq *sequence
 }
 
 func NewTableEntry(db *DBEntry, schema *Schema, txnCtx txnif.AsyncTxn, dataFactory TableDataFactory) *TableEntry {
@@ -50,6 +56,7 @@ func NewTableEntry(db *DBEntry, schema *Schema, txnCtx txnif.AsyncTxn, dataFacto
 		schema:  schema,
 		link:    new(common.Link),
 		entries: make(map[uint64]*common.DLNode),
+		seq:     new(sequence),
 	}
 	if dataFactory != nil {
 		e.tableData = dataFactory(e)
@@ -71,6 +78,7 @@ func NewSystemTableEntry(db *DBEntry, id uint64, schema *Schema) *TableEntry {
 		schema:  schema,
 		link:    new(common.Link),
 		entries: make(map[uint64]*common.DLNode),
+		seq:     new(sequence),
 	}
 	var sid uint64
 	if schema.Name == SystemTableSchema.Name {
@@ -92,6 +100,7 @@ func NewReplayTableEntry() *TableEntry {
 		BaseEntry: new(BaseEntry),
 		link:      new(common.Link),
 		entries:   make(map[uint64]*common.DLNode),
+		seq:       new(sequence),
 	}
 	return e
 }
@@ -105,6 +114,7 @@ func MockStaloneTableEntry(id uint64, schema *Schema) *TableEntry {
 		schema:  schema,
 		link:    new(common.Link),
 		entries: make(map[uint64]*common.DLNode),
+		seq:     new(sequence),
 	}
 }
 
@@ -125,9 +135,16 @@ func (entry *TableEntry) MakeSegmentIt(reverse bool) *common.LinkIt {
 }
 
 func (entry *TableEntry) CreateSegment(txn txnif.AsyncTxn, state EntryState, dataFactory SegmentDataFactory) (created *SegmentEntry, err error) {
+	return entry.CreatePartitionSegment(txn, state, 0, dataFactory)
+}
+
+// CreatePartitionSegment creates a segment storing the rows of the table
+// partition of ID partition.
+func (entry *TableEntry) CreatePartitionSegment(txn txnif.AsyncTxn, state EntryState, partition uint32, dataFactory SegmentDataFactory) (created *SegmentEntry, err error) {
 	entry.Lock()
 	defer entry.Unlock()
 	created = NewSegmentEntry(entry, txn, state, dataFactory)
+	created.partition = partition
 	entry.AddEntryLocked(created)
 	return
 }
@@ -218,6 +235,26 @@ func (entry *TableEntry) LastAppendableSegmemt() (seg *SegmentEntry) {
 	return seg
 }
 
+// LastAppendableSegmentOf returns the latest appendable segment of the
+// table partition of ID partition which has not been dropped, or nil.
+func (entry *TableEntry) LastAppendableSegmentOf(partition uint32) (seg *SegmentEntry) {
+	it := entry.MakeSegmentIt(false)
+	for it.Valid() {
+		itSeg := it.Get().GetPayload().(*SegmentEntry)
+		if itSeg.IsAppendable() && itSeg.partition == partition {
+			itSeg.RLock()
+			dropped := itSeg.IsDroppedCommitted() || itSeg.IsDroppedUncommitted()
+			itSeg.RUnlock()
+			if !dropped {
+				seg = itSeg
+				break
+			}
+		}
+		it.Next()
+	}
+	return seg
+}
+
 func (entry *TableEntry) AsCommonID() *common.ID {
 	return &common.ID{
 		TableID: entry.GetID(),
@@ -231,6 +268,7 @@ func (entry *TableEntry) RecurLoop(processor Processor) (err error) {
 		if err = processor.OnSegment(segment); err != nil {
 			if err == ErrStopCurrRecur {
 				err = nil
+				segIt.Next()
 				continue
 			}
 			break
@@ -291,13 +329,28 @@ func (entry *TableEntry) WriteTo(w io.Writer) (n int64, err error) {
 	if n, err = entry.BaseEntry.WriteTo(w); err != nil {
 		return
 	}
-	buf, err := entry.schema.Marshal()
-	if err != nil {
+	schemas := make([]*Schema, 0, len(entry.schemas)+1)
+	schemas = append(schemas, entry.schemas...)
+	schemas = append(schemas, entry.schema)
+	if err = binary.Write(w, binary.BigEndian, uint32(len(schemas))); err != nil {
 		return
 	}
-	sn := int(0)
-	sn, err = w.Write(buf)
-	n += int64(sn)
+	n += 4
+	for _, schema := range schemas {
+		var buf []byte
+		if buf, err = schema.Marshal(); err != nil {
+			return
+		}
+		sn := int(0)
+		if sn, err = w.Write(buf); err != nil {
+			return
+		}
+		n += int64(sn)
+	}
+	if err = binary.Write(w, binary.BigEndian, entry.seq.getLimit()); err != nil {
+		return
+	}
+	n += 8
 	return
 }
 
@@ -305,12 +358,31 @@ func (entry *TableEntry) ReadFrom(r io.Reader) (n int64, err error) {
 	if n, err = entry.BaseEntry.ReadFrom(r); err != nil {
 		return
 	}
-	if entry.schema == nil {
-		entry.schema = NewEmptySchema("")
+	cnt := uint32(0)
+	if err = binary.Read(r, binary.BigEndian, &cnt); err != nil {
+		return
+	}
+	n += 4
+	entry.schemas = nil
+	for i := uint32(0); i < cnt; i++ {
+		schema := NewEmptySchema("")
+		sn := int64(0)
+		if sn, err = schema.ReadFrom(r); err != nil {
+			return
+		}
+		n += sn
+		if i < cnt-1 {
+			entry.schemas = append(entry.schemas, schema)
+		} else {
+			entry.schema = schema
+		}
+	}
+	limit := uint64(0)
+	if err = binary.Read(r, binary.BigEndian, &limit); err != nil {
+		return
 	}
-	sn := int64(0)
-	sn, err = entry.schema.ReadFrom(r)
-	n += sn
+	n += 8
+	entry.seq.replay(limit)
 	return
 }
 
@@ -322,7 +394,9 @@ func (entry *TableEntry) Clone() CheckpointItem {
 	cloned := &TableEntry{
 		BaseEntry: entry.BaseEntry.Clone(),
 		schema:    entry.schema,
+		schemas:   entry.schemas,
 		db:        entry.db,
+		seq:       entry.seq,
 	}
 	return cloned
 }
@@ -331,7 +405,9 @@ func (entry *TableEntry) CloneCreate() CheckpointItem {
 	cloned := &TableEntry{
 		BaseEntry: entry.BaseEntry.CloneCreate(),
 		schema:    entry.schema,
+		schemas:   entry.schemas,
 		db:        entry.db,
+		seq:       entry.seq,
 	}
 	return cloned
 }
@@ -0,0 +1,103 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// IsTTLType returns true if a column of typ can be the TTL column of a table
+func IsTTLType(typ types.Type) bool {
+	return typ.Oid == types.T_date || typ.Oid == types.T_datetime
+}
+
+// ParseTTL parses the spec of the TTL of a table, column:duration, where
+// the duration is a number of days like 90d or a duration like 36h.
+func ParseTTL(spec string) (col string, ttl time.Duration, err error) {
+	pos := strings.LastIndexByte(spec, ':')
+	if pos <= 0 {
+		err = fmt.Errorf("%w: bad ttl %q", ErrValidation, spec)
+		return
+	}
+	col, val := spec[:pos], spec[pos+1:]
+	if days := strings.TrimSuffix(val, "d"); days != val {
+		var n int64
+		if n, err = strconv.ParseInt(days, 10, 64); err == nil {
+			ttl = time.Duration(n) * 24 * time.Hour
+		}
+	} else {
+		ttl, err = time.ParseDuration(val)
+	}
+	if err != nil || ttl <= 0 {
+		err = fmt.Errorf("%w: bad ttl %q", ErrValidation, spec)
+	}
+	return
+}
+
+// SetTTL sets the TTL of the table by spec, see ParseTTL. The rows whose
+// value of the TTL column is older than the TTL are expired, the rows with
+// a null one never expire.
+func (s *Schema) SetTTL(spec string) error {
+	col, ttl, err := ParseTTL(spec)
+	if err != nil {
+		return err
+	}
+	idx := s.GetColIdx(col)
+	if idx < 0 {
+		return fmt.Errorf("%w: ttl column %s", ErrNotFound, col)
+	}
+	if !IsTTLType(s.ColDefs[idx].Type) {
+		return fmt.Errorf("%w: ttl column %s is not a date or datetime", ErrValidation, col)
+	}
+	s.TTL = ttl
+	s.TTLSeqNum = s.ColDefs[idx].SeqNum
+	return nil
+}
+
+// GetTTLColumnDef returns the TTL column, or nil if the table has no TTL
+func (s *Schema) GetTTLColumnDef() *ColDef {
+	if s.TTL == 0 {
+		return nil
+	}
+	idx := s.GetColIdxBySeqNum(s.TTLSeqNum)
+	if idx < 0 {
+		return nil
+	}
+	return s.ColDefs[idx]
+}
+
+// TTLCutoff returns the value of the TTL column, a types.Date or a
+// types.Datetime, the rows with a smaller one of which are expired at now.
+// It returns nil if the table has no TTL.
+func (s *Schema) TTLCutoff(now time.Time) interface{} {
+	colDef := s.GetTTLColumnDef()
+	if colDef == nil {
+		return nil
+	}
+	t := now.Add(-s.TTL)
+	switch colDef.Type.Oid {
+	case types.T_date:
+		return types.FromCalendar(int32(t.Year()), uint8(t.Month()), uint8(t.Day()))
+	case types.T_datetime:
+		return types.FromClock(int32(t.Year()), uint8(t.Month()), uint8(t.Day()),
+			uint8(t.Hour()), uint8(t.Minute()), uint8(t.Second()), uint32(t.Nanosecond()/1000))
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"errors"
+	"fmt"
+	"hash/crc32"
+)
+
+var ErrChecksumMismatch = errors.New("tae: checksum mismatch")
+
+var crc32cTable = crc32.MakeTable(crc32.Castagnoli)
+
+// Checksum returns the CRC32C of the concatenation of bufs
+func Checksum(bufs ...[]byte) uint32 {
+	var crc uint32
+	for _, buf := range bufs {
+		crc = crc32.Update(crc, crc32cTable, buf)
+	}
+	return crc
+}
+
+// CorruptedError is the error of the data found corrupted at Offset of File
+type CorruptedError struct {
+	File   string
+	Offset int64
+	Err    error
+}
+
+func NewCorruptedError(file string, offset int64, err error) *CorruptedError {
+	return &CorruptedError{
+		File:   file,
+		Offset: offset,
+		Err:    err,
+	}
+}
+
+func (e *CorruptedError) Error() string {
+	return fmt.Sprintf("tae: corrupted data in %s at offset %d: %v", e.File, e.Offset, e.Err)
+}
+
+func (e *CorruptedError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,160 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compute
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	gvec "github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+)
+
+// CastValue converts v to a value of type to, which is a type v can be
+// widened to.
+func CastValue(v interface{}, to types.Type) interface{} {
+	switch val := v.(type) {
+	case int8:
+		return castInt(int64(val), to)
+	case int16:
+		return castInt(int64(val), to)
+	case int32:
+		return castInt(int64(val), to)
+	case int64:
+		return castInt(val, to)
+	case uint8:
+		return castUint(uint64(val), to)
+	case uint16:
+		return castUint(uint64(val), to)
+	case uint32:
+		return castUint(uint64(val), to)
+	case uint64:
+		return castUint(val, to)
+	case float32:
+		if to.Oid == types.T_float64 {
+			return float64(val)
+		}
+	case types.Date:
+		if to.Oid == types.T_datetime {
+			return val.ToTime()
+		}
+	}
+	return v
+}
+
+func castInt(v int64, to types.Type) interface{} {
+	switch to.Oid {
+	case types.T_int8:
+		return int8(v)
+	case types.T_int16:
+		return int16(v)
+	case types.T_int32:
+		return int32(v)
+	case types.T_int64:
+		return v
+	case types.T_float32:
+		return float32(v)
+	case types.T_float64:
+		return float64(v)
+	}
+	panic("not supported")
+}
+
+func castUint(v uint64, to types.Type) interface{} {
+	switch to.Oid {
+	case types.T_uint8:
+		return uint8(v)
+	case types.T_uint16:
+		return uint16(v)
+	case types.T_uint32:
+		return uint32(v)
+	case types.T_uint64:
+		return v
+	case types.T_int16, types.T_int32, types.T_int64:
+		return castInt(int64(v), to)
+	case types.T_float32:
+		return float32(v)
+	case types.T_float64:
+		return float64(v)
+	}
+	panic("not supported")
+}
+
+// CastVector returns a vector of type to holding the values of vec.
+func CastVector(vec *gvec.Vector, to types.Type) *gvec.Vector {
+	if vec.Typ.Oid == to.Oid {
+		casted := *vec
+		casted.Typ = to
+		return &casted
+	}
+	casted := gvec.New(to)
+	for row := 0; row < gvec.Length(vec); row++ {
+		AppendValue(casted, CastValue(GetValue(vec, uint32(row)), to))
+	}
+	nulls.Set(casted.Nsp, vec.Nsp)
+	return casted
+}
+
+// MakeDefaultVector returns a vector of rows values of type typ, which are
+// all the value encoded by def or all null if def is nil.
+func MakeDefaultVector(typ types.Type, def []byte, rows int) *gvec.Vector {
+	vec := gvec.New(typ)
+	var v interface{}
+	if def != nil {
+		v = common.DecodeKey(def, typ)
+	} else {
+		v = zeroValue(typ)
+	}
+	for row := 0; row < rows; row++ {
+		AppendValue(vec, v)
+		if def == nil {
+			nulls.Add(vec.Nsp, uint64(row))
+		}
+	}
+	return vec
+}
+
+func zeroValue(typ types.Type) interface{} {
+	switch typ.Oid {
+	case types.T_int8:
+		return int8(0)
+	case types.T_int16:
+		return int16(0)
+	case types.T_int32:
+		return int32(0)
+	case types.T_int64:
+		return int64(0)
+	case types.T_uint8:
+		return uint8(0)
+	case types.T_uint16:
+		return uint16(0)
+	case types.T_uint32:
+		return uint32(0)
+	case types.T_uint64:
+		return uint64(0)
+	case types.T_decimal64:
+		return types.Decimal64(0)
+	case types.T_float32:
+		return float32(0)
+	case types.T_float64:
+		return float64(0)
+	case types.T_date:
+		return types.Date(0)
+	case types.T_datetime:
+		return types.Datetime(0)
+	case types.T_char, types.T_varchar, types.T_json:
+		return []byte{}
+	}
+	panic("not supported")
+}
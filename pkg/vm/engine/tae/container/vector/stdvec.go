@@ -439,6 +439,8 @@ func (v *StdVector) Window(start, end uint32) IVector {
 				np = common.BitMap64Window(v.VMask.Np, int(start), int(end))
 			}
 			vec.VMask = &nulls.Nulls{Np: np}
+		} else {
+			vec.VMask = nulls.Range(v.VMask, uint64(start), uint64(end), &nulls.Nulls{})
 		}
 	} else {
 		vec.VMask = &nulls.Nulls{}
@@ -216,10 +216,10 @@ func (vec *VectorWrapper) WriteTo(w io.Writer) (n int64, err error) {
 	case compress.None:
 		nw, err := w.Write(buf)
 		return int64(nw), err
-	case compress.Lz4:
+	default:
 		nb := lz4.CompressBlockBound(len(buf))
 		tmp := make([]byte, nb)
-		tmp, err = compress.Compress(buf, tmp, compress.Lz4)
+		tmp, err = compress.Compress(buf, tmp, stat.CompressAlgo())
 		if err != nil {
 			return 0, err
 		}
@@ -228,8 +228,6 @@ func (vec *VectorWrapper) WriteTo(w io.Writer) (n int64, err error) {
 			return 0, err
 		}
 		return int64(nw), nil
-	default:
-		panic("invalid compress algorithm")
 	}
 }
 
@@ -264,7 +262,7 @@ func (vec *VectorWrapper) ReadFrom(r io.Reader) (n int64, err error) {
 		vec.Col = v.Col
 		err = vec.Vector.Read(data)
 		return int64(nr), err
-	case compress.Lz4:
+	default:
 		loadSize := uint64(stat.Size())
 		originSize := uint64(stat.OriginSize())
 		tmpNode := common.GPool.Alloc(loadSize)
@@ -274,7 +272,7 @@ func (vec *VectorWrapper) ReadFrom(r io.Reader) (n int64, err error) {
 			return n, err
 		}
 		vec.MNode = common.GPool.Alloc(originSize)
-		_, err = compress.Decompress(tmpNode.Buf[:loadSize], vec.MNode.Buf[:originSize], compress.Lz4)
+		_, err = compress.Decompress(tmpNode.Buf[:loadSize], vec.MNode.Buf[:originSize], stat.CompressAlgo())
 		if err != nil {
 			common.GPool.Free(vec.MNode)
 			return n, err
@@ -288,8 +286,6 @@ func (vec *VectorWrapper) ReadFrom(r io.Reader) (n int64, err error) {
 			common.GPool.Free(vec.MNode)
 		}
 		return int64(nr), err
-	default:
-		panic("not supported")
 	}
 }
 
@@ -313,7 +309,7 @@ func (vec *VectorWrapper) ReadWithBuffer(r io.Reader, compressed *bytes.Buffer,
 			return n, err
 		}
 		return int64(nr), err
-	case compress.Lz4:
+	default:
 		loadSize := stat.Size()
 		originSize := stat.OriginSize()
 		var tmpBuf []byte
@@ -341,7 +337,7 @@ func (vec *VectorWrapper) ReadWithBuffer(r io.Reader, compressed *bytes.Buffer,
 		if err != nil {
 			return n, err
 		}
-		buf, err = compress.Decompress(tmpBuf, buf, compress.Lz4)
+		buf, err = compress.Decompress(tmpBuf, buf, stat.CompressAlgo())
 		if err != nil {
 			return n, err
 		}
@@ -353,8 +349,6 @@ func (vec *VectorWrapper) ReadWithBuffer(r io.Reader, compressed *bytes.Buffer,
 		vec.Col = v.Col
 		err = vec.Vector.Read(buf)
 		return int64(nr), err
-	default:
-		panic("not supported")
 	}
 }
 
@@ -15,12 +15,15 @@
 package mockio
 
 import (
+	"sync"
+
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/file"
 )
 
 type columnBlock struct {
+	mutex sync.RWMutex
 	common.RefHelper
 	block   *blockFile
 	ts      uint64
@@ -44,6 +47,8 @@ func newColumnBlock(block *blockFile, indexCnt int) *columnBlock {
 	return cb
 }
 
+func (cb *columnBlock) SetCompressAlgo(_ int) {}
+
 func (cb *columnBlock) WriteTS(ts uint64) (err error) {
 	cb.ts = ts
 	return
@@ -60,11 +65,14 @@ func (cb *columnBlock) WriteUpdates(buf []byte) (err error) {
 }
 
 func (cb *columnBlock) WriteIndex(idx int, buf []byte) (err error) {
-	if idx >= len(cb.indexes) {
+	cb.mutex.RLock()
+	if idx < 0 || idx >= len(cb.indexes) {
+		cb.mutex.RUnlock()
 		err = file.ErrInvalidParam
 		return
 	}
 	vfile := cb.indexes[idx]
+	cb.mutex.RUnlock()
 	_, err = vfile.Write(buf)
 	return
 }
@@ -82,11 +90,14 @@ func (cb *columnBlock) ReadUpdates(buf []byte) (err error) {
 }
 
 func (cb *columnBlock) ReadIndex(idx int, buf []byte) (err error) {
-	if idx >= len(cb.indexes) {
+	cb.mutex.RLock()
+	if idx < 0 || idx >= len(cb.indexes) {
+		cb.mutex.RUnlock()
 		err = file.ErrInvalidParam
 		return
 	}
 	vfile := cb.indexes[idx]
+	cb.mutex.RUnlock()
 	_, err = vfile.Read(buf)
 	return
 }
@@ -95,12 +106,20 @@ func (cb *columnBlock) GetDataFileStat() (stat common.FileInfo) {
 	return cb.data.stat
 }
 
+// OpenIndexFile opens the index file idx of the column, the index files not
+// reserved when the block was opened are added on demand, as an index may be
+// created on the column later.
 func (cb *columnBlock) OpenIndexFile(idx int) (vfile common.IRWFile, err error) {
-	if idx >= len(cb.indexes) {
+	if idx < 0 {
 		err = file.ErrInvalidParam
 		return
 	}
+	cb.mutex.Lock()
+	for len(cb.indexes) <= idx {
+		cb.indexes = append(cb.indexes, newIndex(cb))
+	}
 	vfile = cb.indexes[idx]
+	cb.mutex.Unlock()
 	vfile.Ref()
 	return
 }
@@ -184,9 +184,9 @@ func (bf *blockFile) LoadIBatch(colTypes []types.Type, maxRow uint32) (bat batch
 			return
 		}
 		vec := vector.NewVector(colTypes[i], uint64(maxRow))
-		if colBlk.data.stat.CompressAlgo() == compress.Lz4 {
+		if algo := colBlk.data.stat.CompressAlgo(); algo != compress.None {
 			decompress := make([]byte, colBlk.data.stat.OriginSize())
-			decompress, err = compress.Decompress(buf, decompress, compress.Lz4)
+			decompress, err = compress.Decompress(buf, decompress, algo)
 			if err != nil {
 				return nil, err
 			}
@@ -223,9 +223,9 @@ func (bf *blockFile) LoadBatch(attrs []string, colTypes []types.Type) (bat *gbat
 			return
 		}
 		vec := gvec.New(colTypes[i])
-		if colBlk.data.stat.CompressAlgo() == compress.Lz4 {
+		if algo := colBlk.data.stat.CompressAlgo(); algo != compress.None {
 			decompress := make([]byte, colBlk.data.stat.OriginSize())
-			decompress, err = compress.Decompress(buf, decompress, compress.Lz4)
+			decompress, err = compress.Decompress(buf, decompress, algo)
 			if err != nil {
 				return nil, err
 			}
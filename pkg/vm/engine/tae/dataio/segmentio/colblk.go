@@ -16,6 +16,7 @@ package segmentio
 
 import (
 	"fmt"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/file"
@@ -32,6 +33,7 @@ type columnBlock struct {
 	updates *updatesFile
 	data    *dataFile
 	col     int
+	algo    int
 }
 
 func newColumnBlock(block *blockFile, indexCnt int, col int) *columnBlock {
@@ -39,6 +41,7 @@ func newColumnBlock(block *blockFile, indexCnt int, col int) *columnBlock {
 		block:   block,
 		indexes: make([]*indexFile, indexCnt),
 		col:     col,
+		algo:    compress.Lz4,
 	}
 	for i := range cb.indexes {
 		cb.indexes[i] = newIndex(cb)
@@ -53,13 +56,23 @@ func newColumnBlock(block *blockFile, indexCnt int, col int) *columnBlock {
 	return cb
 }
 
+func (cb *columnBlock) SetCompressAlgo(algo int) {
+	cb.mutex.Lock()
+	defer cb.mutex.Unlock()
+	cb.algo = algo
+	if cb.data.file != nil {
+		cb.data.file[len(cb.data.file)-1].SetAlgo(uint8(algo))
+	}
+}
+
 func (cb *columnBlock) WriteTS(ts uint64) (err error) {
 	cb.ts = ts
 	if cb.data.file != nil {
 		cb.mutex.Lock()
 		defer cb.mutex.Unlock()
-		cb.data.file = append(cb.data.file,
-			cb.block.seg.GetSegmentFile().NewBlockFile(fmt.Sprintf("%d_%d_%d.blk", cb.col, cb.block.id, ts)))
+		file := cb.block.seg.GetSegmentFile().NewBlockFile(fmt.Sprintf("%d_%d_%d.blk", cb.col, cb.block.id, ts))
+		file.SetAlgo(uint8(cb.algo))
+		cb.data.file = append(cb.data.file, file)
 	}
 	return
 }
@@ -75,11 +88,14 @@ func (cb *columnBlock) WriteUpdates(buf []byte) (err error) {
 }
 
 func (cb *columnBlock) WriteIndex(idx int, buf []byte) (err error) {
-	if idx >= len(cb.indexes) {
+	cb.mutex.RLock()
+	if idx < 0 || idx >= len(cb.indexes) {
+		cb.mutex.RUnlock()
 		err = file.ErrInvalidParam
 		return
 	}
 	vfile := cb.indexes[idx]
+	cb.mutex.RUnlock()
 	_, err = vfile.Write(buf)
 	return
 }
@@ -97,11 +113,14 @@ func (cb *columnBlock) ReadUpdates(buf []byte) (err error) {
 }
 
 func (cb *columnBlock) ReadIndex(idx int, buf []byte) (err error) {
-	if idx >= len(cb.indexes) {
+	cb.mutex.RLock()
+	if idx < 0 || idx >= len(cb.indexes) {
+		cb.mutex.RUnlock()
 		err = file.ErrInvalidParam
 		return
 	}
 	vfile := cb.indexes[idx]
+	cb.mutex.RUnlock()
 	_, err = vfile.Read(buf)
 	return
 }
@@ -110,12 +129,20 @@ func (cb *columnBlock) GetDataFileStat() (stat common.FileInfo) {
 	return cb.data.stat
 }
 
+// OpenIndexFile opens the index file idx of the column, the index files not
+// reserved when the block was opened are added on demand, as an index may be
+// created on the column later.
 func (cb *columnBlock) OpenIndexFile(idx int) (vfile common.IRWFile, err error) {
-	if idx >= len(cb.indexes) {
+	if idx < 0 {
 		err = file.ErrInvalidParam
 		return
 	}
+	cb.mutex.Lock()
+	for len(cb.indexes) <= idx {
+		cb.indexes = append(cb.indexes, newIndex(cb))
+	}
 	vfile = cb.indexes[idx]
+	cb.mutex.Unlock()
 	vfile.Ref()
 	return
 }
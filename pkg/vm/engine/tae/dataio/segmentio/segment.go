@@ -20,6 +20,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/file"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/layout/segment"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/objstore"
 	"sync"
 )
 
@@ -27,6 +28,20 @@ var SegmentFileIOFactory = func(name string, id uint64) file.Segment {
 	return newSegmentFile(name, id)
 }
 
+// NewSegmentFileIOFactory returns a factory of the segment files which are
+// offloaded to store once sealed and read back through cache.
+func NewSegmentFileIOFactory(store objstore.Store, cache *objstore.BlockCache) file.SegmentFileFactory {
+	return func(name string, id uint64) file.Segment {
+		sf := newSegmentFile(name, id)
+		if sf == nil {
+			return nil
+		}
+		sf.store = store
+		sf.cache = cache
+		return sf
+	}
+}
+
 type segmentFile struct {
 	sync.RWMutex
 	common.RefHelper
@@ -35,6 +50,8 @@ type segmentFile struct {
 	blocks map[uint64]*blockFile
 	name   string
 	seg    *segment.Segment
+	store  objstore.Store
+	cache  *objstore.BlockCache
 }
 
 func (sf *segmentFile) RemoveBlock(id uint64) {
@@ -117,3 +134,10 @@ func (sf *segmentFile) GetSegmentFile() *segment.Segment {
 func (sf *segmentFile) Sync() error {
 	return sf.seg.Sync()
 }
+
+func (sf *segmentFile) Offload() error {
+	if sf.store == nil {
+		return nil
+	}
+	return sf.seg.Offload(sf.store, sf.cache)
+}
@@ -19,6 +19,7 @@ import (
 	"testing"
 
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/file"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
 	"github.com/stretchr/testify/assert"
 )
@@ -48,3 +49,34 @@ func TestSegment1(t *testing.T) {
 	t.Log(seg.String())
 	seg.Unref()
 }
+
+func TestIndexFile(t *testing.T) {
+	dir := testutils.InitTestEnv(ModuleName, t)
+	seg := SegmentFileIOFactory(path.Join(dir, "seg"), common.NextGlobalSeqNum())
+	defer seg.Unref()
+	blk, err := seg.OpenBlock(common.NextGlobalSeqNum(), 2, map[int]int{0: 2})
+	assert.Nil(t, err)
+	defer blk.Close()
+
+	col0, err := blk.OpenColumn(0)
+	assert.Nil(t, err)
+	defer col0.Close()
+	assert.Nil(t, col0.WriteIndex(1, []byte("filter")))
+	_, err = col0.OpenIndexFile(-1)
+	assert.Equal(t, file.ErrInvalidParam, err)
+
+	// the index files not reserved are added on demand
+	col1, err := blk.OpenColumn(1)
+	assert.Nil(t, err)
+	defer col1.Close()
+	assert.Equal(t, file.ErrInvalidParam, col1.WriteIndex(0, []byte("zonemap")))
+	idxFile, err := col1.OpenIndexFile(1)
+	assert.Nil(t, err)
+	defer idxFile.Unref()
+	_, err = idxFile.Write([]byte("filter"))
+	assert.Nil(t, err)
+	buf := make([]byte, 6)
+	assert.Nil(t, col1.ReadIndex(1, buf))
+	assert.Equal(t, "filter", string(buf))
+	assert.Nil(t, col1.WriteIndex(0, []byte("zonemap")))
+}
@@ -0,0 +1,718 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	gvec "github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
+)
+
+const (
+	BackupMetaName = "BACKUP"
+
+	backupTableSuffix = ".tbl"
+)
+
+var (
+	ErrBackupDirNotEmpty = errors.New("tae backup: directory not empty")
+	ErrBackupInvalid     = errors.New("tae backup: invalid backup")
+)
+
+type BackupDatabase struct {
+	ID   uint64 `json:"id"`
+	Name string `json:"name"`
+}
+
+// BackupTable is a table of a backup. File holds the schema of the table
+// followed by its rows, block by block.
+type BackupTable struct {
+	DB   uint64 `json:"db"`
+	ID   uint64 `json:"id"`
+	Name string `json:"name"`
+	File string `json:"file"`
+	Rows uint64 `json:"rows"`
+}
+
+// BackupMeta describes a backup, the image of the db seen by a txn
+// started at TS. The tables are ordered so that a table comes after the
+// tables referenced by its foreign keys. BaseTS is the TS of the backup an
+// incremental backup is based on, 0 for a full backup.
+type BackupMeta struct {
+	TS        uint64            `json:"ts"`
+	BaseTS    uint64            `json:"base_ts,omitempty"`
+	Databases []*BackupDatabase `json:"databases"`
+	Tables    []*BackupTable    `json:"tables"`
+}
+
+const (
+	// backupBlockRows is a block whose columns follow its header
+	backupBlockRows uint8 = iota
+	// backupBlockBase is a block of an incremental backup unchanged since
+	// the base backup, its rows are those of the block of the same ID there
+	backupBlockBase
+)
+
+// backupBlockHeader precedes each block in the file of a backup table.
+type backupBlockHeader struct {
+	ID   uint64
+	Kind uint8
+	Rows uint32
+}
+
+// ReadBackupMeta reads the meta of the backup in dir.
+func ReadBackupMeta(dir string) (meta *BackupMeta, err error) {
+	buf, err := ioutil.ReadFile(filepath.Join(dir, BackupMetaName))
+	if err != nil {
+		return
+	}
+	meta = new(BackupMeta)
+	if err = json.Unmarshal(buf, meta); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrBackupInvalid, err)
+	}
+	return
+}
+
+func (meta *BackupMeta) write(dir string) (err error) {
+	buf, err := json.Marshal(meta)
+	if err != nil {
+		return
+	}
+	tmp := filepath.Join(dir, BackupMetaName+".tmp")
+	if err = ioutil.WriteFile(tmp, buf, 0644); err != nil {
+		return
+	}
+	return os.Rename(tmp, filepath.Join(dir, BackupMetaName))
+}
+
+func prepareEmptyDir(dir string) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	if len(files) != 0 {
+		return fmt.Errorf("%w: %s", ErrBackupDirNotEmpty, dir)
+	}
+	return nil
+}
+
+// Backup writes to dir the schemas and the rows of all the user tables as
+// seen by a single txn, while the writes, the compactions and the
+// checkpoints go on. The blocks read by the txn are not gc'ed before it
+// terminates, so the backup is a consistent image at the TS of the meta
+// wherever the rows are stored.
+//
+// If base is not empty, the backup is incremental on the backup in base.
+// The non-appendable blocks that have not changed since its TS are written
+// as references to its blocks instead of their rows.
+func (db *DB) Backup(dir, base string) (meta *BackupMeta, err error) {
+	var baseTables map[uint64]*BackupTable
+	var baseMeta *BackupMeta
+	if base != "" {
+		if baseMeta, err = ReadBackupMeta(base); err != nil {
+			return
+		}
+		baseTables = make(map[uint64]*BackupTable, len(baseMeta.Tables))
+		for _, table := range baseMeta.Tables {
+			baseTables[table.ID] = table
+		}
+	}
+	if err = prepareEmptyDir(dir); err != nil {
+		return
+	}
+	txn, err := db.StartTxn(nil)
+	if err != nil {
+		return
+	}
+	// The txn only reads
+	defer txn.Rollback()
+
+	meta = &BackupMeta{TS: txn.GetStartTS()}
+	if baseMeta != nil {
+		meta.BaseTS = baseMeta.TS
+	}
+	refs := make(map[uint64][]uint64)
+	for _, name := range txn.DatabaseNames() {
+		if name == catalog.SystemDBName {
+			continue
+		}
+		database, err := txn.GetDatabase(name)
+		if err != nil {
+			return nil, err
+		}
+		meta.Databases = append(meta.Databases, &BackupDatabase{
+			ID:   database.GetID(),
+			Name: name,
+		})
+		it := database.MakeRelationIt()
+		for it.Valid() {
+			rel := it.GetRelation()
+			schema := rel.GetSchema().(*catalog.Schema)
+			table := &BackupTable{
+				DB:   database.GetID(),
+				ID:   rel.ID(),
+				Name: schema.Name,
+				File: fmt.Sprintf("%d%s", rel.ID(), backupTableSuffix),
+			}
+			var baseFile *backupTableIndex
+			if baseTable := baseTables[table.ID]; baseTable != nil {
+				if baseFile, err = indexBackupTable(filepath.Join(base, baseTable.File)); err != nil {
+					return nil, err
+				}
+			}
+			if table.Rows, err = backupTable(filepath.Join(dir, table.File), rel, meta, baseFile); err != nil {
+				return nil, err
+			}
+			for _, fk := range schema.ForeignKeys {
+				if !fk.IsSelfRef() {
+					refs[table.ID] = append(refs[table.ID], fk.RefTable)
+				}
+			}
+			meta.Tables = append(meta.Tables, table)
+			it.Next()
+		}
+		if err = it.GetError(); err != nil {
+			return nil, err
+		}
+	}
+	meta.Tables = sortBackupTables(meta.Tables, refs)
+	if err = meta.write(dir); err != nil {
+		return nil, err
+	}
+	logutil.Infof("[Backup] | %s | TS=%d | BaseTS=%d | Databases=%d | Tables=%d",
+		dir, meta.TS, meta.BaseTS, len(meta.Databases), len(meta.Tables))
+	return
+}
+
+// sortBackupTables orders tables so that a table comes after the tables
+// it references in refs. The tables of a reference cycle keep their order.
+func sortBackupTables(tables []*BackupTable, refs map[uint64][]uint64) []*BackupTable {
+	byID := make(map[uint64]*BackupTable, len(tables))
+	for _, table := range tables {
+		byID[table.ID] = table
+	}
+	sorted := make([]*BackupTable, 0, len(tables))
+	visited := make(map[uint64]bool, len(tables))
+	var visit func(table *BackupTable)
+	visit = func(table *BackupTable) {
+		if visited[table.ID] {
+			return
+		}
+		visited[table.ID] = true
+		for _, id := range refs[table.ID] {
+			if ref := byID[id]; ref != nil {
+				visit(ref)
+			}
+		}
+		sorted = append(sorted, table)
+	}
+	for _, table := range tables {
+		visit(table)
+	}
+	return sorted
+}
+
+func writeBackupChunk(w io.Writer, buf []byte) (err error) {
+	if err = binary.Write(w, binary.BigEndian, uint32(len(buf))); err != nil {
+		return
+	}
+	_, err = w.Write(buf)
+	return
+}
+
+func readBackupChunk(r io.Reader) (buf []byte, err error) {
+	size := uint32(0)
+	if err = binary.Read(r, binary.BigEndian, &size); err != nil {
+		return
+	}
+	buf = make([]byte, size)
+	_, err = io.ReadFull(r, buf)
+	return
+}
+
+// backupTable writes the schema of rel and its rows seen by the txn of rel
+// to the file name. Each block is written as its header followed by its
+// columns. If base is not nil, a block of base unchanged since its TS is
+// only written as a header referencing it.
+func backupTable(name string, rel handle.Relation, meta *BackupMeta, base *backupTableIndex) (rows uint64, err error) {
+	f, err := os.Create(name)
+	if err != nil {
+		return
+	}
+	defer func() {
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+	}()
+	w := bufio.NewWriter(f)
+	schema := rel.GetSchema().(*catalog.Schema)
+	buf, err := schema.Marshal()
+	if err != nil {
+		return
+	}
+	if err = writeBackupChunk(w, buf); err != nil {
+		return
+	}
+	// The blocks of base have the rows of another schema if the table was
+	// altered since
+	if base != nil && !bytes.Equal(base.schema, buf) {
+		base = nil
+	}
+	it := rel.MakeBlockIt()
+	for it.Valid() {
+		blk := it.GetBlock()
+		entry := blk.GetMeta().(*catalog.BlockEntry)
+		it.Next()
+		if base != nil {
+			header, ok := base.blocks[entry.GetID()]
+			unchanged := false
+			if ok {
+				if unchanged, err = blockUnchangedSince(entry, meta.BaseTS, meta.TS); err != nil {
+					return
+				}
+			}
+			if unchanged {
+				header.Kind = backupBlockBase
+				if err = binary.Write(w, binary.BigEndian, header.backupBlockHeader); err != nil {
+					return
+				}
+				rows += uint64(header.Rows)
+				continue
+			}
+		}
+		vecs := make([]*gvec.Vector, len(schema.ColDefs))
+		for i := range schema.ColDefs {
+			view, err := blk.GetColumnDataById(i, nil, nil)
+			if err != nil {
+				return 0, err
+			}
+			vecs[i] = view.ApplyDeletes()
+		}
+		// The empty blocks are written too, a later incremental backup may
+		// reference them
+		header := backupBlockHeader{
+			ID:   entry.GetID(),
+			Kind: backupBlockRows,
+			Rows: uint32(gvec.Length(vecs[0])),
+		}
+		if err = binary.Write(w, binary.BigEndian, header); err != nil {
+			return
+		}
+		for _, vec := range vecs {
+			if buf, err = vec.Show(); err != nil {
+				return
+			}
+			if err = writeBackupChunk(w, buf); err != nil {
+				return
+			}
+		}
+		rows += uint64(header.Rows)
+	}
+	if err = w.Flush(); err != nil {
+		return
+	}
+	err = f.Sync()
+	return
+}
+
+// blockUnchangedSince returns true if the block was committed at baseTS and
+// has no deletes or updates committed in (baseTS, ts). An appendable block
+// may have appends since, it is never unchanged.
+func blockUnchangedSince(entry *catalog.BlockEntry, baseTS, ts uint64) (bool, error) {
+	if entry.IsAppendable() {
+		return false, nil
+	}
+	entry.RLock()
+	createAt := entry.CreateAt
+	entry.RUnlock()
+	if createAt == 0 || createAt > baseTS {
+		return false, nil
+	}
+	view, err := entry.GetBlockData().CollectChangesInRange(baseTS, ts)
+	if err != nil {
+		return false, err
+	}
+	if view.DeleteMask != nil && !view.DeleteMask.IsEmpty() {
+		return false, nil
+	}
+	return len(view.UpdateMasks) == 0, nil
+}
+
+// backupIndexedBlock is a block of a backup table file, Offset is the
+// offset of its header in the file.
+type backupIndexedBlock struct {
+	backupBlockHeader
+	Offset int64
+}
+
+// backupTableIndex is the schema and the blocks of a backup table file.
+type backupTableIndex struct {
+	schema []byte
+	cols   int
+	blocks map[uint64]backupIndexedBlock
+}
+
+// countingReader counts the bytes read from r.
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (r *countingReader) Read(p []byte) (n int, err error) {
+	n, err = r.r.Read(p)
+	r.n += int64(n)
+	return
+}
+
+// indexBackupTable reads the headers of the blocks of the backup table file
+// name, skipping their columns.
+func indexBackupTable(name string) (index *backupTableIndex, err error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+	r := &countingReader{r: bufio.NewReader(f)}
+	index = &backupTableIndex{blocks: make(map[uint64]backupIndexedBlock)}
+	if index.schema, err = readBackupChunk(r); err != nil {
+		return nil, err
+	}
+	schema := catalog.NewEmptySchema("")
+	if _, err = schema.ReadFrom(bytes.NewReader(index.schema)); err != nil {
+		return nil, err
+	}
+	index.cols = len(schema.ColDefs)
+	for {
+		block := backupIndexedBlock{Offset: r.n}
+		if err = binary.Read(r, binary.BigEndian, &block.backupBlockHeader); err == io.EOF {
+			return index, nil
+		} else if err != nil {
+			return nil, err
+		}
+		index.blocks[block.ID] = block
+		if block.Kind != backupBlockRows {
+			continue
+		}
+		for i := 0; i < index.cols; i++ {
+			size := uint32(0)
+			if err = binary.Read(r, binary.BigEndian, &size); err != nil {
+				return nil, err
+			}
+			if _, err = io.CopyN(ioutil.Discard, r, int64(size)); err != nil {
+				return nil, err
+			}
+		}
+	}
+}
+
+// Restore creates in dir a db with the tables and the rows of a chain of
+// backups: a full backup followed by the incremental backups, each based on
+// the previous one. The db is the image of the last backup. The foreign keys
+// are remapped to the IDs of the restored tables.
+func Restore(dir string, backups ...string) (err error) {
+	if len(backups) == 0 {
+		return fmt.Errorf("%w: no backup to restore", ErrBackupInvalid)
+	}
+	chain := &backupChain{
+		dirs:    backups,
+		metas:   make([]*BackupMeta, len(backups)),
+		indexes: make(map[backupChainTable]*backupTableIndex),
+	}
+	for i, backup := range backups {
+		if chain.metas[i], err = ReadBackupMeta(backup); err != nil {
+			return
+		}
+		if i == 0 && chain.metas[i].BaseTS != 0 {
+			return fmt.Errorf("%w: %s is not a full backup", ErrBackupInvalid, backup)
+		}
+		if i > 0 && chain.metas[i].BaseTS != chain.metas[i-1].TS {
+			return fmt.Errorf("%w: %s is not based on %s", ErrBackupInvalid, backup, backups[i-1])
+		}
+	}
+	meta := chain.metas[len(backups)-1]
+	if err = prepareEmptyDir(dir); err != nil {
+		return
+	}
+	// The restored rows stay in the WAL till the restored db is opened, no
+	// block is compacted while restoring
+	opts := new(options.Options).FillDefaults(dir)
+	opts.CheckpointCfg.ScannerInterval = math.MaxInt32
+	tae, err := Open(dir, opts)
+	if err != nil {
+		return
+	}
+	defer func() {
+		if cerr := tae.Close(); err == nil {
+			err = cerr
+		}
+	}()
+
+	dbs := make(map[uint64]string, len(meta.Databases))
+	dbIDs := make(map[uint64]uint64, len(meta.Databases))
+	txn, err := tae.StartTxn(nil)
+	if err != nil {
+		return
+	}
+	for _, database := range meta.Databases {
+		h, err := txn.CreateDatabase(database.Name)
+		if err != nil {
+			_ = txn.Rollback()
+			return err
+		}
+		dbs[database.ID] = database.Name
+		dbIDs[database.ID] = h.GetID()
+	}
+	if err = txn.Commit(); err != nil {
+		return
+	}
+
+	tableIDs := make(map[uint64]uint64, len(meta.Tables))
+	for _, table := range meta.Tables {
+		if _, ok := dbs[table.DB]; !ok {
+			return fmt.Errorf("%w: database %d of table %s not found", ErrBackupInvalid, table.DB, table.Name)
+		}
+		if err = tae.restoreTable(chain, table, dbs[table.DB], dbIDs, tableIDs); err != nil {
+			return
+		}
+	}
+	logutil.Infof("[Restore] | %s | TS=%d | Backups=%d | Databases=%d | Tables=%d",
+		dir, meta.TS, len(backups), len(meta.Databases), len(meta.Tables))
+	return
+}
+
+type backupChainTable struct {
+	backup int
+	table  uint64
+}
+
+// backupChain is a chain of backups to restore, the blocks of a backup
+// referencing its base are read from the previous backup.
+type backupChain struct {
+	dirs    []string
+	metas   []*BackupMeta
+	indexes map[backupChainTable]*backupTableIndex
+}
+
+// readBaseBlock returns the rows of the block blk of table in the base of
+// the backup i of the chain.
+func (chain *backupChain) readBaseBlock(i int, table, blk uint64, schema *catalog.Schema) (bat *batch.Batch, err error) {
+	if i--; i < 0 {
+		return nil, fmt.Errorf("%w: block %d of a full backup references a base", ErrBackupInvalid, blk)
+	}
+	key := backupChainTable{backup: i, table: table}
+	index := chain.indexes[key]
+	var file string
+	for _, t := range chain.metas[i].Tables {
+		if t.ID == table {
+			file = filepath.Join(chain.dirs[i], t.File)
+		}
+	}
+	if file == "" {
+		return nil, fmt.Errorf("%w: table %d not found in %s", ErrBackupInvalid, table, chain.dirs[i])
+	}
+	if index == nil {
+		if index, err = indexBackupTable(file); err != nil {
+			return
+		}
+		chain.indexes[key] = index
+	}
+	block, ok := index.blocks[blk]
+	if !ok {
+		return nil, fmt.Errorf("%w: block %d of table %d not found in %s", ErrBackupInvalid, blk, table, chain.dirs[i])
+	}
+	if block.Kind == backupBlockBase {
+		return chain.readBaseBlock(i, table, blk, schema)
+	}
+	f, err := os.Open(file)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+	// Skip the header
+	if _, err = f.Seek(block.Offset+int64(binary.Size(block.backupBlockHeader)), io.SeekStart); err != nil {
+		return
+	}
+	return readBackupBatch(bufio.NewReader(f), schema)
+}
+
+// readBackupBatch reads the columns of a block written by backupTable.
+func readBackupBatch(r io.Reader, schema *catalog.Schema) (bat *batch.Batch, err error) {
+	bat = batch.New(true, schema.Attrs())
+	for i, colDef := range schema.ColDefs {
+		buf, err := readBackupChunk(r)
+		if err != nil {
+			return nil, err
+		}
+		bat.Vecs[i] = gvec.New(colDef.Type)
+		if err = bat.Vecs[i].Read(buf); err != nil {
+			return nil, err
+		}
+	}
+	return
+}
+
+// restoreTable creates table of the last backup of the chain in the
+// database dbName and appends its rows. The rows of each block are appended
+// by a txn, except those of a table referencing itself, which go in a
+// single batch since a row may reference a row of a later block.
+func (db *DB) restoreTable(chain *backupChain, table *BackupTable, dbName string, dbIDs, tableIDs map[uint64]uint64) (err error) {
+	last := len(chain.dirs) - 1
+	f, err := os.Open(filepath.Join(chain.dirs[last], table.File))
+	if err != nil {
+		return
+	}
+	defer f.Close()
+	r := bufio.NewReader(f)
+	buf, err := readBackupChunk(r)
+	if err != nil {
+		return
+	}
+	schema := catalog.NewEmptySchema("")
+	if _, err = schema.ReadFrom(bytes.NewReader(buf)); err != nil {
+		return
+	}
+	selfRef := false
+	for _, fk := range schema.ForeignKeys {
+		if fk.IsSelfRef() {
+			selfRef = true
+			continue
+		}
+		refDB, ok := dbIDs[fk.RefDB]
+		refTable, ok2 := tableIDs[fk.RefTable]
+		if !ok || !ok2 {
+			return fmt.Errorf("%w: table %d referenced by %s not found", ErrBackupInvalid, fk.RefTable, schema.Name)
+		}
+		fk.RefDB, fk.RefTable = refDB, refTable
+	}
+
+	txn, err := db.StartTxn(nil)
+	if err != nil {
+		return
+	}
+	database, err := txn.GetDatabase(dbName)
+	if err != nil {
+		_ = txn.Rollback()
+		return
+	}
+	rel, err := database.CreateRelation(schema)
+	if err != nil {
+		_ = txn.Rollback()
+		return
+	}
+	tableIDs[table.ID] = rel.ID()
+	if err = txn.Commit(); err != nil {
+		return
+	}
+
+	var pending *batch.Batch
+	for {
+		header := backupBlockHeader{}
+		if err = binary.Read(r, binary.BigEndian, &header); err == io.EOF {
+			err = nil
+			break
+		} else if err != nil {
+			return
+		}
+		var bat *batch.Batch
+		switch header.Kind {
+		case backupBlockRows:
+			bat, err = readBackupBatch(r, schema)
+		case backupBlockBase:
+			bat, err = chain.readBaseBlock(last, table.ID, header.ID, schema)
+		default:
+			err = fmt.Errorf("%w: block %d of %s has kind %d", ErrBackupInvalid, header.ID, schema.Name, header.Kind)
+		}
+		if err != nil {
+			return
+		}
+		if header.Rows == 0 {
+			continue
+		}
+		if !selfRef {
+			if err = db.appendRestored(dbName, schema.Name, bat); err != nil {
+				return
+			}
+			continue
+		}
+		if pending == nil {
+			pending = batch.New(true, schema.Attrs())
+			for i, colDef := range schema.ColDefs {
+				pending.Vecs[i] = gvec.New(colDef.Type)
+			}
+		}
+		appendRows(pending, bat)
+	}
+	if pending != nil {
+		if err = db.appendRestored(dbName, schema.Name, pending); err != nil {
+			return
+		}
+	}
+	return
+}
+
+func (db *DB) appendRestored(dbName, tableName string, bat *batch.Batch) (err error) {
+	txn, err := db.StartTxn(nil)
+	if err != nil {
+		return
+	}
+	database, err := txn.GetDatabase(dbName)
+	if err != nil {
+		_ = txn.Rollback()
+		return
+	}
+	rel, err := database.GetRelationByName(tableName)
+	if err != nil {
+		_ = txn.Rollback()
+		return
+	}
+	if err = rel.Append(bat); err != nil {
+		_ = txn.Rollback()
+		return
+	}
+	return txn.Commit()
+}
+
+// appendRows appends the rows of src to dst
+func appendRows(dst, src *batch.Batch) {
+	for i, vec := range src.Vecs {
+		base := uint64(gvec.Length(dst.Vecs[i]))
+		for row := 0; row < gvec.Length(vec); row++ {
+			compute.AppendValue(dst.Vecs[i], compute.GetValue(vec, uint32(row)))
+			if nulls.Contains(vec.Nsp, uint64(row)) {
+				nulls.Add(dst.Vecs[i].Nsp, base+uint64(row))
+			}
+		}
+	}
+}
@@ -0,0 +1,354 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"errors"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	gbat "github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tasks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackup(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	schema := catalog.MockSchema(2)
+	schema.BlockMaxRows = 1000
+	schema.SegmentMaxBlocks = 2
+	schema.PrimaryKey = 1
+	busy := catalog.MockSchema(2)
+	busy.Name = "busy"
+	busy.BlockMaxRows = 1000
+	busy.SegmentMaxBlocks = 2
+	busy.PrimaryKey = 1
+	parent := mockInt32Schema("p", 2)
+	child := mockInt32Schema("c", 2)
+	// a1 of each row references a0 of the next one, in the next block for
+	// the last row of a block
+	self := mockInt32Schema("s", 2)
+	self.ForeignKeys = []*catalog.ForeignKeyInfo{{Name: "s_ibfk_1", Column: 1}}
+
+	txn, _ := tae.StartTxn(nil)
+	db, err := txn.CreateDatabase("db")
+	assert.NoError(t, err)
+	_, err = db.CreateRelation(schema)
+	assert.NoError(t, err)
+	_, err = db.CreateRelation(busy)
+	assert.NoError(t, err)
+	rel, err := db.CreateRelation(parent)
+	assert.NoError(t, err)
+	assert.NoError(t, rel.Append(mockInt32Rows(parent, []interface{}{1, 0}, []interface{}{2, 0})))
+	child.ForeignKeys = []*catalog.ForeignKeyInfo{{
+		Name:     "c_ibfk_1",
+		Column:   1,
+		RefDB:    db.GetID(),
+		RefTable: rel.ID(),
+	}}
+	rel, err = db.CreateRelation(child)
+	assert.NoError(t, err)
+	assert.NoError(t, rel.Append(mockInt32Rows(child, []interface{}{1, 1}, []interface{}{2, nil})))
+	rel, err = db.CreateRelation(self)
+	assert.NoError(t, err)
+	rows := make([][]interface{}, 25)
+	for i := range rows {
+		rows[i] = []interface{}{i, i + 1}
+	}
+	rows[len(rows)-1][1] = nil
+	assert.NoError(t, rel.Append(mockInt32Rows(self, rows...)))
+	assert.NoError(t, txn.Commit())
+
+	bat := compute.MockBatch(schema.Types(), 4000, int(schema.PrimaryKey), nil)
+	bats := compute.SplitBatch(bat, 4)
+	appendTo := func(name string, bat *gbat.Batch) {
+		txn, _ := tae.StartTxn(nil)
+		db, err := txn.GetDatabase("db")
+		assert.NoError(t, err)
+		rel, err := db.GetRelationByName(name)
+		assert.NoError(t, err)
+		assert.NoError(t, rel.Append(bat))
+		assert.NoError(t, txn.Commit())
+	}
+	for _, bat := range bats[:3] {
+		appendTo(schema.Name, bat)
+	}
+
+	// Compact the first two blocks, delete rows of the last one and
+	// checkpoint the catalog, so that the rows are in the compacted blocks,
+	// the appendable block and the WAL
+	for i := 0; i < 2; i++ {
+		txn, _ := tae.StartTxn(nil)
+		db, _ := txn.GetDatabase("db")
+		rel, _ := db.GetRelationByName(schema.Name)
+		it := rel.MakeBlockIt()
+		for j := 0; j < i; j++ {
+			it.Next()
+		}
+		blkData := it.GetBlock().GetMeta().(*catalog.BlockEntry).GetBlockData()
+		factory, taskType, scopes, err := blkData.BuildCompactionTaskFactory()
+		assert.NoError(t, err)
+		task, err := tae.Scheduler.ScheduleMultiScopedTxnTask(tasks.WaitableCtx, taskType, scopes, factory)
+		assert.NoError(t, err)
+		assert.NoError(t, task.WaitDone())
+		assert.NoError(t, txn.Commit())
+	}
+	{
+		txn, _ := tae.StartTxn(nil)
+		db, _ := txn.GetDatabase("db")
+		rel, _ := db.GetRelationByName(schema.Name)
+		id, row, err := rel.GetByFilter(handle.NewEQFilter(compute.GetValue(bat.Vecs[schema.PrimaryKey], 2500)))
+		assert.NoError(t, err)
+		assert.NoError(t, rel.RangeDelete(id, row, row+9))
+		assert.NoError(t, txn.Commit())
+	}
+	assert.NoError(t, tae.Catalog.Checkpoint(tae.Scheduler.GetSafeTS()))
+
+	// Keep writing another table during the backup
+	busyBat := compute.MockBatch(busy.Types(), 10000, int(busy.PrimaryKey), nil)
+	var wg sync.WaitGroup
+	started, stop := make(chan struct{}), make(chan struct{})
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i, bat := range compute.SplitBatch(busyBat, 100) {
+			select {
+			case <-stop:
+				return
+			default:
+			}
+			appendTo(busy.Name, bat)
+			if i == 0 {
+				close(started)
+			}
+		}
+	}()
+	<-started
+
+	dir := filepath.Join(tae.Dir, "backup")
+	meta, err := tae.Backup(dir, "")
+	assert.NoError(t, err)
+	_, err = tae.Backup(dir, "")
+	assert.True(t, errors.Is(err, ErrBackupDirNotEmpty))
+	// Not in the backup
+	appendTo(schema.Name, bats[3])
+	close(stop)
+	wg.Wait()
+
+	tables := make(map[string]*BackupTable)
+	for _, table := range meta.Tables {
+		tables[table.Name] = table
+	}
+	assert.Equal(t, uint64(2990), tables[schema.Name].Rows)
+	assert.Equal(t, uint64(25), tables[self.Name].Rows)
+	// The referenced table is restored first
+	for i, table := range meta.Tables {
+		if table.Name == child.Name {
+			assert.Equal(t, parent.Name, meta.Tables[i-1].Name)
+		}
+	}
+
+	restored := filepath.Join(tae.Dir, "restored")
+	assert.NoError(t, Restore(restored, dir))
+	assert.True(t, errors.Is(Restore(restored, dir), ErrBackupDirNotEmpty))
+	assert.Error(t, Restore(filepath.Join(tae.Dir, "restored2"), restored))
+
+	// The appends replayed from the WAL are not scanned by the blocks after
+	// a reopen, the rows are counted by their primary keys
+	countKeys := func(rel handle.Relation, keys ...interface{}) (rows int) {
+		for _, key := range keys {
+			if _, _, err := rel.GetByFilter(handle.NewEQFilter(key)); err == nil {
+				rows++
+			}
+		}
+		return
+	}
+	tae2, err := Open(restored, nil)
+	assert.NoError(t, err)
+	defer tae2.Close()
+	txn, _ = tae2.StartTxn(nil)
+	db, err = txn.GetDatabase("db")
+	assert.NoError(t, err)
+	rel, err = db.GetRelationByName(schema.Name)
+	assert.NoError(t, err)
+	keys := make([]interface{}, 4000)
+	for i := range keys {
+		keys[i] = compute.GetValue(bat.Vecs[schema.PrimaryKey], uint32(i))
+	}
+	assert.Equal(t, 2990, countKeys(rel, keys[:3000]...))
+	assert.Equal(t, 0, countKeys(rel, keys[2500:2510]...))
+	assert.Equal(t, 0, countKeys(rel, keys[3000:]...))
+	rel, err = db.GetRelationByName(self.Name)
+	assert.NoError(t, err)
+	keys = keys[:0]
+	for i := 0; i < 30; i++ {
+		keys = append(keys, int32(i))
+	}
+	assert.Equal(t, 25, countKeys(rel, keys...))
+	rel, err = db.GetRelationByName(parent.Name)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, countKeys(rel, keys...))
+	// The rows of the busy table committed before the backup txn started
+	rel, err = db.GetRelationByName(busy.Name)
+	assert.NoError(t, err)
+	keys = keys[:0]
+	for i := 0; i < 10000; i++ {
+		keys = append(keys, compute.GetValue(busyBat.Vecs[busy.PrimaryKey], uint32(i)))
+	}
+	assert.Equal(t, int(tables[busy.Name].Rows), countKeys(rel, keys...))
+	assert.Equal(t, int(tables[busy.Name].Rows), countKeys(rel, keys[:tables[busy.Name].Rows]...))
+	// The foreign key references the restored parent
+	rel, err = db.GetRelationByName(child.Name)
+	assert.NoError(t, err)
+	fk := rel.GetSchema().(*catalog.Schema).ForeignKeys[0]
+	assert.Equal(t, db.GetID(), fk.RefDB)
+	rel, err = db.GetRelationByName(parent.Name)
+	assert.NoError(t, err)
+	assert.Equal(t, rel.ID(), fk.RefTable)
+	assert.NoError(t, txn.Commit())
+}
+
+func TestIncrementalBackup(t *testing.T) {
+	opts := new(options.Options)
+	opts.CheckpointCfg = new(options.CheckpointCfg)
+	opts.CheckpointCfg.ScannerInterval = 3600000
+	opts.CheckpointCfg.ExecutionLevels = 20
+	opts.CheckpointCfg.ExecutionInterval = 3600000
+	tae := initDB(t, opts)
+	defer tae.Close()
+	schema := catalog.MockSchema(2)
+	schema.BlockMaxRows = 1000
+	schema.SegmentMaxBlocks = 2
+	schema.PrimaryKey = 1
+	txn, _ := tae.StartTxn(nil)
+	db, err := txn.CreateDatabase("db")
+	assert.NoError(t, err)
+	_, err = db.CreateRelation(schema)
+	assert.NoError(t, err)
+	assert.NoError(t, txn.Commit())
+
+	bat := compute.MockBatch(schema.Types(), 4000, int(schema.PrimaryKey), nil)
+	bats := compute.SplitBatch(bat, 4)
+	keys := make([]interface{}, 4000)
+	for i := range keys {
+		keys[i] = compute.GetValue(bat.Vecs[schema.PrimaryKey], uint32(i))
+	}
+	withRel := func(fn func(rel handle.Relation)) {
+		txn, _ := tae.StartTxn(nil)
+		db, err := txn.GetDatabase("db")
+		assert.NoError(t, err)
+		rel, err := db.GetRelationByName(schema.Name)
+		assert.NoError(t, err)
+		fn(rel)
+		assert.NoError(t, txn.Commit())
+	}
+	// compact compacts the block of the row of key
+	compact := func(key interface{}) {
+		withRel(func(rel handle.Relation) {
+			id, _, err := rel.GetByFilter(handle.NewEQFilter(key))
+			assert.NoError(t, err)
+			for it := rel.MakeBlockIt(); it.Valid(); it.Next() {
+				meta := it.GetBlock().GetMeta().(*catalog.BlockEntry)
+				if meta.GetID() != id.BlockID {
+					continue
+				}
+				factory, taskType, scopes, err := meta.GetBlockData().BuildCompactionTaskFactory()
+				assert.NoError(t, err)
+				task, err := tae.Scheduler.ScheduleMultiScopedTxnTask(tasks.WaitableCtx, taskType, scopes, factory)
+				assert.NoError(t, err)
+				assert.NoError(t, task.WaitDone())
+			}
+		})
+	}
+	// blockKinds returns the number of blocks of each kind in the table file
+	// of the backup
+	blockKinds := func(dir string, meta *BackupMeta) map[uint8]int {
+		index, err := indexBackupTable(filepath.Join(dir, meta.Tables[0].File))
+		assert.NoError(t, err)
+		kinds := make(map[uint8]int)
+		for _, block := range index.blocks {
+			kinds[block.Kind]++
+		}
+		return kinds
+	}
+
+	for _, bat := range bats[:3] {
+		withRel(func(rel handle.Relation) { assert.NoError(t, rel.Append(bat)) })
+	}
+	compact(keys[0])
+	compact(keys[1000])
+	full := filepath.Join(tae.Dir, "full")
+	meta, err := tae.Backup(full, "")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), meta.BaseTS)
+	assert.Equal(t, uint64(3000), meta.Tables[0].Rows)
+
+	// The first block is unchanged, the second one has deletes, the third
+	// one is compacted and the fourth one is new
+	withRel(func(rel handle.Relation) {
+		id, row, err := rel.GetByFilter(handle.NewEQFilter(keys[1000]))
+		assert.NoError(t, err)
+		assert.NoError(t, rel.RangeDelete(id, row, row+4))
+	})
+	compact(keys[2000])
+	withRel(func(rel handle.Relation) { assert.NoError(t, rel.Append(bats[3])) })
+	inc1 := filepath.Join(tae.Dir, "inc1")
+	meta1, err := tae.Backup(inc1, full)
+	assert.NoError(t, err)
+	assert.Equal(t, meta.TS, meta1.BaseTS)
+	assert.Equal(t, uint64(3995), meta1.Tables[0].Rows)
+	assert.Equal(t, map[uint8]int{backupBlockRows: 3, backupBlockBase: 1}, blockKinds(inc1, meta1))
+
+	// Only the appendable block is written again, the first block is a
+	// reference to a reference
+	inc2 := filepath.Join(tae.Dir, "inc2")
+	meta2, err := tae.Backup(inc2, inc1)
+	assert.NoError(t, err)
+	assert.Equal(t, meta1.TS, meta2.BaseTS)
+	assert.Equal(t, uint64(3995), meta2.Tables[0].Rows)
+	assert.Equal(t, map[uint8]int{backupBlockRows: 1, backupBlockBase: 3}, blockKinds(inc2, meta2))
+
+	assert.True(t, errors.Is(Restore(filepath.Join(tae.Dir, "r1"), full, inc2), ErrBackupInvalid))
+	assert.True(t, errors.Is(Restore(filepath.Join(tae.Dir, "r2"), inc1), ErrBackupInvalid))
+	restored := filepath.Join(tae.Dir, "restored")
+	assert.NoError(t, Restore(restored, full, inc1, inc2))
+
+	tae2, err := Open(restored, nil)
+	assert.NoError(t, err)
+	defer tae2.Close()
+	txn, _ = tae2.StartTxn(nil)
+	db, err = txn.GetDatabase("db")
+	assert.NoError(t, err)
+	rel, err := db.GetRelationByName(schema.Name)
+	assert.NoError(t, err)
+	rows := 0
+	for _, key := range keys {
+		if _, _, err := rel.GetByFilter(handle.NewEQFilter(key)); err == nil {
+			rows++
+		}
+	}
+	assert.Equal(t, 3995, rows)
+	for _, key := range keys[1000:1005] {
+		_, _, err := rel.GetByFilter(handle.NewEQFilter(key))
+		assert.Error(t, err)
+	}
+	assert.NoError(t, txn.Commit())
+}
@@ -0,0 +1,450 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/compress"
+	gvec "github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/layout/segment"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/store"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
+)
+
+const (
+	QuarantineDir = "quarantine"
+	segmentSuffix = ".seg"
+)
+
+// CheckOptions are the options of Check
+type CheckOptions struct {
+	// Quarantine moves the corrupt segment files to QuarantineDir of the
+	// directory, so that the db opens without them
+	Quarantine bool
+}
+
+// CheckIssue is a problem found by Check in File. A warning does not stop
+// the db from opening or serving the data.
+type CheckIssue struct {
+	Warning bool
+	File    string
+	Msg     string
+}
+
+func (issue *CheckIssue) String() string {
+	level := "ERROR"
+	if issue.Warning {
+		level = "WARN"
+	}
+	return fmt.Sprintf("%-5s %s: %s", level, issue.File, issue.Msg)
+}
+
+// CheckReport is the result of Check
+type CheckReport struct {
+	Dir         string
+	Entries     int
+	Segments    int
+	Blocks      int
+	Rows        int
+	Issues      []*CheckIssue
+	Quarantined []string
+}
+
+// OK returns true if no error was found
+func (report *CheckReport) OK() bool {
+	for _, issue := range report.Issues {
+		if !issue.Warning {
+			return false
+		}
+	}
+	return true
+}
+
+func (report *CheckReport) String() string {
+	var w strings.Builder
+	fmt.Fprintf(&w, "%s: %d log entries, %d segments, %d blocks, %d rows checked\n",
+		report.Dir, report.Entries, report.Segments, report.Blocks, report.Rows)
+	for _, issue := range report.Issues {
+		fmt.Fprintln(&w, issue.String())
+	}
+	for _, name := range report.Quarantined {
+		fmt.Fprintf(&w, "quarantined %s\n", name)
+	}
+	if report.OK() {
+		w.WriteString("OK")
+	} else {
+		w.WriteString("CORRUPTED")
+	}
+	return w.String()
+}
+
+func (report *CheckReport) errorf(file, format string, args ...interface{}) {
+	report.Issues = append(report.Issues, &CheckIssue{File: file, Msg: fmt.Sprintf(format, args...)})
+}
+
+func (report *CheckReport) warnf(file, format string, args ...interface{}) {
+	report.Issues = append(report.Issues, &CheckIssue{Warning: true, File: file, Msg: fmt.Sprintf(format, args...)})
+}
+
+// checkedSegment is a segment of the catalog replayed by Check
+type checkedSegment struct {
+	id     uint64
+	schema *catalog.Schema
+	// live is false for the segments dropped but not yet removed
+	live bool
+	// blocks are all the blocks of the segment, live the non-appendable
+	// ones whose data must be in the segment file
+	blocks     map[uint64]bool
+	liveBlocks []uint64
+}
+
+// Check checks the TAE directory dir of a db which is not running. It
+// checks every entry of the catalog checkpoint and of the WAL can be read
+// and replayed, the structure of the segment files and that each live
+// non-appendable block of the catalog has the data of all its columns with
+// the same row count in its segment file. It reports the segment files
+// missing or not in the catalog. The index files are kept in memory and
+// not checked. The directory is not changed unless opts.Quarantine is set.
+func Check(dir string, opts *CheckOptions) (report *CheckReport, err error) {
+	if opts == nil {
+		opts = new(CheckOptions)
+	}
+	if _, err = os.Stat(dir); err != nil {
+		return
+	}
+	locker, err := createDBLock(dir)
+	if err != nil {
+		return
+	}
+	defer locker.Close()
+	report = &CheckReport{Dir: dir}
+
+	replayable := true
+	for _, name := range []string{CATALOGDir, WALDir} {
+		result, err := store.CheckFiles(dir, name)
+		if err != nil {
+			return nil, err
+		}
+		report.Entries += result.Entries
+		for _, issue := range result.Issues {
+			if issue.Torn {
+				report.warnf(issue.File, "torn entry at %d is truncated on open", issue.Offset)
+				continue
+			}
+			report.errorf(issue.File, "at %d: %v", issue.Offset, issue.Err)
+			replayable = false
+		}
+	}
+	var segments map[uint64]*checkedSegment
+	if replayable {
+		if segments, err = replayCatalog(dir, report); err != nil {
+			return nil, err
+		}
+	}
+
+	files, err := segmentFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+	var corrupted []string
+	for id, name := range files {
+		var seg *checkedSegment
+		if segments != nil {
+			if seg = segments[id]; seg == nil {
+				report.warnf(relPath(dir, name), "segment %d is not in the catalog", id)
+			}
+		}
+		if !checkSegmentFile(name, seg, report) {
+			corrupted = append(corrupted, name)
+		}
+	}
+	for id, seg := range segments {
+		if _, ok := files[id]; !ok && len(seg.liveBlocks) > 0 {
+			report.errorf(fmt.Sprintf("%d%s", id, segmentSuffix), "segment file of %d blocks is missing", len(seg.liveBlocks))
+		}
+	}
+	sort.Slice(report.Issues, func(i, j int) bool {
+		return report.Issues[i].File < report.Issues[j].File
+	})
+
+	if opts.Quarantine && len(corrupted) > 0 {
+		sort.Strings(corrupted)
+		if err = quarantine(dir, corrupted, report); err != nil {
+			return nil, err
+		}
+	}
+	return
+}
+
+// replayCatalog replays a copy of the catalog checkpoint and the WAL of dir
+// and returns the segments of the catalog
+func replayCatalog(dir string, report *CheckReport) (segments map[uint64]*checkedSegment, err error) {
+	sandbox, err := ioutil.TempDir("", "tae-check")
+	if err != nil {
+		return
+	}
+	defer os.RemoveAll(sandbox)
+	infos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return
+	}
+	for _, info := range infos {
+		if info.IsDir() || filepath.Ext(info.Name()) != ".rot" {
+			continue
+		}
+		if err = store.CopyFilePrefix(filepath.Join(sandbox, info.Name()), filepath.Join(dir, info.Name()), info.Size()); err != nil {
+			return
+		}
+	}
+
+	var db *DB
+	func() {
+		defer func() {
+			if r := recover(); r != nil {
+				report.errorf("wal", "replay failed: %v", r)
+			}
+		}()
+		// no merge or checkpoint runs while the catalog is walked
+		opts := new(options.Options)
+		opts.CheckpointCfg = new(options.CheckpointCfg)
+		opts.CheckpointCfg.ScannerInterval = 1000 * 3600
+		opts.CheckpointCfg.ExecutionLevels = 20
+		opts.CheckpointCfg.ExecutionInterval = 1000 * 3600
+		opts.CheckpointCfg.CatalogCkpInterval = 1000 * 3600
+		if db, err = Open(sandbox, opts); err != nil {
+			report.errorf("wal", "replay failed: %v", err)
+			err = nil
+		}
+	}()
+	if db == nil {
+		return
+	}
+	defer db.Close()
+
+	segments = make(map[uint64]*checkedSegment)
+	processor := new(catalog.LoopProcessor)
+	processor.SegmentFn = func(entry *catalog.SegmentEntry) error {
+		entry.RLock()
+		live := entry.IsCommitted() && !entry.IsDroppedCommitted()
+		entry.RUnlock()
+		segments[entry.GetID()] = &checkedSegment{
+			id:     entry.GetID(),
+			schema: entry.GetTable().GetSchema(),
+			live:   live,
+			blocks: make(map[uint64]bool),
+		}
+		return nil
+	}
+	processor.BlockFn = func(entry *catalog.BlockEntry) error {
+		seg := segments[entry.GetSegment().GetID()]
+		seg.blocks[entry.GetID()] = true
+		entry.RLock()
+		live := entry.IsCommitted() && !entry.IsDroppedCommitted()
+		entry.RUnlock()
+		if seg.live && live && !entry.IsAppendable() {
+			seg.liveBlocks = append(seg.liveBlocks, entry.GetID())
+		}
+		return nil
+	}
+	err = db.Catalog.RecurLoop(processor)
+	return
+}
+
+// segmentFiles returns the segment files of dir and of the local object
+// store, where the segments are offloaded to, by segment id
+func segmentFiles(dir string) (files map[uint64]string, err error) {
+	files = make(map[uint64]string)
+	for _, d := range []string{dir, filepath.Join(dir, ObjectDir)} {
+		infos, err := ioutil.ReadDir(d)
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		for _, info := range infos {
+			if info.IsDir() || filepath.Ext(info.Name()) != segmentSuffix {
+				continue
+			}
+			id, err := strconv.ParseUint(strings.TrimSuffix(info.Name(), segmentSuffix), 10, 64)
+			if err != nil {
+				continue
+			}
+			files[id] = filepath.Join(d, info.Name())
+		}
+	}
+	return
+}
+
+func relPath(dir, name string) string {
+	if rel, err := filepath.Rel(dir, name); err == nil {
+		return rel
+	}
+	return name
+}
+
+// checkSegmentFile checks the segment file name of seg, nil if the segment
+// is unknown. It returns false if the file is corrupted.
+func checkSegmentFile(name string, seg *checkedSegment, report *CheckReport) bool {
+	rel := relPath(report.Dir, name)
+	file, err := segment.Open(name)
+	if err != nil {
+		report.errorf(rel, "%v", err)
+		return false
+	}
+	defer file.Close()
+	report.Segments++
+	errs := file.Verify()
+	for _, err := range errs {
+		report.errorf(rel, "%v", err)
+	}
+	if len(errs) > 0 || seg == nil {
+		return len(errs) == 0
+	}
+
+	// the data of a column is in the file of the latest ts
+	type columnFile struct {
+		ts   uint64
+		file *segment.BlockFile
+	}
+	columns := make(map[uint64]map[int]columnFile)
+	for _, f := range file.Files() {
+		col, blk, ts, ok := parseColumnFileName(f.GetName())
+		if !ok || !seg.blocks[blk] || col >= len(seg.schema.ColDefs) {
+			report.warnf(rel, "%s is not referenced by the catalog", f.GetName())
+			continue
+		}
+		if columns[blk] == nil {
+			columns[blk] = make(map[int]columnFile)
+		}
+		if prev, ok := columns[blk][col]; !ok || prev.ts <= ts {
+			columns[blk][col] = columnFile{ts: ts, file: f}
+		}
+	}
+	ok := true
+	for _, blk := range seg.liveBlocks {
+		report.Blocks++
+		rows := -1
+		for col, def := range seg.schema.ColDefs {
+			f, found := columns[blk][col]
+			if !found || f.file.GetFileSize() == 0 {
+				report.errorf(rel, "column %s of block %d is missing", def.Name, blk)
+				ok = false
+				continue
+			}
+			n, err := columnRows(f.file, def)
+			if err != nil {
+				report.errorf(rel, "column %s of block %d: %v", def.Name, blk, err)
+				ok = false
+				continue
+			}
+			if rows >= 0 && n != rows {
+				report.errorf(rel, "column %s of block %d has %d rows, %d expected", def.Name, blk, n, rows)
+				ok = false
+			}
+			if rows < 0 {
+				rows = n
+			}
+		}
+		if rows > int(seg.schema.BlockMaxRows) {
+			report.errorf(rel, "block %d has %d rows, more than %d", blk, rows, seg.schema.BlockMaxRows)
+			ok = false
+		}
+		if rows > 0 {
+			report.Rows += rows
+		}
+	}
+	return ok
+}
+
+// parseColumnFileName parses the name of a column data file, col_blk.blk
+// or col_blk_ts.blk
+func parseColumnFileName(name string) (col int, blk, ts uint64, ok bool) {
+	if !strings.HasSuffix(name, ".blk") {
+		return
+	}
+	parts := strings.Split(strings.TrimSuffix(name, ".blk"), "_")
+	if len(parts) != 2 && len(parts) != 3 {
+		return
+	}
+	var err error
+	if col, err = strconv.Atoi(parts[0]); err != nil {
+		return
+	}
+	if blk, err = strconv.ParseUint(parts[1], 10, 64); err != nil {
+		return
+	}
+	if len(parts) == 3 {
+		if ts, err = strconv.ParseUint(parts[2], 10, 64); err != nil {
+			return
+		}
+	}
+	return col, blk, ts, true
+}
+
+// columnRows decodes the data of a column file and returns its row count
+func columnRows(f *segment.BlockFile, def *catalog.ColDef) (rows int, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("bad data: %v", r)
+		}
+	}()
+	buf := make([]byte, f.GetFileSize())
+	if _, err = f.Read(buf); err != nil {
+		return
+	}
+	if algo := int(f.GetAlgo()); algo != compress.None {
+		// the origin size is not persisted
+		size := 4 * len(buf)
+		for {
+			var dst []byte
+			if dst, err = compress.Decompress(buf, make([]byte, size), algo); err == nil {
+				buf = dst
+				break
+			}
+			if algo != compress.Lz4 || size > 1<<30 {
+				return
+			}
+			size *= 2
+		}
+	}
+	vec := gvec.New(def.Type)
+	if err = vec.Read(buf); err != nil {
+		return
+	}
+	return gvec.Length(vec), nil
+}
+
+// quarantine moves the corrupted segment files to QuarantineDir of dir
+func quarantine(dir string, files []string, report *CheckReport) (err error) {
+	target := filepath.Join(dir, QuarantineDir)
+	if err = os.MkdirAll(target, 0755); err != nil {
+		return
+	}
+	for _, name := range files {
+		if err = os.Rename(name, filepath.Join(target, filepath.Base(name))); err != nil {
+			return
+		}
+		report.Quarantined = append(report.Quarantined, relPath(dir, name))
+	}
+	return
+}
@@ -0,0 +1,121 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/store"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheck(t *testing.T) {
+	tae := initMergeDB(t)
+	schema := catalog.MockSchema(2)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	schema.PrimaryKey = 1
+	bat := compute.MockBatch(schema.Types(), 30, int(schema.PrimaryKey), nil)
+	{
+		txn, _ := tae.StartTxn(nil)
+		database, err := txn.CreateDatabase("db")
+		assert.NoError(t, err)
+		rel, err := database.CreateRelation(schema)
+		assert.NoError(t, err)
+		assert.NoError(t, rel.Append(bat))
+		assert.NoError(t, txn.Commit())
+	}
+	assert.NoError(t, tae.Compact("db", schema.Name))
+	txn, _ := tae.StartTxn(nil)
+	database, _ := txn.GetDatabase("db")
+	rel, _ := database.GetRelationByName(schema.Name)
+	_, nonAppendable := segmentsOf(rel.GetMeta().(*catalog.TableEntry))
+	assert.Equal(t, 1, len(nonAppendable))
+	merged := filepath.Join(tae.Dir, fmt.Sprintf("%d.seg", nonAppendable[0].GetID()))
+	assert.NoError(t, txn.Commit())
+	dir := tae.Dir
+	tae.Close()
+
+	report, err := Check(dir, nil)
+	assert.NoError(t, err)
+	t.Log(report.String())
+	assert.True(t, report.OK())
+	assert.Equal(t, 0, len(report.Issues))
+	assert.Equal(t, 30, report.Rows)
+
+	// a segment file unknown to the catalog is reported
+	assert.NoError(t, store.CopyFilePrefix(filepath.Join(dir, "9999.seg"), merged, segmentSize(t, merged)))
+	report, err = Check(dir, nil)
+	assert.NoError(t, err)
+	assert.True(t, report.OK())
+	assert.Equal(t, 1, len(report.Issues))
+	assert.Equal(t, "9999.seg", report.Issues[0].File)
+	assert.NoError(t, os.Remove(filepath.Join(dir, "9999.seg")))
+
+	// the data of the merged segment is cut off
+	assert.NoError(t, os.Truncate(merged, segmentSize(t, merged)-100))
+	report, err = Check(dir, nil)
+	assert.NoError(t, err)
+	t.Log(report.String())
+	assert.False(t, report.OK())
+	assert.Equal(t, 0, len(report.Quarantined))
+	report, err = Check(dir, &CheckOptions{Quarantine: true})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{filepath.Base(merged)}, report.Quarantined)
+	_, err = os.Stat(filepath.Join(dir, QuarantineDir, filepath.Base(merged)))
+	assert.NoError(t, err)
+	report, err = Check(dir, nil)
+	assert.NoError(t, err)
+	t.Log(report.String())
+	assert.False(t, report.OK())
+	assert.Equal(t, 1, len(report.Issues))
+	assert.Equal(t, filepath.Base(merged), report.Issues[0].File)
+	tae, err = Open(dir, nil)
+	assert.NoError(t, err)
+	tae.Close()
+
+	// a corrupted WAL entry
+	wal := store.MakeVersionFile(dir, WALDir, 0)
+	f, err := os.OpenFile(wal, os.O_RDWR, os.ModePerm)
+	assert.NoError(t, err)
+	_, err = f.WriteAt([]byte{0, 0}, 0)
+	assert.NoError(t, err)
+	assert.NoError(t, f.Close())
+	report, err = Check(dir, nil)
+	assert.NoError(t, err)
+	t.Log(report.String())
+	assert.False(t, report.OK())
+	assert.Equal(t, filepath.Base(wal), report.Issues[0].File)
+
+	// the corruption is returned by Open instead of crashing the replay
+	_, err = Open(dir, nil)
+	var corrupted *common.CorruptedError
+	assert.True(t, errors.As(err, &corrupted))
+	assert.Equal(t, wal, corrupted.File)
+	assert.Equal(t, int64(0), corrupted.Offset)
+}
+
+func segmentSize(t *testing.T, name string) int64 {
+	stat, err := os.Stat(name)
+	assert.NoError(t, err)
+	return stat.Size()
+}
@@ -0,0 +1,244 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checkpoint
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+)
+
+var ErrBadMergePolicy = errors.New("tae: bad merge policy")
+
+const (
+	// DefaultMergeSegments is the number of the small segments of a size
+	// tier merged by the tiered policy
+	DefaultMergeSegments = 4
+	// DefaultMergeDeleteRatio is the ratio of the deleted rows of a segment
+	// rewritten by the deletes policy
+	DefaultMergeDeleteRatio = 0.3
+)
+
+// SegmentStats is what a MergePolicy knows of a segment of a table.
+type SegmentStats struct {
+	Segment *catalog.SegmentEntry
+	// Blocks are the committed non-appendable blocks of the segment, which
+	// are the ones can be merged. All the blocks of a non-appendable segment
+	// are in Blocks.
+	Blocks []*catalog.BlockEntry
+	// Rows is the number of the rows of Blocks, including the deleted ones
+	Rows int
+	// Deletes is the number of the deleted rows of Blocks
+	Deletes int
+	// Size is the size of the column data of Blocks in bytes
+	Size int64
+	// Age is the time since the segment was first seen by the scanner
+	Age time.Duration
+	// Overlaps is the number of the other non-appendable segments of the
+	// table whose primary key range overlaps with the one of the segment
+	Overlaps int
+}
+
+func (stats *SegmentStats) LiveRows() int { return stats.Rows - stats.Deletes }
+
+func (stats *SegmentStats) DeleteRatio() float64 {
+	if stats.Rows == 0 {
+		return 0
+	}
+	return float64(stats.Deletes) / float64(stats.Rows)
+}
+
+// Merge is a group of blocks merged into a new non-appendable segment. The
+// Segments are dropped after the merge, so all their blocks are in Blocks.
+type Merge struct {
+	Segments []*catalog.SegmentEntry
+	Blocks   []*catalog.BlockEntry
+}
+
+// MergePolicy decides the blocks of a table to merge.
+type MergePolicy interface {
+	String() string
+	// Decide returns the merges of the segments of a table. force is true
+	// for ALTER TABLE ... COMPACT, which merges all the segments worth
+	// merging regardless of the thresholds of the policy.
+	Decide(schema *catalog.Schema, segs []*SegmentStats, force bool) []*Merge
+}
+
+// NewMergePolicy returns the merge policy of spec. "tiered[:n]" merges n
+// small segments of similar sizes, n defaults to 4. "deletes[:r]" rewrites
+// the segments with a ratio r of deleted rows, r defaults to 0.3. An empty
+// spec is the default tiered policy.
+func NewMergePolicy(spec string) (MergePolicy, error) {
+	name, arg := spec, ""
+	if i := strings.IndexByte(spec, ':'); i >= 0 {
+		name, arg = spec[:i], spec[i+1:]
+	}
+	switch name {
+	case "", "tiered":
+		policy := &sizeTieredPolicy{segments: DefaultMergeSegments}
+		if arg != "" {
+			n, err := strconv.Atoi(arg)
+			if err != nil || n < 2 {
+				return nil, fmt.Errorf("%w: %s", ErrBadMergePolicy, spec)
+			}
+			policy.segments = n
+		}
+		return policy, nil
+	case "deletes":
+		policy := &deleteRatioPolicy{ratio: DefaultMergeDeleteRatio}
+		if arg != "" {
+			r, err := strconv.ParseFloat(arg, 64)
+			if err != nil || r <= 0 || r > 1 {
+				return nil, fmt.Errorf("%w: %s", ErrBadMergePolicy, spec)
+			}
+			policy.ratio = r
+		}
+		return policy, nil
+	}
+	return nil, fmt.Errorf("%w: %s", ErrBadMergePolicy, spec)
+}
+
+// mergeAppendable merges the sealed blocks of an appendable segment. A full
+// segment is dropped with the merge, the sealed blocks of a segment still
+// appended are only merged if forced.
+func mergeAppendable(schema *catalog.Schema, stats *SegmentStats, force bool) *Merge {
+	if len(stats.Blocks) >= int(schema.SegmentMaxBlocks) {
+		return &Merge{
+			Segments: []*catalog.SegmentEntry{stats.Segment},
+			Blocks:   stats.Blocks,
+		}
+	}
+	if force && len(stats.Blocks) > 1 {
+		return &Merge{Blocks: stats.Blocks}
+	}
+	return nil
+}
+
+func makeMerge(segs []*SegmentStats) *Merge {
+	merge := new(Merge)
+	for _, stats := range segs {
+		merge.Segments = append(merge.Segments, stats.Segment)
+		merge.Blocks = append(merge.Blocks, stats.Blocks...)
+	}
+	return merge
+}
+
+// sizeTieredPolicy puts the non-appendable segments less than half full
+// into tiers by the power of two of their live rows, and merges the
+// segments of a tier once there are enough of them. The segments whose key
+// ranges overlap the most are merged first.
+type sizeTieredPolicy struct {
+	segments int
+}
+
+func (policy *sizeTieredPolicy) String() string {
+	return fmt.Sprintf("tiered:%d", policy.segments)
+}
+
+func (policy *sizeTieredPolicy) Decide(schema *catalog.Schema, segs []*SegmentStats, force bool) (merges []*Merge) {
+	capacity := int(schema.BlockMaxRows) * int(schema.SegmentMaxBlocks)
+	tiers := make(map[int][]*SegmentStats)
+	small := make([]*SegmentStats, 0)
+	for _, stats := range segs {
+		if stats.Segment.IsAppendable() {
+			if merge := mergeAppendable(schema, stats, force); merge != nil {
+				merges = append(merges, merge)
+			}
+			continue
+		}
+		if stats.LiveRows() == 0 || stats.LiveRows()*2 >= capacity {
+			continue
+		}
+		tier := bits.Len(uint(stats.LiveRows()))
+		tiers[tier] = append(tiers[tier], stats)
+		small = append(small, stats)
+	}
+	if force {
+		return append(merges, policy.group(small, capacity, true)...)
+	}
+	levels := make([]int, 0, len(tiers))
+	for tier := range tiers {
+		levels = append(levels, tier)
+	}
+	sort.Ints(levels)
+	for _, tier := range levels {
+		if len(tiers[tier]) >= policy.segments {
+			merges = append(merges, policy.group(tiers[tier], capacity, false)...)
+		}
+	}
+	return
+}
+
+// group packs segs into merges of at most capacity live rows. A single
+// segment is only rewritten if forced and it has deleted rows.
+func (policy *sizeTieredPolicy) group(segs []*SegmentStats, capacity int, force bool) (merges []*Merge) {
+	sort.SliceStable(segs, func(i, j int) bool {
+		if segs[i].Overlaps != segs[j].Overlaps {
+			return segs[i].Overlaps > segs[j].Overlaps
+		}
+		return segs[i].LiveRows() < segs[j].LiveRows()
+	})
+	var group []*SegmentStats
+	rows := 0
+	flush := func() {
+		if len(group) > 1 || (len(group) == 1 && force && group[0].Deletes > 0) {
+			merges = append(merges, makeMerge(group))
+		}
+		group, rows = nil, 0
+	}
+	for _, stats := range segs {
+		if rows+stats.LiveRows() > capacity {
+			flush()
+		}
+		group = append(group, stats)
+		rows += stats.LiveRows()
+	}
+	flush()
+	return
+}
+
+// deleteRatioPolicy rewrites each non-appendable segment whose ratio of
+// deleted rows reaches the threshold.
+type deleteRatioPolicy struct {
+	ratio float64
+}
+
+func (policy *deleteRatioPolicy) String() string {
+	return fmt.Sprintf("deletes:%g", policy.ratio)
+}
+
+func (policy *deleteRatioPolicy) Decide(schema *catalog.Schema, segs []*SegmentStats, force bool) (merges []*Merge) {
+	for _, stats := range segs {
+		if stats.Segment.IsAppendable() {
+			if merge := mergeAppendable(schema, stats, force); merge != nil {
+				merges = append(merges, merge)
+			}
+			continue
+		}
+		if stats.Deletes == 0 || stats.LiveRows() == 0 {
+			continue
+		}
+		if force || stats.DeleteRatio() >= policy.ratio {
+			merges = append(merges, makeMerge([]*SegmentStats{stats}))
+		}
+	}
+	return
+}
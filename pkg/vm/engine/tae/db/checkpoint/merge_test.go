@@ -0,0 +1,40 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checkpoint
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMergePolicy(t *testing.T) {
+	for spec, name := range map[string]string{
+		"":            "tiered:4",
+		"tiered":      "tiered:4",
+		"tiered:8":    "tiered:8",
+		"deletes":     "deletes:0.3",
+		"deletes:0.5": "deletes:0.5",
+	} {
+		policy, err := NewMergePolicy(spec)
+		assert.NoError(t, err)
+		assert.Equal(t, name, policy.String())
+	}
+	for _, spec := range []string{"tiered:1", "tiered:x", "deletes:0", "deletes:2", "leveled"} {
+		_, err := NewMergePolicy(spec)
+		assert.True(t, errors.Is(err, ErrBadMergePolicy))
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"errors"
 	"io"
 	"sync/atomic"
+	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/buffer/base"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
@@ -49,6 +50,8 @@ type DB struct {
 	Wal    wal.Driver
 
 	CKPDriver checkpoint.Driver
+	// MergePolicy is the merge policy of the tables without their own
+	MergePolicy checkpoint.MergePolicy
 
 	Scheduler tasks.TaskScheduler
 
@@ -63,6 +66,16 @@ func (db *DB) StartTxn(info []byte) (txnif.AsyncTxn, error) {
 	return db.TxnMgr.StartTxn(info)
 }
 
+// StartTxnWithIsolation starts a txn at the isolation level.
+func (db *DB) StartTxnWithIsolation(info []byte, level txnif.IsolationLevel) (txnif.AsyncTxn, error) {
+	return db.TxnMgr.StartTxnWithIsolation(info, level)
+}
+
+// StartTxnAt starts a read-only txn reading the data as it was at the time at.
+func (db *DB) StartTxnAt(info []byte, at time.Time) (txnif.AsyncTxn, error) {
+	return db.TxnMgr.StartTxnAt(info, at)
+}
+
 func (db *DB) CommitTxn(txn txnif.AsyncTxn) (err error) {
 	return txn.Commit()
 }
@@ -87,16 +100,25 @@ func (db *DB) RollbackTxn(txn txnif.AsyncTxn) error {
 	return txn.Rollback()
 }
 
-func (db *DB) Replay(dataFactory *tables.DataFactory) {
+func (db *DB) Replay(dataFactory *tables.DataFactory) (err error) {
 	maxTs := db.Catalog.GetCheckpointed().MaxTS
 	replayer := newReplayer(dataFactory, db)
 	replayer.OnTimeStamp(maxTs)
-	replayer.Replay()
+	if err = replayer.Replay(); err != nil {
+		return
+	}
 
 	// TODO: init txn id
-	err := db.TxnMgr.Init(0, replayer.GetMaxTS())
-	if err != nil {
-		panic(err)
+	err = db.TxnMgr.Init(0, replayer.GetMaxTS())
+	return
+}
+
+// BufferStats returns the counters of the index, insert and txn caches.
+func (db *DB) BufferStats() map[string]base.Stats {
+	return map[string]base.Stats{
+		"index":  db.IndexBufMgr.Stats(),
+		"insert": db.MTBufMgr.Stats(),
+		"txn":    db.TxnBufMgr.Stats(),
 	}
 }
 
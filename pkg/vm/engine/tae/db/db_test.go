@@ -16,6 +16,7 @@ package db
 
 import (
 	"bytes"
+	"fmt"
 	"math"
 	"sync"
 	"testing"
@@ -24,8 +25,11 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/buffer"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/dataio/mockio"
 	idxCommon "github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/store"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnbase"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnimpl"
 
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	gbat "github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
@@ -2088,3 +2092,609 @@ func TestUpdateByFilter(t *testing.T) {
 
 	assert.NoError(t, txn.Commit())
 }
+
+func TestSecondaryIndex(t *testing.T) {
+	tae := initDB(t, nil)
+	schema := catalog.MockSchemaAll(13)
+	schema.BlockMaxRows = 1000
+	schema.SegmentMaxBlocks = 10
+	schema.PrimaryKey = 3
+
+	bat := compute.MockBatch(schema.Types(), uint64(schema.BlockMaxRows), int(schema.PrimaryKey), nil)
+	{
+		txn, _ := tae.StartTxn(nil)
+		database, err := txn.CreateDatabase("db")
+		assert.Nil(t, err)
+		rel, err := database.CreateRelation(schema)
+		assert.Nil(t, err)
+		err = rel.Append(bat)
+		assert.Nil(t, err)
+		assert.Nil(t, txn.Commit())
+	}
+	{
+		txn, _ := tae.StartTxn(nil)
+		database, _ := txn.GetDatabase("db")
+		rel, _ := database.GetRelationByName(schema.Name)
+		err := rel.CreateIndex(catalog.NewIndexInfo("idx", catalog.ZoneMap, 2))
+		assert.Nil(t, err)
+		err = rel.CreateIndex(catalog.NewIndexInfo("idx", catalog.ZoneMap, 4))
+		assert.ErrorIs(t, err, catalog.ErrDuplicate)
+		err = rel.DropIndex("idx_not_exist")
+		assert.ErrorIs(t, err, catalog.ErrNotFound)
+		assert.Nil(t, txn.Commit())
+	}
+	{
+		txn, _ := tae.StartTxn(nil)
+		database, _ := txn.GetDatabase("db")
+		rel, _ := database.GetRelationByName(schema.Name)
+		blk := rel.MakeBlockIt().GetBlock()
+		blkData := blk.GetMeta().(*catalog.BlockEntry).GetBlockData()
+		factory, taskType, scopes, err := blkData.BuildCompactionTaskFactory()
+		assert.Nil(t, err)
+		task, err := tae.Scheduler.ScheduleMultiScopedTxnTask(tasks.WaitableCtx, taskType, scopes, factory)
+		assert.Nil(t, err)
+		err = task.WaitDone()
+		assert.Nil(t, err)
+		assert.Nil(t, txn.Commit())
+	}
+	check := func(tae *DB, loaded bool) {
+		txn, _ := tae.StartTxn(nil)
+		database, _ := txn.GetDatabase("db")
+		rel, _ := database.GetRelationByName(schema.Name)
+		meta := rel.GetMeta().(*catalog.TableEntry)
+		assert.Equal(t, []int{2}, meta.GetIndexedColumns())
+		it := rel.MakeBlockIt()
+		checked := 0
+		for it.Valid() {
+			blkMeta := it.GetBlock().GetMeta().(*catalog.BlockEntry)
+			it.Next()
+			if blkMeta.IsAppendable() {
+				continue
+			}
+			blkData := blkMeta.GetBlockData()
+			assert.True(t, blkData.MayContainsKeyOnColumn(2, compute.GetValue(bat.Vecs[2], 10)))
+			// index files of replayed blocks are not loaded, they are never pruned
+			assert.Equal(t, !loaded, blkData.MayContainsKeyOnColumn(2, int32(-1)))
+			assert.Equal(t, !loaded, blkData.MayContainsRangeOnColumn(2, nil, int32(-1)))
+			assert.True(t, blkData.MayContainsRangeOnColumn(2, int32(-1), nil))
+			// not indexed columns are pruned by their zonemaps
+			assert.Equal(t, !loaded, blkData.MayContainsKeyOnColumn(1, int16(-1)))
+			assert.True(t, blkData.MayContainsKeyOnColumn(1, compute.GetValue(bat.Vecs[1], 10)))
+			checked++
+		}
+		assert.Equal(t, 1, checked)
+		assert.Nil(t, txn.Commit())
+	}
+	check(tae, true)
+	tae.Close()
+
+	tae2, err := Open(tae.Dir, nil)
+	assert.Nil(t, err)
+	defer tae2.Close()
+	check(tae2, false)
+
+	txn, _ := tae2.StartTxn(nil)
+	database, _ := txn.GetDatabase("db")
+	rel, _ := database.GetRelationByName(schema.Name)
+	assert.Nil(t, rel.DropIndex("idx"))
+	assert.Nil(t, txn.Commit())
+	assert.Equal(t, 0, len(rel.GetMeta().(*catalog.TableEntry).GetIndexes()))
+}
+
+func TestSecondaryIndexBackfill(t *testing.T) {
+	// the index op of the timed scanner is run by hand below
+	opts := new(options.Options)
+	opts.CheckpointCfg = &options.CheckpointCfg{
+		ScannerInterval:    3600000,
+		ExecutionInterval:  options.DefaultExecutionInterval,
+		ExecutionLevels:    options.DefaultExecutionLevels,
+		CatalogCkpInterval: options.DefaultCatalogCkpInterval,
+		CatalogUnCkpLimit:  options.DefaultCatalogUnCkpLimit,
+	}
+	tae := initDB(t, opts)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(13)
+	schema.BlockMaxRows = 1000
+	schema.SegmentMaxBlocks = 10
+	schema.PrimaryKey = 3
+
+	bat := compute.MockBatch(schema.Types(), uint64(schema.BlockMaxRows)*2, int(schema.PrimaryKey), nil)
+	{
+		txn, _ := tae.StartTxn(nil)
+		database, err := txn.CreateDatabase("db")
+		assert.Nil(t, err)
+		rel, err := database.CreateRelation(schema)
+		assert.Nil(t, err)
+		assert.Nil(t, rel.Append(bat))
+		assert.Nil(t, txn.Commit())
+	}
+	compactOne := func() {
+		txn, _ := tae.StartTxn(nil)
+		database, _ := txn.GetDatabase("db")
+		rel, _ := database.GetRelationByName(schema.Name)
+		it := rel.MakeBlockIt()
+		for it.Valid() {
+			blkMeta := it.GetBlock().GetMeta().(*catalog.BlockEntry)
+			it.Next()
+			if !blkMeta.IsAppendable() || blkMeta.HasDropped() {
+				continue
+			}
+			factory, taskType, scopes, err := blkMeta.GetBlockData().BuildCompactionTaskFactory()
+			assert.Nil(t, err)
+			task, err := tae.Scheduler.ScheduleMultiScopedTxnTask(tasks.WaitableCtx, taskType, scopes, factory)
+			assert.Nil(t, err)
+			assert.Nil(t, task.WaitDone())
+			break
+		}
+		assert.Nil(t, txn.Commit())
+	}
+	compactOne()
+	{
+		txn, _ := tae.StartTxn(nil)
+		database, _ := txn.GetDatabase("db")
+		rel, _ := database.GetRelationByName(schema.Name)
+		assert.Nil(t, rel.CreateIndex(catalog.NewIndexInfo("idx", catalog.ZoneMap, 2)))
+		assert.Nil(t, txn.Commit())
+	}
+	compactOne()
+
+	// check returns whether the non-appendable blocks have the static filter
+	// of the indexed column, they all have its zonemap
+	check := func() (filtered []bool) {
+		txn, _ := tae.StartTxn(nil)
+		database, _ := txn.GetDatabase("db")
+		rel, _ := database.GetRelationByName(schema.Name)
+		it := rel.MakeBlockIt()
+		for it.Valid() {
+			blkMeta := it.GetBlock().GetMeta().(*catalog.BlockEntry)
+			it.Next()
+			if blkMeta.IsAppendable() {
+				continue
+			}
+			metas, err := blkMeta.GetBlockData().GetBlockFile().LoadIndexMeta()
+			assert.Nil(t, err)
+			zonemap, filter := false, false
+			for _, meta := range metas.Metas {
+				if meta.ColIdx != 2 {
+					continue
+				}
+				zonemap = zonemap || meta.IdxType == idxCommon.BlockZoneMapIndex
+				filter = filter || meta.IdxType == idxCommon.StaticFilterIndex
+			}
+			assert.True(t, zonemap)
+			filtered = append(filtered, filter)
+		}
+		assert.Nil(t, txn.Commit())
+		return
+	}
+	// the block flushed before the index has no static filter of the
+	// indexed column, the one flushed after has
+	assert.ElementsMatch(t, []bool{false, true}, check())
+
+	txn, _ := tae.StartTxn(nil)
+	database, _ := txn.GetDatabase("db")
+	rel, _ := database.GetRelationByName(schema.Name)
+	tableEntry := rel.GetMeta().(*catalog.TableEntry)
+	assert.Nil(t, txn.Commit())
+	cols := tableEntry.GetIndexedColumns()
+	blks := collectUnindexedBlocks(tableEntry, cols)
+	assert.Equal(t, 1, len(blks))
+	task, err := tae.scheduleBuildIndexes(tasks.WaitableCtx, blks, cols)
+	assert.Nil(t, err)
+	assert.Nil(t, task.WaitDone())
+
+	// the block flushed before is backfilled and its filter is loaded
+	assert.ElementsMatch(t, []bool{true, true}, check())
+	assert.Equal(t, 0, len(collectUnindexedBlocks(tableEntry, cols)))
+	blkData := blks[0].GetBlockData()
+	assert.Equal(t, 0, len(blkData.GetUnindexedColumns(cols)))
+	txn, _ = tae.StartTxn(nil)
+	view, err := blkData.GetColumnDataById(txn, 2, nil, nil)
+	assert.Nil(t, err)
+	assert.True(t, blkData.MayContainsKeyOnColumn(2, compute.GetValue(view.AppliedVec, 0)))
+	assert.Nil(t, txn.Commit())
+}
+
+func TestTimeTravel(t *testing.T) {
+	opts := new(options.Options)
+	opts.TxnCfg = &options.TxnCfg{SnapshotRetention: 60000}
+	tae := initDB(t, opts)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(13)
+	schema.PrimaryKey = 3
+	bat := catalog.MockData(schema, 100)
+
+	_, err := tae.StartTxnAt(nil, time.Now().Add(-time.Second))
+	assert.ErrorIs(t, err, txnbase.ErrTxnSnapshotExpired)
+	_, err = tae.StartTxnAt(nil, time.Now().Add(time.Second))
+	assert.ErrorIs(t, err, txnbase.ErrTxnSnapshotInFuture)
+	time.Sleep(txnbase.SnapshotSampleInterval * 2)
+	started := time.Now()
+
+	txn, _ := tae.StartTxn(nil)
+	database, _ := txn.CreateDatabase("db")
+	rel, _ := database.CreateRelation(schema)
+	assert.NoError(t, rel.Append(bat))
+	assert.NoError(t, txn.Commit())
+	time.Sleep(txnbase.SnapshotSampleInterval * 2)
+	created := time.Now()
+	time.Sleep(txnbase.SnapshotSampleInterval * 2)
+
+	filter := handle.NewEQFilter(compute.GetValue(bat.Vecs[schema.PrimaryKey], 2))
+	txn, _ = tae.StartTxn(nil)
+	database, _ = txn.GetDatabase("db")
+	rel, _ = database.GetRelationByName(schema.Name)
+	assert.NoError(t, rel.UpdateByFilter(filter, 2, int32(2222)))
+	assert.NoError(t, txn.Commit())
+	time.Sleep(txnbase.SnapshotSampleInterval * 2)
+	updated := time.Now()
+
+	check := func(at time.Time, expected interface{}) {
+		txn, err := tae.StartTxnAt(nil, at)
+		assert.NoError(t, err)
+		database, err := txn.GetDatabase("db")
+		assert.NoError(t, err)
+		rel, err := database.GetRelationByName(schema.Name)
+		assert.NoError(t, err)
+		id, row, err := rel.GetByFilter(filter)
+		assert.NoError(t, err)
+		v, err := rel.GetValue(id, row, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, v)
+		assert.NoError(t, txn.Commit())
+	}
+	check(created, compute.GetValue(bat.Vecs[2], 2))
+	check(updated, int32(2222))
+
+	// the database does not exist at the start of the time travel
+	txn, err = tae.StartTxnAt(nil, started)
+	assert.NoError(t, err)
+	_, err = txn.GetDatabase("db")
+	assert.ErrorIs(t, err, catalog.ErrNotFound)
+	assert.NoError(t, txn.Commit())
+
+	// snapshot txns are read-only, the writes fail when they are made
+	txn, err = tae.StartTxnAt(nil, updated)
+	assert.NoError(t, err)
+	assert.True(t, tae.TxnMgr.StatSafeTS() < txn.GetStartTS())
+	database, _ = txn.GetDatabase("db")
+	rel, _ = database.GetRelationByName(schema.Name)
+	assert.ErrorIs(t, rel.UpdateByFilter(filter, 2, int32(3333)), txnbase.ErrTxnSnapshotReadonly)
+	id, row, err := rel.GetByFilter(filter)
+	assert.NoError(t, err)
+	assert.ErrorIs(t, rel.RangeDelete(id, row, row), txnbase.ErrTxnSnapshotReadonly)
+	assert.ErrorIs(t, rel.Append(catalog.MockData(schema, 1)), txnbase.ErrTxnSnapshotReadonly)
+	_, err = database.CreateRelation(catalog.MockSchema(2))
+	assert.ErrorIs(t, err, txnbase.ErrTxnSnapshotReadonly)
+	_, err = txn.CreateDatabase("db2")
+	assert.ErrorIs(t, err, txnbase.ErrTxnSnapshotReadonly)
+
+	// nothing is left in the update chains to conflict with a live txn
+	txn2, _ := tae.StartTxn(nil)
+	database, _ = txn2.GetDatabase("db")
+	rel, _ = database.GetRelationByName(schema.Name)
+	assert.NoError(t, rel.UpdateByFilter(filter, 2, int32(4444)))
+	assert.NoError(t, txn2.Commit())
+	assert.NoError(t, txn.Commit())
+	check(time.Now(), int32(4444))
+}
+
+func TestAlterTable(t *testing.T) {
+	tae := initDB(t, nil)
+	schema := catalog.MockSchemaAll(4)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	schema.PrimaryKey = 3
+	bats := compute.SplitBatch(catalog.MockData(schema, 20), 4)
+	{
+		txn, _ := tae.StartTxn(nil)
+		database, _ := txn.CreateDatabase("db")
+		rel, _ := database.CreateRelation(schema)
+		for _, bat := range bats[:3] {
+			assert.NoError(t, rel.Append(bat))
+		}
+		assert.NoError(t, txn.Commit())
+	}
+
+	int64Type := schema.ColDefs[3].Type
+	def, _ := common.EncodeKey(int32(7), schema.ColDefs[2].Type)
+	actions := []*catalog.AlterAction{
+		{Op: catalog.AlterAddColumn, Def: &catalog.ColDef{Name: "added", Type: schema.ColDefs[2].Type, Default: def}},
+		{Op: catalog.AlterDropColumn, Name: "mock_1"},
+		{Op: catalog.AlterRenameColumn, Name: "mock_0", NewName: "renamed"},
+		{Op: catalog.AlterModifyColumn, Name: "mock_2", Def: &catalog.ColDef{Type: int64Type}},
+	}
+	{
+		txn, _ := tae.StartTxn(nil)
+		database, _ := txn.GetDatabase("db")
+		rel, _ := database.GetRelationByName(schema.Name)
+		err := rel.AlterTable([]*catalog.AlterAction{{Op: catalog.AlterDropColumn, Name: "mock_3"}})
+		assert.ErrorIs(t, err, catalog.ErrNotPermitted)
+		err = rel.AlterTable([]*catalog.AlterAction{{Op: catalog.AlterModifyColumn, Name: "mock_2", Def: &catalog.ColDef{Type: schema.ColDefs[0].Type}}})
+		assert.ErrorIs(t, err, catalog.ErrNotPermitted)
+		assert.NoError(t, txn.Rollback())
+	}
+
+	// a txn appending with the old schema cannot commit after the alter
+	appendTxn, _ := tae.StartTxn(nil)
+	database, _ := appendTxn.GetDatabase("db")
+	rel, _ := database.GetRelationByName(schema.Name)
+	assert.NoError(t, rel.Append(bats[3]))
+	{
+		txn, _ := tae.StartTxn(nil)
+		database, _ := txn.GetDatabase("db")
+		rel, _ := database.GetRelationByName(schema.Name)
+		assert.NoError(t, rel.AlterTable(actions))
+		assert.ErrorIs(t, rel.Append(bats[3]), txnimpl.ErrAlterConflict)
+		assert.NoError(t, txn.Commit())
+	}
+	assert.ErrorIs(t, appendTxn.Commit(), catalog.ErrSchemaChanged)
+
+	var altered *catalog.Schema
+	check := func(tae *DB, rows int, updated map[int64]int32) {
+		txn, _ := tae.StartTxn(nil)
+		database, _ := txn.GetDatabase("db")
+		rel, _ := database.GetRelationByName(schema.Name)
+		altered = rel.GetSchema().(*catalog.Schema)
+		assert.Equal(t, []string{"renamed", "mock_2", "mock_3", "added"}, altered.Attrs())
+		assert.Equal(t, int64Type, altered.ColDefs[1].Type)
+		it := rel.MakeBlockIt()
+		cnt := 0
+		for it.Valid() {
+			blk := it.GetBlock()
+			it.Next()
+			pkView, err := blk.GetColumnDataByName("mock_3", nil, nil)
+			assert.NoError(t, err)
+			modified, err := blk.GetColumnDataByName("mock_2", nil, nil)
+			assert.NoError(t, err)
+			added, err := blk.GetColumnDataByName("added", nil, nil)
+			assert.NoError(t, err)
+			for row := 0; row < vector.Length(pkView.AppliedVec); row++ {
+				if pkView.DeleteMask != nil && pkView.DeleteMask.Contains(uint32(row)) {
+					continue
+				}
+				pk := compute.GetValue(pkView.AppliedVec, uint32(row)).(int64)
+				assert.Equal(t, int64(compute.GetValue(bats[pk/5].Vecs[2], uint32(pk%5)).(int32)), compute.GetValue(modified.AppliedVec, uint32(row)))
+				expected, ok := updated[pk]
+				if !ok {
+					expected = 7
+				}
+				assert.Equal(t, expected, compute.GetValue(added.AppliedVec, uint32(row)))
+				cnt++
+			}
+		}
+		assert.Equal(t, rows, cnt)
+		assert.NoError(t, txn.Commit())
+	}
+	check(tae, 15, nil)
+
+	updated := map[int64]int32{}
+	{
+		txn, _ := tae.StartTxn(nil)
+		database, _ := txn.GetDatabase("db")
+		rel, _ := database.GetRelationByName(schema.Name)
+		newBat := catalog.MockData(altered, 20)
+		newBats := compute.SplitBatch(newBat, 4)
+		for row := 0; row < 5; row++ {
+			newBats[3].Vecs[1].Col.([]int64)[row] = int64(compute.GetValue(bats[3].Vecs[2], uint32(row)).(int32))
+			updated[15+int64(row)] = newBats[3].Vecs[3].Col.([]int32)[row]
+		}
+		assert.NoError(t, rel.Append(newBats[3]))
+		// the updated column has no values in the old block
+		filter := handle.NewEQFilter(int64(2))
+		assert.NoError(t, rel.UpdateByFilter(filter, 3, int32(9)))
+		updated[2] = 9
+		id, row, err := rel.GetByFilter(filter)
+		assert.NoError(t, err)
+		v, err := rel.GetValue(id, row, 3)
+		assert.NoError(t, err)
+		assert.Equal(t, int32(9), v)
+		assert.NoError(t, txn.Commit())
+	}
+	check(tae, 20, updated)
+
+	// compaction rewrites the blocks of the old schema with the new one
+	{
+		txn, _ := tae.StartTxn(nil)
+		database, _ := txn.GetDatabase("db")
+		rel, _ := database.GetRelationByName(schema.Name)
+		it := rel.MakeBlockIt()
+		var stale []*catalog.BlockEntry
+		for it.Valid() {
+			meta := it.GetBlock().GetMeta().(*catalog.BlockEntry)
+			if meta.IsSchemaStale() {
+				stale = append(stale, meta)
+			}
+			it.Next()
+		}
+		assert.NoError(t, txn.Commit())
+		assert.Equal(t, 2, len(stale))
+		for _, meta := range stale {
+			factory, taskType, scopes, err := meta.GetBlockData().BuildCompactionTaskFactory()
+			assert.NoError(t, err)
+			task, err := tae.Scheduler.ScheduleMultiScopedTxnTask(tasks.WaitableCtx, taskType, scopes, factory)
+			assert.NoError(t, err)
+			assert.NoError(t, task.WaitDone())
+		}
+	}
+	check(tae, 20, updated)
+	tae.Close()
+
+	// the schema versions are replayed from the log
+	tae2, err := Open(tae.Dir, nil)
+	assert.NoError(t, err)
+	defer tae2.Close()
+	txn, _ := tae2.StartTxn(nil)
+	database, _ = txn.GetDatabase("db")
+	rel, _ = database.GetRelationByName(schema.Name)
+	replayed := rel.GetSchema().(*catalog.Schema)
+	assert.Equal(t, altered.Attrs(), replayed.Attrs())
+	assert.Equal(t, altered.Version, replayed.Version)
+	assert.Equal(t, altered.ColSeqNum, replayed.ColSeqNum)
+	assert.NoError(t, txn.Commit())
+}
+
+func TestColumnCodecs(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(14)
+	schema.BlockMaxRows = 1000
+	codecs := []uint8{
+		compress.None, compress.Zstd, compress.Delta, compress.RLE,
+		compress.Auto, compress.Lz4, compress.Delta, compress.Zstd,
+		compress.RLE, compress.Delta, compress.Delta, compress.Delta,
+		compress.Dict, compress.Auto,
+	}
+	for i, codec := range codecs {
+		schema.ColDefs[i].Compress = codec
+	}
+	bat := catalog.MockData(schema, schema.BlockMaxRows)
+	{
+		txn, _ := tae.StartTxn(nil)
+		database, _ := txn.CreateDatabase("db")
+		rel, _ := database.CreateRelation(schema)
+		assert.NoError(t, rel.Append(bat))
+		assert.NoError(t, txn.Commit())
+	}
+	var meta *catalog.BlockEntry
+	{
+		txn, _ := tae.StartTxn(nil)
+		database, _ := txn.GetDatabase("db")
+		rel, _ := database.GetRelationByName(schema.Name)
+		it := rel.MakeBlockIt()
+		meta = it.GetBlock().GetMeta().(*catalog.BlockEntry)
+		assert.NoError(t, txn.Commit())
+	}
+	factory, taskType, scopes, err := meta.GetBlockData().BuildCompactionTaskFactory()
+	assert.NoError(t, err)
+	task, err := tae.Scheduler.ScheduleMultiScopedTxnTask(tasks.WaitableCtx, taskType, scopes, factory)
+	assert.NoError(t, err)
+	assert.NoError(t, task.WaitDone())
+
+	// the rows are sorted by the primary key after compaction
+	srcRows := make(map[string]uint32)
+	for row := 0; row < vector.Length(bat.Vecs[schema.PrimaryKey]); row++ {
+		srcRows[fmt.Sprint(compute.GetValue(bat.Vecs[schema.PrimaryKey], uint32(row)))] = uint32(row)
+	}
+	txn, _ := tae.StartTxn(nil)
+	database, _ := txn.GetDatabase("db")
+	rel, _ := database.GetRelationByName(schema.Name)
+	it := rel.MakeBlockIt()
+	blk := it.GetBlock()
+	assert.False(t, blk.IsAppendableBlock())
+	file := blk.GetMeta().(*catalog.BlockEntry).GetBlockData().GetBlockFile()
+	for i := range schema.ColDefs {
+		colBlk, err := file.OpenColumn(i)
+		assert.NoError(t, err)
+		algo := colBlk.GetDataFileStat().CompressAlgo()
+		colBlk.Close()
+		switch i {
+		case 4, 13:
+			// picked by sampling the data
+			assert.NotEqual(t, compress.Auto, algo)
+		case 9:
+			// delta does not apply to floats
+			assert.Equal(t, compress.Lz4, algo)
+		default:
+			assert.Equal(t, int(codecs[i]), algo)
+		}
+
+		view, err := blk.GetColumnDataById(i, nil, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, int(schema.BlockMaxRows), vector.Length(view.AppliedVec))
+		pks, err := blk.GetColumnDataById(int(schema.PrimaryKey), nil, nil)
+		assert.NoError(t, err)
+		for row := 0; row < vector.Length(view.AppliedVec); row++ {
+			src := srcRows[fmt.Sprint(compute.GetValue(pks.AppliedVec, uint32(row)))]
+			assert.Equal(t, compute.GetValue(bat.Vecs[i], src), compute.GetValue(view.AppliedVec, uint32(row)))
+		}
+	}
+	assert.NoError(t, txn.Commit())
+}
+
+func TestBufferEvictPolicy(t *testing.T) {
+	opts := new(options.Options)
+	opts.CacheCfg = &options.CacheCfg{
+		IndexCapacity:  options.DefaultIndexCacheSize,
+		InsertCapacity: options.DefaultMTCacheSize,
+		TxnCapacity:    options.DefaultTxnCacheSize,
+		EvictPolicy:    "lru",
+	}
+	_, err := Open(testutils.InitTestEnv(ModuleName, t), opts)
+	assert.ErrorIs(t, err, buffer.ErrBadEvictPolicy)
+
+	opts.CacheCfg.EvictPolicy = "2q-clock"
+	tae := initDB(t, opts)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(3)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	schema.PrimaryKey = 2
+	bat := catalog.MockData(schema, 15)
+	{
+		txn, _ := tae.StartTxn(nil)
+		database, _ := txn.CreateDatabase("db")
+		rel, _ := database.CreateRelation(schema)
+		assert.NoError(t, rel.Append(bat))
+		assert.NoError(t, txn.Commit())
+	}
+	txn, _ := tae.StartTxn(nil)
+	database, _ := txn.GetDatabase("db")
+	rel, _ := database.GetRelationByName(schema.Name)
+	for i := 0; i < 2; i++ {
+		it := rel.MakeBlockIt()
+		for it.Valid() {
+			view, err := it.GetBlock().GetColumnDataById(0, nil, nil)
+			assert.NoError(t, err)
+			assert.NotZero(t, vector.Length(view.AppliedVec))
+			it.Next()
+		}
+	}
+	assert.NoError(t, txn.Commit())
+
+	stats := tae.BufferStats()
+	assert.Equal(t, 3, len(stats))
+	insert := stats["insert"]
+	assert.NotZero(t, insert.Hits)
+	assert.NotZero(t, insert.Misses)
+	assert.Zero(t, insert.Evicts)
+}
+
+func TestWalSyncPolicy(t *testing.T) {
+	opts := new(options.Options)
+	opts.WalCfg = &options.WalCfg{SyncPolicy: "always"}
+	_, err := Open(testutils.InitTestEnv(ModuleName, t), opts)
+	assert.ErrorIs(t, err, store.ErrBadSyncPolicy)
+
+	opts.WalCfg.SyncPolicy = "commit"
+	tae := initDB(t, opts)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(3)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	schema.PrimaryKey = 2
+	bats := compute.SplitBatch(catalog.MockData(schema, 20), 4)
+	{
+		txn, _ := tae.StartTxn(nil)
+		database, _ := txn.CreateDatabase("db")
+		_, err := database.CreateRelation(schema)
+		assert.NoError(t, err)
+		assert.NoError(t, txn.Commit())
+	}
+	var wg sync.WaitGroup
+	for _, bat := range bats {
+		wg.Add(1)
+		go func(bat *gbat.Batch) {
+			defer wg.Done()
+			txn, _ := tae.StartTxn(nil)
+			database, _ := txn.GetDatabase("db")
+			rel, _ := database.GetRelationByName(schema.Name)
+			assert.NoError(t, rel.Append(bat))
+			assert.NoError(t, txn.Commit())
+		}(bat)
+	}
+	wg.Wait()
+	stats := tae.Wal.SyncStats()
+	assert.NotZero(t, stats.Syncs)
+	assert.GreaterOrEqual(t, stats.Entries, uint64(len(bats)))
+}
@@ -0,0 +1,105 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tasks"
+)
+
+// indexOp schedules building the indexes of the secondary indexes on the
+// flushed blocks that miss them, the blocks flushed before CREATE INDEX and
+// the blocks replayed after a restart.
+type indexOp struct {
+	*catalog.LoopProcessor
+	db *DB
+}
+
+func newIndexOp(db *DB) *indexOp {
+	processor := &indexOp{
+		LoopProcessor: new(catalog.LoopProcessor),
+		db:            db,
+	}
+	processor.TableFn = processor.onTable
+	return processor
+}
+
+func (processor *indexOp) PreExecute() error  { return nil }
+func (processor *indexOp) PostExecute() error { return nil }
+
+func (processor *indexOp) onTable(tableEntry *catalog.TableEntry) (err error) {
+	tableEntry.RLock()
+	skip := !tableEntry.IsCommitted() || tableEntry.IsDroppedCommitted()
+	tableEntry.RUnlock()
+	if skip {
+		return
+	}
+	cols := tableEntry.GetIndexedColumns()
+	if len(cols) == 0 {
+		return
+	}
+	if blks := collectUnindexedBlocks(tableEntry, cols); len(blks) > 0 {
+		_, _ = processor.db.scheduleBuildIndexes(nil, blks, cols)
+	}
+	return
+}
+
+// collectUnindexedBlocks returns the committed non-appendable blocks of the
+// table that miss the indexes of some columns of cols.
+func collectUnindexedBlocks(tableEntry *catalog.TableEntry, cols []int) (blks []*catalog.BlockEntry) {
+	segIt := tableEntry.MakeSegmentIt(true)
+	for ; segIt.Valid(); segIt.Next() {
+		segment := segIt.Get().GetPayload().(*catalog.SegmentEntry)
+		segment.RLock()
+		skip := !segment.IsCommitted() || segment.IsDroppedCommitted()
+		segment.RUnlock()
+		if skip {
+			continue
+		}
+		// the blocks in an active txn are left to the next scan
+		for _, blk := range segment.CollectBlockEntries(catalog.ActiveWithNoTxnFilter, nil) {
+			if blk.IsAppendable() {
+				continue
+			}
+			if len(blk.GetBlockData().GetUnindexedColumns(cols)) > 0 {
+				blks = append(blks, blk)
+			}
+		}
+	}
+	return
+}
+
+func (db *DB) scheduleBuildIndexes(ctx *tasks.Context, blks []*catalog.BlockEntry, cols []int) (task tasks.Task, err error) {
+	scopes := MakeBlockScopes(blks...)
+	fn := func() error {
+		for _, blk := range blks {
+			blk.RLock()
+			dropped := blk.IsDroppedCommitted()
+			blk.RUnlock()
+			if dropped {
+				continue
+			}
+			if err := blk.GetBlockData().BuildColumnIndexes(cols); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	task, err = db.Scheduler.ScheduleMultiScopedFn(ctx, tasks.DataCompactionTask, scopes, fn)
+	logutil.Infof("[BuildIndexes] | Blocks=%d | Columns=%v | Scheduled | State=%v | Scopes=%s", len(blks), cols, err, common.IDArraryString(scopes))
+	return
+}
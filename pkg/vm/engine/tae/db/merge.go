@@ -0,0 +1,290 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/checkpoint"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/file"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables/jobs"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tasks"
+)
+
+// mergeOp schedules the merges decided by the merge policies of the tables
+type mergeOp struct {
+	*catalog.LoopProcessor
+	db *DB
+	// seen is the time each segment was first seen, for the age of it
+	seen    map[uint64]time.Time
+	visited map[uint64]bool
+}
+
+func newMergeOp(db *DB) *mergeOp {
+	processor := &mergeOp{
+		LoopProcessor: new(catalog.LoopProcessor),
+		db:            db,
+		seen:          make(map[uint64]time.Time),
+	}
+	processor.TableFn = processor.onTable
+	return processor
+}
+
+func (processor *mergeOp) PreExecute() error {
+	processor.visited = make(map[uint64]bool)
+	return nil
+}
+
+func (processor *mergeOp) PostExecute() error {
+	for id := range processor.seen {
+		if !processor.visited[id] {
+			delete(processor.seen, id)
+		}
+	}
+	return nil
+}
+
+func (processor *mergeOp) age(segment *catalog.SegmentEntry) time.Duration {
+	now := time.Now()
+	processor.visited[segment.GetID()] = true
+	first, ok := processor.seen[segment.GetID()]
+	if !ok {
+		processor.seen[segment.GetID()] = now
+		first = now
+	}
+	return now.Sub(first)
+}
+
+func (processor *mergeOp) onTable(tableEntry *catalog.TableEntry) (err error) {
+	tableEntry.RLock()
+	skip := !tableEntry.IsCommitted() || tableEntry.IsDroppedCommitted()
+	tableEntry.RUnlock()
+	if skip {
+		return
+	}
+	schema := tableEntry.GetSchema()
+	for _, segs := range groupByPartition(processor.db.collectSegmentStats(tableEntry, processor.age)) {
+		for _, merge := range processor.db.getMergePolicy(schema).Decide(schema, segs, false) {
+			_, _ = processor.db.scheduleMerge(nil, merge)
+		}
+	}
+	return
+}
+
+// groupByPartition groups the segments by their table partitions, the
+// blocks of different partitions are never merged together
+func groupByPartition(segs []*checkpoint.SegmentStats) (groups [][]*checkpoint.SegmentStats) {
+	pos := make(map[uint32]int)
+	for _, seg := range segs {
+		partition := seg.Segment.GetPartition()
+		i, ok := pos[partition]
+		if !ok {
+			i = len(groups)
+			pos[partition] = i
+			groups = append(groups, nil)
+		}
+		groups[i] = append(groups[i], seg)
+	}
+	return
+}
+
+// getMergePolicy returns the merge policy of the table of schema
+func (db *DB) getMergePolicy(schema *catalog.Schema) checkpoint.MergePolicy {
+	if schema.MergePolicy == "" {
+		return db.MergePolicy
+	}
+	policy, err := checkpoint.NewMergePolicy(schema.MergePolicy)
+	if err != nil {
+		logutil.Warnf("table %s: %v", schema.Name, err)
+		return db.MergePolicy
+	}
+	return policy
+}
+
+func (db *DB) scheduleMerge(ctx *tasks.Context, merge *checkpoint.Merge) (task tasks.Task, err error) {
+	scopes := make([]common.ID, 0, len(merge.Blocks))
+	for _, blk := range merge.Blocks {
+		scopes = append(scopes, *blk.AsCommonID())
+	}
+	factory := jobs.MergeSegmentsTaskFactory(merge.Blocks, merge.Segments, db.Scheduler)
+	task, err = db.Scheduler.ScheduleMultiScopedTxnTask(ctx, tasks.DataCompactionTask, scopes, factory)
+	logutil.Infof("[Mergeblocks] | Segments=%d | Scheduled | State=%v | Scopes=%s", len(merge.Segments), err, common.IDArraryString(scopes))
+	return
+}
+
+// collectSegmentStats returns the stats of the segments of the table which
+// can be merged. age returns the age of a segment, nil for no age.
+func (db *DB) collectSegmentStats(tableEntry *catalog.TableEntry, age func(*catalog.SegmentEntry) time.Duration) (segs []*checkpoint.SegmentStats) {
+	filter := catalog.NewComposedFilter()
+	filter.AddBlockFilter(catalog.NonAppendableBlkFilter)
+	filter.AddCommitFilter(catalog.ActiveWithNoTxnFilter)
+	notDropped := func(be *catalog.BaseEntry) bool { return !be.IsDroppedCommitted() }
+	segIt := tableEntry.MakeSegmentIt(true)
+	for ; segIt.Valid(); segIt.Next() {
+		segment := segIt.Get().GetPayload().(*catalog.SegmentEntry)
+		segment.RLock()
+		skip := !segment.IsCommitted() || segment.IsDroppedCommitted() || segment.HasActiveTxn()
+		segment.RUnlock()
+		if skip {
+			continue
+		}
+		blks := segment.CollectBlockEntries(filter.FilteCommit, filter.FilteBlock)
+		if len(blks) == 0 {
+			continue
+		}
+		// a non-appendable segment is dropped with the merge, which needs
+		// all its blocks
+		if !segment.IsAppendable() && len(segment.CollectBlockEntries(notDropped, nil)) != len(blks) {
+			continue
+		}
+		stats := &checkpoint.SegmentStats{
+			Segment: segment,
+			Blocks:  blks,
+		}
+		for _, blk := range blks {
+			blkData := blk.GetBlockData()
+			stats.Rows += blkData.Rows(nil, true)
+			stats.Deletes += int(blkData.GetDeleteCnt())
+			stats.Size += blockDataSize(blkData.GetBlockFile(), len(blk.GetSchema().ColDefs))
+		}
+		if age != nil {
+			stats.Age = age(segment)
+		}
+		segs = append(segs, stats)
+	}
+	countOverlaps(tableEntry.GetSchema(), segs)
+	return
+}
+
+func blockDataSize(blkFile file.Block, cols int) (size int64) {
+	for i := 0; i < cols; i++ {
+		colBlk, err := blkFile.OpenColumn(i)
+		if err != nil {
+			continue
+		}
+		size += colBlk.GetDataFileStat().Size()
+		colBlk.Close()
+	}
+	return
+}
+
+// countOverlaps counts the non-appendable segments whose primary key zonemaps
+// overlap with each other
+func countOverlaps(schema *catalog.Schema, segs []*checkpoint.SegmentStats) {
+	seqNum := schema.GetPKColumnDef().SeqNum
+	var stats []*checkpoint.SegmentStats
+	var minKeys, maxKeys []interface{}
+	for _, seg := range segs {
+		if seg.Segment.IsAppendable() {
+			continue
+		}
+		zm := seg.Segment.GetSegmentData().GetColumnZoneMap(seqNum)
+		if zm == nil || !zm.Initialized() {
+			continue
+		}
+		stats = append(stats, seg)
+		minKeys = append(minKeys, zm.GetMin())
+		maxKeys = append(maxKeys, zm.GetMax())
+	}
+	typ := schema.GetPKType()
+	for i := range stats {
+		for j := i + 1; j < len(stats); j++ {
+			if common.CompareGeneric(minKeys[i], maxKeys[j], typ) <= 0 &&
+				common.CompareGeneric(minKeys[j], maxKeys[i], typ) <= 0 {
+				stats[i].Overlaps++
+				stats[j].Overlaps++
+			}
+		}
+	}
+}
+
+// Compact forces the merge policy of a table to merge its blocks, for ALTER
+// TABLE ... COMPACT. The appendable blocks which are full are compacted
+// first. It returns after the merges are done.
+func (db *DB) Compact(dbName, tableName string) (err error) {
+	txn, err := db.StartTxn(nil)
+	if err != nil {
+		return
+	}
+	database, err := txn.GetDatabase(dbName)
+	if err != nil {
+		_ = txn.Rollback()
+		return
+	}
+	rel, err := database.GetRelationByName(tableName)
+	if err != nil {
+		_ = txn.Rollback()
+		return
+	}
+	tableEntry := rel.GetMeta().(*catalog.TableEntry)
+	if err = txn.Rollback(); err != nil {
+		return
+	}
+
+	var scheduled []tasks.Task
+	filter := catalog.NewComposedFilter()
+	filter.AddBlockFilter(catalog.AppendableBlkFilter)
+	filter.AddCommitFilter(catalog.ActiveWithNoTxnFilter)
+	segIt := tableEntry.MakeSegmentIt(true)
+	for ; segIt.Valid(); segIt.Next() {
+		segment := segIt.Get().GetPayload().(*catalog.SegmentEntry)
+		for _, blk := range segment.CollectBlockEntries(filter.FilteCommit, filter.FilteBlock) {
+			blkData := blk.GetBlockData()
+			if blkData.IsAppendable() || blkData.Rows(nil, true) == 0 {
+				continue
+			}
+			factory, taskType, scopes, err := blkData.BuildCompactionTaskFactory()
+			if err != nil {
+				return err
+			}
+			if factory == nil {
+				continue
+			}
+			task, err := db.Scheduler.ScheduleMultiScopedTxnTask(tasks.WaitableCtx, taskType, scopes, factory)
+			if err != nil {
+				return err
+			}
+			scheduled = append(scheduled, task)
+		}
+	}
+	if err = waitTasks(scheduled); err != nil {
+		return
+	}
+
+	scheduled = scheduled[:0]
+	schema := tableEntry.GetSchema()
+	for _, segs := range groupByPartition(db.collectSegmentStats(tableEntry, nil)) {
+		for _, merge := range db.getMergePolicy(schema).Decide(schema, segs, true) {
+			task, err := db.scheduleMerge(tasks.WaitableCtx, merge)
+			if err != nil {
+				return err
+			}
+			scheduled = append(scheduled, task)
+		}
+	}
+	return waitTasks(scheduled)
+}
+
+func waitTasks(scheduled []tasks.Task) (err error) {
+	for _, task := range scheduled {
+		if waitErr := task.WaitDone(); waitErr != nil && err == nil {
+			err = waitErr
+		}
+	}
+	return
+}
@@ -0,0 +1,205 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/checkpoint"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
+	"github.com/stretchr/testify/assert"
+)
+
+func initMergeDB(t *testing.T) *DB {
+	opts := new(options.Options)
+	opts.CheckpointCfg = new(options.CheckpointCfg)
+	opts.CheckpointCfg.ScannerInterval = 10000
+	opts.CheckpointCfg.ExecutionLevels = 20
+	opts.CheckpointCfg.ExecutionInterval = 20000
+	return initDB(t, opts)
+}
+
+// segmentsOf returns the live segments of the table
+func segmentsOf(tableEntry *catalog.TableEntry) (appendable, nonAppendable []*catalog.SegmentEntry) {
+	segIt := tableEntry.MakeSegmentIt(true)
+	for ; segIt.Valid(); segIt.Next() {
+		segment := segIt.Get().GetPayload().(*catalog.SegmentEntry)
+		if segment.IsDroppedCommitted() {
+			continue
+		}
+		if segment.IsAppendable() {
+			appendable = append(appendable, segment)
+		} else {
+			nonAppendable = append(nonAppendable, segment)
+		}
+	}
+	return
+}
+
+func scanRows(t *testing.T, rel handle.Relation, pkIdx int) (rows int) {
+	it := rel.MakeBlockIt()
+	for it.Valid() {
+		view, err := it.GetBlock().GetColumnDataById(pkIdx, nil, nil)
+		assert.NoError(t, err)
+		rows += vector.Length(view.ApplyDeletes())
+		it.Next()
+	}
+	return
+}
+
+func TestCompactTable(t *testing.T) {
+	tae := initMergeDB(t)
+	defer tae.Close()
+	schema := catalog.MockSchema(2)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	schema.PrimaryKey = 1
+	schema.MergePolicy = "deletes:0.5"
+	bat := compute.MockBatch(schema.Types(), 30, int(schema.PrimaryKey), nil)
+	{
+		txn, _ := tae.StartTxn(nil)
+		database, err := txn.CreateDatabase("db")
+		assert.NoError(t, err)
+		rel, err := database.CreateRelation(schema)
+		assert.NoError(t, err)
+		assert.NoError(t, rel.Append(bat))
+		assert.NoError(t, txn.Commit())
+	}
+	assert.Error(t, tae.Compact("db", "missing"))
+
+	// the full appendable blocks are compacted and the full segment merged
+	assert.NoError(t, tae.Compact("db", schema.Name))
+	txn, _ := tae.StartTxn(nil)
+	database, _ := txn.GetDatabase("db")
+	rel, _ := database.GetRelationByName(schema.Name)
+	tableEntry := rel.GetMeta().(*catalog.TableEntry)
+	appendable, nonAppendable := segmentsOf(tableEntry)
+	assert.Equal(t, 1, len(appendable))
+	assert.Equal(t, 1, len(nonAppendable))
+	assert.Equal(t, 30, scanRows(t, rel, int(schema.PrimaryKey)))
+	merged := nonAppendable[0]
+
+	// delete 12 of the 20 rows of the merged segment
+	seg, err := rel.GetSegment(merged.GetID())
+	assert.NoError(t, err)
+	blkIt := seg.MakeBlockIt()
+	for blkIt.Valid() {
+		assert.NoError(t, blkIt.GetBlock().RangeDelete(0, 5))
+		blkIt.Next()
+	}
+	assert.NoError(t, txn.Commit())
+
+	// the segment is rewritten without the deleted rows
+	assert.NoError(t, tae.Compact("db", schema.Name))
+	txn, _ = tae.StartTxn(nil)
+	database, _ = txn.GetDatabase("db")
+	rel, _ = database.GetRelationByName(schema.Name)
+	_, nonAppendable = segmentsOf(tableEntry)
+	assert.Equal(t, 1, len(nonAppendable))
+	assert.NotEqual(t, merged.GetID(), nonAppendable[0].GetID())
+	assert.Equal(t, 18, scanRows(t, rel, int(schema.PrimaryKey)))
+	stats := tae.collectSegmentStats(tableEntry, nil)
+	for _, s := range stats {
+		if s.Segment == nonAppendable[0] {
+			assert.Equal(t, 8, s.Rows)
+			assert.Equal(t, 0, s.Deletes)
+		}
+	}
+	assert.NoError(t, txn.Commit())
+
+	// nothing more to merge
+	assert.NoError(t, tae.Compact("db", schema.Name))
+	_, after := segmentsOf(tableEntry)
+	assert.Equal(t, nonAppendable, after)
+}
+
+func TestMergePolicyDecide(t *testing.T) {
+	tae := initMergeDB(t)
+	defer tae.Close()
+	schema := catalog.MockSchema(2)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	schema.PrimaryKey = 1
+	var segs []*checkpoint.SegmentStats
+	{
+		txn, _ := tae.StartTxn(nil)
+		database, err := txn.CreateDatabase("db")
+		assert.NoError(t, err)
+		rel, err := database.CreateRelation(schema)
+		assert.NoError(t, err)
+		for i := 0; i < 6; i++ {
+			seg, err := rel.CreateNonAppendableSegment()
+			assert.NoError(t, err)
+			blk, err := seg.CreateNonAppendableBlock()
+			assert.NoError(t, err)
+			segs = append(segs, &checkpoint.SegmentStats{
+				Segment: seg.GetMeta().(*catalog.SegmentEntry),
+				Blocks:  []*catalog.BlockEntry{blk.GetMeta().(*catalog.BlockEntry)},
+			})
+		}
+		assert.NoError(t, txn.Commit())
+	}
+	setRows := func(rows ...int) {
+		for i, n := range rows {
+			segs[i].Rows, segs[i].Deletes = n, 0
+		}
+	}
+
+	tiered, err := checkpoint.NewMergePolicy("")
+	assert.NoError(t, err)
+	// 4 segments of the tier of 2 or 3 rows are merged, the others are in
+	// their own tiers or too big
+	setRows(3, 2, 3, 3, 9, 12)
+	merges := tiered.Decide(schema, segs, false)
+	assert.Equal(t, 1, len(merges))
+	assert.Equal(t, 4, len(merges[0].Segments))
+	assert.Equal(t, 4, len(merges[0].Blocks))
+	assert.Equal(t, 0, len(tiered.Decide(schema, segs[1:], false)))
+	// all the small segments are merged if forced
+	merges = tiered.Decide(schema, segs, true)
+	assert.Equal(t, 1, len(merges))
+	assert.Equal(t, 5, len(merges[0].Segments))
+	// the merges are no bigger than a segment
+	setRows(9, 8, 7, 6, 5, 4)
+	merges = tiered.Decide(schema, segs, true)
+	assert.Equal(t, 2, len(merges))
+	for _, merge := range merges {
+		rows := 0
+		for _, seg := range merge.Segments {
+			for _, s := range segs {
+				if s.Segment == seg {
+					rows += s.LiveRows()
+				}
+			}
+		}
+		assert.True(t, rows <= 20)
+	}
+
+	deletes, err := checkpoint.NewMergePolicy("deletes:0.5")
+	assert.NoError(t, err)
+	setRows(10, 10, 10, 10, 10, 10)
+	segs[0].Deletes = 5
+	segs[1].Deletes = 1
+	segs[2].Deletes = 10
+	merges = deletes.Decide(schema, segs, false)
+	assert.Equal(t, 1, len(merges))
+	assert.Equal(t, segs[0].Segment, merges[0].Segments[0])
+	// a segment without live rows is not rewritten
+	assert.Equal(t, 2, len(deletes.Decide(schema, segs, true)))
+}
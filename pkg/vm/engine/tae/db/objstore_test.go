@@ -0,0 +1,105 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables/jobs"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOffloadSegment(t *testing.T) {
+	opts := new(options.Options)
+	opts.ObjectStoreCfg = &options.ObjectStoreCfg{
+		Backend: options.ObjectStoreLocal,
+	}
+	tae := initDB(t, opts)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(4)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 4
+	schema.PrimaryKey = 2
+	bat := compute.MockBatch(schema.Types(), uint64(schema.BlockMaxRows*3), int(schema.PrimaryKey), nil)
+	{
+		txn, _ := tae.StartTxn(nil)
+		db, _ := txn.CreateDatabase("db")
+		rel, _ := db.CreateRelation(schema)
+		assert.Nil(t, rel.Append(bat))
+		assert.Nil(t, txn.Commit())
+	}
+	{
+		txn, _ := tae.StartTxn(nil)
+		db, _ := txn.GetDatabase("db")
+		rel, _ := db.GetRelationByName(schema.Name)
+		blks := make([]*catalog.BlockEntry, 0)
+		it := rel.MakeBlockIt()
+		for it.Valid() {
+			blks = append(blks, it.GetBlock().GetMeta().(*catalog.BlockEntry))
+			it.Next()
+		}
+		factory := jobs.CompactSegmentTaskFactory(blks, tae.Scheduler)
+		task, err := factory(nil, txn)
+		assert.Nil(t, err)
+		assert.Nil(t, task.OnExec())
+		assert.Nil(t, txn.Commit())
+	}
+
+	var merged *catalog.SegmentEntry
+	processor := new(catalog.LoopProcessor)
+	processor.SegmentFn = func(entry *catalog.SegmentEntry) error {
+		if !entry.IsAppendable() {
+			merged = entry
+		}
+		return catalog.ErrStopCurrRecur
+	}
+	assert.Nil(t, tae.Catalog.RecurLoop(processor))
+	assert.NotNil(t, merged)
+	segFile := merged.GetSegmentData().GetSegmentFile().GetSegmentFile()
+	testutils.WaitExpect(2000, segFile.IsOffloaded)
+	assert.True(t, segFile.IsOffloaded())
+	_, err := os.Stat(segFile.GetName())
+	assert.True(t, os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(tae.Dir, ObjectDir, filepath.Base(segFile.GetName())))
+	assert.Nil(t, err)
+
+	// The merged rows are read back from the object store
+	txn, _ := tae.StartTxn(nil)
+	db, _ := txn.GetDatabase("db")
+	rel, _ := db.GetRelationByName(schema.Name)
+	for i := 0; i < int(schema.BlockMaxRows*3); i++ {
+		filter := handle.NewEQFilter(compute.GetValue(bat.Vecs[schema.PrimaryKey], uint32(i)))
+		_, _, err = rel.GetByFilter(filter)
+		assert.Nil(t, err)
+	}
+	rows := 0
+	it := rel.MakeBlockIt()
+	for it.Valid() {
+		blk := it.GetBlock()
+		view, err := blk.GetColumnDataById(int(schema.PrimaryKey), nil, nil)
+		assert.Nil(t, err)
+		rows += view.Length()
+		it.Next()
+	}
+	assert.Equal(t, int(schema.BlockMaxRows*3), rows)
+	assert.Nil(t, txn.Commit())
+}
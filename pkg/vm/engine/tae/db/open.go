@@ -15,6 +15,8 @@
 package db
 
 import (
+	"fmt"
+	"path/filepath"
 	"sync/atomic"
 	"time"
 
@@ -23,6 +25,9 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/buffer"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/checkpoint"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/file"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/store"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/objstore"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables"
 	w "github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tasks/worker"
@@ -34,8 +39,33 @@ import (
 const (
 	WALDir     = "wal"
 	CATALOGDir = "catalog"
+	ObjectDir  = "objects"
 )
 
+func openObjectStore(dirname string, cfg *options.ObjectStoreCfg) (objstore.Store, error) {
+	switch cfg.Backend {
+	case "":
+		return nil, nil
+	case options.ObjectStoreLocal:
+		dir := cfg.Dir
+		if dir == "" {
+			dir = filepath.Join(dirname, ObjectDir)
+		}
+		return objstore.NewLocalStore(dir)
+	case options.ObjectStoreMemory:
+		return objstore.NewMemoryStore(), nil
+	case options.ObjectStoreS3:
+		return objstore.NewS3Store(objstore.S3Config{
+			Endpoint:  cfg.Endpoint,
+			Bucket:    cfg.Bucket,
+			Region:    cfg.Region,
+			AccessKey: cfg.AccessKey,
+			SecretKey: cfg.SecretKey,
+		})
+	}
+	return nil, fmt.Errorf("unknown object store backend: %s", cfg.Backend)
+}
+
 func Open(dirname string, opts *options.Options) (db *DB, err error) {
 	dbLocker, err := createDBLock(dirname)
 	if err != nil {
@@ -48,10 +78,30 @@ func Open(dirname string, opts *options.Options) (db *DB, err error) {
 	}()
 
 	opts = opts.FillDefaults(dirname)
+	mergePolicy, err := checkpoint.NewMergePolicy(opts.CheckpointCfg.MergePolicy)
+	if err != nil {
+		return nil, err
+	}
+	syncPolicy, err := store.ParseSyncPolicy(opts.WalCfg.SyncPolicy)
+	if err != nil {
+		return nil, err
+	}
+	if opts.ObjectStore == nil {
+		if opts.ObjectStore, err = openObjectStore(dirname, opts.ObjectStoreCfg); err != nil {
+			return nil, err
+		}
+	}
 
-	indexBufMgr := buffer.NewNodeManager(opts.CacheCfg.IndexCapacity, nil)
-	mutBufMgr := buffer.NewNodeManager(opts.CacheCfg.InsertCapacity, nil)
-	txnBufMgr := buffer.NewNodeManager(opts.CacheCfg.TxnCapacity, nil)
+	// Each cache evicts its own nodes only, within its own capacity
+	var evicters [3]buffer.IEvictHolder
+	for i := range evicters {
+		if evicters[i], err = buffer.NewEvictHolder(opts.CacheCfg.EvictPolicy); err != nil {
+			return nil, err
+		}
+	}
+	indexBufMgr := buffer.NewNodeManager(opts.CacheCfg.IndexCapacity, evicters[0])
+	mutBufMgr := buffer.NewNodeManager(opts.CacheCfg.InsertCapacity, evicters[1])
+	txnBufMgr := buffer.NewNodeManager(opts.CacheCfg.TxnCapacity, evicters[2])
 
 	db = &DB{
 		Dir:         dirname,
@@ -60,11 +110,17 @@ func Open(dirname string, opts *options.Options) (db *DB, err error) {
 		MTBufMgr:    mutBufMgr,
 		TxnBufMgr:   txnBufMgr,
 		Closed:      new(atomic.Value),
+		MergePolicy: mergePolicy,
 	}
 
-	db.Wal = wal.NewDriver(dirname, WALDir, nil)
+	db.Wal = wal.NewDriver(dirname, WALDir, &store.StoreCfg{SyncPolicy: syncPolicy})
 	db.Scheduler = newTaskScheduler(db, db.Opts.SchedulerCfg.AsyncWorkers, db.Opts.SchedulerCfg.IOWorkers)
-	dataFactory := tables.NewDataFactory(segmentio.SegmentFileIOFactory, mutBufMgr, db.Scheduler, db.Dir)
+	var fileFactory file.SegmentFileFactory = segmentio.SegmentFileIOFactory
+	if opts.ObjectStore != nil {
+		cache := objstore.NewBlockCache(opts.ObjectStoreCfg.CacheSize, objstore.DefaultCachePageSize)
+		fileFactory = segmentio.NewSegmentFileIOFactory(opts.ObjectStore, cache)
+	}
+	dataFactory := tables.NewDataFactory(fileFactory, mutBufMgr, db.Scheduler, db.Dir)
 	if db.Opts.Catalog, err = catalog.OpenCatalog(dirname, CATALOGDir, nil, db.Scheduler, dataFactory); err != nil {
 		return
 	}
@@ -75,7 +131,13 @@ func Open(dirname string, opts *options.Options) (db *DB, err error) {
 	txnFactory := txnimpl.TxnFactory(db.Opts.Catalog)
 	db.TxnMgr = txnbase.NewTxnManager(txnStoreFactory, txnFactory)
 
-	db.Replay(dataFactory)
+	if err = db.Replay(dataFactory); err != nil {
+		db.Scheduler.Stop()
+		db.Wal.Close()
+		db.Catalog.Close()
+		return nil, err
+	}
+	db.TxnMgr.SetRetention(time.Duration(opts.TxnCfg.SnapshotRetention) * time.Millisecond)
 
 	db.TxnMgr.Start()
 
@@ -90,8 +152,14 @@ func Open(dirname string, opts *options.Options) (db *DB, err error) {
 	// Init timed scanner
 	scanner := NewDBScanner(db, nil)
 	calibrationOp := newCalibrationOp(db)
+	mergeOp := newMergeOp(db)
+	ttlOp := newTTLOp(db)
+	indexOp := newIndexOp(db)
 	catalogMonotor := newCatalogStatsMonitor(db, opts.CheckpointCfg.CatalogUnCkpLimit, time.Duration(opts.CheckpointCfg.CatalogCkpInterval))
 	scanner.RegisterOp(calibrationOp)
+	scanner.RegisterOp(mergeOp)
+	scanner.RegisterOp(ttlOp)
+	scanner.RegisterOp(indexOp)
 	scanner.RegisterOp(catalogMonotor)
 	db.TimedScanner = w.NewHeartBeater(time.Duration(opts.CheckpointCfg.ScannerInterval)*time.Millisecond, scanner)
 
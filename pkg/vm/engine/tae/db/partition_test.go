@@ -0,0 +1,142 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/stretchr/testify/assert"
+)
+
+// checkPartitionRows checks the rows of the table are stored in the
+// segments of their partitions and returns the number of them
+func checkPartitionRows(t *testing.T, rel handle.Relation) (rows int) {
+	schema := rel.GetSchema().(*catalog.Schema)
+	it := rel.MakeBlockIt()
+	for ; it.Valid(); it.Next() {
+		blk := it.GetBlock()
+		meta := blk.GetMeta().(*catalog.BlockEntry)
+		view, err := blk.GetColumnDataByName(schema.GetPartitionColumnDef().Name, nil, nil)
+		assert.NoError(t, err)
+		for row := 0; row < vector.Length(view.AppliedVec); row++ {
+			if view.DeleteMask != nil && view.DeleteMask.Contains(uint32(row)) {
+				continue
+			}
+			part, err := schema.Partition.Locate(compute.GetValue(view.AppliedVec, uint32(row)))
+			assert.NoError(t, err)
+			assert.Equal(t, part.ID, meta.GetSegment().GetPartition())
+			rows++
+		}
+	}
+	return
+}
+
+func TestPartitionTable(t *testing.T) {
+	tae := initMergeDB(t)
+	schema := catalog.MockSchemaAll(4)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	schema.PrimaryKey = 3
+	parts := []*catalog.PartitionDef{
+		{Name: "p0", Bound: int32(10)},
+		{Name: "p1", Bound: int32(20)},
+		{Name: "p2"},
+	}
+	assert.NoError(t, schema.SetPartition(catalog.PartitionRange, "mock_2", parts))
+	bats := compute.SplitBatch(catalog.MockData(schema, 40), 4)
+	for i, bat := range bats {
+		for row := 0; row < 10; row++ {
+			bat.Vecs[2].Col.([]int32)[row] = int32(row*3 + i)
+		}
+	}
+	{
+		txn, _ := tae.StartTxn(nil)
+		database, _ := txn.CreateDatabase("db")
+		rel, _ := database.CreateRelation(schema)
+		// each batch has rows of all the partitions
+		for _, bat := range bats[:3] {
+			assert.NoError(t, rel.Append(bat))
+		}
+		// the updated row moves to another partition
+		pk := compute.GetValue(bats[0].Vecs[3], 0)
+		assert.NoError(t, rel.UpdateByFilter(handle.NewEQFilter(pk), 2, int32(100)))
+		assert.NoError(t, txn.Commit())
+	}
+	{
+		txn, _ := tae.StartTxn(nil)
+		database, _ := txn.GetDatabase("db")
+		rel, _ := database.GetRelationByName(schema.Name)
+		assert.Equal(t, 30, checkPartitionRows(t, rel))
+		// so does an updated committed row
+		pk := compute.GetValue(bats[0].Vecs[3], 1)
+		assert.NoError(t, rel.UpdateByFilter(handle.NewEQFilter(pk), 2, int32(15)))
+		assert.NoError(t, txn.Commit())
+	}
+	assert.NoError(t, tae.Compact("db", schema.Name))
+	{
+		txn, _ := tae.StartTxn(nil)
+		database, _ := txn.GetDatabase("db")
+		rel, _ := database.GetRelationByName(schema.Name)
+		assert.Equal(t, 30, checkPartitionRows(t, rel))
+		// the IDs of p0, p1 and p2 are 1, 2 and 3
+		assert.NoError(t, rel.TruncatePartitions([]uint32{2}))
+		assert.NoError(t, txn.Commit())
+	}
+	{
+		txn, _ := tae.StartTxn(nil)
+		database, _ := txn.GetDatabase("db")
+		rel, _ := database.GetRelationByName(schema.Name)
+		// p1 with the 10 rows and the one moved from p0 is gone
+		assert.Equal(t, 19, checkPartitionRows(t, rel))
+		assert.NoError(t, rel.AlterTable([]*catalog.AlterAction{{Op: catalog.AlterDropPartition, Name: "p0"}}))
+		assert.NoError(t, rel.TruncatePartitions([]uint32{1}))
+		assert.NoError(t, txn.Commit())
+	}
+	{
+		txn, _ := tae.StartTxn(nil)
+		database, _ := txn.GetDatabase("db")
+		rel, _ := database.GetRelationByName(schema.Name)
+		// p2 has 10 rows and the one moved to 100
+		assert.Equal(t, 11, checkPartitionRows(t, rel))
+		// the truncated partition takes new rows, and the rows of the
+		// dropped one go to the next one
+		assert.NoError(t, rel.Append(bats[3]))
+		assert.NoError(t, txn.Commit())
+	}
+	tae.Close()
+
+	tae, err := Open(tae.Dir, nil)
+	assert.NoError(t, err)
+	defer tae.Close()
+	txn, _ := tae.StartTxn(nil)
+	database, _ := txn.GetDatabase("db")
+	rel, _ := database.GetRelationByName(schema.Name)
+	// the sealed segment files are not replayed, so only check the
+	// partitions of the segments
+	assert.Equal(t, 2, len(rel.GetSchema().(*catalog.Schema).Partition.Partitions))
+	partitions := make(map[uint32]int)
+	segIt := rel.MakeSegmentIt()
+	for ; segIt.Valid(); segIt.Next() {
+		partitions[segIt.GetSegment().GetMeta().(*catalog.SegmentEntry).GetPartition()]++
+	}
+	assert.Equal(t, 2, len(partitions))
+	assert.Equal(t, 1, partitions[2])
+	assert.Equal(t, 2, partitions[3])
+	assert.NoError(t, txn.Commit())
+}
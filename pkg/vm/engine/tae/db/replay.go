@@ -30,11 +30,8 @@ func newReplayer(dataFactory *tables.DataFactory, db *DB) *Replayer {
 	}
 }
 
-func (replayer *Replayer) Replay() {
-	err := replayer.db.Wal.Replay(replayer.OnReplayEntry)
-	if err != nil {
-		panic(err)
-	}
+func (replayer *Replayer) Replay() error {
+	return replayer.db.Wal.Replay(replayer.OnReplayEntry)
 }
 
 func (replayer *Replayer) OnReplayEntry(group uint32, commitId uint64, payload []byte, typ uint16, info interface{}) {
@@ -125,10 +122,6 @@ func (db *DB) onReplayAppendCmd(cmd *txnimpl.AppendCmd) {
 		if err != nil {
 			panic(err)
 		}
-		attrs := make([]string, len(tb.GetSchema().ColDefs))
-		for i := range attrs {
-			attrs[i] = tb.GetSchema().ColDefs[i].Name
-		}
 		seg, err := tb.GetSegmentByID(id.SegmentID)
 		if err != nil {
 			panic(err)
@@ -137,6 +130,7 @@ func (db *DB) onReplayAppendCmd(cmd *txnimpl.AppendCmd) {
 		if err != nil {
 			panic(err)
 		}
+		attrs := blk.GetSchema().Attrs()
 		if blk.CurrOp == catalog.OpSoftDelete {
 			continue
 		}
@@ -171,7 +165,7 @@ func (db *DB) window(attrs []string, data batch.IBatch, deletes *roaring.Bitmap,
 		if err != nil {
 			return nil, err
 		}
-		deletes := common.BitMapWindow(deletes, int(start), int(end))
+		deletes := common.BitMapWindow(deletes, int(start), int(end)+1)
 		srcVec = compute.ApplyDeleteToVector(srcVec, deletes)
 		ret.Vecs[i] = srcVec
 	}
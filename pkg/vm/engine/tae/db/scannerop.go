@@ -32,8 +32,7 @@ type ScannerOp interface {
 
 type calibrationOp struct {
 	*catalog.LoopProcessor
-	db              *DB
-	blkCntOfSegment int
+	db *DB
 }
 
 func newCalibrationOp(db *DB) *calibrationOp {
@@ -42,34 +41,12 @@ func newCalibrationOp(db *DB) *calibrationOp {
 		LoopProcessor: new(catalog.LoopProcessor),
 	}
 	processor.BlockFn = processor.onBlock
-	processor.SegmentFn = processor.onSegment
-	processor.PostSegmentFn = processor.onPostSegment
 	return processor
 }
 
 func (processor *calibrationOp) PreExecute() error  { return nil }
 func (processor *calibrationOp) PostExecute() error { return nil }
 
-func (processor *calibrationOp) onSegment(segmentEntry *catalog.SegmentEntry) (err error) {
-	processor.blkCntOfSegment = 0
-	return
-}
-
-func (processor *calibrationOp) onPostSegment(segmentEntry *catalog.SegmentEntry) (err error) {
-	if processor.blkCntOfSegment >= int(segmentEntry.GetTable().GetSchema().SegmentMaxBlocks) {
-		segmentData := segmentEntry.GetSegmentData()
-		taskFactory, taskType, scopes, err := segmentData.BuildCompactionTaskFactory()
-		if err != nil || taskFactory == nil {
-			logutil.Warnf("%s: %v", segmentData.MutationInfo(), err)
-		} else {
-			_, err = processor.db.Scheduler.ScheduleMultiScopedTxnTask(nil, taskType, scopes, taskFactory)
-			logutil.Infof("[Mergeblocks] | %s | Scheduled | State=%v | Scopes=%s", segmentEntry.String(), err, common.IDArraryString(scopes))
-		}
-	}
-	processor.blkCntOfSegment = 0
-	return
-}
-
 func (processor *calibrationOp) onBlock(blockEntry *catalog.BlockEntry) (err error) {
 	blockEntry.RLock()
 	// 1. Skip uncommitted entries
@@ -82,9 +59,6 @@ func (processor *calibrationOp) onBlock(blockEntry *catalog.BlockEntry) (err err
 		blockEntry.RUnlock()
 		return nil
 	}
-	if blockEntry.GetSegment().IsAppendable() && catalog.ActiveWithNoTxnFilter(blockEntry.BaseEntry) && catalog.NonAppendableBlkFilter(blockEntry) {
-		processor.blkCntOfSegment++
-	}
 	blockEntry.RUnlock()
 
 	data := blockEntry.GetBlockData()
@@ -104,6 +78,7 @@ type catalogStatsMonitor struct {
 	unCheckpointedCnt int64
 	minTs             uint64
 	maxTs             uint64
+	gcTs              uint64 // entries dropped after gcTs are kept for the snapshots
 	lastScheduleTime  time.Time
 	cntLimit          int64
 	intervalLimit     time.Duration
@@ -135,6 +110,10 @@ func (monitor *catalogStatsMonitor) PreExecute() error {
 	monitor.unCheckpointedCnt = 0
 	monitor.minTs = monitor.db.Catalog.GetCheckpointed().MaxTS + 1
 	monitor.maxTs = monitor.db.Scheduler.GetSafeTS()
+	monitor.gcTs = monitor.maxTs
+	if retainedTs := monitor.db.TxnMgr.StatRetainedTS(); retainedTs < monitor.gcTs {
+		monitor.gcTs = retainedTs
+	}
 	return nil
 }
 
@@ -163,7 +142,7 @@ func (monitor *catalogStatsMonitor) onBlock(entry *catalog.BlockEntry) (err erro
 	checkpointed := monitor.db.Scheduler.GetCheckpointedLSN()
 	gcNeeded := false
 	entry.RLock()
-	if entry.IsDroppedCommitted() && !entry.DeleteAfter(monitor.maxTs) {
+	if entry.IsDroppedCommitted() && !entry.DeleteAfter(monitor.gcTs) {
 		logIndex := entry.GetLogIndex()
 		if logIndex != nil {
 			gcNeeded = checkpointed >= logIndex.LSN
@@ -192,7 +171,7 @@ func (monitor *catalogStatsMonitor) onSegment(entry *catalog.SegmentEntry) (err
 	checkpointed := monitor.db.Scheduler.GetCheckpointedLSN()
 	gcNeeded := false
 	entry.RLock()
-	if entry.IsDroppedCommitted() {
+	if entry.IsDroppedCommitted() && !entry.DeleteAfter(monitor.gcTs) {
 		logIndex := entry.GetLogIndex()
 		if logIndex != nil {
 			gcNeeded = checkpointed >= logIndex.LSN
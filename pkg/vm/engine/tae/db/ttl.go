@@ -0,0 +1,105 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables/jobs"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tasks"
+)
+
+// ttlOp schedules dropping the segments of the tables with a TTL, all the
+// rows of which are expired. The expired rows of the other segments are
+// filtered out on read.
+type ttlOp struct {
+	*catalog.LoopProcessor
+	db  *DB
+	now func() time.Time
+}
+
+func newTTLOp(db *DB) *ttlOp {
+	processor := &ttlOp{
+		LoopProcessor: new(catalog.LoopProcessor),
+		db:            db,
+		now:           time.Now,
+	}
+	processor.TableFn = processor.onTable
+	return processor
+}
+
+func (processor *ttlOp) PreExecute() error  { return nil }
+func (processor *ttlOp) PostExecute() error { return nil }
+
+func (processor *ttlOp) onTable(tableEntry *catalog.TableEntry) (err error) {
+	tableEntry.RLock()
+	skip := !tableEntry.IsCommitted() || tableEntry.IsDroppedCommitted()
+	tableEntry.RUnlock()
+	if skip || tableEntry.GetSchema().TTL == 0 {
+		return
+	}
+	segs := collectExpiredSegments(tableEntry, processor.now())
+	if len(segs) > 0 {
+		_, _ = processor.db.scheduleExpire(nil, segs)
+	}
+	return
+}
+
+// collectExpiredSegments returns the non-appendable segments of the table
+// whose TTL column zonemaps prove all the rows of them expired at now.
+func collectExpiredSegments(tableEntry *catalog.TableEntry, now time.Time) (segs []*catalog.SegmentEntry) {
+	schema := tableEntry.GetSchema()
+	colDef := schema.GetTTLColumnDef()
+	if colDef == nil {
+		return
+	}
+	cutoff := schema.TTLCutoff(now)
+	notDropped := func(be *catalog.BaseEntry) bool { return !be.IsDroppedCommitted() }
+	segIt := tableEntry.MakeSegmentIt(true)
+	for ; segIt.Valid(); segIt.Next() {
+		segment := segIt.Get().GetPayload().(*catalog.SegmentEntry)
+		segment.RLock()
+		skip := segment.IsAppendable() || !segment.IsCommitted() || segment.IsDroppedCommitted() || segment.HasActiveTxn()
+		segment.RUnlock()
+		if skip {
+			continue
+		}
+		// the blocks being merged or dropped are left to them
+		blks := segment.CollectBlockEntries(notDropped, nil)
+		if len(blks) == 0 || len(segment.CollectBlockEntries(catalog.ActiveWithNoTxnFilter, nil)) != len(blks) {
+			continue
+		}
+		// the rows with a null TTL column never expire
+		zm := segment.GetSegmentData().GetColumnZoneMap(colDef.SeqNum)
+		if zm == nil || !zm.Initialized() || zm.MayContainsNull() || zm.GetType().Oid != colDef.Type.Oid {
+			continue
+		}
+		if common.CompareGeneric(zm.GetMax(), cutoff, colDef.Type) < 0 {
+			segs = append(segs, segment)
+		}
+	}
+	return
+}
+
+func (db *DB) scheduleExpire(ctx *tasks.Context, segs []*catalog.SegmentEntry) (task tasks.Task, err error) {
+	scopes := MakeSegmentScopes(segs...)
+	factory := jobs.ExpireSegmentsTaskFactory(segs)
+	task, err = db.Scheduler.ScheduleMultiScopedTxnTask(ctx, tasks.DataCompactionTask, scopes, factory)
+	logutil.Infof("[ExpireSegments] | Segments=%d | Scheduled | State=%v | Scopes=%s", len(segs), err, common.IDArraryString(scopes))
+	return
+}
@@ -0,0 +1,86 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tasks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpireSegments(t *testing.T) {
+	tae := initMergeDB(t)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(12)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	schema.PrimaryKey = 3
+	assert.NoError(t, schema.SetTTL("mock_10:30d"))
+	// row i is dated i days after base, a year later so that the scanner
+	// expires nothing meanwhile
+	base := time.Now().AddDate(1, 0, 0)
+	dates := make([]types.Date, 30)
+	for i := range dates {
+		d := base.AddDate(0, 0, i)
+		dates[i] = types.FromCalendar(int32(d.Year()), uint8(d.Month()), uint8(d.Day()))
+	}
+	provider := compute.NewMockDataProvider()
+	vec := vector.New(schema.ColDefs[10].Type)
+	assert.NoError(t, vector.Append(vec, dates))
+	provider.AddColumnProvider(10, vec)
+	bat := compute.MockBatch(schema.Types(), 30, int(schema.PrimaryKey), provider)
+	{
+		txn, _ := tae.StartTxn(nil)
+		database, err := txn.CreateDatabase("db")
+		assert.NoError(t, err)
+		rel, err := database.CreateRelation(schema)
+		assert.NoError(t, err)
+		assert.NoError(t, rel.Append(bat))
+		assert.NoError(t, txn.Commit())
+	}
+	// the first 20 rows are merged into a non-appendable segment
+	assert.NoError(t, tae.Compact("db", schema.Name))
+	txn, _ := tae.StartTxn(nil)
+	database, _ := txn.GetDatabase("db")
+	rel, _ := database.GetRelationByName(schema.Name)
+	tableEntry := rel.GetMeta().(*catalog.TableEntry)
+	_, nonAppendable := segmentsOf(tableEntry)
+	assert.Equal(t, 1, len(nonAppendable))
+	assert.NoError(t, txn.Commit())
+
+	// the segment is expired only once all its rows are
+	assert.Empty(t, collectExpiredSegments(tableEntry, base.AddDate(0, 0, 30+19)))
+	segs := collectExpiredSegments(tableEntry, base.AddDate(0, 0, 30+20))
+	assert.Equal(t, nonAppendable, segs)
+
+	task, err := tae.scheduleExpire(tasks.WaitableCtx, segs)
+	assert.NoError(t, err)
+	assert.NoError(t, task.WaitDone())
+	_, nonAppendable = segmentsOf(tableEntry)
+	assert.Empty(t, nonAppendable)
+	assert.Empty(t, collectExpiredSegments(tableEntry, base.AddDate(0, 0, 30+20)))
+
+	txn, _ = tae.StartTxn(nil)
+	database, _ = txn.GetDatabase("db")
+	rel, _ = database.GetRelationByName(schema.Name)
+	assert.Equal(t, 10, scanRows(t, rel, int(schema.PrimaryKey)))
+	assert.NoError(t, txn.Commit())
+}
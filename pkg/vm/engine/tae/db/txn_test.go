@@ -24,6 +24,8 @@ import (
 	"testing"
 	"time"
 
+	gbat "github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	gvec "github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
@@ -34,7 +36,11 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/model"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables/jobs"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tasks"
+	ops "github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tasks/worker"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnbase"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnimpl"
 	"github.com/panjf2000/ants/v2"
 	"github.com/stretchr/testify/assert"
 )
@@ -753,3 +759,988 @@ func TestTxn9(t *testing.T) {
 	assert.NoError(t, txn.Commit())
 	wg.Wait()
 }
+
+func startSSITxn(t *testing.T, tae *DB, level txnif.IsolationLevel, name string) (txnif.AsyncTxn, handle.Relation) {
+	txn, err := tae.StartTxnWithIsolation(nil, level)
+	assert.NoError(t, err)
+	db, err := txn.GetDatabase("db")
+	assert.NoError(t, err)
+	rel, err := db.GetRelationByName(name)
+	assert.NoError(t, err)
+	return txn, rel
+}
+
+// mockSSITable commits the first 10 rows of the returned batch of rows rows
+// to a new table
+func mockSSITable(t *testing.T, tae *DB, rows int) (*catalog.Schema, *gbat.Batch) {
+	schema := catalog.MockSchemaAll(13)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	schema.PrimaryKey = 2
+	bat := catalog.MockData(schema, uint32(rows))
+
+	txn, _ := tae.StartTxn(nil)
+	db, err := txn.CreateDatabase("db")
+	assert.NoError(t, err)
+	rel, err := db.CreateRelation(schema)
+	assert.NoError(t, err)
+	assert.NoError(t, rel.Append(compute.SplitBatch(bat, rows/int(schema.BlockMaxRows))[0]))
+	assert.NoError(t, txn.Commit())
+	return schema, bat
+}
+
+// scanAndUpdate reads all the rows of the relation and updates the row pk
+func scanAndUpdate(t *testing.T, rel handle.Relation, pk interface{}) {
+	rows := 0
+	it := rel.MakeBlockIt()
+	for it.Valid() {
+		rows += it.GetBlock().Rows()
+		it.Next()
+	}
+	assert.Equal(t, 10, rows)
+	assert.NoError(t, rel.UpdateByFilter(handle.NewEQFilter(pk), 3, int64(0)))
+}
+
+func TestSSIWriteSkew(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	schema, bat := mockSSITable(t, tae, 10)
+	pk1 := compute.GetValue(bat.Vecs[schema.PrimaryKey], 1)
+	pk2 := compute.GetValue(bat.Vecs[schema.PrimaryKey], 2)
+
+	// Both txns check all the rows and update a different one
+	txn1, rel1 := startSSITxn(t, tae, txnif.Serializable, schema.Name)
+	txn2, rel2 := startSSITxn(t, tae, txnif.Serializable, schema.Name)
+	scanAndUpdate(t, rel1, pk1)
+	scanAndUpdate(t, rel2, pk2)
+	err1 := txn1.Commit()
+	err2 := txn2.Commit()
+	t.Log(err1, err2)
+	assert.True(t, (err1 == nil) != (err2 == nil))
+	if err1 != nil {
+		assert.ErrorIs(t, err1, txnif.TxnRWConflictErr)
+	} else {
+		assert.ErrorIs(t, err2, txnif.TxnRWConflictErr)
+	}
+	assert.Equal(t, 0, tae.TxnMgr.SSI.Count())
+
+	// The snapshot isolation allows the write skew
+	txn1, rel1 = startSSITxn(t, tae, txnif.SnapshotIsolation, schema.Name)
+	txn2, rel2 = startSSITxn(t, tae, txnif.SnapshotIsolation, schema.Name)
+	scanAndUpdate(t, rel1, pk1)
+	scanAndUpdate(t, rel2, pk2)
+	assert.NoError(t, txn1.Commit())
+	assert.NoError(t, txn2.Commit())
+}
+
+func TestSSIWriteSkewByKey(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	schema, bat := mockSSITable(t, tae, 10)
+	pk1 := compute.GetValue(bat.Vecs[schema.PrimaryKey], 1)
+	pk2 := compute.GetValue(bat.Vecs[schema.PrimaryKey], 2)
+	pk3 := compute.GetValue(bat.Vecs[schema.PrimaryKey], 3)
+
+	// Txn1 reads pk1 and writes pk2, txn2 reads pk2 and writes pk1
+	txn1, rel1 := startSSITxn(t, tae, txnif.Serializable, schema.Name)
+	txn2, rel2 := startSSITxn(t, tae, txnif.Serializable, schema.Name)
+	_, _, err := rel1.GetByFilter(handle.NewEQFilter(pk1))
+	assert.NoError(t, err)
+	_, _, err = rel2.GetByFilter(handle.NewEQFilter(pk2))
+	assert.NoError(t, err)
+	assert.NoError(t, rel1.UpdateByFilter(handle.NewEQFilter(pk2), 3, int64(0)))
+	assert.NoError(t, rel2.UpdateByFilter(handle.NewEQFilter(pk1), 3, int64(0)))
+	// Both txns are pivots now, the first to commit is aborted
+	assert.ErrorIs(t, txn1.Commit(), txnif.TxnRWConflictErr)
+	assert.NoError(t, txn2.Commit())
+
+	// The readonly anomaly: txn3 commits before txn1 starts and txn1 sees
+	// its write, txn2 does not see the write of txn3, but txn1 does not see
+	// the write of txn2, so no serial order exists
+	txn2, rel2 = startSSITxn(t, tae, txnif.Serializable, schema.Name)
+	txn3, rel3 := startSSITxn(t, tae, txnif.Serializable, schema.Name)
+	_, _, err = rel2.GetByFilter(handle.NewEQFilter(pk3))
+	assert.NoError(t, err)
+	assert.NoError(t, rel2.UpdateByFilter(handle.NewEQFilter(pk1), 3, int64(1)))
+	assert.NoError(t, rel3.UpdateByFilter(handle.NewEQFilter(pk3), 3, int64(1)))
+	assert.NoError(t, txn3.Commit())
+	txn1, rel1 = startSSITxn(t, tae, txnif.Serializable, schema.Name)
+	assert.NoError(t, txn2.Commit())
+	_, _, err = rel1.GetByFilter(handle.NewEQFilter(pk3))
+	assert.NoError(t, err)
+	_, _, err = rel1.GetByFilter(handle.NewEQFilter(pk1))
+	assert.NoError(t, err)
+	assert.ErrorIs(t, txn1.Commit(), txnif.TxnRWConflictErr)
+
+	// Disjoint serializable txns do not conflict
+	txn1, rel1 = startSSITxn(t, tae, txnif.Serializable, schema.Name)
+	txn2, rel2 = startSSITxn(t, tae, txnif.Serializable, schema.Name)
+	assert.NoError(t, rel1.UpdateByFilter(handle.NewEQFilter(pk1), 3, int64(2)))
+	assert.NoError(t, rel2.UpdateByFilter(handle.NewEQFilter(pk2), 3, int64(2)))
+	assert.NoError(t, txn1.Commit())
+	assert.NoError(t, txn2.Commit())
+	assert.Equal(t, 0, tae.TxnMgr.SSI.Count())
+}
+
+func TestLockForUpdate(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	schema, bat := mockSSITable(t, tae, 10)
+	pk := compute.GetValue(bat.Vecs[schema.PrimaryKey], 1)
+	filter := handle.NewEQFilter(pk)
+
+	// Each txn locks the counter before reading it, so no increment is lost
+	// and no txn is aborted
+	var wg sync.WaitGroup
+	workers, loops := 4, 10
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < loops; j++ {
+				txn, rel := startSSITxn(t, tae, txnif.SnapshotIsolation, schema.Name)
+				locked, err := rel.Lock(pk, handle.LockExclusive, handle.LockWait)
+				assert.NoError(t, err)
+				assert.True(t, locked)
+				id, row, err := rel.GetByFilter(filter)
+				assert.NoError(t, err)
+				v, err := rel.GetValue(id, row, 3)
+				assert.NoError(t, err)
+				assert.NoError(t, rel.UpdateByFilter(filter, 3, v.(int64)+1))
+				assert.NoError(t, txn.Commit())
+			}
+		}()
+	}
+	wg.Wait()
+
+	txn, rel := startSSITxn(t, tae, txnif.SnapshotIsolation, schema.Name)
+	id, row, err := rel.GetByFilter(filter)
+	assert.NoError(t, err)
+	v, err := rel.GetValue(id, row, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, compute.GetValue(bat.Vecs[3], 1).(int64)+int64(workers*loops), v)
+	assert.NoError(t, txn.Commit())
+}
+
+func TestLockWaitPolicy(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	schema, bat := mockSSITable(t, tae, 10)
+	pk1 := compute.GetValue(bat.Vecs[schema.PrimaryKey], 1)
+	pk2 := compute.GetValue(bat.Vecs[schema.PrimaryKey], 2)
+
+	txn1, rel1 := startSSITxn(t, tae, txnif.SnapshotIsolation, schema.Name)
+	txn2, rel2 := startSSITxn(t, tae, txnif.SnapshotIsolation, schema.Name)
+	txn3, rel3 := startSSITxn(t, tae, txnif.SnapshotIsolation, schema.Name)
+	locked, err := rel1.Lock(pk1, handle.LockExclusive, handle.LockWait)
+	assert.NoError(t, err)
+	assert.True(t, locked)
+	_, err = rel2.Lock(pk1, handle.LockShared, handle.LockNoWait)
+	assert.ErrorIs(t, err, txnbase.ErrLockNotAvailable)
+	locked, err = rel2.Lock(pk1, handle.LockExclusive, handle.LockSkipLocked)
+	assert.NoError(t, err)
+	assert.False(t, locked)
+
+	// The shared locks are compatible
+	locked, err = rel2.Lock(pk2, handle.LockShared, handle.LockNoWait)
+	assert.NoError(t, err)
+	assert.True(t, locked)
+	locked, err = rel3.Lock(pk2, handle.LockShared, handle.LockNoWait)
+	assert.NoError(t, err)
+	assert.True(t, locked)
+	_, err = rel1.Lock(pk2, handle.LockExclusive, handle.LockNoWait)
+	assert.ErrorIs(t, err, txnbase.ErrLockNotAvailable)
+
+	// The writes wait for the row locks
+	txn2.(interface{ SetLockWaitTimeout(time.Duration) }).SetLockWaitTimeout(time.Millisecond * 10)
+	_, err = rel2.Lock(pk1, handle.LockShared, handle.LockWait)
+	assert.ErrorIs(t, err, txnbase.ErrLockWaitTimeout)
+	err = rel2.UpdateByFilter(handle.NewEQFilter(pk1), 3, int64(0))
+	assert.ErrorIs(t, err, txnbase.ErrLockWaitTimeout)
+	assert.NoError(t, txn2.Rollback())
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		assert.NoError(t, rel3.UpdateByFilter(handle.NewEQFilter(pk1), 3, int64(0)))
+		assert.NoError(t, txn3.Commit())
+	}()
+	assert.NoError(t, txn1.Commit())
+	wg.Wait()
+}
+
+func TestLockAfterUpdate(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	schema, bat := mockSSITable(t, tae, 10)
+	pk := compute.GetValue(bat.Vecs[schema.PrimaryKey], 1)
+	filter := handle.NewEQFilter(pk)
+
+	// A plain UPDATE locks the row though no row of the table is locked, so
+	// a later FOR UPDATE waits for it and reads the updated row
+	txn1, rel1 := startSSITxn(t, tae, txnif.SnapshotIsolation, schema.Name)
+	assert.NoError(t, rel1.UpdateByFilter(filter, 3, int64(100)))
+	txn2, rel2 := startSSITxn(t, tae, txnif.SnapshotIsolation, schema.Name)
+	_, err := rel2.Lock(pk, handle.LockExclusive, handle.LockNoWait)
+	assert.ErrorIs(t, err, txnbase.ErrLockNotAvailable)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		locked, err := rel2.Lock(pk, handle.LockExclusive, handle.LockWait)
+		assert.NoError(t, err)
+		assert.True(t, locked)
+		id, row, err := rel2.GetByFilter(filter)
+		assert.NoError(t, err)
+		v, err := rel2.GetValue(id, row, 3)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(100), v)
+		assert.NoError(t, rel2.UpdateByFilter(filter, 3, v.(int64)+1))
+		assert.NoError(t, txn2.Commit())
+	}()
+	time.Sleep(time.Millisecond * 10)
+	assert.NoError(t, txn1.Commit())
+	wg.Wait()
+
+	txn, rel := startSSITxn(t, tae, txnif.SnapshotIsolation, schema.Name)
+	id, row, err := rel.GetByFilter(filter)
+	assert.NoError(t, err)
+	v, err := rel.GetValue(id, row, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(101), v)
+	assert.NoError(t, txn.Commit())
+	assert.Equal(t, 0, tae.TxnMgr.LockMgr.Count())
+}
+
+func TestLockDeadlock(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	schema, bat := mockSSITable(t, tae, 10)
+	pk1 := compute.GetValue(bat.Vecs[schema.PrimaryKey], 1)
+	pk2 := compute.GetValue(bat.Vecs[schema.PrimaryKey], 2)
+
+	txn1, rel1 := startSSITxn(t, tae, txnif.SnapshotIsolation, schema.Name)
+	txn2, rel2 := startSSITxn(t, tae, txnif.SnapshotIsolation, schema.Name)
+	_, err := rel1.Lock(pk1, handle.LockExclusive, handle.LockWait)
+	assert.NoError(t, err)
+	_, err = rel2.Lock(pk2, handle.LockExclusive, handle.LockWait)
+	assert.NoError(t, err)
+
+	// Whichever blocks second closes the cycle and is the victim
+	var wg sync.WaitGroup
+	errs := make([]error, 2)
+	lockAndTerminate := func(i int, txn txnif.AsyncTxn, rel handle.Relation, pk interface{}) {
+		defer wg.Done()
+		if _, errs[i] = rel.Lock(pk, handle.LockExclusive, handle.LockWait); errs[i] != nil {
+			assert.NoError(t, txn.Rollback())
+		} else {
+			assert.NoError(t, txn.Commit())
+		}
+	}
+	wg.Add(2)
+	go lockAndTerminate(0, txn1, rel1, pk2)
+	go lockAndTerminate(1, txn2, rel2, pk1)
+	wg.Wait()
+	t.Log(errs)
+	assert.True(t, (errs[0] == nil) != (errs[1] == nil))
+	if errs[0] != nil {
+		assert.ErrorIs(t, errs[0], txnbase.ErrLockDeadlock)
+	} else {
+		assert.ErrorIs(t, errs[1], txnbase.ErrLockDeadlock)
+	}
+	assert.Equal(t, 0, tae.TxnMgr.LockMgr.Count())
+}
+
+func getRowValue(rel handle.Relation, bat *gbat.Batch, pkIdx, row int, col uint16) (interface{}, error) {
+	id, offset, err := rel.GetByFilter(handle.NewEQFilter(compute.GetValue(bat.Vecs[pkIdx], uint32(row))))
+	if err != nil {
+		return nil, err
+	}
+	return rel.GetValue(id, offset, col)
+}
+
+func deleteRow(t *testing.T, rel handle.Relation, bat *gbat.Batch, pkIdx, row int) {
+	id, offset, err := rel.GetByFilter(handle.NewEQFilter(compute.GetValue(bat.Vecs[pkIdx], uint32(row))))
+	assert.NoError(t, err)
+	assert.NoError(t, rel.RangeDelete(id, offset, offset))
+}
+
+func TestSavepoint(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	schema, bat := mockSSITable(t, tae, 30)
+	bats := compute.SplitBatch(bat, 3)
+	pkIdx := int(schema.PrimaryKey)
+	value := func(row int) interface{} { return compute.GetValue(bat.Vecs[3], uint32(row)) }
+	pkOf := func(row int) *handle.Filter {
+		return handle.NewEQFilter(compute.GetValue(bat.Vecs[pkIdx], uint32(row)))
+	}
+
+	txn, rel := startSSITxn(t, tae, txnif.SnapshotIsolation, schema.Name)
+	assert.NoError(t, rel.Append(bats[1]))
+	assert.NoError(t, rel.UpdateByFilter(pkOf(1), 3, int64(100)))
+	deleteRow(t, rel, bat, pkIdx, 3)
+	deleteRow(t, rel, bat, pkIdx, 11)
+	assert.NoError(t, txn.Savepoint("sp1"))
+
+	for i := 0; i < 2; i++ {
+		assert.NoError(t, rel.Append(bats[2]))
+		assert.NoError(t, rel.UpdateByFilter(pkOf(1), 3, int64(200)))
+		assert.NoError(t, rel.UpdateByFilter(pkOf(2), 3, int64(300)))
+		assert.NoError(t, rel.UpdateByFilter(pkOf(12), 3, int64(400)))
+		deleteRow(t, rel, bat, pkIdx, 0)
+		deleteRow(t, rel, bat, pkIdx, 4)
+		deleteRow(t, rel, bat, pkIdx, 15)
+		// The writes after the savepoint are undone, the txn goes on
+		assert.NoError(t, txn.RollbackToSavepoint("sp1"))
+	}
+	assert.NoError(t, txn.Commit())
+
+	txn, rel = startSSITxn(t, tae, txnif.SnapshotIsolation, schema.Name)
+	for row := 0; row < 30; row++ {
+		v, err := getRowValue(rel, bat, pkIdx, row, 3)
+		switch {
+		case row == 3 || row == 11 || row >= 20:
+			assert.Error(t, err)
+		case row == 1:
+			assert.NoError(t, err)
+			assert.Equal(t, int64(100), v)
+		default:
+			assert.NoError(t, err)
+			assert.Equal(t, value(row), v)
+		}
+	}
+	assert.NoError(t, txn.Commit())
+}
+
+func TestReleaseSavepoint(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	schema, bat := mockSSITable(t, tae, 30)
+	bats := compute.SplitBatch(bat, 3)
+	pkIdx := int(schema.PrimaryKey)
+
+	txn, rel := startSSITxn(t, tae, txnif.SnapshotIsolation, schema.Name)
+	assert.NoError(t, txn.Savepoint("sp1"))
+	assert.NoError(t, rel.Append(bats[1]))
+	assert.NoError(t, txn.Savepoint("sp2"))
+	assert.NoError(t, rel.Append(bats[2]))
+
+	// Releasing sp1 releases sp2 too and keeps the writes
+	assert.NoError(t, txn.ReleaseSavepoint("sp1"))
+	assert.ErrorIs(t, txn.RollbackToSavepoint("sp2"), txnbase.ErrSavepointNotFound)
+	assert.ErrorIs(t, txn.ReleaseSavepoint("sp1"), txnbase.ErrSavepointNotFound)
+
+	// A savepoint with an existing name replaces the old one
+	assert.NoError(t, txn.Savepoint("sp3"))
+	deleteRow(t, rel, bat, pkIdx, 0)
+	assert.NoError(t, txn.Savepoint("sp3"))
+	deleteRow(t, rel, bat, pkIdx, 25)
+	assert.NoError(t, txn.RollbackToSavepoint("sp3"))
+	assert.NoError(t, txn.Commit())
+
+	txn, rel = startSSITxn(t, tae, txnif.SnapshotIsolation, schema.Name)
+	for row := 0; row < 30; row++ {
+		_, err := getRowValue(rel, bat, pkIdx, row, 3)
+		if row == 0 {
+			assert.Error(t, err)
+		} else {
+			assert.NoError(t, err)
+		}
+	}
+	assert.NoError(t, txn.Commit())
+}
+
+func TestStatementRollback(t *testing.T) {
+	tae := initDB(t, nil)
+	schema, bat := mockSSITable(t, tae, 30)
+	bats := compute.SplitBatch(bat, 3)
+	pkIdx := int(schema.PrimaryKey)
+
+	txn, rel := startSSITxn(t, tae, txnif.SnapshotIsolation, schema.Name)
+	assert.NoError(t, txn.BeginStatement())
+	assert.NoError(t, rel.Append(bats[1]))
+	assert.NoError(t, txn.BeginStatement())
+	deleteRow(t, rel, bat, pkIdx, 5)
+	assert.NoError(t, txn.BeginStatement())
+	assert.NoError(t, rel.Append(bats[2]))
+	// The failed statement is undone and the previous ones are kept
+	assert.Error(t, rel.Append(bats[0]))
+	assert.NoError(t, txn.RollbackStatement())
+	assert.NoError(t, txn.RollbackStatement())
+	assert.NoError(t, txn.Commit())
+
+	check := func(tae *DB) {
+		txn, rel := startSSITxn(t, tae, txnif.SnapshotIsolation, schema.Name)
+		for row := 0; row < 30; row++ {
+			_, _, err := rel.GetByFilter(handle.NewEQFilter(compute.GetValue(bat.Vecs[pkIdx], uint32(row))))
+			if row == 5 || row >= 20 {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		}
+		assert.NoError(t, txn.Commit())
+	}
+	check(tae)
+	tae.Close()
+
+	// The undone rows are not replayed
+	tae2, err := Open(tae.Dir, nil)
+	assert.NoError(t, err)
+	defer tae2.Close()
+	check(tae2)
+}
+
+func TestSavepointDDL(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	schema, bat := mockSSITable(t, tae, 30)
+	bats := compute.SplitBatch(bat, 3)
+
+	txn, rel := startSSITxn(t, tae, txnif.SnapshotIsolation, schema.Name)
+	assert.NoError(t, txn.Savepoint("sp1"))
+	assert.NoError(t, rel.Append(bats[1]))
+	db, err := txn.GetDatabase("db")
+	assert.NoError(t, err)
+	_, err = db.CreateRelation(catalog.MockSchema(2))
+	assert.NoError(t, err)
+	// The ddl cannot be undone, and nothing is undone
+	assert.ErrorIs(t, txn.RollbackToSavepoint("sp1"), txnimpl.ErrSavepointDDL)
+	assert.NoError(t, txn.Commit())
+
+	txn, rel = startSSITxn(t, tae, txnif.SnapshotIsolation, schema.Name)
+	_, err = getRowValue(rel, bat, int(schema.PrimaryKey), 15, 3)
+	assert.NoError(t, err)
+	assert.NoError(t, txn.Commit())
+}
+
+func TestAutoIncrement(t *testing.T) {
+	tae := initDB(t, nil)
+	schema := catalog.MockSchemaAll(13)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	schema.PrimaryKey = 3
+	schema.ColDefs[3].AutoIncrement = 1
+	schema.AutoIncrement = 100
+
+	// Rows 0-4 are NULL, row 5 is explicit and rows 6-9 are 0
+	bat := catalog.MockData(schema, 10)
+	for row := uint32(0); row < 10; row++ {
+		v := int64(0)
+		if row == 5 {
+			v = 500
+		}
+		assert.NoError(t, compute.SetFixSizeTypeValue(bat.Vecs[3], row, v))
+		if row < 5 {
+			nulls.Add(bat.Vecs[3].Nsp, uint64(row))
+		}
+	}
+	txn, _ := tae.StartTxn(nil)
+	db, err := txn.CreateDatabase("db")
+	assert.NoError(t, err)
+	rel, err := db.CreateRelation(schema)
+	assert.NoError(t, err)
+	assert.NoError(t, rel.Append(bat))
+	assert.Equal(t, uint64(100), txn.GetLastInsertID())
+	assert.NoError(t, txn.Commit())
+
+	// A batch without the column gets all the values generated
+	withoutColumn := func(rows uint32) *gbat.Batch {
+		bat := catalog.MockData(schema, rows)
+		bat.Vecs = append(bat.Vecs[:3], bat.Vecs[4:]...)
+		return bat
+	}
+	// The values allocated by a rolled back txn are not reused
+	txn, rel = startSSITxn(t, tae, txnif.SnapshotIsolation, schema.Name)
+	assert.NoError(t, rel.Append(withoutColumn(3)))
+	assert.Equal(t, uint64(505), txn.GetLastInsertID())
+	assert.NoError(t, txn.Rollback())
+	txn, rel = startSSITxn(t, tae, txnif.SnapshotIsolation, schema.Name)
+	assert.NoError(t, rel.Append(withoutColumn(3)))
+	assert.Equal(t, uint64(508), txn.GetLastInsertID())
+	assert.NoError(t, txn.Commit())
+
+	check := func(tae *DB) {
+		txn, rel := startSSITxn(t, tae, txnif.SnapshotIsolation, schema.Name)
+		for _, v := range []int64{100, 104, 500, 501, 504, 508, 510} {
+			_, _, err := rel.GetByFilter(handle.NewEQFilter(v))
+			assert.NoError(t, err)
+		}
+		for _, v := range []int64{99, 105, 505, 507, 511} {
+			_, _, err := rel.GetByFilter(handle.NewEQFilter(v))
+			assert.Error(t, err)
+		}
+		assert.NoError(t, txn.Commit())
+	}
+	check(tae)
+	tae.Close()
+
+	// The values reserved before the restart are skipped
+	tae2, err := Open(tae.Dir, nil)
+	assert.NoError(t, err)
+	defer tae2.Close()
+	check(tae2)
+	txn, rel = startSSITxn(t, tae2, txnif.SnapshotIsolation, schema.Name)
+	assert.NoError(t, rel.Append(withoutColumn(1)))
+	assert.Equal(t, 105+catalog.AutoIncrementBatch, txn.GetLastInsertID())
+	assert.NoError(t, txn.Commit())
+}
+
+// mockInt32Schema is a schema of int32 columns a0, a1, ..., a0 is the primary
+// key
+func mockInt32Schema(name string, colCnt int) *catalog.Schema {
+	schema := catalog.NewEmptySchema(name)
+	for i := 0; i < colCnt; i++ {
+		schema.AppendCol(fmt.Sprintf("a%d", i), types.Type{Oid: types.T_int32, Size: 4, Width: 32})
+	}
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	return schema
+}
+
+// mockInt32Rows makes a batch of schema from rows of int32 values, nil is
+// NULL
+func mockInt32Rows(schema *catalog.Schema, rows ...[]interface{}) *gbat.Batch {
+	bat := gbat.New(true, schema.Attrs())
+	for i, colDef := range schema.ColDefs {
+		bat.Vecs[i] = gvec.New(colDef.Type)
+		for row, vals := range rows {
+			if vals[i] == nil {
+				compute.AppendValue(bat.Vecs[i], int32(0))
+				nulls.Add(bat.Vecs[i].Nsp, uint64(row))
+				continue
+			}
+			compute.AppendValue(bat.Vecs[i], int32(vals[i].(int)))
+		}
+	}
+	return bat
+}
+
+// getInt32Value returns the value of col of the row of pk, nil for NULL
+func getInt32Value(t *testing.T, rel handle.Relation, pk int32, col int) interface{} {
+	it := rel.MakeBlockIt()
+	for it.Valid() {
+		blk := it.GetBlock()
+		pks, err := blk.GetColumnDataById(0, nil, nil)
+		assert.NoError(t, err)
+		vals, err := blk.GetColumnDataById(col, nil, nil)
+		assert.NoError(t, err)
+		for row := 0; row < gvec.Length(pks.AppliedVec); row++ {
+			if pks.DeleteMask != nil && pks.DeleteMask.Contains(uint32(row)) {
+				continue
+			}
+			if compute.GetValue(pks.AppliedVec, uint32(row)).(int32) != pk {
+				continue
+			}
+			if nulls.Contains(vals.AppliedVec.Nsp, uint64(row)) {
+				return nil
+			}
+			return compute.GetValue(vals.AppliedVec, uint32(row))
+		}
+		it.Next()
+	}
+	t.Fatalf("row %d not found", pk)
+	return nil
+}
+
+func TestConstraintNotNullAndCheck(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	schema := mockInt32Schema("t", 3)
+	schema.ColDefs[1].NullAbility = 1
+	typ := schema.ColDefs[2].Type
+	zero, _ := common.EncodeKey(int32(0), typ)
+	// a2 > 0
+	schema.Checks = []*catalog.CheckInfo{{
+		Name: "t_chk_1",
+		Expr: "a2 > 0",
+		Pred: &catalog.CheckExpr{
+			Op: catalog.CheckGt,
+			Args: []*catalog.CheckExpr{
+				{Op: catalog.CheckColumn, SeqNum: 2, Typ: typ},
+				{Op: catalog.CheckConst, Typ: typ, Val: zero},
+			},
+		},
+	}}
+	txn, _ := tae.StartTxn(nil)
+	db, err := txn.CreateDatabase("db")
+	assert.NoError(t, err)
+	rel, err := db.CreateRelation(schema)
+	assert.NoError(t, err)
+	err = rel.Append(mockInt32Rows(schema, []interface{}{1, nil, 1}))
+	assert.ErrorIs(t, err, txnbase.ErrNotNullViolation)
+	var cerr *txnbase.ConstraintError
+	assert.True(t, errors.As(err, &cerr))
+	assert.Equal(t, "a1", cerr.Name)
+	err = rel.Append(mockInt32Rows(schema, []interface{}{1, 1, -1}))
+	assert.ErrorIs(t, err, txnbase.ErrCheckViolation)
+	// NULL does not violate the check
+	assert.NoError(t, rel.Append(mockInt32Rows(schema, []interface{}{1, 1, nil}, []interface{}{2, 2, 2})))
+	assert.NoError(t, txn.Commit())
+
+	txn, rel = startSSITxn(t, tae, txnif.SnapshotIsolation, schema.Name)
+	id, row, err := rel.GetByFilter(handle.NewEQFilter(int32(2)))
+	assert.NoError(t, err)
+	assert.ErrorIs(t, rel.Update(id, row, 2, int32(-2)), txnbase.ErrCheckViolation)
+	assert.ErrorIs(t, rel.Update(id, row, 1, nil), txnbase.ErrNotNullViolation)
+	assert.NoError(t, rel.Update(id, row, 2, nil))
+	assert.NoError(t, txn.Commit())
+
+	txn, rel = startSSITxn(t, tae, txnif.SnapshotIsolation, schema.Name)
+	assert.Nil(t, getInt32Value(t, rel, 2, 2))
+	assert.Equal(t, int32(2), getInt32Value(t, rel, 2, 1))
+	assert.NoError(t, txn.Commit())
+}
+
+func TestConstraintUnique(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	schema := mockInt32Schema("t", 2)
+	schema.Indexes = append(schema.Indexes, catalog.NewIndexInfo("u1", catalog.Unique, 1))
+	rows := make([][]interface{}, 0)
+	for i := 0; i < 25; i++ {
+		rows = append(rows, []interface{}{i, i * 10})
+	}
+	txn, _ := tae.StartTxn(nil)
+	db, err := txn.CreateDatabase("db")
+	assert.NoError(t, err)
+	rel, err := db.CreateRelation(schema)
+	assert.NoError(t, err)
+	assert.NoError(t, rel.Append(mockInt32Rows(schema, rows...)))
+	assert.NoError(t, txn.Commit())
+
+	txn, rel = startSSITxn(t, tae, txnif.SnapshotIsolation, schema.Name)
+	err = rel.Append(mockInt32Rows(schema, []interface{}{100, 50}))
+	assert.ErrorIs(t, err, txnbase.ErrDuplicated)
+	var cerr *txnbase.ConstraintError
+	assert.True(t, errors.As(err, &cerr))
+	assert.Equal(t, "u1", cerr.Name)
+	// NULLs are not duplicated
+	assert.NoError(t, rel.Append(mockInt32Rows(schema, []interface{}{100, nil}, []interface{}{101, nil})))
+	err = rel.Append(mockInt32Rows(schema, []interface{}{102, 7}, []interface{}{103, 7}))
+	assert.ErrorIs(t, err, txnbase.ErrDuplicated)
+	err = rel.Append(mockInt32Rows(schema, []interface{}{102, 7}))
+	assert.NoError(t, err)
+	err = rel.Append(mockInt32Rows(schema, []interface{}{103, 7}))
+	assert.ErrorIs(t, err, txnbase.ErrDuplicated)
+	// The value of a deleted row can be appended again
+	id, row, err := rel.GetByFilter(handle.NewEQFilter(int32(5)))
+	assert.NoError(t, err)
+	assert.NoError(t, rel.RangeDelete(id, row, row))
+	assert.NoError(t, rel.Append(mockInt32Rows(schema, []interface{}{104, 50})))
+	assert.NoError(t, txn.Commit())
+
+	// Concurrent txns appending the same value
+	txn1, rel1 := startSSITxn(t, tae, txnif.SnapshotIsolation, schema.Name)
+	txn2, rel2 := startSSITxn(t, tae, txnif.SnapshotIsolation, schema.Name)
+	assert.NoError(t, rel1.Append(mockInt32Rows(schema, []interface{}{105, 1000})))
+	assert.NoError(t, rel2.Append(mockInt32Rows(schema, []interface{}{106, 1000})))
+	assert.NoError(t, txn1.Commit())
+	assert.Error(t, txn2.Commit())
+
+	// Updates
+	txn, rel = startSSITxn(t, tae, txnif.SnapshotIsolation, schema.Name)
+	id, row, err = rel.GetByFilter(handle.NewEQFilter(int32(1)))
+	assert.NoError(t, err)
+	assert.ErrorIs(t, rel.Update(id, row, 1, int32(20)), txnbase.ErrDuplicated)
+	assert.NoError(t, rel.Update(id, row, 1, int32(10)))
+	assert.NoError(t, rel.Update(id, row, 1, int32(9999)))
+	assert.NoError(t, txn.Commit())
+	txn, rel = startSSITxn(t, tae, txnif.SnapshotIsolation, schema.Name)
+	assert.NoError(t, rel.Append(mockInt32Rows(schema, []interface{}{107, 10})))
+	assert.ErrorIs(t, rel.Append(mockInt32Rows(schema, []interface{}{108, 9999})), txnbase.ErrDuplicated)
+	assert.NoError(t, txn.Commit())
+
+	// The values in the compacted blocks
+	worker := ops.NewOpWorker("xx")
+	worker.Start()
+	defer worker.Stop()
+	txn, rel = startSSITxn(t, tae, txnif.SnapshotIsolation, schema.Name)
+	it := rel.MakeBlockIt()
+	blk := it.GetBlock()
+	ctx := &tasks.Context{Waitable: true}
+	task, err := jobs.NewCompactBlockTask(ctx, txn, blk.GetMeta().(*catalog.BlockEntry), tae.Scheduler)
+	assert.NoError(t, err)
+	worker.SendOp(task)
+	assert.NoError(t, task.WaitDone())
+	assert.NoError(t, txn.Commit())
+	txn, rel = startSSITxn(t, tae, txnif.SnapshotIsolation, schema.Name)
+	assert.ErrorIs(t, rel.Append(mockInt32Rows(schema, []interface{}{109, 0})), txnbase.ErrDuplicated)
+	assert.ErrorIs(t, rel.Append(mockInt32Rows(schema, []interface{}{109, 30})), txnbase.ErrDuplicated)
+	assert.NoError(t, rel.Append(mockInt32Rows(schema, []interface{}{109, 35})))
+	assert.NoError(t, txn.Commit())
+}
+
+func TestConstraintForeignKey(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	parent := mockInt32Schema("p", 2)
+	parent.Indexes = append(parent.Indexes, catalog.NewIndexInfo("u1", catalog.Unique, 1))
+	txn, _ := tae.StartTxn(nil)
+	db, err := txn.CreateDatabase("db")
+	assert.NoError(t, err)
+	rel, err := db.CreateRelation(parent)
+	assert.NoError(t, err)
+	assert.NoError(t, rel.Append(mockInt32Rows(parent,
+		[]interface{}{1, 10}, []interface{}{2, 20}, []interface{}{3, 30}, []interface{}{4, 40})))
+	// Children referencing the a1 of parent with different actions
+	newChild := func(name string, onDelete, onUpdate catalog.RefAction) *catalog.Schema {
+		schema := mockInt32Schema(name, 2)
+		schema.ForeignKeys = []*catalog.ForeignKeyInfo{{
+			Name:      name + "_ibfk_1",
+			Column:    1,
+			RefDB:     db.GetID(),
+			RefTable:  rel.ID(),
+			RefColumn: 1,
+			OnDelete:  onDelete,
+			OnUpdate:  onUpdate,
+		}}
+		_, err := db.CreateRelation(schema)
+		assert.NoError(t, err)
+		return schema
+	}
+	restrict := newChild("cr", catalog.RefRestrict, catalog.RefRestrict)
+	cascade := newChild("cc", catalog.RefCascade, catalog.RefCascade)
+	setNull := newChild("cn", catalog.RefSetNull, catalog.RefSetNull)
+	assert.NoError(t, txn.Commit())
+
+	txn, rel = startSSITxn(t, tae, txnif.SnapshotIsolation, restrict.Name)
+	err = rel.Append(mockInt32Rows(restrict, []interface{}{1, 99}))
+	assert.ErrorIs(t, err, txnbase.ErrNoReferencedRow)
+	assert.NoError(t, rel.Append(mockInt32Rows(restrict, []interface{}{1, 10}, []interface{}{2, nil})))
+	db, _ = txn.GetDatabase("db")
+	rel, _ = db.GetRelationByName(cascade.Name)
+	assert.NoError(t, rel.Append(mockInt32Rows(cascade, []interface{}{1, 20}, []interface{}{2, 40})))
+	rel, _ = db.GetRelationByName(setNull.Name)
+	assert.NoError(t, rel.Append(mockInt32Rows(setNull, []interface{}{1, 30})))
+	assert.NoError(t, txn.Commit())
+
+	// RESTRICT
+	txn, rel = startSSITxn(t, tae, txnif.SnapshotIsolation, parent.Name)
+	id, row, err := rel.GetByFilter(handle.NewEQFilter(int32(1)))
+	assert.NoError(t, err)
+	assert.ErrorIs(t, rel.RangeDelete(id, row, row), txnbase.ErrRowIsReferenced)
+	assert.ErrorIs(t, rel.Update(id, row, 1, int32(11)), txnbase.ErrRowIsReferenced)
+	db, _ = txn.GetDatabase("db")
+	_, err = db.DropRelationByName(parent.Name)
+	assert.ErrorIs(t, err, txnbase.ErrTableIsReferenced)
+	assert.NoError(t, txn.Rollback())
+
+	// CASCADE and SET NULL
+	txn, rel = startSSITxn(t, tae, txnif.SnapshotIsolation, parent.Name)
+	id, row, err = rel.GetByFilter(handle.NewEQFilter(int32(2)))
+	assert.NoError(t, err)
+	assert.NoError(t, rel.Update(id, row, 1, int32(200)))
+	id, row, err = rel.GetByFilter(handle.NewEQFilter(int32(4)))
+	assert.NoError(t, err)
+	assert.NoError(t, rel.RangeDelete(id, row, row))
+	id, row, err = rel.GetByFilter(handle.NewEQFilter(int32(3)))
+	assert.NoError(t, err)
+	assert.NoError(t, rel.RangeDelete(id, row, row))
+	assert.NoError(t, txn.Commit())
+
+	txn, rel = startSSITxn(t, tae, txnif.SnapshotIsolation, cascade.Name)
+	assert.Equal(t, int32(200), getInt32Value(t, rel, 1, 1))
+	_, _, err = rel.GetByFilter(handle.NewEQFilter(int32(2)))
+	assert.Error(t, err)
+	db, _ = txn.GetDatabase("db")
+	rel, _ = db.GetRelationByName(setNull.Name)
+	assert.Nil(t, getInt32Value(t, rel, 1, 1))
+	// The child is updated to a key not in the parent
+	id, row, err = rel.GetByFilter(handle.NewEQFilter(int32(1)))
+	assert.NoError(t, err)
+	assert.ErrorIs(t, rel.Update(id, row, 1, int32(30)), txnbase.ErrNoReferencedRow)
+	assert.NoError(t, rel.Update(id, row, 1, int32(10)))
+	assert.NoError(t, txn.Commit())
+
+	txn, rel = startSSITxn(t, tae, txnif.SnapshotIsolation, parent.Name)
+	assert.NoError(t, rel.Append(mockInt32Rows(parent, []interface{}{5, 50}, []interface{}{6, 60})))
+	assert.NoError(t, txn.Commit())
+
+	// A child referencing the parent deleted by a concurrent txn
+	txn1, rel1 := startSSITxn(t, tae, txnif.SnapshotIsolation, parent.Name)
+	txn2, rel2 := startSSITxn(t, tae, txnif.SnapshotIsolation, restrict.Name)
+	id, row, err = rel1.GetByFilter(handle.NewEQFilter(int32(5)))
+	assert.NoError(t, err)
+	assert.NoError(t, rel1.RangeDelete(id, row, row))
+	assert.NoError(t, rel2.Append(mockInt32Rows(restrict, []interface{}{3, 50})))
+	assert.NoError(t, txn2.Commit())
+	assert.ErrorIs(t, txn1.Commit(), txnbase.ErrRowIsReferenced)
+
+	txn1, rel1 = startSSITxn(t, tae, txnif.SnapshotIsolation, restrict.Name)
+	txn2, rel2 = startSSITxn(t, tae, txnif.SnapshotIsolation, parent.Name)
+	assert.NoError(t, rel1.Append(mockInt32Rows(restrict, []interface{}{4, 60})))
+	id, row, err = rel2.GetByFilter(handle.NewEQFilter(int32(6)))
+	assert.NoError(t, err)
+	assert.NoError(t, rel2.RangeDelete(id, row, row))
+	assert.NoError(t, txn2.Commit())
+	assert.ErrorIs(t, txn1.Commit(), txnbase.ErrNoReferencedRow)
+
+	// The deleted key appended again by the txn is still referenced
+	txn1, rel1 = startSSITxn(t, tae, txnif.SnapshotIsolation, parent.Name)
+	txn2, rel2 = startSSITxn(t, tae, txnif.SnapshotIsolation, restrict.Name)
+	id, row, err = rel1.GetByFilter(handle.NewEQFilter(int32(2)))
+	assert.NoError(t, err)
+	assert.NoError(t, rel1.Update(id, row, 1, int32(20)))
+	assert.NoError(t, rel1.Append(mockInt32Rows(parent, []interface{}{7, 200})))
+	assert.NoError(t, rel2.Append(mockInt32Rows(restrict, []interface{}{5, 200})))
+	assert.NoError(t, txn2.Commit())
+	assert.NoError(t, txn1.Commit())
+}
+
+func TestUpsert(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	schema := mockInt32Schema("t", 3)
+	txn, _ := tae.StartTxn(nil)
+	db, err := txn.CreateDatabase("db")
+	assert.NoError(t, err)
+	rel, err := db.CreateRelation(schema)
+	assert.NoError(t, err)
+	// a full block to be compacted later
+	rows := [][]interface{}{{1, 1, 1}, {2, 2, 2}}
+	for i := 0; i < int(schema.BlockMaxRows)-2; i++ {
+		rows = append(rows, []interface{}{i + 10, i, i})
+	}
+	assert.NoError(t, rel.Append(mockInt32Rows(schema, rows...)))
+	assert.NoError(t, txn.Commit())
+
+	// REPLACE a committed row, insert a row and replace it in the same batch
+	txn, rel = startSSITxn(t, tae, txnif.SnapshotIsolation, schema.Name)
+	affected, err := rel.Upsert(mockInt32Rows(schema,
+		[]interface{}{1, 10, 10},
+		[]interface{}{3, 3, 3},
+		[]interface{}{3, 30, nil}), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(5), affected)
+	// and an uncommitted row of the txn
+	affected, err = rel.Upsert(mockInt32Rows(schema, []interface{}{3, 31, 31}), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), affected)
+	assert.Equal(t, int32(10), getInt32Value(t, rel, 1, 1))
+	assert.Equal(t, int32(31), getInt32Value(t, rel, 3, 1))
+	assert.NoError(t, txn.Commit())
+
+	// a1 = a1 + VALUES(a1), a2 = VALUES(a2)
+	onDup := func(old, row []interface{}) ([]interface{}, error) {
+		updated := append([]interface{}{}, old...)
+		updated[1] = old[1].(int32) + row[1].(int32)
+		updated[2] = row[2]
+		return updated, nil
+	}
+	txn, rel = startSSITxn(t, tae, txnif.SnapshotIsolation, schema.Name)
+	affected, err = rel.Upsert(mockInt32Rows(schema,
+		[]interface{}{2, 5, 2},   // updated
+		[]interface{}{4, 4, 4},   // inserted
+		[]interface{}{1, 0, 10},  // updated to its current values
+		[]interface{}{4, 1, nil}, // updated to NULL
+	), onDup)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(5), affected)
+	assert.NoError(t, txn.Commit())
+
+	txn, rel = startSSITxn(t, tae, txnif.SnapshotIsolation, schema.Name)
+	assert.Equal(t, int32(7), getInt32Value(t, rel, 2, 1))
+	assert.Equal(t, int32(2), getInt32Value(t, rel, 2, 2))
+	assert.Equal(t, int32(10), getInt32Value(t, rel, 1, 1))
+	assert.Equal(t, int32(5), getInt32Value(t, rel, 4, 1))
+	assert.Nil(t, getInt32Value(t, rel, 4, 2))
+	assert.NoError(t, txn.Commit())
+
+	// The committed rows in the compacted blocks
+	worker := ops.NewOpWorker("xx")
+	worker.Start()
+	defer worker.Stop()
+	txn, rel = startSSITxn(t, tae, txnif.SnapshotIsolation, schema.Name)
+	it := rel.MakeBlockIt()
+	blk := it.GetBlock()
+	ctx := &tasks.Context{Waitable: true}
+	task, err := jobs.NewCompactBlockTask(ctx, txn, blk.GetMeta().(*catalog.BlockEntry), tae.Scheduler)
+	assert.NoError(t, err)
+	worker.SendOp(task)
+	assert.NoError(t, task.WaitDone())
+	assert.NoError(t, txn.Commit())
+
+	// a new primary key deletes the row and appends it again
+	txn, rel = startSSITxn(t, tae, txnif.SnapshotIsolation, schema.Name)
+	affected, err = rel.Upsert(mockInt32Rows(schema, []interface{}{2, 0, 0}), func(old, row []interface{}) ([]interface{}, error) {
+		updated := append([]interface{}{}, old...)
+		updated[0] = old[0].(int32) + 100
+		return updated, nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), affected)
+	assert.NoError(t, txn.Commit())
+
+	txn, rel = startSSITxn(t, tae, txnif.SnapshotIsolation, schema.Name)
+	_, _, err = rel.GetByFilter(handle.NewEQFilter(int32(2)))
+	assert.Error(t, err)
+	assert.Equal(t, int32(7), getInt32Value(t, rel, 102, 1))
+	affected, err = rel.Upsert(mockInt32Rows(schema, []interface{}{1, 1, 1}), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), affected)
+	assert.Equal(t, int32(1), getInt32Value(t, rel, 1, 1))
+	assert.NoError(t, txn.Commit())
+}
+
+func TestUpsertUnique(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	schema := mockInt32Schema("t", 3)
+	schema.Indexes = append(schema.Indexes, catalog.NewIndexInfo("u1", catalog.Unique, 1))
+	txn, _ := tae.StartTxn(nil)
+	db, err := txn.CreateDatabase("db")
+	assert.NoError(t, err)
+	rel, err := db.CreateRelation(schema)
+	assert.NoError(t, err)
+	assert.NoError(t, rel.Append(mockInt32Rows(schema, []interface{}{1, 10, 1}, []interface{}{2, 20, 2})))
+	assert.NoError(t, txn.Commit())
+
+	// REPLACE the rows conflicting on the unique column or the primary key
+	txn, rel = startSSITxn(t, tae, txnif.SnapshotIsolation, schema.Name)
+	affected, err := rel.Upsert(mockInt32Rows(schema, []interface{}{3, 10, 3}), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), affected)
+	// both the row of the txn with a1 = 10 and the committed row 2
+	affected, err = rel.Upsert(mockInt32Rows(schema, []interface{}{2, 10, 5}), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(3), affected)
+	assert.NoError(t, txn.Commit())
+
+	txn, rel = startSSITxn(t, tae, txnif.SnapshotIsolation, schema.Name)
+	_, _, err = rel.GetByFilter(handle.NewEQFilter(int32(1)))
+	assert.Error(t, err)
+	_, _, err = rel.GetByFilter(handle.NewEQFilter(int32(3)))
+	assert.Error(t, err)
+	assert.Equal(t, int32(5), getInt32Value(t, rel, 2, 2))
+	assert.NoError(t, txn.Commit())
+
+	// a2 = VALUES(a2)
+	onDup := func(old, row []interface{}) ([]interface{}, error) {
+		updated := append([]interface{}{}, old...)
+		updated[2] = row[2]
+		return updated, nil
+	}
+	txn, rel = startSSITxn(t, tae, txnif.SnapshotIsolation, schema.Name)
+	affected, err = rel.Upsert(mockInt32Rows(schema,
+		[]interface{}{5, 10, 0},  // updates the row 2
+		[]interface{}{6, 60, 6},  // inserted
+		[]interface{}{7, 60, 7},  // updates the row 6 of the batch
+		[]interface{}{8, 10, 0}), // the row 2 updated to its current values
+		onDup)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(5), affected)
+	assert.NoError(t, txn.Commit())
+
+	txn, rel = startSSITxn(t, tae, txnif.SnapshotIsolation, schema.Name)
+	assert.Equal(t, int32(0), getInt32Value(t, rel, 2, 2))
+	assert.Equal(t, int32(7), getInt32Value(t, rel, 6, 2))
+	for _, pk := range []int32{5, 7, 8} {
+		_, _, err = rel.GetByFilter(handle.NewEQFilter(pk))
+		assert.Error(t, err)
+	}
+	assert.NoError(t, txn.Commit())
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/file"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/basic"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/model"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tasks"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/wal"
@@ -63,11 +64,20 @@ type Block interface {
 	Update(txn txnif.AsyncTxn, row uint32, colIdx uint16, v interface{}) (txnif.UpdateNode, error)
 
 	GetTotalChanges() int
+	// GetDeleteCnt returns the number of the committed deleted rows
+	GetDeleteCnt() uint32
 	CollectChangesInRange(startTs, endTs uint64) (*model.BlockView, error)
 	CollectAppendLogIndexes(startTs, endTs uint64) ([]*wal.Index, error)
 
 	BatchDedup(txn txnif.AsyncTxn, pks *vector.Vector) error
+	BatchDedupOnColumn(txn txnif.AsyncTxn, colIdx uint16, keys *vector.Vector) error
 	GetByFilter(txn txnif.AsyncTxn, filter *handle.Filter) (uint32, error)
+	MayContainsKeyOnColumn(colIdx uint16, key interface{}) bool
+	MayContainsRangeOnColumn(colIdx uint16, min, max interface{}) bool
+	MayContainsNullOnColumn(colIdx uint16) bool
+	GetColumnZoneMap(colIdx uint16) *basic.ZoneMap
+	GetUnindexedColumns(cols []int) []int
+	BuildColumnIndexes(cols []int) error
 	GetValue(txn txnif.AsyncTxn, row uint32, col uint16) (interface{}, error)
 	PPString(level common.PPLevel, depth int, prefix string) string
 	GetBlockFile() file.Block
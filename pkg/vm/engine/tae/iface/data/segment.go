@@ -18,6 +18,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/file"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/basic"
 )
 
 type Segment interface {
@@ -25,5 +26,8 @@ type Segment interface {
 	GetID() uint64
 	GetSegmentFile() file.Segment
 	BatchDedup(txn txnif.AsyncTxn, pks *vector.Vector) error
+	// GetColumnZoneMap returns the zonemap of the column with the sequence
+	// number over all the blocks of the segment, or nil if there is none
+	GetColumnZoneMap(seqNum uint16) *basic.ZoneMap
 	Destory() error
 }
@@ -23,5 +23,8 @@ type TableHandle interface {
 
 type Table interface {
 	GetHandle() TableHandle
+	// GetPartitionHandle returns the handle appending to the partition of
+	// ID partition of a partitioned table
+	GetPartitionHandle(partition uint32) TableHandle
 	ApplyHandle(TableHandle)
 }
@@ -57,6 +57,9 @@ type Block interface {
 
 type ColumnBlock interface {
 	io.Closer
+	// SetCompressAlgo sets the compress algorithm of the column data written
+	// later
+	SetCompressAlgo(algo int)
 	WriteTS(ts uint64) error
 	WriteData(buf []byte) error
 	WriteIndex(idx int, buf []byte) error
@@ -26,5 +26,8 @@ type Segment interface {
 	String() string
 	RemoveBlock(id uint64)
 	GetSegmentFile() *segment.Segment
+	// Offload moves the sealed segment to the object store of the factory,
+	// it does nothing without an object store
+	Offload() error
 	// IsAppendable() bool
 }
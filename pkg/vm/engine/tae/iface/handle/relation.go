@@ -22,6 +22,32 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 )
 
+type LockMode uint8
+
+const (
+	// LockShared is compatible with the other shared locks, as FOR SHARE
+	LockShared LockMode = iota
+	// LockExclusive is compatible with no lock, as FOR UPDATE
+	LockExclusive
+)
+
+// LockWaitPolicy specifies what to do if a row is locked by the others
+type LockWaitPolicy uint8
+
+const (
+	// LockWait waits until the lock is released or the wait times out
+	LockWait LockWaitPolicy = iota
+	// LockNoWait fails at once, as NOWAIT
+	LockNoWait
+	// LockSkipLocked gives up the row, as SKIP LOCKED
+	LockSkipLocked
+)
+
+// OnDupFunc returns the row an existing row with the primary key or a unique
+// value of a row upserted is updated to. old is the existing row and row the one upserted,
+// both in the column order of the schema with nil for NULL.
+type OnDupFunc func(old, row []interface{}) ([]interface{}, error)
+
 type Relation interface {
 	io.Closer
 	ID() uint64
@@ -39,16 +65,42 @@ type Relation interface {
 	GetByFilter(filter *Filter) (id *common.ID, offset uint32, err error)
 	GetValue(id *common.ID, row uint32, col uint16) (interface{}, error)
 	UpdateByFilter(filter *Filter, col uint16, v interface{}) error
+	// Lock locks the row with the primary key pk until the txn terminates.
+	// It returns false if the row is skipped by LockSkipLocked.
+	Lock(pk interface{}, mode LockMode, policy LockWaitPolicy) (bool, error)
 
 	BatchDedup(col *vector.Vector) error
 	Append(data *batch.Batch) error
+	// Upsert appends the rows of data, a row with the primary key or a
+	// unique value of rows seen by the txn replaces all of them if onDup is
+	// nil, or else updates the first of them to the row returned by onDup.
+	// It returns the affected rows counted like MySQL: 1 per row inserted
+	// plus 1 per row it replaces, 2 per row updated and 0 per row updated
+	// to its current values.
+	Upsert(data *batch.Batch, onDup OnDupFunc) (affected uint64, err error)
 
 	GetMeta() interface{}
+	GetSchema() interface{}
 	CreateSegment() (Segment, error)
 	CreateNonAppendableSegment() (Segment, error)
+	// CreateNonAppendablePartitionSegment creates a non-appendable segment
+	// of the table partition of ID partition
+	CreateNonAppendablePartitionSegment(partition uint32) (Segment, error)
 	GetSegment(id uint64) (Segment, error)
 
 	SoftDeleteSegment(id uint64) (err error)
+
+	// CreateIndex creates a secondary index when the txn commits. The index
+	// is a zonemap and a static filter per flushed block, it only prunes the
+	// blocks a lookup on the indexed columns has to read and never maps a
+	// value to its rows. The blocks flushed before are backfilled in the
+	// background, till then they are only pruned by their zonemaps.
+	CreateIndex(def interface{}) error
+	DropIndex(name string) error
+	AlterTable(actions interface{}) error
+	// TruncatePartitions drops all the rows of the table partitions of IDs
+	// partitions by dropping their segments
+	TruncatePartitions(partitions []uint32) error
 }
 
 type RelationIt interface {
@@ -46,3 +46,24 @@ func TxnStrState(state TxnState) string {
 	}
 	panic("state not support")
 }
+
+type IsolationLevel int8
+
+const (
+	// SnapshotIsolation is the default level, a txn reads a snapshot at its
+	// start and only the ww-conflicts are checked, so write skew is possible
+	SnapshotIsolation IsolationLevel = iota
+	// Serializable also tracks the rows and tables read by the txn and
+	// aborts the txns which may form a rw-antidependency cycle
+	Serializable
+)
+
+func IsolationLevelStr(level IsolationLevel) string {
+	switch level {
+	case SnapshotIsolation:
+		return "SnapshotIsolation"
+	case Serializable:
+		return "Serializable"
+	}
+	panic("isolation level not support")
+}
@@ -42,6 +42,7 @@ type TxnReader interface {
 	GetStartTS() uint64
 	GetCommitTS() uint64
 	GetInfo() []byte
+	GetIsolationLevel() IsolationLevel
 	IsTerminated(bool) bool
 	IsVisible(o TxnReader) bool
 	GetTxnState(waitIfcommitting bool) TxnState
@@ -76,6 +77,22 @@ type TxnWriter interface {
 	LogTxnEntry(dbId, tableId uint64, entry TxnEntry, readed []*common.ID) error
 }
 
+// TxnSavepointer rolls back the writes of a txn without aborting it
+type TxnSavepointer interface {
+	Savepoint(name string) error
+	RollbackToSavepoint(name string) error
+	ReleaseSavepoint(name string) error
+	BeginStatement() error
+	RollbackStatement() error
+}
+
+// TxnInsertIDer tracks the first auto increment value generated by the
+// current statement of a txn
+type TxnInsertIDer interface {
+	GetLastInsertID() uint64
+	SetLastInsertID(id uint64)
+}
+
 type TxnAsyncer interface {
 	WaitDone(error) error
 }
@@ -97,6 +114,8 @@ type AsyncTxn interface {
 	TxnReader
 	TxnWriter
 	TxnChanger
+	TxnSavepointer
+	TxnInsertIDer
 }
 
 type SyncTxn interface {
@@ -178,6 +197,7 @@ type TxnStore interface {
 	Update(dbId uint64, id *common.ID, row uint32, col uint16, v interface{}) error
 	GetByFilter(dbId uint64, id uint64, filter *handle.Filter) (*common.ID, uint32, error)
 	GetValue(dbId uint64, id *common.ID, row uint32, col uint16) (interface{}, error)
+	Lock(dbId uint64, id uint64, pk interface{}, mode handle.LockMode, policy handle.LockWaitPolicy) (bool, error)
 
 	CreateRelation(dbId uint64, def interface{}) (handle.Relation, error)
 	DropRelationByName(dbId uint64, name string) (handle.Relation, error)
@@ -203,8 +223,14 @@ type TxnStore interface {
 
 	IsReadonly() bool
 	IncreateWriteCnt() int
+
+	MakeSavepoint() (Savepoint, error)
+	RollbackToSavepoint(Savepoint) error
 }
 
+// Savepoint is a checkpoint of the uncommitted writes of a TxnStore
+type Savepoint interface{}
+
 type TxnEntryType int16
 
 type TxnEntry interface {
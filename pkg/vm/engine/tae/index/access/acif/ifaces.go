@@ -20,6 +20,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/buffer/base"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/data"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/basic"
 )
 
 type IAppendableBlockIndexHolder interface {
@@ -29,13 +30,23 @@ type IAppendableBlockIndexHolder interface {
 	Search(key interface{}) (uint32, error)
 	//Upgrade() (INonAppendableBlockIndexHolder, error)
 	BatchDedup(keys *vector.Vector) error
+	BatchInsertOnColumn(colIdx uint16, keys *vector.Vector, start uint32, count int, offset uint32) error
+	SearchOnColumn(colIdx uint16, key interface{}) (uint32, error)
+	ContainsAnyKeysOnColumn(colIdx uint16, keys *vector.Vector) (bool, error)
+	UniqueColumns() []uint16
 }
 
 type INonAppendableBlockIndexHolder interface {
 	IBlockIndexHolder
 	MayContainsKey(key interface{}) bool
 	MayContainsAnyKeys(keys *vector.Vector) (error, *roaring.Bitmap)
+	MayContainsKeyOnColumn(colIdx uint16, key interface{}) bool
+	MayContainsRangeOnColumn(colIdx uint16, min, max interface{}) bool
+	MayContainsNullOnColumn(colIdx uint16) bool
+	GetColumnZoneMap(colIdx uint16) *basic.ZoneMap
+	HasStaticFilterOnColumn(colIdx uint16) bool
 	InitFromHost(host data.Block, schema *catalog.Schema, bufManager base.INodeManager) error
+	LoadColumnIndexes(host data.Block, schema *catalog.Schema, cols []uint16, bufManager base.INodeManager) error
 }
 
 type IBlockIndexHolder interface {
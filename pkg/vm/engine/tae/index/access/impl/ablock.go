@@ -16,8 +16,10 @@ package impl
 
 import (
 	"github.com/RoaringBitmap/roaring"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/data"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/basic"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/common/errors"
@@ -28,6 +30,10 @@ type appendableBlockIndexHolder struct {
 	treeIndex    basic.ARTMap
 	zoneMapIndex *basic.ZoneMap
 	schema       *catalog.Schema
+	// uniqueIndexes are the trees of the values of the unique columns. A
+	// value stays in the tree after its row is deleted or updated, so the
+	// keys found have to be checked against the rows.
+	uniqueIndexes map[uint16]basic.ARTMap
 }
 
 func NewAppendableBlockIndexHolder(host data.Block, schema *catalog.Schema) *appendableBlockIndexHolder {
@@ -37,9 +43,68 @@ func NewAppendableBlockIndexHolder(host data.Block, schema *catalog.Schema) *app
 	pkType := schema.GetPKType()
 	holder.treeIndex = basic.NewSimpleARTMap(pkType, nil)
 	holder.zoneMapIndex = basic.NewZoneMap(pkType, nil)
+	holder.uniqueIndexes = make(map[uint16]basic.ARTMap)
+	for _, col := range schema.UniqueColumns() {
+		holder.uniqueIndexes[uint16(col)] = basic.NewSimpleARTMap(schema.ColDefs[col].Type, nil)
+	}
 	return holder
 }
 
+// BatchInsertOnColumn inserts the values of a unique column, nulls and the
+// columns not indexed by the holder are skipped. The rows are
+// [start, start+count) of keys and offset is the row of the block of the
+// first one.
+func (holder *appendableBlockIndexHolder) BatchInsertOnColumn(colIdx uint16, keys *vector.Vector, start uint32, count int, offset uint32) error {
+	tree, ok := holder.uniqueIndexes[colIdx]
+	if !ok {
+		return nil
+	}
+	for i := 0; i < count; i++ {
+		row := start + uint32(i)
+		if nulls.Contains(keys.Nsp, uint64(row)) {
+			continue
+		}
+		// A value appended again replaces the row of the deleted one
+		key := compute.GetValue(keys, row)
+		err := tree.Insert(key, offset+uint32(i))
+		if err == errors.ErrKeyDuplicate {
+			err = tree.Update(key, offset+uint32(i))
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SearchOnColumn returns the last row appended with key in a unique column.
+func (holder *appendableBlockIndexHolder) SearchOnColumn(colIdx uint16, key interface{}) (uint32, error) {
+	tree, ok := holder.uniqueIndexes[colIdx]
+	if !ok {
+		return 0, errors.ErrKeyNotFound
+	}
+	return tree.Search(key)
+}
+
+// ContainsAnyKeysOnColumn returns false if none of keys was appended to a
+// unique column. A column not indexed by the holder may contain any key.
+func (holder *appendableBlockIndexHolder) ContainsAnyKeysOnColumn(colIdx uint16, keys *vector.Vector) (bool, error) {
+	tree, ok := holder.uniqueIndexes[colIdx]
+	if !ok {
+		return true, nil
+	}
+	return tree.ContainsAnyKeys(keys, nil)
+}
+
+// UniqueColumns returns the unique columns indexed by the holder.
+func (holder *appendableBlockIndexHolder) UniqueColumns() []uint16 {
+	cols := make([]uint16, 0, len(holder.uniqueIndexes))
+	for col := range holder.uniqueIndexes {
+		cols = append(cols, col)
+	}
+	return cols
+}
+
 func (holder *appendableBlockIndexHolder) BatchInsert(keys *vector.Vector, start uint32, count int, offset uint32, verify bool) error {
 	// TODO: consume `count` when needed
 	if err := holder.zoneMapIndex.BatchUpdate(keys, start, -1); err != nil {
@@ -94,6 +159,7 @@ func (holder *appendableBlockIndexHolder) BatchDedup(keys *vector.Vector) error
 func (holder *appendableBlockIndexHolder) Destroy() error {
 	holder.treeIndex = nil
 	holder.zoneMapIndex = nil
+	holder.uniqueIndexes = nil
 	return nil
 }
 
@@ -15,22 +15,53 @@
 package impl
 
 import (
+	"sync"
+
 	"github.com/RoaringBitmap/roaring"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/buffer/base"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	gCommon "github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/data"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/file"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/basic"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/common/errors"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/io"
 )
 
 type nonAppendableBlockIndexHolder struct {
+	// mu protects columnIndexes, the static filters of a secondary index
+	// created after the block was flushed are added by LoadStaticFilters
+	mu                sync.RWMutex
 	host              data.Block
 	zoneMapIndex      *io.BlockZoneMapIndexReader
 	staticFilterIndex *io.StaticFilterIndexReader
 	schema            *catalog.Schema
+	// columnIndexes are the indexes of the columns keyed by column index,
+	// including the primary key. Every column has a zonemap, only the
+	// primary key and the columns covered by secondary indexes have a
+	// static filter.
+	// They only tell that a block can not contain a value, so a lookup on
+	// a secondary index prunes blocks and never maps a value to its rows.
+	columnIndexes map[uint16]*columnIndexReaders
+}
+
+type columnIndexReaders struct {
+	zoneMapIndex      *io.BlockZoneMapIndexReader
+	staticFilterIndex *io.StaticFilterIndexReader
+}
+
+func (readers *columnIndexReaders) Destroy() (err error) {
+	if readers.zoneMapIndex != nil {
+		if err = readers.zoneMapIndex.Destroy(); err != nil {
+			return
+		}
+	}
+	if readers.staticFilterIndex != nil {
+		err = readers.staticFilterIndex.Destroy()
+	}
+	return
 }
 
 func (holder *nonAppendableBlockIndexHolder) MayContainsKey(key interface{}) bool {
@@ -76,31 +107,157 @@ func (holder *nonAppendableBlockIndexHolder) MayContainsAnyKeys(keys *vector.Vec
 	return errors.ErrKeyDuplicate, pos
 }
 
+// MayContainsKeyOnColumn returns false if the indexes of the column prove
+// that no row of the block equals key. It returns true if the column has no
+// index in this block.
+func (holder *nonAppendableBlockIndexHolder) MayContainsKeyOnColumn(colIdx uint16, key interface{}) bool {
+	readers, ok := holder.getColumnReaders(colIdx)
+	if !ok {
+		return true
+	}
+	// an index that can not be read never excludes the block
+	if readers.zoneMapIndex != nil {
+		if exist, err := readers.zoneMapIndex.MayContainsKey(key); err == nil && !exist {
+			return false
+		}
+	}
+	if readers.staticFilterIndex != nil {
+		if exist, err := readers.staticFilterIndex.MayContainsKey(key); err == nil && !exist {
+			return false
+		}
+	}
+	return true
+}
+
+// MayContainsRangeOnColumn returns false if the zonemap of the column proves
+// that no row of the block is in [min, max], a nil bound means the range is
+// unbounded on that side. It returns true if the column has no zonemap in
+// this block.
+func (holder *nonAppendableBlockIndexHolder) MayContainsRangeOnColumn(colIdx uint16, min, max interface{}) bool {
+	readers, ok := holder.getColumnReaders(colIdx)
+	if !ok || readers.zoneMapIndex == nil {
+		return true
+	}
+	if exist, err := readers.zoneMapIndex.MayContainsRange(min, max); err == nil && !exist {
+		return false
+	}
+	return true
+}
+
+// MayContainsNullOnColumn returns false if the zonemap of the column proves
+// that the block has no null in it.
+func (holder *nonAppendableBlockIndexHolder) MayContainsNullOnColumn(colIdx uint16) bool {
+	readers, ok := holder.getColumnReaders(colIdx)
+	if !ok || readers.zoneMapIndex == nil {
+		return true
+	}
+	return readers.zoneMapIndex.MayContainsNull()
+}
+
+// GetColumnZoneMap returns the zonemap of the column, or nil if the column
+// has no zonemap in this block.
+func (holder *nonAppendableBlockIndexHolder) GetColumnZoneMap(colIdx uint16) *basic.ZoneMap {
+	readers, ok := holder.getColumnReaders(colIdx)
+	if !ok || readers.zoneMapIndex == nil {
+		return nil
+	}
+	return readers.zoneMapIndex.GetZoneMap()
+}
+
 func NewEmptyNonAppendableBlockIndexHolder() *nonAppendableBlockIndexHolder {
-	return &nonAppendableBlockIndexHolder{}
+	return &nonAppendableBlockIndexHolder{
+		columnIndexes: make(map[uint16]*columnIndexReaders),
+	}
+}
+
+func (holder *nonAppendableBlockIndexHolder) getColumnReaders(colIdx uint16) (readers *columnIndexReaders, ok bool) {
+	holder.mu.RLock()
+	defer holder.mu.RUnlock()
+	readers, ok = holder.columnIndexes[colIdx]
+	return
+}
+
+// HasStaticFilterOnColumn returns true if the static filter of the column is
+// loaded.
+func (holder *nonAppendableBlockIndexHolder) HasStaticFilterOnColumn(colIdx uint16) bool {
+	readers, ok := holder.getColumnReaders(colIdx)
+	return ok && readers.staticFilterIndex != nil
 }
 
 func (holder *nonAppendableBlockIndexHolder) InitFromHost(host data.Block, schema *catalog.Schema, bufManager base.INodeManager) error {
+	holder.mu.Lock()
+	defer holder.mu.Unlock()
 	holder.host = host
 	holder.schema = schema
-	pkIdx := schema.PrimaryKey
-	blkFile := host.GetBlockFile()
-	idxMetas, err := blkFile.LoadIndexMeta()
+	idxMetas, err := host.GetBlockFile().LoadIndexMeta()
 	if err != nil {
 		return err
 	}
+	return holder.loadIndexesLocked(idxMetas.Metas, bufManager)
+}
 
-	colFile, err := blkFile.OpenColumn(int(pkIdx))
+// LoadColumnIndexes loads the indexes of cols written to the block file
+// after InitFromHost, it is used when a secondary index is backfilled on a
+// flushed block. The indexes already loaded are skipped. A holder of a
+// replayed block is not initialized, host and schema are set if so.
+func (holder *nonAppendableBlockIndexHolder) LoadColumnIndexes(host data.Block, schema *catalog.Schema, cols []uint16, bufManager base.INodeManager) error {
+	holder.mu.Lock()
+	defer holder.mu.Unlock()
+	if holder.host == nil {
+		holder.host = host
+		holder.schema = schema
+	}
+	idxMetas, err := holder.host.GetBlockFile().LoadIndexMeta()
 	if err != nil {
 		return err
 	}
+	wanted := make(map[uint16]bool, len(cols))
+	for _, col := range cols {
+		wanted[col] = true
+	}
+	metas := make([]common.IndexMeta, 0, len(cols))
 	for _, meta := range idxMetas.Metas {
+		if !wanted[meta.ColIdx] {
+			continue
+		}
+		if readers, ok := holder.columnIndexes[meta.ColIdx]; ok {
+			if meta.IdxType == common.BlockZoneMapIndex && readers.zoneMapIndex != nil {
+				continue
+			}
+			if meta.IdxType == common.StaticFilterIndex && readers.staticFilterIndex != nil {
+				continue
+			}
+		}
+		metas = append(metas, meta)
+	}
+	return holder.loadIndexesLocked(metas, bufManager)
+}
+
+func (holder *nonAppendableBlockIndexHolder) loadIndexesLocked(metas []common.IndexMeta, bufManager base.INodeManager) error {
+	var err error
+	host := holder.host
+	pkIdx := holder.schema.PrimaryKey
+	blkFile := host.GetBlockFile()
+	colFiles := make(map[uint16]file.ColumnBlock)
+	for _, meta := range metas {
+		colFile, ok := colFiles[meta.ColIdx]
+		if !ok {
+			if colFile, err = blkFile.OpenColumn(int(meta.ColIdx)); err != nil {
+				return err
+			}
+			colFiles[meta.ColIdx] = colFile
+		}
 		internal := meta.InternalIdx
 		colFile.GetDataFileStat()
 		idxFile, err := colFile.OpenIndexFile(int(internal))
 		if err != nil {
 			return err
 		}
+		readers, ok := holder.columnIndexes[meta.ColIdx]
+		if !ok {
+			readers = &columnIndexReaders{}
+			holder.columnIndexes[meta.ColIdx] = readers
+		}
 		switch meta.IdxType {
 		case common.BlockZoneMapIndex:
 			size := idxFile.Stat().Size()
@@ -120,7 +277,10 @@ func (holder *nonAppendableBlockIndexHolder) InitFromHost(host data.Block, schem
 			if err != nil {
 				return err
 			}
-			holder.zoneMapIndex = reader
+			readers.zoneMapIndex = reader
+			if meta.ColIdx == uint16(pkIdx) {
+				holder.zoneMapIndex = reader
+			}
 		case common.StaticFilterIndex:
 			size := idxFile.Stat().Size()
 			buf := make([]byte, size)
@@ -139,7 +299,10 @@ func (holder *nonAppendableBlockIndexHolder) InitFromHost(host data.Block, schem
 			if err != nil {
 				return err
 			}
-			holder.staticFilterIndex = reader
+			readers.staticFilterIndex = reader
+			if meta.ColIdx == uint16(pkIdx) {
+				holder.staticFilterIndex = reader
+			}
 		default:
 			panic("unsupported index type for block")
 		}
@@ -148,11 +311,11 @@ func (holder *nonAppendableBlockIndexHolder) InitFromHost(host data.Block, schem
 }
 
 func (holder *nonAppendableBlockIndexHolder) Destroy() (err error) {
-	if err = holder.zoneMapIndex.Destroy(); err != nil {
-		return err
-	}
-	if err = holder.staticFilterIndex.Destroy(); err != nil {
-		return err
+	// the indexes of the primary key are in columnIndexes too
+	for _, readers := range holder.columnIndexes {
+		if err = readers.Destroy(); err != nil {
+			return err
+		}
 	}
 	return nil
 }
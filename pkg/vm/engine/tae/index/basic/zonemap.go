@@ -20,6 +20,7 @@ import (
 	"sync"
 
 	"github.com/RoaringBitmap/roaring"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/encoding"
@@ -27,12 +28,33 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/common/errors"
 )
 
+const (
+	zoneMapInitialized = 1 << iota
+	// the nulls are tracked if the zonemap is built from whole vectors
+	zoneMapNullTracked
+	zoneMapHasNull
+)
+
 type ZoneMap struct {
 	mu          *sync.RWMutex
 	typ         types.Type
 	min         interface{}
 	max         interface{}
 	initialized bool
+	nullTracked bool
+	hasNull     bool
+}
+
+// ZoneMapSupported returns true if a zonemap can be built on a column of typ.
+func ZoneMapSupported(typ types.Type) bool {
+	switch typ.Oid {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_float32, types.T_float64, types.T_date, types.T_datetime,
+		types.T_char, types.T_varchar:
+		return true
+	}
+	return false
 }
 
 func NewZoneMap(typ types.Type, mutex *sync.RWMutex) *ZoneMap {
@@ -83,18 +105,64 @@ func (zm *ZoneMap) UpdateLocked(v interface{}) error {
 	return nil
 }
 
+// BatchUpdate updates the zonemap with the values of vec from offset, the
+// nulls are not values of the zonemap but are recorded.
 func (zm *ZoneMap) BatchUpdate(vec *vector.Vector, offset uint32, length int) error {
 	if !zm.typ.Eq(vec.Typ) {
 		return errors.ErrTypeMismatch
 	}
 	zm.mu.Lock()
 	defer zm.mu.Unlock()
-	if err := common.ProcessVector(vec, offset, length, zm.UpdateLocked, nil); err != nil {
+	zm.nullTracked = true
+	var visibility *roaring.Bitmap
+	if nulls.Any(vec.Nsp) {
+		visibility = roaring.NewBitmap()
+		for i := uint64(offset); i < uint64(vector.Length(vec)); i++ {
+			if nulls.Contains(vec.Nsp, i) {
+				zm.hasNull = true
+			} else {
+				visibility.Add(uint32(i) - offset)
+			}
+		}
+	}
+	if err := common.ProcessVector(vec, offset, length, zm.UpdateLocked, visibility); err != nil {
 		return err
 	}
 	return nil
 }
 
+// MayContainsNull returns false if the zonemap is built from values without
+// nulls.
+func (zm *ZoneMap) MayContainsNull() bool {
+	zm.mu.RLock()
+	defer zm.mu.RUnlock()
+	return !zm.nullTracked || zm.hasNull
+}
+
+// MergeZoneMaps returns the zonemap of the values and the nulls of all the
+// zonemaps, which have the same type.
+func MergeZoneMaps(zms ...*ZoneMap) (*ZoneMap, error) {
+	if len(zms) == 0 {
+		return nil, errors.ErrTypeMismatch
+	}
+	merged := NewZoneMap(zms[0].typ, nil)
+	merged.nullTracked = true
+	for _, zm := range zms {
+		if !merged.typ.Eq(zm.typ) {
+			return nil, errors.ErrTypeMismatch
+		}
+		zm.mu.RLock()
+		if zm.initialized {
+			_ = merged.UpdateLocked(zm.min)
+			_ = merged.UpdateLocked(zm.max)
+		}
+		merged.nullTracked = merged.nullTracked && zm.nullTracked
+		merged.hasNull = merged.hasNull || zm.hasNull
+		zm.mu.RUnlock()
+	}
+	return merged, nil
+}
+
 func (zm *ZoneMap) Query(key interface{}) (int, error) {
 	// TODO: mismatch error
 	zm.mu.RLock()
@@ -130,6 +198,26 @@ func (zm *ZoneMap) MayContainsKey(key interface{}) (bool, error) {
 	return true, nil
 }
 
+// MayContainsRange returns false if no key of the zonemap is in [min, max],
+// a nil bound means the range is unbounded on that side.
+func (zm *ZoneMap) MayContainsRange(min, max interface{}) (bool, error) {
+	zm.mu.RLock()
+	defer zm.mu.RUnlock()
+	if !zm.initialized {
+		return false, nil
+	}
+	if min != nil && max != nil && common.CompareGeneric(min, max, zm.typ) > 0 {
+		return false, nil
+	}
+	if min != nil && common.CompareGeneric(min, zm.GetMaxLocked(), zm.typ) > 0 {
+		return false, nil
+	}
+	if max != nil && common.CompareGeneric(max, zm.GetMinLocked(), zm.typ) < 0 {
+		return false, nil
+	}
+	return true, nil
+}
+
 func (zm *ZoneMap) MayContainsAnyKeys(keys *vector.Vector) (bool, *roaring.Bitmap, error) {
 	// TODO: mismatch error
 	zm.mu.RLock()
@@ -223,11 +311,18 @@ func (zm *ZoneMap) Print() string {
 func (zm *ZoneMap) Marshal() ([]byte, error) {
 	var buf bytes.Buffer
 	buf.Write(encoding.EncodeType(zm.typ))
+	flags := int8(0)
+	if zm.nullTracked {
+		flags |= zoneMapNullTracked
+	}
+	if zm.hasNull {
+		flags |= zoneMapHasNull
+	}
 	if !zm.initialized {
-		buf.Write(encoding.EncodeInt8(0))
+		buf.Write(encoding.EncodeInt8(flags))
 		return buf.Bytes(), nil
 	}
-	buf.Write(encoding.EncodeInt8(1))
+	buf.Write(encoding.EncodeInt8(flags | zoneMapInitialized))
 	switch zm.typ.Oid {
 	case types.T_int8:
 		buf.Write(encoding.EncodeInt8(zm.min.(int8)))
@@ -292,10 +387,12 @@ func (zm *ZoneMap) Marshal() ([]byte, error) {
 func (zm *ZoneMap) Unmarshal(buf []byte) error {
 	zm.typ = encoding.DecodeType(buf[:encoding.TypeSize])
 	buf = buf[encoding.TypeSize:]
-	init := encoding.DecodeInt8(buf[:1])
+	flags := encoding.DecodeInt8(buf[:1])
 	buf = buf[1:]
 	zm.mu = new(sync.RWMutex)
-	if init == 0 {
+	zm.nullTracked = flags&zoneMapNullTracked != 0
+	zm.hasNull = flags&zoneMapHasNull != 0
+	if flags&zoneMapInitialized == 0 {
 		zm.initialized = false
 		return nil
 	}
@@ -19,7 +19,9 @@ import (
 	"testing"
 
 	"github.com/RoaringBitmap/roaring"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/common"
 	"github.com/stretchr/testify/require"
 )
@@ -194,3 +196,88 @@ func TestZoneMapString(t *testing.T) {
 	require.NoError(t, err)
 	require.False(t, res)
 }
+
+func TestZoneMapRange(t *testing.T) {
+	typ := types.Type{Oid: types.T_int32}
+	zm := NewZoneMap(typ, nil)
+	res, err := zm.MayContainsRange(nil, nil)
+	require.NoError(t, err)
+	require.False(t, res)
+
+	vec := common.MockVec(typ, 1000, 100)
+	err = zm.BatchUpdate(vec, 0, -1)
+	require.NoError(t, err)
+
+	res, err = zm.MayContainsRange(nil, nil)
+	require.NoError(t, err)
+	require.True(t, res)
+
+	res, err = zm.MayContainsRange(int32(0), int32(99))
+	require.NoError(t, err)
+	require.False(t, res)
+
+	res, err = zm.MayContainsRange(int32(0), int32(100))
+	require.NoError(t, err)
+	require.True(t, res)
+
+	res, err = zm.MayContainsRange(nil, int32(99))
+	require.NoError(t, err)
+	require.False(t, res)
+
+	res, err = zm.MayContainsRange(int32(1099), nil)
+	require.NoError(t, err)
+	require.True(t, res)
+
+	res, err = zm.MayContainsRange(int32(1100), nil)
+	require.NoError(t, err)
+	require.False(t, res)
+
+	res, err = zm.MayContainsRange(int32(500), int32(400))
+	require.NoError(t, err)
+	require.False(t, res)
+}
+
+func TestZoneMapNulls(t *testing.T) {
+	typ := types.Type{Oid: types.T_int32}
+	zm := NewZoneMap(typ, nil)
+	require.True(t, zm.MayContainsNull())
+
+	vec := common.MockVec(typ, 100, 0)
+	require.NoError(t, zm.BatchUpdate(vec, 0, -1))
+	require.False(t, zm.MayContainsNull())
+
+	buf, err := zm.Marshal()
+	require.NoError(t, err)
+	zm1, err := NewZoneMapFromSource(buf)
+	require.NoError(t, err)
+	require.False(t, zm1.MayContainsNull())
+
+	// The value of a null is not in the zonemap
+	withNull := common.MockVec(typ, 100, 100)
+	nulls.Add(withNull.Nsp, 0)
+	zm2 := NewZoneMap(typ, nil)
+	require.NoError(t, zm2.BatchUpdate(withNull, 0, -1))
+	require.True(t, zm2.MayContainsNull())
+	res, err := zm2.MayContainsKey(int32(100))
+	require.NoError(t, err)
+	require.False(t, res)
+	res, err = zm2.MayContainsKey(int32(101))
+	require.NoError(t, err)
+	require.True(t, res)
+
+	merged, err := MergeZoneMaps(zm1, zm2)
+	require.NoError(t, err)
+	require.True(t, merged.MayContainsNull())
+	res, err = merged.MayContainsRange(int32(50), int32(150))
+	require.NoError(t, err)
+	require.True(t, res)
+	res, err = merged.MayContainsKey(int32(200))
+	require.NoError(t, err)
+	require.False(t, res)
+
+	// Legacy zonemaps do not track the nulls
+	buf[encoding.TypeSize] = 1
+	zm3, err := NewZoneMapFromSource(buf)
+	require.NoError(t, err)
+	require.True(t, zm3.MayContainsNull())
+}
@@ -155,6 +155,11 @@ func (metas *IndicesMeta) Marshal() ([]byte, error) {
 }
 
 func (metas *IndicesMeta) Unmarshal(buf []byte) error {
+	// a block flushed without any index has an empty meta
+	if len(buf) == 0 {
+		metas.Metas = make([]IndexMeta, 0)
+		return nil
+	}
 	count := encoding.DecodeUint8(buf[:1])
 	buf = buf[1:]
 	metas.Metas = make([]IndexMeta, 0)
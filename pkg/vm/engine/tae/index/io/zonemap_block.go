@@ -122,6 +122,26 @@ func (reader *BlockZoneMapIndexReader) MayContainsKey(key interface{}) (bool, er
 	return handle.GetNode().(*blockZoneMapIndexNode).inner.MayContainsKey(key)
 }
 
+func (reader *BlockZoneMapIndexReader) MayContainsRange(min, max interface{}) (bool, error) {
+	handle := reader.inode.mgr.Pin(reader.inode)
+	defer handle.Close()
+	return handle.GetNode().(*blockZoneMapIndexNode).inner.MayContainsRange(min, max)
+}
+
+// MayContainsNull returns false if the block has no null in the column.
+func (reader *BlockZoneMapIndexReader) MayContainsNull() bool {
+	handle := reader.inode.mgr.Pin(reader.inode)
+	defer handle.Close()
+	return handle.GetNode().(*blockZoneMapIndexNode).inner.MayContainsNull()
+}
+
+// GetZoneMap returns the loaded zonemap, which is not changed afterwards.
+func (reader *BlockZoneMapIndexReader) GetZoneMap() *basic.ZoneMap {
+	handle := reader.inode.mgr.Pin(reader.inode)
+	defer handle.Close()
+	return handle.GetNode().(*blockZoneMapIndexNode).inner
+}
+
 type BlockZoneMapIndexWriter struct {
 	cType       common.CompressType
 	host        gCommon.IRWFile
@@ -268,9 +268,12 @@ func (b *BitmapAllocator) Allocate(len uint64) (uint64, uint64) {
 						break
 					}
 					if (*val & (1 << nextPos)) == 0 {
+						// the run is broken, restart it from the next free page
 						l0freePos = b.getBitPos(*val, nextPos+1)
 						nextPos = l0freePos + 1
 						allocatedPage = 0
+						startIdx = idx
+						startPos = l0freePos
 					} else {
 						nextPos++
 						allocatedPage++
@@ -0,0 +1,155 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segment
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"sort"
+)
+
+// Open opens the segment file name read only and replays its inodes, to
+// inspect a segment file which is not in use. It cannot be written.
+func Open(name string) (s *Segment, err error) {
+	segFile, err := os.Open(name)
+	if err != nil {
+		return
+	}
+	s = &Segment{
+		name:    name,
+		segFile: segFile,
+	}
+	if err = s.Replay(bytes.NewBuffer(make([]byte, LOG_SIZE))); err != nil {
+		segFile.Close()
+		return nil, fmt.Errorf("%w: %s: %v", ErrCorrupted, name, err)
+	}
+	if err = s.readSuper(); err != nil {
+		segFile.Close()
+		return nil, fmt.Errorf("%w: %s: %v", ErrCorrupted, name, err)
+	}
+	return
+}
+
+func (s *Segment) readSuper() (err error) {
+	var algo uint8
+	r := bytes.NewBuffer(make([]byte, 8+1+4+4))
+	if _, err = s.segFile.ReadAt(r.Bytes(), 0); err != nil {
+		return
+	}
+	if err = binary.Read(r, binary.BigEndian, &s.super.version); err != nil {
+		return
+	}
+	if err = binary.Read(r, binary.BigEndian, &algo); err != nil {
+		return
+	}
+	if err = binary.Read(r, binary.BigEndian, &s.super.blockSize); err != nil {
+		return
+	}
+	err = binary.Read(r, binary.BigEndian, &s.super.colCnt)
+	return
+}
+
+// Close closes a segment file opened by Open
+func (s *Segment) Close() error {
+	return s.segFile.Close()
+}
+
+// Files returns the block files of the segment by name, the log file
+// excluded
+func (s *Segment) Files() []*BlockFile {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	files := make([]*BlockFile, 0, len(s.nodes))
+	for name, file := range s.nodes {
+		if name == "logfile" {
+			continue
+		}
+		files = append(files, file)
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].name < files[j].name
+	})
+	return files
+}
+
+// Verify checks the superblock, that the extents of the block files lie
+// in the data area of the file without overlapping and that their data
+// match the checksums. It returns an error wrapping ErrCorrupted, or a
+// common.CorruptedError of a checksum mismatch, for each problem found.
+func (s *Segment) Verify() (errs []error) {
+	if s.super.version != 1 || s.super.blockSize != BLOCK_SIZE {
+		errs = append(errs, fmt.Errorf("%w: %s: bad superblock version %d block size %d",
+			ErrCorrupted, s.name, s.super.version, s.super.blockSize))
+		return
+	}
+	stat, err := s.segFile.Stat()
+	if err != nil {
+		return []error{err}
+	}
+	size := stat.Size()
+	if size < DATA_START {
+		errs = append(errs, fmt.Errorf("%w: %s: size %d is smaller than the header", ErrCorrupted, s.name, size))
+		return
+	}
+	type owned struct {
+		Extent
+		file string
+	}
+	var extents []owned
+	for _, file := range s.Files() {
+		dataSize := uint64(0)
+		appendOnly := true
+		for i, ext := range file.snode.extents {
+			if ext.typ != APPEND {
+				appendOnly = false
+			}
+			if ext.offset%s.super.blockSize != 0 || ext.data.length > ext.length {
+				errs = append(errs, fmt.Errorf("%w: %s: %s: bad extent %d [%d,+%d]",
+					ErrCorrupted, s.name, file.name, i, ext.offset, ext.length))
+				continue
+			}
+			if int64(ext.offset)+int64(ext.data.length) > size {
+				errs = append(errs, fmt.Errorf("%w: %s: %s: extent %d ends at %d beyond the end of the file",
+					ErrCorrupted, s.name, file.name, i, int64(ext.offset)+int64(ext.data.length)))
+			} else {
+				data := make([]byte, ext.data.length)
+				if _, err = s.readAt(data, int64(ext.offset+ext.data.offset)); err != nil {
+					return append(errs, err)
+				}
+				if err = file.verifyExtent(&ext, data); err != nil {
+					errs = append(errs, err)
+				}
+			}
+			dataSize += uint64(ext.data.length)
+			extents = append(extents, owned{Extent: ext, file: file.name})
+		}
+		if appendOnly && dataSize != file.snode.size {
+			errs = append(errs, fmt.Errorf("%w: %s: %s: size %d but %d bytes in extents",
+				ErrCorrupted, s.name, file.name, file.snode.size, dataSize))
+		}
+	}
+	sort.Slice(extents, func(i, j int) bool {
+		return extents[i].offset < extents[j].offset
+	})
+	for i := 1; i < len(extents); i++ {
+		if extents[i].offset < extents[i-1].End() {
+			errs = append(errs, fmt.Errorf("%w: %s: extents of %s and %s overlap at %d",
+				ErrCorrupted, s.name, extents[i-1].file, extents[i].file, extents[i].offset))
+		}
+	}
+	return
+}
@@ -14,6 +14,8 @@
 
 package segment
 
+import "github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+
 type ExtentType uint8
 
 const (
@@ -31,6 +33,10 @@ type Extent struct {
 	offset uint32
 	length uint32
 	data   entry
+	// checksums are the CRC32C of the pages of the data of the extent, so
+	// the part of the extent left by an update keeps the checksums of its
+	// pages. They are empty for the extents written without checksums.
+	checksums []uint32
 }
 
 func (ex *Extent) End() uint32 {
@@ -45,6 +51,55 @@ func (ex *Extent) Length() uint32 {
 	return ex.length
 }
 
+func (ex *Extent) Checksums() []uint32 {
+	return ex.checksums
+}
+
+// slice returns the part [start, start+length) of the extent. The part only
+// keeps the checksums if it is cut at the page boundaries, otherwise its
+// pages can not be checked without reading them
+func (ex *Extent) slice(start, length, pageSize uint32) Extent {
+	part := Extent{
+		typ:    ex.typ,
+		offset: ex.offset + start,
+		length: length,
+	}
+	if ex.data.length > start {
+		part.data.length = ex.data.length - start
+		if part.data.length > length {
+			part.data.length = length
+		}
+	}
+	aligned := start%pageSize == 0 &&
+		(part.data.length%pageSize == 0 || start+part.data.length == ex.data.length)
+	if len(ex.checksums) > 0 && aligned {
+		from := start / pageSize
+		to := (start + part.data.length + pageSize - 1) / pageSize
+		if to > uint32(len(ex.checksums)) {
+			to = uint32(len(ex.checksums))
+		}
+		if from < to {
+			part.checksums = ex.checksums[from:to]
+		}
+	}
+	return part
+}
+
+// pageChecksums returns the CRC32C of each page of data, the last one may be
+// short
+func pageChecksums(data []byte, pageSize uint32) []uint32 {
+	checksums := make([]uint32, 0, (len(data)+int(pageSize)-1)/int(pageSize))
+	for len(data) > 0 {
+		n := int(pageSize)
+		if n > len(data) {
+			n = len(data)
+		}
+		checksums = append(checksums, common.Checksum(data[:n]))
+		data = data[n:]
+	}
+	return checksums
+}
+
 func (ex *Extent) GetData() *entry {
 	return &ex.data
 }
@@ -17,7 +17,9 @@ package segment
 import (
 	"bytes"
 	"encoding/binary"
+	"fmt"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"io"
 )
 
@@ -53,6 +55,13 @@ func (b *BlockFile) GetAlgo() uint8 {
 	return b.snode.algo
 }
 
+// SetAlgo sets the compress algorithm of the data appended later
+func (b *BlockFile) SetAlgo(algo uint8) {
+	b.snode.mutex.Lock()
+	defer b.snode.mutex.Unlock()
+	b.snode.algo = algo
+}
+
 func (b *BlockFile) GetName() string {
 	return b.name
 }
@@ -65,10 +74,11 @@ func (b *BlockFile) Append(offset uint64, data []byte, originSize uint32) (err e
 	}
 	b.snode.mutex.Lock()
 	b.snode.extents = append(b.snode.extents, Extent{
-		typ:    APPEND,
-		offset: uint32(offset),
-		length: cbufLen,
-		data:   entry{offset: 0, length: uint32(len(data))},
+		typ:       APPEND,
+		offset:    uint32(offset),
+		length:    cbufLen,
+		data:      entry{offset: 0, length: uint32(len(data))},
+		checksums: pageChecksums(data, b.segment.super.blockSize),
 	})
 	b.snode.size += uint64(len(data))
 	b.snode.originSize += uint64(originSize)
@@ -94,7 +104,11 @@ func extentsRemove(extents *[]Extent, vals []Extent) {
 	}
 }
 
-func (b *BlockFile) repairExtent(offset, fOffset, length uint32) []Extent {
+// repairExtent replaces the extents from the file offset fOffset with the
+// extent updated. The extents cut keep the checksums of their pages left.
+func (b *BlockFile) repairExtent(updated Extent, fOffset uint32) []Extent {
+	length := updated.length
+	pageSize := b.segment.super.blockSize
 	num := 0
 	b.snode.mutex.Lock()
 	defer b.snode.mutex.Unlock()
@@ -116,24 +130,17 @@ func (b *BlockFile) repairExtent(offset, fOffset, length uint32) []Extent {
 	}
 	oldOff := b.snode.extents[num].offset
 	if fOffset == 0 && ext.length-fOffset-length == 0 {
-		b.snode.extents[num].typ = UPDATE
-		b.snode.extents[num].offset = offset
+		b.snode.extents[num] = updated
 		free = append(free, Extent{
 			offset: oldOff,
 			length: length,
 		})
 		return free
 	}
-	vals := make([]Extent, 1)
-	vals[0].offset = offset
-	vals[0].length = length
-	vals[0].typ = UPDATE
+	vals := []Extent{updated}
 	if remaining > 0 {
-		b.snode.extents[num].length = fOffset
-		vals = append(vals, Extent{
-			offset: ext.End() - remaining,
-			length: remaining,
-		})
+		b.snode.extents[num] = ext.slice(0, fOffset, pageSize)
+		vals = append(vals, ext.slice(ext.length-remaining, remaining, pageSize))
 	}
 	freeLength := length
 	idx := num
@@ -143,7 +150,7 @@ func (b *BlockFile) repairExtent(offset, fOffset, length uint32) []Extent {
 		}
 		e := &b.snode.extents[idx]
 		if idx == num {
-			b.snode.extents[num].length = fOffset
+			b.snode.extents[num] = ext.slice(0, fOffset, pageSize)
 			xLen := ext.length - fOffset
 			if xLen > freeLength {
 				xLen = freeLength
@@ -166,8 +173,7 @@ func (b *BlockFile) repairExtent(offset, fOffset, length uint32) []Extent {
 				offset: e.offset,
 				length: xLen,
 			})
-			e.offset += xLen
-			e.length -= xLen
+			*e = e.slice(xLen, e.length-xLen, pageSize)
 		} else {
 			free = append(free, Extent{
 				offset: e.offset,
@@ -209,7 +215,14 @@ func (b *BlockFile) Update(offset uint64, data []byte, fOffset uint32) ([]Extent
 		return nil, err
 	}
 	logutil.Infof("extents is %d", len(b.snode.extents))
-	return b.repairExtent(uint32(offset), fOffset, cbufLen), nil
+	updated := Extent{
+		typ:       UPDATE,
+		offset:    uint32(offset),
+		length:    cbufLen,
+		data:      entry{offset: 0, length: uint32(len(data))},
+		checksums: pageChecksums(data, b.segment.super.blockSize),
+	}
+	return b.repairExtent(updated, fOffset), nil
 }
 
 func (b *BlockFile) GetExtents() *[]Extent {
@@ -237,6 +250,9 @@ func (b *BlockFile) Read(data []byte) (n int, err error) {
 		if err != nil && dataLen != ext.GetData().GetLength() {
 			return int(dataLen), err
 		}
+		if err = b.verifyExtent(&ext, buf); err != nil {
+			return n, err
+		}
 		n += int(dataLen)
 		boff += ext.GetData().GetLength()
 		roff += ext.Length()
@@ -244,6 +260,29 @@ func (b *BlockFile) Read(data []byte) (n int, err error) {
 	return n, nil
 }
 
+// verifyExtent checks the data read of ext against the checksums of its
+// pages. The extents written without checksums are not checked.
+func (b *BlockFile) verifyExtent(ext *Extent, data []byte) error {
+	if len(ext.checksums) == 0 {
+		return nil
+	}
+	pageSize := b.segment.super.blockSize
+	checksums := pageChecksums(data, pageSize)
+	if len(checksums) != len(ext.checksums) {
+		return common.NewCorruptedError(b.segment.name, int64(ext.offset),
+			fmt.Errorf("%w: extent of %s has %d page checksums for %d pages",
+				common.ErrChecksumMismatch, b.name, len(ext.checksums), len(checksums)))
+	}
+	for i, checksum := range checksums {
+		if checksum != ext.checksums[i] {
+			return common.NewCorruptedError(b.segment.name, int64(ext.offset)+int64(i)*int64(pageSize),
+				fmt.Errorf("%w: page %d of extent of %s checksum %x, computed %x",
+					common.ErrChecksumMismatch, i, b.name, ext.checksums[i], checksum))
+		}
+	}
+	return nil
+}
+
 func (b *BlockFile) ReadExtent(offset, length uint32, data []byte) (uint32, error) {
 	remain := uint32(b.snode.size) - offset - length
 	num := 0
@@ -268,7 +307,7 @@ func (b *BlockFile) ReadExtent(offset, length uint32, data []byte) (uint32, erro
 			readOne = b.snode.extents[num].length
 		}
 		buf = buf[read : read+readOne]
-		_, err := b.segment.segFile.ReadAt(buf, int64(b.snode.extents[num].offset)+int64(offset))
+		_, err := b.segment.readAt(buf, int64(b.snode.extents[num].offset)+int64(offset))
 		if err != nil && err != io.EOF {
 			return 0, err
 		}
@@ -35,7 +35,7 @@ func (l *Log) readInode(cache *bytes.Buffer, file *BlockFile) (n int, err error)
 	if err = binary.Read(cache, binary.BigEndian, &file.snode.magic); err != nil {
 		return
 	}
-	if file.snode.magic != MAGIC {
+	if file.snode.magic != MAGIC && file.snode.magic != CHECKSUM_MAGIC {
 		return 0, nil
 	}
 	n += int(unsafe.Sizeof(file.snode.magic))
@@ -47,6 +47,9 @@ func (l *Log) readInode(cache *bytes.Buffer, file *BlockFile) (n int, err error)
 		return
 	}
 	n += int(unsafe.Sizeof(nameLen))
+	if int(nameLen) > cache.Len() {
+		return 0, ErrCorrupted
+	}
 	name := make([]byte, nameLen)
 	if err = binary.Read(cache, binary.BigEndian, name); err != nil {
 		return
@@ -73,6 +76,9 @@ func (l *Log) readInode(cache *bytes.Buffer, file *BlockFile) (n int, err error)
 		return
 	}
 	n += int(unsafe.Sizeof(extentLen))
+	if extentLen > uint64(cache.Len()) {
+		return 0, ErrCorrupted
+	}
 	file.snode.extents = make([]Extent, extentLen)
 	for i := 0; i < int(extentLen); i++ {
 		if err = binary.Read(cache, binary.BigEndian, &file.snode.extents[i].typ); err != nil {
@@ -95,12 +101,31 @@ func (l *Log) readInode(cache *bytes.Buffer, file *BlockFile) (n int, err error)
 			return
 		}
 		n += int(unsafe.Sizeof(file.snode.extents[i].data.length))
+		if file.snode.magic != CHECKSUM_MAGIC {
+			continue
+		}
+		var checksumLen uint32
+		if err = binary.Read(cache, binary.BigEndian, &checksumLen); err != nil {
+			return
+		}
+		n += int(unsafe.Sizeof(checksumLen))
+		if uint64(checksumLen)*4 > uint64(cache.Len()) {
+			return 0, ErrCorrupted
+		}
+		if checksumLen == 0 {
+			continue
+		}
+		file.snode.extents[i].checksums = make([]uint32, checksumLen)
+		if err = binary.Read(cache, binary.BigEndian, file.snode.extents[i].checksums); err != nil {
+			return
+		}
+		n += int(checksumLen) * 4
 	}
 	return
 }
 
 func (l *Log) Replay(cache *bytes.Buffer) error {
-	n, err := l.logFile.segment.segFile.ReadAt(cache.Bytes(), LOG_START)
+	n, err := l.logFile.segment.readAt(cache.Bytes(), LOG_START)
 	if err != nil {
 		return err
 	}
@@ -113,6 +138,8 @@ func (l *Log) Replay(cache *bytes.Buffer) error {
 	l.logFile.name = "logfile"
 	l.logFile.segment.nodes[l.logFile.name] = l.logFile
 	magicLen := uint32(unsafe.Sizeof(l.logFile.snode.magic))
+	// the inodes removed, whose older records may still be in the log
+	removed := make(map[uint64]bool)
 	for {
 		file := &BlockFile{
 			snode:   &Inode{},
@@ -135,9 +162,20 @@ func (l *Log) Replay(cache *bytes.Buffer) error {
 		}
 		cache = bytes.NewBuffer(cache.Bytes()[seekLen:])
 		block := l.logFile.segment.nodes[file.name]
-		if (block == nil || block.snode.seq < file.snode.seq) &&
-			file.snode.state == RESIDENT {
+		if file.snode.state == REMOVE {
+			removed[file.snode.inode] = true
+			if block != nil && block.snode.inode == file.snode.inode {
+				l.logFile.segment.Free(block)
+				delete(l.logFile.segment.nodes, file.name)
+			}
+		} else if (block == nil || block.snode.seq < file.snode.seq) &&
+			!removed[file.snode.inode] {
 			extents := file.GetExtents()
+			for _, extent := range *extents {
+				if extent.offset < DATA_START || extent.End() < extent.offset {
+					return ErrCorrupted
+				}
+			}
 			for _, extent := range *extents {
 				l.logFile.segment.allocator.CheckAllocations(
 					extent.offset-DATA_START, extent.length)
@@ -169,7 +207,7 @@ func (l *Log) Append(file *BlockFile) error {
 		ibuffer bytes.Buffer
 	)
 	segment := l.logFile.segment
-	if err = binary.Write(&ibuffer, binary.BigEndian, file.snode.magic); err != nil {
+	if err = binary.Write(&ibuffer, binary.BigEndian, uint64(CHECKSUM_MAGIC)); err != nil {
 		return err
 	}
 	if err = binary.Write(&ibuffer, binary.BigEndian, file.snode.inode); err != nil {
@@ -215,6 +253,12 @@ func (l *Log) Append(file *BlockFile) error {
 		if err = binary.Write(&ibuffer, binary.BigEndian, ext.data.length); err != nil {
 			return err
 		}
+		if err = binary.Write(&ibuffer, binary.BigEndian, uint32(len(ext.checksums))); err != nil {
+			return err
+		}
+		if err = binary.Write(&ibuffer, binary.BigEndian, ext.checksums); err != nil {
+			return err
+		}
 	}
 	ibufLen := (segment.super.blockSize - (uint32(ibuffer.Len()) % segment.super.blockSize)) + uint32(ibuffer.Len())
 	offset, allocated := l.allocator.Allocate(uint64(ibufLen))
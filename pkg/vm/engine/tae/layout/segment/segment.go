@@ -17,10 +17,15 @@ package segment
 import (
 	"bytes"
 	"encoding/binary"
+	"errors"
+	"fmt"
 	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/objstore"
 	"github.com/pierrec/lz4"
+	"io"
 	"os"
+	"path/filepath"
 	"sync"
 )
 
@@ -33,6 +38,15 @@ const DATA_SIZE = SIZE - DATA_START
 const LOG_SIZE = DATA_START - LOG_START
 const MAGIC = 0xFFFFFFFF
 
+// CHECKSUM_MAGIC starts the inodes whose extents have the checksums of their
+// pages. The inodes starting with MAGIC were written without checksums.
+const CHECKSUM_MAGIC = 0xFFFFFFFE
+
+var (
+	ErrOffloaded = errors.New("tae segment: offloaded to object store")
+	ErrCorrupted = errors.New("tae segment: corrupted")
+)
+
 type SuperBlock struct {
 	version   uint64
 	blockSize uint32
@@ -49,6 +63,13 @@ type Segment struct {
 	log       *Log
 	allocator Allocator
 	name      string
+
+	// ioMu protects reader from being swapped by Offload during a read
+	ioMu sync.RWMutex
+	// reader is the object of the segment once offloaded, segFile is closed
+	reader *objstore.Reader
+	store  objstore.Store
+	cache  *objstore.BlockCache
 }
 
 func (s *Segment) Init(name string) error {
@@ -136,6 +157,16 @@ func (s *Segment) Unmount() {
 func (s *Segment) Destroy() {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
+	if s.reader != nil {
+		logutil.Infof(" %s | SegmentFile | Destroying Object", s.name)
+		if err := s.store.Delete(s.reader.Name()); err != nil {
+			logutil.Warnf(" %s | SegmentFile | Destroying Object | %v", s.name, err)
+		}
+		if s.cache != nil {
+			s.cache.Evict(s.reader.Name())
+		}
+		return
+	}
 	err := s.segFile.Close()
 	if err != nil {
 		panic(any(err.Error()))
@@ -196,13 +227,30 @@ func (s *Segment) NewBlockFile(fname string) *BlockFile {
 }
 
 func (s *Segment) Append(fd *BlockFile, pl []byte) (err error) {
+	if s.segFile == nil {
+		return ErrOffloaded
+	}
 	buf := pl
-	if fd.snode.algo == compress.Lz4 {
+	algo := int(fd.GetAlgo())
+	if algo == compress.Auto {
+		algo = compress.Pick(pl)
+	}
+	if algo != compress.None {
 		colSize := len(pl)
 		buf = make([]byte, lz4.CompressBlockBound(colSize))
-		if buf, err = compress.Compress(pl, buf, compress.Lz4); err != nil {
+		compressed, err := compress.Compress(pl, buf, algo)
+		if errors.Is(err, compress.ErrNotApplicable) {
+			// the column type is not supported by the codec
+			algo = compress.Lz4
+			compressed, err = compress.Compress(pl, buf, algo)
+		}
+		if err != nil {
 			return err
 		}
+		buf = compressed
+	}
+	if algo != int(fd.GetAlgo()) {
+		fd.SetAlgo(uint8(algo))
 	}
 	offset, allocated := s.allocator.Allocate(uint64(len(buf)))
 	if allocated == 0 {
@@ -221,6 +269,9 @@ func (s *Segment) Append(fd *BlockFile, pl []byte) (err error) {
 }
 
 func (s *Segment) Update(fd *BlockFile, pl []byte, fOffset uint64) error {
+	if s.segFile == nil {
+		return ErrOffloaded
+	}
 	offset, _ := s.allocator.Allocate(uint64(len(pl)))
 	free, err := fd.Update(DATA_START+offset, pl, uint32(fOffset))
 	if err != nil {
@@ -265,9 +316,63 @@ func (s *Segment) GetPageSize() uint32 {
 }
 
 func (s *Segment) Sync() error {
+	if s.segFile == nil {
+		return nil
+	}
 	return s.segFile.Sync()
 }
 
+func (s *Segment) readAt(buf []byte, off int64) (int, error) {
+	s.ioMu.RLock()
+	defer s.ioMu.RUnlock()
+	if s.reader != nil {
+		return s.reader.ReadAt(buf, off)
+	}
+	return s.segFile.ReadAt(buf, off)
+}
+
+// IsOffloaded returns true if the data of the segment is read from the
+// object store.
+func (s *Segment) IsOffloaded() bool {
+	s.ioMu.RLock()
+	defer s.ioMu.RUnlock()
+	return s.reader != nil
+}
+
+// Offload uploads the sealed segment file to store as an object named by
+// the base name of the file and removes the local file. The reads go
+// through cache afterwards, the segment cannot be written any more.
+func (s *Segment) Offload(store objstore.Store, cache *objstore.BlockCache) (err error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.segFile == nil {
+		return nil
+	}
+	if err = s.segFile.Sync(); err != nil {
+		return
+	}
+	stat, err := s.segFile.Stat()
+	if err != nil {
+		return
+	}
+	name := filepath.Base(s.name)
+	if err = store.Put(name, io.NewSectionReader(s.segFile, 0, stat.Size()), stat.Size()); err != nil {
+		return fmt.Errorf("offload %s: %w", s.name, err)
+	}
+	s.ioMu.Lock()
+	s.reader = objstore.NewReader(store, name, cache)
+	s.store = store
+	s.cache = cache
+	segFile := s.segFile
+	s.segFile = nil
+	s.ioMu.Unlock()
+	if err = segFile.Close(); err != nil {
+		return
+	}
+	logutil.Infof(" %s | SegmentFile | Offloaded | Size=%d", s.name, stat.Size())
+	return os.Remove(s.name)
+}
+
 func (s *Segment) GetName() string {
 	return s.name
 }
@@ -17,6 +17,7 @@ package segment
 import (
 	"bytes"
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"os"
 	"path"
@@ -24,6 +25,8 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/objstore"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
 	"github.com/stretchr/testify/assert"
 )
@@ -189,6 +192,22 @@ func TestBitmapAllocator_Free(t *testing.T) {
 	//fmt.Printf(debugBitmap(seg.allocator.(*BitmapAllocator)))
 }
 
+func TestBitmapAllocator_Fragmented(t *testing.T) {
+	allocator := NewBitmapAllocator(LOG_SIZE, 4096)
+	offsets := make([]uint64, 0)
+	for i := 0; i < 11; i++ {
+		offset, _ := allocator.Allocate(4096)
+		offsets = append(offsets, offset)
+	}
+	// a single free page in front of a used one can not hold two pages
+	allocator.Free(uint32(offsets[9]), 4096)
+	offset, allocated := allocator.Allocate(8192)
+	assert.Equal(t, 11*4096, int(offset))
+	assert.Equal(t, 8192, int(allocated))
+	offset, _ = allocator.Allocate(4096)
+	assert.Equal(t, offsets[9], offset)
+}
+
 func TestBlockFile_GetExtents(t *testing.T) {
 	dir := testutils.InitTestEnv(ModuleName, t)
 	name := path.Join(dir, "free.seg")
@@ -356,3 +375,204 @@ func TestSegment_Init(t *testing.T) {
 	seg.Append(file, []byte(fmt.Sprintf("this is tests %d", 514)))
 	seg.Append(file, []byte(fmt.Sprintf("this is tests %d", 515)))*/
 }
+
+func TestSegment_Offload(t *testing.T) {
+	dir := testutils.InitTestEnv(ModuleName, t)
+	name := path.Join(dir, "offload.seg")
+	seg := Segment{}
+	err := seg.Init(name)
+	assert.Nil(t, err)
+	seg.Mount()
+	files := make([]*BlockFile, 0)
+	for i := 0; i < 4; i++ {
+		file := seg.NewBlockFile(fmt.Sprintf("test_%d.blk", i))
+		err = seg.Append(file, mockData(uint32(8192*(i+1))))
+		assert.Nil(t, err)
+		files = append(files, file)
+	}
+	expected := make([][]byte, len(files))
+	for i, file := range files {
+		expected[i] = make([]byte, file.GetFileSize())
+		_, err = file.Read(expected[i])
+		assert.Nil(t, err)
+	}
+
+	store := objstore.NewMemoryStore()
+	cache := objstore.NewBlockCache(1<<20, 4096)
+	assert.Nil(t, seg.Offload(store, cache))
+	assert.True(t, seg.IsOffloaded())
+	_, err = os.Stat(name)
+	assert.True(t, os.IsNotExist(err))
+	_, err = store.Size("offload.seg")
+	assert.Nil(t, err)
+	for i, file := range files {
+		buf := make([]byte, file.GetFileSize())
+		_, err = file.Read(buf)
+		assert.Nil(t, err)
+		assert.Equal(t, expected[i], buf)
+	}
+	assert.True(t, cache.Misses() > 0)
+	assert.Equal(t, ErrOffloaded, seg.Append(files[0], mockData(4096)))
+	seg.ReleaseFile(files[0])
+
+	seg.Destroy()
+	_, err = store.Size("offload.seg")
+	assert.Equal(t, objstore.ErrNotFound, err)
+	assert.Equal(t, int64(0), cache.Size())
+}
+
+func TestSegment_OpenVerify(t *testing.T) {
+	dir := testutils.InitTestEnv(ModuleName, t)
+	name := path.Join(dir, "verify.seg")
+	seg := Segment{}
+	assert.Nil(t, seg.Init(name))
+	seg.Mount()
+	for i := 0; i < 4; i++ {
+		file := seg.NewBlockFile(fmt.Sprintf("test_%d.blk", i))
+		assert.Nil(t, seg.Append(file, mockData(8192)))
+	}
+	// the extents of the released file are reused by the next append
+	seg.ReleaseFile(seg.nodes["test_1.blk"])
+	file := seg.NewBlockFile("test_4.blk")
+	assert.Nil(t, seg.Append(file, mockData(8192)))
+	assert.Nil(t, seg.Sync())
+
+	opened, err := Open(name)
+	assert.Nil(t, err)
+	files := opened.Files()
+	assert.Equal(t, 4, len(files))
+	for _, file := range files {
+		assert.NotEqual(t, "test_1.blk", file.GetName())
+		buf := make([]byte, file.GetFileSize())
+		_, err = file.Read(buf)
+		assert.Nil(t, err)
+		dst := make([]byte, 8192)
+		dst, err = compress.Decompress(buf, dst, compress.Lz4)
+		assert.Nil(t, err)
+		assert.Equal(t, mockData(8192), dst)
+	}
+	assert.Equal(t, 0, len(opened.Verify()))
+	assert.Nil(t, opened.Close())
+
+	// the data of the last extent is cut off
+	stat, err := os.Stat(name)
+	assert.Nil(t, err)
+	assert.Nil(t, os.Truncate(name, stat.Size()-10))
+	opened, err = Open(name)
+	assert.Nil(t, err)
+	errs := opened.Verify()
+	assert.Equal(t, 1, len(errs))
+	assert.ErrorIs(t, errs[0], ErrCorrupted)
+	assert.Nil(t, opened.Close())
+
+	// a bad inode record in the log
+	f, err := os.OpenFile(name, os.O_RDWR, os.ModePerm)
+	assert.Nil(t, err)
+	bad := make([]byte, 20)
+	binary.BigEndian.PutUint64(bad, MAGIC)
+	binary.BigEndian.PutUint64(bad[8:], 2)
+	binary.BigEndian.PutUint32(bad[16:], 0xFFFFFFF0)
+	_, err = f.WriteAt(bad, LOG_START)
+	assert.Nil(t, err)
+	assert.Nil(t, f.Close())
+	_, err = Open(name)
+	assert.ErrorIs(t, err, ErrCorrupted)
+}
+
+func TestSegment_Checksum(t *testing.T) {
+	dir := testutils.InitTestEnv(ModuleName, t)
+	name := path.Join(dir, "checksum.seg")
+	seg := Segment{}
+	assert.Nil(t, seg.Init(name))
+	seg.Mount()
+	files := make([]*BlockFile, 0)
+	for i := 0; i < 2; i++ {
+		file := seg.NewBlockFile(fmt.Sprintf("test_%d.blk", i))
+		file.snode.algo = compress.None
+		assert.Nil(t, seg.Append(file, mockData(8192)))
+		files = append(files, file)
+	}
+	// an update cuts the extent, whose page left keeps its checksum
+	assert.Nil(t, seg.Update(files[1], mockData(4096), 0))
+	assert.Nil(t, seg.Sync())
+	buf := make([]byte, files[1].GetFileSize())
+	_, err := files[1].Read(buf)
+	assert.Nil(t, err)
+	assert.Equal(t, mockData(4096), buf[:4096])
+	assert.Equal(t, mockData(8192)[4096:], buf[4096:])
+	opened, err := Open(name)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(opened.Verify()))
+	assert.Nil(t, opened.Close())
+
+	// flip a byte of the data of the first file
+	ext := (*files[0].GetExtents())[0]
+	f, err := os.OpenFile(name, os.O_RDWR, os.ModePerm)
+	assert.Nil(t, err)
+	b := make([]byte, 1)
+	_, err = f.ReadAt(b, int64(ext.Offset())+1)
+	assert.Nil(t, err)
+	b[0] ^= 0xff
+	_, err = f.WriteAt(b, int64(ext.Offset())+1)
+	assert.Nil(t, err)
+	assert.Nil(t, f.Close())
+
+	buf = make([]byte, files[0].GetFileSize())
+	_, err = files[0].Read(buf)
+	var corrupted *common.CorruptedError
+	assert.True(t, errors.As(err, &corrupted))
+	assert.ErrorIs(t, err, common.ErrChecksumMismatch)
+	assert.Equal(t, name, corrupted.File)
+	assert.Equal(t, int64(ext.Offset()), corrupted.Offset)
+
+	opened, err = Open(name)
+	assert.Nil(t, err)
+	errs := opened.Verify()
+	assert.Equal(t, 1, len(errs))
+	assert.ErrorIs(t, errs[0], common.ErrChecksumMismatch)
+	assert.Nil(t, opened.Close())
+}
+
+// The inodes written before the checksums are read without them
+func TestSegment_LegacyInode(t *testing.T) {
+	dir := testutils.InitTestEnv(ModuleName, t)
+	name := path.Join(dir, "legacy.seg")
+	seg := Segment{}
+	assert.Nil(t, seg.Init(name))
+	seg.Mount()
+	file := seg.NewBlockFile("test.blk")
+	file.snode.algo = compress.None
+	assert.Nil(t, seg.Append(file, mockData(8192)))
+	assert.Nil(t, seg.Sync())
+
+	var ibuffer bytes.Buffer
+	for _, v := range []interface{}{uint64(MAGIC), file.snode.inode, uint32(len(file.name)), []byte(file.name),
+		file.snode.seq, file.snode.algo, file.snode.state, file.snode.size, uint64(len(file.snode.extents))} {
+		assert.Nil(t, binary.Write(&ibuffer, binary.BigEndian, v))
+	}
+	for _, ext := range file.snode.extents {
+		for _, v := range []interface{}{ext.typ, ext.offset, ext.length, ext.data.offset, ext.data.length} {
+			assert.Nil(t, binary.Write(&ibuffer, binary.BigEndian, v))
+		}
+	}
+	f, err := os.OpenFile(name, os.O_RDWR, os.ModePerm)
+	assert.Nil(t, err)
+	_, err = f.WriteAt(make([]byte, BLOCK_SIZE), int64(file.snode.logExtents.offset+LOG_START))
+	assert.Nil(t, err)
+	_, err = f.WriteAt(ibuffer.Bytes(), int64(file.snode.logExtents.offset+LOG_START))
+	assert.Nil(t, err)
+	assert.Nil(t, f.Close())
+
+	opened, err := Open(name)
+	assert.Nil(t, err)
+	files := opened.Files()
+	assert.Equal(t, 1, len(files))
+	ext := (*files[0].GetExtents())[0]
+	assert.Equal(t, 0, len(ext.Checksums()))
+	buf := make([]byte, files[0].GetFileSize())
+	_, err = files[0].Read(buf)
+	assert.Nil(t, err)
+	assert.Equal(t, mockData(8192), buf)
+	assert.Equal(t, 0, len(opened.Verify()))
+	assert.Nil(t, opened.Close())
+}
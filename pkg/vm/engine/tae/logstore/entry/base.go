@@ -401,7 +401,11 @@ func (b *Base) ReadFrom(r io.Reader) (int64, error) {
 	if err != nil {
 		return int64(n2), err
 	}
-	return int64(n1 + n2), nil
+	// A short read is a torn entry, which is left to the caller
+	if n1+n2 == b.TotalSizeExpectMeta() {
+		err = b.VerifyChecksum()
+	}
+	return int64(n1 + n2), err
 }
 
 func (b *Base) ReadAt(r *os.File, offset int) (int, error) {
@@ -421,10 +425,28 @@ func (b *Base) ReadAt(r *os.File, offset int) (int, error) {
 	if err != nil {
 		return n2, err
 	}
-	return n1 + n2, nil
+	return n1 + n2, b.VerifyChecksum()
+}
+
+func (b *Base) computeChecksum() uint32 {
+	return common.Checksum(b.GetMetaBuf()[:ChecksumOffset], b.GetInfoBuf(), b.payload)
+}
+
+// VerifyChecksum checks the checksum in the descriptor against the info and
+// the payload read. The legacy entries have no checksum to check
+func (b *Base) VerifyChecksum() error {
+	if b.GetVersion() == DescVersionLegacy {
+		return nil
+	}
+	if checksum := b.computeChecksum(); checksum != b.GetChecksum() {
+		return fmt.Errorf("%w: entry checksum %x, computed %x",
+			common.ErrChecksumMismatch, b.GetChecksum(), checksum)
+	}
+	return nil
 }
 
 func (b *Base) WriteTo(w io.Writer) (int64, error) {
+	b.SetChecksum(b.computeChecksum())
 	n1, err := b.descriptor.WriteTo(w)
 	if err != nil {
 		return n1, err
@@ -16,25 +16,51 @@ package entry
 
 import (
 	"encoding/binary"
+	"errors"
+	"fmt"
 	"io"
 	"unsafe"
 )
 
 const (
-	PayloadSizeOffset = int(unsafe.Sizeof(ETInvalid))
-	InfoSizeOffset    = int(unsafe.Sizeof(ETInvalid) + unsafe.Sizeof(uint32(0)))
-	DescriptorSize    = int(unsafe.Sizeof(ETInvalid) + 2*unsafe.Sizeof(uint32(0)))
+	PayloadSizeOffset    = int(unsafe.Sizeof(ETInvalid))
+	InfoSizeOffset       = int(unsafe.Sizeof(ETInvalid) + unsafe.Sizeof(uint32(0)))
+	ChecksumOffset       = int(unsafe.Sizeof(ETInvalid) + 2*unsafe.Sizeof(uint32(0)))
+	LegacyDescriptorSize = ChecksumOffset
+	DescriptorSize       = int(unsafe.Sizeof(ETInvalid) + 3*unsafe.Sizeof(uint32(0)))
 )
 
-//type u16, payloadsize u32, infosize u32
+const (
+	// DescVersionLegacy is the descriptor written before the checksum was
+	// added, which ends at the info size
+	DescVersionLegacy uint16 = iota
+	// DescVersionChecksum is the descriptor with the checksum
+	DescVersionChecksum
+)
+
+const (
+	descVersionShift = 12
+	descTypeMask     = Type(1)<<descVersionShift - 1
+)
+
+var ErrUnknownVersion = errors.New("tae logstore: unknown entry descriptor version")
+
+// version u4 + type u12, payloadsize u32, infosize u32, checksum u32
+//
+// The version is kept in the high bits of the type, which are always zero in
+// the legacy format. The checksum is only in the descriptors of version
+// DescVersionChecksum, and is the CRC32C of the descriptor before it, the info
+// and the payload of the entry
 type descriptor struct {
 	descBuf []byte
 }
 
 func newDescriptor() *descriptor {
-	return &descriptor{
+	desc := &descriptor{
 		descBuf: make([]byte, DescriptorSize),
 	}
+	desc.SetVersion(DescVersionChecksum)
+	return desc
 }
 
 func (desc *descriptor) IsFlush() bool {
@@ -46,7 +72,12 @@ func (desc *descriptor) IsCheckpoint() bool {
 }
 
 func (desc *descriptor) SetType(t Type) {
-	binary.BigEndian.PutUint16(desc.descBuf, t)
+	version := binary.BigEndian.Uint16(desc.descBuf) &^ descTypeMask
+	binary.BigEndian.PutUint16(desc.descBuf, version|t&descTypeMask)
+}
+
+func (desc *descriptor) SetVersion(version uint16) {
+	binary.BigEndian.PutUint16(desc.descBuf, version<<descVersionShift|desc.GetType())
 }
 
 func (desc *descriptor) SetPayloadSize(size int) {
@@ -57,18 +88,35 @@ func (desc *descriptor) SetInfoSize(size int) {
 	binary.BigEndian.PutUint32(desc.descBuf[InfoSizeOffset:], uint32(size))
 }
 
+func (desc *descriptor) SetChecksum(checksum uint32) {
+	binary.BigEndian.PutUint32(desc.descBuf[ChecksumOffset:], checksum)
+}
+
 func (desc *descriptor) reset() {
 	desc.SetType(ETInvalid)
+	desc.SetVersion(DescVersionChecksum)
 	desc.SetPayloadSize(0)
 	desc.SetInfoSize(0)
+	desc.SetChecksum(0)
 }
 
 func (desc *descriptor) GetMetaBuf() []byte {
-	return desc.descBuf
+	return desc.descBuf[:desc.metaSize()]
 }
 
 func (desc *descriptor) GetType() Type {
-	return binary.BigEndian.Uint16(desc.descBuf)
+	return binary.BigEndian.Uint16(desc.descBuf) & descTypeMask
+}
+
+func (desc *descriptor) GetVersion() uint16 {
+	return binary.BigEndian.Uint16(desc.descBuf) >> descVersionShift
+}
+
+func (desc *descriptor) metaSize() int {
+	if desc.GetVersion() == DescVersionLegacy {
+		return LegacyDescriptorSize
+	}
+	return DescriptorSize
 }
 
 func (desc *descriptor) GetPayloadSize() int {
@@ -79,8 +127,15 @@ func (desc *descriptor) GetInfoSize() int {
 	return int(binary.BigEndian.Uint32(desc.descBuf[InfoSizeOffset:]))
 }
 
+func (desc *descriptor) GetChecksum() uint32 {
+	if desc.GetVersion() == DescVersionLegacy {
+		return 0
+	}
+	return binary.BigEndian.Uint32(desc.descBuf[ChecksumOffset:])
+}
+
 func (desc *descriptor) TotalSize() int {
-	return DescriptorSize + desc.GetPayloadSize() + desc.GetInfoSize()
+	return desc.metaSize() + desc.GetPayloadSize() + desc.GetInfoSize()
 }
 
 func (desc *descriptor) TotalSizeExpectMeta() int {
@@ -88,10 +143,38 @@ func (desc *descriptor) TotalSizeExpectMeta() int {
 }
 
 func (desc *descriptor) WriteTo(w io.Writer) (int64, error) {
-	n, err := w.Write(desc.descBuf)
+	n, err := w.Write(desc.GetMetaBuf())
 	return int64(n), err
 }
 
+func (desc *descriptor) checkVersion() error {
+	if version := desc.GetVersion(); version > DescVersionChecksum {
+		return fmt.Errorf("%w: %d", ErrUnknownVersion, version)
+	}
+	return nil
+}
+
+// ReadMeta reads a descriptor of any version. io.EOF is only returned when
+// nothing is read, and a descriptor cut off is io.ErrUnexpectedEOF
 func (desc *descriptor) ReadMeta(r io.Reader) (int, error) {
-	return r.Read(desc.descBuf)
+	n, err := io.ReadFull(r, desc.descBuf[:LegacyDescriptorSize])
+	if err != nil {
+		return n, err
+	}
+	if err = desc.checkVersion(); err != nil {
+		return n, err
+	}
+	if desc.metaSize() == LegacyDescriptorSize {
+		return n, nil
+	}
+	n2, err := io.ReadFull(r, desc.descBuf[LegacyDescriptorSize:])
+	if errors.Is(err, io.EOF) {
+		err = io.ErrUnexpectedEOF
+	}
+	return n + n2, err
+}
+
+// ReadMetaAt is ReadMeta at the offset of r
+func (desc *descriptor) ReadMetaAt(r io.ReaderAt, offset int64) (int, error) {
+	return desc.ReadMeta(io.NewSectionReader(r, offset, int64(DescriptorSize)))
 }
@@ -16,6 +16,8 @@ package entry
 
 import (
 	"bytes"
+	"encoding/binary"
+	"io"
 	"testing"
 	"time"
 
@@ -142,3 +144,95 @@ func checkInfoEqual(t *testing.T, info, info2 *Info) {
 	}
 	assert.Equal(t, info.GroupLSN, info2.GroupLSN)
 }
+
+func TestChecksum(t *testing.T) {
+	e := GetBase()
+	defer e.Free()
+	e.SetType(ETCustomizedStart)
+	e.SetInfoBuf((&Info{Group: GTCustomizedStart, GroupLSN: 1}).Marshal())
+	e.SetInfoSize(len(e.GetInfoBuf()))
+	assert.Nil(t, e.Unmarshal([]byte("helloworld")))
+	var w bytes.Buffer
+	_, err := e.WriteTo(&w)
+	assert.Nil(t, err)
+	buf := w.Bytes()
+
+	read := func(buf []byte) error {
+		e2 := GetBase()
+		defer e2.Free()
+		r := bytes.NewBuffer(buf)
+		_, err := e2.ReadMeta(r)
+		assert.Nil(t, err)
+		_, err = e2.ReadFrom(r)
+		return err
+	}
+	assert.Nil(t, read(buf))
+
+	buf[len(buf)-1] ^= 0xff
+	assert.ErrorIs(t, read(buf), common.ErrChecksumMismatch)
+	buf[len(buf)-1] ^= 0xff
+	buf[1] ^= 0xff
+	assert.ErrorIs(t, read(buf), common.ErrChecksumMismatch)
+}
+
+func TestLegacyDescriptor(t *testing.T) {
+	info := (&Info{Group: GTCustomizedStart, GroupLSN: 1}).Marshal()
+	payload := []byte("helloworld")
+	// type, payload size, info size, info and payload without a checksum
+	buf := make([]byte, LegacyDescriptorSize)
+	binary.BigEndian.PutUint16(buf, ETCustomizedStart)
+	binary.BigEndian.PutUint32(buf[PayloadSizeOffset:], uint32(len(payload)))
+	binary.BigEndian.PutUint32(buf[InfoSizeOffset:], uint32(len(info)))
+	buf = append(buf, info...)
+	buf = append(buf, payload...)
+
+	e := GetBase()
+	defer e.Free()
+	r := bytes.NewBuffer(buf)
+	n, err := e.ReadMeta(r)
+	assert.Nil(t, err)
+	assert.Equal(t, LegacyDescriptorSize, n)
+	assert.Equal(t, DescVersionLegacy, e.GetVersion())
+	assert.Equal(t, ETCustomizedStart, e.GetType())
+	assert.Equal(t, len(buf), e.TotalSize())
+	_, err = e.ReadFrom(r)
+	assert.Nil(t, err)
+	assert.Equal(t, payload, e.GetPayload())
+	assert.Equal(t, info, e.GetInfoBuf())
+
+	e2 := GetBase()
+	defer e2.Free()
+	_, err = e2.ReadMetaAt(bytes.NewReader(buf), 0)
+	assert.Nil(t, err)
+	assert.Equal(t, len(buf), e2.TotalSize())
+
+	// the entries to write are in the current format
+	e3 := GetBase()
+	defer e3.Free()
+	assert.Equal(t, DescVersionChecksum, e3.GetVersion())
+	assert.Equal(t, DescriptorSize, len(e3.GetMetaBuf()))
+}
+
+func TestUnknownVersion(t *testing.T) {
+	e := GetBase()
+	defer e.Free()
+	e.SetType(ETCustomizedStart)
+	assert.Nil(t, e.Unmarshal([]byte("helloworld")))
+	var w bytes.Buffer
+	_, err := e.WriteTo(&w)
+	assert.Nil(t, err)
+	buf := w.Bytes()
+	typ := buf[0]
+	buf[0] |= 0xf0
+
+	e2 := GetBase()
+	defer e2.Free()
+	_, err = e2.ReadMeta(bytes.NewBuffer(buf))
+	assert.ErrorIs(t, err, ErrUnknownVersion)
+
+	_, err = e2.ReadMeta(bytes.NewBuffer(buf[:LegacyDescriptorSize+1]))
+	assert.ErrorIs(t, err, ErrUnknownVersion)
+	buf[0] = typ
+	_, err = e2.ReadMeta(bytes.NewBuffer(buf[:LegacyDescriptorSize+1]))
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
@@ -48,6 +48,8 @@ type Desc interface {
 	SetPayloadSize(int)
 	GetInfoSize() int
 	SetInfoSize(int)
+	GetChecksum() uint32
+	SetChecksum(uint32)
 	TotalSize() int
 	GetMetaBuf() []byte
 	IsFlush() bool
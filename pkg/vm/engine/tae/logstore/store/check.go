@@ -0,0 +1,207 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/entry"
+)
+
+var ErrCorruptedEntry = errors.New("tae logstore: corrupted entry")
+
+// FileIssue is a problem found by CheckFiles at Offset of the version file
+// File. Torn is true for an incomplete entry at the tail of the last file,
+// which is left by a crash and truncated by the replay.
+type FileIssue struct {
+	File   string
+	Offset int64
+	Torn   bool
+	Err    error
+}
+
+func (issue *FileIssue) String() string {
+	return fmt.Sprintf("%s@%d: %v", issue.File, issue.Offset, issue.Err)
+}
+
+// CheckResult is what CheckFiles found in the version files of a store
+type CheckResult struct {
+	// Files are the names of the version files in version order
+	Files   []string
+	Entries int
+	// LSNs is the max LSN of each group
+	LSNs   map[uint32]uint64
+	Issues []*FileIssue
+}
+
+// CheckFiles reads the version files of the store name in dir without
+// changing them, and checks every entry is complete and has a valid info
+// with an LSN not seen before in its group.
+func CheckFiles(dir, name string) (result *CheckResult, err error) {
+	infos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return
+	}
+	versions := make(map[string]int)
+	result = &CheckResult{LSNs: make(map[uint32]uint64)}
+	for _, info := range infos {
+		version, err := ParseVersion(info.Name(), name, suffix)
+		if err != nil {
+			continue
+		}
+		versions[info.Name()] = version
+		result.Files = append(result.Files, info.Name())
+	}
+	sort.Slice(result.Files, func(i, j int) bool {
+		return versions[result.Files[i]] < versions[result.Files[j]]
+	})
+	seen := make(map[uint32]map[uint64]bool)
+	for i, file := range result.Files {
+		if err = result.checkFile(filepath.Join(dir, file), i == len(result.Files)-1, seen); err != nil {
+			return nil, err
+		}
+	}
+	return
+}
+
+func (result *CheckResult) checkFile(name string, last bool, seen map[uint32]map[uint64]bool) (err error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+	stat, err := f.Stat()
+	if err != nil {
+		return
+	}
+	issue := func(offset int64, torn bool, err error) {
+		result.Issues = append(result.Issues, &FileIssue{
+			File:   filepath.Base(name),
+			Offset: offset,
+			Torn:   torn,
+			Err:    err,
+		})
+	}
+	r := bufio.NewReader(f)
+	e := entry.GetBase()
+	defer e.Free()
+	offset := int64(0)
+	for offset < stat.Size() {
+		remain := stat.Size() - offset
+		if _, err = e.ReadMeta(r); err != nil {
+			if errors.Is(err, entry.ErrUnknownVersion) {
+				issue(offset, false, fmt.Errorf("%w: %v", ErrCorruptedEntry, err))
+			} else {
+				issue(offset, last, fmt.Errorf("%w: incomplete descriptor", ErrCorruptedEntry))
+			}
+			return nil
+		}
+		if e.GetType() == entry.ETInvalid {
+			issue(offset, false, fmt.Errorf("%w: invalid type", ErrCorruptedEntry))
+			return nil
+		}
+		if remain < int64(e.TotalSize()) {
+			issue(offset, last, fmt.Errorf("%w: incomplete entry of %d bytes, %d left",
+				ErrCorruptedEntry, e.TotalSize(), remain))
+			return nil
+		}
+		infoBuf := make([]byte, e.GetInfoSize())
+		if _, err = io.ReadFull(r, infoBuf); err != nil {
+			return
+		}
+		payload := make([]byte, e.GetPayloadSize())
+		if _, err = io.ReadFull(r, payload); err != nil {
+			return
+		}
+		result.Entries++
+		_ = e.Unmarshal(payload)
+		e.SetInfoBuf(infoBuf)
+		if checksumErr := e.VerifyChecksum(); checksumErr != nil {
+			issue(offset, false, fmt.Errorf("%w: %v", ErrCorruptedEntry, checksumErr))
+			offset += int64(e.TotalSize())
+			continue
+		}
+		info, infoErr := parseInfo(infoBuf)
+		if infoErr != nil {
+			issue(offset, false, infoErr)
+		} else if e.GetType() != entry.ETFlush && e.GetType() != entry.ETNoop {
+			lsns := seen[info.Group]
+			if lsns == nil {
+				lsns = make(map[uint64]bool)
+				seen[info.Group] = lsns
+			}
+			if lsns[info.GroupLSN] {
+				issue(offset, false, fmt.Errorf("%w: duplicate LSN %d of group %d",
+					ErrCorruptedEntry, info.GroupLSN, info.Group))
+			}
+			lsns[info.GroupLSN] = true
+			if info.GroupLSN > result.LSNs[info.Group] {
+				result.LSNs[info.Group] = info.GroupLSN
+			}
+		}
+		offset += int64(e.TotalSize())
+	}
+	return nil
+}
+
+func parseInfo(buf []byte) (info *entry.Info, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%w: bad info: %v", ErrCorruptedEntry, r)
+		}
+	}()
+	info = entry.Unmarshal(buf)
+	return
+}
+
+// Errors returns the issues which are not torn tails
+func (result *CheckResult) Errors() (issues []*FileIssue) {
+	for _, issue := range result.Issues {
+		if !issue.Torn {
+			issues = append(issues, issue)
+		}
+	}
+	return
+}
+
+// CopyFilePrefix copies the first size bytes of src to dst, which is
+// created or truncated.
+func CopyFilePrefix(dst, src string, size int64) (err error) {
+	in, err := os.Open(src)
+	if err != nil {
+		return
+	}
+	defer in.Close()
+	out, err := os.Create(dst)
+	if err != nil {
+		return
+	}
+	defer func() {
+		if cerr := out.Close(); err == nil {
+			err = cerr
+		}
+	}()
+	if _, err = io.CopyN(out, in, size); err != nil {
+		return
+	}
+	return out.Sync()
+}
@@ -167,10 +167,11 @@ func (rf *rotateFile) Replay(r *replayer, o ReplayObserver) error {
 	if err != nil {
 		return err
 	}
+	r.tail = true
 	for _, vf := range rf.uncommitted {
 		err = vf.Replay(r, vf)
 		if err != nil {
-			return nil
+			return err
 		}
 	}
 	return nil
@@ -298,6 +299,14 @@ func (rf *rotateFile) commitFile() {
 }
 
 func (rf *rotateFile) Sync() error {
+	return rf.flush(true)
+}
+
+func (rf *rotateFile) Flush() error {
+	return rf.flush(false)
+}
+
+func (rf *rotateFile) flush(sync bool) error {
 	rf.RLock()
 	if len(rf.uncommitted) == 0 {
 		rf.RUnlock()
@@ -306,13 +315,13 @@ func (rf *rotateFile) Sync() error {
 	if len(rf.uncommitted) == 1 {
 		f := rf.uncommitted[0]
 		rf.RUnlock()
-		return f.Sync()
+		return f.flush(sync)
 	}
 	lastFile := rf.uncommitted[len(rf.uncommitted)-1]
 	waitFile := rf.uncommitted[len(rf.uncommitted)-2]
 	rf.RUnlock()
 	waitFile.WaitCommitted()
-	return lastFile.Sync()
+	return lastFile.flush(sync)
 }
 
 func (rf *rotateFile) Load(ver int, groupId uint32, lsn uint64) (entry.Entry, error) {
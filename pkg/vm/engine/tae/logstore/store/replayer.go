@@ -44,6 +44,8 @@ type replayer struct {
 	checkpoints     []*replayEntry
 	mergeFuncs      map[uint32]func(pre, curr []byte) []byte
 	applyEntry      ApplyHandle
+	// tail is true when replaying the last version file
+	tail bool
 
 	//syncbase
 	addrs    map[uint32]map[int]common.ClosedInterval
@@ -281,53 +283,65 @@ func (r *replayer) onReplayEntry(e entry.Entry, vf ReplayObserver) error {
 	return nil
 }
 
+// onTorn handles the entry at the current position which is cut off by the
+// end of the file. Only the tail of the last version file could be torn by a
+// crash, which is truncated, and anywhere else it is a corruption
+func (r *replayer) onTorn(vfile *vFile) error {
+	if !r.tail {
+		return common.NewCorruptedError(vfile.Name(), int64(r.state.pos), io.ErrUnexpectedEOF)
+	}
+	if err := vfile.Truncate(int64(r.state.pos)); err != nil {
+		return err
+	}
+	return io.EOF
+}
+
 func (r *replayer) replayHandler(v VFile, o ReplayObserver) error {
 	vfile := v.(*vFile)
 	if vfile.version != r.version {
 		r.state.pos = 0
 	}
-	current := vfile.GetState()
-	entry := entry.GetBase()
-	defer entry.Free()
+	e := entry.GetBase()
+	defer e.Free()
 
-	metaBuf := entry.GetMetaBuf()
-	_, err := vfile.Read(metaBuf)
+	_, err := e.ReadMeta(vfile)
 	if err != nil {
-		if !errors.Is(err, io.EOF) {
+		if errors.Is(err, io.EOF) {
 			return err
 		}
-		err2 := vfile.Truncate(int64(r.state.pos))
-		if err2 != nil {
-			panic(err2)
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			return r.onTorn(vfile)
+		}
+		if errors.Is(err, entry.ErrUnknownVersion) {
+			return common.NewCorruptedError(vfile.Name(), int64(r.state.pos), err)
 		}
 		return err
 	}
+	// No entry is written with the invalid type, and the legacy entries have
+	// no checksum to catch a zeroed descriptor
+	if e.GetType() == entry.ETInvalid {
+		return common.NewCorruptedError(vfile.Name(), int64(r.state.pos),
+			fmt.Errorf("%w: invalid type", ErrCorruptedEntry))
+	}
+	// The sizes are checked before reading, as a corrupted descriptor could
+	// make a huge entry
+	if r.state.pos+e.TotalSize() > vfile.size {
+		return r.onTorn(vfile)
+	}
 
-	n, err := entry.ReadFrom(vfile)
+	n, err := e.ReadFrom(vfile)
 	if err != nil {
-		if !errors.Is(err, io.EOF) {
-			return err
+		if errors.Is(err, io.EOF) {
+			return r.onTorn(vfile)
 		}
-		err2 := vfile.Truncate(int64(r.state.pos))
-		if err2 != nil {
-			panic(err2)
-		}
-		return err
+		return common.NewCorruptedError(vfile.Name(), int64(r.state.pos), err)
 	}
-	if int(n) != entry.TotalSizeExpectMeta() {
-		if current.pos == r.state.pos+int(n) {
-			err2 := vfile.Truncate(int64(current.pos))
-			if err2 != nil {
-				return err
-			}
-			return io.EOF
-		} else {
-			return fmt.Errorf("payload mismatch: %d != %d", n, entry.GetPayloadSize())
-		}
+	if int(n) != e.TotalSizeExpectMeta() {
+		return r.onTorn(vfile)
 	}
-	if err = r.onReplayEntry(entry, o); err != nil {
+	if err = r.onReplayEntry(e, o); err != nil {
 		return err
 	}
-	r.state.pos += entry.TotalSize()
+	r.state.pos += e.TotalSize()
 	return nil
 }
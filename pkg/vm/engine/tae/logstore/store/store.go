@@ -85,6 +85,8 @@ type baseStore struct {
 	wg              sync.WaitGroup
 	file            File
 	mu              *sync.RWMutex
+	syncPolicy      SyncPolicy
+	syncRecorder    syncRecorder
 }
 
 func NewBaseStore(dir, name string, cfg *StoreCfg) (*baseStore, error) {
@@ -102,6 +104,7 @@ func NewBaseStore(dir, name string, cfg *StoreCfg) (*baseStore, error) {
 	if cfg == nil {
 		cfg = &StoreCfg{}
 	}
+	bs.syncPolicy = cfg.SyncPolicy
 	bs.file, err = OpenRotateFile(dir, name, nil, cfg.RotateChecker, cfg.HistoryFactory, &bs.storeInfo)
 	if err != nil {
 		return nil, err
@@ -124,7 +127,11 @@ func (bs *baseStore) flushLoop() {
 	defer bs.wg.Done()
 	entries := make([]entry.Entry, 0, DefaultMaxBatchSize)
 	bats := make([]*batch, 0, DefaultBatchPerSync)
-	ticker := time.NewTicker(DefaultSyncDuration)
+	// Only SyncInterval holds the written batches back, the other modes
+	// pass each one to the sync loop at once
+	interval := bs.syncPolicy.interval()
+	grouped := bs.syncPolicy.Mode != SyncInterval
+	ticker := time.NewTicker(interval)
 	for {
 		t1 := time.Now()
 		select {
@@ -156,8 +163,8 @@ func (bs *baseStore) flushLoop() {
 			bs.onEntriesDuration += time.Since(t1)
 			t1 = time.Now()
 			bats = append(bats, bat)
-			if len(bats) >= DefaultBatchPerSync || time.Since(t0) > DefaultSyncDuration {
-				if len(bats) >= DefaultBatchPerSync {
+			if grouped || len(bats) >= DefaultBatchPerSync || time.Since(t0) > interval {
+				if grouped || len(bats) >= DefaultBatchPerSync {
 					bs.bySize++
 				} else {
 					bs.byDuration++
@@ -317,9 +324,20 @@ func (bs *baseStore) onCommits(batches []*batch) {
 
 func (bs *baseStore) onSyncs(batches []*batch) {
 	var err error
-	if err = bs.file.Sync(); err != nil {
+	t0 := time.Now()
+	if bs.syncPolicy.Mode == SyncOS {
+		err = bs.file.Flush()
+	} else {
+		err = bs.file.Sync()
+	}
+	if err != nil {
 		panic(err)
 	}
+	entries := 0
+	for _, bat := range batches {
+		entries += len(bat.entrys)
+	}
+	bs.syncRecorder.record(entries, time.Since(t0))
 	bats := make([]*batch, len(batches))
 	copy(bats, batches)
 	bs.commitQueue <- bats
@@ -428,6 +446,7 @@ func (bs *baseStore) Close() error {
 		bs.flushQueueDuration, bs.flushLoop1Duration, bs.onEntriesDuration, bs.flushLoop2Duration, bs.tickerTimes)
 	fmt.Printf("***********************\n")
 	fmt.Printf("sync %d times(S%dD%d)\n", bs.syncTimes, bs.bySize, bs.byDuration)
+	fmt.Printf("sync policy %s|%s\n", bs.syncPolicy.String(), bs.SyncStats().String())
 	if bs.syncTimes != 0 {
 		fmt.Printf("sync duration %v(avg%v)\nwrite durtion %v\nsync queue duration %v\nsync loop duration %v\n",
 			bs.syncDuration, time.Duration(int(bs.syncDuration)/bs.syncTimes), bs.writeDuration, bs.syncQueueDuration, bs.syncLoopDuration)
@@ -512,6 +531,11 @@ func (s *baseStore) Sync() error {
 	return err
 }
 
+// SyncStats returns the counters of the groups of entries synced
+func (s *baseStore) SyncStats() SyncStats {
+	return s.syncRecorder.get()
+}
+
 func (s *baseStore) Replay(h ApplyHandle) error {
 	r := newReplayer(h)
 	o := &noopObserver{}
@@ -17,9 +17,12 @@ package store
 import (
 	"bytes"
 	"context"
+	"encoding/binary"
+	"errors"
 	"fmt"
 	"math/rand"
 	"os"
+	"path"
 	"sync"
 	"testing"
 	"time"
@@ -702,3 +705,193 @@ func TestLoad(t *testing.T) {
 
 	s.Close()
 }
+
+func TestParseSyncPolicy(t *testing.T) {
+	for spec, expected := range map[string]SyncPolicy{
+		"":            {Mode: SyncInterval},
+		"interval:10": {Mode: SyncInterval, Interval: 10 * time.Millisecond},
+		"commit":      {Mode: SyncCommit},
+		"OS":          {Mode: SyncOS},
+	} {
+		policy, err := ParseSyncPolicy(spec)
+		assert.NoError(t, err, spec)
+		assert.Equal(t, expected, policy, spec)
+	}
+	for _, spec := range []string{"always", "interval:0", "interval:x", "commit:1"} {
+		_, err := ParseSyncPolicy(spec)
+		assert.ErrorIs(t, err, ErrBadSyncPolicy, spec)
+	}
+}
+
+func TestGroupCommit(t *testing.T) {
+	for _, spec := range []string{"commit", "os", "interval:5"} {
+		dir := "/tmp/logstore/testgroupcommit"
+		name := "mock"
+		os.RemoveAll(dir)
+		policy, err := ParseSyncPolicy(spec)
+		assert.NoError(t, err)
+		cfg := &StoreCfg{SyncPolicy: policy}
+		s, err := NewBaseStore(dir, name, cfg)
+		assert.NoError(t, err)
+
+		workers, entryPerWorker := 8, 50
+		var wg sync.WaitGroup
+		for i := 0; i < workers; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for j := 0; j < entryPerWorker; j++ {
+					e := entry.GetBase()
+					e.SetType(entry.ETCustomizedStart)
+					e.SetInfo(&entry.Info{})
+					assert.NoError(t, e.Unmarshal([]byte("payload")))
+					_, err := s.AppendEntry(entry.GTCustomizedStart, e)
+					assert.NoError(t, err)
+					assert.NoError(t, e.WaitDone())
+				}
+			}()
+		}
+		wg.Wait()
+
+		stats := s.SyncStats()
+		t.Logf("%s: %s", spec, stats.String())
+		assert.Equal(t, uint64(workers*entryPerWorker), stats.Entries, spec)
+		assert.NotZero(t, stats.Syncs, spec)
+		assert.LessOrEqual(t, stats.MaxBatch, uint64(workers*entryPerWorker), spec)
+		assert.NoError(t, s.Close())
+
+		s, err = NewBaseStore(dir, name, cfg)
+		assert.NoError(t, err)
+		replayed := 0
+		err = s.Replay(func(group uint32, _ uint64, payload []byte, _ uint16, _ interface{}) {
+			if group == entry.GTCustomizedStart {
+				replayed++
+			}
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, workers*entryPerWorker, replayed, spec)
+		assert.NoError(t, s.Close())
+	}
+}
+
+func TestCheckFiles(t *testing.T) {
+	dir := "/tmp/logstore/testcheckfiles"
+	name := "mock"
+	os.RemoveAll(dir)
+	cfg := &StoreCfg{
+		RotateChecker: NewMaxSizeRotateChecker(int(common.K) * 4),
+	}
+	s, err := NewBaseStore(dir, name, cfg)
+	assert.NoError(t, err)
+	entryCnt := 100
+	for i := 0; i < entryCnt; i++ {
+		e := entry.GetBase()
+		e.SetType(entry.ETCustomizedStart)
+		e.SetInfo(&entry.Info{})
+		assert.NoError(t, e.Unmarshal([]byte(fmt.Sprintf("payload %d", i))))
+		_, err := s.AppendEntry(entry.GTCustomizedStart, e)
+		assert.NoError(t, err)
+		assert.NoError(t, e.WaitDone())
+		e.Free()
+	}
+	assert.NoError(t, s.Close())
+
+	result, err := CheckFiles(dir, name)
+	assert.NoError(t, err)
+	assert.True(t, len(result.Files) > 1)
+	assert.Equal(t, 0, len(result.Issues))
+	assert.True(t, result.Entries >= entryCnt)
+	assert.Equal(t, uint64(entryCnt), result.LSNs[entry.GTCustomizedStart])
+
+	// an entry cut by a crash at the tail of the last file
+	last := path.Join(dir, result.Files[len(result.Files)-1])
+	f, err := os.OpenFile(last, os.O_RDWR|os.O_APPEND, os.ModePerm)
+	assert.NoError(t, err)
+	_, err = f.Write([]byte{0, byte(entry.ETCustomizedStart), 0, 0, 1, 0})
+	assert.NoError(t, err)
+	assert.NoError(t, f.Close())
+	result, err = CheckFiles(dir, name)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(result.Issues))
+	assert.True(t, result.Issues[0].Torn)
+	assert.Equal(t, 0, len(result.Errors()))
+
+	// the type of the first entry is overwritten
+	first := path.Join(dir, result.Files[0])
+	f, err = os.OpenFile(first, os.O_RDWR, os.ModePerm)
+	assert.NoError(t, err)
+	_, err = f.WriteAt([]byte{0, 0}, 0)
+	assert.NoError(t, err)
+	assert.NoError(t, f.Close())
+	result, err = CheckFiles(dir, name)
+	assert.NoError(t, err)
+	errs := result.Errors()
+	assert.Equal(t, 1, len(errs))
+	assert.ErrorIs(t, errs[0].Err, ErrCorruptedEntry)
+	assert.Equal(t, result.Files[0], errs[0].File)
+}
+
+func TestReplayCorrupted(t *testing.T) {
+	dir := "/tmp/logstore/testreplaycorrupted"
+	name := "mock"
+	os.RemoveAll(dir)
+	cfg := &StoreCfg{
+		RotateChecker: NewMaxSizeRotateChecker(int(common.K) * 4),
+	}
+	s, err := NewBaseStore(dir, name, cfg)
+	assert.NoError(t, err)
+	for i := 0; i < 100; i++ {
+		e := entry.GetBase()
+		e.SetType(entry.ETCustomizedStart)
+		e.SetInfo(&entry.Info{})
+		assert.NoError(t, e.Unmarshal([]byte(fmt.Sprintf("payload %d", i))))
+		_, err := s.AppendEntry(entry.GTCustomizedStart, e)
+		assert.NoError(t, err)
+		assert.NoError(t, e.WaitDone())
+		e.Free()
+	}
+	assert.NoError(t, s.Close())
+	result, err := CheckFiles(dir, name)
+	assert.NoError(t, err)
+	assert.True(t, len(result.Files) > 1)
+
+	// flip the last payload byte of the second entry of the first file
+	first := path.Join(dir, result.Files[0])
+	f, err := os.OpenFile(first, os.O_RDWR, os.ModePerm)
+	assert.NoError(t, err)
+	desc := make([]byte, entry.DescriptorSize)
+	_, err = f.ReadAt(desc, 0)
+	assert.NoError(t, err)
+	offset := int64(entry.DescriptorSize) +
+		int64(binary.BigEndian.Uint32(desc[entry.PayloadSizeOffset:])) +
+		int64(binary.BigEndian.Uint32(desc[entry.InfoSizeOffset:]))
+	_, err = f.ReadAt(desc, offset)
+	assert.NoError(t, err)
+	size := int64(entry.DescriptorSize) +
+		int64(binary.BigEndian.Uint32(desc[entry.PayloadSizeOffset:])) +
+		int64(binary.BigEndian.Uint32(desc[entry.InfoSizeOffset:]))
+	b := make([]byte, 1)
+	_, err = f.ReadAt(b, offset+size-1)
+	assert.NoError(t, err)
+	b[0] ^= 0xff
+	_, err = f.WriteAt(b, offset+size-1)
+	assert.NoError(t, err)
+	assert.NoError(t, f.Close())
+
+	result, err = CheckFiles(dir, name)
+	assert.NoError(t, err)
+	errs := result.Errors()
+	assert.Equal(t, 1, len(errs))
+	assert.ErrorIs(t, errs[0].Err, ErrCorruptedEntry)
+	assert.Equal(t, offset, errs[0].Offset)
+
+	s, err = NewBaseStore(dir, name, cfg)
+	assert.NoError(t, err)
+	err = s.Replay(func(uint32, uint64, []byte, uint16, interface{}) {})
+	var corrupted *common.CorruptedError
+	assert.True(t, errors.As(err, &corrupted))
+	assert.ErrorIs(t, err, common.ErrChecksumMismatch)
+	assert.Equal(t, first, corrupted.File)
+	assert.Equal(t, offset, corrupted.Offset)
+	assert.NoError(t, s.Close())
+}
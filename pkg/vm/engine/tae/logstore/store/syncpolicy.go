@@ -0,0 +1,157 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	ErrBadSyncPolicy = errors.New("tae logstore: bad sync policy")
+)
+
+// SyncMode is when the appended entries are synced to the disk. In every
+// mode the entries appended concurrently are written and synced as a group.
+type SyncMode int8
+
+const (
+	// SyncInterval syncs the entries appended within an interval at once
+	SyncInterval SyncMode = iota
+	// SyncCommit syncs each group as soon as it is written, the entries
+	// appended while a group is synced make up the next group
+	SyncCommit
+	// SyncOS writes each group without syncing it, the OS flushes the
+	// entries at its own pace
+	SyncOS
+)
+
+type SyncPolicy struct {
+	Mode SyncMode
+	// Interval is the interval of SyncInterval, DefaultSyncDuration if 0
+	Interval time.Duration
+}
+
+// ParseSyncPolicy returns the sync policy of spec, which is one of commit,
+// os and interval[:ms]. An empty spec is interval.
+func ParseSyncPolicy(spec string) (policy SyncPolicy, err error) {
+	name, arg := spec, ""
+	if i := strings.IndexByte(spec, ':'); i >= 0 {
+		name, arg = spec[:i], spec[i+1:]
+	}
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "", "interval":
+		policy.Mode = SyncInterval
+		if arg != "" {
+			ms, perr := strconv.Atoi(arg)
+			if perr != nil || ms <= 0 {
+				break
+			}
+			policy.Interval = time.Duration(ms) * time.Millisecond
+		}
+		return
+	case "commit":
+		if arg != "" {
+			break
+		}
+		policy.Mode = SyncCommit
+		return
+	case "os":
+		if arg != "" {
+			break
+		}
+		policy.Mode = SyncOS
+		return
+	}
+	err = fmt.Errorf("%w: %q", ErrBadSyncPolicy, spec)
+	return
+}
+
+func (policy SyncPolicy) String() string {
+	switch policy.Mode {
+	case SyncCommit:
+		return "commit"
+	case SyncOS:
+		return "os"
+	}
+	return fmt.Sprintf("interval:%d", policy.interval().Milliseconds())
+}
+
+func (policy SyncPolicy) interval() time.Duration {
+	if policy.Interval <= 0 {
+		return DefaultSyncDuration
+	}
+	return policy.Interval
+}
+
+// SyncStats are the counters of the groups of entries synced by a store.
+// With SyncOS the groups are written but not synced.
+type SyncStats struct {
+	Syncs   uint64
+	Entries uint64
+	// MaxBatch is the most entries synced at once
+	MaxBatch uint64
+	// Duration is the time spent syncing and MaxDuration the longest sync
+	Duration    time.Duration
+	MaxDuration time.Duration
+}
+
+func (stats SyncStats) AvgBatch() float64 {
+	if stats.Syncs == 0 {
+		return 0
+	}
+	return float64(stats.Entries) / float64(stats.Syncs)
+}
+
+func (stats SyncStats) AvgDuration() time.Duration {
+	if stats.Syncs == 0 {
+		return 0
+	}
+	return stats.Duration / time.Duration(stats.Syncs)
+}
+
+func (stats SyncStats) String() string {
+	return fmt.Sprintf("Syncs=%d,Entries=%d,AvgBatch=%.2f,MaxBatch=%d,AvgDuration=%v,MaxDuration=%v",
+		stats.Syncs, stats.Entries, stats.AvgBatch(), stats.MaxBatch, stats.AvgDuration(), stats.MaxDuration)
+}
+
+type syncRecorder struct {
+	sync.Mutex
+	stats SyncStats
+}
+
+func (r *syncRecorder) record(entries int, d time.Duration) {
+	r.Lock()
+	defer r.Unlock()
+	r.stats.Syncs++
+	r.stats.Entries += uint64(entries)
+	if uint64(entries) > r.stats.MaxBatch {
+		r.stats.MaxBatch = uint64(entries)
+	}
+	r.stats.Duration += d
+	if d > r.stats.MaxDuration {
+		r.stats.MaxDuration = d
+	}
+}
+
+func (r *syncRecorder) get() SyncStats {
+	r.Lock()
+	defer r.Unlock()
+	return r.stats
+}
@@ -24,6 +24,7 @@ import (
 type StoreCfg struct {
 	RotateChecker  RotateChecker
 	HistoryFactory HistoryFactory
+	SyncPolicy     SyncPolicy
 }
 
 type RotateChecker interface {
@@ -104,6 +105,8 @@ type File interface {
 	FileReader
 
 	Sync() error
+	// Flush writes the appended entries without syncing them
+	Flush() error
 	GetAppender() FileAppender
 	Replay(*replayer, ReplayObserver) error
 	GetHistory() History
@@ -123,4 +126,5 @@ type Store interface {
 	TryCompact() error
 	TryTruncate(int64) error
 	Load(groupId uint32, lsn uint64) (entry.Entry, error)
+	SyncStats() SyncStats
 }
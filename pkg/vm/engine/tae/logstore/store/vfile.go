@@ -162,12 +162,23 @@ func (vf *vFile) Commit() {
 
 //TODO reuse wait sync
 func (vf *vFile) Sync() error {
+	return vf.flush(true)
+}
+
+func (vf *vFile) Flush() error {
+	return vf.flush(false)
+}
+
+func (vf *vFile) flush(sync bool) error {
 	vf.Lock()
 	defer vf.Unlock()
 	if vf.bsInfo != nil {
 		vf.bsInfo.syncTimes++
 	}
 	if vf.buf == nil {
+		if !sync {
+			return nil
+		}
 		err := vf.File.Sync()
 		return err
 	}
@@ -194,6 +205,9 @@ func (vf *vFile) Sync() error {
 	}
 	vf.bufpos = 0
 	// fmt.Printf("199bufpos is %v\n",vf.bufpos)
+	if !sync {
+		return nil
+	}
 	t0 = time.Now()
 	err = vf.File.Sync()
 	if err != nil {
@@ -342,13 +356,15 @@ func (vf *vFile) Load(groupId uint32, lsn uint64) (entry.Entry, error) {
 		return nil, err
 	}
 	entry := entry.GetBase()
-	metaBuf := entry.GetMetaBuf()
-	_, err = vf.ReadAt(metaBuf, int64(offset))
+	_, err = entry.ReadMetaAt(vf, int64(offset))
 	// fmt.Printf("%p|read meta [%v,%v]\n", vf, offset, offset+n)
 	if err != nil {
 		return nil, err
 	}
 	_, err = entry.ReadAt(vf.File, offset)
+	if errors.Is(err, common.ErrChecksumMismatch) {
+		err = common.NewCorruptedError(vf.Name(), int64(offset), err)
+	}
 	return entry, err
 }
 
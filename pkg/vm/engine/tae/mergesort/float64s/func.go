@@ -91,11 +91,15 @@ func Merge(col []*vector.Vector, src *[]uint32, fromLayout, toLayout []uint32) (
 	}
 
 	nBlk := len(data)
-	heap := make(heapSlice, nBlk)
+	heap := make(heapSlice, 0, nBlk)
 	merged := make([][]float64, len(toLayout))
 
 	for i := 0; i < nBlk; i++ {
-		heap[i] = heapElem{data: data[i][0], src: uint32(i), next: 1}
+		if fromLayout[i] > 0 {
+			heap = append(heap, heapElem{data: data[i][0], src: uint32(i), next: 1})
+		}
+	}
+	for i := range toLayout {
 		merged[i] = make([]float64, toLayout[i])
 	}
 	heapInit(heap)
@@ -133,12 +133,14 @@ func Merge(col []*vector.Vector, src *[]uint32, fromLayout, toLayout []uint32) (
 			maxTo = i
 		}
 	}
-	heap := make(heapSlice, from)
+	heap := make(heapSlice, 0, from)
 	strings := make([][]byte, maxTo)
 	merged := make([]*types.Bytes, to)
 
 	for i := 0; i < from; i++ {
-		heap[i] = heapElem{data: data[i].Get(0), src: uint32(i), next: 1}
+		if fromLayout[i] > 0 {
+			heap = append(heap, heapElem{data: data[i].Get(0), src: uint32(i), next: 1})
+		}
 	}
 	heapInit(heap)
 
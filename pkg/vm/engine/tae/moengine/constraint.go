@@ -0,0 +1,295 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package moengine
+
+import (
+	"fmt"
+	"go/constant"
+	"math"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+)
+
+// buildConstraints adds the CHECK and FOREIGN KEY constraints of info to
+// schema. The unique indexes and NOT NULL columns are set by
+// TableInfoToSchema.
+func (db *txnDatabase) buildConstraints(schema *catalog.Schema, info *aoe.TableInfo) (err error) {
+	for _, index := range info.Indices {
+		if index.Type == aoe.Unique && len(index.ColumnNames) != 1 {
+			return fmt.Errorf("%w: unique key %s of multiple columns", catalog.ErrValidation, index.Name)
+		}
+	}
+	for _, def := range info.Checks {
+		check := &catalog.CheckInfo{
+			Name: def.Name,
+			Expr: def.Expr,
+		}
+		if check.Pred, err = compileCheck(schema, def.Expr); err != nil {
+			return
+		}
+		schema.Checks = append(schema.Checks, check)
+	}
+	for _, def := range info.ForeignKeys {
+		var fk *catalog.ForeignKeyInfo
+		if fk, err = db.buildForeignKey(schema, def); err != nil {
+			return
+		}
+		schema.ForeignKeys = append(schema.ForeignKeys, fk)
+	}
+	return
+}
+
+func (db *txnDatabase) buildForeignKey(schema *catalog.Schema, def engine.ForeignKeyDef) (fk *catalog.ForeignKeyInfo, err error) {
+	if len(def.ColNames) != 1 || len(def.RefColNames) != 1 {
+		return nil, fmt.Errorf("%w: foreign key %s of multiple columns", catalog.ErrValidation, def.Name)
+	}
+	col := schema.GetColIdx(def.ColNames[0])
+	if col < 0 {
+		return nil, fmt.Errorf("%w: column %s of foreign key %s", catalog.ErrValidation, def.ColNames[0], def.Name)
+	}
+	fk = &catalog.ForeignKeyInfo{
+		Name:     def.Name,
+		Column:   schema.ColDefs[col].SeqNum,
+		OnDelete: catalog.RefAction(def.OnDelete),
+		OnUpdate: catalog.RefAction(def.OnUpdate),
+	}
+	refSchema := schema
+	if def.RefTable != schema.Name || (def.RefDatabase != "" && def.RefDatabase != db.handle.GetName()) {
+		refDB := db.handle
+		if def.RefDatabase != "" && def.RefDatabase != db.handle.GetName() {
+			if refDB, err = db.txn.GetDatabase(def.RefDatabase); err != nil {
+				return
+			}
+		}
+		var rel handle.Relation
+		if rel, err = refDB.GetRelationByName(def.RefTable); err != nil {
+			return
+		}
+		fk.RefDB = refDB.GetID()
+		fk.RefTable = rel.ID()
+		refSchema = rel.GetSchema().(*catalog.Schema)
+	}
+	refCol := refSchema.GetColIdx(def.RefColNames[0])
+	if refCol < 0 || !refSchema.IsUniqueColumn(refCol) {
+		return nil, fmt.Errorf("%w: referenced column %s of foreign key %s is not a unique key", catalog.ErrValidation, def.RefColNames[0], def.Name)
+	}
+	if refSchema.ColDefs[refCol].Type.Oid != schema.ColDefs[col].Type.Oid {
+		return nil, fmt.Errorf("%w: columns of foreign key %s are of different types", catalog.ErrValidation, def.Name)
+	}
+	fk.RefColumn = refSchema.ColDefs[refCol].SeqNum
+	return
+}
+
+// compileCheck compiles the SQL text of a CHECK constraint to a predicate
+// over the columns of schema. Only comparisons between columns and constants
+// combined with AND, OR and NOT are supported.
+func compileCheck(schema *catalog.Schema, expr string) (*catalog.CheckExpr, error) {
+	stmt, err := mysql.ParseOne("select " + expr)
+	if err != nil {
+		return nil, err
+	}
+	if sel, ok := stmt.(*tree.Select); ok {
+		if clause, ok := sel.Select.(*tree.SelectClause); ok && len(clause.Exprs) == 1 {
+			return compilePredicate(schema, clause.Exprs[0].Expr)
+		}
+	}
+	return nil, fmt.Errorf("%w: check expression %s", catalog.ErrValidation, expr)
+}
+
+func compilePredicate(schema *catalog.Schema, expr tree.Expr) (pred *catalog.CheckExpr, err error) {
+	logical := func(op catalog.CheckOp, exprs ...tree.Expr) (*catalog.CheckExpr, error) {
+		pred := &catalog.CheckExpr{Op: op}
+		for _, e := range exprs {
+			arg, err := compilePredicate(schema, e)
+			if err != nil {
+				return nil, err
+			}
+			pred.Args = append(pred.Args, arg)
+		}
+		return pred, nil
+	}
+	switch e := expr.(type) {
+	case *tree.ParenExpr:
+		return compilePredicate(schema, e.Expr)
+	case *tree.AndExpr:
+		return logical(catalog.CheckAnd, e.Left, e.Right)
+	case *tree.OrExpr:
+		return logical(catalog.CheckOr, e.Left, e.Right)
+	case *tree.NotExpr:
+		return logical(catalog.CheckNot, e.Expr)
+	case *tree.IsNullExpr, *tree.IsNotNullExpr:
+		op, arg := catalog.CheckIsNull, tree.Expr(nil)
+		if isNull, ok := e.(*tree.IsNullExpr); ok {
+			arg = isNull.Expr
+		} else {
+			op, arg = catalog.CheckIsNotNull, e.(*tree.IsNotNullExpr).Expr
+		}
+		col := compileColumn(schema, arg)
+		if col == nil {
+			break
+		}
+		return &catalog.CheckExpr{Op: op, Args: []*catalog.CheckExpr{col}}, nil
+	case *tree.RangeCond:
+		var ge, le *catalog.CheckExpr
+		if ge, err = compileComparison(schema, catalog.CheckGe, e.Left, e.From); err != nil {
+			return
+		}
+		if le, err = compileComparison(schema, catalog.CheckLe, e.Left, e.To); err != nil {
+			return
+		}
+		pred = &catalog.CheckExpr{Op: catalog.CheckAnd, Args: []*catalog.CheckExpr{ge, le}}
+		if e.Not {
+			pred = &catalog.CheckExpr{Op: catalog.CheckNot, Args: []*catalog.CheckExpr{pred}}
+		}
+		return
+	case *tree.ComparisonExpr:
+		switch e.Op {
+		case tree.EQUAL:
+			return compileComparison(schema, catalog.CheckEq, e.Left, e.Right)
+		case tree.NOT_EQUAL:
+			return compileComparison(schema, catalog.CheckNe, e.Left, e.Right)
+		case tree.LESS_THAN:
+			return compileComparison(schema, catalog.CheckLt, e.Left, e.Right)
+		case tree.LESS_THAN_EQUAL:
+			return compileComparison(schema, catalog.CheckLe, e.Left, e.Right)
+		case tree.GREAT_THAN:
+			return compileComparison(schema, catalog.CheckGt, e.Left, e.Right)
+		case tree.GREAT_THAN_EQUAL:
+			return compileComparison(schema, catalog.CheckGe, e.Left, e.Right)
+		case tree.IN, tree.NOT_IN:
+			col := compileColumn(schema, e.Left)
+			tuple, ok := e.Right.(*tree.Tuple)
+			if col == nil || !ok {
+				break
+			}
+			pred = &catalog.CheckExpr{Op: catalog.CheckIn, Args: []*catalog.CheckExpr{col}}
+			for _, arg := range tuple.Exprs {
+				var c *catalog.CheckExpr
+				if c, err = compileConst(arg, col.Typ); err != nil {
+					return
+				}
+				pred.Args = append(pred.Args, c)
+			}
+			if e.Op == tree.NOT_IN {
+				pred = &catalog.CheckExpr{Op: catalog.CheckNot, Args: []*catalog.CheckExpr{pred}}
+			}
+			return
+		}
+	}
+	return nil, fmt.Errorf("%w: check expression %s not supported", catalog.ErrValidation, tree.String(expr, dialect.MYSQL))
+}
+
+// compileComparison compiles a comparison between two columns of the same
+// type or between a column and a constant of its type.
+func compileComparison(schema *catalog.Schema, op catalog.CheckOp, left, right tree.Expr) (pred *catalog.CheckExpr, err error) {
+	l, r := compileColumn(schema, left), compileColumn(schema, right)
+	switch {
+	case l != nil && r != nil:
+		if l.Typ.Oid != r.Typ.Oid {
+			err = fmt.Errorf("%w: check compares columns of different types", catalog.ErrValidation)
+		}
+	case l != nil:
+		r, err = compileConst(right, l.Typ)
+	case r != nil:
+		l, err = compileConst(left, r.Typ)
+	default:
+		err = fmt.Errorf("%w: check compares no column", catalog.ErrValidation)
+	}
+	if err != nil {
+		return
+	}
+	return &catalog.CheckExpr{Op: op, Args: []*catalog.CheckExpr{l, r}}, nil
+}
+
+func compileColumn(schema *catalog.Schema, expr tree.Expr) *catalog.CheckExpr {
+	for {
+		paren, ok := expr.(*tree.ParenExpr)
+		if !ok {
+			break
+		}
+		expr = paren.Expr
+	}
+	name, ok := expr.(*tree.UnresolvedName)
+	if !ok || name.Star {
+		return nil
+	}
+	idx := schema.GetColIdx(name.Parts[0])
+	if idx < 0 {
+		return nil
+	}
+	colDef := schema.ColDefs[idx]
+	return &catalog.CheckExpr{Op: catalog.CheckColumn, SeqNum: colDef.SeqNum, Typ: colDef.Type}
+}
+
+// compileConst casts a literal to typ, it fails if the value is out of the
+// range of typ.
+func compileConst(expr tree.Expr, typ types.Type) (*catalog.CheckExpr, error) {
+	negative := false
+	for {
+		if paren, ok := expr.(*tree.ParenExpr); ok {
+			expr = paren.Expr
+		} else if unary, ok := expr.(*tree.UnaryExpr); ok && unary.Op == tree.UNARY_MINUS {
+			negative = !negative
+			expr = unary.Expr
+		} else {
+			break
+		}
+	}
+	num, ok := expr.(*tree.NumVal)
+	if !ok {
+		return nil, fmt.Errorf("%w: check operand %s is not a constant", catalog.ErrValidation, tree.String(expr, dialect.MYSQL))
+	}
+	pred := &catalog.CheckExpr{Op: catalog.CheckConst, Typ: typ}
+	var v interface{}
+	switch num.Value.Kind() {
+	case constant.Unknown:
+		return pred, nil
+	case constant.Int:
+		if i, exact := constant.Int64Val(num.Value); exact {
+			if negative {
+				i = -i
+			}
+			v = castInt(i, typ)
+		} else if u, exact := constant.Uint64Val(num.Value); exact && !negative && typ.Oid == types.T_uint64 {
+			v = u
+		}
+	case constant.Float:
+		f, _ := constant.Float64Val(num.Value)
+		if negative {
+			f = -f
+		}
+		if !math.IsInf(f, 0) {
+			v = castFloat(f, typ)
+		}
+	case constant.String:
+		if !negative && (typ.Oid == types.T_char || typ.Oid == types.T_varchar) {
+			v = []byte(constant.StringVal(num.Value))
+		}
+	}
+	if v == nil {
+		return nil, fmt.Errorf("%w: check operand %s is not of type %s", catalog.ErrValidation, tree.String(expr, dialect.MYSQL), typ.String())
+	}
+	var err error
+	pred.Val, err = common.EncodeKey(v, typ)
+	return pred, err
+}
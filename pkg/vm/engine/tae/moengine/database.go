@@ -18,15 +18,18 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/common/helper"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/checkpoint"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
 )
 
 var (
 	_ engine.Database = (*txnDatabase)(nil)
 )
 
-func newDatabase(h handle.Database) *txnDatabase {
+func newDatabase(txn txnif.AsyncTxn, h handle.Database) *txnDatabase {
 	return &txnDatabase{
+		txn:    txn,
 		handle: h,
 	}
 }
@@ -55,6 +58,27 @@ func (db *txnDatabase) Create(_ uint64, name string, defs []engine.TableDef, _ e
 		return err
 	}
 	schema := TableInfoToSchema(&info)
+	if err = db.buildConstraints(schema, &info); err != nil {
+		return err
+	}
+	if _, err = checkpoint.NewMergePolicy(schema.MergePolicy); err != nil {
+		return err
+	}
+	// a bad TTL fails the creation, unlike the properties TableInfoToSchema sets
+	for _, property := range info.Properties {
+		if property.Key == engine.TTLProperty {
+			if err = schema.SetTTL(property.Value); err != nil {
+				return err
+			}
+		}
+	}
+	for _, def := range defs {
+		if partition, ok := def.(*engine.PartitionByDef); ok {
+			if err = setPartition(schema, partition); err != nil {
+				return err
+			}
+		}
+	}
 	schema.BlockMaxRows = 40000
 	schema.SegmentMaxBlocks = 20
 	_, err = db.handle.CreateRelation(schema)
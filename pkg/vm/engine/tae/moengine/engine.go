@@ -16,6 +16,7 @@ package moengine
 
 import (
 	"runtime"
+	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
@@ -23,7 +24,10 @@ import (
 )
 
 var (
-	_ engine.Engine = (*txnEngine)(nil)
+	_ engine.Engine         = (*txnEngine)(nil)
+	_ engine.SnapshotEngine = (*txnEngine)(nil)
+	_ engine.BackupEngine   = (*txnEngine)(nil)
+	_ engine.CompactEngine  = (*txnEngine)(nil)
 )
 
 func NewEngine(impl *db.DB) *txnEngine {
@@ -68,7 +72,7 @@ func (e *txnEngine) Database(name string, ctx engine.Snapshot) (db engine.Databa
 	if err != nil {
 		return nil, err
 	}
-	db = newDatabase(h)
+	db = newDatabase(txn, h)
 	return db, err
 }
 
@@ -79,3 +83,32 @@ func (e *txnEngine) Node(ip string, _ engine.Snapshot) *engine.NodeInfo {
 func (e *txnEngine) StartTxn(info []byte) (txn Txn, err error) {
 	return e.impl.StartTxn(info)
 }
+
+func (e *txnEngine) StartTxnWithIsolation(info []byte, level IsolationLevel) (txn Txn, err error) {
+	return e.impl.StartTxnWithIsolation(info, level)
+}
+
+func (e *txnEngine) SnapshotAt(ts time.Time) (engine.Snapshot, error) {
+	txn, err := e.impl.StartTxnAt(nil, ts)
+	if err != nil {
+		return nil, err
+	}
+	return txn.GetCtx(), nil
+}
+
+func (e *txnEngine) ReleaseSnapshot(ctx engine.Snapshot) error {
+	txn, err := e.impl.GetTxnByCtx(ctx)
+	if err != nil {
+		return err
+	}
+	return txn.Commit()
+}
+
+func (e *txnEngine) Backup(dir, base string) error {
+	_, err := e.impl.Backup(dir, base)
+	return err
+}
+
+func (e *txnEngine) Compact(dbName, tableName string) error {
+	return e.impl.Compact(dbName, tableName)
+}
@@ -5,7 +5,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/common/helper"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/adaptor"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/buffer"
@@ -16,7 +20,9 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
 	idxCommon "github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables/jobs"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnbase"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -78,3 +84,502 @@ func TestEngine(t *testing.T) {
 	}
 	t.Log(tae.Catalog.SimplePPString(common.PPL1))
 }
+
+func TestIndex(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	e := NewEngine(tae)
+	txn, err := e.StartTxn(nil)
+	assert.Nil(t, err)
+	err = e.Create(0, "db", 0, txn.GetCtx())
+	assert.Nil(t, err)
+	dbase, err := e.Database("db", txn.GetCtx())
+	assert.Nil(t, err)
+	mockTbl := adaptor.MockTableInfo(10)
+	_, _, _, _, defs, _ := helper.UnTransfer(*mockTbl)
+	err = dbase.Create(0, mockTbl.Name, defs, txn.GetCtx())
+	assert.Nil(t, err)
+	assert.Nil(t, txn.Commit())
+
+	txn, err = e.StartTxn(nil)
+	assert.Nil(t, err)
+	dbase, err = e.Database("db", txn.GetCtx())
+	assert.Nil(t, err)
+	rel, err := dbase.Relation(mockTbl.Name, txn.GetCtx())
+	assert.Nil(t, err)
+	def := &engine.IndexTableDef{Typ: engine.ZoneMap, Name: "idx", ColNames: []string{"mock_2"}}
+	err = rel.AddTableDef(0, def, txn.GetCtx())
+	assert.Nil(t, err)
+	err = rel.AddTableDef(0, &engine.IndexTableDef{Typ: engine.ZoneMap, Name: "idx1", ColNames: []string{"xxx"}}, txn.GetCtx())
+	assert.ErrorIs(t, err, catalog.ErrNotFound)
+	err = rel.AddTableDef(0, &engine.CommentDef{Comment: "comment"}, txn.GetCtx())
+	assert.ErrorIs(t, err, ErrTableDefNotSupported)
+	assert.Nil(t, txn.Commit())
+
+	txn, err = e.StartTxn(nil)
+	assert.Nil(t, err)
+	dbase, err = e.Database("db", txn.GetCtx())
+	assert.Nil(t, err)
+	rel, err = dbase.Relation(mockTbl.Name, txn.GetCtx())
+	assert.Nil(t, err)
+	found := false
+	for _, def := range rel.TableDefs(txn.GetCtx()) {
+		if indexDef, ok := def.(*engine.IndexTableDef); ok && indexDef.Name == "idx" {
+			assert.Equal(t, []string{"mock_2"}, indexDef.ColNames)
+			found = true
+		}
+	}
+	assert.True(t, found)
+
+	// mock_2 = 10 and 20 > mock_2 and mock_3 = 1
+	value := func(v int64) *extend.ValueExtend {
+		vec := vector.New(types.Type{Oid: types.T_int64, Size: 8})
+		assert.Nil(t, vector.Append(vec, []int64{v}))
+		return &extend.ValueExtend{V: vec}
+	}
+	cond := &extend.BinaryExtend{
+		Op: overload.And,
+		Left: &extend.BinaryExtend{
+			Op:    overload.EQ,
+			Left:  &extend.Attribute{Name: "mock_2", Type: types.T_int32},
+			Right: value(10),
+		},
+		Right: &extend.BinaryExtend{
+			Op: overload.And,
+			Left: &extend.BinaryExtend{
+				Op:    overload.GT,
+				Left:  value(20),
+				Right: &extend.Attribute{Name: "mock_2", Type: types.T_int32},
+			},
+			Right: &extend.BinaryExtend{
+				Op:    overload.EQ,
+				Left:  &extend.Attribute{Name: "mock_3", Type: types.T_int32},
+				Right: value(1),
+			},
+		},
+	}
+	filters := getBlockFilters(rel.(*txnRelation).handle.GetSchema().(*catalog.Schema), cond)
+	assert.Equal(t, 3, len(filters))
+	assert.Equal(t, []interface{}{int32(10)}, filters[0].keys)
+	assert.Nil(t, filters[1].min)
+	assert.Equal(t, int32(20), filters[1].max)
+	assert.Equal(t, []interface{}{int32(1)}, filters[2].keys)
+	for _, reader := range rel.NewReader(2, cond, nil, nil) {
+		assert.Equal(t, 3, len(reader.(*txnReader).filters))
+	}
+
+	err = rel.DelTableDef(0, def, txn.GetCtx())
+	assert.Nil(t, err)
+	assert.Nil(t, txn.Commit())
+}
+
+func TestPruneBlocks(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	schema := catalog.MockSchema(3)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	schema.PrimaryKey = 0
+	bat := compute.MockBatch(schema.Types(), 40, int(schema.PrimaryKey), nil)
+	{
+		txn, _ := tae.StartTxn(nil)
+		database, _ := txn.CreateDatabase("db")
+		rel, _ := database.CreateRelation(schema)
+		assert.Nil(t, rel.Append(bat))
+		assert.Nil(t, txn.Commit())
+	}
+	{
+		txn, _ := tae.StartTxn(nil)
+		database, _ := txn.GetDatabase("db")
+		rel, _ := database.GetRelationByName(schema.Name)
+		var blks []*catalog.BlockEntry
+		it := rel.MakeBlockIt()
+		for it.Valid() {
+			blks = append(blks, it.GetBlock().GetMeta().(*catalog.BlockEntry))
+			it.Next()
+		}
+		task, err := jobs.CompactSegmentTaskFactory(blks, tae.Scheduler)(nil, txn)
+		assert.Nil(t, err)
+		assert.Nil(t, task.OnExec())
+		assert.Nil(t, txn.Commit())
+	}
+
+	e := NewEngine(tae)
+	txn, err := e.StartTxn(nil)
+	assert.Nil(t, err)
+	dbase, err := e.Database("db", txn.GetCtx())
+	assert.Nil(t, err)
+	rel, err := dbase.Relation(schema.Name, txn.GetCtx())
+	assert.Nil(t, err)
+	value := func(v int64) *extend.ValueExtend {
+		vec := vector.New(types.Type{Oid: types.T_int64, Size: 8})
+		assert.Nil(t, vector.Append(vec, []int64{v}))
+		return &extend.ValueExtend{V: vec}
+	}
+	pk := &extend.Attribute{Name: "mock_0", Type: types.T_int32}
+	scan := func(cond extend.Extend) (rows int, blocks, pruned int64) {
+		for _, reader := range rel.NewReader(2, cond, nil, nil) {
+			for {
+				bat, err := reader.Read([]uint64{1}, []string{"mock_0"})
+				assert.Nil(t, err)
+				if bat == nil {
+					break
+				}
+				rows += vector.Length(bat.Vecs[0])
+			}
+			b, p := reader.(engine.PruningReader).PruneStats()
+			blocks += b
+			pruned += p
+		}
+		return
+	}
+
+	rows, blocks, pruned := scan(nil)
+	assert.Equal(t, 40, rows)
+	assert.Equal(t, int64(0), pruned)
+	total := blocks
+
+	// 35 <= mock_0
+	rows, blocks, pruned = scan(&extend.BinaryExtend{Op: overload.LE, Left: value(35), Right: pk})
+	assert.Equal(t, 10, rows)
+	assert.Equal(t, total, blocks)
+	assert.Equal(t, total-1, pruned)
+
+	// mock_0 > 12 and mock_0 < 18
+	rows, _, pruned = scan(&extend.BinaryExtend{
+		Op:    overload.And,
+		Left:  &extend.BinaryExtend{Op: overload.GT, Left: pk, Right: value(12)},
+		Right: &extend.BinaryExtend{Op: overload.LT, Left: pk, Right: value(18)},
+	})
+	assert.Equal(t, 10, rows)
+	assert.Equal(t, total-1, pruned)
+
+	// mock_0 in (5, 25, 100)
+	rows, _, pruned = scan(&extend.FuncExtend{Name: "in", Args: []extend.Extend{pk, value(5), value(25), value(100)}})
+	assert.Equal(t, 20, rows)
+	assert.Equal(t, total-2, pruned)
+
+	// mock_1 is null
+	rows, _, pruned = scan(&extend.FuncExtend{Name: "isnull", Args: []extend.Extend{&extend.Attribute{Name: "mock_1", Type: types.T_int32}}})
+	assert.Equal(t, 0, rows)
+	assert.Equal(t, total, pruned)
+	assert.Nil(t, txn.Commit())
+}
+
+func TestLockingReader(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	schema := catalog.MockSchema(3)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	schema.PrimaryKey = 0
+	bat := compute.MockBatch(schema.Types(), 20, int(schema.PrimaryKey), nil)
+	{
+		txn, _ := tae.StartTxn(nil)
+		database, _ := txn.CreateDatabase("db")
+		rel, _ := database.CreateRelation(schema)
+		assert.Nil(t, rel.Append(bat))
+		assert.Nil(t, txn.Commit())
+	}
+	{
+		// compact the blocks to build the zone maps for the pruning
+		txn, _ := tae.StartTxn(nil)
+		database, _ := txn.GetDatabase("db")
+		rel, _ := database.GetRelationByName(schema.Name)
+		var blks []*catalog.BlockEntry
+		it := rel.MakeBlockIt()
+		for it.Valid() {
+			blks = append(blks, it.GetBlock().GetMeta().(*catalog.BlockEntry))
+			it.Next()
+		}
+		task, err := jobs.CompactSegmentTaskFactory(blks, tae.Scheduler)(nil, txn)
+		assert.Nil(t, err)
+		assert.Nil(t, task.OnExec())
+		assert.Nil(t, txn.Commit())
+	}
+
+	e := NewEngine(tae)
+	value := func(v int64) *extend.ValueExtend {
+		vec := vector.New(types.Type{Oid: types.T_int64, Size: 8})
+		assert.Nil(t, vector.Append(vec, []int64{v}))
+		return &extend.ValueExtend{V: vec}
+	}
+	pk := &extend.Attribute{Name: "mock_0", Type: types.T_int32}
+	scan := func(txn Txn, cond extend.Extend, mode engine.RowLockMode, wait engine.RowLockWait) (rows int, err error) {
+		dbase, err := e.Database("db", txn.GetCtx())
+		assert.Nil(t, err)
+		rel, err := dbase.Relation(schema.Name, txn.GetCtx())
+		assert.Nil(t, err)
+		reader := rel.NewReader(1, cond, nil, nil)[0]
+		reader.(engine.LockingReader).SetRowLock(mode, wait)
+		for {
+			bat, err := reader.Read([]uint64{1}, []string{"mock_0"})
+			if err != nil || bat == nil {
+				return rows, err
+			}
+			rows += vector.Length(bat.Vecs[0])
+		}
+	}
+
+	// the rows of the first block are locked
+	txn1, _ := e.StartTxn(nil)
+	rows, err := scan(txn1, &extend.BinaryExtend{Op: overload.LT, Left: pk, Right: value(5)}, engine.RowLockUpdate, engine.RowLockWaitDefault)
+	assert.Nil(t, err)
+	assert.Equal(t, 10, rows)
+
+	txn2, _ := e.StartTxn(nil)
+	rows, err = scan(txn2, nil, engine.RowLockUpdate, engine.RowLockSkipLocked)
+	assert.Nil(t, err)
+	assert.Equal(t, 10, rows)
+	_, err = scan(txn2, nil, engine.RowLockShare, engine.RowLockNoWait)
+	assert.ErrorIs(t, err, txnbase.ErrLockNotAvailable)
+	assert.Nil(t, txn2.Rollback())
+
+	assert.Nil(t, txn1.Commit())
+	txn3, _ := e.StartTxn(nil)
+	rows, err = scan(txn3, nil, engine.RowLockShare, engine.RowLockNoWait)
+	assert.Nil(t, err)
+	assert.Equal(t, 20, rows)
+	assert.Nil(t, txn3.Commit())
+}
+
+func TestCompact(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	e := NewEngine(tae)
+	txn, err := e.StartTxn(nil)
+	assert.Nil(t, err)
+	assert.Nil(t, e.Create(0, "db", 0, txn.GetCtx()))
+	dbase, err := e.Database("db", txn.GetCtx())
+	assert.Nil(t, err)
+
+	mockTbl := adaptor.MockTableInfo(3)
+	mockTbl.Name = "xx"
+	_, _, _, _, defs, _ := helper.UnTransfer(*mockTbl)
+	policy := func(spec string) []engine.TableDef {
+		return append(defs, &engine.PropertiesDef{
+			Properties: []engine.Property{{Key: engine.MergePolicyProperty, Value: spec}},
+		})
+	}
+	assert.NotNil(t, dbase.Create(0, mockTbl.Name, policy("deletes:2"), txn.GetCtx()))
+	assert.Nil(t, dbase.Create(0, mockTbl.Name, policy("deletes:0.2"), txn.GetCtx()))
+	rel, err := dbase.Relation(mockTbl.Name, txn.GetCtx())
+	assert.Nil(t, err)
+	meta := rel.(*txnRelation).handle.GetMeta().(*catalog.TableEntry)
+	assert.Equal(t, "deletes:0.2", meta.GetSchema().MergePolicy)
+	bat := compute.MockBatch(meta.GetSchema().Types(), 100, int(meta.GetSchema().PrimaryKey), nil)
+	assert.Nil(t, rel.Write(0, bat, txn.GetCtx()))
+	assert.Nil(t, txn.Commit())
+
+	assert.Nil(t, e.Compact("db", mockTbl.Name))
+	assert.NotNil(t, e.Compact("db", "missing"))
+}
+
+func TestTTLRead(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(12)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	schema.PrimaryKey = 3
+	assert.Nil(t, schema.SetTTL("mock_10:30d"))
+	// row i is dated 4*i days ago, the rows from 8 on are expired
+	now := time.Now()
+	dates := make([]types.Date, 20)
+	for i := range dates {
+		d := now.AddDate(0, 0, -4*i)
+		dates[i] = types.FromCalendar(int32(d.Year()), uint8(d.Month()), uint8(d.Day()))
+	}
+	provider := compute.NewMockDataProvider()
+	vec := vector.New(schema.ColDefs[10].Type)
+	assert.Nil(t, vector.Append(vec, dates))
+	provider.AddColumnProvider(10, vec)
+	bat := compute.MockBatch(schema.Types(), 20, int(schema.PrimaryKey), provider)
+	{
+		txn, _ := tae.StartTxn(nil)
+		database, _ := txn.CreateDatabase("db")
+		rel, _ := database.CreateRelation(schema)
+		assert.Nil(t, rel.Append(bat))
+		assert.Nil(t, txn.Commit())
+	}
+
+	e := NewEngine(tae)
+	scan := func(mode engine.RowLockMode) (rows int, pruned int64) {
+		txn, _ := e.StartTxn(nil)
+		dbase, err := e.Database("db", txn.GetCtx())
+		assert.Nil(t, err)
+		rel, err := dbase.Relation(schema.Name, txn.GetCtx())
+		assert.Nil(t, err)
+		reader := rel.NewReader(1, nil, nil, nil)[0]
+		reader.(engine.LockingReader).SetRowLock(mode, engine.RowLockWaitDefault)
+		for {
+			bat, err := reader.Read([]uint64{1}, []string{"mock_3"})
+			assert.Nil(t, err)
+			if bat == nil {
+				break
+			}
+			rows += vector.Length(bat.Vecs[0])
+		}
+		assert.Nil(t, txn.Commit())
+		_, pruned = reader.(engine.PruningReader).PruneStats()
+		return
+	}
+	rows, pruned := scan(engine.RowLockNone)
+	assert.Equal(t, 8, rows)
+	assert.Equal(t, int64(0), pruned)
+
+	{
+		// the fully expired block is skipped by its zone map once compacted
+		txn, _ := tae.StartTxn(nil)
+		database, _ := txn.GetDatabase("db")
+		rel, _ := database.GetRelationByName(schema.Name)
+		var blks []*catalog.BlockEntry
+		it := rel.MakeBlockIt()
+		for it.Valid() {
+			blks = append(blks, it.GetBlock().GetMeta().(*catalog.BlockEntry))
+			it.Next()
+		}
+		task, err := jobs.CompactSegmentTaskFactory(blks, tae.Scheduler)(nil, txn)
+		assert.Nil(t, err)
+		assert.Nil(t, task.OnExec())
+		assert.Nil(t, txn.Commit())
+	}
+	rows, pruned = scan(engine.RowLockNone)
+	assert.Equal(t, 8, rows)
+	assert.Equal(t, int64(1), pruned)
+	rows, _ = scan(engine.RowLockShare)
+	assert.Equal(t, 8, rows)
+}
+
+func TestTTLProperty(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	e := NewEngine(tae)
+	txn, err := e.StartTxn(nil)
+	assert.Nil(t, err)
+	assert.Nil(t, e.Create(0, "db", 0, txn.GetCtx()))
+	dbase, err := e.Database("db", txn.GetCtx())
+	assert.Nil(t, err)
+
+	mockTbl := adaptor.MockTableInfo(3)
+	mockTbl.Name = "xx"
+	_, _, _, _, defs, _ := helper.UnTransfer(*mockTbl)
+	ttl := func(spec string) []engine.TableDef {
+		return append(defs, &engine.PropertiesDef{
+			Properties: []engine.Property{{Key: engine.TTLProperty, Value: spec}},
+		})
+	}
+	assert.ErrorIs(t, dbase.Create(0, mockTbl.Name, ttl("mock_0:0d"), txn.GetCtx()), catalog.ErrValidation)
+	assert.ErrorIs(t, dbase.Create(0, mockTbl.Name, ttl("missing:90d"), txn.GetCtx()), catalog.ErrNotFound)
+	// the mock columns are not dates
+	assert.ErrorIs(t, dbase.Create(0, mockTbl.Name, ttl(mockTbl.Columns[0].Name+":90d"), txn.GetCtx()), catalog.ErrValidation)
+	assert.Nil(t, txn.Rollback())
+}
+
+func TestPartition(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	e := NewEngine(tae)
+	txn, err := e.StartTxn(nil)
+	assert.Nil(t, err)
+	assert.Nil(t, e.Create(0, "db", 0, txn.GetCtx()))
+	dbase, err := e.Database("db", txn.GetCtx())
+	assert.Nil(t, err)
+
+	mockTbl := adaptor.MockTableInfo(3)
+	mockTbl.Name = "xx"
+	_, _, _, _, defs, _ := helper.UnTransfer(*mockTbl)
+	value := func(v int64) extend.Extend {
+		vec := vector.New(types.Type{Oid: types.T_int64, Size: 8})
+		assert.Nil(t, vector.Append(vec, []int64{v}))
+		return &extend.ValueExtend{V: vec}
+	}
+	partition := func(def *engine.PartitionByDef) []engine.TableDef {
+		return append(defs[:len(defs):len(defs)], def)
+	}
+	assert.ErrorIs(t, dbase.Create(0, mockTbl.Name, partition(&engine.PartitionByDef{
+		Fields: []string{"missing"},
+		Hash:   4,
+	}), txn.GetCtx()), catalog.ErrNotFound)
+	// a varchar column is not hash partitioned
+	assert.ErrorIs(t, dbase.Create(0, mockTbl.Name, partition(&engine.PartitionByDef{
+		Fields: []string{"mock_1"},
+		Hash:   4,
+	}), txn.GetCtx()), catalog.ErrValidation)
+	assert.Nil(t, dbase.Create(0, mockTbl.Name, partition(&engine.PartitionByDef{
+		Fields: []string{"mock_0"},
+		Range: []engine.RangePartition{
+			{Name: "p0", To: []extend.Extend{value(50)}},
+			{Name: "p1"},
+		},
+	}), txn.GetCtx()))
+	rel, err := dbase.Relation(mockTbl.Name, txn.GetCtx())
+	assert.Nil(t, err)
+	schema := rel.(*txnRelation).handle.GetSchema().(*catalog.Schema)
+	bat := compute.MockBatch(schema.Types(), 100, int(schema.PrimaryKey), nil)
+	assert.Nil(t, rel.Write(0, bat, txn.GetCtx()))
+	assert.Nil(t, txn.Commit())
+
+	scan := func(partitions []string) (rows int, pruned int64) {
+		txn, _ := e.StartTxn(nil)
+		dbase, err := e.Database("db", txn.GetCtx())
+		assert.Nil(t, err)
+		rel, err := dbase.Relation(mockTbl.Name, txn.GetCtx())
+		assert.Nil(t, err)
+		reader := rel.NewReader(1, nil, nil, nil)[0]
+		if partitions != nil {
+			reader.(engine.PartitionReader).SetPartitions(partitions)
+		}
+		for {
+			bat, err := reader.Read([]uint64{1}, []string{"mock_0"})
+			assert.Nil(t, err)
+			if bat == nil {
+				break
+			}
+			rows += vector.Length(bat.Vecs[0])
+		}
+		assert.Nil(t, txn.Commit())
+		_, pruned = reader.(engine.PruningReader).PruneStats()
+		return
+	}
+	rows, pruned := scan(nil)
+	assert.Equal(t, 100, rows)
+	assert.Equal(t, int64(0), pruned)
+	rows, pruned = scan([]string{"p0"})
+	assert.Equal(t, 50, rows)
+	assert.Equal(t, int64(1), pruned)
+
+	txn, _ = e.StartTxn(nil)
+	dbase, _ = e.Database("db", txn.GetCtx())
+	rel, _ = dbase.Relation(mockTbl.Name, txn.GetCtx())
+	prel := rel.(engine.PartitionRelation)
+	assert.ErrorIs(t, prel.TruncatePartitions(0, []string{"missing"}, txn.GetCtx()), catalog.ErrNotFound)
+	assert.Nil(t, prel.TruncatePartitions(0, []string{"p1"}, txn.GetCtx()))
+	assert.Nil(t, txn.Commit())
+	rows, _ = scan(nil)
+	assert.Equal(t, 50, rows)
+
+	txn, _ = e.StartTxn(nil)
+	dbase, _ = e.Database("db", txn.GetCtx())
+	rel, _ = dbase.Relation(mockTbl.Name, txn.GetCtx())
+	prel = rel.(engine.PartitionRelation)
+	assert.Nil(t, prel.DropPartitions(0, []string{"p0"}, txn.GetCtx()))
+	assert.Nil(t, txn.Commit())
+	rows, _ = scan(nil)
+	assert.Equal(t, 0, rows)
+
+	txn, _ = e.StartTxn(nil)
+	dbase, _ = e.Database("db", txn.GetCtx())
+	rel, _ = dbase.Relation(mockTbl.Name, txn.GetCtx())
+	var def *engine.PartitionByDef
+	for _, tdef := range rel.TableDefs(txn.GetCtx()) {
+		if d, ok := tdef.(*engine.PartitionByDef); ok {
+			def = d
+		}
+	}
+	assert.NotNil(t, def)
+	assert.Equal(t, []string{"mock_0"}, def.Fields)
+	assert.Equal(t, 1, len(def.Range))
+	assert.Equal(t, "p1", def.Range[0].Name)
+	assert.Nil(t, txn.Commit())
+}
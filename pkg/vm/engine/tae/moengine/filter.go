@@ -0,0 +1,420 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package moengine
+
+import (
+	"math"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/basic"
+)
+
+// blockFilter is a predicate on a column used to skip the blocks and the
+// segments which can not have a row satisfying it. It is either col = key
+// for any of keys, a range predicate min <= col <= max where a nil bound is
+// unbounded, or col is null. Strict comparisons are widened to inclusive
+// ones, the filter only decides what can be skipped. The column is
+// identified by its sequence number, because its position and type in a
+// block written before an alter can differ from the current schema.
+type blockFilter struct {
+	seqNum uint16
+	typ    types.Type
+	keys   []interface{}
+	min    interface{}
+	max    interface{}
+	isNull bool
+}
+
+// getBlockFilters returns the filters of the conjuncts of e which compare a
+// column of schema with constants, test it against a list of constants with
+// in, or test if it is null. Only the columns with a zonemap are used.
+func getBlockFilters(schema *catalog.Schema, e extend.Extend) []*blockFilter {
+	if e == nil {
+		return nil
+	}
+	indexed := make(map[int]bool)
+	for _, colIdx := range schema.IndexedColumns() {
+		indexed[colIdx] = true
+	}
+	newFilter := func(attr *extend.Attribute) *blockFilter {
+		colIdx := schema.GetColIdx(attr.Name)
+		if colIdx < 0 {
+			return nil
+		}
+		colDef := schema.ColDefs[colIdx]
+		if !indexed[colIdx] && !basic.ZoneMapSupported(colDef.Type) {
+			return nil
+		}
+		return &blockFilter{seqNum: colDef.SeqNum, typ: colDef.Type}
+	}
+	var filters []*blockFilter
+	for _, conjunct := range splitConjuncts(e, nil) {
+		var filter *blockFilter
+		switch v := conjunct.(type) {
+		case *extend.BinaryExtend:
+			filter = getCompareFilter(v, newFilter)
+		case *extend.FuncExtend:
+			filter = getFuncFilter(v, newFilter)
+		}
+		if filter != nil {
+			filters = append(filters, filter)
+		}
+	}
+	return filters
+}
+
+// getCompareFilter returns the filter of col op const or const op col.
+func getCompareFilter(be *extend.BinaryExtend, newFilter func(*extend.Attribute) *blockFilter) *blockFilter {
+	op := be.Op
+	attr, ok := be.Left.(*extend.Attribute)
+	val, isVal := be.Right.(*extend.ValueExtend)
+	if !ok || !isVal {
+		// const op col is col reversed(op) const
+		if attr, ok = be.Right.(*extend.Attribute); !ok {
+			return nil
+		}
+		if val, ok = be.Left.(*extend.ValueExtend); !ok {
+			return nil
+		}
+		op = reverseCompareOp(op)
+	}
+	filter := newFilter(attr)
+	if filter == nil {
+		return nil
+	}
+	v := castToColumnType(val.V, filter.typ)
+	if v == nil {
+		return nil
+	}
+	switch op {
+	case overload.EQ:
+		filter.keys = []interface{}{v}
+	case overload.LT, overload.LE:
+		filter.max = v
+	case overload.GT, overload.GE:
+		filter.min = v
+	default:
+		return nil
+	}
+	return filter
+}
+
+// getFuncFilter returns the filter of in(col, const...) or isnull(col).
+func getFuncFilter(fe *extend.FuncExtend, newFilter func(*extend.Attribute) *blockFilter) *blockFilter {
+	if len(fe.Args) == 0 {
+		return nil
+	}
+	attr, ok := fe.Args[0].(*extend.Attribute)
+	if !ok {
+		return nil
+	}
+	switch fe.Name {
+	case "in":
+		if len(fe.Args) == 1 {
+			return nil
+		}
+		filter := newFilter(attr)
+		if filter == nil {
+			return nil
+		}
+		for _, arg := range fe.Args[1:] {
+			val, ok := arg.(*extend.ValueExtend)
+			if !ok {
+				return nil
+			}
+			v := castToColumnType(val.V, filter.typ)
+			if v == nil {
+				return nil
+			}
+			filter.keys = append(filter.keys, v)
+		}
+		return filter
+	case "isnull":
+		if len(fe.Args) != 1 {
+			return nil
+		}
+		filter := newFilter(attr)
+		if filter == nil {
+			return nil
+		}
+		filter.isNull = true
+		return filter
+	}
+	return nil
+}
+
+// mayMatch returns false if the indexes of the block prove that no row of it
+// satisfies all the filters. A filter on a column the block has no values of
+// the same type for is skipped.
+func mayMatch(meta *catalog.BlockEntry, filters []*blockFilter) bool {
+	blk := meta.GetBlockData()
+	schema := meta.GetSchema()
+	for _, filter := range filters {
+		colIdx := schema.GetColIdxBySeqNum(filter.seqNum)
+		if colIdx < 0 || schema.ColDefs[colIdx].Type.Oid != filter.typ.Oid {
+			continue
+		}
+		switch {
+		case filter.isNull:
+			if !blk.MayContainsNullOnColumn(uint16(colIdx)) {
+				return false
+			}
+		case filter.keys != nil:
+			found := false
+			for _, key := range filter.keys {
+				if blk.MayContainsKeyOnColumn(uint16(colIdx), key) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		default:
+			if !blk.MayContainsRangeOnColumn(uint16(colIdx), filter.min, filter.max) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// segmentMayMatch returns false if the zonemaps of all the blocks of the
+// segment prove that no row of it satisfies all the filters.
+func segmentMayMatch(seg *catalog.SegmentEntry, filters []*blockFilter) bool {
+	segData := seg.GetSegmentData()
+	if segData == nil {
+		return true
+	}
+	for _, filter := range filters {
+		zm := segData.GetColumnZoneMap(filter.seqNum)
+		if zm == nil || zm.GetType().Oid != filter.typ.Oid {
+			continue
+		}
+		if !zoneMapMayMatch(zm, filter) {
+			return false
+		}
+	}
+	return true
+}
+
+func zoneMapMayMatch(zm *basic.ZoneMap, filter *blockFilter) bool {
+	switch {
+	case filter.isNull:
+		return zm.MayContainsNull()
+	case filter.keys != nil:
+		for _, key := range filter.keys {
+			// a zonemap which can not be queried never excludes the key
+			if exist, err := zm.MayContainsKey(key); err != nil || exist {
+				return true
+			}
+		}
+		return false
+	}
+	exist, err := zm.MayContainsRange(filter.min, filter.max)
+	return err != nil || exist
+}
+
+// segmentPruner caches which segments can be skipped for the filters, it is
+// shared by the readers of a relation.
+type segmentPruner struct {
+	sync.Mutex
+	filters  []*blockFilter
+	segments map[uint64]bool
+}
+
+func newSegmentPruner(filters []*blockFilter) *segmentPruner {
+	return &segmentPruner{
+		filters:  filters,
+		segments: make(map[uint64]bool),
+	}
+}
+
+func (p *segmentPruner) mayMatch(seg *catalog.SegmentEntry) bool {
+	p.Lock()
+	defer p.Unlock()
+	match, ok := p.segments[seg.GetID()]
+	if !ok {
+		match = segmentMayMatch(seg, p.filters)
+		p.segments[seg.GetID()] = match
+	}
+	return match
+}
+
+func splitConjuncts(e extend.Extend, es []extend.Extend) []extend.Extend {
+	switch v := e.(type) {
+	case *extend.ParenExtend:
+		return splitConjuncts(v.E, es)
+	case *extend.BinaryExtend:
+		if v.Op == overload.And {
+			es = splitConjuncts(v.Left, es)
+			return splitConjuncts(v.Right, es)
+		}
+	}
+	return append(es, e)
+}
+
+func reverseCompareOp(op int) int {
+	switch op {
+	case overload.LT:
+		return overload.GT
+	case overload.LE:
+		return overload.GE
+	case overload.GT:
+		return overload.LT
+	case overload.GE:
+		return overload.LE
+	}
+	return op
+}
+
+// castToColumnType converts the constant in vec to the go type of the values
+// of a column of type typ, it returns nil if the conversion is not exact.
+func castToColumnType(vec *vector.Vector, typ types.Type) interface{} {
+	if vec == nil || vector.Length(vec) != 1 || nulls.Contains(vec.Nsp, 0) {
+		return nil
+	}
+	switch vec.Typ.Oid {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64:
+		return castInt(toInt64(vec), typ)
+	case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
+		v := toUint64(vec)
+		if v > math.MaxInt64 {
+			if typ.Oid == types.T_uint64 {
+				return v
+			}
+			return nil
+		}
+		return castInt(int64(v), typ)
+	case types.T_float32, types.T_float64:
+		var v float64
+		if vec.Typ.Oid == types.T_float32 {
+			v = float64(vec.Col.([]float32)[0])
+		} else {
+			v = vec.Col.([]float64)[0]
+		}
+		switch typ.Oid {
+		case types.T_float32:
+			if float64(float32(v)) == v {
+				return float32(v)
+			}
+			return nil
+		case types.T_float64:
+			return v
+		}
+		if v != math.Trunc(v) || v < math.MinInt64 || v >= math.MaxInt64 {
+			return nil
+		}
+		return castInt(int64(v), typ)
+	case types.T_char, types.T_varchar:
+		str := vec.Col.(*types.Bytes).Get(0)
+		switch typ.Oid {
+		case types.T_char, types.T_varchar:
+			return str
+		case types.T_date:
+			if v, err := types.ParseDate(string(str)); err == nil {
+				return v
+			}
+		case types.T_datetime:
+			if v, err := types.ParseDatetime(string(str)); err == nil {
+				return v
+			}
+		}
+		return nil
+	case types.T_date:
+		if typ.Oid == types.T_date {
+			return vec.Col.([]types.Date)[0]
+		}
+	case types.T_datetime:
+		if typ.Oid == types.T_datetime {
+			return vec.Col.([]types.Datetime)[0]
+		}
+	}
+	return nil
+}
+
+func toInt64(vec *vector.Vector) int64 {
+	switch vec.Typ.Oid {
+	case types.T_int8:
+		return int64(vec.Col.([]int8)[0])
+	case types.T_int16:
+		return int64(vec.Col.([]int16)[0])
+	case types.T_int32:
+		return int64(vec.Col.([]int32)[0])
+	}
+	return vec.Col.([]int64)[0]
+}
+
+func toUint64(vec *vector.Vector) uint64 {
+	switch vec.Typ.Oid {
+	case types.T_uint8:
+		return uint64(vec.Col.([]uint8)[0])
+	case types.T_uint16:
+		return uint64(vec.Col.([]uint16)[0])
+	case types.T_uint32:
+		return uint64(vec.Col.([]uint32)[0])
+	}
+	return vec.Col.([]uint64)[0]
+}
+
+func castInt(v int64, typ types.Type) interface{} {
+	switch typ.Oid {
+	case types.T_int8:
+		if v >= math.MinInt8 && v <= math.MaxInt8 {
+			return int8(v)
+		}
+	case types.T_int16:
+		if v >= math.MinInt16 && v <= math.MaxInt16 {
+			return int16(v)
+		}
+	case types.T_int32:
+		if v >= math.MinInt32 && v <= math.MaxInt32 {
+			return int32(v)
+		}
+	case types.T_int64:
+		return v
+	case types.T_uint8:
+		if v >= 0 && v <= math.MaxUint8 {
+			return uint8(v)
+		}
+	case types.T_uint16:
+		if v >= 0 && v <= math.MaxUint16 {
+			return uint16(v)
+		}
+	case types.T_uint32:
+		if v >= 0 && v <= math.MaxUint32 {
+			return uint32(v)
+		}
+	case types.T_uint64:
+		if v >= 0 {
+			return uint64(v)
+		}
+	case types.T_float32:
+		if int64(float32(v)) == v {
+			return float32(v)
+		}
+	case types.T_float64:
+		if int64(float64(v)) == v {
+			return float64(v)
+		}
+	}
+	return nil
+}
@@ -16,11 +16,15 @@ package moengine
 
 import (
 	"fmt"
+	"math"
+	"strconv"
 
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 )
 
 func SchemaToTableInfo(schema *catalog.Schema) aoe.TableInfo {
@@ -33,12 +37,35 @@ func SchemaToTableInfo(schema *catalog.Schema) aoe.TableInfo {
 		col := aoe.ColumnInfo{
 			Name: colDef.Name,
 			Type: colDef.Type,
+			Alg:  int(colDef.Compress),
 		}
 		if idx == int(schema.PrimaryKey) {
 			col.PrimaryKey = true
 		}
+		col.AutoIncrement = colDef.AutoIncrement == 1
+		col.NullAbility = colDef.NullAbility == 1
 		tblInfo.Columns = append(tblInfo.Columns, col)
 	}
+	for _, index := range schema.Indexes {
+		indexInfo := aoe.IndexInfo{
+			Type: aoe.ZoneMap,
+			Name: index.Name,
+		}
+		if index.Type == catalog.Unique {
+			indexInfo.Type = aoe.Unique
+		}
+		for _, col := range index.Columns {
+			indexInfo.Columns = append(indexInfo.Columns, uint64(col))
+			indexInfo.ColumnNames = append(indexInfo.ColumnNames, schema.ColDefs[col].Name)
+		}
+		tblInfo.Indices = append(tblInfo.Indices, indexInfo)
+	}
+	for _, check := range schema.Checks {
+		tblInfo.Checks = append(tblInfo.Checks, engine.CheckDef{
+			Name: check.Name,
+			Expr: check.Expr,
+		})
+	}
 	return tblInfo
 }
 
@@ -85,9 +112,18 @@ func TableInfoToSchema(info *aoe.TableInfo) *catalog.Schema {
 	schema := catalog.NewEmptySchema(info.Name)
 	for idx, colInfo := range info.Columns {
 		newInfo := &catalog.ColDef{
-			Name: colInfo.Name,
-			Idx:  idx,
-			Type: colInfo.Type,
+			Name:     colInfo.Name,
+			Idx:      idx,
+			Type:     colInfo.Type,
+			SeqNum:   schema.ColSeqNum,
+			Compress: uint8(colInfo.Alg),
+		}
+		schema.ColSeqNum++
+		if colInfo.AutoIncrement {
+			newInfo.AutoIncrement = 1
+		}
+		if colInfo.NullAbility {
+			newInfo.NullAbility = 1
 		}
 		if colInfo.PrimaryKey {
 			schema.PrimaryKey = int32(idx)
@@ -96,6 +132,102 @@ func TableInfoToSchema(info *aoe.TableInfo) *catalog.Schema {
 		schema.NameIndex[newInfo.Name] = len(schema.ColDefs)
 		schema.ColDefs = append(schema.ColDefs, newInfo)
 	}
+	for _, indexInfo := range info.Indices {
+		if (indexInfo.Type != aoe.ZoneMap && indexInfo.Type != aoe.Unique) || len(indexInfo.ColumnNames) == 0 {
+			continue
+		}
+		colIdx := make([]int, 0, len(indexInfo.ColumnNames))
+		for _, name := range indexInfo.ColumnNames {
+			if idx := schema.GetColIdx(name); idx >= 0 {
+				colIdx = append(colIdx, idx)
+			}
+		}
+		if len(colIdx) != len(indexInfo.ColumnNames) {
+			continue
+		}
+		typ := catalog.ZoneMap
+		if indexInfo.Type == aoe.Unique {
+			typ = catalog.Unique
+		}
+		index := catalog.NewIndexInfo(indexInfo.Name, typ, colIdx...)
+		index.Id = uint64(len(schema.Indexes))
+		schema.Indexes = append(schema.Indexes, index)
+	}
+	for _, property := range info.Properties {
+		switch property.Key {
+		case engine.AutoIncrementProperty:
+			schema.AutoIncrement, _ = strconv.ParseUint(property.Value, 10, 64)
+		case engine.MergePolicyProperty:
+			schema.MergePolicy = property.Value
+		}
+	}
 
 	return schema
 }
+
+// encodeDefault returns the default value of attr encoded as a key of the
+// column type, or nil if the default is null or not given.
+func encodeDefault(attr engine.Attribute) ([]byte, error) {
+	if !attr.Default.Exist || attr.Default.IsNull || attr.Default.Value == nil {
+		return nil, nil
+	}
+	var v interface{}
+	switch val := attr.Default.Value.(type) {
+	case int8:
+		v = castInt(int64(val), attr.Type)
+	case int16:
+		v = castInt(int64(val), attr.Type)
+	case int32:
+		v = castInt(int64(val), attr.Type)
+	case int64:
+		v = castInt(val, attr.Type)
+	case uint8:
+		v = castInt(int64(val), attr.Type)
+	case uint16:
+		v = castInt(int64(val), attr.Type)
+	case uint32:
+		v = castInt(int64(val), attr.Type)
+	case uint64:
+		if val <= math.MaxInt64 {
+			v = castInt(int64(val), attr.Type)
+		} else if attr.Type.Oid == types.T_uint64 {
+			v = val
+		}
+	case float32:
+		v = castFloat(float64(val), attr.Type)
+	case float64:
+		v = castFloat(val, attr.Type)
+	case string:
+		if attr.Type.Oid == types.T_char || attr.Type.Oid == types.T_varchar {
+			v = []byte(val)
+		}
+	case types.Date:
+		switch attr.Type.Oid {
+		case types.T_date:
+			v = val
+		case types.T_datetime:
+			v = val.ToTime()
+		}
+	case types.Datetime:
+		if attr.Type.Oid == types.T_datetime {
+			v = val
+		}
+	}
+	if v == nil {
+		return nil, fmt.Errorf("%w: default value %v of column %s", catalog.ErrValidation, attr.Default.Value, attr.Name)
+	}
+	return common.EncodeKey(v, attr.Type)
+}
+
+func castFloat(v float64, typ types.Type) interface{} {
+	switch typ.Oid {
+	case types.T_float32:
+		return float32(v)
+	case types.T_float64:
+		return v
+	}
+	if v != math.Trunc(v) || v < math.MinInt64 || v >= math.MaxInt64 {
+		return nil
+	}
+	return castInt(int64(v), typ)
+}
@@ -0,0 +1,234 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package moengine
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
+)
+
+var _ engine.PartitionRelation = (*txnRelation)(nil)
+
+// setPartition partitions schema by def
+func setPartition(schema *catalog.Schema, def *engine.PartitionByDef) error {
+	if len(def.Fields) != 1 {
+		return fmt.Errorf("%w: a table is partitioned by one column", catalog.ErrValidation)
+	}
+	idx := schema.GetColIdx(def.Fields[0])
+	if idx < 0 {
+		return fmt.Errorf("%w: partition column %s", catalog.ErrNotFound, def.Fields[0])
+	}
+	typ := schema.ColDefs[idx].Type
+	switch {
+	case def.Hash > 0:
+		parts := make([]*catalog.PartitionDef, def.Hash)
+		for i := range parts {
+			parts[i] = &catalog.PartitionDef{Name: fmt.Sprintf("p%d", i)}
+		}
+		return schema.SetPartition(catalog.PartitionHash, def.Fields[0], parts)
+	case len(def.Range) > 0:
+		parts, err := rangePartitions(def.Range, typ)
+		if err != nil {
+			return err
+		}
+		return schema.SetPartition(catalog.PartitionRange, def.Fields[0], parts)
+	case len(def.List) > 0:
+		parts, err := listPartitions(def.List, typ)
+		if err != nil {
+			return err
+		}
+		return schema.SetPartition(catalog.PartitionList, def.Fields[0], parts)
+	}
+	return fmt.Errorf("%w: no partitions", catalog.ErrValidation)
+}
+
+func rangePartitions(defs []engine.RangePartition, typ types.Type) (parts []*catalog.PartitionDef, err error) {
+	for _, def := range defs {
+		part := &catalog.PartitionDef{Name: def.Name}
+		switch len(def.To) {
+		case 0:
+		case 1:
+			if part.Bound, err = partitionValue(def.To[0], typ); err != nil {
+				return
+			}
+			if part.Bound == nil {
+				return nil, fmt.Errorf("%w: partition %s is less than NULL", catalog.ErrValidation, def.Name)
+			}
+		default:
+			return nil, fmt.Errorf("%w: partition %s has %d bounds", catalog.ErrValidation, def.Name, len(def.To))
+		}
+		parts = append(parts, part)
+	}
+	return
+}
+
+func listPartitions(defs []engine.ListPartition, typ types.Type) (parts []*catalog.PartitionDef, err error) {
+	for _, def := range defs {
+		part := &catalog.PartitionDef{Name: def.Name}
+		for _, e := range def.Extends {
+			var v interface{}
+			if v, err = partitionValue(e, typ); err != nil {
+				return
+			}
+			part.Values = append(part.Values, v)
+		}
+		parts = append(parts, part)
+	}
+	return
+}
+
+// partitionValue returns the value of the partition column of type typ of
+// e, nil for NULL
+func partitionValue(e extend.Extend, typ types.Type) (interface{}, error) {
+	val, ok := e.(*extend.ValueExtend)
+	if !ok {
+		return nil, fmt.Errorf("%w: partition value %s is not a constant", catalog.ErrValidation, e)
+	}
+	if val.V != nil && vector.Length(val.V) == 1 && nulls.Contains(val.V.Nsp, 0) {
+		return nil, nil
+	}
+	v := castToColumnType(val.V, typ)
+	if v == nil {
+		return nil, fmt.Errorf("%w: partition value %s of type %s", catalog.ErrValidation, e, typ)
+	}
+	return v, nil
+}
+
+func partitionExtend(v interface{}, typ types.Type) extend.Extend {
+	if v == nil {
+		return &extend.ValueExtend{V: compute.MakeDefaultVector(typ, nil, 1)}
+	}
+	vec := vector.New(typ)
+	compute.AppendValue(vec, v)
+	return &extend.ValueExtend{V: vec}
+}
+
+// schemaToPartitionDef returns the partitioning of schema, nil if the table
+// is not partitioned
+func schemaToPartitionDef(schema *catalog.Schema) *engine.PartitionByDef {
+	colDef := schema.GetPartitionColumnDef()
+	if colDef == nil {
+		return nil
+	}
+	info := schema.Partition
+	def := &engine.PartitionByDef{Fields: []string{colDef.Name}}
+	for _, part := range info.Partitions {
+		switch info.Method {
+		case catalog.PartitionHash:
+			def.Hash++
+		case catalog.PartitionRange:
+			rng := engine.RangePartition{Name: part.Name}
+			if part.Bound != nil {
+				rng.To = []extend.Extend{partitionExtend(part.Bound, colDef.Type)}
+			}
+			def.Range = append(def.Range, rng)
+		case catalog.PartitionList:
+			list := engine.ListPartition{Name: part.Name}
+			for _, v := range part.Values {
+				list.Extends = append(list.Extends, partitionExtend(v, colDef.Type))
+			}
+			def.List = append(def.List, list)
+		}
+	}
+	return def
+}
+
+// partitionIDs returns the IDs of the partitions named names
+func partitionIDs(schema *catalog.Schema, names []string) ([]uint32, error) {
+	if schema.Partition == nil {
+		return nil, fmt.Errorf("%w: table %s is not partitioned", catalog.ErrNotPermitted, schema.Name)
+	}
+	ids := make([]uint32, len(names))
+	for i, name := range names {
+		part := schema.Partition.GetByName(name)
+		if part == nil {
+			return nil, fmt.Errorf("%w: partition %s", catalog.ErrNotFound, name)
+		}
+		ids[i] = part.ID
+	}
+	return ids, nil
+}
+
+func (rel *txnRelation) AddPartitions(_ uint64, def *engine.PartitionByDef, _ engine.Snapshot) (err error) {
+	schema := rel.handle.GetSchema().(*catalog.Schema)
+	colDef := schema.GetPartitionColumnDef()
+	if colDef == nil {
+		return fmt.Errorf("%w: table %s is not partitioned", catalog.ErrNotPermitted, schema.Name)
+	}
+	action := &catalog.AlterAction{Op: catalog.AlterAddPartition}
+	switch schema.Partition.Method {
+	case catalog.PartitionRange:
+		action.Partitions, err = rangePartitions(def.Range, colDef.Type)
+	case catalog.PartitionList:
+		action.Partitions, err = listPartitions(def.List, colDef.Type)
+	}
+	if err != nil {
+		return
+	}
+	return rel.handle.AlterTable([]*catalog.AlterAction{action})
+}
+
+func (rel *txnRelation) DropPartitions(_ uint64, names []string, _ engine.Snapshot) error {
+	ids, err := partitionIDs(rel.handle.GetSchema().(*catalog.Schema), names)
+	if err != nil {
+		return err
+	}
+	actions := make([]*catalog.AlterAction, len(names))
+	for i, name := range names {
+		actions[i] = &catalog.AlterAction{
+			Op:   catalog.AlterDropPartition,
+			Name: name,
+		}
+	}
+	if err = rel.handle.AlterTable(actions); err != nil {
+		return err
+	}
+	return rel.handle.TruncatePartitions(ids)
+}
+
+func (rel *txnRelation) TruncatePartitions(_ uint64, names []string, _ engine.Snapshot) error {
+	ids, err := partitionIDs(rel.handle.GetSchema().(*catalog.Schema), names)
+	if err != nil {
+		return err
+	}
+	return rel.handle.TruncatePartitions(ids)
+}
+
+// SetPartitions limits the reader to the partitions named names. The
+// uncommitted rows of the txn are always read, they are not stored by
+// partition yet.
+func (r *txnReader) SetPartitions(names []string) {
+	schema := r.handle.GetSchema().(*catalog.Schema)
+	if schema.Partition == nil {
+		return
+	}
+	r.partitions = make(map[uint32]bool, len(names))
+	for _, name := range names {
+		if part := schema.Partition.GetByName(name); part != nil {
+			r.partitions[part.ID] = true
+		}
+	}
+}
+
+func (r *txnReader) inPartitions(seg *catalog.SegmentEntry) bool {
+	return r.partitions == nil || seg.GetSegmentData() == nil || r.partitions[seg.GetPartition()]
+}
@@ -18,17 +18,22 @@ import (
 	"bytes"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
 )
 
 var (
-	_ engine.Reader = (*txnReader)(nil)
+	_ engine.Reader          = (*txnReader)(nil)
+	_ engine.PruningReader   = (*txnReader)(nil)
+	_ engine.LockingReader   = (*txnReader)(nil)
+	_ engine.PartitionReader = (*txnReader)(nil)
 )
 
 func newReader(rel handle.Relation, it handle.BlockIt) *txnReader {
-	attrCnt := len(rel.GetMeta().(*catalog.TableEntry).GetSchema().ColDefs)
+	attrCnt := len(rel.GetSchema().(*catalog.Schema).ColDefs)
 	cds := make([]*bytes.Buffer, attrCnt)
 	dds := make([]*bytes.Buffer, attrCnt)
 	for i := 0; i < attrCnt; i++ {
@@ -44,16 +49,161 @@ func newReader(rel handle.Relation, it handle.BlockIt) *txnReader {
 }
 
 func (r *txnReader) Read(refCount []uint64, attrs []string) (*batch.Batch, error) {
-	r.it.Lock()
-	if !r.it.Valid() {
+	for {
+		h := r.nextBlock()
+		if h == nil {
+			return nil, nil
+		}
+		block := newBlock(h)
+		var expired []bool
+		if r.ttl != nil {
+			var err error
+			if expired, err = r.ttl.expiredRows(h); err != nil {
+				return nil, err
+			}
+		}
+		var bat *batch.Batch
+		var err error
+		if r.lock {
+			bat, err = r.readLocked(block, refCount, attrs, expired)
+		} else {
+			bat, err = r.readLive(block, refCount, attrs, expired)
+		}
+		// bat is nil if all the rows are expired or skipped by SKIP LOCKED
+		if err != nil || bat != nil {
+			return bat, err
+		}
+	}
+}
+
+// nextBlock returns the next block which may match the filters, nil at the
+// end of the relation.
+func (r *txnReader) nextBlock() handle.Block {
+	for {
+		r.it.Lock()
+		if !r.it.Valid() {
+			r.it.Unlock()
+			return nil
+		}
+		h := r.it.GetBlock()
+		r.it.Next()
 		r.it.Unlock()
+		r.blocks++
+		meta := h.GetMeta().(*catalog.BlockEntry)
+		if !r.inPartitions(meta.GetSegment()) {
+			r.pruned++
+			continue
+		}
+		if r.ttl != nil && r.ttl.allExpired(meta) {
+			r.pruned++
+			continue
+		}
+		if len(r.filters) == 0 {
+			return h
+		}
+		if r.pruner.mayMatch(meta.GetSegment()) && mayMatch(meta, r.filters) {
+			return h
+		}
+		r.pruned++
+	}
+}
+
+func (r *txnReader) SetRowLock(mode engine.RowLockMode, wait engine.RowLockWait) {
+	r.lock = mode != engine.RowLockNone
+	r.lockMode = handle.LockShared
+	if mode == engine.RowLockUpdate {
+		r.lockMode = handle.LockExclusive
+	}
+	switch wait {
+	case engine.RowLockNoWait:
+		r.lockPolicy = handle.LockNoWait
+	case engine.RowLockSkipLocked:
+		r.lockPolicy = handle.LockSkipLocked
+	default:
+		r.lockPolicy = handle.LockWait
+	}
+}
+
+// readLive reads the block without the expired rows, it returns nil if all
+// the rows of the block are expired.
+func (r *txnReader) readLive(blk *txnBlock, refCount []uint64, attrs []string, expired []bool) (*batch.Batch, error) {
+	bat, err := blk.Read(refCount, attrs, r.compressed, r.decompressed)
+	if err != nil || expired == nil || len(bat.Vecs) == 0 {
+		return bat, err
+	}
+	sels := make([]int64, 0, len(expired))
+	for i, e := range expired {
+		if !e {
+			sels = append(sels, int64(i))
+		}
+	}
+	if len(sels) == 0 {
 		return nil, nil
 	}
-	h := r.it.GetBlock()
-	r.it.Next()
-	r.it.Unlock()
-	block := newBlock(h)
-	return block.Read(refCount, attrs, r.compressed, r.decompressed)
+	shrink(bat, sels)
+	return bat, nil
+}
+
+// readLocked locks the rows of the block before reading it. Waiting for a
+// lock may move the snapshot of the txn forward, so the primary keys are
+// read again until all the visible rows are locked. The rows locked by the
+// other txns are dropped with SKIP LOCKED, the expired ones are neither
+// locked nor returned.
+func (r *txnReader) readLocked(blk *txnBlock, refCount []uint64, attrs []string, expired []bool) (*batch.Batch, error) {
+	schema := r.handle.GetSchema().(*catalog.Schema)
+	pkDef := schema.ColDefs[schema.PrimaryKey]
+	locked := make(map[interface{}]bool)
+	var sels []int64
+	for done := false; !done; {
+		view, err := blk.handle.GetColumnDataByName(pkDef.Name, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		view.ApplyDeletes()
+		done = true
+		sels = sels[:0]
+		for i := 0; i < vector.Length(view.AppliedVec); i++ {
+			if i < len(expired) && expired[i] {
+				continue
+			}
+			v := compute.GetValue(view.AppliedVec, uint32(i))
+			if buf, ok := v.([]byte); ok {
+				v = string(buf)
+			}
+			granted, ok := locked[v]
+			if !ok {
+				if granted, err = r.handle.Lock(v, r.lockMode, r.lockPolicy); err != nil {
+					return nil, err
+				}
+				locked[v] = granted
+				done = false
+			}
+			if granted {
+				sels = append(sels, int64(i))
+			}
+		}
+	}
+	bat, err := blk.Read(refCount, attrs, r.compressed, r.decompressed)
+	if err != nil || len(bat.Vecs) == 0 || len(sels) == vector.Length(bat.Vecs[0]) {
+		return bat, err
+	}
+	if len(sels) == 0 {
+		return nil, nil
+	}
+	shrink(bat, sels)
+	return bat, nil
+}
+
+// shrink keeps the rows of sels of the batch read from a block, which has no
+// Zs to shrink with batch.Shrink.
+func shrink(bat *batch.Batch, sels []int64) {
+	for _, vec := range bat.Vecs {
+		vector.Shrink(vec, sels)
+	}
+}
+
+func (r *txnReader) PruneStats() (blocks, pruned int64) {
+	return r.blocks, r.pruned
 }
 
 func (r *txnReader) NewFilter() engine.Filter {
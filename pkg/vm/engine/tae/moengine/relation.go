@@ -15,6 +15,10 @@
 package moengine
 
 import (
+	"errors"
+	"fmt"
+	"time"
+
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
@@ -24,7 +28,13 @@ import (
 )
 
 var (
-	_ engine.Relation = (*txnRelation)(nil)
+	_ engine.Relation             = (*txnRelation)(nil)
+	_ engine.SchemaChangeRelation = (*txnRelation)(nil)
+	_ engine.UpsertRelation       = (*txnRelation)(nil)
+)
+
+var (
+	ErrTableDefNotSupported = errors.New("tae moengine: table def not supported")
 )
 
 func newRelation(h handle.Relation) *txnRelation {
@@ -34,7 +44,7 @@ func newRelation(h handle.Relation) *txnRelation {
 }
 
 func (rel *txnRelation) ID(_ engine.Snapshot) string {
-	return rel.handle.GetMeta().(*catalog.TableEntry).GetSchema().Name
+	return rel.handle.GetSchema().(*catalog.Schema).Name
 }
 
 func (rel *txnRelation) Close(_ engine.Snapshot) {}
@@ -59,18 +69,73 @@ func (_ *txnRelation) DropIndex(_ uint64, _ string) error {
 	panic("implement me")
 }
 
-func (_ *txnRelation) AddTableDef(u uint64, def engine.TableDef, _ engine.Snapshot) error {
-	panic("implement me")
+func (rel *txnRelation) AddTableDef(_ uint64, def engine.TableDef, _ engine.Snapshot) error {
+	indexDef, ok := def.(*engine.IndexTableDef)
+	if !ok {
+		return ErrTableDefNotSupported
+	}
+	schema := rel.handle.GetSchema().(*catalog.Schema)
+	colIdx := make([]int, len(indexDef.ColNames))
+	for i, name := range indexDef.ColNames {
+		if colIdx[i] = schema.GetColIdx(name); colIdx[i] < 0 {
+			return fmt.Errorf("%w: index column %s", catalog.ErrNotFound, name)
+		}
+	}
+	return rel.handle.CreateIndex(catalog.NewIndexInfo(indexDef.Name, catalog.ZoneMap, colIdx...))
 }
 
-func (_ *txnRelation) DelTableDef(u uint64, def engine.TableDef, _ engine.Snapshot) error {
-	panic("implement me")
+func (rel *txnRelation) DelTableDef(_ uint64, def engine.TableDef, _ engine.Snapshot) error {
+	indexDef, ok := def.(*engine.IndexTableDef)
+	if !ok {
+		return ErrTableDefNotSupported
+	}
+	return rel.handle.DropIndex(indexDef.Name)
+}
+
+func (rel *txnRelation) AlterTable(_ uint64, actions []engine.AlterTableAction, _ engine.Snapshot) error {
+	alters := make([]*catalog.AlterAction, len(actions))
+	for i, action := range actions {
+		alter := &catalog.AlterAction{
+			Name:    action.Name,
+			NewName: action.NewName,
+		}
+		switch action.Kind {
+		case engine.AlterAddColumn:
+			alter.Op = catalog.AlterAddColumn
+		case engine.AlterDropColumn:
+			alter.Op = catalog.AlterDropColumn
+		case engine.AlterRenameColumn:
+			alter.Op = catalog.AlterRenameColumn
+		case engine.AlterModifyColumn:
+			alter.Op = catalog.AlterModifyColumn
+		}
+		if alter.Op == catalog.AlterAddColumn || alter.Op == catalog.AlterModifyColumn {
+			def, err := encodeDefault(action.Attr)
+			if err != nil {
+				return err
+			}
+			alter.Def = &catalog.ColDef{
+				Name:     action.Attr.Name,
+				Type:     action.Attr.Type,
+				Default:  def,
+				Compress: uint8(action.Attr.Alg),
+			}
+			if action.Attr.NotNull {
+				alter.Def.NullAbility = 1
+			}
+		}
+		alters[i] = alter
+	}
+	return rel.handle.AlterTable(alters)
 }
 
 func (rel *txnRelation) TableDefs(_ engine.Snapshot) []engine.TableDef {
-	schema := rel.handle.GetMeta().(*catalog.TableEntry).GetSchema()
+	schema := rel.handle.GetSchema().(*catalog.Schema)
 	info := SchemaToTableInfo(schema)
 	_, _, _, _, defs, _ := helper.UnTransfer(info)
+	if partition := schemaToPartitionDef(schema); partition != nil {
+		defs = append(defs, partition)
+	}
 	return defs
 }
 
@@ -83,7 +148,7 @@ func (_ *txnRelation) Index() []*engine.IndexTableDef {
 }
 
 func (rel *txnRelation) GetPriKeyOrHideKey(_ engine.Snapshot) ([]engine.Attribute, bool) {
-	schema := rel.handle.GetMeta().(*catalog.TableEntry).GetSchema()
+	schema := rel.handle.GetSchema().(*catalog.Schema)
 	attrs := make([]engine.Attribute, 1)
 	attrs[0].Name = schema.ColDefs[schema.PrimaryKey].Name
 	attrs[0].Type = schema.ColDefs[schema.PrimaryKey].Type
@@ -91,11 +156,11 @@ func (rel *txnRelation) GetPriKeyOrHideKey(_ engine.Snapshot) ([]engine.Attribut
 }
 
 func (rel *txnRelation) Attribute() []engine.Attribute {
-	meta := rel.handle.GetMeta().(*catalog.TableEntry)
-	attrs := make([]engine.Attribute, len(meta.GetSchema().ColDefs))
+	schema := rel.handle.GetSchema().(*catalog.Schema)
+	attrs := make([]engine.Attribute, len(schema.ColDefs))
 	for idx, attr := range attrs {
-		attr.Name = meta.GetSchema().ColDefs[idx].Name
-		attr.Type = meta.GetSchema().ColDefs[idx].Type
+		attr.Name = schema.ColDefs[idx].Name
+		attr.Type = schema.ColDefs[idx].Type
 		attrs[idx] = attr
 	}
 	return attrs
@@ -105,10 +170,21 @@ func (rel *txnRelation) Write(_ uint64, bat *batch.Batch, _ engine.Snapshot) err
 	return rel.handle.Append(bat)
 }
 
-func (rel *txnRelation) NewReader(num int, _ extend.Extend, _ []byte, _ engine.Snapshot) (rds []engine.Reader) {
+func (rel *txnRelation) Upsert(_ uint64, bat *batch.Batch, onDup engine.OnDuplicateFunc, _ engine.Snapshot) (uint64, error) {
+	return rel.handle.Upsert(bat, handle.OnDupFunc(onDup))
+}
+
+func (rel *txnRelation) NewReader(num int, e extend.Extend, _ []byte, _ engine.Snapshot) (rds []engine.Reader) {
 	it := rel.handle.MakeBlockIt()
+	filters := getBlockFilters(rel.handle.GetSchema().(*catalog.Schema), e)
+	pruner := newSegmentPruner(filters)
+	// all the readers share one cutoff, so that they see the same rows expired
+	ttl := newTTLFilter(rel.handle.GetSchema().(*catalog.Schema), time.Now())
 	for i := 0; i < num; i++ {
 		reader := newReader(rel.handle, it)
+		reader.filters = filters
+		reader.pruner = pruner
+		reader.ttl = ttl
 		rds = append(rds, reader)
 	}
 	return
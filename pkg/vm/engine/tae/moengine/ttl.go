@@ -0,0 +1,100 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package moengine
+
+import (
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+)
+
+// ttlFilter drops the rows expired by the TTL of a table on read. The
+// blocks all the rows of which are expired are skipped by the zonemaps,
+// the segments of them are dropped by the TTL scanner of the DB later.
+type ttlFilter struct {
+	colDef *catalog.ColDef
+	// cutoff is the value of the TTL column before which the rows are expired
+	cutoff interface{}
+}
+
+// newTTLFilter returns the filter of the TTL of schema at now, or nil if the
+// table has no TTL.
+func newTTLFilter(schema *catalog.Schema, now time.Time) *ttlFilter {
+	colDef := schema.GetTTLColumnDef()
+	if colDef == nil {
+		return nil
+	}
+	return &ttlFilter{
+		colDef: colDef,
+		cutoff: schema.TTLCutoff(now),
+	}
+}
+
+// allExpired returns true if the zonemap of the TTL column of the block
+// proves all the rows of it expired.
+func (f *ttlFilter) allExpired(meta *catalog.BlockEntry) bool {
+	colIdx := meta.GetSchema().GetColIdxBySeqNum(f.colDef.SeqNum)
+	if colIdx < 0 {
+		return false
+	}
+	zm := meta.GetBlockData().GetColumnZoneMap(uint16(colIdx))
+	if zm == nil || !zm.Initialized() || zm.MayContainsNull() || zm.GetType().Oid != f.colDef.Type.Oid {
+		return false
+	}
+	return common.CompareGeneric(zm.GetMax(), f.cutoff, f.colDef.Type) < 0
+}
+
+// expiredRows returns which rows of the block are expired after the deletes
+// are applied, or nil if none of them is.
+func (f *ttlFilter) expiredRows(h handle.Block) (expired []bool, err error) {
+	meta := h.GetMeta().(*catalog.BlockEntry)
+	if colIdx := meta.GetSchema().GetColIdxBySeqNum(f.colDef.SeqNum); colIdx >= 0 {
+		zm := meta.GetBlockData().GetColumnZoneMap(uint16(colIdx))
+		if zm != nil && zm.GetType().Oid == f.colDef.Type.Oid &&
+			(!zm.Initialized() || common.CompareGeneric(zm.GetMin(), f.cutoff, f.colDef.Type) >= 0) {
+			return nil, nil
+		}
+	}
+	view, err := h.GetColumnDataByName(f.colDef.Name, nil, nil)
+	if err != nil {
+		return
+	}
+	view.ApplyDeletes()
+	vec := view.AppliedVec
+	if vec.Typ.Oid != f.colDef.Type.Oid {
+		return nil, nil
+	}
+	found := false
+	expired = make([]bool, vector.Length(vec))
+	for i := range expired {
+		if nulls.Contains(vec.Nsp, uint64(i)) {
+			continue
+		}
+		v := compute.GetValue(vec, uint32(i))
+		if common.CompareGeneric(v, f.cutoff, f.colDef.Type) < 0 {
+			expired[i] = true
+			found = true
+		}
+	}
+	if !found {
+		expired = nil
+	}
+	return
+}
@@ -20,6 +20,14 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+)
+
+type IsolationLevel = txnif.IsolationLevel
+
+const (
+	SnapshotIsolation = txnif.SnapshotIsolation
+	Serializable      = txnif.Serializable
 )
 
 type Txn interface {
@@ -35,6 +43,7 @@ type Txn interface {
 type TxnEngine interface {
 	engine.Engine
 	StartTxn(info []byte) (txn Txn, err error)
+	StartTxnWithIsolation(info []byte, level IsolationLevel) (txn Txn, err error)
 }
 
 var _ TxnEngine = &txnEngine{}
@@ -44,6 +53,7 @@ type txnEngine struct {
 }
 
 type txnDatabase struct {
+	txn    txnif.AsyncTxn
 	handle handle.Database
 }
 
@@ -60,4 +70,19 @@ type txnReader struct {
 	it           handle.BlockIt
 	compressed   []*bytes.Buffer
 	decompressed []*bytes.Buffer
+	// filters skip the blocks whose indexes exclude them
+	filters []*blockFilter
+	pruner  *segmentPruner
+	// ttl drops the expired rows of the tables with a TTL, nil for the others
+	ttl *ttlFilter
+	// partitions are the IDs of the partitions read, nil for all
+	partitions map[uint32]bool
+	// blocks is the number of blocks the reader went through, pruned is the
+	// number of them skipped by the filters
+	blocks int64
+	pruned int64
+	// lock is true for the locking reads, which lock the rows they return
+	lock       bool
+	lockMode   handle.LockMode
+	lockPolicy handle.LockWaitPolicy
 }
@@ -0,0 +1,149 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objstore
+
+import (
+	"container/list"
+	"errors"
+	"io"
+	"sync"
+	"sync/atomic"
+)
+
+const (
+	DefaultCachePageSize = 256 * 1024
+)
+
+type pageKey struct {
+	name string
+	idx  int64
+}
+
+type page struct {
+	key  pageKey
+	data []byte
+}
+
+// BlockCache is a LRU cache of the pages of the objects read from the
+// stores. A page is the aligned range of pageSize bytes of an object, the
+// last page of an object may be shorter.
+type BlockCache struct {
+	sync.Mutex
+	capacity int64
+	pageSize int64
+	size     int64
+	pages    map[pageKey]*list.Element
+	lru      *list.List
+	hits     int64
+	misses   int64
+}
+
+// NewBlockCache returns a cache keeping at most capacity bytes of pages.
+func NewBlockCache(capacity int64, pageSize int) *BlockCache {
+	if pageSize <= 0 {
+		pageSize = DefaultCachePageSize
+	}
+	return &BlockCache{
+		capacity: capacity,
+		pageSize: int64(pageSize),
+		pages:    make(map[pageKey]*list.Element),
+		lru:      list.New(),
+	}
+}
+
+func (c *BlockCache) Hits() int64   { return atomic.LoadInt64(&c.hits) }
+func (c *BlockCache) Misses() int64 { return atomic.LoadInt64(&c.misses) }
+
+func (c *BlockCache) Size() int64 {
+	c.Lock()
+	defer c.Unlock()
+	return c.size
+}
+
+func (c *BlockCache) get(key pageKey) []byte {
+	c.Lock()
+	defer c.Unlock()
+	elem, ok := c.pages[key]
+	if !ok {
+		return nil
+	}
+	c.lru.MoveToFront(elem)
+	return elem.Value.(*page).data
+}
+
+func (c *BlockCache) put(key pageKey, data []byte) {
+	c.Lock()
+	defer c.Unlock()
+	if _, ok := c.pages[key]; ok {
+		return
+	}
+	c.pages[key] = c.lru.PushFront(&page{key: key, data: data})
+	c.size += int64(len(data))
+	for c.size > c.capacity && c.lru.Len() > 0 {
+		elem := c.lru.Back()
+		evicted := c.lru.Remove(elem).(*page)
+		delete(c.pages, evicted.key)
+		c.size -= int64(len(evicted.data))
+	}
+}
+
+// Evict drops the cached pages of the object name, which is called once the
+// object is deleted.
+func (c *BlockCache) Evict(name string) {
+	c.Lock()
+	defer c.Unlock()
+	for key, elem := range c.pages {
+		if key.name == name {
+			c.lru.Remove(elem)
+			delete(c.pages, key)
+			c.size -= int64(len(elem.Value.(*page).data))
+		}
+	}
+}
+
+func (c *BlockCache) loadPage(store Store, key pageKey) ([]byte, error) {
+	if data := c.get(key); data != nil {
+		atomic.AddInt64(&c.hits, 1)
+		return data, nil
+	}
+	atomic.AddInt64(&c.misses, 1)
+	data := make([]byte, c.pageSize)
+	n, err := store.ReadAt(key.name, data, key.idx*c.pageSize)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	data = data[:n]
+	c.put(key, data)
+	return data, nil
+}
+
+// ReadAt reads len(buf) bytes of the object name from off through the
+// cache, the missed pages are read from store.
+func (c *BlockCache) ReadAt(store Store, name string, buf []byte, off int64) (n int, err error) {
+	for n < len(buf) {
+		pos := off + int64(n)
+		key := pageKey{name: name, idx: pos / c.pageSize}
+		data, err := c.loadPage(store, key)
+		if err != nil {
+			return n, err
+		}
+		start := pos - key.idx*c.pageSize
+		if start >= int64(len(data)) {
+			return n, io.EOF
+		}
+		n += copy(buf[n:], data[start:])
+	}
+	return n, nil
+}
@@ -0,0 +1,98 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objstore
+
+import (
+	"io"
+	"sort"
+	"strings"
+	"sync"
+)
+
+type memoryStore struct {
+	sync.RWMutex
+	objects map[string][]byte
+}
+
+// NewMemoryStore returns a store keeping the objects in memory, which is
+// used by the tests.
+func NewMemoryStore() Store {
+	return &memoryStore{
+		objects: make(map[string][]byte),
+	}
+}
+
+func (s *memoryStore) Put(name string, r io.Reader, size int64) error {
+	if name == "" {
+		return ErrInvalidName
+	}
+	buf := make([]byte, size)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return err
+	}
+	s.Lock()
+	defer s.Unlock()
+	s.objects[name] = buf
+	return nil
+}
+
+func (s *memoryStore) ReadAt(name string, buf []byte, off int64) (int, error) {
+	s.RLock()
+	defer s.RUnlock()
+	obj, ok := s.objects[name]
+	if !ok {
+		return 0, ErrNotFound
+	}
+	if off >= int64(len(obj)) {
+		return 0, io.EOF
+	}
+	n := copy(buf, obj[off:])
+	if n < len(buf) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+func (s *memoryStore) Size(name string) (int64, error) {
+	s.RLock()
+	defer s.RUnlock()
+	obj, ok := s.objects[name]
+	if !ok {
+		return 0, ErrNotFound
+	}
+	return int64(len(obj)), nil
+}
+
+func (s *memoryStore) Delete(name string) error {
+	s.Lock()
+	defer s.Unlock()
+	if _, ok := s.objects[name]; !ok {
+		return ErrNotFound
+	}
+	delete(s.objects, name)
+	return nil
+}
+
+func (s *memoryStore) List(prefix string) (names []string, err error) {
+	s.RLock()
+	defer s.RUnlock()
+	for name := range s.objects {
+		if strings.HasPrefix(name, prefix) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return
+}
@@ -0,0 +1,274 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objstore
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	fakeAccessKey = "minio"
+	fakeSecretKey = "minio123"
+	fakeRegion    = "us-east-1"
+)
+
+// fakeS3 is a MinIO-like server of a single bucket. It checks the signature
+// of every request and serves the requests used by s3Store.
+type fakeS3 struct {
+	sync.Mutex
+	bucket  string
+	objects map[string][]byte
+	// maxKeys is the page size of the listing
+	maxKeys int
+}
+
+func newFakeS3(bucket string) *fakeS3 {
+	return &fakeS3{
+		bucket:  bucket,
+		objects: make(map[string][]byte),
+		maxKeys: 2,
+	}
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	auth := r.Header.Get("Authorization")
+	signature, _, _ := s3Signature(r, fakeRegion, fakeSecretKey)
+	if !strings.Contains(auth, "Credential="+fakeAccessKey+"/") || !strings.HasSuffix(auth, "Signature="+signature) {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+	path := strings.TrimPrefix(r.URL.Path, "/")
+	if path != f.bucket && !strings.HasPrefix(path, f.bucket+"/") {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	key := strings.TrimPrefix(strings.TrimPrefix(path, f.bucket), "/")
+	f.Lock()
+	defer f.Unlock()
+	if key == "" {
+		f.list(w, r)
+		return
+	}
+	switch r.Method {
+	case http.MethodPut:
+		buf, err := ioutil.ReadAll(r.Body)
+		if err != nil || int64(len(buf)) != r.ContentLength {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		f.objects[key] = buf
+		w.WriteHeader(http.StatusOK)
+	case http.MethodGet, http.MethodHead:
+		buf, ok := f.objects[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		rng := r.Header.Get("Range")
+		if rng == "" || r.Method == http.MethodHead {
+			w.Header().Set("Content-Length", strconv.Itoa(len(buf)))
+			w.WriteHeader(http.StatusOK)
+			if r.Method == http.MethodGet {
+				w.Write(buf)
+			}
+			return
+		}
+		var start, end int
+		fmt.Sscanf(rng, "bytes=%d-%d", &start, &end)
+		if start >= len(buf) {
+			w.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+			return
+		}
+		if end >= len(buf) {
+			end = len(buf) - 1
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(end-start+1))
+		w.WriteHeader(http.StatusPartialContent)
+		w.Write(buf[start : end+1])
+	case http.MethodDelete:
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (f *fakeS3) list(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	prefix := query.Get("prefix")
+	keys := make([]string, 0)
+	for key := range f.objects {
+		if strings.HasPrefix(key, prefix) && key > query.Get("continuation-token") {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	result := new(s3ListResult)
+	if len(keys) > f.maxKeys {
+		keys = keys[:f.maxKeys]
+		result.IsTruncated = true
+		result.NextContinuationToken = keys[len(keys)-1]
+	}
+	for _, key := range keys {
+		result.Contents = append(result.Contents, struct {
+			Key string `xml:"Key"`
+		}{Key: key})
+	}
+	w.WriteHeader(http.StatusOK)
+	xml.NewEncoder(w).Encode(result)
+}
+
+func testStore(t *testing.T, store Store) {
+	_, err := store.Size("a")
+	assert.True(t, errors.Is(err, ErrNotFound))
+	_, err = store.ReadAt("a", make([]byte, 1), 0)
+	assert.True(t, errors.Is(err, ErrNotFound))
+	assert.True(t, errors.Is(store.Delete("a"), ErrNotFound))
+	assert.True(t, errors.Is(store.Put("", bytes.NewReader(nil), 0), ErrInvalidName))
+
+	data := []byte("0123456789")
+	for _, name := range []string{"b.seg", "a.seg", "c.seg", "d.idx", "a.tmp"} {
+		assert.Nil(t, store.Put(name, bytes.NewReader(data), int64(len(data))))
+	}
+	size, err := store.Size("a.seg")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(len(data)), size)
+
+	buf := make([]byte, 4)
+	n, err := store.ReadAt("a.seg", buf, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, n)
+	assert.Equal(t, "3456", string(buf))
+	n, err = store.ReadAt("a.seg", buf, 8)
+	assert.Equal(t, io.EOF, err)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, "89", string(buf[:n]))
+	_, err = store.ReadAt("a.seg", buf, 10)
+	assert.Equal(t, io.EOF, err)
+
+	// Replace
+	assert.Nil(t, store.Put("a.seg", bytes.NewReader([]byte("abc")), 3))
+	size, err = store.Size("a.seg")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), size)
+
+	names, err := store.List("")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a.seg", "a.tmp", "b.seg", "c.seg", "d.idx"}, names)
+	names, err = store.List("a.")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a.seg", "a.tmp"}, names)
+
+	assert.Nil(t, store.Delete("b.seg"))
+	_, err = store.Size("b.seg")
+	assert.True(t, errors.Is(err, ErrNotFound))
+	names, err = store.List("")
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(names))
+}
+
+func TestLocalStore(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "objects")
+	store, err := NewLocalStore(dir)
+	assert.Nil(t, err)
+	testStore(t, store)
+	assert.True(t, errors.Is(store.Put("../a", bytes.NewReader(nil), 0), ErrInvalidName))
+}
+
+func TestMemoryStore(t *testing.T) {
+	testStore(t, NewMemoryStore())
+}
+
+func TestS3Store(t *testing.T) {
+	server := httptest.NewServer(newFakeS3("tae"))
+	defer server.Close()
+	store, err := NewS3Store(S3Config{
+		Endpoint:  server.URL,
+		Bucket:    "tae",
+		Region:    fakeRegion,
+		AccessKey: fakeAccessKey,
+		SecretKey: fakeSecretKey,
+	})
+	assert.Nil(t, err)
+	testStore(t, store)
+
+	// A wrong secret is rejected
+	store, err = NewS3Store(S3Config{
+		Endpoint:  server.URL,
+		Bucket:    "tae",
+		Region:    fakeRegion,
+		AccessKey: fakeAccessKey,
+		SecretKey: "wrong",
+	})
+	assert.Nil(t, err)
+	_, err = store.List("")
+	assert.True(t, errors.Is(err, ErrS3))
+}
+
+func TestBlockCache(t *testing.T) {
+	store := NewMemoryStore()
+	data := make([]byte, 1000)
+	for i := range data {
+		data[i] = byte(i)
+	}
+	assert.Nil(t, store.Put("a", bytes.NewReader(data), int64(len(data))))
+	assert.Nil(t, store.Put("b", bytes.NewReader(data), int64(len(data))))
+
+	cache := NewBlockCache(400, 100)
+	reader := NewReader(store, "a", cache)
+	buf := make([]byte, 150)
+	n, err := reader.ReadAt(buf, 50)
+	assert.Nil(t, err)
+	assert.Equal(t, 150, n)
+	assert.Equal(t, data[50:200], buf)
+	assert.Equal(t, int64(2), cache.Misses())
+	n, err = reader.ReadAt(buf, 60)
+	assert.Nil(t, err)
+	assert.Equal(t, data[60:210], buf[:n])
+	assert.Equal(t, int64(2), cache.Hits())
+	assert.Equal(t, int64(3), cache.Misses())
+
+	n, err = reader.ReadAt(buf, 950)
+	assert.Equal(t, io.EOF, err)
+	assert.Equal(t, data[950:], buf[:n])
+	_, err = reader.ReadAt(buf, 1000)
+	assert.Equal(t, io.EOF, err)
+
+	// The least recently used pages are evicted
+	_, err = NewReader(store, "b", cache).ReadAt(buf, 0)
+	assert.Nil(t, err)
+	assert.True(t, cache.Size() <= 400)
+	cache.Evict("a")
+	cache.Evict("b")
+	assert.Equal(t, int64(0), cache.Size())
+
+	_, err = NewReader(store, "c", cache).ReadAt(buf, 0)
+	assert.True(t, errors.Is(err, ErrNotFound))
+}
@@ -0,0 +1,65 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objstore
+
+import (
+	"errors"
+	"io"
+)
+
+var (
+	ErrNotFound    = errors.New("tae objstore: object not found")
+	ErrInvalidName = errors.New("tae objstore: invalid object name")
+)
+
+// Store is a flat namespace of immutable objects. An object is written once
+// by Put and read by ranges afterwards.
+type Store interface {
+	// Put writes the object name with the size bytes read from r, an
+	// existing object with the same name is replaced.
+	Put(name string, r io.Reader, size int64) error
+	// ReadAt reads len(buf) bytes of the object name from off. It returns
+	// io.EOF if the object has less bytes, like io.ReaderAt.
+	ReadAt(name string, buf []byte, off int64) (int, error)
+	// Size returns the size of the object name.
+	Size(name string) (int64, error)
+	Delete(name string) error
+	// List returns the names of the objects with prefix in order.
+	List(prefix string) ([]string, error)
+}
+
+// Reader reads an object of a store through a block cache.
+type Reader struct {
+	store Store
+	name  string
+	cache *BlockCache
+}
+
+func NewReader(store Store, name string, cache *BlockCache) *Reader {
+	return &Reader{
+		store: store,
+		name:  name,
+		cache: cache,
+	}
+}
+
+func (r *Reader) Name() string { return r.name }
+
+func (r *Reader) ReadAt(buf []byte, off int64) (int, error) {
+	if r.cache == nil {
+		return r.store.ReadAt(r.name, buf, off)
+	}
+	return r.cache.ReadAt(r.store, r.name, buf, off)
+}
@@ -18,6 +18,14 @@ type CacheCfg struct {
 	IndexCapacity  uint64 `toml:"index-cache-size"`
 	InsertCapacity uint64 `toml:"insert-cache-size"`
 	TxnCapacity    uint64 `toml:"txn-cache-size"`
+	// EvictPolicy is the evict policy of each of the caches, see
+	// buffer.NewEvictHolder. It is fifo by default, which is not scan
+	// resistant: a large scan can evict the hot index and insert nodes.
+	// The default is kept so that upgrading does not change the cache
+	// behaviour of the existing deployments, which tune the cache sizes
+	// for fifo. Set it to lru-k or 2q-clock to protect the hot nodes
+	// from the scans.
+	EvictPolicy string `toml:"evict-policy"`
 }
 
 type StorageCfg struct {
@@ -31,9 +39,46 @@ type CheckpointCfg struct {
 	ExecutionLevels    int16 `toml:"execution-levels"`
 	CatalogUnCkpLimit  int64 `toml:"catalog-unckp-limit"`
 	CatalogCkpInterval int64 `toml:"catalog-ckp-interval"`
+	// MergePolicy is the merge policy of the tables, see
+	// checkpoint.NewMergePolicy for the format
+	MergePolicy string `toml:"merge-policy"`
+}
+
+type WalCfg struct {
+	// SyncPolicy is when the log entries are synced, see
+	// store.ParseSyncPolicy for the format
+	SyncPolicy string `toml:"sync-policy"`
 }
 
 type SchedulerCfg struct {
 	IOWorkers    int `toml:"io-workers"`
 	AsyncWorkers int `toml:"async-workers"`
 }
+
+type TxnCfg struct {
+	// SnapshotRetention is how long the old versions are kept for the
+	// time travel reads, 0 disables the time travel
+	SnapshotRetention int64 `toml:"snapshot-retention"`
+}
+
+const (
+	ObjectStoreLocal  = "local"
+	ObjectStoreMemory = "memory"
+	ObjectStoreS3     = "s3"
+)
+
+// ObjectStoreCfg is the object store the sealed segments are moved to, the
+// segments stay on the local disk if Backend is empty.
+type ObjectStoreCfg struct {
+	// Backend is one of local, memory and s3
+	Backend string `toml:"backend"`
+	// Dir is the directory of the local backend
+	Dir       string `toml:"dir"`
+	Endpoint  string `toml:"endpoint"`
+	Bucket    string `toml:"bucket"`
+	Region    string `toml:"region"`
+	AccessKey string `toml:"access-key"`
+	SecretKey string `toml:"secret-key"`
+	// CacheSize is the capacity of the local block cache of the objects
+	CacheSize int64 `toml:"cache-size"`
+}
@@ -24,6 +24,7 @@ func (o *Options) FillDefaults(dirname string) *Options {
 			IndexCapacity:  DefaultIndexCacheSize,
 			InsertCapacity: DefaultMTCacheSize,
 			TxnCapacity:    DefaultTxnCacheSize,
+			EvictPolicy:    DefaultEvictPolicy,
 		}
 	}
 
@@ -51,5 +52,22 @@ func (o *Options) FillDefaults(dirname string) *Options {
 		}
 	}
 
+	if o.TxnCfg == nil {
+		o.TxnCfg = &TxnCfg{
+			SnapshotRetention: DefaultSnapshotRetention,
+		}
+	}
+
+	if o.WalCfg == nil {
+		o.WalCfg = &WalCfg{}
+	}
+
+	if o.ObjectStoreCfg == nil {
+		o.ObjectStoreCfg = &ObjectStoreCfg{}
+	}
+	if o.ObjectStoreCfg.CacheSize == 0 {
+		o.ObjectStoreCfg.CacheSize = DefaultObjectCacheSize
+	}
+
 	return o
 }
@@ -17,12 +17,15 @@ package options
 import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/objstore"
 )
 
 const (
 	DefaultTxnCacheSize   = 256 * common.M
 	DefaultIndexCacheSize = 128 * common.M
 	DefaultMTCacheSize    = 4 * common.G
+	// DefaultEvictPolicy stays fifo, see CacheCfg.EvictPolicy
+	DefaultEvictPolicy = "fifo"
 
 	DefaultBlockMaxRows     = uint32(40000)
 	DefaultBlocksPerSegment = uint16(40)
@@ -35,12 +38,21 @@ const (
 
 	DefaultIOWorkers    = int(8)
 	DefaultAsyncWorkers = int(16)
+
+	DefaultSnapshotRetention = int64(0) // millisecond
+
+	DefaultObjectCacheSize = int64(256 * common.M)
 )
 
 type Options struct {
-	CacheCfg      *CacheCfg      `toml:"cache-cfg"`
-	StorageCfg    *StorageCfg    `toml:"storage-cfg"`
-	CheckpointCfg *CheckpointCfg `toml:"checkpoint-cfg"`
-	SchedulerCfg  *SchedulerCfg  `toml:"scheduler-cfg"`
-	Catalog       *catalog.Catalog
+	CacheCfg       *CacheCfg       `toml:"cache-cfg"`
+	StorageCfg     *StorageCfg     `toml:"storage-cfg"`
+	CheckpointCfg  *CheckpointCfg  `toml:"checkpoint-cfg"`
+	SchedulerCfg   *SchedulerCfg   `toml:"scheduler-cfg"`
+	TxnCfg         *TxnCfg         `toml:"txn-cfg"`
+	WalCfg         *WalCfg         `toml:"wal-cfg"`
+	ObjectStoreCfg *ObjectStoreCfg `toml:"object-store-cfg"`
+	Catalog        *catalog.Catalog
+	// ObjectStore is used instead of the one of ObjectStoreCfg if not nil
+	ObjectStore objstore.Store
 }
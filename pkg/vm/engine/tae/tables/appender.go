@@ -53,6 +53,9 @@ func (appender *blockAppender) GetID() *common.ID {
 }
 
 func (appender *blockAppender) IsAppendable() bool {
+	if appender.node.block.meta.IsSchemaStale() {
+		return false
+	}
 	return appender.rows+appender.placeholder < appender.node.block.meta.GetSchema().BlockMaxRows
 }
 
@@ -79,9 +82,7 @@ func (appender *blockAppender) OnReplayInsertNode(bat *gbat.Batch, offset, lengt
 		return err
 	})
 
-	pks := bat.Vecs[appender.node.block.meta.GetSchema().PrimaryKey]
-	// logutil.Infof("Append into %d: %s", appender.node.meta.GetID(), pks.String())
-	err = appender.indexAppender.BatchInsert(pks, offset, int(length), from, false)
+	err = appender.insertIndexes(bat, offset, length, from)
 	if err != nil {
 		panic(err)
 	}
@@ -98,9 +99,7 @@ func (appender *blockAppender) ApplyAppend(bat *gbat.Batch, offset, length uint3
 			return err
 		})
 
-		pks := bat.Vecs[appender.node.block.meta.GetSchema().PrimaryKey]
-		// logutil.Infof("Append into %d: %s", appender.node.meta.GetID(), pks.String())
-		err = appender.indexAppender.BatchInsert(pks, offset, int(length), from, false)
+		err = appender.insertIndexes(bat, offset, length, from)
 		if err != nil {
 			panic(err)
 		}
@@ -110,3 +109,19 @@ func (appender *blockAppender) ApplyAppend(bat *gbat.Batch, offset, length uint3
 
 	return
 }
+
+// insertIndexes inserts the rows [offset, offset+length) of bat, which are
+// appended to the block from row from, into the indexes of the block.
+func (appender *blockAppender) insertIndexes(bat *gbat.Batch, offset, length, from uint32) (err error) {
+	pks := bat.Vecs[appender.node.block.meta.GetSchema().PrimaryKey]
+	// logutil.Infof("Append into %d: %s", appender.node.meta.GetID(), pks.String())
+	if err = appender.indexAppender.BatchInsert(pks, offset, int(length), from, false); err != nil {
+		return
+	}
+	for _, col := range appender.indexAppender.UniqueColumns() {
+		if err = appender.indexAppender.BatchInsertOnColumn(col, bat.Vecs[col], offset, int(length), from); err != nil {
+			return
+		}
+	}
+	return
+}
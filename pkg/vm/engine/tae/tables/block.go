@@ -26,12 +26,14 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/wal"
 
 	"github.com/RoaringBitmap/roaring"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/access/acif"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/access/impl"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/basic"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables/jobs"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables/updates"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tasks"
@@ -59,12 +61,26 @@ type dataBlock struct {
 	mvcc        *updates.MVCCHandle
 	nice        uint32
 	ckpTs       uint64
+	// indexMu serializes the backfills of the indexes of the block
+	indexMu sync.Mutex
 }
 
 func newBlock(meta *catalog.BlockEntry, segFile file.Segment, bufMgr base.INodeManager, scheduler tasks.TaskScheduler) *dataBlock {
-	colCnt := len(meta.GetSchema().ColDefs)
+	schema := meta.GetSchema()
+	colCnt := len(schema.ColDefs)
+	// The primary key and the indexed columns reserve the zonemap and the
+	// static filter index files, the other columns the zonemap only if they
+	// have one. An index created later opens its files on demand.
 	indexCnt := make(map[int]int)
-	indexCnt[int(meta.GetSchema().PrimaryKey)] = 2
+	for i, colDef := range schema.ColDefs {
+		if basic.ZoneMapSupported(colDef.Type) {
+			indexCnt[i] = 1
+		}
+	}
+	indexCnt[int(schema.PrimaryKey)] = 2
+	for _, i := range schema.IndexedColumns() {
+		indexCnt[i] = 2
+	}
 	file, err := segFile.OpenBlock(meta.GetID(), colCnt, indexCnt)
 	if err != nil {
 		panic(err)
@@ -74,6 +90,7 @@ func newBlock(meta *catalog.BlockEntry, segFile file.Segment, bufMgr base.INodeM
 		if colBlk, err := file.OpenColumn(i); err != nil {
 			panic(err)
 		} else {
+			colBlk.SetCompressAlgo(int(schema.ColDefs[i].Compress))
 			colFiles[i], err = colBlk.OpenDataFile()
 			if err != nil {
 				panic(err)
@@ -95,7 +112,6 @@ func newBlock(meta *catalog.BlockEntry, segFile file.Segment, bufMgr base.INodeM
 		block.mvcc.SetDeletesListener(block.ABlkApplyDeleteToIndex)
 		node = newNode(bufMgr, block, file)
 		block.node = node
-		schema := meta.GetSchema()
 		block.indexHolder = impl.NewAppendableBlockIndexHolder(block, schema)
 	} else {
 		block.indexHolder = impl.NewEmptyNonAppendableBlockIndexHolder()
@@ -105,9 +121,20 @@ func newBlock(meta *catalog.BlockEntry, segFile file.Segment, bufMgr base.INodeM
 
 func (blk *dataBlock) ReplayData() (err error) {
 	if blk.meta.IsAppendable() {
+		holder := blk.indexHolder.(acif.IAppendableBlockIndexHolder)
 		w, _ := blk.getVectorWrapper(int(blk.meta.GetSchema().PrimaryKey))
 		defer common.GPool.Free(w.MNode)
-		err = blk.indexHolder.(acif.IAppendableBlockIndexHolder).BatchInsert(&w.Vector, 0, gvec.Length(&w.Vector), 0, false)
+		if err = holder.BatchInsert(&w.Vector, 0, gvec.Length(&w.Vector), 0, false); err != nil {
+			return
+		}
+		for _, col := range holder.UniqueColumns() {
+			cw, _ := blk.getVectorWrapper(int(col))
+			err = holder.BatchInsertOnColumn(col, &cw.Vector, 0, gvec.Length(&cw.Vector), 0)
+			common.GPool.Free(cw.MNode)
+			if err != nil {
+				return
+			}
+		}
 		return
 	}
 	return blk.indexHolder.(acif.INonAppendableBlockIndexHolder).InitFromHost(blk, blk.meta.GetSchema(), idxCommon.MockIndexBufferManager /* TODO: use dedicated index buffer manager */)
@@ -173,7 +200,7 @@ func (blk *dataBlock) resetNice() {
 }
 
 func (blk *dataBlock) estimateRawScore() int {
-	if blk.Rows(nil, true) == int(blk.meta.GetSchema().BlockMaxRows) && blk.meta.IsAppendable() {
+	if blk.meta.IsAppendable() && blk.isAppendFinished() {
 		return 100
 	}
 
@@ -231,7 +258,7 @@ func (blk *dataBlock) MutationInfo() string {
 }
 
 func (blk *dataBlock) EstimateScore() int {
-	if blk.meta.IsAppendable() && blk.Rows(nil, true) == int(blk.meta.GetSchema().BlockMaxRows) {
+	if blk.meta.IsAppendable() && blk.isAppendFinished() {
 		blk.meta.RLock()
 		if blk.meta.IsDroppedCommitted() || blk.meta.IsDroppedUncommitted() {
 			blk.meta.RUnlock()
@@ -258,7 +285,7 @@ func (blk *dataBlock) BuildCompactionTaskFactory() (factory tasks.TxnTaskFactory
 	if dropped || inTxn {
 		return
 	}
-	if !blk.meta.IsAppendable() || (blk.meta.IsAppendable() && blk.Rows(nil, true) == int(blk.meta.GetSchema().BlockMaxRows)) {
+	if !blk.meta.IsAppendable() || (blk.meta.IsAppendable() && blk.isAppendFinished()) {
 		factory = jobs.CompactBlockTaskFactory(blk.meta, blk.scheduler)
 		taskType = tasks.DataCompactionTask
 	} else if blk.meta.IsAppendable() {
@@ -273,16 +300,31 @@ func (blk *dataBlock) IsAppendable() bool {
 	if !blk.meta.IsAppendable() {
 		return false
 	}
-	if blk.node.Rows(nil, true) == blk.meta.GetSegment().GetTable().GetSchema().BlockMaxRows {
+	if blk.node.Rows(nil, true) == blk.meta.GetSchema().BlockMaxRows || blk.meta.IsSchemaStale() {
 		return false
 	}
 	return true
 }
 
+// isAppendFinished returns true if no more rows will be appended to the
+// block, either because it is full or because the table was altered after it
+// was created, and the block can be compacted as a full one.
+func (blk *dataBlock) isAppendFinished() bool {
+	rows := blk.Rows(nil, true)
+	if rows == int(blk.meta.GetSchema().BlockMaxRows) {
+		return true
+	}
+	return rows > 0 && blk.meta.IsSchemaStale()
+}
+
 func (blk *dataBlock) GetTotalChanges() int {
 	return int(blk.mvcc.GetChangeNodeCnt())
 }
 
+func (blk *dataBlock) GetDeleteCnt() uint32 {
+	return blk.mvcc.GetDeleteCnt()
+}
+
 func (blk *dataBlock) Rows(txn txnif.AsyncTxn, coarse bool) int {
 	if blk.meta.IsAppendable() {
 		rows := int(blk.node.Rows(txn, coarse))
@@ -665,6 +707,88 @@ func (blk *dataBlock) ablkGetByFilter(ts uint64, filter *handle.Filter) (offset
 	return
 }
 
+// MayContainsKeyOnColumn returns false if the indexes of the column prove
+// that no row of the block equals key. Appendable blocks and columns with
+// updates since the indexes were built are never excluded.
+func (blk *dataBlock) MayContainsKeyOnColumn(colIdx uint16, key interface{}) bool {
+	if blk.meta.IsAppendable() || blk.mvcc.GetColumnUpdateCnt(colIdx) > 0 {
+		return true
+	}
+	return blk.indexHolder.(acif.INonAppendableBlockIndexHolder).MayContainsKeyOnColumn(colIdx, key)
+}
+
+// MayContainsRangeOnColumn returns false if the zonemap of the column proves
+// that no row of the block is in [min, max].
+func (blk *dataBlock) MayContainsRangeOnColumn(colIdx uint16, min, max interface{}) bool {
+	if blk.meta.IsAppendable() || blk.mvcc.GetColumnUpdateCnt(colIdx) > 0 {
+		return true
+	}
+	return blk.indexHolder.(acif.INonAppendableBlockIndexHolder).MayContainsRangeOnColumn(colIdx, min, max)
+}
+
+// MayContainsNullOnColumn returns false if the zonemap of the column proves
+// that the block has no null in it.
+func (blk *dataBlock) MayContainsNullOnColumn(colIdx uint16) bool {
+	if blk.meta.IsAppendable() || blk.mvcc.GetColumnUpdateCnt(colIdx) > 0 {
+		return true
+	}
+	return blk.indexHolder.(acif.INonAppendableBlockIndexHolder).MayContainsNullOnColumn(colIdx)
+}
+
+// GetColumnZoneMap returns the zonemap of the column, or nil if the block is
+// appendable, the column has no zonemap or it has updates since the zonemap
+// was built.
+func (blk *dataBlock) GetColumnZoneMap(colIdx uint16) *basic.ZoneMap {
+	if blk.meta.IsAppendable() || blk.mvcc.GetColumnUpdateCnt(colIdx) > 0 {
+		return nil
+	}
+	return blk.indexHolder.(acif.INonAppendableBlockIndexHolder).GetColumnZoneMap(colIdx)
+}
+
+// GetUnindexedColumns returns the columns of cols that have no static filter
+// in the block. An appendable block has no static filters and returns nil,
+// its secondary indexes are built when it is compacted.
+func (blk *dataBlock) GetUnindexedColumns(cols []int) (unindexed []int) {
+	if blk.meta.IsAppendable() {
+		return
+	}
+	holder := blk.indexHolder.(acif.INonAppendableBlockIndexHolder)
+	for _, col := range cols {
+		if !holder.HasStaticFilterOnColumn(uint16(col)) {
+			unindexed = append(unindexed, col)
+		}
+	}
+	return
+}
+
+// BuildColumnIndexes backfills the indexes of a secondary index created after
+// the block was flushed on the columns of cols. The indexes are built from
+// the flushed column data, the rows deleted or updated since are still in
+// them, so they may only make a lookup read the block for nothing.
+func (blk *dataBlock) BuildColumnIndexes(cols []int) (err error) {
+	blk.indexMu.Lock()
+	defer blk.indexMu.Unlock()
+	cols = blk.GetUnindexedColumns(cols)
+	if len(cols) == 0 {
+		return
+	}
+	columnData := make(map[int]*gvec.Vector, len(cols))
+	for _, col := range cols {
+		if columnData[col], err = blk.getVectorWithBuffer(col, nil, nil); err != nil {
+			return
+		}
+	}
+	if err = jobs.BuildBlockColumnIndexes(blk.file, columnData); err != nil {
+		return
+	}
+	loaded := make([]uint16, len(cols))
+	for i, col := range cols {
+		loaded[i] = uint16(col)
+	}
+	holder := blk.indexHolder.(acif.INonAppendableBlockIndexHolder)
+	return holder.LoadColumnIndexes(blk, blk.meta.GetSchema(), loaded, idxCommon.MockIndexBufferManager /* TODO: use dedicated index buffer manager */)
+}
+
 func (blk *dataBlock) blkGetByFilter(ts uint64, filter *handle.Filter) (offset uint32, err error) {
 	mayExists := blk.indexHolder.(acif.INonAppendableBlockIndexHolder).MayContainsKey(filter.Val)
 	if !mayExists {
@@ -715,7 +839,7 @@ func (blk *dataBlock) ABlkApplyDeleteToIndex(gen common.RowGen) (err error) {
 		if err != nil {
 			return err
 		}
-		if gen.HasNext() {
+		for gen.HasNext() {
 			row = gen.Next()
 			v, _ := vec.GetValue(int(row))
 			if err = index.Delete(v); err != nil {
@@ -727,13 +851,34 @@ func (blk *dataBlock) ABlkApplyDeleteToIndex(gen common.RowGen) (err error) {
 	return
 }
 
+// ablkDedupDeleted checks the keys found in the index of the appendable block
+// again, ignoring the rows deleted as seen by txn. It is called with the
+// shared lock of mvcc held.
+func (blk *dataBlock) ablkDedupDeleted(txn txnif.AsyncTxn, pks *gvec.Vector) (err error) {
+	holder := blk.indexHolder.(acif.IAppendableBlockIndexHolder)
+	for i := 0; i < gvec.Length(pks); i++ {
+		offset, err := holder.Search(compute.GetValue(pks, uint32(i)))
+		if err != nil {
+			continue
+		}
+		deleted, err := blk.mvcc.IsDeletedLocked(offset, txn.GetStartTS())
+		if err != nil {
+			return err
+		}
+		if !deleted {
+			return txnbase.ErrDuplicated
+		}
+	}
+	return nil
+}
+
 func (blk *dataBlock) BatchDedup(txn txnif.AsyncTxn, pks *gvec.Vector) (err error) {
 	if blk.meta.IsAppendable() {
 		readLock := blk.mvcc.GetSharedLock()
 		defer readLock.Unlock()
 		if err = blk.indexHolder.(acif.IAppendableBlockIndexHolder).BatchDedup(pks); err != nil {
 			if err == errors.ErrKeyDuplicate {
-				return txnbase.ErrDuplicated
+				return blk.ablkDedupDeleted(txn, pks)
 			}
 			return err
 		}
@@ -767,6 +912,86 @@ func (blk *dataBlock) BatchDedup(txn txnif.AsyncTxn, pks *gvec.Vector) (err erro
 	return
 }
 
+// BatchDedupOnColumn is BatchDedup on the unique column colIdx, the keys
+// have no nulls. The indexes of the column only prune the blocks without
+// any of the keys, the keys found are checked against the column data seen
+// by txn. In an appendable block, a key appended by a txn invisible to txn
+// is a conflict like a duplicated primary key.
+func (blk *dataBlock) BatchDedupOnColumn(txn txnif.AsyncTxn, colIdx uint16, keys *gvec.Vector) (err error) {
+	// The indexes do not know the values updated into the column
+	blk.mvcc.RLock()
+	chain := blk.mvcc.GetColumnChain(colIdx)
+	chain.RLock()
+	updated := chain.DepthLocked() > 0
+	chain.RUnlock()
+	blk.mvcc.RUnlock()
+	if !updated {
+		mayExist := true
+		if blk.meta.IsAppendable() {
+			holder := blk.indexHolder.(acif.IAppendableBlockIndexHolder)
+			if mayExist, err = holder.ContainsAnyKeysOnColumn(colIdx, keys); err != nil {
+				return
+			}
+		} else if blk.indexHolder != nil {
+			holder := blk.indexHolder.(acif.INonAppendableBlockIndexHolder)
+			mayExist = false
+			for i := 0; i < gvec.Length(keys) && !mayExist; i++ {
+				mayExist = holder.MayContainsKeyOnColumn(colIdx, compute.GetValue(keys, uint32(i)))
+			}
+		}
+		if !mayExist {
+			return
+		}
+	}
+	view, err := blk.GetColumnDataById(txn, int(colIdx), nil, nil)
+	if err != nil {
+		return
+	}
+	rows := 0
+	existence := make(map[string]bool)
+	if view != nil && view.AppliedVec != nil {
+		vec := view.AppliedVec
+		rows = gvec.Length(vec)
+		for row := 0; row < rows; row++ {
+			if nulls.Contains(vec.Nsp, uint64(row)) {
+				continue
+			}
+			if view.DeleteMask != nil && view.DeleteMask.Contains(uint32(row)) {
+				continue
+			}
+			key, _ := common.EncodeKey(compute.GetValue(vec, uint32(row)), vec.Typ)
+			existence[string(key)] = true
+		}
+		view.Free()
+	}
+	for i := 0; i < gvec.Length(keys); i++ {
+		key, _ := common.EncodeKey(compute.GetValue(keys, uint32(i)), keys.Typ)
+		if existence[string(key)] {
+			return txnbase.ErrDuplicated
+		}
+	}
+	if !blk.meta.IsAppendable() {
+		return
+	}
+	holder := blk.indexHolder.(acif.IAppendableBlockIndexHolder)
+	readLock := blk.mvcc.GetSharedLock()
+	defer readLock.Unlock()
+	for i := 0; i < gvec.Length(keys); i++ {
+		offset, err := holder.SearchOnColumn(colIdx, compute.GetValue(keys, uint32(i)))
+		if err != nil || offset < uint32(rows) {
+			continue
+		}
+		deleted, err := blk.mvcc.IsDeletedLocked(offset, txn.GetStartTS())
+		if err != nil {
+			return err
+		}
+		if !deleted {
+			return txnbase.ErrDuplicated
+		}
+	}
+	return
+}
+
 func (blk *dataBlock) CollectAppendLogIndexes(startTs, endTs uint64) (indexes []*wal.Index, err error) {
 	readLock := blk.mvcc.GetSharedLock()
 	defer readLock.Unlock()
@@ -24,6 +24,8 @@ type tableHandle struct {
 	table    *dataTable
 	block    *dataBlock
 	appender data.BlockAppender
+	// partition is the ID of the table partition appended to
+	partition uint32
 }
 
 func newHandle(table *dataTable, block *dataBlock) *tableHandle {
@@ -49,8 +51,17 @@ func (h *tableHandle) SetAppender(id *common.ID) (appender data.BlockAppender) {
 
 func (h *tableHandle) GetAppender() (appender data.BlockAppender, err error) {
 	var segEntry *catalog.SegmentEntry
+	if h.partition != 0 && h.appender != nil && h.block.meta.GetSegment().HasDropped() {
+		// The partition has been truncated since the last append
+		h.block = nil
+		h.appender = nil
+	}
 	if h.appender == nil {
-		segEntry = h.table.meta.LastAppendableSegmemt()
+		if h.partition == 0 {
+			segEntry = h.table.meta.LastAppendableSegmemt()
+		} else {
+			segEntry = h.table.meta.LastAppendableSegmentOf(h.partition)
+		}
 		if segEntry == nil {
 			err = data.ErrAppendableSegmentNotFound
 			return
@@ -40,6 +40,7 @@ type compactBlockTask struct {
 	compacted handle.Block
 	created   handle.Block
 	meta      *catalog.BlockEntry
+	schema    *catalog.Schema
 	scheduler tasks.TaskScheduler
 	scopes    []common.ID
 }
@@ -60,6 +61,7 @@ func NewCompactBlockTask(ctx *tasks.Context, txn txnif.AsyncTxn, meta *catalog.B
 	if err != nil {
 		return
 	}
+	task.schema = rel.GetSchema().(*catalog.Schema)
 	seg, err := rel.GetSegment(meta.GetSegment().GetID())
 	if err != nil {
 		return
@@ -75,11 +77,13 @@ func NewCompactBlockTask(ctx *tasks.Context, txn txnif.AsyncTxn, meta *catalog.B
 
 func (task *compactBlockTask) Scopes() []common.ID { return task.scopes }
 
+// PrepareData reads the compacted block with the latest schema of the table,
+// so the block written with an older schema is rewritten with the latest one.
 func (task *compactBlockTask) PrepareData() (bat *batch.Batch, err error) {
-	attrs := task.meta.GetSchema().Attrs()
+	attrs := task.schema.Attrs()
 	bat = batch.New(true, attrs)
 
-	for i := range task.meta.GetSchema().ColDefs {
+	for i := range task.schema.ColDefs {
 		view, err := task.compacted.GetColumnDataById(i, nil, nil)
 		if err != nil {
 			return bat, err
@@ -87,7 +91,7 @@ func (task *compactBlockTask) PrepareData() (bat *batch.Batch, err error) {
 		vec := view.ApplyDeletes()
 		bat.Vecs[i] = vec
 	}
-	if err = mergesort.SortBlockColumns(bat.Vecs, int(task.meta.GetSchema().PrimaryKey)); err != nil {
+	if err = mergesort.SortBlockColumns(bat.Vecs, int(task.schema.PrimaryKey)); err != nil {
 		return
 	}
 	return
@@ -97,20 +101,23 @@ func (task *compactBlockTask) GetNewBlock() handle.Block { return task.created }
 
 func (task *compactBlockTask) Execute() (err error) {
 	now := time.Now()
-	data, err := task.PrepareData()
-	if err != nil {
-		return
-	}
 	seg := task.compacted.GetSegment()
 	// rel := seg.GetRelation()
 	newBlk, err := seg.CreateNonAppendableBlock()
 	if err != nil {
 		return err
 	}
+	newMeta := newBlk.GetMeta().(*catalog.BlockEntry)
+	if newMeta.GetSchema() != task.schema {
+		return catalog.ErrSchemaChanged
+	}
+	data, err := task.PrepareData()
+	if err != nil {
+		return
+	}
 	if err = seg.SoftDeleteBlock(task.compacted.Fingerprint().BlockID); err != nil {
 		return err
 	}
-	newMeta := newBlk.GetMeta().(*catalog.BlockEntry)
 	newBlkData := newMeta.GetBlockData()
 	blockFile := newBlkData.GetBlockFile()
 
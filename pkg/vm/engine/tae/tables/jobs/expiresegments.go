@@ -0,0 +1,85 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jobs
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tasks"
+)
+
+// ExpireSegmentsTaskFactory drops the segments all the rows of which are
+// expired by the TTL of the table, with all their blocks.
+var ExpireSegmentsTaskFactory = func(segs []*catalog.SegmentEntry) tasks.TxnTaskFactory {
+	return func(ctx *tasks.Context, txn txnif.AsyncTxn) (tasks.Task, error) {
+		return NewExpireSegmentsTask(ctx, txn, segs)
+	}
+}
+
+type expireSegmentsTask struct {
+	*tasks.BaseTask
+	txn    txnif.AsyncTxn
+	rel    handle.Relation
+	segs   []*catalog.SegmentEntry
+	scopes []common.ID
+}
+
+func NewExpireSegmentsTask(ctx *tasks.Context, txn txnif.AsyncTxn, segs []*catalog.SegmentEntry) (task *expireSegmentsTask, err error) {
+	task = &expireSegmentsTask{
+		txn:  txn,
+		segs: segs,
+	}
+	table := segs[0].GetTable()
+	database, err := txn.GetDatabase(table.GetDB().GetName())
+	if err != nil {
+		return
+	}
+	if task.rel, err = database.GetRelationByName(table.GetSchema().Name); err != nil {
+		return
+	}
+	for _, seg := range segs {
+		task.scopes = append(task.scopes, *seg.AsCommonID())
+	}
+	task.BaseTask = tasks.NewBaseTask(task, tasks.DataCompactionTask, ctx)
+	return
+}
+
+func (task *expireSegmentsTask) Scopes() []common.ID { return task.scopes }
+
+func (task *expireSegmentsTask) Execute() (err error) {
+	for _, entry := range task.segs {
+		var seg handle.Segment
+		if seg, err = task.rel.GetSegment(entry.GetID()); err != nil {
+			return
+		}
+		var blks []uint64
+		for it := seg.MakeBlockIt(); it.Valid(); it.Next() {
+			blks = append(blks, it.GetBlock().Fingerprint().BlockID)
+		}
+		for _, id := range blks {
+			if err = seg.SoftDeleteBlock(id); err != nil {
+				return
+			}
+		}
+		if err = task.rel.SoftDeleteSegment(entry.GetID()); err != nil {
+			return
+		}
+	}
+	logutil.Infof("[ExpireSegments] | %s | Segments=%d | Done", task.rel.GetMeta().(*catalog.TableEntry).String(), len(task.segs))
+	return
+}
@@ -16,6 +16,7 @@ package jobs
 
 import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/file"
@@ -45,7 +46,11 @@ func (task *flushBlkTask) Scope() *common.ID { return task.meta.AsCommonID() }
 
 func (task *flushBlkTask) Execute() (err error) {
 	pkColumnData := task.data.Vecs[task.meta.GetSchema().PrimaryKey]
-	if err = BuildAndFlushBlockIndex(task.file, task.meta, pkColumnData); err != nil {
+	columnData := make(map[int]*vector.Vector)
+	for colIdx, vec := range task.data.Vecs {
+		columnData[colIdx] = vec
+	}
+	if err = BuildAndFlushBlockIndex(task.file, task.meta, pkColumnData, columnData); err != nil {
 		return
 	}
 	if err = task.file.WriteBatch(task.data, task.ts); err != nil {
@@ -15,69 +15,144 @@
 package jobs
 
 import (
+	"sort"
+
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/file"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/basic"
 	idxCommon "github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/io"
 )
 
-func BuildAndFlushBlockIndex(file file.Block, meta *catalog.BlockEntry, pkColumnData *vector.Vector) (err error) {
+// BuildAndFlushBlockIndex writes the zonemap and the static filter of the
+// primary key, the zonemap of every other column in columnData whose type
+// supports zonemaps, and the static filter of the indexed columns in
+// columnData, then writes the meta of all these indexes.
+func BuildAndFlushBlockIndex(file file.Block, meta *catalog.BlockEntry, pkColumnData *vector.Vector, columnData map[int]*vector.Vector) (err error) {
 	// write indexes, collect their meta, and refresh host's index holder
 	schema := meta.GetSchema()
-	pkColumn, err := file.OpenColumn(int(schema.PrimaryKey))
-	if err != nil {
+	metas := idxCommon.NewEmptyIndicesMeta()
+	if err = buildColumnIndex(file, int(schema.PrimaryKey), pkColumnData, true, true, metas); err != nil {
 		return
 	}
-	zmIdx := uint16(0)
-	sfIdx := uint16(1)
-	metas := idxCommon.NewEmptyIndicesMeta()
-
-	zoneMapWriter := io.NewBlockZoneMapIndexWriter()
-	zmFile, err := pkColumn.OpenIndexFile(int(zmIdx))
+	indexed := make(map[int]bool)
+	for _, colIdx := range schema.IndexedColumns() {
+		indexed[colIdx] = true
+	}
+	for colIdx := range schema.ColDefs {
+		if colIdx == int(schema.PrimaryKey) {
+			continue
+		}
+		data, ok := columnData[colIdx]
+		if !ok || (!indexed[colIdx] && !basic.ZoneMapSupported(data.Typ)) {
+			continue
+		}
+		if err = buildColumnIndex(file, colIdx, data, true, indexed[colIdx], metas); err != nil {
+			return
+		}
+	}
+	metaBuf, err := metas.Marshal()
 	if err != nil {
 		return err
 	}
-	err = zoneMapWriter.Init(zmFile, idxCommon.Plain, uint16(schema.PrimaryKey), zmIdx)
+
+	err = file.WriteIndexMeta(metaBuf)
 	if err != nil {
 		return err
 	}
-	err = zoneMapWriter.AddValues(pkColumnData)
+	return nil
+}
+
+// BuildBlockColumnIndexes backfills a secondary index created after the
+// block was flushed. It writes the static filter of every column in
+// columnData that has none, with its zonemap if the column has none either,
+// then rewrites the meta of the indexes of the block.
+func BuildBlockColumnIndexes(file file.Block, columnData map[int]*vector.Vector) (err error) {
+	metas, err := file.LoadIndexMeta()
 	if err != nil {
-		return err
+		return
+	}
+	zoneMaps := make(map[int]bool)
+	filters := make(map[int]bool)
+	for _, meta := range metas.Metas {
+		switch meta.IdxType {
+		case idxCommon.BlockZoneMapIndex:
+			zoneMaps[int(meta.ColIdx)] = true
+		case idxCommon.StaticFilterIndex:
+			filters[int(meta.ColIdx)] = true
+		}
+	}
+	cols := make([]int, 0, len(columnData))
+	for colIdx := range columnData {
+		if !filters[colIdx] {
+			cols = append(cols, colIdx)
+		}
+	}
+	if len(cols) == 0 {
+		return
+	}
+	sort.Ints(cols)
+	for _, colIdx := range cols {
+		if err = buildColumnIndex(file, colIdx, columnData[colIdx], !zoneMaps[colIdx], true, metas); err != nil {
+			return
+		}
 	}
-	zmMeta, err := zoneMapWriter.Finalize()
+	metaBuf, err := metas.Marshal()
 	if err != nil {
-		return err
+		return
 	}
-	metas.AddIndex(*zmMeta)
+	return file.WriteIndexMeta(metaBuf)
+}
 
-	staticFilterWriter := io.NewStaticFilterIndexWriter()
-	sfFile, err := pkColumn.OpenIndexFile(int(sfIdx))
+func buildColumnIndex(file file.Block, colIdx int, columnData *vector.Vector, withZoneMap, withFilter bool, metas *idxCommon.IndicesMeta) (err error) {
+	column, err := file.OpenColumn(colIdx)
 	if err != nil {
-		return err
+		return
 	}
-	err = staticFilterWriter.Init(sfFile, idxCommon.Plain, uint16(schema.PrimaryKey), sfIdx)
-	if err != nil {
-		return err
+	zmIdx := uint16(0)
+	sfIdx := uint16(1)
+	if withZoneMap {
+		zoneMapWriter := io.NewBlockZoneMapIndexWriter()
+		zmFile, err := column.OpenIndexFile(int(zmIdx))
+		if err != nil {
+			return err
+		}
+		err = zoneMapWriter.Init(zmFile, idxCommon.Plain, uint16(colIdx), zmIdx)
+		if err != nil {
+			return err
+		}
+		err = zoneMapWriter.AddValues(columnData)
+		if err != nil {
+			return err
+		}
+		zmMeta, err := zoneMapWriter.Finalize()
+		if err != nil {
+			return err
+		}
+		metas.AddIndex(*zmMeta)
 	}
-	err = staticFilterWriter.AddValues(pkColumnData)
+	if !withFilter {
+		return nil
+	}
+
+	staticFilterWriter := io.NewStaticFilterIndexWriter()
+	sfFile, err := column.OpenIndexFile(int(sfIdx))
 	if err != nil {
 		return err
 	}
-	sfMeta, err := staticFilterWriter.Finalize()
+	err = staticFilterWriter.Init(sfFile, idxCommon.Plain, uint16(colIdx), sfIdx)
 	if err != nil {
 		return err
 	}
-	metas.AddIndex(*sfMeta)
-	metaBuf, err := metas.Marshal()
+	err = staticFilterWriter.AddValues(columnData)
 	if err != nil {
 		return err
 	}
-
-	err = file.WriteIndexMeta(metaBuf)
+	sfMeta, err := staticFilterWriter.Finalize()
 	if err != nil {
 		return err
 	}
+	metas.AddIndex(*sfMeta)
 	return nil
 }
@@ -38,6 +38,14 @@ var CompactSegmentTaskFactory = func(mergedBlks []*catalog.BlockEntry, scheduler
 	}
 }
 
+// MergeSegmentsTaskFactory merges the blocks into a new segment and drops
+// the merged segments, all the blocks of which should be merged.
+var MergeSegmentsTaskFactory = func(mergedBlks []*catalog.BlockEntry, mergedSegs []*catalog.SegmentEntry, scheduler tasks.TaskScheduler) tasks.TxnTaskFactory {
+	return func(ctx *tasks.Context, txn txnif.AsyncTxn) (tasks.Task, error) {
+		return NewMergeBlocksTask(ctx, txn, mergedBlks, mergedSegs, nil, scheduler)
+	}
+}
+
 var MergeBlocksIntoSegmentTaskFctory = func(mergedBlks []*catalog.BlockEntry, toSegEntry *catalog.SegmentEntry, scheduler tasks.TaskScheduler) tasks.TxnTaskFactory {
 	if toSegEntry == nil {
 		panic(tasks.ErrBadTaskRequestPara)
@@ -122,7 +130,10 @@ func (task *mergeBlocksTask) Execute() (err error) {
 	logutil.Info(message)
 	var toSegEntry handle.Segment
 	if task.toSegEntry == nil {
-		if toSegEntry, err = task.rel.CreateNonAppendableSegment(); err != nil {
+		// The merged blocks are of the same table partition, see
+		// db.collectSegmentStats
+		partition := task.mergedBlks[0].GetSegment().GetPartition()
+		if toSegEntry, err = task.rel.CreateNonAppendablePartitionSegment(partition); err != nil {
 			return err
 		}
 		task.toSegEntry = toSegEntry.GetMeta().(*catalog.SegmentEntry)
@@ -133,7 +144,9 @@ func (task *mergeBlocksTask) Execute() (err error) {
 		}
 	}
 
-	schema := task.mergedBlks[0].GetSchema()
+	// The merged blocks are read with the latest schema of the table, so the
+	// blocks written with older schemas are rewritten with the latest one
+	schema := task.rel.GetSchema().(*catalog.Schema)
 	var view *model.ColumnView
 	vecs := make([]*vector.Vector, 0)
 	rows := make([]uint32, len(task.compacted))
@@ -185,6 +198,9 @@ func (task *mergeBlocksTask) Execute() (err error) {
 		}
 		task.createdBlks = append(task.createdBlks, blk.GetMeta().(*catalog.BlockEntry))
 		meta := blk.GetMeta().(*catalog.BlockEntry)
+		if meta.GetSchema() != schema {
+			return catalog.ErrSchemaChanged
+		}
 		closure := meta.GetBlockData().FlushColumnDataClosure(ts, int(schema.PrimaryKey), vec, false)
 		flushTask, err = task.scheduler.ScheduleScopedFn(tasks.WaitableCtx, tasks.IOTask, meta.AsCommonID(), closure)
 		if err != nil {
@@ -193,18 +209,20 @@ func (task *mergeBlocksTask) Execute() (err error) {
 		if err = flushTask.WaitDone(); err != nil {
 			return
 		}
-		if err = BuildAndFlushBlockIndex(meta.GetBlockData().GetBlockFile(), meta, vec); err != nil {
-			return
-		}
-		if err = meta.GetBlockData().ReplayData(); err != nil {
-			return
-		}
 		// bf := blk.GetMeta().(*catalog.BlockEntry).GetBlockData().GetBlockFile()
 		// if bf.WriteColumnVec(task.txn.GetStartTS(), int(schema.PrimaryKey), vec); err != nil {
 		// 	return
 		// }
 	}
 
+	// the indexes are built after all the columns are merged
+	pkVecs := make([]*vector.Vector, len(vecs))
+	copy(pkVecs, vecs)
+	columnData := make([]map[int]*vector.Vector, len(vecs))
+	for pos := range columnData {
+		columnData[pos] = make(map[int]*vector.Vector)
+	}
+
 	for i := 0; i < len(schema.ColDefs); i++ {
 		if i == int(schema.PrimaryKey) {
 			continue
@@ -228,10 +246,19 @@ func (task *mergeBlocksTask) Execute() (err error) {
 			if err = flushTask.WaitDone(); err != nil {
 				return
 			}
+			columnData[pos][i] = vec
+		}
+	}
+	for pos, blk := range task.createdBlks {
+		if err = BuildAndFlushBlockIndex(blk.GetBlockData().GetBlockFile(), blk, pkVecs[pos], columnData[pos]); err != nil {
+			return
+		}
+		if err = blk.GetBlockData().ReplayData(); err != nil {
+			return
 		}
 	}
 	for i, blk := range task.createdBlks {
-		closure := blk.GetBlockData().SyncBlockDataClosure(ts, rows[i])
+		closure := blk.GetBlockData().SyncBlockDataClosure(ts, to[i])
 		flushTask, err = task.scheduler.ScheduleScopedFn(tasks.WaitableCtx, tasks.IOTask, blk.AsCommonID(), closure)
 		if err != nil {
 			return
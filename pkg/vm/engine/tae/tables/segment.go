@@ -23,6 +23,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/data"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/file"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/basic"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables/jobs"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tasks"
 )
@@ -79,6 +80,35 @@ func (segment *dataSegment) BatchDedup(txn txnif.AsyncTxn, pks *vector.Vector) (
 	// return nil
 }
 
+// GetColumnZoneMap merges the zonemaps of the column in all the blocks of a
+// non-appendable segment. It returns nil if any block has no zonemap of the
+// column or the column has different types in the blocks.
+func (segment *dataSegment) GetColumnZoneMap(seqNum uint16) *basic.ZoneMap {
+	if segment.meta.IsAppendable() {
+		return nil
+	}
+	var zms []*basic.ZoneMap
+	blkIt := segment.meta.MakeBlockIt(false)
+	for blkIt.Valid() {
+		blk := blkIt.Get().GetPayload().(*catalog.BlockEntry)
+		colIdx := blk.GetSchema().GetColIdxBySeqNum(seqNum)
+		if colIdx < 0 {
+			return nil
+		}
+		zm := blk.GetBlockData().GetColumnZoneMap(uint16(colIdx))
+		if zm == nil {
+			return nil
+		}
+		zms = append(zms, zm)
+		blkIt.Next()
+	}
+	zm, err := basic.MergeZoneMaps(zms...)
+	if err != nil {
+		return nil
+	}
+	return zm
+}
+
 func (segment *dataSegment) MutationInfo() string { return "" }
 
 func (segment *dataSegment) RunCalibration()    {}
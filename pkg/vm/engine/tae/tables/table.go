@@ -26,6 +26,9 @@ type dataTable struct {
 	fileFactory file.SegmentFileFactory
 	bufMgr      base.INodeManager
 	aBlk        *dataBlock
+	// pBlks are the appendable blocks of the partitions of a partitioned
+	// table by partition ID
+	pBlks map[uint32]*dataBlock
 }
 
 func newTable(meta *catalog.TableEntry, fileFactory file.SegmentFileFactory, bufMgr base.INodeManager) *dataTable {
@@ -33,6 +36,7 @@ func newTable(meta *catalog.TableEntry, fileFactory file.SegmentFileFactory, buf
 		meta:        meta,
 		fileFactory: fileFactory,
 		bufMgr:      bufMgr,
+		pBlks:       make(map[uint32]*dataBlock),
 	}
 }
 
@@ -40,7 +44,20 @@ func (table *dataTable) GetHandle() data.TableHandle {
 	return newHandle(table, table.aBlk)
 }
 
+func (table *dataTable) GetPartitionHandle(partition uint32) data.TableHandle {
+	if partition == 0 {
+		return table.GetHandle()
+	}
+	h := newHandle(table, table.pBlks[partition])
+	h.partition = partition
+	return h
+}
+
 func (table *dataTable) ApplyHandle(h data.TableHandle) {
 	handle := h.(*tableHandle)
-	table.aBlk = handle.block
+	if handle.partition == 0 {
+		table.aBlk = handle.block
+		return
+	}
+	table.pBlks[handle.partition] = handle.block
 }
@@ -55,7 +55,7 @@ func TestMergeBlocksCmd(t *testing.T) {
 	droppedSegs := []*common.ID{{TableID: 1, SegmentID: 2}, {TableID: 1, SegmentID: 2}}
 	createdSegs := []*common.ID{{TableID: 1, SegmentID: 3}}
 	droppedBlks := []*common.ID{{TableID: 1, SegmentID: 2, BlockID: 3}, {TableID: 1, SegmentID: 2, BlockID: 4}}
-	createdBlks := []*common.ID{{TableID: 1, SegmentID: 3, BlockID: 1}}
+	createdBlks := []*common.ID{{TableID: 1, SegmentID: 3, BlockID: 1}, {TableID: 1, SegmentID: 3, BlockID: 2}}
 	mapping := []uint32{3445, 4253, 425, 45, 123, 34, 42, 42, 2, 5, 0}
 	fromAddr := []uint32{40000, 40000, 40000, 42}
 	toAddr := []uint32{40000, 40000, 242}
@@ -73,6 +73,28 @@ func TestMergeBlocksCmd(t *testing.T) {
 	checkMergeBlocksCmdIsEqual(t, cmd, cmd2.(*mergeBlocksCmd))
 }
 
+// The commands are replayed from a WAL entry in a row, the command after a
+// merge that created more blocks than segments is read from where it ends
+func TestReplayMergeBlocksCmd(t *testing.T) {
+	createdSegs := []*common.ID{{TableID: 1, SegmentID: 3}}
+	createdBlks := []*common.ID{{TableID: 1, SegmentID: 3, BlockID: 1}, {TableID: 1, SegmentID: 3, BlockID: 2}}
+	droppedBlks := []*common.ID{{TableID: 1, SegmentID: 2, BlockID: 3}, {TableID: 1, SegmentID: 2, BlockID: 4}}
+	merge := newMergeBlocksCmd(1, nil, createdSegs, droppedBlks, createdBlks, []uint32{1, 0, 3, 2}, []uint32{2, 2}, []uint32{3, 1})
+	compact := newCompactBlockCmd(&common.ID{TableID: 1, SegmentID: 3, BlockID: 1}, &common.ID{TableID: 1, SegmentID: 4, BlockID: 1})
+	cmd := txnbase.NewComposedCmd()
+	cmd.AddCmd(merge)
+	cmd.AddCmd(compact)
+
+	buf, err := cmd.Marshal()
+	assert.Nil(t, err)
+	replayed, _, err := txnbase.BuildCommandFrom(bytes.NewBuffer(buf))
+	assert.Nil(t, err)
+	cmds := replayed.(*txnbase.ComposedCmd).Cmds
+	assert.Equal(t, 2, len(cmds))
+	checkMergeBlocksCmdIsEqual(t, merge, cmds[0].(*mergeBlocksCmd))
+	checkCompactBlockCmdIsEqual(t, compact, cmds[1].(*compactBlockCmd))
+}
+
 func checkMergeBlocksCmdIsEqual(t *testing.T, cmd1, cmd2 *mergeBlocksCmd) {
 	assert.Equal(t, len(cmd1.createdSegs), len(cmd2.createdSegs))
 	for i, seg1 := range cmd1.createdSegs {
@@ -76,7 +76,9 @@ func (entry *compactBlockEntry) MakeCommand(csn uint32) (cmd txnif.TxnCmd, err e
 }
 
 func (entry *compactBlockEntry) PrepareCommit() (err error) {
-	dataBlock := entry.from.GetMeta().(*catalog.BlockEntry).GetBlockData()
+	from := entry.from.GetMeta().(*catalog.BlockEntry)
+	to := entry.to.GetMeta().(*catalog.BlockEntry)
+	dataBlock := from.GetBlockData()
 	view, err := dataBlock.CollectChangesInRange(entry.txn.GetStartTS(), entry.txn.GetCommitTS())
 	if view == nil || err != nil {
 		return
@@ -85,8 +87,13 @@ func (entry *compactBlockEntry) PrepareCommit() (err error) {
 	for colIdx, mask := range view.UpdateMasks {
 		vals := view.UpdateVals[colIdx]
 		view.UpdateMasks[colIdx], view.UpdateVals[colIdx], view.DeleteMask = compute.ShuffleByDeletes(mask, vals, deletes)
+		toIdx, _, ok := transferUpdate(from, to, colIdx, nil)
+		if !ok {
+			continue
+		}
 		for row, v := range view.UpdateVals[colIdx] {
-			if err = entry.to.Update(row, colIdx, v); err != nil {
+			_, v, _ = transferUpdate(from, to, colIdx, v)
+			if err = entry.to.Update(row, toIdx, v); err != nil {
 				return
 			}
 		}
@@ -83,6 +83,14 @@ func (entry *mergeBlocksEntry) PostCommit() (err error) {
 			err = nil
 		}
 	}
+	// The created segments are sealed, move them to the object store if any
+	for _, seg := range entry.createdSegs {
+		segFile := seg.GetSegmentData().GetSegmentFile()
+		if _, err = entry.scheduler.ScheduleScopedFn(nil, tasks.IOTask, seg.AsCommonID(), segFile.Offload); err != nil {
+			logutil.Warnf("Schedule offload task failed: %v", err)
+			err = nil
+		}
+	}
 	return
 }
 
@@ -168,8 +176,11 @@ func (entry *mergeBlocksEntry) PrepareCommit() (err error) {
 			view.UpdateMasks[colIdx], view.UpdateVals[colIdx], view.DeleteMask = compute.ShuffleByDeletes(mask, vals, deletes)
 			for row, v := range view.UpdateVals[colIdx] {
 				toPos, toRow := entry.resolveAddr(fromPos, row)
-
-				if err = blks[toPos].Update(toRow, colIdx, v); err != nil {
+				toIdx, v, ok := transferUpdate(dropped, entry.createdBlks[toPos], colIdx, v)
+				if !ok {
+					break
+				}
+				if err = blks[toPos].Update(toRow, toIdx, v); err != nil {
 					return
 				}
 			}
@@ -14,7 +14,11 @@
 
 package txnentries
 
-import "github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+import (
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+)
 
 const (
 	CmdCompactBlock int16 = 0x0200 + iota
@@ -29,3 +33,18 @@ func init() {
 		return new(mergeBlocksCmd)
 	})
 }
+
+// transferUpdate maps an update of the column colIdx of the block from to the
+// block to, which may be created with a newer schema. It returns false if the
+// column was dropped.
+func transferUpdate(from, to *catalog.BlockEntry, colIdx uint16, v interface{}) (uint16, interface{}, bool) {
+	fromSchema, toSchema := from.GetSchema(), to.GetSchema()
+	if fromSchema == toSchema {
+		return colIdx, v, true
+	}
+	toIdx := toSchema.GetColIdxBySeqNum(fromSchema.ColDefs[colIdx].SeqNum)
+	if toIdx < 0 {
+		return 0, nil, false
+	}
+	return uint16(toIdx), compute.CastValue(v, toSchema.ColDefs[toIdx].Type), true
+}
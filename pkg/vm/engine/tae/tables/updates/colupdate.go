@@ -255,6 +255,20 @@ func (node *ColumnNode) UpdateLocked(row uint32, v interface{}) error {
 	return nil
 }
 
+// UndoUpdateLocked sets the row back to v if it was updated by the
+// uncommitted node before, or removes the update of the row otherwise, to
+// roll the node back to a savepoint of the txn
+func (node *ColumnNode) UndoUpdateLocked(row uint32, v interface{}, updated bool) {
+	if updated {
+		node.txnVals[row] = v
+		return
+	}
+	node.txnMask.Remove(row)
+	delete(node.txnVals, row)
+	_ = node.chain.view.Delete(row, node)
+	node.chain.SetUpdateCnt(uint32(node.chain.view.mask.GetCardinality()))
+}
+
 func (node *ColumnNode) MergeLocked(o *ColumnNode) {
 	for k, v := range o.txnVals {
 		if vv := node.txnVals[k]; vv == nil {
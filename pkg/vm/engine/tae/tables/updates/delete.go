@@ -154,6 +154,12 @@ func (node *DeleteNode) IsDeletedLocked(row uint32) bool {
 func (node *DeleteNode) RangeDeleteLocked(start, end uint32) {
 	node.mask.AddRange(uint64(start), uint64(end+1))
 }
+
+// UndoRangeDeleteLocked removes the rows [start, end] from the uncommitted
+// node, to roll it back to a savepoint of the txn
+func (node *DeleteNode) UndoRangeDeleteLocked(start, end uint32) {
+	node.mask.RemoveRange(uint64(start), uint64(end+1))
+}
 func (node *DeleteNode) GetCardinalityLocked() uint32 { return uint32(node.mask.GetCardinality()) }
 
 func (node *DeleteNode) PrepareCommit() (err error) {
@@ -14,7 +14,10 @@
 
 package txnbase
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var (
 	ErrTxnAlreadyCommitted = errors.New("tae: txn already committed")
@@ -24,8 +27,47 @@ var (
 	ErrTxnCannotRollback   = errors.New("tae: txn cannot txn rollback")
 	ErrTxnDBNotSpecified   = errors.New("tae: database not specified")
 
+	ErrTxnSnapshotExpired  = errors.New("tae: snapshot is out of the retention window")
+	ErrTxnSnapshotInFuture = errors.New("tae: snapshot is in the future")
+	ErrTxnSnapshotReadonly = errors.New("tae: snapshot txn is read-only")
+
+	ErrLockWaitTimeout  = errors.New("tae: lock wait timeout exceeded")
+	ErrLockDeadlock     = errors.New("tae: deadlock found when trying to get lock")
+	ErrLockNotAvailable = errors.New("tae: lock not available and NOWAIT is set")
+
+	ErrSavepointNotFound = errors.New("tae: savepoint does not exist")
+
+	ErrNotNullViolation  = errors.New("tae: column cannot be null")
+	ErrCheckViolation    = errors.New("tae: check constraint is violated")
+	ErrNoReferencedRow   = errors.New("tae: foreign key constraint fails, no referenced row")
+	ErrRowIsReferenced   = errors.New("tae: foreign key constraint fails, row is referenced")
+	ErrTableIsReferenced = errors.New("tae: table is referenced by a foreign key")
+
 	ErrNotFound   = errors.New("tae: not found")
 	ErrDuplicated = errors.New("tae: duplicated ")
 
 	ErrDDLDropCreated = errors.New("tae: DDL cannot drop created in a txn")
 )
+
+// ConstraintError is a violation of the constraint, or the NOT NULL column,
+// of Name. Err is one of the violation errors above or ErrDuplicated for a
+// unique key.
+type ConstraintError struct {
+	Err  error
+	Name string
+}
+
+func NewConstraintError(err error, name string) *ConstraintError {
+	return &ConstraintError{
+		Err:  err,
+		Name: name,
+	}
+}
+
+func (e *ConstraintError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Err.Error(), e.Name)
+}
+
+func (e *ConstraintError) Unwrap() error {
+	return e.Err
+}
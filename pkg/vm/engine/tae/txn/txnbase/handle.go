@@ -69,20 +69,36 @@ func (rel *TxnRelation) MakeBlockIt() handle.BlockIt
 func (rel *TxnRelation) BatchDedup(col *vector.Vector) error                                  { return nil }
 func (rel *TxnRelation) Append(data *batch.Batch) error                                       { return nil }
 func (rel *TxnRelation) GetMeta() interface{}                                                 { return nil }
+func (rel *TxnRelation) GetSchema() interface{}                                               { return nil }
 func (rel *TxnRelation) GetSegment(id uint64) (seg handle.Segment, err error)                 { return }
 func (rel *TxnRelation) SoftDeleteSegment(id uint64) (err error)                              { return }
+func (rel *TxnRelation) CreateIndex(def interface{}) (err error)                              { return }
+func (rel *TxnRelation) DropIndex(name string) (err error)                                    { return }
+func (rel *TxnRelation) AlterTable(actions interface{}) (err error)                           { return }
 func (rel *TxnRelation) CreateSegment() (seg handle.Segment, err error)                       { return }
 func (rel *TxnRelation) CreateNonAppendableSegment() (seg handle.Segment, err error)          { return }
 func (rel *TxnRelation) GetValue(*common.ID, uint32, uint16) (v interface{}, err error)       { return }
 func (rel *TxnRelation) Update(*common.ID, uint32, uint16, interface{}) (err error)           { return }
 func (rel *TxnRelation) RangeDelete(*common.ID, uint32, uint32) (err error)                   { return }
 func (rel *TxnRelation) GetByFilter(*handle.Filter) (id *common.ID, offset uint32, err error) { return }
+func (rel *TxnRelation) Lock(interface{}, handle.LockMode, handle.LockWaitPolicy) (bool, error) {
+	return true, nil
+}
+func (rel *TxnRelation) Upsert(*batch.Batch, handle.OnDupFunc) (affected uint64, err error) {
+	return
+}
 func (rel *TxnRelation) UpdateByFilter(filter *handle.Filter, col uint16, v interface{}) (err error) {
 	return
 }
 func (rel *TxnRelation) LogTxnEntry(entry txnif.TxnEntry, readed []*common.ID) (err error) {
 	return
 }
+func (rel *TxnRelation) CreateNonAppendablePartitionSegment(uint32) (seg handle.Segment, err error) {
+	return
+}
+func (rel *TxnRelation) TruncatePartitions([]uint32) (err error) {
+	return
+}
 
 func (seg *TxnSegment) GetMeta() interface{}             { return nil }
 func (seg *TxnSegment) String() string                   { return "" }
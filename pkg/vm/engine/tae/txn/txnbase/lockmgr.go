@@ -0,0 +1,210 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txnbase
+
+import (
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+)
+
+// DefaultLockWaitTimeout is the default time a txn waits for a row lock,
+// the same as the default innodb_lock_wait_timeout
+const DefaultLockWaitTimeout = time.Second * 50
+
+// LockManager is the lock table of the pessimistic row locks, keyed by the
+// table id and the encoded primary key. The locks are held until the txn
+// terminates. A txn blocked by the others waits until the locks are
+// released or the wait times out. A deadlock is found by the wait-for
+// graph when a txn is blocked, and the blocked txn is the victim.
+type LockManager struct {
+	sync.Mutex
+	Timeout time.Duration
+	locks   map[lockKey]*rowLock
+	// held maps the txns to the keys they lock
+	held map[uint64]map[lockKey]bool
+	// waitFor maps the blocked txns to the txns blocking them
+	waitFor map[uint64]map[uint64]bool
+}
+
+type lockKey struct {
+	tableId uint64
+	key     string
+}
+
+type rowLock struct {
+	mode    handle.LockMode
+	holders map[uint64]bool
+	// released is closed when a holder releases the lock
+	released chan struct{}
+}
+
+func NewLockManager() *LockManager {
+	return &LockManager{
+		Timeout: DefaultLockWaitTimeout,
+		locks:   make(map[lockKey]*rowLock),
+		held:    make(map[uint64]map[lockKey]bool),
+		waitFor: make(map[uint64]map[uint64]bool),
+	}
+}
+
+// Acquire locks the key of the table for the txn. A non-positive timeout is
+// the default timeout of the manager. It returns if the lock is granted
+// and if the txn has waited for it. A lock is not granted without an error
+// only by LockSkipLocked.
+func (mgr *LockManager) Acquire(txnId, tableId uint64, key string, mode handle.LockMode,
+	policy handle.LockWaitPolicy, timeout time.Duration) (granted, waited bool, err error) {
+	if timeout <= 0 {
+		timeout = mgr.Timeout
+	}
+	deadline := time.Now().Add(timeout)
+	lk := lockKey{tableId: tableId, key: key}
+	mgr.Lock()
+	for {
+		l := mgr.locks[lk]
+		if l == nil {
+			l = &rowLock{
+				mode:     mode,
+				holders:  make(map[uint64]bool),
+				released: make(chan struct{}),
+			}
+			mgr.locks[lk] = l
+		}
+		if l.grantableLocked(txnId, mode) {
+			if mode == handle.LockExclusive {
+				l.mode = mode
+			}
+			l.holders[txnId] = true
+			keys := mgr.held[txnId]
+			if keys == nil {
+				keys = make(map[lockKey]bool)
+				mgr.held[txnId] = keys
+			}
+			keys[lk] = true
+			mgr.Unlock()
+			granted = true
+			return
+		}
+		switch policy {
+		case handle.LockNoWait:
+			mgr.Unlock()
+			err = ErrLockNotAvailable
+			return
+		case handle.LockSkipLocked:
+			mgr.Unlock()
+			return
+		}
+		blockers := make(map[uint64]bool)
+		for holder := range l.holders {
+			if holder != txnId {
+				blockers[holder] = true
+			}
+		}
+		mgr.waitFor[txnId] = blockers
+		if mgr.reachLocked(txnId, txnId, make(map[uint64]bool)) {
+			delete(mgr.waitFor, txnId)
+			mgr.Unlock()
+			err = ErrLockDeadlock
+			return
+		}
+		released := l.released
+		mgr.Unlock()
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			mgr.stopWaiting(txnId)
+			err = ErrLockWaitTimeout
+			return
+		}
+		timer := time.NewTimer(remaining)
+		select {
+		case <-released:
+			timer.Stop()
+		case <-timer.C:
+			mgr.stopWaiting(txnId)
+			err = ErrLockWaitTimeout
+			return
+		}
+		waited = true
+		mgr.Lock()
+		delete(mgr.waitFor, txnId)
+	}
+}
+
+func (l *rowLock) grantableLocked(txnId uint64, mode handle.LockMode) bool {
+	if len(l.holders) == 0 {
+		return true
+	}
+	if len(l.holders) == 1 && l.holders[txnId] {
+		return true
+	}
+	return mode == handle.LockShared && l.mode == handle.LockShared
+}
+
+func (mgr *LockManager) stopWaiting(txnId uint64) {
+	mgr.Lock()
+	defer mgr.Unlock()
+	delete(mgr.waitFor, txnId)
+}
+
+// reachLocked returns true if the txn target is reachable from the txn from
+// in the wait-for graph
+func (mgr *LockManager) reachLocked(from, target uint64, visited map[uint64]bool) bool {
+	for next := range mgr.waitFor[from] {
+		if next == target {
+			return true
+		}
+		if visited[next] {
+			continue
+		}
+		visited[next] = true
+		if mgr.reachLocked(next, target, visited) {
+			return true
+		}
+	}
+	return false
+}
+
+// Release releases all the locks of the txn and wakes up the txns waiting
+// for them.
+func (mgr *LockManager) Release(txnId uint64) {
+	mgr.Lock()
+	defer mgr.Unlock()
+	delete(mgr.waitFor, txnId)
+	for lk := range mgr.held[txnId] {
+		l := mgr.locks[lk]
+		delete(l.holders, txnId)
+		if len(l.holders) == 0 {
+			delete(mgr.locks, lk)
+		}
+		close(l.released)
+		l.released = make(chan struct{})
+	}
+	delete(mgr.held, txnId)
+}
+
+// Count returns the number of the locked keys
+func (mgr *LockManager) Count() int {
+	mgr.Lock()
+	defer mgr.Unlock()
+	return len(mgr.locks)
+}
+
+// IsLocked returns true if the key of the table is locked by the txn.
+func (mgr *LockManager) IsLocked(txnId, tableId uint64, key string) bool {
+	mgr.Lock()
+	defer mgr.Unlock()
+	return mgr.held[txnId][lockKey{tableId: tableId, key: key}]
+}
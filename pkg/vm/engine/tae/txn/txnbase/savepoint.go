@@ -0,0 +1,89 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txnbase
+
+import "github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+
+type namedSavepoint struct {
+	name string
+	sp   txnif.Savepoint
+}
+
+func (txn *Txn) findSavepoint(name string) int {
+	for i := len(txn.savepoints) - 1; i >= 0; i-- {
+		if txn.savepoints[i].name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// Savepoint marks the current writes of the txn with name. An existing
+// savepoint with the same name is replaced.
+func (txn *Txn) Savepoint(name string) error {
+	sp, err := txn.Store.MakeSavepoint()
+	if err != nil {
+		return err
+	}
+	if pos := txn.findSavepoint(name); pos >= 0 {
+		txn.savepoints = append(txn.savepoints[:pos], txn.savepoints[pos+1:]...)
+	}
+	txn.savepoints = append(txn.savepoints, namedSavepoint{name: name, sp: sp})
+	return nil
+}
+
+// RollbackToSavepoint undoes the writes after the savepoint name. The
+// savepoint is kept and the later ones are removed.
+func (txn *Txn) RollbackToSavepoint(name string) error {
+	pos := txn.findSavepoint(name)
+	if pos < 0 {
+		return ErrSavepointNotFound
+	}
+	if err := txn.Store.RollbackToSavepoint(txn.savepoints[pos].sp); err != nil {
+		return err
+	}
+	txn.savepoints = txn.savepoints[:pos+1]
+	return nil
+}
+
+// ReleaseSavepoint removes the savepoint name and the later ones without
+// undoing any write.
+func (txn *Txn) ReleaseSavepoint(name string) error {
+	pos := txn.findSavepoint(name)
+	if pos < 0 {
+		return ErrSavepointNotFound
+	}
+	txn.savepoints = txn.savepoints[:pos]
+	return nil
+}
+
+// BeginStatement takes the savepoint that RollbackStatement rolls back to
+// if the statement fails.
+func (txn *Txn) BeginStatement() (err error) {
+	txn.lastInsertID = 0
+	txn.stmtSavepoint, err = txn.Store.MakeSavepoint()
+	return
+}
+
+// RollbackStatement undoes the writes of the current statement and keeps
+// the txn active.
+func (txn *Txn) RollbackStatement() error {
+	if txn.stmtSavepoint == nil {
+		return nil
+	}
+	sp := txn.stmtSavepoint
+	txn.stmtSavepoint = nil
+	return txn.Store.RollbackToSavepoint(sp)
+}
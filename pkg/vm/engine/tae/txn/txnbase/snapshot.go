@@ -0,0 +1,154 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txnbase
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+)
+
+// SnapshotSampleInterval is the granularity of the time travel. Commits within
+// an interval share one snapshot timestamp.
+const SnapshotSampleInterval = time.Millisecond * 10
+
+// snapshotSample records that all the timestamps not greater than ts were
+// allocated at the wall clock time at. The ts of a sample is allocated only
+// for the sample, so no txn starts or commits at it.
+type snapshotSample struct {
+	at time.Time
+	ts uint64
+}
+
+// SetRetention sets how long the old versions are kept for the snapshot txns.
+// A zero retention disables the time travel. The samples of the snapshots
+// are only kept in memory and are not replayed, so after a restart the
+// oldest snapshot a txn can start at is the start of the txn manager, not
+// the start of the retention window, until the window passes.
+func (mgr *TxnManager) SetRetention(retention time.Duration) {
+	mgr.Lock()
+	defer mgr.Unlock()
+	mgr.Retention = retention
+	if retention <= 0 {
+		mgr.samples = nil
+	} else if len(mgr.samples) == 0 {
+		mgr.samples = append(mgr.samples, snapshotSample{at: time.Now(), ts: mgr.TsAlloc.Alloc()})
+	}
+}
+
+// sampleLocked records a snapshot timestamp after a commit timestamp is
+// allocated. The samples within SnapshotSampleInterval are merged into one.
+func (mgr *TxnManager) sampleLocked(now time.Time) {
+	if mgr.Retention <= 0 {
+		return
+	}
+	sample := snapshotSample{at: now, ts: mgr.TsAlloc.Alloc()}
+	if n := len(mgr.samples); n > 1 && now.Sub(mgr.samples[n-2].at) < SnapshotSampleInterval {
+		mgr.samples[n-1] = sample
+	} else {
+		mgr.samples = append(mgr.samples, sample)
+	}
+	mgr.truncateSamplesLocked(now)
+}
+
+// truncateSamplesLocked removes the samples out of the retention window but
+// the latest one of them, which is the snapshot at the start of the window.
+func (mgr *TxnManager) truncateSamplesLocked(now time.Time) {
+	horizon := now.Add(-mgr.Retention)
+	i := 0
+	for i+1 < len(mgr.samples) && !mgr.samples[i+1].at.After(horizon) {
+		i++
+	}
+	if i > 0 {
+		mgr.samples = append(mgr.samples[:0], mgr.samples[i:]...)
+	}
+}
+
+// snapshotTSLocked returns the timestamp of the snapshot at the wall clock
+// time at.
+func (mgr *TxnManager) snapshotTSLocked(at time.Time) (ts uint64, err error) {
+	now := time.Now()
+	if mgr.Retention <= 0 || at.Before(now.Add(-mgr.Retention)) {
+		err = ErrTxnSnapshotExpired
+		return
+	}
+	if at.After(now) {
+		err = ErrTxnSnapshotInFuture
+		return
+	}
+	mgr.truncateSamplesLocked(now)
+	i := sort.Search(len(mgr.samples), func(i int) bool {
+		return mgr.samples[i].at.After(at)
+	})
+	if i == 0 {
+		// at is before the txn manager started
+		err = ErrTxnSnapshotExpired
+		return
+	}
+	ts = mgr.samples[i-1].ts
+	return
+}
+
+// StartTxnAt starts a read-only txn reading the data as it was at the wall
+// clock time at, which should be in the retention window. Commits in the
+// SnapshotSampleInterval before at may be invisible to the txn. The writes of
+// a snapshot txn fail with ErrTxnSnapshotReadonly when they are made.
+func (mgr *TxnManager) StartTxnAt(info []byte, at time.Time) (txn txnif.AsyncTxn, err error) {
+	if exp := mgr.Exception.Load(); exp != nil {
+		err = exp.(error)
+		logutil.Warnf("StartTxnAt: %v", err)
+		return
+	}
+	mgr.Lock()
+	defer mgr.Unlock()
+	startTs, err := mgr.snapshotTSLocked(at)
+	if err != nil {
+		return
+	}
+	txnId := mgr.IdAlloc.Alloc()
+
+	store := mgr.TxnStoreFactory()
+	txn = mgr.TxnFactory(mgr, store, txnId, startTs, info)
+	if setter, ok := txn.(interface{ SetSnapshot() }); ok {
+		setter.SetSnapshot()
+	}
+	store.BindTxn(txn)
+	mgr.Active[txnId] = txn
+	mgr.Snapshots[txnId] = startTs
+	return
+}
+
+// IsSnapshot returns true if the txn is started by StartTxnAt.
+func (mgr *TxnManager) IsSnapshot(id uint64) bool {
+	mgr.RLock()
+	defer mgr.RUnlock()
+	_, ok := mgr.Snapshots[id]
+	return ok
+}
+
+// StatRetainedTS returns the max timestamp of the versions which can be
+// garbage collected without breaking the snapshots in the retention window.
+func (mgr *TxnManager) StatRetainedTS() uint64 {
+	mgr.Lock()
+	defer mgr.Unlock()
+	if mgr.Retention <= 0 {
+		return math.MaxUint64
+	}
+	mgr.truncateSamplesLocked(time.Now())
+	return mgr.samples[0].ts - 1
+}
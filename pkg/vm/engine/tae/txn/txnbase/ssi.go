@@ -0,0 +1,237 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txnbase
+
+import (
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+)
+
+// SSITracker implements the serializable snapshot isolation. It tracks the
+// keys read and written by the serializable txns and the tables they scan,
+// and builds the rw-antidependencies among them: R -> W if R did not see a
+// write of W to something R read. A txn with both an incoming and an
+// outgoing rw-antidependency, the pivot of a possible cycle, is aborted at
+// commit. Only the serializable txns are tracked, the snapshot isolation
+// txns neither cause nor suffer aborts.
+type SSITracker struct {
+	sync.Mutex
+	txns map[uint64]*ssiTxn
+}
+
+type ssiTxn struct {
+	id       uint64
+	startTs  uint64
+	commitTs uint64
+	// reads and writes are the keys read and written, keyed by table id
+	reads  map[uint64]map[string]bool
+	writes map[uint64]map[string]bool
+	// scans are the tables read by predicates
+	scans map[uint64]bool
+	// ins are the txns which did not see a write of this txn, outs are the
+	// txns whose writes this txn did not see
+	ins  map[uint64]bool
+	outs map[uint64]bool
+}
+
+func NewSSITracker() *SSITracker {
+	return &SSITracker{
+		txns: make(map[uint64]*ssiTxn),
+	}
+}
+
+func (t *SSITracker) Register(id, startTs uint64) {
+	t.Lock()
+	defer t.Unlock()
+	t.txns[id] = &ssiTxn{
+		id:      id,
+		startTs: startTs,
+		reads:   make(map[uint64]map[string]bool),
+		writes:  make(map[uint64]map[string]bool),
+		scans:   make(map[uint64]bool),
+		ins:     make(map[uint64]bool),
+		outs:    make(map[uint64]bool),
+	}
+}
+
+// concurrent returns true if r did not see the writes of w and w did not
+// start after r committed.
+func concurrent(r, w *ssiTxn) bool {
+	if w.commitTs != 0 && w.commitTs <= r.startTs {
+		return false
+	}
+	return r.commitTs == 0 || r.commitTs > w.startTs
+}
+
+func (t *SSITracker) addEdgeLocked(r, w *ssiTxn) {
+	if r == w || !concurrent(r, w) {
+		return
+	}
+	r.outs[w.id] = true
+	w.ins[r.id] = true
+}
+
+// ReadKey records that the txn read the row with the primary key key, or
+// found no such row, in the table.
+func (t *SSITracker) ReadKey(id, tableId uint64, key string) {
+	t.Lock()
+	defer t.Unlock()
+	txn := t.txns[id]
+	if txn == nil {
+		return
+	}
+	keys := txn.reads[tableId]
+	if keys == nil {
+		keys = make(map[string]bool)
+		txn.reads[tableId] = keys
+	}
+	if keys[key] {
+		return
+	}
+	keys[key] = true
+	for _, other := range t.txns {
+		if other.writes[tableId][key] {
+			t.addEdgeLocked(txn, other)
+		}
+	}
+}
+
+// ReadTable records that the txn read the table by a predicate, which may
+// match any row.
+func (t *SSITracker) ReadTable(id, tableId uint64) {
+	t.Lock()
+	defer t.Unlock()
+	txn := t.txns[id]
+	if txn == nil || txn.scans[tableId] {
+		return
+	}
+	txn.scans[tableId] = true
+	for _, other := range t.txns {
+		if len(other.writes[tableId]) > 0 {
+			t.addEdgeLocked(txn, other)
+		}
+	}
+}
+
+// Write records that the txn inserted, updated or deleted the row with the
+// primary key key in the table.
+func (t *SSITracker) Write(id, tableId uint64, key string) {
+	t.Lock()
+	defer t.Unlock()
+	txn := t.txns[id]
+	if txn == nil {
+		return
+	}
+	keys := txn.writes[tableId]
+	if keys == nil {
+		keys = make(map[string]bool)
+		txn.writes[tableId] = keys
+	}
+	if keys[key] {
+		return
+	}
+	keys[key] = true
+	for _, other := range t.txns {
+		if other.scans[tableId] || other.reads[tableId][key] {
+			t.addEdgeLocked(other, txn)
+		}
+	}
+}
+
+// Commit checks if committing the txn at commitTs can complete a dangerous
+// structure R -> P -> W, it returns TxnRWConflictErr if so. Otherwise the
+// txn is kept as committed until no active txn is concurrent with it.
+func (t *SSITracker) Commit(id, commitTs uint64) error {
+	t.Lock()
+	defer t.Unlock()
+	txn := t.txns[id]
+	if txn == nil {
+		return nil
+	}
+	committed := func(id uint64) *ssiTxn {
+		if other := t.txns[id]; other != nil && other.commitTs != 0 {
+			return other
+		}
+		return nil
+	}
+	// the txn is the pivot
+	conflict := len(txn.ins) > 0 && len(txn.outs) > 0
+	// the txn is W of a committed pivot
+	for in := range txn.ins {
+		if p := committed(in); p != nil && len(p.ins) > 0 {
+			conflict = true
+		}
+	}
+	// the txn is R of a committed pivot
+	for out := range txn.outs {
+		if p := committed(out); p != nil && len(p.outs) > 0 {
+			conflict = true
+		}
+	}
+	if conflict {
+		logutil.Infof("TxnRWConflictErr Found: Txn-%d is in a dangerous structure", id)
+		return txnif.TxnRWConflictErr
+	}
+	txn.commitTs = commitTs
+	t.gcLocked()
+	return nil
+}
+
+// Abort forgets the txn and the rw-antidependencies it is in.
+func (t *SSITracker) Abort(id uint64) {
+	t.Lock()
+	defer t.Unlock()
+	txn := t.txns[id]
+	if txn == nil {
+		return
+	}
+	for in := range txn.ins {
+		if other := t.txns[in]; other != nil {
+			delete(other.outs, id)
+		}
+	}
+	for out := range txn.outs {
+		if other := t.txns[out]; other != nil {
+			delete(other.ins, id)
+		}
+	}
+	delete(t.txns, id)
+	t.gcLocked()
+}
+
+// gcLocked forgets the committed txns which no active txn is concurrent
+// with. The rw-antidependencies with them are kept, as they are committed.
+func (t *SSITracker) gcLocked() {
+	var minActive uint64
+	for _, txn := range t.txns {
+		if txn.commitTs == 0 && (minActive == 0 || txn.startTs < minActive) {
+			minActive = txn.startTs
+		}
+	}
+	for id, txn := range t.txns {
+		if txn.commitTs != 0 && (minActive == 0 || txn.commitTs <= minActive) {
+			delete(t.txns, id)
+		}
+	}
+}
+
+// Count returns the number of the tracked txns.
+func (t *SSITracker) Count() int {
+	t.Lock()
+	defer t.Unlock()
+	return len(t.txns)
+}
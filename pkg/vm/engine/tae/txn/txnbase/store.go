@@ -77,6 +77,9 @@ func (store *NoopTxnStore) GetByFilter(uint64, uint64, *handle.Filter) (id *comm
 func (store *NoopTxnStore) GetValue(uint64, *common.ID, uint32, uint16) (v interface{}, err error) {
 	return
 }
+func (store *NoopTxnStore) Lock(uint64, uint64, interface{}, handle.LockMode, handle.LockWaitPolicy) (bool, error) {
+	return true, nil
+}
 
 func (store *NoopTxnStore) LogSegmentID(dbId, tid, sid uint64) {}
 func (store *NoopTxnStore) LogBlockID(dbId, tid, bid uint64)   {}
@@ -86,3 +89,6 @@ func (store *NoopTxnStore) LogTxnEntry(dbId, tableId uint64, entry txnif.TxnEntr
 
 func (store *NoopTxnStore) IsReadonly() bool      { return false }
 func (store *NoopTxnStore) IncreateWriteCnt() int { return 0 }
+
+func (store *NoopTxnStore) MakeSavepoint() (sp txnif.Savepoint, err error)  { return }
+func (store *NoopTxnStore) RollbackToSavepoint(txnif.Savepoint) (err error) { return }
@@ -17,6 +17,7 @@ package txnbase
 import (
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
@@ -56,6 +57,16 @@ type Txn struct {
 	Store    txnif.TxnStore
 	Err      error
 	DoneCond sync.Cond
+	// LockWaitTimeout is how long the txn waits for a row lock, the
+	// default timeout of the lock manager if not positive
+	LockWaitTimeout time.Duration
+	// savepoints are the named savepoints from the oldest to the latest,
+	// stmtSavepoint is the one taken at the start of the current statement
+	savepoints    []namedSavepoint
+	stmtSavepoint txnif.Savepoint
+	// lastInsertID is the first auto increment value generated by the
+	// current statement, 0 if none
+	lastInsertID uint64
 
 	PrepareCommitFn   func(txnif.AsyncTxn) error
 	PrepareRollbackFn func(txnif.AsyncTxn) error
@@ -78,6 +89,16 @@ func (txn *Txn) MockIncWriteCnt() int { return txn.Store.IncreateWriteCnt() }
 func (txn *Txn) SetError(err error) { txn.Err = err }
 func (txn *Txn) GetError() error    { return txn.Err }
 
+func (txn *Txn) GetLastInsertID() uint64 { return txn.lastInsertID }
+
+// SetLastInsertID records id as the last insert id if the current statement
+// has not generated any auto increment value yet.
+func (txn *Txn) SetLastInsertID(id uint64) {
+	if txn.lastInsertID == 0 {
+		txn.lastInsertID = id
+	}
+}
+
 func (txn *Txn) SetPrepareCommitFn(fn func(txnif.AsyncTxn) error)   { txn.PrepareCommitFn = fn }
 func (txn *Txn) SetPrepareRollbackFn(fn func(txnif.AsyncTxn) error) { txn.PrepareRollbackFn = fn }
 func (txn *Txn) SetApplyCommitFn(fn func(txnif.AsyncTxn) error)     { txn.ApplyCommitFn = fn }
@@ -85,8 +106,19 @@ func (txn *Txn) SetApplyRollbackFn(fn func(txnif.AsyncTxn) error)   { txn.ApplyR
 
 func (txn *Txn) Commit() (err error) {
 	if txn.Store.IsReadonly() {
+		if txn.GetIsolationLevel() == txnif.Serializable {
+			// a readonly txn can still be the reader of a dangerous structure
+			err = txn.Mgr.SSI.Commit(txn.GetID(), txn.Mgr.TsAlloc.Alloc())
+			if err != nil {
+				txn.Mgr.SSI.Abort(txn.GetID())
+			}
+		}
 		txn.Mgr.DeleteTxn(txn.GetID())
-		return nil
+		return
+	}
+	if txn.IsSnapshot() {
+		_ = txn.Rollback()
+		return ErrTxnSnapshotReadonly
 	}
 	txn.Add(1)
 	err = txn.Mgr.OnOpTxn(&OpTxn{
@@ -105,7 +137,45 @@ func (txn *Txn) Commit() (err error) {
 	}
 	txn.Wait()
 	txn.Mgr.DeleteTxn(txn.GetID())
-	return txn.GetError()
+	if err = txn.GetError(); err != nil {
+		txn.Mgr.SSI.Abort(txn.GetID())
+	}
+	return
+}
+
+// GetLockManager returns the lock manager of the row locks, nil if the txn
+// is not managed by a txn manager.
+func (txn *Txn) GetLockManager() *LockManager {
+	if txn.Mgr == nil {
+		return nil
+	}
+	return txn.Mgr.LockMgr
+}
+
+func (txn *Txn) SetLockWaitTimeout(timeout time.Duration) { txn.LockWaitTimeout = timeout }
+
+// LockRow locks the row with the encoded primary key key of the table. If
+// the txn has waited for the lock and has not written anything yet, its
+// start ts is refreshed to read the row committed by the previous holder.
+func (txn *Txn) LockRow(tableId uint64, key string, mode handle.LockMode, policy handle.LockWaitPolicy) (bool, error) {
+	if txn.Mgr == nil {
+		return true, nil
+	}
+	granted, waited, err := txn.Mgr.LockMgr.Acquire(txn.ID, tableId, key, mode, policy, txn.LockWaitTimeout)
+	if granted && waited && txn.Store.IsReadonly() &&
+		txn.GetIsolationLevel() != txnif.Serializable && !txn.IsSnapshot() {
+		txn.Mgr.RefreshStartTS(txn.TxnCtx)
+	}
+	return granted, err
+}
+
+// GetSSITracker returns the tracker of the reads and writes of the txn if it
+// is serializable, or nil.
+func (txn *Txn) GetSSITracker() *SSITracker {
+	if txn.GetIsolationLevel() != txnif.Serializable {
+		return nil
+	}
+	return txn.Mgr.SSI
 }
 
 func (txn *Txn) GetStore() txnif.TxnStore {
@@ -113,6 +183,7 @@ func (txn *Txn) GetStore() txnif.TxnStore {
 }
 
 func (txn *Txn) Rollback() (err error) {
+	txn.Mgr.SSI.Abort(txn.GetID())
 	if txn.Store.IsReadonly() {
 		txn.Mgr.DeleteTxn(txn.GetID())
 		return
@@ -189,6 +260,11 @@ func (txn *Txn) PrepareCommit() (err error) {
 			return
 		}
 	}
+	if txn.GetIsolationLevel() == txnif.Serializable {
+		if err = txn.Mgr.SSI.Commit(txn.GetID(), txn.GetCommitTS()); err != nil {
+			return
+		}
+	}
 	err = txn.Store.PrepareCommit()
 	return err
 }
@@ -39,6 +39,9 @@ type TxnCtx struct {
 	StartTS, CommitTS uint64
 	Info              []byte
 	State             txnif.TxnState
+	Isolation         txnif.IsolationLevel
+	// Snapshot is true if the txn is started by StartTxnAt
+	Snapshot bool
 }
 
 func NewTxnCtx(rwlocker *sync.RWMutex, id, start uint64, info []byte) *TxnCtx {
@@ -70,6 +73,14 @@ func (ctx *TxnCtx) String() string     { return ctx.Repr() }
 func (ctx *TxnCtx) GetID() uint64      { return ctx.ID }
 func (ctx *TxnCtx) GetInfo() []byte    { return ctx.Info }
 func (ctx *TxnCtx) GetStartTS() uint64 { return ctx.StartTS }
+func (ctx *TxnCtx) GetIsolationLevel() txnif.IsolationLevel {
+	return ctx.Isolation
+}
+func (ctx *TxnCtx) SetIsolationLevel(level txnif.IsolationLevel) {
+	ctx.Isolation = level
+}
+func (ctx *TxnCtx) IsSnapshot() bool { return ctx.Snapshot }
+func (ctx *TxnCtx) SetSnapshot()     { ctx.Snapshot = true }
 func (ctx *TxnCtx) GetCommitTS() uint64 {
 	ctx.RLock()
 	defer ctx.RUnlock()
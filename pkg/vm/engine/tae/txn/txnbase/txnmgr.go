@@ -39,6 +39,15 @@ type TxnManager struct {
 	TxnFactory       TxnFactory
 	ActiveMask       *roaring64.Bitmap
 	Exception        *atomic.Value
+	// Snapshots maps the ids of the txns started by StartTxnAt to their start
+	// timestamps, which are not unique and are not in ActiveMask
+	Snapshots map[uint64]uint64
+	Retention time.Duration
+	samples   []snapshotSample
+	// SSI tracks the reads and writes of the serializable txns
+	SSI *SSITracker
+	// LockMgr holds the row locks of the txns
+	LockMgr *LockManager
 }
 
 func NewTxnManager(txnStoreFactory TxnStoreFactory, txnFactory TxnFactory) *TxnManager {
@@ -53,6 +62,9 @@ func NewTxnManager(txnStoreFactory TxnStoreFactory, txnFactory TxnFactory) *TxnM
 		TxnFactory:      txnFactory,
 		ActiveMask:      roaring64.New(),
 		Exception:       new(atomic.Value),
+		Snapshots:       make(map[uint64]uint64),
+		SSI:             NewSSITracker(),
+		LockMgr:         NewLockManager(),
 	}
 	pqueue := sm.NewSafeQueue(20000, 1000, mgr.onPreparing)
 	cqueue := sm.NewSafeQueue(20000, 1000, mgr.onCommit)
@@ -69,21 +81,32 @@ func (mgr *TxnManager) Init(prevTxnId uint64, prevTs uint64) error {
 func (mgr *TxnManager) StatActiveTxnCnt() int {
 	mgr.RLock()
 	defer mgr.RUnlock()
-	return int(mgr.ActiveMask.GetCardinality())
+	return int(mgr.ActiveMask.GetCardinality()) + len(mgr.Snapshots)
 }
 
 func (mgr *TxnManager) StatSafeTS() (ts uint64) {
 	mgr.RLock()
-	if len(mgr.Active) > 0 {
+	if !mgr.ActiveMask.IsEmpty() {
 		ts = mgr.ActiveMask.Minimum() - 1
 	} else {
 		ts = mgr.TsAlloc.Get()
 	}
+	for _, startTs := range mgr.Snapshots {
+		if startTs-1 < ts {
+			ts = startTs - 1
+		}
+	}
 	mgr.RUnlock()
 	return
 }
 
 func (mgr *TxnManager) StartTxn(info []byte) (txn txnif.AsyncTxn, err error) {
+	return mgr.StartTxnWithIsolation(info, txnif.SnapshotIsolation)
+}
+
+// StartTxnWithIsolation starts a txn at the isolation level. A serializable
+// txn is registered to the SSI tracker before it is bound to its store.
+func (mgr *TxnManager) StartTxnWithIsolation(info []byte, level txnif.IsolationLevel) (txn txnif.AsyncTxn, err error) {
 	if exp := mgr.Exception.Load(); exp != nil {
 		err = exp.(error)
 		logutil.Warnf("StartTxn: %v", err)
@@ -96,17 +119,45 @@ func (mgr *TxnManager) StartTxn(info []byte) (txn txnif.AsyncTxn, err error) {
 
 	store := mgr.TxnStoreFactory()
 	txn = mgr.TxnFactory(mgr, store, txnId, startTs, info)
+	if level == txnif.Serializable {
+		setter, ok := txn.(interface {
+			SetIsolationLevel(txnif.IsolationLevel)
+		})
+		if !ok {
+			panic("txn does not support the isolation level")
+		}
+		setter.SetIsolationLevel(level)
+		mgr.SSI.Register(txnId, startTs)
+	}
 	store.BindTxn(txn)
 	mgr.Active[txnId] = txn
 	mgr.ActiveMask.Add(startTs)
 	return
 }
 
+// RefreshStartTS moves the start ts of an active txn to a new ts, so that it
+// reads the rows committed by the txns it waited for.
+func (mgr *TxnManager) RefreshStartTS(txn *TxnCtx) {
+	mgr.Lock()
+	defer mgr.Unlock()
+	startTs := mgr.TsAlloc.Alloc()
+	txn.Lock()
+	mgr.ActiveMask.Remove(txn.StartTS)
+	txn.StartTS = startTs
+	txn.Unlock()
+	mgr.ActiveMask.Add(startTs)
+}
+
 func (mgr *TxnManager) DeleteTxn(id uint64) {
+	mgr.LockMgr.Release(id)
 	mgr.Lock()
 	defer mgr.Unlock()
 	txn := mgr.Active[id]
 	delete(mgr.Active, id)
+	if _, ok := mgr.Snapshots[id]; ok {
+		delete(mgr.Snapshots, id)
+		return
+	}
 	mgr.ActiveMask.Remove(txn.GetStartTS())
 }
 
@@ -155,6 +206,9 @@ func (mgr *TxnManager) onPreparing(items ...interface{}) {
 		}
 		mgr.Lock()
 		ts := mgr.TsAlloc.Alloc()
+		if op.Op == OpCommit {
+			mgr.sampleLocked(time.Now())
+		}
 		op.Txn.Lock()
 		if op.Txn.GetError() != nil {
 			op.Op = OpRollback
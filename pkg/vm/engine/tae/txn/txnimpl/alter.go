@@ -0,0 +1,147 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txnimpl
+
+import (
+	"bytes"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	gvec "github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/model"
+)
+
+// AlterTable changes the columns of the table when the txn commits. A txn
+// cannot both alter a table and change its rows.
+func (tbl *txnTable) AlterTable(actions []*catalog.AlterAction) (err error) {
+	if err = tbl.store.beginWrite(); err != nil {
+		return
+	}
+	if tbl.alterEntry != nil || tbl.localSegment != nil || len(tbl.txnEntries) > 0 {
+		err = ErrAlterConflict
+		return
+	}
+	if tbl.schema.Version != tbl.entry.GetSchema().Version {
+		err = catalog.ErrSchemaChanged
+		return
+	}
+	txnEntry, err := tbl.entry.CreateAlterEntry(actions, tbl.store.txn)
+	if err != nil {
+		return
+	}
+	tbl.alterEntry = txnEntry
+	tbl.store.logDDL()
+	tbl.schema = txnEntry.GetSchema()
+	tbl.txnEntries = append(tbl.txnEntries, txnEntry)
+	tbl.store.warChecker.ReadTable(tbl.entry.GetDB().ID, tbl.entry.AsCommonID())
+	return
+}
+
+// getBlockColIdx returns the index of the column colIdx of the txn schema in
+// the block. It returns false if the block was written before the column was
+// added or before its type was widened.
+func (tbl *txnTable) getBlockColIdx(meta *catalog.BlockEntry, colIdx int) (int, bool) {
+	blkSchema := meta.GetSchema()
+	if blkSchema == tbl.schema {
+		return colIdx, true
+	}
+	colDef := tbl.schema.ColDefs[colIdx]
+	blkIdx := blkSchema.GetColIdxBySeqNum(colDef.SeqNum)
+	if blkIdx < 0 || blkSchema.ColDefs[blkIdx].Type.Oid != colDef.Type.Oid {
+		return blkIdx, false
+	}
+	return blkIdx, true
+}
+
+// getBlockColumnData reads the column colIdx of the txn schema from a
+// committed block. The values of a column added after the block was written
+// are the default of the column, and the values of a widened column are
+// converted to the new type.
+func (tbl *txnTable) getBlockColumnData(meta *catalog.BlockEntry, colIdx int, compressed, decompressed *bytes.Buffer) (view *model.ColumnView, err error) {
+	block := meta.GetBlockData()
+	blkIdx, ok := tbl.getBlockColIdx(meta, colIdx)
+	if ok {
+		return block.GetColumnDataById(tbl.store.txn, blkIdx, compressed, decompressed)
+	}
+	colDef := tbl.schema.ColDefs[colIdx]
+	if blkIdx >= 0 {
+		if view, err = block.GetColumnDataById(tbl.store.txn, blkIdx, compressed, decompressed); err != nil {
+			return
+		}
+		view.ColIdx = colIdx
+		view.AppliedVec = compute.CastVector(view.AppliedVec, colDef.Type)
+		return
+	}
+	pkView, err := block.GetColumnDataById(tbl.store.txn, int(meta.GetSchema().PrimaryKey), nil, nil)
+	if err != nil {
+		return
+	}
+	view = model.NewColumnView(pkView.Ts, colIdx)
+	view.DeleteMask = pkView.DeleteMask
+	view.AppliedVec = compute.MakeDefaultVector(colDef.Type, colDef.Default, gvec.Length(pkView.AppliedVec))
+	return
+}
+
+// getBlockValue reads the value of the column col of the txn schema at row of
+// a committed block, see getBlockColumnData.
+func (tbl *txnTable) getBlockValue(meta *catalog.BlockEntry, row uint32, col int) (v interface{}, err error) {
+	block := meta.GetBlockData()
+	blkIdx, ok := tbl.getBlockColIdx(meta, col)
+	if ok {
+		return block.GetValue(tbl.store.txn, row, uint16(blkIdx))
+	}
+	colDef := tbl.schema.ColDefs[col]
+	if blkIdx < 0 {
+		if colDef.Default == nil {
+			return
+		}
+		return common.DecodeKey(colDef.Default, colDef.Type), nil
+	}
+	if v, err = block.GetValue(tbl.store.txn, row, uint16(blkIdx)); err != nil {
+		return
+	}
+	return compute.CastValue(v, colDef.Type), nil
+}
+
+// rewriteBlockRow updates a row of a committed block, which has no physical
+// column for col of the txn schema, is set to NULL or is the partition
+// column, by deleting the row and appending it again with the new value.
+func (tbl *txnTable) rewriteBlockRow(meta *catalog.BlockEntry, row uint32, col int, v interface{}) (err error) {
+	bat := catalog.MockData(tbl.schema, 0)
+	for i := range tbl.schema.ColDefs {
+		if i == col {
+			if v == nil {
+				bat.Vecs[i] = compute.MakeDefaultVector(tbl.schema.ColDefs[i].Type, nil, 1)
+			} else {
+				compute.AppendValue(bat.Vecs[i], v)
+			}
+			continue
+		}
+		var view *model.ColumnView
+		if view, err = tbl.getBlockColumnData(meta, i, nil, nil); err != nil {
+			return
+		}
+		compute.AppendValue(bat.Vecs[i], compute.GetValue(view.AppliedVec, row))
+		if nulls.Contains(view.AppliedVec.Nsp, uint64(row)) {
+			nulls.Add(bat.Vecs[i].Nsp, 0)
+		}
+	}
+	if err = tbl.rangeDelete(meta.AsCommonID(), row, row); err != nil {
+		return
+	}
+	return tbl.Append(bat)
+}
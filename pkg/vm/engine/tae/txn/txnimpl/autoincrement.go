@@ -0,0 +1,172 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txnimpl
+
+import (
+	"errors"
+	"math"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	gvec "github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
+)
+
+var (
+	ErrAutoIncrementOverflow = errors.New("tae: auto increment value out of range")
+)
+
+// fillAutoIncrement assigns the values of the auto increment column to the
+// rows of bat whose value is NULL or 0, in the order of the rows. The column
+// is added to bat if bat has all the columns but it. An explicit value makes
+// the values allocated later greater than it.
+func (tbl *txnTable) fillAutoIncrement(bat *batch.Batch) (err error) {
+	schema := tbl.GetSchema()
+	idx := schema.GetAutoIncrementIdx()
+	if idx < 0 {
+		return
+	}
+	colDef := schema.ColDefs[idx]
+	if len(bat.Vecs) == len(schema.ColDefs)-1 {
+		vec := compute.MakeDefaultVector(colDef.Type, nil, gvec.Length(bat.Vecs[0]))
+		bat.Vecs = append(bat.Vecs[:idx], append([]*gvec.Vector{vec}, bat.Vecs[idx:]...)...)
+		if len(bat.Attrs) == len(schema.ColDefs)-1 {
+			bat.Attrs = append(bat.Attrs[:idx], append([]string{colDef.Name}, bat.Attrs[idx:]...)...)
+		}
+	}
+	vec := bat.Vecs[idx]
+	rows := uint32(gvec.Length(vec))
+	logged := false
+	start := uint32(0)
+	for row := uint32(0); row <= rows; row++ {
+		var v uint64
+		explicit := false
+		if row < rows {
+			if !nulls.Contains(vec.Nsp, uint64(row)) {
+				v, explicit = autoIncrementValue(compute.GetValue(vec, row))
+			}
+			if !explicit {
+				continue
+			}
+		}
+		// Allocate the values of the rows without one before the row
+		if row > start {
+			if err = tbl.allocAutoIncrement(vec, start, row, &logged); err != nil {
+				return
+			}
+		}
+		if explicit && tbl.entry.ObserveAutoIncrement(v) {
+			logged = true
+		}
+		start = row + 1
+	}
+	if logged && tbl.autoIncEntry == nil {
+		tbl.autoIncEntry = catalog.NewAutoIncrementEntry(tbl.entry)
+		tbl.txnEntries = append(tbl.txnEntries, tbl.autoIncEntry)
+	}
+	return
+}
+
+func (tbl *txnTable) allocAutoIncrement(vec *gvec.Vector, start, end uint32, logged *bool) error {
+	first, ok := tbl.entry.AllocAutoIncrement(uint64(end - start))
+	if ok {
+		*logged = true
+	}
+	if first+uint64(end-start)-1 > autoIncrementMax(vec.Typ) {
+		return ErrAutoIncrementOverflow
+	}
+	for row := start; row < end; row++ {
+		v := first + uint64(row-start)
+		if err := compute.SetFixSizeTypeValue(vec, row, castAutoIncrement(v, vec.Typ)); err != nil {
+			return err
+		}
+		nulls.Del(vec.Nsp, uint64(row))
+	}
+	tbl.store.txn.SetLastInsertID(first)
+	return nil
+}
+
+// autoIncrementValue returns the explicit value of an auto increment column.
+// ok is false if v is 0 or negative, which asks for a generated value.
+func autoIncrementValue(v interface{}) (uint64, bool) {
+	var val int64
+	switch v := v.(type) {
+	case int8:
+		val = int64(v)
+	case int16:
+		val = int64(v)
+	case int32:
+		val = int64(v)
+	case int64:
+		val = v
+	case uint8:
+		return uint64(v), v != 0
+	case uint16:
+		return uint64(v), v != 0
+	case uint32:
+		return uint64(v), v != 0
+	case uint64:
+		return v, v != 0
+	default:
+		panic("not supported")
+	}
+	return uint64(val), val > 0
+}
+
+func autoIncrementMax(typ types.Type) uint64 {
+	switch typ.Oid {
+	case types.T_int8:
+		return math.MaxInt8
+	case types.T_int16:
+		return math.MaxInt16
+	case types.T_int32:
+		return math.MaxInt32
+	case types.T_int64:
+		return math.MaxInt64
+	case types.T_uint8:
+		return math.MaxUint8
+	case types.T_uint16:
+		return math.MaxUint16
+	case types.T_uint32:
+		return math.MaxUint32
+	case types.T_uint64:
+		return math.MaxUint64
+	}
+	panic("not supported")
+}
+
+func castAutoIncrement(v uint64, typ types.Type) interface{} {
+	switch typ.Oid {
+	case types.T_int8:
+		return int8(v)
+	case types.T_int16:
+		return int16(v)
+	case types.T_int32:
+		return int32(v)
+	case types.T_int64:
+		return int64(v)
+	case types.T_uint8:
+		return uint8(v)
+	case types.T_uint16:
+		return uint16(v)
+	case types.T_uint32:
+		return uint32(v)
+	case types.T_uint64:
+		return v
+	}
+	panic("not supported")
+}
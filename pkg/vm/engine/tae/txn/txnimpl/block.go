@@ -187,14 +187,14 @@ func (blk *txnBlock) GetColumnDataById(colIdx int, compressed, decompressed *byt
 	if blk.isUncommitted {
 		return blk.table.localSegment.GetColumnDataById(blk.entry, colIdx, compressed, decompressed)
 	}
-	return blk.entry.GetBlockData().GetColumnDataById(blk.Txn, colIdx, compressed, decompressed)
+	return blk.table.getBlockColumnData(blk.entry, colIdx, compressed, decompressed)
 }
 func (blk *txnBlock) GetColumnDataByName(attr string, compressed, decompressed *bytes.Buffer) (*model.ColumnView, error) {
+	attrId := blk.table.GetSchema().GetColIdx(attr)
 	if blk.isUncommitted {
-		attrId := blk.table.entry.GetSchema().GetColIdx(attr)
 		return blk.table.localSegment.GetColumnDataById(blk.entry, attrId, compressed, decompressed)
 	}
-	return blk.entry.GetBlockData().GetColumnDataByName(blk.Txn, attr, compressed, decompressed)
+	return blk.table.getBlockColumnData(blk.entry, attrId, compressed, decompressed)
 }
 
 func (blk *txnBlock) LogTxnEntry(entry txnif.TxnEntry, readed []*common.ID) (err error) {
@@ -211,9 +211,9 @@ func (blk *txnBlock) GetByFilter(filter *handle.Filter) (offset uint32, err erro
 }
 
 // TODO: segmentit or tableit
-func newRelationBlockIt(rel handle.Relation) *relBlockIt {
+func newRelationBlockIt(rel *txnRelation) *relBlockIt {
 	it := new(relBlockIt)
-	segmentIt := rel.MakeSegmentIt()
+	segmentIt := newSegmentIt(rel.table)
 	if !segmentIt.Valid() {
 		it.err = segmentIt.GetError()
 		return it
@@ -0,0 +1,853 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txnimpl
+
+import (
+	"math"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	gvec "github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables/updates"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnbase"
+)
+
+// rowRef is a row of a committed block or of the local segment
+type rowRef struct {
+	id  *common.ID
+	row uint32
+}
+
+// fkRef is a foreign key of child referencing the table
+type fkRef struct {
+	child *txnTable
+	fk    *catalog.ForeignKeyInfo
+}
+
+// removedKeys are the keys referenced by ref that a txn deleted or updated
+type removedKeys struct {
+	ref  fkRef
+	keys map[string]interface{}
+}
+
+// latestTxn reads the rows committed by all the txns, including the ones
+// committed or committing after txn started, and none of the uncommitted
+// writes
+type latestTxn struct {
+	txnif.AsyncTxn
+}
+
+func (txn latestTxn) GetStartTS() uint64 { return txnif.UncommitTS - 1 }
+
+func vectorValue(vec *gvec.Vector, row int) interface{} {
+	if nulls.Contains(vec.Nsp, uint64(row)) {
+		return nil
+	}
+	return compute.GetValue(vec, uint32(row))
+}
+
+// checkAppendConstraints checks the rows of bat against the NOT NULL, CHECK,
+// UNIQUE and FOREIGN KEY constraints of the table. A duplicated unique value
+// returns txnbase.ErrDuplicated like a duplicated primary key.
+func (tbl *txnTable) checkAppendConstraints(bat *batch.Batch) (err error) {
+	schema := tbl.GetSchema()
+	if !schema.HasConstraints() {
+		return
+	}
+	for idx, colDef := range schema.ColDefs {
+		if colDef.NullAbility == 1 && nulls.Any(bat.Vecs[idx].Nsp) {
+			return txnbase.NewConstraintError(txnbase.ErrNotNullViolation, colDef.Name)
+		}
+	}
+	rows := gvec.Length(bat.Vecs[0])
+	for _, check := range schema.Checks {
+		for row := 0; row < rows; row++ {
+			value := func(seqNum uint16) interface{} {
+				return vectorValue(bat.Vecs[schema.GetColIdxBySeqNum(seqNum)], row)
+			}
+			if check.Pred.Eval(value) == catalog.CheckFalse {
+				return txnbase.NewConstraintError(txnbase.ErrCheckViolation, check.Name)
+			}
+		}
+	}
+	for _, col := range schema.UniqueColumns() {
+		keys := make(map[string]interface{})
+		for row := 0; row < rows; row++ {
+			v := vectorValue(bat.Vecs[col], row)
+			if v == nil {
+				continue
+			}
+			key := encodePK(v)
+			if _, ok := keys[key]; ok {
+				return txnbase.NewConstraintError(txnbase.ErrDuplicated, uniqueIndexName(schema, col))
+			}
+			keys[key] = v
+		}
+		if err = tbl.dedupOnColumn(col, keys); err != nil {
+			return
+		}
+	}
+	for _, fk := range schema.ForeignKeys {
+		col := schema.GetColIdxBySeqNum(fk.Column)
+		keys := make(map[string]interface{})
+		for row := 0; row < rows; row++ {
+			if v := vectorValue(bat.Vecs[col], row); v != nil {
+				keys[encodePK(v)] = v
+			}
+		}
+		if fk.IsSelfRef() {
+			// The rows may reference the rows of the same batch
+			refCol := schema.GetColIdxBySeqNum(fk.RefColumn)
+			for row := 0; row < rows; row++ {
+				if v := vectorValue(bat.Vecs[refCol], row); v != nil {
+					delete(keys, encodePK(v))
+				}
+			}
+		}
+		if err = tbl.checkReferenced(fk, keys); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// checkUpdateConstraints checks the update of the column col of a row to v,
+// nil for NULL.
+func (tbl *txnTable) checkUpdateConstraints(id *common.ID, row uint32, col int, v interface{}) (err error) {
+	schema := tbl.GetSchema()
+	colDef := schema.ColDefs[col]
+	if v == nil && colDef.NullAbility == 1 {
+		return txnbase.NewConstraintError(txnbase.ErrNotNullViolation, colDef.Name)
+	}
+	for _, check := range schema.Checks {
+		reads := false
+		for _, seqNum := range check.Pred.Columns() {
+			reads = reads || seqNum == colDef.SeqNum
+		}
+		if !reads {
+			continue
+		}
+		var readErr error
+		value := func(seqNum uint16) interface{} {
+			if seqNum == colDef.SeqNum {
+				return v
+			}
+			val, err := tbl.getRowValue(id, row, schema.GetColIdxBySeqNum(seqNum))
+			if err != nil {
+				readErr = err
+			}
+			return val
+		}
+		res := check.Pred.Eval(value)
+		if readErr != nil {
+			return readErr
+		}
+		if res == catalog.CheckFalse {
+			return txnbase.NewConstraintError(txnbase.ErrCheckViolation, check.Name)
+		}
+	}
+	if v == nil {
+		return
+	}
+	keys := map[string]interface{}{encodePK(v): v}
+	if schema.IsUniqueColumn(col) {
+		var old interface{}
+		if old, err = tbl.getRowValue(id, row, col); err != nil {
+			return
+		}
+		if old == nil || encodePK(old) != encodePK(v) {
+			if err = tbl.dedupOnColumn(col, keys); err != nil {
+				return
+			}
+		}
+	}
+	for _, fk := range schema.ForeignKeys {
+		if fk.Column == colDef.SeqNum {
+			if err = tbl.checkReferenced(fk, map[string]interface{}{encodePK(v): v}); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
+// getRowValue returns the value of the column col of a row, nil for NULL.
+func (tbl *txnTable) getRowValue(id *common.ID, row uint32, col int) (v interface{}, err error) {
+	err = tbl.scanRows(id, row, row, col, func(_ uint32, val interface{}) bool {
+		v = val
+		return false
+	})
+	return
+}
+
+// scanRows calls fn on the non-null values of the column col of the rows
+// [start, end] of the block id not deleted, until fn returns false.
+func (tbl *txnTable) scanRows(id *common.ID, start, end uint32, col int, fn func(row uint32, v interface{}) bool) (err error) {
+	if isLocalSegment(id) {
+		if tbl.localSegment == nil {
+			return
+		}
+		_, err = tbl.localSegment.ScanColumn(col, start, end, fn)
+		return
+	}
+	seg, err := tbl.entry.GetSegmentByID(id.SegmentID)
+	if err != nil {
+		return
+	}
+	meta, err := seg.GetBlockEntryByID(id.BlockID)
+	if err != nil {
+		return
+	}
+	_, err = tbl.scanBlockColumn(meta, col, start, end, fn)
+	return
+}
+
+// scanBlockColumn is ScanColumn of the local segment on a committed block.
+func (tbl *txnTable) scanBlockColumn(meta *catalog.BlockEntry, col int, start, end uint32, fn func(row uint32, v interface{}) bool) (next bool, err error) {
+	view, err := tbl.getBlockColumnData(meta, col, nil, nil)
+	if err != nil || view == nil || view.AppliedVec == nil {
+		return true, err
+	}
+	vec := view.AppliedVec
+	for row := start; row < uint32(gvec.Length(vec)) && row <= end; row++ {
+		if view.DeleteMask != nil && view.DeleteMask.Contains(row) {
+			continue
+		}
+		if v := vectorValue(vec, int(row)); v != nil && !fn(row, v) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// scanColumn calls fn on the non-null values of the column col of all the
+// rows seen by the txn, until fn returns false. The committed blocks whose
+// indexes of the column prove that they have none of keys are skipped.
+func (tbl *txnTable) scanColumn(col int, keys map[string]interface{}, fn func(id *common.ID, row uint32, v interface{}) bool) (err error) {
+	if tbl.localSegment != nil {
+		id := tbl.localSegment.entry.AsCommonID()
+		next, err := tbl.localSegment.ScanColumn(col, 0, math.MaxUint32, func(row uint32, v interface{}) bool {
+			return fn(id, row, v)
+		})
+		if err != nil || !next {
+			return err
+		}
+	}
+	blockIt := newRelationBlockIt(newRelation(tbl))
+	for blockIt.Valid() {
+		h := blockIt.GetBlock()
+		if h.IsUncommitted() {
+			blockIt.Next()
+			continue
+		}
+		meta := h.GetMeta().(*catalog.BlockEntry)
+		if !tbl.blockMayContainKeys(meta, col, keys) {
+			blockIt.Next()
+			continue
+		}
+		id := meta.AsCommonID()
+		next, err := tbl.scanBlockColumn(meta, col, 0, math.MaxUint32, func(row uint32, v interface{}) bool {
+			return fn(id, row, v)
+		})
+		if err != nil || !next {
+			return err
+		}
+		blockIt.Next()
+	}
+	return
+}
+
+// blockMayContainKeys returns false if the indexes of the column col of the
+// committed block prove that none of keys is in it.
+func (tbl *txnTable) blockMayContainKeys(meta *catalog.BlockEntry, col int, keys map[string]interface{}) bool {
+	blkIdx, ok := tbl.getBlockColIdx(meta, col)
+	if !ok {
+		return true
+	}
+	blkData := meta.GetBlockData()
+	for _, v := range keys {
+		if blkData.MayContainsKeyOnColumn(uint16(blkIdx), v) {
+			return true
+		}
+	}
+	return false
+}
+
+// dedupOnColumn returns txnbase.ErrDuplicated if any of keys is found in the
+// unique column col. The committed blocks are checked with the indexes of
+// the column like the primary key.
+func (tbl *txnTable) dedupOnColumn(col int, keys map[string]interface{}) (err error) {
+	if len(keys) == 0 {
+		return
+	}
+	defer func() {
+		if err == txnbase.ErrDuplicated {
+			err = txnbase.NewConstraintError(err, uniqueIndexName(tbl.GetSchema(), col))
+		}
+	}()
+	found := func(_ *common.ID, _ uint32, v interface{}) bool {
+		if _, ok := keys[encodePK(v)]; ok {
+			err = txnbase.ErrDuplicated
+			return false
+		}
+		return true
+	}
+	if tbl.localSegment != nil {
+		id := tbl.localSegment.entry.AsCommonID()
+		if _, err2 := tbl.localSegment.ScanColumn(col, 0, math.MaxUint32, func(row uint32, v interface{}) bool {
+			return found(id, row, v)
+		}); err2 != nil {
+			return err2
+		}
+		if err != nil {
+			return
+		}
+	}
+	vec := gvec.New(tbl.GetSchema().ColDefs[col].Type)
+	for _, v := range keys {
+		compute.AppendValue(vec, v)
+	}
+	blockIt := newRelationBlockIt(newRelation(tbl))
+	for blockIt.Valid() {
+		h := blockIt.GetBlock()
+		if h.IsUncommitted() {
+			blockIt.Next()
+			continue
+		}
+		meta := h.GetMeta().(*catalog.BlockEntry)
+		if blkIdx, ok := tbl.getBlockColIdx(meta, col); ok {
+			err = meta.GetBlockData().BatchDedupOnColumn(tbl.store.txn, uint16(blkIdx), vec)
+		} else if _, err2 := tbl.scanBlockColumn(meta, col, 0, math.MaxUint32, func(row uint32, v interface{}) bool {
+			return found(nil, row, v)
+		}); err2 != nil {
+			err = err2
+		}
+		if err != nil {
+			return
+		}
+		blockIt.Next()
+	}
+	return
+}
+
+// preCommitDedupUnique checks the unique values appended by the txn against
+// the rows committed by the other txns.
+func (tbl *txnTable) preCommitDedupUnique() (err error) {
+	for _, col := range tbl.schema.UniqueColumns() {
+		vec := gvec.New(tbl.schema.ColDefs[col].Type)
+		if _, err = tbl.localSegment.ScanColumn(col, 0, math.MaxUint32, func(_ uint32, v interface{}) bool {
+			compute.AppendValue(vec, v)
+			return true
+		}); err != nil {
+			return
+		}
+		if gvec.Length(vec) == 0 {
+			continue
+		}
+		segIt := tbl.entry.MakeSegmentIt(false)
+		for segIt.Valid() {
+			seg := segIt.Get().GetPayload().(*catalog.SegmentEntry)
+			blkIt := seg.MakeBlockIt(false)
+			for blkIt.Valid() {
+				blk := blkIt.Get().GetPayload().(*catalog.BlockEntry)
+				blk.RLock()
+				skip := blk.IsCreatedUncommitted() || blk.IsDroppedCommitted()
+				blk.RUnlock()
+				if blkIdx, ok := tbl.getBlockColIdx(blk, col); ok && !skip {
+					if err = blk.GetBlockData().BatchDedupOnColumn(tbl.store.txn, uint16(blkIdx), vec); err != nil {
+						if err == txnbase.ErrDuplicated {
+							err = txnbase.NewConstraintError(err, uniqueIndexName(tbl.schema, col))
+						}
+						return
+					}
+				}
+				blkIt.Next()
+			}
+			segIt.Next()
+		}
+	}
+	return
+}
+
+func uniqueIndexName(schema *catalog.Schema, col int) string {
+	for _, index := range schema.Indexes {
+		if index.Type == catalog.Unique && int(index.Columns[0]) == col {
+			return index.Name
+		}
+	}
+	return schema.ColDefs[col].Name
+}
+
+func (tbl *txnTable) getRefTable(fk *catalog.ForeignKeyInfo) (*txnTable, error) {
+	if fk.IsSelfRef() {
+		return tbl, nil
+	}
+	db, err := tbl.store.getOrSetDB(fk.RefDB)
+	if err != nil {
+		return nil, err
+	}
+	return db.getOrSetTable(fk.RefTable)
+}
+
+// checkReferenced returns ErrNoReferencedRow if any of keys is not found in
+// the column referenced by fk.
+func (tbl *txnTable) checkReferenced(fk *catalog.ForeignKeyInfo, keys map[string]interface{}) (err error) {
+	if len(keys) == 0 {
+		return
+	}
+	parent, err := tbl.getRefTable(fk)
+	if err != nil {
+		return
+	}
+	refCol := parent.GetSchema().GetColIdxBySeqNum(fk.RefColumn)
+	if parent.GetSchema().IsPartOfPK(refCol) {
+		for _, v := range keys {
+			var id *common.ID
+			id, _, err = parent.GetByFilter(handle.NewEQFilter(v))
+			if err == txnbase.ErrNotFound || (err == nil && id == nil) {
+				return txnbase.NewConstraintError(txnbase.ErrNoReferencedRow, fk.Name)
+			}
+			if err != nil {
+				return
+			}
+		}
+		return
+	}
+	if err = parent.scanColumn(refCol, keys, func(_ *common.ID, _ uint32, v interface{}) bool {
+		delete(keys, encodePK(v))
+		return len(keys) > 0
+	}); err != nil {
+		return
+	}
+	if len(keys) > 0 {
+		err = txnbase.NewConstraintError(txnbase.ErrNoReferencedRow, fk.Name)
+	}
+	return
+}
+
+// getReferences returns the foreign keys referencing the table. They are
+// collected once in a txn.
+func (tbl *txnTable) getReferences() (refs []fkRef, err error) {
+	if tbl.fkRefs != nil {
+		return *tbl.fkRefs, nil
+	}
+	dbIt := newDBIt(tbl.store.txn, tbl.store.catalog)
+	for dbIt.Valid() {
+		var db *txnDB
+		if db, err = tbl.store.getOrSetDB(dbIt.GetCurr().GetID()); err != nil {
+			return
+		}
+		tableIt := newRelationIt(db)
+		for tableIt.Valid() {
+			entry := tableIt.curr
+			referenced := false
+			for _, fk := range entry.GetSchema().ForeignKeys {
+				if fk.IsSelfRef() {
+					referenced = referenced || entry.GetID() == tbl.GetID()
+				} else {
+					referenced = referenced || (fk.RefDB == tbl.entry.GetDB().GetID() && fk.RefTable == tbl.GetID())
+				}
+			}
+			if referenced {
+				var child *txnTable
+				if child, err = db.getOrSetTable(entry.GetID()); err != nil {
+					return
+				}
+				for _, fk := range child.GetSchema().ForeignKeys {
+					if ref, _ := child.getRefTable(fk); ref == tbl {
+						refs = append(refs, fkRef{child: child, fk: fk})
+					}
+				}
+			}
+			tableIt.Next()
+		}
+		if err = tableIt.GetError(); err != nil {
+			return
+		}
+		dbIt.Next()
+	}
+	if err = dbIt.GetError(); err != nil {
+		return
+	}
+	tbl.fkRefs = &refs
+	return
+}
+
+// findReferencing returns the rows of the child table of ref whose value of
+// the foreign key is one of keys.
+func (ref fkRef) findReferencing(keys map[string]interface{}) (rows []rowRef, err error) {
+	if len(keys) == 0 {
+		return
+	}
+	col := ref.child.GetSchema().GetColIdxBySeqNum(ref.fk.Column)
+	err = ref.child.scanColumn(col, keys, func(id *common.ID, row uint32, v interface{}) bool {
+		if _, ok := keys[encodePK(v)]; ok {
+			rows = append(rows, rowRef{id: id, row: row})
+		}
+		return true
+	})
+	return
+}
+
+// getRowKeys returns the non-null values of the column col of the rows
+// [start, end] of the block id.
+func (tbl *txnTable) getRowKeys(id *common.ID, start, end uint32, col int) (keys map[string]interface{}, err error) {
+	keys = make(map[string]interface{})
+	err = tbl.scanRows(id, start, end, col, func(_ uint32, v interface{}) bool {
+		keys[encodePK(v)] = v
+		return true
+	})
+	return
+}
+
+func inRange(ref rowRef, id *common.ID, start, end uint32) bool {
+	return ref.id.SegmentID == id.SegmentID && ref.id.BlockID == id.BlockID && ref.row >= start && ref.row <= end
+}
+
+// deleteReferencedRows deletes the rows [start, end] of the block id, which
+// are referenced by refs, and takes the ON DELETE actions of the foreign
+// keys. The referencing rows are found before the rows are deleted and the
+// actions are taken after, so a cycle of cascades ends.
+func (tbl *txnTable) deleteReferencedRows(id *common.ID, start, end uint32, refs []fkRef) (err error) {
+	matches := make([][]rowRef, len(refs))
+	keys := make([]map[string]interface{}, len(refs))
+	for i, ref := range refs {
+		if keys[i], err = tbl.getRowKeys(id, start, end, tbl.GetSchema().GetColIdxBySeqNum(ref.fk.RefColumn)); err != nil {
+			return
+		}
+		var rows []rowRef
+		if rows, err = ref.findReferencing(keys[i]); err != nil {
+			return
+		}
+		for _, row := range rows {
+			// The rows deleted together do not reference each other
+			if ref.child != tbl || !inRange(row, id, start, end) {
+				matches[i] = append(matches[i], row)
+			}
+		}
+		if len(matches[i]) > 0 && ref.fk.OnDelete == catalog.RefRestrict {
+			return txnbase.NewConstraintError(txnbase.ErrRowIsReferenced, ref.fk.Name)
+		}
+	}
+	if err = tbl.rangeDelete(id, start, end); err != nil {
+		return
+	}
+	for i, ref := range refs {
+		tbl.logRemovedKeys(ref, keys[i])
+		col := ref.child.GetSchema().GetColIdxBySeqNum(ref.fk.Column)
+		for _, row := range matches[i] {
+			switch ref.fk.OnDelete {
+			case catalog.RefCascade:
+				err = ref.child.RangeDelete(row.id, row.row, row.row)
+			case catalog.RefSetNull:
+				err = ref.child.Update(row.id, row.row, uint16(col), nil)
+			}
+			if err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
+// updateReferencedRow updates the column col of a row, which is referenced
+// by refs, and takes the ON UPDATE actions of the foreign keys.
+func (tbl *txnTable) updateReferencedRow(id *common.ID, row uint32, col uint16, v interface{}, refs []fkRef) (err error) {
+	old, err := tbl.getRowValue(id, row, int(col))
+	if err != nil {
+		return
+	}
+	if old == nil || (v != nil && encodePK(old) == encodePK(v)) {
+		return tbl.update(id, row, col, v)
+	}
+	keys := map[string]interface{}{encodePK(old): old}
+	matches := make([][]rowRef, len(refs))
+	for i, ref := range refs {
+		if matches[i], err = ref.findReferencing(keys); err != nil {
+			return
+		}
+		if len(matches[i]) > 0 && ref.fk.OnUpdate == catalog.RefRestrict {
+			return txnbase.NewConstraintError(txnbase.ErrRowIsReferenced, ref.fk.Name)
+		}
+	}
+	if err = tbl.update(id, row, col, v); err != nil {
+		return
+	}
+	for i, ref := range refs {
+		tbl.logRemovedKeys(ref, keys)
+		childCol := uint16(ref.child.GetSchema().GetColIdxBySeqNum(ref.fk.Column))
+		for _, match := range matches[i] {
+			switch ref.fk.OnUpdate {
+			case catalog.RefCascade:
+				err = ref.child.Update(match.id, match.row, childCol, v)
+			case catalog.RefSetNull:
+				err = ref.child.Update(match.id, match.row, childCol, nil)
+			}
+			if err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
+// logRemovedKeys records the keys referenced by ref that the txn deleted or
+// updated, to check them again at commit.
+func (tbl *txnTable) logRemovedKeys(ref fkRef, keys map[string]interface{}) {
+	if len(keys) == 0 {
+		return
+	}
+	tbl.store.logUndo(&removedKeysUndo{tbl: tbl, n: len(tbl.removedKeys)})
+	tbl.removedKeys = append(tbl.removedKeys, removedKeys{ref: ref, keys: keys})
+}
+
+// preCommitCheckForeignKeys checks the foreign keys against the rows
+// committed by the other txns after the txn started. The values written to
+// the foreign keys must still be referenced rows, and the referenced keys
+// removed by the txn must not be referenced by the rows of the other txns.
+func (tbl *txnTable) preCommitCheckForeignKeys() (err error) {
+	schema := tbl.GetSchema()
+	for _, fk := range schema.ForeignKeys {
+		var keys map[string]interface{}
+		if keys, err = tbl.getWrittenKeys(schema.GetColIdxBySeqNum(fk.Column)); err != nil {
+			return
+		}
+		if len(keys) == 0 {
+			continue
+		}
+		var parent *txnTable
+		if parent, err = tbl.getRefTable(fk); err != nil {
+			return
+		}
+		if err = parent.removeLatestKeys(parent.GetSchema().GetColIdxBySeqNum(fk.RefColumn), keys); err != nil {
+			return
+		}
+		if len(keys) > 0 {
+			return txnbase.NewConstraintError(txnbase.ErrNoReferencedRow, fk.Name)
+		}
+	}
+	for _, removed := range tbl.removedKeys {
+		keys := make(map[string]interface{}, len(removed.keys))
+		for k, v := range removed.keys {
+			keys[k] = v
+		}
+		// The keys written again are still referenced
+		if err = tbl.removeLatestKeys(schema.GetColIdxBySeqNum(removed.ref.fk.RefColumn), keys); err != nil {
+			return
+		}
+		if len(keys) == 0 {
+			continue
+		}
+		child := removed.ref.child
+		referenced := false
+		if err = child.scanLatestColumn(child.GetSchema().GetColIdxBySeqNum(removed.ref.fk.Column), keys, func(v interface{}) bool {
+			_, referenced = keys[encodePK(v)]
+			return !referenced
+		}); err != nil {
+			return
+		}
+		if referenced {
+			return txnbase.NewConstraintError(txnbase.ErrRowIsReferenced, removed.ref.fk.Name)
+		}
+	}
+	return
+}
+
+// getWrittenKeys returns the non-null values of the column col appended or
+// updated by the txn.
+func (tbl *txnTable) getWrittenKeys(col int) (keys map[string]interface{}, err error) {
+	keys = make(map[string]interface{})
+	err = tbl.scanWrittenColumn(col, func(v interface{}) bool {
+		keys[encodePK(v)] = v
+		return true
+	})
+	return
+}
+
+// scanWrittenColumn calls fn on the non-null values of the column col of the
+// rows appended by the txn and the rows of the committed blocks updated by
+// the txn and not deleted, until fn returns false.
+func (tbl *txnTable) scanWrittenColumn(col int, fn func(v interface{}) bool) (err error) {
+	next := true
+	if tbl.localSegment != nil {
+		if _, err = tbl.localSegment.ScanColumn(col, 0, math.MaxUint32, func(_ uint32, v interface{}) bool {
+			next = fn(v)
+			return next
+		}); err != nil || !next {
+			return
+		}
+	}
+	for id, node := range tbl.updateNodes {
+		var meta *catalog.BlockEntry
+		if meta, err = tbl.getBlockEntry(&id); err != nil {
+			return
+		}
+		if blkIdx, ok := tbl.getBlockColIdx(meta, col); !ok || blkIdx != int(id.Idx) {
+			continue
+		}
+		chain := node.GetChain().(*updates.ColumnChain)
+		chain.RLock()
+		vals := make(map[uint32]interface{})
+		for row, v := range node.(*updates.ColumnNode).GetValues() {
+			vals[row] = v
+		}
+		chain.RUnlock()
+		for row, v := range vals {
+			if v == nil || tbl.isDeletedByTxn(meta, row) {
+				continue
+			}
+			if !fn(v) {
+				return
+			}
+		}
+	}
+	return
+}
+
+func (tbl *txnTable) getBlockEntry(id *common.ID) (meta *catalog.BlockEntry, err error) {
+	seg, err := tbl.entry.GetSegmentByID(id.SegmentID)
+	if err != nil {
+		return
+	}
+	return seg.GetBlockEntryByID(id.BlockID)
+}
+
+// removeLatestKeys removes from keys the values of the column col written by
+// the txn, or committed by any txn and not deleted or updated by the txn. The primary key is looked up by its index.
+func (tbl *txnTable) removeLatestKeys(col int, keys map[string]interface{}) (err error) {
+	if err = tbl.scanWrittenColumn(col, func(v interface{}) bool {
+		delete(keys, encodePK(v))
+		return len(keys) > 0
+	}); err != nil || len(keys) == 0 {
+		return
+	}
+	if !tbl.GetSchema().IsPartOfPK(col) {
+		return tbl.scanLatestColumn(col, keys, func(v interface{}) bool {
+			delete(keys, encodePK(v))
+			return len(keys) > 0
+		})
+	}
+	txn := latestTxn{tbl.store.txn}
+	return tbl.forEachLatestBlock(func(meta *catalog.BlockEntry) (bool, error) {
+		for k, v := range keys {
+			row, err := meta.GetBlockData().GetByFilter(txn, handle.NewEQFilter(v))
+			if err == txnbase.ErrNotFound {
+				continue
+			}
+			if err != nil {
+				return false, err
+			}
+			if !tbl.isChangedByTxn(meta, col, row) {
+				delete(keys, k)
+			}
+		}
+		return len(keys) > 0, nil
+	})
+}
+
+// scanLatestColumn calls fn on the non-null values of the column col of the
+// rows committed by any txn and not deleted or updated by the txn, until fn
+// returns false. The blocks whose indexes of the column prove that they
+// have none of keys are skipped.
+func (tbl *txnTable) scanLatestColumn(col int, keys map[string]interface{}, fn func(v interface{}) bool) error {
+	txn := latestTxn{tbl.store.txn}
+	return tbl.forEachLatestBlock(func(meta *catalog.BlockEntry) (bool, error) {
+		blkIdx, ok := tbl.getBlockColIdx(meta, col)
+		if !ok || !tbl.blockMayContainKeys(meta, col, keys) {
+			return true, nil
+		}
+		view, err := meta.GetBlockData().GetColumnDataById(txn, blkIdx, nil, nil)
+		if err != nil || view == nil || view.AppliedVec == nil {
+			return true, err
+		}
+		defer view.Free()
+		vec := view.AppliedVec
+		for row := uint32(0); row < uint32(gvec.Length(vec)); row++ {
+			if view.DeleteMask != nil && view.DeleteMask.Contains(row) {
+				continue
+			}
+			if tbl.isChangedByTxn(meta, col, row) {
+				continue
+			}
+			if v := vectorValue(vec, int(row)); v != nil && !fn(v) {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+}
+
+// forEachLatestBlock calls fn on the committed blocks of the table, including
+// the ones created after the txn started, until fn returns false.
+func (tbl *txnTable) forEachLatestBlock(fn func(meta *catalog.BlockEntry) (bool, error)) error {
+	segIt := tbl.entry.MakeSegmentIt(false)
+	for segIt.Valid() {
+		seg := segIt.Get().GetPayload().(*catalog.SegmentEntry)
+		blkIt := seg.MakeBlockIt(false)
+		for blkIt.Valid() {
+			meta := blkIt.Get().GetPayload().(*catalog.BlockEntry)
+			meta.RLock()
+			skip := meta.IsCreatedUncommitted() || meta.IsDroppedCommitted()
+			meta.RUnlock()
+			if !skip {
+				if next, err := fn(meta); err != nil || !next {
+					return err
+				}
+			}
+			blkIt.Next()
+		}
+		segIt.Next()
+	}
+	return nil
+}
+
+// isChangedByTxn returns true if the row of a committed block is deleted by
+// the txn, or its column col is updated by the txn.
+func (tbl *txnTable) isChangedByTxn(meta *catalog.BlockEntry, col int, row uint32) bool {
+	if tbl.isDeletedByTxn(meta, row) {
+		return true
+	}
+	id := *meta.AsCommonID()
+	blkIdx, ok := tbl.getBlockColIdx(meta, col)
+	if !ok {
+		return false
+	}
+	id.Idx = uint16(blkIdx)
+	if node := tbl.updateNodes[id]; node != nil {
+		chain := node.GetChain().(*updates.ColumnChain)
+		chain.RLock()
+		defer chain.RUnlock()
+		return node.(*updates.ColumnNode).HasUpdateLocked(row)
+	}
+	return false
+}
+
+// isDeletedByTxn returns true if the row of a committed block is deleted by
+// the txn.
+func (tbl *txnTable) isDeletedByTxn(meta *catalog.BlockEntry, row uint32) bool {
+	node := tbl.deleteNodes[*meta.AsCommonID()]
+	if node == nil {
+		return false
+	}
+	controller := node.GetChain().(*updates.DeleteChain).GetController()
+	sharedLock := controller.GetSharedLock()
+	defer sharedLock.Unlock()
+	return node.(*updates.DeleteNode).IsDeletedLocked(row)
+}
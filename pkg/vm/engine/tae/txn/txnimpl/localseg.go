@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/buffer/base"
@@ -45,6 +46,10 @@ type localSegment struct {
 	rows        uint32
 	appends     []*appendCtx
 	tableHandle data.TableHandle
+	// runs are the partitions of the rows of a partitioned table and
+	// partHandles the handles appending to them
+	runs        []*partitionRun
+	partHandles map[uint32]data.TableHandle
 }
 
 func newLocalSegment(table *txnTable) *localSegment {
@@ -86,12 +91,14 @@ func (seg *localSegment) ApplyAppend() (err error) {
 			appendNode txnif.AppendNode
 		)
 		bat, _ := ctx.node.Window(ctx.start, ctx.start+ctx.count-1)
-		if appendNode, destOff, err = ctx.driver.ApplyAppend(bat, 0, ctx.count, seg.table.store.txn); err != nil {
+		// The deleted rows are skipped by the window
+		destLen := uint32(vector.Length(bat.Vecs[0]))
+		if appendNode, destOff, err = ctx.driver.ApplyAppend(bat, 0, destLen, seg.table.store.txn); err != nil {
 			return
 		}
 		ctx.driver.Close()
 		id := ctx.driver.GetID()
-		info := ctx.node.AddApplyInfo(ctx.start, ctx.count, destOff, ctx.count, seg.table.entry.GetDB().ID, id)
+		info := ctx.node.AddApplyInfo(ctx.start, ctx.count, destOff, destLen, seg.table.entry.GetDB().ID, id)
 		logutil.Debugf(info.String())
 		if err = appendNode.PrepareCommit(); err != nil {
 			return
@@ -101,10 +108,16 @@ func (seg *localSegment) ApplyAppend() (err error) {
 	if seg.tableHandle != nil {
 		seg.table.entry.GetTableData().ApplyHandle(seg.tableHandle)
 	}
+	for _, h := range seg.partHandles {
+		seg.table.entry.GetTableData().ApplyHandle(h)
+	}
 	return
 }
 
 func (seg *localSegment) PrepareApply() (err error) {
+	if seg.table.GetSchema().IsPartitioned() {
+		return seg.prepareApplyPartitions()
+	}
 	for _, node := range seg.nodes {
 		if err = seg.prepareApplyNode(node); err != nil {
 			break
@@ -118,11 +131,18 @@ func (seg *localSegment) prepareApplyNode(node InsertNode) (err error) {
 	if seg.tableHandle == nil {
 		seg.tableHandle = tableData.GetHandle()
 	}
-	appended := uint32(0)
-	for appended < node.RowsWithoutDeletes() {
-		appender, err := seg.tableHandle.GetAppender()
+	return seg.prepareApplyRange(seg.tableHandle, 0, node, 0, node.RowsWithoutDeletes())
+}
+
+// prepareApplyRange prepares appending the rows not deleted of node, from
+// the from-th one to the to-th one, to the table partition of ID partition
+// by h.
+func (seg *localSegment) prepareApplyRange(h data.TableHandle, partition uint32, node InsertNode, from, to uint32) (err error) {
+	appended := from
+	for appended < to {
+		appender, err := h.GetAppender()
 		if err == data.ErrAppendableSegmentNotFound {
-			segH, err := seg.table.CreateSegment()
+			segH, err := seg.table.createSegment(catalog.ES_Appendable, partition)
 			if err != nil {
 				return err
 			}
@@ -130,21 +150,21 @@ func (seg *localSegment) prepareApplyNode(node InsertNode) (err error) {
 			if err != nil {
 				return err
 			}
-			appender = seg.tableHandle.SetAppender(blk.Fingerprint())
+			appender = h.SetAppender(blk.Fingerprint())
 		} else if err == data.ErrAppendableBlockNotFound {
 			id := appender.GetID()
 			blk, err := seg.table.CreateBlock(id.SegmentID)
 			if err != nil {
 				return err
 			}
-			appender = seg.tableHandle.SetAppender(blk.Fingerprint())
+			appender = h.SetAppender(blk.Fingerprint())
 		}
-		toAppend, err := appender.PrepareAppend(node.RowsWithoutDeletes() - appended)
+		toAppend, err := appender.PrepareAppend(to - appended)
 		toAppendWithDeletes := node.LengthWithDeletes(appended, toAppend)
 		ctx := &appendCtx{
 			driver: appender,
 			node:   node,
-			start:  appended,
+			start:  node.OffsetWithDeletes(appended),
 			count:  toAppendWithDeletes,
 		}
 		id := appender.GetID()
@@ -160,6 +180,30 @@ func (seg *localSegment) prepareApplyNode(node InsertNode) (err error) {
 }
 
 func (seg *localSegment) Append(data *batch.Batch) (err error) {
+	undo := &localAppend{seg: seg, start: seg.Rows()}
+	defer func() {
+		undo.end = seg.Rows()
+		seg.table.store.logUndo(undo)
+	}()
+	if !seg.table.GetSchema().IsPartitioned() {
+		return seg.append(data)
+	}
+	parts, bats, err := seg.table.splitByPartition(data)
+	if err != nil {
+		return
+	}
+	for i, bat := range bats {
+		start := seg.rows
+		err = seg.append(bat)
+		seg.addRun(start, seg.rows-start, parts[i])
+		if err != nil {
+			break
+		}
+	}
+	return
+}
+
+func (seg *localSegment) append(data *batch.Batch) (err error) {
 	if seg.appendable == nil {
 		seg.registerInsertNode()
 	}
@@ -199,45 +243,34 @@ func (seg *localSegment) Append(data *batch.Batch) (err error) {
 }
 
 func (seg *localSegment) RangeDelete(start, end uint32) error {
+	for row := start; row <= end; row++ {
+		if seg.IsDeleted(row) {
+			return txnbase.ErrNotFound
+		}
+	}
+	seg.table.store.logUndo(&localDelete{seg: seg, start: start, end: end})
 	first, firstOffset := seg.GetLocalPhysicalAxis(start)
 	last, lastOffset := seg.GetLocalPhysicalAxis(end)
+	pk := int(seg.table.GetSchema().PrimaryKey)
 	var err error
-	if last == first {
-		node := seg.nodes[first]
-		err = node.RangeDelete(firstOffset, lastOffset)
-		if err == nil {
-			for i := firstOffset; i <= lastOffset; i++ {
-				v, _ := node.GetValue(int(seg.table.entry.GetSchema().PrimaryKey), i)
-				if err = seg.index.Delete(v); err != nil {
-					break
-				}
-			}
+	for npos := first; npos <= last && err == nil; npos++ {
+		from, to := uint32(0), txnbase.MaxNodeRows-1
+		if npos == first {
+			from = firstOffset
 		}
-	} else {
-		node := seg.nodes[first]
-		err = node.RangeDelete(firstOffset, txnbase.MaxNodeRows-1)
-		node = seg.nodes[last]
-		err = node.RangeDelete(0, lastOffset)
-		for i := uint32(0); i <= lastOffset; i++ {
-			v, _ := node.GetValue(int(seg.table.entry.GetSchema().PrimaryKey), i)
+		if npos == last {
+			to = lastOffset
+		}
+		node := seg.nodes[npos]
+		if err = node.RangeDelete(from, to); err != nil {
+			break
+		}
+		for i := from; i <= to; i++ {
+			v, _ := node.GetValue(pk, i)
 			if err = seg.index.Delete(v); err != nil {
 				break
 			}
 		}
-		if last > first+1 && err == nil {
-			for i := first + 1; i < last; i++ {
-				node = seg.nodes[i]
-				if err = node.RangeDelete(0, txnbase.MaxNodeRows); err != nil {
-					break
-				}
-				for i := uint32(0); i <= txnbase.MaxNodeRows; i++ {
-					v, _ := node.GetValue(int(seg.table.entry.GetSchema().PrimaryKey), i)
-					if err = seg.index.Delete(v); err != nil {
-						break
-					}
-				}
-			}
-		}
 	}
 	return err
 }
@@ -287,22 +320,63 @@ func (seg *localSegment) Update(row uint32, col uint16, value interface{}) error
 	if err != nil {
 		return err
 	}
-	if err = n.RangeDelete(uint32(noffset), uint32(noffset)); err != nil {
+	if err = seg.RangeDelete(row, row); err != nil {
 		return err
 	}
-	v, _ := n.GetValue(int(seg.table.entry.GetSchema().PrimaryKey), row)
-	if err = seg.index.Delete(v); err != nil {
-		panic(err)
-	}
 
-	vec := vector.New(window.Vecs[col].Typ)
-	compute.AppendValue(vec, value)
+	var vec *vector.Vector
+	if value == nil {
+		vec = compute.MakeDefaultVector(window.Vecs[col].Typ, nil, 1)
+	} else {
+		vec = vector.New(window.Vecs[col].Typ)
+		compute.AppendValue(vec, value)
+	}
 	window.Vecs[col] = vec
 
 	err = seg.Append(window)
 	return err
 }
 
+// ScanColumn calls fn on the non-null values of the column colIdx of the
+// rows [start, end] not deleted, until fn returns false. next is false if
+// fn returned false.
+func (seg *localSegment) ScanColumn(colIdx int, start, end uint32, fn func(row uint32, v interface{}) bool) (next bool, err error) {
+	rows := seg.Rows()
+	if rows == 0 || start >= rows {
+		return true, nil
+	}
+	if end >= rows {
+		end = rows - 1
+	}
+	first, _ := seg.GetLocalPhysicalAxis(start)
+	last, _ := seg.GetLocalPhysicalAxis(end)
+	for npos := first; npos <= last; npos++ {
+		n := seg.nodes[npos]
+		view := model.NewColumnView(seg.table.store.txn.GetStartTS(), colIdx)
+		h, err := seg.table.store.nodesMgr.TryPin(n, time.Second)
+		if err != nil {
+			return false, err
+		}
+		err = n.FillColumnView(view, nil, nil)
+		h.Close()
+		if err != nil {
+			return false, err
+		}
+		vec := view.AppliedVec
+		base := uint32(npos) * txnbase.MaxNodeRows
+		for i := 0; i < vector.Length(vec); i++ {
+			row := base + uint32(i)
+			if row < start || row > end || n.IsRowDeleted(uint32(i)) || nulls.Contains(vec.Nsp, uint64(i)) {
+				continue
+			}
+			if !fn(row, compute.GetValue(vec, uint32(i))) {
+				return false, nil
+			}
+		}
+	}
+	return true, nil
+}
+
 func (seg *localSegment) Rows() uint32 {
 	cnt := len(seg.nodes)
 	if cnt == 0 {
@@ -324,7 +398,7 @@ func (seg *localSegment) GetByFilter(filter *handle.Filter) (id *common.ID, offs
 }
 
 func (seg *localSegment) GetPrimaryColumn() *vector.Vector {
-	schema := seg.table.entry.GetSchema()
+	schema := seg.table.GetSchema()
 	return seg.index.KeyToVector(schema.ColDefs[schema.PrimaryKey].Type)
 }
 
@@ -0,0 +1,84 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txnimpl
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnbase"
+)
+
+type rowLocker interface {
+	LockRow(tableId uint64, key string, mode handle.LockMode, policy handle.LockWaitPolicy) (bool, error)
+}
+
+// encodePK encodes a primary key value as the key of the row locks and the
+// SSI tracker
+func encodePK(v interface{}) string {
+	if buf, ok := v.([]byte); ok {
+		return string(buf)
+	}
+	return fmt.Sprint(v)
+}
+
+func (tbl *txnTable) Lock(pk interface{}, mode handle.LockMode, policy handle.LockWaitPolicy) (bool, error) {
+	locker, ok := tbl.store.txn.(rowLocker)
+	if !ok {
+		return true, nil
+	}
+	return locker.LockRow(tbl.GetID(), encodePK(pk), mode, policy)
+}
+
+// takesRowLocks returns true if the writes of the txn take the row locks.
+// The writes transferred to the compacted or merged blocks when the txn
+// commits were locked by the txns which made them.
+func (tbl *txnTable) takesRowLocks() bool {
+	txn, ok := tbl.store.txn.(interface{ GetLockManager() *txnbase.LockManager })
+	if !ok || txn.GetLockManager() == nil {
+		return false
+	}
+	return tbl.store.txn.GetTxnState(false) == txnif.TxnStateActive
+}
+
+// beforeWriteRows locks the committed rows [start, end] of the block id
+// exclusively, waiting for the other holders, and records the writes to
+// the rows for the SSI tracker. The rows are locked even if no other txn
+// locks the table yet, or a later SELECT ... FOR UPDATE would not wait for
+// the write.
+func (tbl *txnTable) beforeWriteRows(id *common.ID, start, end uint32) (err error) {
+	lock := tbl.takesRowLocks()
+	if !lock && tbl.store.ssi == nil {
+		return
+	}
+	pk := uint16(tbl.GetSchema().PrimaryKey)
+	for row := start; row <= end; row++ {
+		var v interface{}
+		if v, err = tbl.GetValue(id, row, pk); err != nil {
+			return
+		}
+		if lock {
+			if _, err = tbl.Lock(v, handle.LockExclusive, handle.LockWait); err != nil {
+				return
+			}
+		}
+		if tbl.store.ssi != nil {
+			tbl.store.ssi.Write(tbl.store.txn.GetID(), tbl.GetID(), encodePK(v))
+		}
+	}
+	return
+}
@@ -56,6 +56,7 @@ type InsertNode interface {
 	Append(data *gbat.Batch, offset uint32) (appended uint32, err error)
 	RangeDelete(start, end uint32) error
 	IsRowDeleted(row uint32) bool
+	UndoRangeDelete(start, end uint32)
 	PrintDeletes() string
 	FillColumnView(*model.ColumnView, *bytes.Buffer, *bytes.Buffer) error
 	Window(start, end uint32) (*gbat.Batch, error)
@@ -67,6 +68,8 @@ type InsertNode interface {
 	AddApplyInfo(srcOff, srcLen, destOff, destLen uint32, dbid uint64, dest *common.ID) *appendInfo
 	RowsWithoutDeletes() uint32
 	LengthWithDeletes(appended, toAppend uint32) uint32
+	OffsetWithDeletes(count uint32) uint32
+	RowsWithoutDeletesBefore(offset uint32) uint32
 	GetAppends() []*appendInfo
 }
 
@@ -431,12 +434,14 @@ func (n *insertNode) LengthWithDeletes(appended, toAppend uint32) uint32 {
 	if n.deletes == nil || n.deletes.GetCardinality() == 0 {
 		return toAppend
 	}
-	appendedOffset := n.offsetWithDeletes(appended)
-	toAppendOffset := n.offsetWithDeletes(toAppend + appended)
+	appendedOffset := n.OffsetWithDeletes(appended)
+	toAppendOffset := n.OffsetWithDeletes(toAppend + appended)
 	return toAppendOffset - appendedOffset
 }
 
-func (n *insertNode) offsetWithDeletes(count uint32) uint32 {
+// OffsetWithDeletes returns the offset of the row after the first count
+// rows not deleted
+func (n *insertNode) OffsetWithDeletes(count uint32) uint32 {
 	if n.deletes == nil || n.deletes.GetCardinality() == 0 {
 		return count
 	}
@@ -451,6 +456,15 @@ func (n *insertNode) offsetWithDeletes(count uint32) uint32 {
 	return offset
 }
 
+// RowsWithoutDeletesBefore returns the number of the rows not deleted
+// before offset
+func (n *insertNode) RowsWithoutDeletesBefore(offset uint32) uint32 {
+	if offset == 0 || n.deletes == nil {
+		return offset
+	}
+	return offset - uint32(n.deletes.Rank(offset-1))
+}
+
 func (n *insertNode) GetValue(col int, row uint32) (interface{}, error) {
 	vec, err := n.data.GetVectorByAttr(col)
 	if err != nil {
@@ -468,6 +482,13 @@ func (n *insertNode) RangeDelete(start, end uint32) error {
 	return nil
 }
 
+// UndoRangeDelete removes the rows [start, end] from the deleted rows
+func (n *insertNode) UndoRangeDelete(start, end uint32) {
+	if n.deletes != nil {
+		n.deletes.RemoveRange(uint64(start), uint64(end)+1)
+	}
+}
+
 func (n *insertNode) IsRowDeleted(row uint32) bool {
 	if n.deletes == nil {
 		return false
@@ -495,7 +516,7 @@ func (n *insertNode) Window(start, end uint32) (*gbat.Batch, error) {
 			return nil, err
 		}
 		srcVec, _ := src.Window(start, end+1).CopyToVector()
-		deletes := common.BitMapWindow(n.deletes, int(start), int(end))
+		deletes := common.BitMapWindow(n.deletes, int(start), int(end)+1)
 		srcVec = compute.ApplyDeleteToVector(srcVec, deletes)
 		ret.Vecs[i] = srcVec
 	}
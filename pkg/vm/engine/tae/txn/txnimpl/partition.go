@@ -0,0 +1,187 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txnimpl
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	gvec "github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/data"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnbase"
+)
+
+// partitionRun is a run of the rows of the local segment of a partitioned
+// table which belong to the same partition
+type partitionRun struct {
+	start     uint32
+	count     uint32
+	partition uint32
+}
+
+func (seg *localSegment) addRun(start, count, partition uint32) {
+	if count == 0 {
+		return
+	}
+	if n := len(seg.runs); n > 0 {
+		last := seg.runs[n-1]
+		if last.partition == partition && last.start+last.count == start {
+			last.count += count
+			return
+		}
+	}
+	seg.runs = append(seg.runs, &partitionRun{
+		start:     start,
+		count:     count,
+		partition: partition,
+	})
+}
+
+func (seg *localSegment) getPartitionHandle(partition uint32) data.TableHandle {
+	if seg.partHandles == nil {
+		seg.partHandles = make(map[uint32]data.TableHandle)
+	}
+	h := seg.partHandles[partition]
+	if h == nil {
+		h = seg.table.entry.GetTableData().GetPartitionHandle(partition)
+		seg.partHandles[partition] = h
+	}
+	return h
+}
+
+// prepareApplyPartitions prepares appending the rows of each node to the
+// segments of their partitions
+func (seg *localSegment) prepareApplyPartitions() (err error) {
+	for i, node := range seg.nodes {
+		base := uint32(i) * txnbase.MaxNodeRows
+		for _, run := range seg.runs {
+			start, end := run.start, run.start+run.count
+			if start < base {
+				start = base
+			}
+			if end > base+node.Rows() {
+				end = base + node.Rows()
+			}
+			if start >= end {
+				continue
+			}
+			from := node.RowsWithoutDeletesBefore(start - base)
+			to := node.RowsWithoutDeletesBefore(end - base)
+			if from == to {
+				continue
+			}
+			h := seg.getPartitionHandle(run.partition)
+			if err = seg.prepareApplyRange(h, run.partition, node, from, to); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
+// splitByPartition splits the rows of bat by the partitions of the table.
+// parts are the IDs of the partitions of bats.
+func (tbl *txnTable) splitByPartition(bat *batch.Batch) (parts []uint32, bats []*batch.Batch, err error) {
+	schema := tbl.GetSchema()
+	info := schema.Partition
+	vec := bat.Vecs[schema.GetPartitionColumnDef().Idx]
+	sels := make(map[uint32][]uint32)
+	for row := 0; row < gvec.Length(vec); row++ {
+		var v interface{}
+		if !nulls.Contains(vec.Nsp, uint64(row)) {
+			v = compute.GetValue(vec, uint32(row))
+		}
+		part, err := info.Locate(v)
+		if err != nil {
+			return nil, nil, err
+		}
+		if _, ok := sels[part.ID]; !ok {
+			parts = append(parts, part.ID)
+		}
+		sels[part.ID] = append(sels[part.ID], uint32(row))
+	}
+	if len(parts) == 1 {
+		return parts, []*batch.Batch{bat}, nil
+	}
+	bats = make([]*batch.Batch, len(parts))
+	for i, part := range parts {
+		bats[i] = selectRows(bat, sels[part])
+	}
+	return
+}
+
+// selectRows returns a batch of the rows sels of bat
+func selectRows(bat *batch.Batch, sels []uint32) *batch.Batch {
+	ret := batch.New(true, bat.Attrs)
+	for i, vec := range bat.Vecs {
+		ret.Vecs[i] = gvec.New(vec.Typ)
+		for j, row := range sels {
+			compute.AppendValue(ret.Vecs[i], compute.GetValue(vec, row))
+			if nulls.Contains(vec.Nsp, uint64(row)) {
+				nulls.Add(ret.Vecs[i].Nsp, uint64(j))
+			}
+		}
+	}
+	return ret
+}
+
+func (tbl *txnTable) isPartitionColumn(col int) bool {
+	colDef := tbl.GetSchema().GetPartitionColumnDef()
+	return colDef != nil && colDef.Idx == col
+}
+
+// TruncatePartitions drops the segments of the partitions of IDs partitions
+// seen by the txn, with all their blocks. The rows are not rewritten, so it
+// costs the same however many rows the partitions have.
+func (tbl *txnTable) TruncatePartitions(partitions []uint32) (err error) {
+	if err = tbl.store.beginWrite(); err != nil {
+		return
+	}
+	if tbl.localSegment != nil {
+		return ErrAlterConflict
+	}
+	truncated := make(map[uint32]bool, len(partitions))
+	for _, partition := range partitions {
+		truncated[partition] = true
+	}
+	var segs []handle.Segment
+	it := newSegmentIt(tbl)
+	for ; it.Valid(); it.Next() {
+		seg := it.GetSegment()
+		if truncated[seg.GetMeta().(*catalog.SegmentEntry).GetPartition()] {
+			segs = append(segs, seg)
+		}
+	}
+	if err = it.GetError(); err != nil {
+		return
+	}
+	for _, seg := range segs {
+		var blks []uint64
+		for blkIt := seg.MakeBlockIt(); blkIt.Valid(); blkIt.Next() {
+			blks = append(blks, blkIt.GetBlock().Fingerprint().BlockID)
+		}
+		for _, id := range blks {
+			if err = seg.SoftDeleteBlock(id); err != nil {
+				return
+			}
+		}
+		if err = tbl.SoftDeleteSegment(seg.GetID()); err != nil {
+			return
+		}
+	}
+	return
+}
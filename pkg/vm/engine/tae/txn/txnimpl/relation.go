@@ -136,7 +136,7 @@ func (h *txnRelation) SimplePPString(level common.PPLevel) string {
 }
 
 func (h *txnRelation) GetMeta() interface{}   { return h.table.entry }
-func (h *txnRelation) GetSchema() interface{} { return h.table.entry.GetSchema() }
+func (h *txnRelation) GetSchema() interface{} { return h.table.GetSchema() }
 
 func (h *txnRelation) Close() error                     { return nil }
 func (h *txnRelation) Rows() int64                      { return 0 }
@@ -165,17 +165,39 @@ func (h *txnRelation) CreateNonAppendableSegment() (seg handle.Segment, err erro
 	return h.Txn.GetStore().CreateNonAppendableSegment(h.table.entry.GetDB().ID, h.table.entry.GetID())
 }
 
+func (h *txnRelation) CreateNonAppendablePartitionSegment(partition uint32) (seg handle.Segment, err error) {
+	return h.table.CreateNonAppendablePartitionSegment(partition)
+}
+
+func (h *txnRelation) TruncatePartitions(partitions []uint32) error {
+	return h.table.TruncatePartitions(partitions)
+}
+
 func (h *txnRelation) SoftDeleteSegment(id uint64) (err error) {
 	fp := h.table.entry.AsCommonID()
 	fp.SegmentID = id
 	return h.Txn.GetStore().SoftDeleteSegment(h.table.entry.GetDB().ID, fp)
 }
 
+func (h *txnRelation) CreateIndex(def interface{}) error {
+	return h.table.CreateIndex(def.(*catalog.IndexInfo))
+}
+
+func (h *txnRelation) DropIndex(name string) error {
+	return h.table.DropIndex(name)
+}
+
+func (h *txnRelation) AlterTable(actions interface{}) error {
+	return h.table.AlterTable(actions.([]*catalog.AlterAction))
+}
+
 func (h *txnRelation) MakeSegmentIt() handle.SegmentIt {
+	h.table.ssiReadTable()
 	return newSegmentIt(h.table)
 }
 
 func (h *txnRelation) MakeBlockIt() handle.BlockIt {
+	h.table.ssiReadTable()
 	return newRelationBlockIt(h)
 }
 
@@ -183,14 +205,18 @@ func (h *txnRelation) GetByFilter(filter *handle.Filter) (*common.ID, uint32, er
 	return h.Txn.GetStore().GetByFilter(h.table.entry.GetDB().ID, h.table.entry.GetID(), filter)
 }
 
+func (h *txnRelation) Lock(pk interface{}, mode handle.LockMode, policy handle.LockWaitPolicy) (bool, error) {
+	return h.Txn.GetStore().Lock(h.table.entry.GetDB().ID, h.table.entry.GetID(), pk, mode, policy)
+}
+
 func (h *txnRelation) UpdateByFilter(filter *handle.Filter, col uint16, v interface{}) (err error) {
 	id, row, err := h.table.GetByFilter(filter)
 	if err != nil {
 		return
 	}
-	schema := h.table.entry.GetSchema()
+	schema := h.table.GetSchema()
 	if !schema.IsPartOfPK(int(col)) {
-		err = h.table.Update(id, row, col, v)
+		err = h.Update(id, row, col, v)
 		return
 	}
 	bat := catalog.MockData(schema, 0)
@@ -201,10 +227,10 @@ func (h *txnRelation) UpdateByFilter(filter *handle.Filter, col uint16, v interf
 		}
 		compute.AppendValue(bat.Vecs[i], colVal)
 	}
-	if err = h.table.RangeDelete(id, row, row); err != nil {
+	if err = h.RangeDelete(id, row, row); err != nil {
 		return
 	}
-	err = h.table.Append(bat)
+	err = h.Append(bat)
 	return
 }
 
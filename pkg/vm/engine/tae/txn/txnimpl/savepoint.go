@@ -0,0 +1,211 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txnimpl
+
+import (
+	"errors"
+	"sync/atomic"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables/updates"
+)
+
+var (
+	ErrSavepointDDL = errors.New("tae: cannot roll back the ddl to a savepoint")
+)
+
+// undoRecord undoes a write of the txn. The records are undone in the
+// reverse order of the writes.
+type undoRecord interface {
+	undo() error
+}
+
+// storeSavepoint is a position in the undo log of a txn store. Rolling back
+// to it undoes the appends, deletes and updates logged after it. The ddl
+// made after it cannot be undone.
+type storeSavepoint struct {
+	undos    int
+	writeOps uint32
+	ddlOps   uint32
+}
+
+func (store *txnStore) logUndo(r undoRecord) {
+	store.undos = append(store.undos, r)
+}
+
+func (store *txnStore) logDDL() {
+	store.ddlOps++
+}
+
+func (store *txnStore) MakeSavepoint() (txnif.Savepoint, error) {
+	return &storeSavepoint{
+		undos:    len(store.undos),
+		writeOps: atomic.LoadUint32(&store.writeOps),
+		ddlOps:   store.ddlOps,
+	}, nil
+}
+
+func (store *txnStore) RollbackToSavepoint(savepoint txnif.Savepoint) (err error) {
+	sp := savepoint.(*storeSavepoint)
+	// Nothing is undone if any ddl was made after the savepoint
+	if store.ddlOps != sp.ddlOps {
+		return ErrSavepointDDL
+	}
+	for i := len(store.undos) - 1; i >= sp.undos; i-- {
+		if err = store.undos[i].undo(); err != nil {
+			return
+		}
+		store.undos = store.undos[:i]
+	}
+	atomic.StoreUint32(&store.writeOps, sp.writeOps)
+	return
+}
+
+// localAppend undoes the rows [start, end) appended to the local segment.
+// The rows are kept in the insert nodes as deleted rows, which are skipped
+// when applied.
+type localAppend struct {
+	seg        *localSegment
+	start, end uint32
+}
+
+func (r *localAppend) undo() error {
+	seg := r.seg
+	if r.end > r.start {
+		pk := int(seg.table.GetSchema().PrimaryKey)
+		_, err := seg.ScanColumn(pk, r.start, r.end-1, func(row uint32, v interface{}) bool {
+			if buf, ok := v.([]byte); ok {
+				v = string(buf)
+			}
+			// A failed append may not have indexed all its rows
+			if indexed, err := seg.index.Find(v); err == nil && indexed == row {
+				_ = seg.index.Delete(v)
+			}
+			npos, noffset := seg.GetLocalPhysicalAxis(row)
+			_ = seg.nodes[npos].RangeDelete(noffset, noffset)
+			return true
+		})
+		if err != nil {
+			return err
+		}
+	}
+	seg.rows = seg.Rows()
+	return nil
+}
+
+// localDelete undoes the delete of the rows [start, end] of the local
+// segment
+type localDelete struct {
+	seg        *localSegment
+	start, end uint32
+}
+
+func (r *localDelete) undo() error {
+	seg := r.seg
+	pk := uint16(seg.table.GetSchema().PrimaryKey)
+	for row := r.start; row <= r.end; row++ {
+		npos, noffset := seg.GetLocalPhysicalAxis(row)
+		seg.nodes[npos].UndoRangeDelete(noffset, noffset)
+		v, err := seg.GetValue(row, pk)
+		if err != nil {
+			return err
+		}
+		if buf, ok := v.([]byte); ok {
+			v = string(buf)
+		}
+		if err = seg.index.Insert(v, row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// blockDelete undoes the delete of the rows [start, end] of a committed
+// block. The delete node is rolled back if it was created by the delete.
+type blockDelete struct {
+	tbl        *txnTable
+	id         common.ID
+	node       txnif.DeleteNode
+	start, end uint32
+	created    bool
+}
+
+func (r *blockDelete) undo() error {
+	if r.created {
+		delete(r.tbl.deleteNodes, r.id)
+		return r.tbl.rollbackTxnEntry(r.node)
+	}
+	controller := r.node.GetChain().(*updates.DeleteChain).GetController()
+	writeLock := controller.GetExclusiveLock()
+	r.node.(*updates.DeleteNode).UndoRangeDeleteLocked(r.start, r.end)
+	writeLock.Unlock()
+	return nil
+}
+
+// blockUpdate undoes the update of a row of a committed block. The update
+// node is rolled back if it was created by the update.
+type blockUpdate struct {
+	tbl     *txnTable
+	id      common.ID
+	node    txnif.UpdateNode
+	row     uint32
+	prev    interface{}
+	updated bool
+	created bool
+}
+
+func (r *blockUpdate) undo() error {
+	if r.created {
+		delete(r.tbl.updateNodes, r.id)
+		return r.tbl.rollbackTxnEntry(r.node)
+	}
+	chain := r.node.GetChain().(*updates.ColumnChain)
+	sharedLock := chain.GetController().GetSharedLock()
+	chain.Lock()
+	r.node.(*updates.ColumnNode).UndoUpdateLocked(r.row, r.prev, r.updated)
+	chain.Unlock()
+	sharedLock.Unlock()
+	return nil
+}
+
+// rollbackTxnEntry rolls back the uncommitted txnEntry and removes it from
+// the entries of the table
+func (tbl *txnTable) rollbackTxnEntry(txnEntry txnif.TxnEntry) (err error) {
+	if err = txnEntry.PrepareRollback(); err != nil {
+		return
+	}
+	if err = txnEntry.ApplyRollback(); err != nil {
+		return
+	}
+	for i := len(tbl.txnEntries) - 1; i >= 0; i-- {
+		if tbl.txnEntries[i] == txnEntry {
+			tbl.txnEntries = append(tbl.txnEntries[:i], tbl.txnEntries[i+1:]...)
+			break
+		}
+	}
+	return
+}
+
+// removedKeysUndo undoes the referenced keys removed by a write
+type removedKeysUndo struct {
+	tbl *txnTable
+	n   int
+}
+
+func (r *removedKeysUndo) undo() error {
+	r.tbl.removedKeys = r.tbl.removedKeys[:r.n]
+	return nil
+}
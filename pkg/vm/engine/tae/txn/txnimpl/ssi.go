@@ -0,0 +1,44 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txnimpl
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
+)
+
+func (tbl *txnTable) ssiReadTable() {
+	if tbl.store.ssi == nil {
+		return
+	}
+	tbl.store.ssi.ReadTable(tbl.store.txn.GetID(), tbl.GetID())
+}
+
+func (tbl *txnTable) ssiReadKey(v interface{}) {
+	if tbl.store.ssi == nil {
+		return
+	}
+	tbl.store.ssi.ReadKey(tbl.store.txn.GetID(), tbl.GetID(), encodePK(v))
+}
+
+func (tbl *txnTable) ssiWriteKeys(pks *vector.Vector) {
+	if tbl.store.ssi == nil {
+		return
+	}
+	for row := 0; row < vector.Length(pks); row++ {
+		v := compute.GetValue(pks, uint32(row))
+		tbl.store.ssi.Write(tbl.store.txn.GetID(), tbl.GetID(), encodePK(v))
+	}
+}
@@ -44,6 +44,15 @@ type txnStore struct {
 	warChecker  *warChecker
 	dataFactory *tables.DataFactory
 	writeOps    uint32
+	// ssi is nil unless the txn is serializable
+	ssi *txnbase.SSITracker
+	// undos undo the writes in the reverse order to roll back to a
+	// savepoint, ddlOps counts the ddl that cannot be undone
+	undos  []undoRecord
+	ddlOps uint32
+	// snapshot is true if the txn is started by StartTxnAt, which can not
+	// write
+	snapshot bool
 }
 
 var TxnStoreFactory = func(catalog *catalog.Catalog, driver wal.Driver, txnBufMgr base.INodeManager, dataFactory *tables.DataFactory) txnbase.TxnStoreFactory {
@@ -72,6 +81,17 @@ func (store *txnStore) IncreateWriteCnt() int {
 	return int(atomic.AddUint32(&store.writeOps, uint32(1)))
 }
 
+// beginWrite counts a write of the txn. A snapshot txn reads the past, so
+// its writes are rejected before they reach the update and delete chains
+// of the live txns.
+func (store *txnStore) beginWrite() error {
+	if store.snapshot {
+		return txnbase.ErrTxnSnapshotReadonly
+	}
+	store.IncreateWriteCnt()
+	return nil
+}
+
 func (store *txnStore) LogTxnEntry(dbId uint64, tableId uint64, entry txnif.TxnEntry, readed []*common.ID) (err error) {
 	db, err := store.getOrSetDB(dbId)
 	if err != nil {
@@ -106,6 +126,14 @@ func (store *txnStore) Close() error {
 
 func (store *txnStore) BindTxn(txn txnif.AsyncTxn) {
 	store.txn = txn
+	if t, ok := txn.(interface{ IsSnapshot() bool }); ok {
+		store.snapshot = t.IsSnapshot()
+	}
+	if t, ok := txn.(interface {
+		GetSSITracker() *txnbase.SSITracker
+	}); ok {
+		store.ssi = t.GetSSITracker()
+	}
 }
 
 func (store *txnStore) BatchDedup(dbId, id uint64, pks *vector.Vector) (err error) {
@@ -121,7 +149,9 @@ func (store *txnStore) BatchDedup(dbId, id uint64, pks *vector.Vector) (err erro
 }
 
 func (store *txnStore) Append(dbId, id uint64, data *batch.Batch) error {
-	store.IncreateWriteCnt()
+	if err := store.beginWrite(); err != nil {
+		return err
+	}
 	db, err := store.getOrSetDB(dbId)
 	if err != nil {
 		return err
@@ -133,7 +163,9 @@ func (store *txnStore) Append(dbId, id uint64, data *batch.Batch) error {
 }
 
 func (store *txnStore) RangeDelete(dbId uint64, id *common.ID, start, end uint32) (err error) {
-	store.IncreateWriteCnt()
+	if err = store.beginWrite(); err != nil {
+		return
+	}
 	db, err := store.getOrSetDB(dbId)
 	if err != nil {
 		return err
@@ -168,8 +200,18 @@ func (store *txnStore) GetValue(dbId uint64, id *common.ID, row uint32, colIdx u
 	return db.GetValue(id, row, colIdx)
 }
 
+func (store *txnStore) Lock(dbId, tid uint64, pk interface{}, mode handle.LockMode, policy handle.LockWaitPolicy) (bool, error) {
+	db, err := store.getOrSetDB(dbId)
+	if err != nil {
+		return false, err
+	}
+	return db.Lock(tid, pk, mode, policy)
+}
+
 func (store *txnStore) Update(dbId uint64, id *common.ID, row uint32, colIdx uint16, v interface{}) (err error) {
-	store.IncreateWriteCnt()
+	if err = store.beginWrite(); err != nil {
+		return
+	}
 	db, err := store.getOrSetDB(dbId)
 	if err != nil {
 		return err
@@ -207,7 +249,9 @@ func (store *txnStore) GetDatabase(name string) (h handle.Database, err error) {
 }
 
 func (store *txnStore) CreateDatabase(name string) (h handle.Database, err error) {
-	store.IncreateWriteCnt()
+	if err = store.beginWrite(); err != nil {
+		return
+	}
 	meta, err := store.catalog.CreateDBEntry(name, store.txn)
 	if err != nil {
 		return nil, err
@@ -224,7 +268,9 @@ func (store *txnStore) CreateDatabase(name string) (h handle.Database, err error
 }
 
 func (store *txnStore) DropDatabase(name string) (h handle.Database, err error) {
-	store.IncreateWriteCnt()
+	if err = store.beginWrite(); err != nil {
+		return
+	}
 	meta, err := store.catalog.DropDBEntry(name, store.txn)
 	if err != nil {
 		return
@@ -241,7 +287,9 @@ func (store *txnStore) DropDatabase(name string) (h handle.Database, err error)
 }
 
 func (store *txnStore) CreateRelation(dbId uint64, def interface{}) (relation handle.Relation, err error) {
-	store.IncreateWriteCnt()
+	if err = store.beginWrite(); err != nil {
+		return
+	}
 	db, err := store.getOrSetDB(dbId)
 	if err != nil {
 		return
@@ -250,7 +298,9 @@ func (store *txnStore) CreateRelation(dbId uint64, def interface{}) (relation ha
 }
 
 func (store *txnStore) DropRelationByName(dbId uint64, name string) (relation handle.Relation, err error) {
-	store.IncreateWriteCnt()
+	if err = store.beginWrite(); err != nil {
+		return
+	}
 	db, err := store.getOrSetDB(dbId)
 	if err != nil {
 		return nil, err
@@ -275,7 +325,9 @@ func (store *txnStore) GetSegment(dbId uint64, id *common.ID) (seg handle.Segmen
 }
 
 func (store *txnStore) CreateSegment(dbId, tid uint64) (seg handle.Segment, err error) {
-	store.IncreateWriteCnt()
+	if err = store.beginWrite(); err != nil {
+		return
+	}
 	var db *txnDB
 	if db, err = store.getOrSetDB(dbId); err != nil {
 		return
@@ -284,7 +336,9 @@ func (store *txnStore) CreateSegment(dbId, tid uint64) (seg handle.Segment, err
 }
 
 func (store *txnStore) CreateNonAppendableSegment(dbId, tid uint64) (seg handle.Segment, err error) {
-	store.IncreateWriteCnt()
+	if err = store.beginWrite(); err != nil {
+		return
+	}
 	var db *txnDB
 	if db, err = store.getOrSetDB(dbId); err != nil {
 		return
@@ -306,7 +360,9 @@ func (store *txnStore) getOrSetDB(id uint64) (db *txnDB, err error) {
 }
 
 func (store *txnStore) CreateNonAppendableBlock(dbId uint64, id *common.ID) (blk handle.Block, err error) {
-	store.IncreateWriteCnt()
+	if err = store.beginWrite(); err != nil {
+		return
+	}
 	var db *txnDB
 	if db, err = store.getOrSetDB(dbId); err != nil {
 		return
@@ -323,7 +379,9 @@ func (store *txnStore) GetBlock(dbId uint64, id *common.ID) (blk handle.Block, e
 }
 
 func (store *txnStore) CreateBlock(dbId, tid, sid uint64) (blk handle.Block, err error) {
-	store.IncreateWriteCnt()
+	if err = store.beginWrite(); err != nil {
+		return
+	}
 	var db *txnDB
 	if db, err = store.getOrSetDB(dbId); err != nil {
 		return
@@ -332,7 +390,9 @@ func (store *txnStore) CreateBlock(dbId, tid, sid uint64) (blk handle.Block, err
 }
 
 func (store *txnStore) SoftDeleteBlock(dbId uint64, id *common.ID) (err error) {
-	store.IncreateWriteCnt()
+	if err = store.beginWrite(); err != nil {
+		return
+	}
 	var db *txnDB
 	if db, err = store.getOrSetDB(dbId); err != nil {
 		return
@@ -341,7 +401,9 @@ func (store *txnStore) SoftDeleteBlock(dbId uint64, id *common.ID) (err error) {
 }
 
 func (store *txnStore) SoftDeleteSegment(dbId uint64, id *common.ID) (err error) {
-	store.IncreateWriteCnt()
+	if err = store.beginWrite(); err != nil {
+		return
+	}
 	var db *txnDB
 	if db, err = store.getOrSetDB(dbId); err != nil {
 		return
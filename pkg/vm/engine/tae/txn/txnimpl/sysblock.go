@@ -28,7 +28,7 @@ func newSysBlock(table *txnTable, meta *catalog.BlockEntry) *txnSysBlock {
 }
 
 func (blk *txnSysBlock) isSysTable() bool {
-	return sysTableNames[blk.table.entry.GetSchema().Name]
+	return sysTableNames[blk.table.GetSchema().Name]
 }
 
 func (blk *txnSysBlock) GetTotalChanges() int {
@@ -32,6 +32,7 @@ import (
 
 var (
 	ErrDuplicateNode = errors.New("tae: duplicate node")
+	ErrAlterConflict = errors.New("tae: table is altered and changed in the same txn")
 )
 
 type txnTable struct {
@@ -42,9 +43,16 @@ type txnTable struct {
 	updateNodes  map[common.ID]txnif.UpdateNode
 	deleteNodes  map[common.ID]txnif.DeleteNode
 	entry        *catalog.TableEntry
+	schema       *catalog.Schema
+	alterEntry   *catalog.AlterEntry
+	autoIncEntry *catalog.AutoIncrementEntry
 	logs         []wal.LogEntry
 	maxSegId     uint64
 	maxBlkId     uint64
+	fkRefs       *[]fkRef
+	// removedKeys are the referenced keys deleted or updated by the txn,
+	// which are checked again against the referencing rows at commit
+	removedKeys []removedKeys
 
 	txnEntries []txnif.TxnEntry
 	csnStart   uint32
@@ -54,6 +62,7 @@ func newTxnTable(store *txnStore, entry *catalog.TableEntry) *txnTable {
 	tbl := &txnTable{
 		store:       store,
 		entry:       entry,
+		schema:      entry.GetSchema(),
 		updateNodes: make(map[common.ID]txnif.UpdateNode),
 		deleteNodes: make(map[common.ID]txnif.DeleteNode),
 		logs:        make([]wal.LogEntry, 0),
@@ -129,36 +138,38 @@ func (tbl *txnTable) SoftDeleteSegment(id uint64) (err error) {
 	if err != nil {
 		return
 	}
+	tbl.store.logDDL()
 	tbl.txnEntries = append(tbl.txnEntries, txnEntry)
 	tbl.store.warChecker.ReadTable(tbl.entry.GetDB().ID, tbl.entry.AsCommonID())
 	return
 }
 
 func (tbl *txnTable) CreateNonAppendableSegment() (seg handle.Segment, err error) {
-	var meta *catalog.SegmentEntry
-	var factory catalog.SegmentDataFactory
-	if tbl.store.dataFactory != nil {
-		factory = tbl.store.dataFactory.MakeSegmentFactory()
-	}
-	if meta, err = tbl.entry.CreateSegment(tbl.store.txn, catalog.ES_NotAppendable, factory); err != nil {
+	return tbl.createSegment(catalog.ES_NotAppendable, 0)
+}
+
+func (tbl *txnTable) CreateNonAppendablePartitionSegment(partition uint32) (seg handle.Segment, err error) {
+	if err = tbl.store.beginWrite(); err != nil {
 		return
 	}
-	seg = newSegment(tbl, meta)
-	tbl.txnEntries = append(tbl.txnEntries, meta)
-	tbl.store.warChecker.ReadTable(tbl.entry.GetDB().ID, meta.GetTable().AsCommonID())
-	return
+	return tbl.createSegment(catalog.ES_NotAppendable, partition)
 }
 
 func (tbl *txnTable) CreateSegment() (seg handle.Segment, err error) {
+	return tbl.createSegment(catalog.ES_Appendable, 0)
+}
+
+func (tbl *txnTable) createSegment(state catalog.EntryState, partition uint32) (seg handle.Segment, err error) {
 	var meta *catalog.SegmentEntry
 	var factory catalog.SegmentDataFactory
 	if tbl.store.dataFactory != nil {
 		factory = tbl.store.dataFactory.MakeSegmentFactory()
 	}
-	if meta, err = tbl.entry.CreateSegment(tbl.store.txn, catalog.ES_Appendable, factory); err != nil {
+	if meta, err = tbl.entry.CreatePartitionSegment(tbl.store.txn, state, partition, factory); err != nil {
 		return
 	}
 	seg = newSegment(tbl, meta)
+	tbl.store.logDDL()
 	tbl.txnEntries = append(tbl.txnEntries, meta)
 	tbl.store.warChecker.ReadTable(tbl.entry.GetDB().ID, meta.GetTable().AsCommonID())
 	return
@@ -173,12 +184,14 @@ func (tbl *txnTable) SoftDeleteBlock(id *common.ID) (err error) {
 	if err != nil {
 		return
 	}
+	tbl.store.logDDL()
 	tbl.txnEntries = append(tbl.txnEntries, meta)
 	tbl.store.warChecker.ReadSegment(tbl.entry.GetDB().ID, seg.AsCommonID())
 	return
 }
 
 func (tbl *txnTable) LogTxnEntry(entry txnif.TxnEntry, readed []*common.ID) (err error) {
+	tbl.store.logDDL()
 	tbl.txnEntries = append(tbl.txnEntries, entry)
 	for _, id := range readed {
 		tbl.store.warChecker.Read(tbl.entry.GetDB().ID, id)
@@ -225,16 +238,68 @@ func (tbl *txnTable) createBlock(sid uint64, state catalog.EntryState) (blk hand
 	if err != nil {
 		return
 	}
+	tbl.store.logDDL()
 	tbl.txnEntries = append(tbl.txnEntries, meta)
 	tbl.store.warChecker.ReadSegment(tbl.entry.GetDB().ID, seg.AsCommonID())
 	return buildBlock(tbl, meta), err
 }
 
+// getIndexEntry returns the index ddl on name made earlier in this txn.
+func (tbl *txnTable) getIndexEntry(name string) *catalog.IndexEntry {
+	for _, txnEntry := range tbl.txnEntries {
+		if e, ok := txnEntry.(*catalog.IndexEntry); ok && e.GetIndex().Name == name {
+			return e
+		}
+	}
+	return nil
+}
+
+func (tbl *txnTable) CreateIndex(index *catalog.IndexInfo) (err error) {
+	if err = tbl.store.beginWrite(); err != nil {
+		return
+	}
+	if tbl.getIndexEntry(index.Name) != nil {
+		err = catalog.ErrDuplicate
+		return
+	}
+	txnEntry, err := tbl.entry.CreateIndexEntry(index, tbl.store.txn)
+	if err != nil {
+		return
+	}
+	tbl.store.logDDL()
+	tbl.txnEntries = append(tbl.txnEntries, txnEntry)
+	tbl.store.warChecker.ReadTable(tbl.entry.GetDB().ID, tbl.entry.AsCommonID())
+	return
+}
+
+func (tbl *txnTable) DropIndex(name string) (err error) {
+	if err = tbl.store.beginWrite(); err != nil {
+		return
+	}
+	if e := tbl.getIndexEntry(name); e != nil {
+		if e.IsDrop() {
+			err = catalog.ErrNotFound
+		} else {
+			err = txnbase.ErrDDLDropCreated
+		}
+		return
+	}
+	txnEntry, err := tbl.entry.DropIndexEntry(name, tbl.store.txn)
+	if err != nil {
+		return
+	}
+	tbl.store.logDDL()
+	tbl.txnEntries = append(tbl.txnEntries, txnEntry)
+	tbl.store.warChecker.ReadTable(tbl.entry.GetDB().ID, tbl.entry.AsCommonID())
+	return
+}
+
 func (tbl *txnTable) SetCreateEntry(e txnif.TxnEntry) {
 	if tbl.createEntry != nil {
 		panic("logic error")
 	}
 	tbl.createEntry = e
+	tbl.store.logDDL()
 	tbl.txnEntries = append(tbl.txnEntries, e)
 	tbl.store.warChecker.ReadDB(tbl.entry.GetDB().GetID())
 }
@@ -247,6 +312,7 @@ func (tbl *txnTable) SetDropEntry(e txnif.TxnEntry) error {
 		return txnbase.ErrDDLDropCreated
 	}
 	tbl.dropEntry = e
+	tbl.store.logDDL()
 	tbl.txnEntries = append(tbl.txnEntries, e)
 	tbl.store.warChecker.ReadDB(tbl.entry.GetDB().GetID())
 	return nil
@@ -256,8 +322,11 @@ func (tbl *txnTable) IsDeleted() bool {
 	return tbl.dropEntry != nil
 }
 
+// GetSchema returns the schema of the table seen by the txn. It is the schema
+// when the txn first accessed the table, or the altered one if the txn
+// altered the table.
 func (tbl *txnTable) GetSchema() *catalog.Schema {
-	return tbl.entry.GetSchema()
+	return tbl.schema
 }
 
 func (tbl *txnTable) GetMeta() *catalog.TableEntry {
@@ -305,10 +374,20 @@ func (tbl *txnTable) AddUpdateNode(node txnif.UpdateNode) error {
 }
 
 func (tbl *txnTable) Append(data *batch.Batch) error {
-	err := tbl.BatchDedup(data.Vecs[tbl.entry.GetSchema().PrimaryKey])
+	if tbl.alterEntry != nil {
+		return ErrAlterConflict
+	}
+	if err := tbl.fillAutoIncrement(data); err != nil {
+		return err
+	}
+	if err := tbl.checkAppendConstraints(data); err != nil {
+		return err
+	}
+	err := tbl.BatchDedup(data.Vecs[tbl.GetSchema().PrimaryKey])
 	if err != nil {
 		return err
 	}
+	tbl.ssiWriteKeys(data.Vecs[tbl.GetSchema().PrimaryKey])
 	if tbl.localSegment == nil {
 		tbl.localSegment = newLocalSegment(tbl)
 	}
@@ -338,9 +417,26 @@ func (tbl *txnTable) IsLocalDeleted(row uint32) bool {
 }
 
 func (tbl *txnTable) RangeDelete(id *common.ID, start, end uint32) (err error) {
+	if tbl.alterEntry != nil {
+		return ErrAlterConflict
+	}
+	refs, err := tbl.getReferences()
+	if err != nil {
+		return
+	}
+	if len(refs) > 0 {
+		return tbl.deleteReferencedRows(id, start, end, refs)
+	}
+	return tbl.rangeDelete(id, start, end)
+}
+
+func (tbl *txnTable) rangeDelete(id *common.ID, start, end uint32) (err error) {
 	if isLocalSegment(id) {
 		return tbl.RangeDeleteLocalRows(start, end)
 	}
+	if err = tbl.beforeWriteRows(id, start, end); err != nil {
+		return
+	}
 	node := tbl.deleteNodes[*id]
 	if node != nil {
 		chain := node.GetChain().(*updates.DeleteChain)
@@ -353,7 +449,9 @@ func (tbl *txnTable) RangeDelete(id *common.ID, start, end uint32) (err error) {
 			}
 		}
 		writeLock.Unlock()
-		if err != nil {
+		if err == nil {
+			tbl.store.logUndo(&blockDelete{tbl: tbl, id: *id, node: node, start: start, end: end})
+		} else {
 			seg, _ := tbl.entry.GetSegmentByID(id.SegmentID)
 			blk, _ := seg.GetBlockEntryByID(id.BlockID)
 			tbl.store.warChecker.ReadBlock(tbl.entry.GetDB().ID, blk.AsCommonID())
@@ -375,12 +473,14 @@ func (tbl *txnTable) RangeDelete(id *common.ID, start, end uint32) (err error) {
 		if err = tbl.AddDeleteNode(id, node2); err != nil {
 			return
 		}
+		tbl.store.logUndo(&blockDelete{tbl: tbl, id: *id, node: node2, start: start, end: end, created: true})
 		tbl.store.warChecker.ReadBlock(tbl.entry.GetDB().ID, id)
 	}
 	return
 }
 
 func (tbl *txnTable) GetByFilter(filter *handle.Filter) (id *common.ID, offset uint32, err error) {
+	tbl.ssiReadKey(filter.Val)
 	if tbl.localSegment != nil {
 		id, offset, err = tbl.localSegment.GetByFilter(filter)
 		if err == nil {
@@ -388,8 +488,7 @@ func (tbl *txnTable) GetByFilter(filter *handle.Filter) (id *common.ID, offset u
 		}
 		err = nil
 	}
-	h := newRelation(tbl)
-	blockIt := h.MakeBlockIt()
+	blockIt := newRelationBlockIt(newRelation(tbl))
 	for blockIt.Valid() {
 		h := blockIt.GetBlock()
 		if h.IsUncommitted() {
@@ -427,8 +526,7 @@ func (tbl *txnTable) GetValue(id *common.ID, row uint32, col uint16) (v interfac
 	if err != nil {
 		panic(err)
 	}
-	block := meta.GetBlockData()
-	return block.GetValue(tbl.store.txn, row, col)
+	return tbl.getBlockValue(meta, row, int(col))
 }
 
 func (tbl *txnTable) updateWithFineLock(node txnif.UpdateNode, txn txnif.AsyncTxn, row uint32, v interface{}) (err error) {
@@ -437,7 +535,13 @@ func (tbl *txnTable) updateWithFineLock(node txnif.UpdateNode, txn txnif.AsyncTx
 	sharedLock := controller.GetSharedLock()
 	if err = controller.CheckNotDeleted(row, row, txn.GetStartTS()); err == nil {
 		chain.Lock()
-		err = chain.TryUpdateNodeLocked(row, v, node)
+		undo := &blockUpdate{tbl: tbl, id: *node.GetID(), node: node, row: row}
+		if undo.updated = node.(*updates.ColumnNode).HasUpdateLocked(row); undo.updated {
+			undo.prev, _ = node.(*updates.ColumnNode).GetValueLocked(row)
+		}
+		if err = chain.TryUpdateNodeLocked(row, v, node); err == nil {
+			tbl.store.logUndo(undo)
+		}
 		chain.Unlock()
 	}
 	sharedLock.Unlock()
@@ -445,23 +549,42 @@ func (tbl *txnTable) updateWithFineLock(node txnif.UpdateNode, txn txnif.AsyncTx
 }
 
 func (tbl *txnTable) Update(id *common.ID, row uint32, col uint16, v interface{}) (err error) {
-	if tbl.entry.GetSchema().IsPartOfPK(int(col)) {
+	if tbl.alterEntry != nil {
+		return ErrAlterConflict
+	}
+	if tbl.GetSchema().IsPartOfPK(int(col)) {
 		err = data.ErrUpdateUniqueKey
 		return
 	}
+	if tbl.GetSchema().HasConstraints() {
+		if err = tbl.checkUpdateConstraints(id, row, int(col), v); err != nil {
+			return
+		}
+	}
+	refs, err := tbl.getReferences()
+	if err != nil {
+		return
+	}
+	seqNum := tbl.GetSchema().ColDefs[col].SeqNum
+	var colRefs []fkRef
+	for _, ref := range refs {
+		if ref.fk.RefColumn == seqNum {
+			colRefs = append(colRefs, ref)
+		}
+	}
+	if len(colRefs) > 0 {
+		return tbl.updateReferencedRow(id, row, col, v, colRefs)
+	}
+	return tbl.update(id, row, col, v)
+}
+
+// update sets the column col of a row to v, nil for NULL. The row of a
+// committed block is rewritten to be set to NULL.
+func (tbl *txnTable) update(id *common.ID, row uint32, col uint16, v interface{}) (err error) {
 	if isLocalSegment(id) {
 		return tbl.UpdateLocalValue(row, col, v)
 	}
-	uid := *id
-	uid.Idx = col
-	node := tbl.updateNodes[uid]
-	if node != nil {
-		err = tbl.updateWithFineLock(node, tbl.store.txn, row, v)
-		if err != nil {
-			seg, _ := tbl.entry.GetSegmentByID(id.SegmentID)
-			blk, _ := seg.GetBlockEntryByID(id.BlockID)
-			tbl.store.warChecker.ReadBlock(tbl.entry.GetDB().ID, blk.AsCommonID())
-		}
+	if err = tbl.beforeWriteRows(id, row, row); err != nil {
 		return
 	}
 	seg, err := tbl.entry.GetSegmentByID(id.SegmentID)
@@ -472,12 +595,29 @@ func (tbl *txnTable) Update(id *common.ID, row uint32, col uint16, v interface{}
 	if err != nil {
 		return
 	}
+	phyCol, ok := tbl.getBlockColIdx(blk, int(col))
+	// A row whose partition column is updated may move to another partition
+	if !ok || v == nil || tbl.isPartitionColumn(int(col)) {
+		return tbl.rewriteBlockRow(blk, row, int(col), v)
+	}
+	col = uint16(phyCol)
+	uid := *id
+	uid.Idx = col
+	node := tbl.updateNodes[uid]
+	if node != nil {
+		err = tbl.updateWithFineLock(node, tbl.store.txn, row, v)
+		if err != nil {
+			tbl.store.warChecker.ReadBlock(tbl.entry.GetDB().ID, blk.AsCommonID())
+		}
+		return
+	}
 	blkData := blk.GetBlockData()
 	node2, err := blkData.Update(tbl.store.txn, row, col, v)
 	if err == nil {
 		if err = tbl.AddUpdateNode(node2); err != nil {
 			return
 		}
+		tbl.store.logUndo(&blockUpdate{tbl: tbl, id: *node2.GetID(), node: node2, row: row, created: true})
 		tbl.store.warChecker.ReadBlock(tbl.entry.GetDB().ID, blk.AsCommonID())
 	}
 	return
@@ -503,9 +643,20 @@ func (tbl *txnTable) UncommittedRows() uint32 {
 }
 
 func (tbl *txnTable) PreCommitDededup() (err error) {
+	if err = tbl.preCommitCheckForeignKeys(); err != nil {
+		return
+	}
 	if tbl.localSegment == nil {
 		return
 	}
+	// The rows are laid out by the schema seen by the txn, they cannot be
+	// appended once the table is altered
+	if tbl.schema.Version != tbl.entry.GetSchema().Version {
+		return catalog.ErrSchemaChanged
+	}
+	if err = tbl.preCommitDedupUnique(); err != nil {
+		return
+	}
 	pks := tbl.localSegment.GetPrimaryColumn()
 	segIt := tbl.entry.MakeSegmentIt(false)
 	for segIt.Valid() {
@@ -640,14 +791,17 @@ func (tbl *txnTable) PrepareCommit() (err error) {
 }
 
 func (tbl *txnTable) PreApplyCommit() (err error) {
-	if err = tbl.ApplyAppend(); err != nil {
-		return
+	if tbl.alterEntry != nil {
+		tbl.alterEntry.Apply()
 	}
+	// The deletes are applied to the indexes first, a row deleted and
+	// appended again in the same txn can land in the same block
 	for _, dn := range tbl.deleteNodes {
 		if err = dn.OnApply(); err != nil {
 			return
 		}
 	}
+	err = tbl.ApplyAppend()
 	return
 }
 
@@ -36,6 +36,7 @@ func (db *txnDB) SetCreateEntry(e txnif.TxnEntry) error {
 		panic("logic error")
 	}
 	db.createEntry = e
+	db.store.logDDL()
 	return nil
 }
 
@@ -47,6 +48,7 @@ func (db *txnDB) SetDropEntry(e txnif.TxnEntry) error {
 		return txnbase.ErrDDLDropCreated
 	}
 	db.dropEntry = e
+	db.store.logDDL()
 	return nil
 }
 
@@ -139,6 +141,17 @@ func (db *txnDB) GetValue(id *common.ID, row uint32, colIdx uint16) (v interface
 	return table.GetValue(id, row, colIdx)
 }
 
+func (db *txnDB) Lock(tid uint64, pk interface{}, mode handle.LockMode, policy handle.LockWaitPolicy) (bool, error) {
+	table, err := db.getOrSetTable(tid)
+	if err != nil {
+		return false, err
+	}
+	if table.IsDeleted() {
+		return false, txnbase.ErrNotFound
+	}
+	return table.Lock(pk, mode, policy)
+}
+
 func (db *txnDB) Update(id *common.ID, row uint32, colIdx uint16, v interface{}) (err error) {
 	table, err := db.getOrSetTable(id.TableID)
 	if err != nil {
@@ -151,7 +164,9 @@ func (db *txnDB) Update(id *common.ID, row uint32, colIdx uint16, v interface{})
 }
 
 func (db *txnDB) CreateRelation(def interface{}) (relation handle.Relation, err error) {
-	db.store.IncreateWriteCnt()
+	if err = db.store.beginWrite(); err != nil {
+		return
+	}
 	schema := def.(*catalog.Schema)
 	var factory catalog.TableDataFactory
 	if db.store.dataFactory != nil {
@@ -171,7 +186,12 @@ func (db *txnDB) CreateRelation(def interface{}) (relation handle.Relation, err
 }
 
 func (db *txnDB) DropRelationByName(name string) (relation handle.Relation, err error) {
-	db.store.IncreateWriteCnt()
+	if err = db.store.beginWrite(); err != nil {
+		return
+	}
+	if err = db.checkNotReferenced(name); err != nil {
+		return
+	}
 	meta, err := db.entry.DropTableEntry(name, db.store.txn)
 	if err != nil {
 		return nil, err
@@ -185,6 +205,30 @@ func (db *txnDB) DropRelationByName(name string) (relation handle.Relation, err
 	return
 }
 
+// checkNotReferenced returns txnbase.ErrTableIsReferenced if the table of name is
+// referenced by a foreign key of another table.
+func (db *txnDB) checkNotReferenced(name string) (err error) {
+	meta, err := db.entry.GetTableEntry(name, db.store.txn)
+	if err != nil {
+		// Left to DropTableEntry
+		return nil
+	}
+	table, err := db.getOrSetTable(meta.GetID())
+	if err != nil {
+		return
+	}
+	refs, err := table.getReferences()
+	if err != nil {
+		return
+	}
+	for _, ref := range refs {
+		if ref.child != table {
+			return txnbase.NewConstraintError(txnbase.ErrTableIsReferenced, ref.fk.Name)
+		}
+	}
+	return
+}
+
 func (db *txnDB) GetRelationByName(name string) (relation handle.Relation, err error) {
 	meta, err := db.entry.GetTableEntry(name, db.store.txn)
 	if err != nil {
@@ -0,0 +1,402 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txnimpl
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	gvec "github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	idxerrors "github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/common/errors"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnbase"
+)
+
+func (h *txnRelation) Upsert(data *batch.Batch, onDup handle.OnDupFunc) (affected uint64, err error) {
+	set := newUpsertSet(h)
+	if err = set.probe(data); err != nil {
+		return
+	}
+	schema := h.table.GetSchema()
+	rows := gvec.Length(data.Vecs[0])
+	for i := 0; i < rows; i++ {
+		row := make([]interface{}, len(schema.ColDefs))
+		for col := range schema.ColDefs {
+			row[col] = vectorValue(data.Vecs[col], i)
+		}
+		var n uint64
+		if n, err = set.upsert(row, onDup); err != nil {
+			return
+		}
+		affected += n
+	}
+	err = set.apply()
+	return
+}
+
+// rowLoc is the location of a row seen by the txn
+type rowLoc struct {
+	id  common.ID
+	row uint32
+}
+
+// upsertRow is a row to insert, or to update an existing row to if loc is
+// not nil
+type upsertRow struct {
+	values  []interface{}
+	loc     *rowLoc
+	old     []interface{}
+	dropped bool
+}
+
+// upsertSet resolves the conflicts of the rows of a batch upserted against
+// the rows seen by the txn and the rows before them in the batch. The rows
+// are written once all of them are resolved: the rows replaced or rewritten
+// are deleted, then the rows updated in place are updated and the new rows
+// are appended as a batch.
+type upsertSet struct {
+	h      *txnRelation
+	schema *catalog.Schema
+	// cols are the primary key and the unique columns
+	cols []int
+	// existing are the rows seen by the txn with the values of cols of the
+	// batch, by column and value
+	existing []map[string]rowLoc
+	// keys are the rows of the set by column and value
+	keys    []map[string]*upsertRow
+	rows    []*upsertRow
+	updated map[rowLoc]*upsertRow
+	deleted map[rowLoc]bool
+	deletes []rowLoc
+}
+
+func newUpsertSet(h *txnRelation) *upsertSet {
+	schema := h.table.GetSchema()
+	cols := append([]int{int(schema.PrimaryKey)}, schema.UniqueColumns()...)
+	set := &upsertSet{
+		h:        h,
+		schema:   schema,
+		cols:     cols,
+		existing: make([]map[string]rowLoc, len(cols)),
+		keys:     make([]map[string]*upsertRow, len(cols)),
+		updated:  make(map[rowLoc]*upsertRow),
+		deleted:  make(map[rowLoc]bool),
+	}
+	for i := range cols {
+		set.existing[i] = make(map[string]rowLoc)
+		set.keys[i] = make(map[string]*upsertRow)
+	}
+	return set
+}
+
+// probe dedups the values of the primary key and the unique columns of data
+// against the rows seen by the txn, and locates the existing rows only for
+// the columns with duplicated values.
+func (set *upsertSet) probe(data *batch.Batch) (err error) {
+	tbl := set.h.table
+	for i, col := range set.cols {
+		keys := make(map[string]interface{})
+		rows := gvec.Length(data.Vecs[col])
+		for row := 0; row < rows; row++ {
+			if v := vectorValue(data.Vecs[col], row); v != nil {
+				keys[encodePK(v)] = v
+			}
+		}
+		if len(keys) == 0 {
+			continue
+		}
+		if i > 0 {
+			err = tbl.dedupOnColumn(col, keys)
+		} else {
+			err = set.dedupPK(keys)
+		}
+		if !errors.Is(err, txnbase.ErrDuplicated) {
+			if err != nil {
+				return
+			}
+			continue
+		}
+		if i > 0 {
+			err = tbl.scanColumn(col, keys, func(id *common.ID, row uint32, v interface{}) bool {
+				if key := encodePK(v); keys[key] != nil {
+					set.existing[i][key] = rowLoc{id: *id, row: row}
+				}
+				return true
+			})
+			if err != nil {
+				return
+			}
+			continue
+		}
+		for key, v := range keys {
+			var id *common.ID
+			var offset uint32
+			if id, offset, err = set.h.findRow(v); err != nil {
+				return
+			}
+			if id != nil {
+				set.existing[i][key] = rowLoc{id: *id, row: offset}
+			}
+		}
+	}
+	return
+}
+
+// dedupPK dedups the primary keys against the rows of the txn and the
+// committed rows like an append.
+func (set *upsertSet) dedupPK(keys map[string]interface{}) (err error) {
+	tbl := set.h.table
+	vec := gvec.New(set.schema.ColDefs[set.schema.PrimaryKey].Type)
+	for _, v := range keys {
+		compute.AppendValue(vec, v)
+	}
+	if tbl.localSegment != nil {
+		if err = tbl.localSegment.BatchDedupByCol(vec); err != nil {
+			return
+		}
+	}
+	return tbl.BatchDedup(vec)
+}
+
+// upsert resolves a row against the existing rows and the rows of the set.
+// A row replaces all the rows it conflicts with if onDup is nil, or else
+// updates the first of them.
+func (set *upsertSet) upsert(row []interface{}, onDup handle.OnDupFunc) (affected uint64, err error) {
+	var matches []*upsertRow
+	var locs []rowLoc
+	for i, col := range set.cols {
+		if row[col] == nil {
+			continue
+		}
+		key := encodePK(row[col])
+		if r := set.keys[i][key]; r != nil {
+			if !containsRow(matches, r) {
+				matches = append(matches, r)
+			}
+			continue
+		}
+		if loc, ok := set.existing[i][key]; ok && !set.deleted[loc] && set.updated[loc] == nil {
+			if !containsLoc(locs, loc) {
+				locs = append(locs, loc)
+			}
+		}
+	}
+	if len(matches) == 0 && len(locs) == 0 {
+		set.add(&upsertRow{values: row})
+		return 1, nil
+	}
+	if onDup == nil {
+		for _, r := range matches {
+			set.drop(r)
+		}
+		for _, loc := range locs {
+			set.delete(loc)
+		}
+		set.add(&upsertRow{values: row})
+		return uint64(len(matches)+len(locs)) + 1, nil
+	}
+	var target *upsertRow
+	if len(matches) > 0 {
+		target = matches[0]
+	} else {
+		loc := locs[0]
+		old := make([]interface{}, len(set.schema.ColDefs))
+		for col := range set.schema.ColDefs {
+			if old[col], err = set.h.table.getRowValue(&loc.id, loc.row, col); err != nil {
+				return
+			}
+		}
+		target = &upsertRow{values: append([]interface{}{}, old...), loc: &loc, old: old}
+	}
+	updated, err := onDup(target.values, row)
+	if err != nil || rowEqual(target.values, updated) {
+		return
+	}
+	set.unregister(target)
+	target.values = updated
+	if target.loc != nil && set.needRewrite(target) {
+		delete(set.updated, *target.loc)
+		set.delete(*target.loc)
+		target.loc, target.old = nil, nil
+	}
+	if len(matches) > 0 {
+		set.register(target)
+	} else {
+		set.add(target)
+	}
+	return 2, nil
+}
+
+// needRewrite returns true if the existing row is deleted and appended again
+// to be updated: the row of the txn, or a row with a new primary key or a
+// column set to NULL.
+func (set *upsertSet) needRewrite(r *upsertRow) bool {
+	if isLocalSegment(&r.loc.id) {
+		return true
+	}
+	for col := range set.schema.ColDefs {
+		if valueEqual(r.old[col], r.values[col]) {
+			continue
+		}
+		if r.values[col] == nil || set.schema.IsPartOfPK(col) {
+			return true
+		}
+	}
+	return false
+}
+
+func (set *upsertSet) add(r *upsertRow) {
+	set.rows = append(set.rows, r)
+	if r.loc != nil {
+		set.updated[*r.loc] = r
+	}
+	set.register(r)
+}
+
+// drop drops r from the set, the existing row it updates is deleted
+func (set *upsertSet) drop(r *upsertRow) {
+	set.unregister(r)
+	r.dropped = true
+	if r.loc != nil {
+		delete(set.updated, *r.loc)
+		set.delete(*r.loc)
+	}
+}
+
+func (set *upsertSet) register(r *upsertRow) {
+	for i, col := range set.cols {
+		if v := r.values[col]; v != nil {
+			set.keys[i][encodePK(v)] = r
+		}
+	}
+}
+
+func (set *upsertSet) unregister(r *upsertRow) {
+	for i, col := range set.cols {
+		if v := r.values[col]; v != nil && set.keys[i][encodePK(v)] == r {
+			delete(set.keys[i], encodePK(v))
+		}
+	}
+}
+
+func (set *upsertSet) delete(loc rowLoc) {
+	if !set.deleted[loc] {
+		set.deleted[loc] = true
+		set.deletes = append(set.deletes, loc)
+	}
+}
+
+// apply writes the set
+func (set *upsertSet) apply() (err error) {
+	for _, loc := range set.deletes {
+		if err = set.h.RangeDelete(&loc.id, loc.row, loc.row); err != nil {
+			return
+		}
+	}
+	var inserts [][]interface{}
+	for _, r := range set.rows {
+		if r.dropped {
+			continue
+		}
+		if r.loc == nil {
+			inserts = append(inserts, r.values)
+			continue
+		}
+		for col := range set.schema.ColDefs {
+			if valueEqual(r.old[col], r.values[col]) {
+				continue
+			}
+			if err = set.h.Update(&r.loc.id, r.loc.row, uint16(col), r.values[col]); err != nil {
+				return
+			}
+		}
+	}
+	if len(inserts) > 0 {
+		err = set.h.Append(makeRowsBatch(set.schema, inserts))
+	}
+	return
+}
+
+// findRow returns the row of the primary key pk seen by the txn, or a nil id
+// if there is none.
+func (h *txnRelation) findRow(pk interface{}) (id *common.ID, offset uint32, err error) {
+	id, offset, err = h.GetByFilter(handle.NewEQFilter(pk))
+	if err == txnbase.ErrNotFound || err == idxerrors.ErrKeyNotFound {
+		id, err = nil, nil
+	}
+	return
+}
+
+func containsRow(rows []*upsertRow, r *upsertRow) bool {
+	for _, row := range rows {
+		if row == r {
+			return true
+		}
+	}
+	return false
+}
+
+func containsLoc(locs []rowLoc, loc rowLoc) bool {
+	for _, l := range locs {
+		if l == loc {
+			return true
+		}
+	}
+	return false
+}
+
+func rowEqual(a, b []interface{}) bool {
+	for col := range a {
+		if !valueEqual(a[col], b[col]) {
+			return false
+		}
+	}
+	return true
+}
+
+// makeRowsBatch makes a batch of schema with rows, nil is NULL
+func makeRowsBatch(schema *catalog.Schema, rows [][]interface{}) *batch.Batch {
+	bat := catalog.MockData(schema, 0)
+	for col, colDef := range schema.ColDefs {
+		vec := bat.Vecs[col]
+		// The value of a NULL is the one of a default NULL vector
+		null := compute.GetValue(compute.MakeDefaultVector(colDef.Type, nil, 1), 0)
+		for i, row := range rows {
+			if row[col] == nil {
+				compute.AppendValue(vec, null)
+				nulls.Add(vec.Nsp, uint64(i))
+				continue
+			}
+			compute.AppendValue(vec, row[col])
+		}
+	}
+	return bat
+}
+
+func valueEqual(a, b interface{}) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	if v, ok := a.([]byte); ok {
+		w, ok := b.([]byte)
+		return ok && bytes.Equal(v, w)
+	}
+	return a == b
+}
@@ -116,6 +116,10 @@ func (driver *walDriver) AppendEntry(group uint32, e LogEntry) (uint64, error) {
 	return id, err
 }
 
+func (driver *walDriver) SyncStats() store.SyncStats {
+	return driver.impl.SyncStats()
+}
+
 func (driver *walDriver) Close() error {
 	if driver.own {
 		return driver.impl.Close()
@@ -50,6 +50,8 @@ type Driver interface {
 	GetPenddingCnt() uint64
 	Compact() error
 	Replay(handle store.ApplyHandle) (err error)
+	// SyncStats returns the counters of the groups of entries synced
+	SyncStats() store.SyncStats
 	Close() error
 }
 
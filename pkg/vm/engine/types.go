@@ -15,6 +15,8 @@
 package engine
 
 import (
+	"time"
+
 	roaring "github.com/RoaringBitmap/roaring/roaring64"
 	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
@@ -38,6 +40,10 @@ type Attribute struct {
 	Type    types.Type  // type of attribute
 	Default DefaultExpr // default value of this attribute.
 	Primary bool        // if true, it is primary key
+	// AutoIncrement is true if the values are generated by a sequence
+	AutoIncrement bool
+	// NotNull is true if the attribute rejects NULL values
+	NotNull bool
 }
 
 type DefaultExpr struct {
@@ -61,6 +67,18 @@ type Property struct {
 	Value string
 }
 
+// AutoIncrementProperty is the key of the property holding the first value
+// of the auto increment column of a table.
+const AutoIncrementProperty = "auto_increment"
+
+// MergePolicyProperty is the key of the property holding the policy the
+// engine merges the blocks of a table with.
+const MergePolicyProperty = "merge_policy"
+
+// TTLProperty is the key of the property holding the TTL of the rows of a
+// table, column:duration like created:90d.
+const TTLProperty = "ttl"
+
 type NodeInfo struct {
 	Mcpu int
 }
@@ -83,10 +101,16 @@ type RangePartition struct {
 	Subpartition *PartitionByDef
 }
 
+// PartitionByDef is the partitioning of a table. The bound of a range
+// partition is its To, exclusive, with an empty To for MAXVALUE. A list
+// partition lists the Extends. The values are ValueExtends.
 type PartitionByDef struct {
 	Fields []string
 	List   []ListPartition
 	Range  []RangePartition
+	// Hash is the number of the partitions of a hash partitioned table,
+	// named p0 to pN-1
+	Hash uint64
 }
 
 type IndexTableDef struct {
@@ -114,6 +138,40 @@ const (
 	BsiIndex
 )
 
+// UniqueIndexDef is a UNIQUE constraint over ColNames.
+type UniqueIndexDef struct {
+	Name     string
+	ColNames []string
+}
+
+// CheckDef is an enforced CHECK constraint, Expr is its SQL text.
+type CheckDef struct {
+	Name string
+	Expr string
+}
+
+// RefAction is the action taken on the referencing rows when a
+// referenced row is deleted or its key updated.
+type RefAction int
+
+const (
+	RefRestrict RefAction = iota
+	RefCascade
+	RefSetNull
+)
+
+// ForeignKeyDef is a FOREIGN KEY constraint from ColNames to RefColNames
+// of table RefTable in database RefDatabase.
+type ForeignKeyDef struct {
+	Name        string
+	ColNames    []string
+	RefDatabase string
+	RefTable    string
+	RefColNames []string
+	OnDelete    RefAction
+	OnUpdate    RefAction
+}
+
 type AttributeDef struct {
 	Attr Attribute
 }
@@ -131,6 +189,9 @@ func (*AttributeDef) tableDef()   {}
 func (*IndexTableDef) tableDef()  {}
 func (*PartitionByDef) tableDef() {}
 func (*PropertiesDef) tableDef()  {}
+func (*UniqueIndexDef) tableDef() {}
+func (*CheckDef) tableDef()       {}
+func (*ForeignKeyDef) tableDef()  {}
 
 type Relation interface {
 	Statistics
@@ -155,10 +216,121 @@ type Relation interface {
 	NewReader(int, extend.Extend, []byte, Snapshot) []Reader
 }
 
+type AlterKind int
+
+const (
+	AlterAddColumn AlterKind = iota
+	AlterDropColumn
+	AlterRenameColumn
+	AlterModifyColumn
+)
+
+// AlterTableAction is one column change of an ALTER TABLE. Name is the column
+// to drop, rename or modify, NewName is the new name of a renamed column and
+// Attr is the definition of an added or modified column.
+type AlterTableAction struct {
+	Kind    AlterKind
+	Name    string
+	NewName string
+	Attr    Attribute
+}
+
+// SchemaChangeRelation is a Relation whose columns can be changed online.
+type SchemaChangeRelation interface {
+	Relation
+	AlterTable(uint64, []AlterTableAction, Snapshot) error
+}
+
+// PartitionRelation is a Relation whose partitions can be added, dropped
+// and truncated without rewriting the rows of the other partitions. The
+// partitions added are the List or the Range of the PartitionByDef.
+type PartitionRelation interface {
+	Relation
+	AddPartitions(uint64, *PartitionByDef, Snapshot) error
+	DropPartitions(uint64, []string, Snapshot) error
+	TruncatePartitions(uint64, []string, Snapshot) error
+}
+
+// OnDuplicate is how an insert resolves a row with the primary key of an
+// existing row.
+type OnDuplicate int8
+
+const (
+	// OnDuplicateError fails the insert
+	OnDuplicateError OnDuplicate = iota
+	// OnDuplicateReplace deletes the existing row, REPLACE INTO
+	OnDuplicateReplace
+	// OnDuplicateUpdate updates the existing row, ON DUPLICATE KEY UPDATE
+	OnDuplicateUpdate
+)
+
+// OnDuplicateFunc returns the row an existing row is updated to. old is the
+// existing row and row the one inserted, both in the column order of the
+// table with nil for NULL.
+type OnDuplicateFunc func(old, row []interface{}) ([]interface{}, error)
+
+// UpsertRelation is a Relation which resolves the primary key and unique
+// key conflicts of the rows written, against the committed rows and the rows
+// written by the txn. A nil OnDuplicateFunc replaces the existing rows. It
+// returns the affected rows the way MySQL counts them: 1 for an inserted row
+// plus 1 for each row it replaces, 2 for an updated row and 0 for a row
+// updated to its current values.
+type UpsertRelation interface {
+	Relation
+	Upsert(uint64, *batch.Batch, OnDuplicateFunc, Snapshot) (uint64, error)
+}
+
 type Reader interface {
 	Read([]uint64, []string) (*batch.Batch, error)
 }
 
+// PruningReader is a Reader which skips the blocks that can not match the
+// filter it was created with.
+type PruningReader interface {
+	Reader
+	// PruneStats returns the number of blocks the reader went through and
+	// the number of them it skipped without reading
+	PruneStats() (blocks, pruned int64)
+}
+
+// RowLockMode is the row lock taken on the rows read by a locking read.
+type RowLockMode int8
+
+const (
+	RowLockNone RowLockMode = iota
+	// RowLockShare is taken by SELECT ... FOR SHARE
+	RowLockShare
+	// RowLockUpdate is taken by SELECT ... FOR UPDATE
+	RowLockUpdate
+)
+
+// RowLockWait is what a locking read does on a row locked by another txn.
+type RowLockWait int8
+
+const (
+	// RowLockWaitDefault waits until the lock wait timeout
+	RowLockWaitDefault RowLockWait = iota
+	// RowLockNoWait fails at once, NOWAIT
+	RowLockNoWait
+	// RowLockSkipLocked skips the row, SKIP LOCKED
+	RowLockSkipLocked
+)
+
+// LockingReader is a Reader which locks the rows it returns for the txn,
+// for SELECT ... FOR UPDATE and FOR SHARE.
+type LockingReader interface {
+	Reader
+	SetRowLock(RowLockMode, RowLockWait)
+}
+
+// PartitionReader is a Reader of a partitioned table which can be limited
+// to some of its partitions, for the queries the planner proved only need
+// them.
+type PartitionReader interface {
+	Reader
+	SetPartitions([]string)
+}
+
 type Filter interface {
 	Eq(string, interface{}) (*roaring.Bitmap, error)
 	Ne(string, interface{}) (*roaring.Bitmap, error)
@@ -205,6 +377,34 @@ type Engine interface {
 	Node(string, Snapshot) *NodeInfo
 }
 
+// SnapshotEngine is an Engine which can read the data as it was at a time in
+// the past.
+type SnapshotEngine interface {
+	Engine
+	// SnapshotAt returns a read-only Snapshot of the data at ts, which must
+	// be released by ReleaseSnapshot.
+	SnapshotAt(ts time.Time) (Snapshot, error)
+	ReleaseSnapshot(Snapshot) error
+}
+
+// BackupEngine is an Engine which can copy its data to a directory while
+// serving the writes.
+type BackupEngine interface {
+	Engine
+	// Backup copies the data to dir. If base is not empty, only the changes
+	// since the backup in base are copied.
+	Backup(dir, base string) error
+}
+
+// CompactEngine is an Engine which can merge the blocks of a table on
+// demand, for ALTER TABLE ... COMPACT.
+type CompactEngine interface {
+	Engine
+	// Compact merges the blocks of the table as the merge policy of it
+	// decides regardless of the thresholds, and waits for the merges.
+	Compact(db, table string) error
+}
+
 // MakeDefaultExpr returns a new DefaultExpr
 func MakeDefaultExpr(exist bool, value interface{}, isNull bool) DefaultExpr {
 	return DefaultExpr{
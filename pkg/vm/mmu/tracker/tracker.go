@@ -0,0 +1,219 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracker
+
+import (
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu"
+)
+
+// New creates a tracker as a child of parent, parent may be nil.
+func New(label string, limit int64, parent *Tracker) *Tracker {
+	t := &Tracker{
+		label:  label,
+		limit:  limit,
+		parent: parent,
+	}
+	if parent != nil {
+		parent.Lock()
+		parent.children = append(parent.children, t)
+		parent.Unlock()
+	}
+	return t
+}
+
+// All methods can be called on a nil tracker, which tracks nothing.
+
+func (t *Tracker) Label() string {
+	if t == nil {
+		return ""
+	}
+	return t.label
+}
+
+func (t *Tracker) Limit() int64 {
+	if t == nil {
+		return 0
+	}
+	return t.limit
+}
+
+func (t *Tracker) Size() int64 {
+	if t == nil {
+		return 0
+	}
+	t.Lock()
+	defer t.Unlock()
+	return t.size
+}
+
+func (t *Tracker) Peak() int64 {
+	if t == nil {
+		return 0
+	}
+	t.Lock()
+	defer t.Unlock()
+	return t.peak
+}
+
+// Children returns a snapshot of the children of the tracker.
+func (t *Tracker) Children() []*Tracker {
+	if t == nil {
+		return nil
+	}
+	t.Lock()
+	defer t.Unlock()
+	return append([]*Tracker{}, t.children...)
+}
+
+// Consume adds size to the tracker and all its ancestors. If any of them
+// would exceed its limitation nothing is consumed and mmu.OutOfMemory is
+// returned. The peaks are only raised once the whole path has consumed.
+func (t *Tracker) Consume(size int64) error {
+	for c := t; c != nil; {
+		c.Lock()
+		if c.limit > 0 && c.size+size > c.limit {
+			c.Unlock()
+			t.release(size, c)
+			return mmu.OutOfMemory
+		}
+		c.size += size
+		next := c.parent
+		c.Unlock()
+		c = next
+	}
+	for c := t; c != nil; {
+		c.Lock()
+		if c.size > c.peak {
+			c.peak = c.size
+		}
+		next := c.parent
+		c.Unlock()
+		c = next
+	}
+	return nil
+}
+
+// Release subtracts size from the tracker and all its ancestors.
+func (t *Tracker) Release(size int64) {
+	t.release(size, nil)
+}
+
+// Set changes the size of a tracker without children to size, it is used by
+// operators which know the size of their whole state.
+func (t *Tracker) Set(size int64) error {
+	if t == nil {
+		return nil
+	}
+	t.Lock()
+	delta := size - t.size
+	t.Unlock()
+	switch {
+	case delta > 0:
+		return t.Consume(delta)
+	case delta < 0:
+		t.Release(-delta)
+	}
+	return nil
+}
+
+// Detach releases the memory of the tracker from its ancestors and removes
+// it from its parent. The tracker keeps its own size and peak, so that they
+// can still be reported after the end of a query.
+func (t *Tracker) Detach() {
+	if t == nil {
+		return
+	}
+	t.Lock()
+	parent, size := t.parent, t.size
+	t.parent = nil
+	t.Unlock()
+	if parent == nil {
+		return
+	}
+	parent.release(size, nil)
+	parent.Lock()
+	for i, c := range parent.children {
+		if c == t {
+			parent.children = append(parent.children[:i], parent.children[i+1:]...)
+			break
+		}
+	}
+	parent.Unlock()
+}
+
+// Admit waits until neither the tracker nor any of its ancestors uses more
+// than AdmissionRatio of its limitation. AdmissionTimeout is returned if that does not happen within
+// timeout, a timeout <= 0 rejects at once.
+func (t *Tracker) Admit(timeout time.Duration) error {
+	var timer *time.Timer
+
+	for {
+		wait := t.exhausted()
+		if wait == nil {
+			return nil
+		}
+		if timeout <= 0 {
+			return AdmissionTimeout
+		}
+		if timer == nil {
+			timer = time.NewTimer(timeout)
+			defer timer.Stop()
+		}
+		select {
+		case <-wait:
+		case <-timer.C:
+			return AdmissionTimeout
+		}
+	}
+}
+
+// exhausted returns a channel closed when the first tracker on the path to
+// the root that uses more than AdmissionRatio of its limitation frees memory, or nil if there is
+// no such tracker.
+func (t *Tracker) exhausted() chan struct{} {
+	for c := t; c != nil; {
+		c.Lock()
+		if c.limit > 0 && float64(c.size) > float64(c.limit)*AdmissionRatio {
+			if c.wait == nil {
+				c.wait = make(chan struct{})
+			}
+			wait := c.wait
+			c.Unlock()
+			return wait
+		}
+		next := c.parent
+		c.Unlock()
+		c = next
+	}
+	return nil
+}
+
+// release subtracts size from the trackers on the path from t to end, end
+// excluded.
+func (t *Tracker) release(size int64, end *Tracker) {
+	for c := t; c != nil && c != end; {
+		c.Lock()
+		c.size -= size
+		if c.wait != nil {
+			close(c.wait)
+			c.wait = nil
+		}
+		next := c.parent
+		c.Unlock()
+		c = next
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTracker(t *testing.T) {
+	server := New("server", 100, nil)
+	session := New("session", 80, server)
+	query := New("query", 0, session)
+	op0 := New("group", 0, query)
+	op1 := New("join", 0, query)
+
+	require.NoError(t, op0.Set(30))
+	require.NoError(t, op1.Set(40))
+	require.Equal(t, int64(70), server.Size())
+	require.Equal(t, int64(70), query.Size())
+	require.Equal(t, mmu.OutOfMemory, op1.Set(60))
+	require.Equal(t, int64(40), op1.Size())
+	require.Equal(t, int64(70), session.Size())
+
+	require.NoError(t, op1.Set(0))
+	require.Equal(t, int64(30), server.Size())
+	require.Equal(t, int64(40), op1.Peak())
+	require.Equal(t, int64(70), query.Peak())
+	require.Equal(t, 2, len(query.Children()))
+
+	query.Detach()
+	require.Equal(t, int64(0), server.Size())
+	require.Equal(t, 0, len(session.Children()))
+	require.Equal(t, int64(30), query.Size())
+	require.Equal(t, int64(70), query.Peak())
+}
+
+func TestAdmit(t *testing.T) {
+	server := New("server", 100, nil)
+	session := New("session", 0, server)
+	require.NoError(t, session.Admit(0))
+
+	query := New("query", 0, session)
+	require.NoError(t, query.Consume(95))
+	require.Equal(t, AdmissionTimeout, session.Admit(0))
+	require.Equal(t, AdmissionTimeout, session.Admit(10*time.Millisecond))
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		query.Detach()
+	}()
+	require.NoError(t, session.Admit(time.Minute))
+}
+
+func TestNil(t *testing.T) {
+	var tr *Tracker
+
+	require.NoError(t, tr.Set(10))
+	require.NoError(t, tr.Admit(0))
+	require.Equal(t, int64(0), tr.Size())
+	tr.Detach()
+}
@@ -0,0 +1,54 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracker
+
+import (
+	"errors"
+	"sync"
+)
+
+const (
+	// AdmissionRatio is the part of the limitation of a tracker that may be
+	// in use when a new query is admitted.
+	AdmissionRatio = 0.9
+)
+
+var (
+	// AdmissionTimeout is returned by Admit if the memory budget stays
+	// exhausted until the timeout.
+	AdmissionTimeout = errors.New("memory budget exhausted")
+)
+
+// Tracker accounts the memory used by a server, a session, a query or an
+// operator. Trackers form a tree, the memory consumed by a tracker is also
+// consumed by all its ancestors. The operators keeping batches across calls
+// (joins, groups, sorts, tops, products and subqueries) report the size of
+// their state, the others such as limit, offset, projection and restrict
+// only pass the current batch on and report nothing.
+type Tracker struct {
+	sync.Mutex
+	// label, name of the tracker, e.g. the operator name.
+	label string
+	// limit, maximum memory can be used, no limitation if limit <= 0.
+	limit int64
+	// size, current usage of memory, including the children.
+	size int64
+	// peak, the maximum size ever reached.
+	peak int64
+	// wait, closed and reset if the size decreases, used by Admit.
+	wait     chan struct{}
+	parent   *Tracker
+	children []*Tracker
+}
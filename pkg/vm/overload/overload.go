@@ -35,10 +35,36 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/product"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/projection"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/restrict"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/subquery"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/top"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
+// names of the operators, used to label their memory trackers.
+var names = [...]string{
+	Top:        "top",
+	Join:       "join",
+	Left:       "left",
+	Limit:      "limit",
+	Merge:      "merge",
+	Order:      "order",
+	Group:      "group",
+	Output:     "output",
+	Offset:     "offset",
+	Product:    "product",
+	Restrict:   "restrict",
+	Connector:  "connector",
+	Projection: "projection",
+	Complement: "complement",
+	Subquery:   "subquery",
+
+	MergeTop:    "merge top",
+	MergeLimit:  "merge limit",
+	MergeOrder:  "merge order",
+	MergeGroup:  "merge group",
+	MergeOffset: "merge offset",
+}
+
 var stringFunc = [...]func(interface{}, *bytes.Buffer){
 	Top:        top.String,
 	Join:       join.String,
@@ -54,6 +80,7 @@ var stringFunc = [...]func(interface{}, *bytes.Buffer){
 	Connector:  connector.String,
 	Projection: projection.String,
 	Complement: complement.String,
+	Subquery:   subquery.String,
 
 	MergeTop:    mergetop.String,
 	MergeLimit:  mergelimit.String,
@@ -77,6 +104,7 @@ var prepareFunc = [...]func(*process.Process, interface{}) error{
 	Connector:  connector.Prepare,
 	Projection: projection.Prepare,
 	Complement: complement.Prepare,
+	Subquery:   subquery.Prepare,
 
 	MergeTop:    mergetop.Prepare,
 	MergeLimit:  mergelimit.Prepare,
@@ -100,6 +128,7 @@ var execFunc = [...]func(*process.Process, interface{}) (bool, error){
 	Connector:  connector.Call,
 	Projection: projection.Call,
 	Complement: complement.Call,
+	Subquery:   subquery.Call,
 
 	MergeTop:    mergetop.Call,
 	MergeLimit:  mergelimit.Call,
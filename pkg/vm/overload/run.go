@@ -16,9 +16,11 @@ package overload
 
 import (
 	"bytes"
+	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/vm"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/tracker"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -32,9 +34,13 @@ func String(ins vm.Instructions, buf *bytes.Buffer) {
 	}
 }
 
-// Prepare range instructions and do init work for each operator's argument by calling its prepare function
+// Prepare range instructions and do init work for each operator's argument by calling its prepare function,
+// each operator gets a memory tracker under the tracker of the query if there is one
 func Prepare(ins vm.Instructions, proc *process.Process) error {
-	for _, in := range ins {
+	for i, in := range ins {
+		if proc.Tr != nil {
+			ins[i].Tr = tracker.New(names[in.Op], 0, proc.Tr)
+		}
 		if err := prepareFunc[in.Op](proc, in.Arg); err != nil {
 			return err
 		}
@@ -47,13 +53,37 @@ func Run(ins vm.Instructions, proc *process.Process) (bool, error) {
 	var end bool
 	var err error
 
+	tr := proc.Tr
 	defer func() {
 		if e := recover(); e != nil {
+			proc.Tr = tr
 			err = moerr.NewPanicError(e)
 		}
 	}()
-	for _, in := range ins {
-		if ok, err = execFunc[in.Op](proc, in.Arg); err != nil {
+	for i, in := range ins {
+		var start time.Time
+
+		// the input of the last instruction is the output of the scope
+		if i == len(ins)-1 && proc.ScopeInfo != nil {
+			proc.ScopeInfo.AddOutput(proc.Reg.InputBatch)
+		}
+
+		anal := process.GetAnalyzeInfo(proc, in.Idx)
+		if anal != nil {
+			anal.AddInput(proc.Reg.InputBatch)
+			start = time.Now()
+		}
+		if in.Tr != nil {
+			proc.Tr = in.Tr
+		}
+		ok, err = execFunc[in.Op](proc, in.Arg)
+		proc.Tr = tr
+		if anal != nil {
+			anal.AddTime(time.Since(start))
+			anal.AddOutput(proc.Reg.InputBatch)
+			anal.SetMemoryPeak(in.Tr.Peak())
+		}
+		if err != nil {
 			return ok || end, err
 		}
 		if ok { // ok is true shows that at least one operator has done its work
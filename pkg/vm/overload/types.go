@@ -29,6 +29,7 @@ const (
 	Connector
 	Projection
 	Complement
+	Subquery
 
 	MergeTop
 	MergeLimit
@@ -16,8 +16,10 @@ package pipeline2
 
 import (
 	"bytes"
+	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	colexec "github.com/matrixorigin/matrixone/pkg/sql/colexec2"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/connector"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
@@ -52,10 +54,11 @@ func (p *Pipeline) Run(r engine.Reader, proc *process.Process) (bool, error) {
 	var err error
 	var bat *batch.Batch
 
+	defer p.analyzeScope(proc)()
 	defer func() {
 		if err != nil {
 			for i, in := range p.instructions {
-				if in.Op == vm.Connector {
+				if in.Op == overload.Connector {
 					arg := p.instructions[i].Arg.(*connector.Argument)
 					arg.Reg.Ch <- nil
 					break
@@ -72,6 +75,13 @@ func (p *Pipeline) Run(r engine.Reader, proc *process.Process) (bool, error) {
 		case <-p.reg.Ch:
 		}
 	}
+	if pr, ok := r.(engine.PruningReader); ok {
+		defer func() {
+			if anal := process.GetAnalyzeInfo(proc, p.instructions[0].Idx); anal != nil {
+				anal.AddPruneStats(pr.PruneStats())
+			}
+		}()
+	}
 	if err = overload.Prepare(p.instructions, proc); err != nil {
 		return false, err
 	}
@@ -81,6 +91,12 @@ func (p *Pipeline) Run(r engine.Reader, proc *process.Process) (bool, error) {
 		if bat, err = r.Read(refCnts, p.attrs); err != nil {
 			return false, err
 		}
+		if anal := process.GetAnalyzeInfo(proc, p.instructions[0].Idx); anal != nil {
+			anal.AddInputSize(colexec.BatchSize(bat))
+		}
+		if proc.ScopeInfo != nil {
+			proc.ScopeInfo.AddInput(bat, colexec.BatchSize(bat))
+		}
 		// processing the batch according to the instructions
 		proc.Reg.InputBatch = bat
 		if end, err = overload.Run(p.instructions, proc); err != nil || end { // end is true means pipeline successfully completed
@@ -93,10 +109,11 @@ func (p *Pipeline) ConstRun(bat *batch.Batch, proc *process.Process) (bool, erro
 	var end bool // exist flag
 	var err error
 
+	defer p.analyzeScope(proc)()
 	defer func() {
 		if err != nil {
 			for i, in := range p.instructions {
-				if in.Op == vm.Connector {
+				if in.Op == overload.Connector {
 					arg := p.instructions[i].Arg.(*connector.Argument)
 					arg.Reg.Ch <- nil
 					break
@@ -117,6 +134,9 @@ func (p *Pipeline) ConstRun(bat *batch.Batch, proc *process.Process) (bool, erro
 		return false, err
 	}
 	// processing the batch according to the instructions
+	if proc.ScopeInfo != nil {
+		proc.ScopeInfo.AddInput(bat, colexec.BatchSize(bat))
+	}
 	proc.Reg.InputBatch = bat
 	end, err = overload.Run(p.instructions, proc)
 	return end, err
@@ -126,10 +146,11 @@ func (p *Pipeline) RunMerge(proc *process.Process) (bool, error) {
 	var end bool
 	var err error
 
+	defer p.analyzeScope(proc)()
 	defer func() {
 		if err != nil {
 			for i, in := range p.instructions {
-				if in.Op == vm.Connector {
+				if in.Op == overload.Connector {
 					arg := p.instructions[i].Arg.(*connector.Argument)
 					arg.Reg.Ch <- nil
 					break
@@ -165,3 +186,27 @@ func (p *Pipeline) RunMerge(proc *process.Process) (bool, error) {
 		}
 	}
 }
+
+// analyzeScope registers the runtime statistics of the scope run by proc if
+// they are collected, the returned function completes them once the
+// pipeline is done.
+func (p *Pipeline) analyzeScope(proc *process.Process) func() {
+	nodeId := int32(-1)
+	for _, in := range p.instructions {
+		if in.Idx >= 0 {
+			nodeId = int32(in.Idx)
+		}
+	}
+	info := proc.ScopeInfos.NewScope(nodeId)
+	if info == nil {
+		return func() {}
+	}
+	proc.ScopeInfo = info
+	start := time.Now()
+	return func() {
+		info.TimeConsumed = int64(time.Since(start))
+		for _, in := range p.instructions {
+			info.MemoryPeak += in.Tr.Peak()
+		}
+	}
+}
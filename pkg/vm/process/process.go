@@ -15,6 +15,10 @@
 package process
 
 import (
+	"sync/atomic"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
@@ -75,3 +79,96 @@ func FreeRegisters(proc *Process) {
 	}
 	proc.Reg.Vecs = proc.Reg.Vecs[:0]
 }
+
+// NewAnalyzeInfos creates the runtime statistics of a plan of n nodes.
+func NewAnalyzeInfos(n int) []*AnalyzeInfo {
+	infos := make([]*AnalyzeInfo, n)
+	for i := range infos {
+		infos[i] = &AnalyzeInfo{NodeId: int32(i)}
+	}
+	return infos
+}
+
+// GetAnalyzeInfo returns the runtime statistics of plan node idx, or nil if
+// they are not collected.
+func GetAnalyzeInfo(proc *Process, idx int) *AnalyzeInfo {
+	if idx < 0 || idx >= len(proc.AnalInfos) {
+		return nil
+	}
+	return proc.AnalInfos[idx]
+}
+
+// NewScope registers the runtime statistics of a scope whose output comes
+// from the operators of plan node nodeId, nil is returned if the
+// statistics are not collected.
+func (s *ScopeAnalyzeInfos) NewScope(nodeId int32) *ScopeAnalyzeInfo {
+	if s == nil {
+		return nil
+	}
+	s.Lock()
+	defer s.Unlock()
+	info := &ScopeAnalyzeInfo{Id: int32(len(s.infos)), NodeId: nodeId}
+	s.infos = append(s.infos, info)
+	return info
+}
+
+// Infos returns the statistics of the scopes ordered by id.
+func (s *ScopeAnalyzeInfos) Infos() []*ScopeAnalyzeInfo {
+	if s == nil {
+		return nil
+	}
+	s.Lock()
+	defer s.Unlock()
+	return append([]*ScopeAnalyzeInfo{}, s.infos...)
+}
+
+func (s *ScopeAnalyzeInfo) AddInput(bat *batch.Batch, size int64) {
+	if bat != nil && len(bat.Zs) > 0 {
+		atomic.AddInt64(&s.InputRows, int64(len(bat.Zs)))
+		atomic.AddInt64(&s.InputBatches, 1)
+		atomic.AddInt64(&s.InputSize, size)
+	}
+}
+
+func (s *ScopeAnalyzeInfo) AddOutput(bat *batch.Batch) {
+	if bat != nil && len(bat.Zs) > 0 {
+		atomic.AddInt64(&s.OutputRows, int64(len(bat.Zs)))
+		atomic.AddInt64(&s.OutputBatches, 1)
+	}
+}
+
+func (a *AnalyzeInfo) AddInput(bat *batch.Batch) {
+	if bat != nil && len(bat.Zs) > 0 {
+		atomic.AddInt64(&a.InputRows, int64(len(bat.Zs)))
+		atomic.AddInt64(&a.InputBatches, 1)
+	}
+}
+
+func (a *AnalyzeInfo) AddOutput(bat *batch.Batch) {
+	if bat != nil && len(bat.Zs) > 0 {
+		atomic.AddInt64(&a.OutputRows, int64(len(bat.Zs)))
+		atomic.AddInt64(&a.OutputBatches, 1)
+	}
+}
+
+func (a *AnalyzeInfo) AddTime(d time.Duration) {
+	atomic.AddInt64(&a.TimeConsumed, int64(d))
+}
+
+func (a *AnalyzeInfo) AddInputSize(size int64) {
+	atomic.AddInt64(&a.InputSize, size)
+}
+
+func (a *AnalyzeInfo) AddPruneStats(blocks, pruned int64) {
+	atomic.AddInt64(&a.InputBlocks, blocks)
+	atomic.AddInt64(&a.PrunedBlocks, pruned)
+}
+
+// SetMemoryPeak raises the memory peak to size.
+func (a *AnalyzeInfo) SetMemoryPeak(size int64) {
+	for v := atomic.LoadInt64(&a.MemoryPeak); v < size; v = atomic.LoadInt64(&a.MemoryPeak) {
+		if atomic.CompareAndSwapInt64(&a.MemoryPeak, v, size) {
+			return
+		}
+	}
+}
@@ -16,10 +16,12 @@ package process
 
 import (
 	"context"
+	"sync"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/tracker"
 )
 
 // WaitRegister channel
@@ -53,6 +55,63 @@ type Limitation struct {
 	BatchSize int64
 	// PartitionRows, max rows for partition.
 	PartitionRows int64
+	// SpillDir, directory for the temporary files of operators that
+	// exceed Size, the system temporary directory is used if empty.
+	SpillDir string
+}
+
+// AnalyzeInfo contains the runtime statistics of the operators compiled from
+// one plan node, it is shared by all the pipelines running these operators.
+type AnalyzeInfo struct {
+	// NodeId, id of the plan node.
+	NodeId int32
+	// InputRows, InputBatches, rows and batches received by the operators.
+	InputRows    int64
+	InputBatches int64
+	// OutputRows, OutputBatches, rows and batches produced by the operators.
+	OutputRows    int64
+	OutputBatches int64
+	// TimeConsumed, nanoseconds spent in the operators.
+	TimeConsumed int64
+	// MemoryPeak, the largest memory peak of a single operator.
+	MemoryPeak int64
+	// InputSize, bytes read from the storage engine.
+	InputSize int64
+	// InputBlocks, blocks the storage engine went through for the scan,
+	// PrunedBlocks, those of them skipped by the pushed down filter.
+	InputBlocks  int64
+	PrunedBlocks int64
+}
+
+// ScopeAnalyzeInfo contains the runtime statistics of one scope of a
+// compiled query, the pipeline running its instructions.
+type ScopeAnalyzeInfo struct {
+	// Id, order in which the scope started to run.
+	Id int32
+	// NodeId, plan node of the last operator of the scope, the one its
+	// output comes from, -1 if no operator of the scope has a plan node.
+	NodeId int32
+	// InputRows, InputBatches, InputSize, rows, batches and bytes read from
+	// the storage engine or from the constant batch of the scope.
+	InputRows    int64
+	InputBatches int64
+	InputSize    int64
+	// OutputRows, OutputBatches, rows and batches passed to the last
+	// instruction of the scope.
+	OutputRows    int64
+	OutputBatches int64
+	// TimeConsumed, nanoseconds from the start to the end of the pipeline,
+	// including the time spent waiting for other scopes.
+	TimeConsumed int64
+	// MemoryPeak, sum of the memory peaks of the operators of the scope.
+	MemoryPeak int64
+}
+
+// ScopeAnalyzeInfos collects the runtime statistics of all the scopes of a
+// query, including the parallel scopes created while it runs.
+type ScopeAnalyzeInfos struct {
+	sync.Mutex
+	infos []*ScopeAnalyzeInfo
 }
 
 // Process contains context used in query execution
@@ -64,6 +123,16 @@ type Process struct {
 	Reg Register
 	Lim Limitation
 	Mp  *mheap.Mheap
+	// Tr, memory tracker of the query, it is replaced by the tracker of
+	// the operator while the operator is running.
+	Tr *tracker.Tracker
+	// AnalInfos, runtime statistics of the query indexed by plan node id,
+	// nil if they are not collected.
+	AnalInfos []*AnalyzeInfo
+	// ScopeInfos, runtime statistics of the scopes of the query, ScopeInfo
+	// those of the scope run by the process, nil if they are not collected.
+	ScopeInfos *ScopeAnalyzeInfos
+	ScopeInfo  *ScopeAnalyzeInfo
 
 	// unix timestamp
 	UnixTime int64
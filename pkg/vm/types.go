@@ -14,6 +14,8 @@
 
 package vm
 
+import "github.com/matrixorigin/matrixone/pkg/vm/mmu/tracker"
+
 const (
 	Top = iota
 	Join
@@ -46,8 +48,13 @@ const (
 type Instruction struct {
 	// Op specified the operator code of an instruction.
 	Op int
+	// Idx is the id of the plan node the instruction is compiled from, it is
+	// used to collect runtime statistics, -1 if there is no such node.
+	Idx int
 	// Arg contains the operand of this instruction.
 	Arg interface{}
+	// Tr tracks the memory used by the operator of this instruction.
+	Tr *tracker.Tracker
 }
 
 type Instructions []Instruction